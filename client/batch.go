@@ -19,6 +19,7 @@ package client
 
 import (
 	"fmt"
+	"sync"
 
 	"github.com/cockroachdb/cockroach/proto"
 	gogoproto "github.com/gogo/protobuf/proto"
@@ -46,11 +47,136 @@ type Batch struct {
 	resultsBuf [8]Result
 	rowsBuf    [8]KeyValue
 	rowsIdx    int
+	// userPriority, if non-zero, is the user priority of the batch's
+	// operations. See SetUserPriority.
+	userPriority int32
+	// readConsistency, if non-nil, is the consistency of the batch's reads.
+	// See SetReadConsistency.
+	readConsistency *proto.ReadConsistencyType
+	// err is the error returned by the cluster when the batch was last
+	// sent.
+	err error
 }
 
+// A BatchError attributes an error encountered while running a batch to
+// the operation which caused it. See Batch.Errors.
+type BatchError struct {
+	// Index is the index of the operation within the batch and of its
+	// result within Batch.Results, or -1 if the error could not be
+	// attributed to a single operation.
+	Index int
+	// Method is the method of the operation, e.g. proto.Put.
+	Method proto.Method
+	// Key is the (first) key of the operation.
+	Key proto.Key
+	// Err is the error encountered.
+	Err error
+}
+
+// Error implements the error interface.
+func (e *BatchError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("batch: %s", e.Err)
+	}
+	return fmt.Sprintf("operation %d (%s %s): %s", e.Index, e.Method, e.Key, e.Err)
+}
+
+// Errors returns the errors encountered by the operations of the batch
+// when it was last run, each attributed to the operation which caused it.
+// An error reported for the batch as a whole is attributed to the batch's
+// operation if there is only one, and otherwise returned with an Index of
+// -1. The error returned by Run is left unchanged so that it can still be
+// inspected by type.
+func (b *Batch) Errors() []*BatchError {
+	var errs []*BatchError
+	offset := 0
+	for i, r := range b.Results {
+		if r.Err != nil {
+			errs = append(errs, b.newBatchError(i, offset, r.calls, r.Err))
+		}
+		offset += r.calls
+	}
+	if len(errs) == 0 && b.err != nil {
+		if len(b.Results) == 1 {
+			errs = append(errs, b.newBatchError(0, 0, b.Results[0].calls, b.err))
+		} else {
+			errs = append(errs, &BatchError{Index: -1, Err: b.err})
+		}
+	}
+	return errs
+}
+
+// newBatchError returns a BatchError for the operation with the given
+// index, whose calls start at the given offset.
+func (b *Batch) newBatchError(index, offset, calls int, err error) *BatchError {
+	e := &BatchError{Index: index, Err: err}
+	if calls > 0 {
+		if args := b.calls[offset].Args; args != nil {
+			e.Method = args.Method()
+			e.Key = args.Header().Key
+		}
+	}
+	return e
+}
+
+// Reset clears the batch so that it can be reused for another set of
+// operations, retaining the memory allocated for its operations and
+// results. Reusing a batch avoids allocations in loops running many small
+// batches:
+//
+//   b := &client.Batch{}
+//   for ... {
+//     b.Put(key, value)
+//     if err := db.Run(b); err != nil {
+//       return err
+//     }
+//     b.Reset()
+//   }
+//
+// The Results of the batch, including their rows, must not be used once
+// the batch has been reset.
+func (b *Batch) Reset() {
+	for i := range b.calls {
+		b.calls[i] = Call{}
+	}
+	for i := range b.Results {
+		b.Results[i] = Result{}
+	}
+	b.calls = b.calls[:0]
+	b.Results = b.Results[:0]
+	b.rowsBuf = [len(b.rowsBuf)]KeyValue{}
+	b.rowsIdx = 0
+	b.userPriority = 0
+	b.readConsistency = nil
+	b.err = nil
+}
+
+// batchPool holds the batches used by the single operation methods of DB
+// and Txn, such as Get and Put.
+var batchPool = sync.Pool{
+	New: func() interface{} {
+		return &Batch{}
+	},
+}
+
+// getBatch returns an empty batch from the pool. The batch should be
+// returned to the pool with putBatch once neither it nor its results are
+// in use.
+func getBatch() *Batch {
+	return batchPool.Get().(*Batch)
+}
+
+// putBatch resets the batch and returns it to the pool.
+func putBatch(b *Batch) {
+	b.Reset()
+	batchPool.Put(b)
+}
+
+// prepare returns the first error encountered while adding operations to
+// the batch. Such operations have no calls.
 func (b *Batch) prepare() error {
 	for _, r := range b.Results {
-		if err := r.Err; err != nil {
+		if err := r.Err; err != nil && r.calls == 0 {
 			return err
 		}
 	}
@@ -77,6 +203,10 @@ func (b *Batch) fillResults() error {
 	offset := 0
 	for i := range b.Results {
 		result := &b.Results[i]
+		if result.calls > 0 {
+			// Clear the outcome of any previous run of the batch.
+			result.Err, result.Deleted = nil, 0
+		}
 
 		for k := 0; k < result.calls; k++ {
 			call := b.calls[offset+k]
@@ -108,6 +238,14 @@ func (b *Batch) fillResults() error {
 					row.setValue(&req.Value)
 					row.setTimestamp(t.Timestamp)
 				}
+			case *proto.PutUniqueResponse:
+				req := call.Args.(*proto.PutUniqueRequest)
+				row := &result.Rows[k]
+				row.Key = []byte(req.Key)
+				if result.Err == nil {
+					row.setValue(&req.Value)
+					row.setTimestamp(t.Timestamp)
+				}
 			case *proto.IncrementResponse:
 				row := &result.Rows[k]
 				row.Key = []byte(call.Args.(*proto.IncrementRequest).Key)
@@ -130,7 +268,19 @@ func (b *Batch) fillResults() error {
 			case *proto.AdminMergeResponse:
 			case *proto.AdminSplitResponse:
 			case *proto.DeleteRangeResponse:
+				if result.Err == nil {
+					result.Deleted += t.NumDeleted
+				}
+			case *proto.DeleteRowResponse:
+				if result.Err == nil && t.Deleted {
+					result.Deleted++
+				}
 			case *proto.EndTransactionResponse:
+			case *proto.GetRowResponse:
+			case *proto.LockRowResponse:
+			case *proto.PutRowResponse:
+			case *proto.ScanRowsResponse:
+			case *proto.ScanChangesResponse:
 			case *proto.InternalBatchResponse:
 			case *proto.InternalGCResponse:
 			case *proto.InternalMergeResponse:
@@ -139,8 +289,8 @@ func (b *Batch) fillResults() error {
 			case *proto.InternalResolveIntentResponse:
 			case *proto.InternalResolveIntentRangeResponse:
 				// Nothing to do for these methods as they do not generate any
-				// rows. For the proto.Internal* responses the caller will have hold of
-				// the response object itself.
+				// rows. For the row and proto.Internal* responses the caller will
+				// have hold of the response object itself.
 
 			default:
 				if result.Err == nil {
@@ -160,6 +310,87 @@ func (b *Batch) fillResults() error {
 	return nil
 }
 
+// SetUserPriority sets the user priority of the operations of the batch,
+// overriding the default priority of the DB. It is ignored by batches run
+// within a transaction, whose operations share the transaction's priority
+// (see Txn.SetUserPriority).
+func (b *Batch) SetUserPriority(priority int32) {
+	b.userPriority = priority
+}
+
+// applyUserPriority sets the user priority of the batch on its calls.
+func (b *Batch) applyUserPriority() {
+	if b.userPriority == 0 {
+		return
+	}
+	for _, c := range b.calls {
+		if c.Args != nil {
+			c.Args.Header().UserPriority = gogoproto.Int32(b.userPriority)
+		}
+	}
+}
+
+// SetReadConsistency sets the consistency of the reads of the batch,
+// overriding the default consistency of the DB (see ReadConsistencyOpt).
+// It is ignored by batches run within a transaction, whose reads are
+// always consistent.
+func (b *Batch) SetReadConsistency(consistency proto.ReadConsistencyType) {
+	b.readConsistency = &consistency
+}
+
+// applyReadConsistency sets the read consistency of the batch, or
+// consistency if the batch has none, on its read-only calls.
+func (b *Batch) applyReadConsistency(consistency proto.ReadConsistencyType) {
+	if b.readConsistency != nil {
+		consistency = *b.readConsistency
+	}
+	for _, c := range b.calls {
+		if c.Args != nil && proto.IsReadOnly(c.Args) {
+			c.Args.Header().ReadConsistency = consistency
+		}
+	}
+}
+
+// A RequestInfo describes a request accumulated by a batch.
+type RequestInfo struct {
+	// Method is the method of the request, e.g. proto.Put.
+	Method proto.Method
+	// Key and EndKey are the keys addressed by the request. EndKey is empty
+	// for requests addressing a single key.
+	Key, EndKey proto.Key
+	// ValueSize is the number of value bytes written by the request.
+	ValueSize int
+}
+
+// Requests returns a description of the requests accumulated by the batch,
+// in the order in which they were added. Operations which failed to
+// generate a request, e.g. because their key could not be marshaled, are
+// omitted. The returned descriptions do not alias the batch.
+func (b *Batch) Requests() []RequestInfo {
+	infos := make([]RequestInfo, 0, len(b.calls))
+	for _, c := range b.calls {
+		if c.Args == nil {
+			continue
+		}
+		h := c.Args.Header()
+		info := RequestInfo{
+			Method: c.Args.Method(),
+			Key:    append(proto.Key(nil), h.Key...),
+			EndKey: append(proto.Key(nil), h.EndKey...),
+		}
+		switch t := c.Args.(type) {
+		case *proto.PutRequest:
+			info.ValueSize = len(t.Value.Bytes)
+		case *proto.ConditionalPutRequest:
+			info.ValueSize = len(t.Value.Bytes)
+		case *proto.PutUniqueRequest:
+			info.ValueSize = len(t.Value.Bytes)
+		}
+		infos = append(infos, info)
+	}
+	return infos
+}
+
 // InternalAddCall adds the specified call to the batch. It is intended for
 // internal use only.
 func (b *Batch) InternalAddCall(call Call) {
@@ -262,6 +493,31 @@ func (b *Batch) CPut(key, value, expValue interface{}) {
 	b.initResult(1, 1, nil)
 }
 
+// PutUnique sets the value for a key unless the key holds a different
+// value, in which case the result's error is a *proto.ConditionFailedError
+// holding the existing value. It writes the entries of unique indexes
+// without a separate read to verify their uniqueness.
+//
+// A new result will be appended to the batch which will contain a single row
+// and Result.Err will indicate success or failure.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (b *Batch) PutUnique(key, value interface{}) {
+	k, err := marshalKey(key)
+	if err != nil {
+		b.initResult(0, 1, err)
+		return
+	}
+	v, err := marshalValue(value)
+	if err != nil {
+		b.initResult(0, 1, err)
+		return
+	}
+	b.calls = append(b.calls, PutUnique(proto.Key(k), v))
+	b.initResult(1, 1, nil)
+}
+
 // Inc increments the integer value at key. If the key does not exist it will
 // be created with an initial value of 0 which will then be incremented. If the
 // key exists but was set using Put or CPut an error will be returned.
@@ -289,6 +545,37 @@ func (b *Batch) Inc(key interface{}, value int64) {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (b *Batch) Scan(s, e interface{}, maxRows int64) {
+	b.scan(s, e, maxRows, Scan)
+}
+
+// ScanKeys retrieves the keys of the rows between begin (inclusive) and end
+// (exclusive). It is like Scan, but the values of the returned rows are
+// nil, which saves shipping them when only the keys are of interest, such
+// as when checking for existence or counting rows.
+//
+// A new result will be appended to the batch which will contain up to maxRows
+// rows and Result.Err will indicate success or failure.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (b *Batch) ScanKeys(s, e interface{}, maxRows int64) {
+	b.scan(s, e, maxRows, ScanKeys)
+}
+
+// ReverseScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in descending key order, starting with the last row before
+// end.
+//
+// A new result will be appended to the batch which will contain up to maxRows
+// rows and Result.Err will indicate success or failure.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (b *Batch) ReverseScan(s, e interface{}, maxRows int64) {
+	b.scan(s, e, maxRows, ReverseScan)
+}
+
+func (b *Batch) scan(s, e interface{}, maxRows int64, scan func(key, endKey proto.Key, maxResults int64) Call) {
 	begin, err := marshalKey(s)
 	if err != nil {
 		b.initResult(0, 0, err)
@@ -299,7 +586,7 @@ func (b *Batch) Scan(s, e interface{}, maxRows int64) {
 		b.initResult(0, 0, err)
 		return
 	}
-	b.calls = append(b.calls, Scan(proto.Key(begin), proto.Key(end), maxRows))
+	b.calls = append(b.calls, scan(proto.Key(begin), proto.Key(end), maxRows))
 	b.initResult(1, 0, nil)
 }
 
@@ -332,6 +619,19 @@ func (b *Batch) Del(keys ...interface{}) {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (b *Batch) DelRange(s, e interface{}) {
+	b.DelRangeLimit(s, e, 0)
+}
+
+// DelRangeLimit deletes at most maxKeys of the rows between begin
+// (inclusive) and end (exclusive). A maxKeys of zero deletes all of the
+// rows. The number of rows deleted is returned in Result.Deleted.
+//
+// A new result will be appended to the batch which will contain 0 rows and
+// Result.Err will indicate success or failure.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (b *Batch) DelRangeLimit(s, e interface{}, maxKeys int64) {
 	begin, err := marshalKey(s)
 	if err != nil {
 		b.initResult(0, 0, err)
@@ -342,7 +642,9 @@ func (b *Batch) DelRange(s, e interface{}) {
 		b.initResult(0, 0, err)
 		return
 	}
-	b.calls = append(b.calls, DeleteRange(proto.Key(begin), proto.Key(end)))
+	call := DeleteRange(proto.Key(begin), proto.Key(end))
+	call.Args.(*proto.DeleteRangeRequest).MaxEntriesToDelete = maxKeys
+	b.calls = append(b.calls, call)
 	b.initResult(1, 0, nil)
 }
 
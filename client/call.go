@@ -44,6 +44,16 @@ func (c *Call) resetClientCmdID() {
 	}
 }
 
+// resetClientCmdIDs sets new client command IDs on the calls. Calls which
+// already have a client command ID keep it when they are sent, so a call
+// retried after a lost response is recognized by the response cache of
+// its range and not applied twice.
+func resetClientCmdIDs(calls []Call) {
+	for i := range calls {
+		calls[i].resetClientCmdID()
+	}
+}
+
 // Method returns the method of the database command for the call.
 func (c *Call) Method() proto.Method {
 	return c.Args.Method()
@@ -131,6 +141,22 @@ func ConditionalPut(key proto.Key, valueBytes, expValueBytes []byte) Call {
 	}
 }
 
+// PutUnique returns a Call object initialized to put value as a byte
+// slice at key unless the existing value at key differs.
+func PutUnique(key proto.Key, valueBytes []byte) Call {
+	value := proto.Value{Bytes: valueBytes}
+	value.InitChecksum(key)
+	return Call{
+		Args: &proto.PutUniqueRequest{
+			RequestHeader: proto.RequestHeader{
+				Key: key,
+			},
+			Value: value,
+		},
+		Reply: &proto.PutUniqueResponse{},
+	}
+}
+
 // PutProto returns a Call object initialized to put the proto
 // message as a byte slice at key.
 func PutProto(key proto.Key, msg gogoproto.Message) Call {
@@ -192,3 +218,19 @@ func Scan(key, endKey proto.Key, maxResults int64) Call {
 		Reply: &proto.ScanResponse{},
 	}
 }
+
+// ScanKeys returns a Call object initialized to scan the keys from start
+// to end keys with max results. The values of the rows are not returned.
+func ScanKeys(key, endKey proto.Key, maxResults int64) Call {
+	c := Scan(key, endKey, maxResults)
+	c.Args.(*proto.ScanRequest).KeysOnly = true
+	return c
+}
+
+// ReverseScan returns a Call object initialized to scan from end to start
+// keys with max results, in descending key order.
+func ReverseScan(key, endKey proto.Key, maxResults int64) Call {
+	c := Scan(key, endKey, maxResults)
+	c.Args.(*proto.ScanRequest).Reverse = true
+	return c
+}
@@ -330,6 +330,54 @@ func TestClientGetAndPut(t *testing.T) {
 	}
 }
 
+// TestClientJSONEncoding verifies that a client using the JSON encoding
+// of the HTTP sender supports plain operations, batches and transactions.
+func TestClientJSONEncoding(t *testing.T) {
+	s := server.StartTestServer(t)
+	defer s.Stop()
+	db, err := client.Open("https://root@" + s.ServingAddr() + "?certs=" + security.EmbeddedCertsDir + "&encoding=json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	b := &client.Batch{}
+	b.Put("b", "2")
+	b.Inc("c", 3)
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Txn(func(txn *client.Txn) error {
+		return txn.Put("d", "4")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := db.Scan("a", "e", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"a=1", "b=2", "c=3", "d=4"}
+	if len(rows) != len(expected) {
+		t.Fatalf("expected %d rows, but found %d", len(expected), len(rows))
+	}
+	for i, row := range rows {
+		var value string
+		if string(row.Key) == "c" {
+			value = fmt.Sprint(row.ValueInt())
+		} else {
+			value = string(row.ValueBytes())
+		}
+		if kv := fmt.Sprintf("%s=%s", row.Key, value); kv != expected[i] {
+			t.Errorf("%d: expected %q, but found %q", i, expected[i], kv)
+		}
+	}
+	if err := db.CPut("a", "2", "3"); err == nil {
+		t.Errorf("expected the conditional put to fail")
+	}
+}
+
 // TestClientEmptyValues verifies that empty values are preserved
 // for both empty []byte and integer=0. This used to fail when we
 // allowed the protobufs to be gob-encoded using the default go rpc
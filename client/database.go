@@ -0,0 +1,126 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// CreateDatabase creates a database. Tables are created within a database
+// by qualifying their names with the database name ("<database>.<table>").
+// An error is returned if the database already exists.
+func (db *DB) CreateDatabase(name string) error {
+	if strings.Contains(name, ".") {
+		return fmt.Errorf("invalid database name: %q", name)
+	}
+	if name == DefaultDatabaseName {
+		return fmt.Errorf("database %q already exists", name)
+	}
+	desc := proto.DatabaseDescriptor{Name: name}
+	var err error
+	if desc.Id, err = db.allocateDescID(); err != nil {
+		return err
+	}
+	if err := proto.ValidateDatabaseDesc(desc); err != nil {
+		return err
+	}
+	return db.Txn(func(txn *Txn) error {
+		key := keys.MakeNamespaceMetadataKey(name)
+		if r, err := txn.Get(key); err != nil {
+			return err
+		} else if r.Exists() {
+			return fmt.Errorf("database %q already exists", name)
+		}
+		b := &Batch{}
+		b.CPut(key, encodeDescID(desc.Id), nil)
+		b.CPut(keys.MakeDescMetadataKey(desc.Id), &desc, nil)
+		return txn.Commit(b)
+	})
+}
+
+// DropDatabase drops an empty database. The default database cannot be
+// dropped.
+func (db *DB) DropDatabase(name string) error {
+	if name == DefaultDatabaseName {
+		return fmt.Errorf("cannot drop database %q", name)
+	}
+	return db.Txn(func(txn *Txn) error {
+		desc, err := getDatabaseDesc(txn, name)
+		if err != nil {
+			return err
+		}
+		names, _, err := listTables(txn, name, "")
+		if err != nil {
+			return err
+		}
+		if len(names) > 0 {
+			return fmt.Errorf("database %q is not empty", name)
+		}
+		b := &Batch{}
+		b.Del(keys.MakeNamespaceMetadataKey(name), keys.MakeDescMetadataKey(desc.Id))
+		return txn.Commit(b)
+	})
+}
+
+// ListDatabases returns the sorted names of the databases, including the
+// default database.
+func (db *DB) ListDatabases() ([]string, error) {
+	prefix := keys.MakeNamespaceMetadataKey("")
+	rows, err := db.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{DefaultDatabaseName}
+	for _, row := range rows {
+		names = append(names, string(bytes.TrimPrefix(row.Key, prefix)))
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// SetDatabase sets the database within which subsequent table operations
+// on db resolve unqualified table names. An empty name selects the default
+// database. An error is returned if the database does not exist. Like the
+// other DB options, SetDatabase should not be called concurrently with
+// operations on db; transactions capture the database when they start.
+func (db *DB) SetDatabase(name string) error {
+	if name == "" {
+		name = DefaultDatabaseName
+	}
+	if err := db.Txn(func(txn *Txn) error {
+		_, err := getDatabaseDesc(txn, name)
+		return err
+	}); err != nil {
+		return err
+	}
+	db.database = name
+	return nil
+}
+
+// defaultDatabase returns the database within which unqualified table names
+// are resolved.
+func (db *DB) defaultDatabase() string {
+	if db.database == "" {
+		return DefaultDatabaseName
+	}
+	return db.database
+}
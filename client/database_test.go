@@ -0,0 +1,158 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCreateDatabase(t *testing.T) {
+	db, _ := newMemDB()
+
+	for _, name := range []string{"app", "billing"} {
+		if err := db.CreateDatabase(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// The same table name may be used in different databases.
+	for _, name := range []string{"app.users", "billing.users", "users"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	appUsers, err := db.DescribeTableDesc("app.users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	billingUsers, err := db.DescribeTableDesc("billing.users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if appUsers.Id == billingUsers.Id || appUsers.ParentId == billingUsers.ParentId {
+		t.Errorf("expected distinct tables, but found %+v and %+v", appUsers, billingUsers)
+	}
+	if names, err := db.ListTables("app", ""); err != nil || !reflect.DeepEqual([]string{"users"}, names) {
+		t.Errorf("unexpected tables: %q (%v)", names, err)
+	}
+
+	testData := []struct {
+		name string
+		err  string
+	}{
+		{"app", `database "app" already exists`},
+		{"default", `database "default" already exists`},
+		{"a.b", `invalid database name: "a.b"`},
+		{"", "empty database name"},
+	}
+	for i, d := range testData {
+		if err := db.CreateDatabase(d.name); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestListDatabases(t *testing.T) {
+	db, _ := newMemDB()
+
+	for _, name := range []string{"billing", "app"} {
+		if err := db.CreateDatabase(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if names, err := db.ListDatabases(); err != nil {
+		t.Fatal(err)
+	} else if expected := []string{"app", "billing", "default"}; !reflect.DeepEqual(expected, names) {
+		t.Errorf("expected %q, but found %q", expected, names)
+	}
+}
+
+func TestDropDatabase(t *testing.T) {
+	db, _ := newMemDB()
+
+	if err := db.CreateDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("app.users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropDatabase("app"); err == nil || err.Error() != `database "app" is not empty` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.DropTable("app.users"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ListTables("app", ""); err == nil || err.Error() != `database "app" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	// The name may be reused.
+	if err := db.CreateDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.DropDatabase("default"); err == nil || err.Error() != `cannot drop database "default"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.DropDatabase("missing"); err == nil || err.Error() != `database "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSetDatabase(t *testing.T) {
+	db, _ := newMemDB()
+
+	if err := db.CreateDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetDatabase("missing"); err == nil || err.Error() != `database "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.SetDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.DescribeTable("app.users"); err != nil {
+		t.Fatal(err)
+	}
+	if names, err := db.ListTables("", ""); err != nil || !reflect.DeepEqual([]string{"users"}, names) {
+		t.Errorf("unexpected tables: %q (%v)", names, err)
+	}
+	// Qualified names still refer to other databases.
+	if err := db.CreateTable(testSchema("default.users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RenameTable("users", "accounts"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.DescribeTable("app.accounts"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.SetDatabase(""); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.DescribeTable("accounts"); err == nil || err.Error() != `table "accounts" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := db.DescribeTable("users"); err != nil {
+		t.Fatal(err)
+	}
+}
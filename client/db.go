@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/url"
 	"strconv"
+	"sync"
 	"time"
 
 	"golang.org/x/net/context"
@@ -109,6 +110,9 @@ func (kv *KeyValue) ValueProto(msg gogoproto.Message) error {
 type Result struct {
 	calls int
 	// Err contains any error encountered when performing the operation.
+	// When a batch fails, the results of the operations which completed
+	// have no error and those of the operations which were not executed
+	// hold the error of the batch.
 	Err error
 	// Rows contains the key/value pairs for the operation. The number of rows
 	// returned varies by operation. For Get, Put, CPut, Inc and Del the number
@@ -116,6 +120,8 @@ type Result struct {
 	// rows returned is the number or rows matching the scan capped by the
 	// maxRows parameter. For DelRange Rows is nil.
 	Rows []KeyValue
+	// Deleted is the number of keys deleted by DelRange and DelRangeLimit.
+	Deleted int64
 }
 
 func (r Result) String() string {
@@ -143,8 +149,46 @@ type DB struct {
 	// userPriority is the default user priority to set on API calls. If
 	// userPriority is set non-zero in call arguments, this value is
 	// ignored.
-	userPriority    int32
+	userPriority int32
+	// readConsistency is the default consistency of reads run outside of
+	// a transaction. See ReadConsistencyOpt.
+	readConsistency proto.ReadConsistencyType
 	txnRetryOptions retry.Options
+	// retryRun is true if Run retries batches failing with retryable
+	// errors. See RetryOpt.
+	retryRun bool
+	// classifiers holds the classifiers registered with ClassifyOpt.
+	classifiers []Classifier
+	// ctx, if non-nil, is the context of the operations performed through
+	// the DB handle. See RunContext and TxnContext.
+	ctx context.Context
+	// inflight, if non-nil, tracks the calls abandoned because ctx was
+	// canceled which have not yet completed.
+	inflight *sync.WaitGroup
+	// traces holds the hooks registered with TraceOpt.
+	traces []TraceHooks
+	// metrics, if non-nil, collects the metrics of the DB handle. See
+	// Metrics.
+	metrics *clientMetrics
+	// limiter, if non-nil, limits the calls sent to the cluster. See
+	// LimitOpt.
+	limiter *limiter
+	// database is the database within which unqualified table names are
+	// resolved. If empty, DefaultDatabaseName is used.
+	database string
+	// batchChunkCalls and batchChunkBytes bound the number of calls and the
+	// encoded size of the requests sent in a single batch. Larger batches
+	// are split into chunks. Zero means unbounded.
+	batchChunkCalls int
+	batchChunkBytes int
+	// batchMaxKeys and batchMaxValueBytes are hard limits on the number of
+	// keys and the total size of the values of a batch. Zero means
+	// unlimited.
+	batchMaxKeys       int
+	batchMaxValueBytes int
+	// descIDs, if non-nil, holds the descriptor IDs reserved by the DB
+	// handle. See DescIDBlockOpt.
+	descIDs *descIDAllocator
 }
 
 // Option is the signature for a function which applies an option to a DB.
@@ -157,7 +201,165 @@ func SenderOpt(sender Sender) Option {
 	}
 }
 
-// TODO(pmattis): Allow setting the sender/txn retry options.
+// DatabaseOpt sets the database within which unqualified table names are
+// resolved. Unlike SetDatabase, the database is not checked for existence.
+func DatabaseOpt(name string) Option {
+	return func(db *DB) {
+		db.database = name
+	}
+}
+
+// RetryOpt sets the retry options, i.e. the backoff curve and the maximum
+// number of attempts, used to retry transactions which must restart (see
+// Txn) and batches which fail with a retryable error (see Run). An error
+// is retryable if it indicates a transient condition, such as a range
+// being unavailable while its leader changes (see util.Retryable). Without
+// RetryOpt, transactions are retried indefinitely using
+// DefaultTxnRetryOptions and batches run outside of a transaction are not
+// retried. See ClassifyOpt to change which errors are retried.
+func RetryOpt(opts retry.Options) Option {
+	return func(db *DB) {
+		db.txnRetryOptions = opts
+		db.retryRun = true
+	}
+}
+
+// ReadConsistencyOpt sets the default consistency of the reads performed
+// through a DB outside of a transaction. proto.INCONSISTENT reads are
+// served by any replica without waiting for pending writes and may
+// return stale values, which suits read-mostly caches. Batches may
+// override the default with Batch.SetReadConsistency. Reads within
+// transactions are always consistent.
+func ReadConsistencyOpt(consistency proto.ReadConsistencyType) Option {
+	return func(db *DB) {
+		db.readConsistency = consistency
+	}
+}
+
+// User priorities. The user priority of an operation is a multiple for
+// how likely it is to prevail in a conflict. See proto.MakePriority.
+const (
+	// NormalUserPriority is the default user priority.
+	NormalUserPriority int32 = 1
+	// LowUserPriority is the lowest user priority: it gives transactions
+	// the lowest possible fixed priority, so that they lose conflicts with
+	// operations of any other priority. It is used by background jobs such
+	// as backfills, exports and garbage collection.
+	LowUserPriority int32 = -1
+)
+
+// UserPriorityOpt sets the default user priority of the operations
+// performed through a DB.
+func UserPriorityOpt(priority int32) Option {
+	return func(db *DB) {
+		db.userPriority = priority
+	}
+}
+
+// background returns a copy of the DB handle whose operations run at
+// LowUserPriority, for use by background jobs so that they do not starve
+// interactive workloads.
+func (db *DB) background() *DB {
+	bg := *db
+	bg.userPriority = LowUserPriority
+	return &bg
+}
+
+// BatchChunkOpt bounds the number of calls and the total encoded size of
+// the requests sent to the cluster in a single batch. Batches exceeding
+// either bound are transparently split into chunks which are sent one
+// after the other; the results are returned in the order the operations
+// were added to the batch. A bound of zero disables chunking on that
+// dimension. Batches are not chunked unless BatchChunkOpt is given. Note
+// that outside of a transaction the chunks of a batch are not applied
+// atomically.
+func BatchChunkOpt(maxCalls, maxBytes int) Option {
+	return func(db *DB) {
+		db.batchChunkCalls = maxCalls
+		db.batchChunkBytes = maxBytes
+	}
+}
+
+// BatchLimitOpt sets hard limits on the number of keys and the total size
+// of the values written by a single batch. Batches exceeding either limit
+// fail with a *BatchTooLargeError before anything is sent to the cluster.
+// A limit of zero disables it.
+func BatchLimitOpt(maxKeys, maxValueBytes int) Option {
+	return func(db *DB) {
+		db.batchMaxKeys = maxKeys
+		db.batchMaxValueBytes = maxValueBytes
+	}
+}
+
+// DefaultDescIDBlockSize is the number of descriptor IDs reserved at a
+// time by DB handles created with Open. See DescIDBlockOpt.
+const DefaultDescIDBlockSize = 10
+
+// DescIDBlockOpt sets the number of database and table descriptor IDs
+// the DB handle reserves at a time from the cluster-wide ID generator.
+// Reserving IDs in blocks saves a round trip to the generator's key,
+// which every schema change in the cluster would otherwise contend on,
+// for all but one of the tables created in a block. IDs reserved but not
+// used before the DB handle is discarded are skipped, and tables created
+// through different DB handles do not have IDs in creation order. A block
+// size of 1 or less allocates IDs one at a time.
+func DescIDBlockOpt(n int64) Option {
+	return func(db *DB) {
+		if n <= 1 {
+			db.descIDs = nil
+		} else {
+			db.descIDs = &descIDAllocator{blockSize: n}
+		}
+	}
+}
+
+// A BatchTooLargeError is returned when a batch exceeds the limits set by
+// BatchLimitOpt.
+type BatchTooLargeError struct {
+	Keys, MaxKeys             int
+	ValueBytes, MaxValueBytes int
+}
+
+// Error implements the error interface.
+func (e *BatchTooLargeError) Error() string {
+	if e.MaxKeys > 0 && e.Keys > e.MaxKeys {
+		return fmt.Sprintf("batch of %d keys exceeds the limit of %d keys", e.Keys, e.MaxKeys)
+	}
+	return fmt.Sprintf("batch of %d value bytes exceeds the limit of %d bytes", e.ValueBytes, e.MaxValueBytes)
+}
+
+// checkBatchLimits returns a *BatchTooLargeError if the calls exceed the
+// limits set by BatchLimitOpt.
+func (db *DB) checkBatchLimits(calls []Call) error {
+	if db.batchMaxKeys <= 0 && db.batchMaxValueBytes <= 0 {
+		return nil
+	}
+	e := &BatchTooLargeError{MaxKeys: db.batchMaxKeys, MaxValueBytes: db.batchMaxValueBytes}
+	for _, c := range calls {
+		if c.Args == nil || len(c.Args.Header().Key) == 0 {
+			continue
+		}
+		e.Keys++
+		switch t := c.Args.(type) {
+		case *proto.PutRequest:
+			e.ValueBytes += len(t.Value.Bytes)
+		case *proto.ConditionalPutRequest:
+			e.ValueBytes += len(t.Value.Bytes)
+		case *proto.PutUniqueRequest:
+			e.ValueBytes += len(t.Value.Bytes)
+		case *proto.PutRowRequest:
+			// Each cell of the row is written under its own key.
+			e.Keys += len(t.Cells)
+			for _, cell := range t.Cells {
+				e.ValueBytes += len(cell.Value)
+			}
+		}
+	}
+	if (e.MaxKeys > 0 && e.Keys > e.MaxKeys) || (e.MaxValueBytes > 0 && e.ValueBytes > e.MaxValueBytes) {
+		return e
+	}
+	return nil
+}
 
 // Open creates a new database handle to the cockroach cluster specified by
 // addr. The cluster is identified by a URL with the format:
@@ -207,6 +409,8 @@ func Open(addr string, opts ...Option) (*DB, error) {
 		Sender:          sender,
 		user:            u.User.Username(),
 		txnRetryOptions: DefaultTxnRetryOptions,
+		metrics:         newClientMetrics(),
+		descIDs:         &descIDAllocator{blockSize: DefaultDescIDBlockSize},
 	}
 
 	if priority := q["priority"]; len(priority) > 0 {
@@ -236,7 +440,8 @@ func Open(addr string, opts ...Option) (*DB, error) {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (db *DB) Get(key interface{}) (KeyValue, error) {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Get(key)
 	return runOneRow(db, b)
 }
@@ -259,7 +464,8 @@ func (db *DB) GetProto(key interface{}, msg gogoproto.Message) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler. value can be any key type or a proto.Message.
 func (db *DB) Put(key, value interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Put(key, value)
 	_, err := runOneResult(db, b)
 	return err
@@ -272,12 +478,27 @@ func (db *DB) Put(key, value interface{}) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler. value can be any key type or a proto.Message.
 func (db *DB) CPut(key, value, expValue interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.CPut(key, value, expValue)
 	_, err := runOneResult(db, b)
 	return err
 }
 
+// PutUnique sets the value for a key unless the key holds a different
+// value, in which case a *proto.ConditionFailedError holding the existing
+// value is returned.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (db *DB) PutUnique(key, value interface{}) error {
+	b := getBatch()
+	defer putBatch(b)
+	b.PutUnique(key, value)
+	_, err := runOneResult(db, b)
+	return err
+}
+
 // Inc increments the integer value at key. If the key does not exist it will
 // be created with an initial value of 0 which will then be incremented. If the
 // key exists but was set using Put or CPut an error will be returned.
@@ -285,7 +506,8 @@ func (db *DB) CPut(key, value, expValue interface{}) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (db *DB) Inc(key interface{}, value int64) (KeyValue, error) {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Inc(key, value)
 	return runOneRow(db, b)
 }
@@ -303,12 +525,42 @@ func (db *DB) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
 	return r.Rows, err
 }
 
+// ScanKeys retrieves the keys of the rows between begin (inclusive) and end
+// (exclusive). The values of the returned rows are nil.
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) ScanKeys(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	b := &Batch{}
+	b.ScanKeys(begin, end, maxRows)
+	r, err := runOneResult(db, b)
+	return r.Rows, err
+}
+
+// ReverseScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in descending key order. For example, the latest n rows of a
+// range of keys ordered by time are returned by ReverseScan(begin, end, n).
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) ReverseScan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	b := &Batch{}
+	b.ReverseScan(begin, end, maxRows)
+	r, err := runOneResult(db, b)
+	return r.Rows, err
+}
+
 // Del deletes one or more keys.
 //
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (db *DB) Del(keys ...interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Del(keys...)
 	_, err := runOneResult(db, b)
 	return err
@@ -321,12 +573,36 @@ func (db *DB) Del(keys ...interface{}) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (db *DB) DelRange(begin, end interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.DelRange(begin, end)
 	_, err := runOneResult(db, b)
 	return err
 }
 
+// DelRangeLimit deletes at most maxKeys of the rows between begin
+// (inclusive) and end (exclusive), returning the number of rows deleted.
+// Deleted rows are no longer in the range, so calling DelRangeLimit again
+// with the same keys continues the deletion; fewer than maxKeys rows are
+// deleted once the range is empty:
+//
+//   for {
+//     n, err := db.DelRangeLimit(begin, end, 1000)
+//     if err != nil || n < 1000 {
+//       return err
+//     }
+//   }
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) DelRangeLimit(begin, end interface{}, maxKeys int64) (int64, error) {
+	b := getBatch()
+	defer putBatch(b)
+	b.DelRangeLimit(begin, end, maxKeys)
+	r, err := runOneResult(db, b)
+	return r.Deleted, err
+}
+
 // AdminMerge merges the range containing key and the subsequent
 // range. After the merge operation is complete, the range containing
 // key will contain all of the key/value pairs of the subsequent range
@@ -367,12 +643,88 @@ func (db *DB) Run(b *Batch) error {
 	if err := b.prepare(); err != nil {
 		return err
 	}
-	if err := db.send(b.calls...); err != nil {
+	if err := db.checkBatchLimits(b.calls); err != nil {
 		return err
 	}
+	b.applyUserPriority()
+	b.applyReadConsistency(db.readConsistency)
+	if b.err = db.sendWithRetry(b.calls); b.err != nil {
+		b.fillResults()
+		return b.err
+	}
 	return b.fillResults()
 }
 
+// sendWithRetry sends the calls, retrying them while they fail with a
+// retryable error if retries were enabled with RetryOpt, or with an error
+// classified as retryable by a classifier registered with ClassifyOpt.
+// The calls keep their client command IDs when retried, so that a call
+// which was applied but whose response was lost is not applied again.
+func (db *DB) sendWithRetry(calls []Call) error {
+	resetClientCmdIDs(calls)
+	if !db.retryRun && len(db.classifiers) == 0 {
+		return db.send(calls...)
+	}
+	retryOpts := db.txnRetryOptions
+	retryOpts.Tag = "batch"
+	return retry.WithBackoff(retryOpts, func() (retry.Status, error) {
+		for _, c := range calls {
+			if c.Reply != nil {
+				c.Reply.Reset()
+			}
+		}
+		err := db.send(calls...)
+		switch db.classify(err) {
+		case ErrorRetryable:
+			return retry.Continue, err
+		case ErrorDefault:
+			if r, ok := err.(util.Retryable); ok && r.CanRetry() && db.retryRun {
+				return retry.Continue, err
+			}
+		}
+		return retry.Break, err
+	})
+}
+
+// RunContext is like Run, but abandons the batch if ctx is canceled or
+// its deadline expires before the batch completes, returning ctx.Err().
+// An abandoned batch may or may not have been applied.
+func (db *DB) RunContext(ctx context.Context, b *Batch) error {
+	return db.withContext(ctx).Run(b)
+}
+
+// TxnContext is like Txn, but abandons the transaction if ctx is canceled
+// or its deadline expires, returning ctx.Err(). The transaction is aborted
+// in the background once its in-flight operations have completed,
+// removing any intents it has written.
+func (db *DB) TxnContext(ctx context.Context, retryable func(txn *Txn) error) error {
+	return newTxn(*db.withContext(ctx), 1 /* depth */).exec(retryable)
+}
+
+// withContext returns a copy of the DB handle whose operations are
+// performed within ctx.
+func (db *DB) withContext(ctx context.Context) *DB {
+	c := *db
+	c.ctx = ctx
+	return &c
+}
+
+// WithUser returns a copy of the DB handle whose operations are performed
+// on behalf of the given user.
+func (db *DB) WithUser(user string) *DB {
+	c := *db
+	c.user = user
+	return &c
+}
+
+// context returns the context of the DB handle's operations.
+func (db *DB) context() context.Context {
+	if db.ctx == nil {
+		return context.Background()
+	}
+	return db.ctx
+}
+
 // Txn executes retryable in the context of a distributed transaction. The
 // transaction is automatically aborted if retryable returns any error aside
 // from recoverable internal errors, and is automatically committed
@@ -408,23 +760,57 @@ func (db *DB) send(calls ...Call) (err error) {
 		if c.Args.Header().UserPriority == nil && db.userPriority != 0 {
 			c.Args.Header().UserPriority = gogoproto.Int32(db.userPriority)
 		}
-		c.resetClientCmdID()
-		db.Sender.Send(context.TODO(), c)
+		if c.Args.Header().CmdID.IsEmpty() {
+			c.resetClientCmdID()
+		}
+		info := db.traceBefore(c)
+		start := time.Now()
+		if err := db.sendContext(c); err != nil {
+			db.metrics.recordCall(c, time.Since(start), err)
+			db.traceAfter(info, start, err)
+			return err
+		}
 		err = c.Reply.Header().GoError()
 		if err != nil {
 			if log.V(1) {
 				log.Infof("failed %s: %s", c.Method(), err)
 			}
 		} else if c.Post != nil {
-			err = c.Post()
+			if err = c.Post(); err != nil {
+				c.Reply.Header().SetGoError(err)
+			}
 		}
+		db.metrics.recordCall(c, time.Since(start), err)
+		db.traceAfter(info, start, err)
 		return
 	}
 
+	if chunks := db.chunkCalls(calls); len(chunks) > 1 {
+		for i, chunk := range chunks {
+			if err := db.send(chunk...); err != nil {
+				for _, rest := range chunks[i+1:] {
+					setCallErrors(rest, err)
+				}
+				return err
+			}
+		}
+		return nil
+	}
+
 	bArgs, bReply := &proto.BatchRequest{}, &proto.BatchResponse{}
 	for _, call := range calls {
 		bArgs.Add(call.Args)
 	}
+	// The calls of a batch share the batch's user priority. The batch is
+	// inconsistent if all of its calls are.
+	bArgs.UserPriority = calls[0].Args.Header().UserPriority
+	bArgs.ReadConsistency = proto.INCONSISTENT
+	for _, call := range calls {
+		if call.Args.Header().ReadConsistency != proto.INCONSISTENT {
+			bArgs.ReadConsistency = proto.CONSISTENT
+			break
+		}
+	}
 	err = db.send(Call{Args: bArgs, Reply: bReply})
 
 	// Recover from protobuf merge panics.
@@ -441,18 +827,104 @@ func (db *DB) send(calls ...Call) (err error) {
 	}()
 
 	// Transfer individual responses from batch response to prepared replies.
+	// The calls beyond the responses were not executed.
+	var postErrs util.Errors
 	for i, reply := range bReply.Responses {
 		c := calls[i]
+		c.Reply.Reset()
 		gogoproto.Merge(c.Reply, reply.GetValue().(gogoproto.Message))
-		if c.Post != nil {
-			if e := c.Post(); e != nil && err != nil {
-				err = e
+		if c.Post != nil && c.Reply.Header().Error == nil {
+			if e := c.Post(); e != nil {
+				c.Reply.Header().SetGoError(e)
+				postErrs = append(postErrs, e)
 			}
 		}
 	}
+	if err != nil {
+		setCallErrors(calls[len(bReply.Responses):], err)
+	} else if len(postErrs) == 1 {
+		err = postErrs[0]
+	} else if len(postErrs) > 1 {
+		err = postErrs
+	}
 	return
 }
 
+// setCallErrors sets err as the error of the calls, which were not
+// executed because an earlier call of their batch failed.
+func setCallErrors(calls []Call, err error) {
+	for _, c := range calls {
+		c.Reply.Header().SetGoError(err)
+	}
+}
+
+// sendContext sends the call within the context of the DB handle once
+// admitted by the limiter, abandoning it if the context is canceled first.
+// An abandoned send keeps running in the background until the sender
+// returns; it writes into a reply of its own, which is only copied into
+// c.Reply if the send completes before the context is canceled.
+func (db *DB) sendContext(c Call) error {
+	ctx := db.context()
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := db.limiter.acquire(ctx, c); err != nil {
+		return err
+	}
+	if ctx.Done() == nil {
+		// The context cannot be canceled.
+		db.Sender.Send(ctx, c)
+		db.limiter.release()
+		return nil
+	}
+	sc := c
+	sc.Reply = gogoproto.Clone(c.Reply).(proto.Response)
+	done := make(chan struct{})
+	if db.inflight != nil {
+		db.inflight.Add(1)
+	}
+	go func() {
+		db.Sender.Send(ctx, sc)
+		db.limiter.release()
+		if db.inflight != nil {
+			db.inflight.Done()
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+		c.Reply.Reset()
+		gogoproto.Merge(c.Reply, sc.Reply)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// chunkCalls splits the calls into chunks which respect the bounds set by
+// BatchChunkOpt, preserving their order. A single call exceeding the size
+// bound forms a chunk of its own.
+func (db *DB) chunkCalls(calls []Call) [][]Call {
+	if db.batchChunkCalls <= 0 && db.batchChunkBytes <= 0 {
+		return [][]Call{calls}
+	}
+	var chunks [][]Call
+	start, size := 0, 0
+	for i, c := range calls {
+		n := 0
+		if db.batchChunkBytes > 0 {
+			n = gogoproto.Size(c.Args)
+		}
+		if i > start && ((db.batchChunkCalls > 0 && i-start >= db.batchChunkCalls) ||
+			(db.batchChunkBytes > 0 && size+n > db.batchChunkBytes)) {
+			chunks = append(chunks, calls[start:i])
+			start, size = i, 0
+		}
+		size += n
+	}
+	return append(chunks, calls[start:])
+}
+
 func marshalKey(k interface{}) ([]byte, error) {
 	// Note that the ordering here is important. In particular, proto.Key is also
 	// a fmt.Stringer.
@@ -19,7 +19,17 @@ package client
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
 	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/retry"
 )
 
 func TestCallError(t *testing.T) {
@@ -53,3 +63,586 @@ func TestClientCommandID(t *testing.T) {
 		t.Errorf("expected test sender to be invoked once; got %d", count)
 	}
 }
+
+func TestBatchChunking(t *testing.T) {
+	db, s := newMemDB()
+	BatchChunkOpt(3, 0)(db)
+	b := &Batch{}
+	for i := 0; i < 7; i++ {
+		b.Put(fmt.Sprintf("%d", i), fmt.Sprintf("v%d", i))
+	}
+	for i := 0; i < 7; i++ {
+		b.Get(fmt.Sprintf("%d", i))
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if s.batches != 5 {
+		t.Errorf("expected 5 batches, but found %d", s.batches)
+	}
+	for i, result := range b.Results[7:] {
+		if v := string(result.Rows[0].ValueBytes()); v != fmt.Sprintf("v%d", i) {
+			t.Errorf("%d: expected \"v%d\", but found %q", i, i, v)
+		}
+	}
+
+	// Chunks within a transaction are sent in order, the commit last.
+	s.batches = 0
+	if err := db.Txn(func(txn *Txn) error {
+		b := &Batch{}
+		for i := 0; i < 4; i++ {
+			b.Put(fmt.Sprintf("t%d", i), "value")
+		}
+		return txn.Commit(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if s.batches != 2 {
+		t.Errorf("expected 2 batches, but found %d", s.batches)
+	}
+	if kv, err := db.Get("t3"); err != nil || string(kv.ValueBytes()) != "value" {
+		t.Errorf("unexpected value: %v, %v", kv, err)
+	}
+
+	// Batches are chunked by size as well.
+	s.batches = 0
+	BatchChunkOpt(0, 350)(db)
+	value := strings.Repeat("x", 100)
+	b = &Batch{}
+	for i := 0; i < 4; i++ {
+		b.Put(fmt.Sprintf("s%d", i), value)
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if s.batches != 2 {
+		t.Errorf("expected 2 batches, but found %d", s.batches)
+	}
+
+	// Unbounded batches are sent as is.
+	s.batches = 0
+	BatchChunkOpt(0, 0)(db)
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if s.batches != 1 {
+		t.Errorf("expected 1 batch, but found %d", s.batches)
+	}
+}
+
+func TestBatchLimits(t *testing.T) {
+	count := 0
+	db := newDB(newTestSender(func(call Call) {
+		count++
+	}))
+	BatchLimitOpt(2, 10)(db)
+
+	testData := []struct {
+		fn  func(b *Batch)
+		err string
+	}{
+		{func(b *Batch) { b.Put("a", "1"); b.Put("b", "2") }, ""},
+		{func(b *Batch) { b.Get("a"); b.Get("b"); b.Get("c") },
+			"batch of 3 keys exceeds the limit of 2 keys"},
+		{func(b *Batch) { b.Put("a", "12345"); b.CPut("b", "123456", nil) },
+			"batch of 11 value bytes exceeds the limit of 10 bytes"},
+		{func(b *Batch) { b.PutUnique("a", "12345678901") },
+			"batch of 11 value bytes exceeds the limit of 10 bytes"},
+	}
+	for i, d := range testData {
+		count = 0
+		b := &Batch{}
+		d.fn(b)
+		err := db.Run(b)
+		if d.err == "" {
+			if err != nil {
+				t.Errorf("%d: unexpected error: %v", i, err)
+			}
+			continue
+		}
+		if _, ok := err.(*BatchTooLargeError); !ok || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+		if count != 0 {
+			t.Errorf("%d: expected nothing to be sent, but found %d calls", i, count)
+		}
+	}
+
+	// The limits apply within transactions as well.
+	err := db.Txn(func(txn *Txn) error {
+		b := &Batch{}
+		b.Put("a", "12345678901")
+		return txn.Run(b)
+	})
+	if _, ok := err.(*BatchTooLargeError); !ok {
+		t.Errorf("expected a *BatchTooLargeError, but found %v", err)
+	}
+}
+
+func TestBatchErrors(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.Put("b", "1"); err != nil {
+		t.Fatal(err)
+	}
+	b := &Batch{}
+	b.Put("a", "1")
+	b.CPut("b", "2", "3")
+	b.Get("c")
+	err := db.Run(b)
+	if _, ok := err.(*proto.ConditionFailedError); !ok {
+		t.Fatalf("expected a *proto.ConditionFailedError, but found %v", err)
+	}
+	errs := b.Errors()
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error, but found %v", errs)
+	}
+	e := errs[0]
+	if e.Index != 1 || e.Method != proto.ConditionalPut || !e.Key.Equal(proto.Key("b")) || e.Err != err {
+		t.Errorf("unexpected error: %+v", e)
+	}
+	if expected := `operation 1 (ConditionalPut "b"): ` + err.Error(); e.Error() != expected {
+		t.Errorf("expected \"%s\", but found \"%s\"", expected, e.Error())
+	}
+
+	// Errors reported for the batch as a whole are attributed to the
+	// batch's only operation.
+	db = newDB(newTestSender(func(call Call) {
+		call.Reply.Header().SetGoError(errors.New("boom"))
+	}))
+	b = &Batch{}
+	b.Del("d")
+	if err := db.Run(b); err == nil {
+		t.Fatal("expected an error")
+	}
+	if errs := b.Errors(); len(errs) != 1 || errs[0].Index != 0 || errs[0].Method != proto.Delete {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	// The operations of a batch failing as a whole were not executed and
+	// each of them reports the batch's error.
+	b.Get("e")
+	if err := db.Run(b); err == nil {
+		t.Fatal("expected an error")
+	}
+	if errs := b.Errors(); len(errs) != 2 || errs[0].Index != 0 || errs[1].Index != 1 ||
+		errs[1].Error() != `operation 1 (Get "e"): boom` {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
+
+func TestBatchPartialResults(t *testing.T) {
+	db, s := newMemDB()
+	// Like the transaction coordinator, stop executing a batch at the first
+	// call which fails.
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		ba, ok := call.Args.(*proto.BatchRequest)
+		if !ok {
+			s.Send(ctx, call)
+			return
+		}
+		br := call.Reply.(*proto.BatchResponse)
+		for _, union := range ba.Requests {
+			req := union.GetValue().(proto.Request)
+			resp := req.CreateReply()
+			s.Send(ctx, Call{Args: req, Reply: resp})
+			br.Add(resp)
+			if err := resp.Header().GoError(); err != nil {
+				br.Header().SetGoError(err)
+				return
+			}
+		}
+	})
+	if err := db.Put("b", "1"); err != nil {
+		t.Fatal(err)
+	}
+
+	b := &Batch{}
+	b.Put("a", "1")
+	b.CPut("b", "2", "3")
+	b.Put("c", "1")
+	if err := db.Run(b); err == nil {
+		t.Fatal("expected an error")
+	}
+	if err := b.Results[0].Err; err != nil {
+		t.Errorf("expected the first operation to complete, but found %v", err)
+	}
+	if _, ok := b.Results[1].Err.(*proto.ConditionFailedError); !ok {
+		t.Errorf("expected a *proto.ConditionFailedError, but found %v", b.Results[1].Err)
+	}
+	if b.Results[2].Err == nil {
+		t.Errorf("expected the last operation to not complete")
+	}
+	if _, ok := s.data["c"]; ok {
+		t.Errorf("expected the last operation to not be executed")
+	}
+
+	// All the errors of the Post functions are reported.
+	b = &Batch{}
+	b.GetProto("x", &proto.TableDescriptor{})
+	b.Get("a")
+	b.GetProto("y", &proto.TableDescriptor{})
+	err := db.Run(b)
+	errs, ok := err.(util.Errors)
+	if !ok || len(errs) != 2 {
+		t.Fatalf("expected 2 errors, but found %v", err)
+	}
+	if !strings.Contains(errs[0].Error(), `"x": no value present`) ||
+		!strings.Contains(errs[1].Error(), `"y": no value present`) {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if b.Results[0].Err == nil || b.Results[1].Err != nil || b.Results[2].Err == nil {
+		t.Errorf("unexpected results: %v", b.Results)
+	}
+}
+
+func TestBatchRequests(t *testing.T) {
+	b := &Batch{}
+	b.Get("a")
+	b.Put("b", "value")
+	b.CPut("c", "v", nil)
+	b.Inc("d", 1)
+	b.Scan("e", "f", 0)
+	b.Del("g", "h")
+	b.DelRange("i", "j")
+	b.PutUnique("k", "unique")
+	b.Put(struct{}{}, "bad key")
+	expected := []RequestInfo{
+		{Method: proto.Get, Key: proto.Key("a")},
+		{Method: proto.Put, Key: proto.Key("b"), ValueSize: 5},
+		{Method: proto.ConditionalPut, Key: proto.Key("c"), ValueSize: 1},
+		{Method: proto.Increment, Key: proto.Key("d")},
+		{Method: proto.Scan, Key: proto.Key("e"), EndKey: proto.Key("f")},
+		{Method: proto.Delete, Key: proto.Key("g")},
+		{Method: proto.Delete, Key: proto.Key("h")},
+		{Method: proto.DeleteRange, Key: proto.Key("i"), EndKey: proto.Key("j")},
+		{Method: proto.PutUnique, Key: proto.Key("k"), ValueSize: 6},
+	}
+	requests := b.Requests()
+	if !reflect.DeepEqual(expected, requests) {
+		t.Errorf("expected %+v, but found %+v", expected, requests)
+	}
+
+	// The descriptions do not alias the batch.
+	requests[0].Key[0] = 'z'
+	if key := b.Requests()[0].Key; !key.Equal(proto.Key("a")) {
+		t.Errorf("expected \"a\", but found %s", key)
+	}
+}
+
+func TestBatchReset(t *testing.T) {
+	db, s := newMemDB()
+	b := &Batch{}
+	b.Put("a", "1")
+	b.Put("b", "2")
+	b.CPut("c", "3", "4")
+	b.SetUserPriority(LowUserPriority)
+	b.SetReadConsistency(proto.INCONSISTENT)
+	if err := db.Run(b); err == nil {
+		t.Fatal("expected the conditional put to fail")
+	}
+	calls := cap(b.calls)
+
+	b.Reset()
+	if len(b.Results) != 0 || len(b.Requests()) != 0 || len(b.Errors()) != 0 ||
+		b.userPriority != 0 || b.readConsistency != nil {
+		t.Fatalf("expected an empty batch, but found %+v", b)
+	}
+	b.Get("a")
+	b.Put("c", "3")
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if cap(b.calls) != calls {
+		t.Errorf("expected the calls to be reused")
+	}
+	if len(b.Results) != 2 || string(b.Results[0].Rows[0].ValueBytes()) != "1" ||
+		string(b.Results[1].Rows[0].Key) != "c" {
+		t.Errorf("unexpected results %+v", b.Results)
+	}
+	if s.batches != 2 {
+		t.Errorf("expected 2 batches, but found %d", s.batches)
+	}
+}
+
+func TestScanKeys(t *testing.T) {
+	db, _ := newMemDB()
+	for _, key := range []string{"a", "b", "c"} {
+		if err := db.Put(key, "1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	rows, err := db.ScanKeys("a", "c", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, but found %d", len(rows))
+	}
+	for i, row := range rows {
+		if expected := string(rune('a' + i)); string(row.Key) != expected {
+			t.Errorf("%d: expected key %q, but found %q", i, expected, row.Key)
+		}
+		if row.Value != nil {
+			t.Errorf("%d: expected no value, but found %v", i, row.Value)
+		}
+	}
+
+	b := &Batch{}
+	b.ScanKeys("a", "z", 1)
+	b.Scan("a", "z", 1)
+	if args := b.calls[0].Args.(*proto.ScanRequest); !args.KeysOnly {
+		t.Errorf("expected a keys-only scan")
+	}
+	if args := b.calls[1].Args.(*proto.ScanRequest); args.KeysOnly {
+		t.Errorf("expected a scan of keys and values")
+	}
+	if err := db.Txn(func(txn *Txn) error {
+		rows, err := txn.ScanKeys("a", "z", 0)
+		if err == nil && len(rows) != 3 {
+			t.Errorf("expected 3 rows, but found %d", len(rows))
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReverseScan(t *testing.T) {
+	db, _ := newMemDB()
+	for _, key := range []string{"a", "b", "c", "d"} {
+		if err := db.Put(key, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	testCases := []struct {
+		begin, end string
+		maxRows    int64
+		expected   []string
+	}{
+		{"a", "d", 0, []string{"c", "b", "a"}},
+		{"a", "z", 2, []string{"d", "c"}},
+		{"b", "c", 0, []string{"b"}},
+		{"x", "z", 0, nil},
+	}
+	for i, c := range testCases {
+		rows, err := db.ReverseScan(c.begin, c.end, c.maxRows)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, row := range rows {
+			keys = append(keys, string(row.Key))
+		}
+		if !reflect.DeepEqual(c.expected, keys) {
+			t.Errorf("%d: expected %v, but found %v", i, c.expected, keys)
+		}
+	}
+
+	if err := db.Txn(func(txn *Txn) error {
+		rows, err := txn.ReverseScan("a", "z", 1)
+		if err == nil && (len(rows) != 1 || string(rows[0].ValueBytes()) != "d") {
+			t.Errorf("expected the last row, but found %v", rows)
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDelRangeLimit(t *testing.T) {
+	db, _ := newMemDB()
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		if err := db.Put(key, "1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Repeated calls continue the deletion.
+	var counts []int64
+	for {
+		n, err := db.DelRangeLimit("a", "e", 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts = append(counts, n)
+		if n < 2 {
+			break
+		}
+	}
+	if expected := []int64{2, 2, 0}; !reflect.DeepEqual(expected, counts) {
+		t.Errorf("expected %v, but found %v", expected, counts)
+	}
+
+	// DelRange reports the number of rows deleted as well.
+	b := &Batch{}
+	b.DelRange("a", "z")
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if b.Results[0].Deleted != 1 {
+		t.Errorf("expected 1 deleted row, but found %d", b.Results[0].Deleted)
+	}
+	if err := db.Txn(func(txn *Txn) error {
+		if err := txn.Put("f", "1"); err != nil {
+			return err
+		}
+		n, err := txn.DelRangeLimit("a", "z", 0)
+		if err == nil && n != 1 {
+			t.Errorf("expected 1 deleted row, but found %d", n)
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUserPriority(t *testing.T) {
+	_, s := newMemDB()
+	var priorities []int32
+	db := newDB(SenderFunc(func(ctx context.Context, call Call) {
+		priorities = append(priorities, call.Args.Header().GetUserPriority())
+		s.Send(ctx, call)
+	}))
+	UserPriorityOpt(5)(db)
+
+	testData := []struct {
+		fn       func() error
+		expected int32
+	}{
+		{func() error { return db.Put("a", "1") }, 5},
+		{func() error {
+			b := &Batch{}
+			b.Put("a", "1")
+			b.Put("b", "2")
+			b.SetUserPriority(LowUserPriority)
+			return db.Run(b)
+		}, LowUserPriority},
+		{func() error { return db.background().Put("a", "1") }, LowUserPriority},
+		{func() error {
+			return db.Txn(func(txn *Txn) error {
+				txn.SetUserPriority(7)
+				return txn.Put("a", "1")
+			})
+		}, 7},
+	}
+	for i, d := range testData {
+		priorities = nil
+		if err := d.fn(); err != nil {
+			t.Fatal(err)
+		}
+		if len(priorities) == 0 || priorities[0] != d.expected {
+			t.Errorf("%d: expected priority %d, but found %v", i, d.expected, priorities)
+		}
+	}
+}
+
+func TestReadConsistency(t *testing.T) {
+	_, s := newMemDB()
+	var consistencies []proto.ReadConsistencyType
+	db := newDB(SenderFunc(func(ctx context.Context, call Call) {
+		if b, ok := call.Args.(*proto.BatchRequest); ok {
+			consistencies = append(consistencies, b.ReadConsistency)
+		}
+		for _, args := range requests(call) {
+			consistencies = append(consistencies, args.Header().ReadConsistency)
+		}
+		s.Send(ctx, call)
+	}))
+	ReadConsistencyOpt(proto.INCONSISTENT)(db)
+
+	testData := []struct {
+		fn       func() error
+		expected []proto.ReadConsistencyType
+	}{
+		{func() error { _, err := db.Get("a"); return err }, []proto.ReadConsistencyType{proto.INCONSISTENT}},
+		// Writes are always consistent and so are batches containing them.
+		{func() error { return db.Put("a", "1") }, []proto.ReadConsistencyType{proto.CONSISTENT}},
+		{func() error {
+			b := &Batch{}
+			b.Get("a")
+			b.Put("b", "2")
+			return db.Run(b)
+		}, []proto.ReadConsistencyType{proto.CONSISTENT, proto.INCONSISTENT, proto.CONSISTENT}},
+		{func() error {
+			b := &Batch{}
+			b.Get("a")
+			b.Scan("a", "c", 0)
+			return db.Run(b)
+		}, []proto.ReadConsistencyType{proto.INCONSISTENT, proto.INCONSISTENT, proto.INCONSISTENT}},
+		// Batches may override the default.
+		{func() error {
+			b := &Batch{}
+			b.Get("a")
+			b.SetReadConsistency(proto.CONSISTENT)
+			return db.Run(b)
+		}, []proto.ReadConsistencyType{proto.CONSISTENT}},
+		// Reads within transactions are consistent.
+		{func() error {
+			return db.Txn(func(txn *Txn) error {
+				_, err := txn.Get("a")
+				return err
+			})
+		}, []proto.ReadConsistencyType{proto.CONSISTENT}},
+	}
+	for i, d := range testData {
+		consistencies = nil
+		if err := d.fn(); err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(d.expected, consistencies) {
+			t.Errorf("%d: expected %v, but found %v", i, d.expected, consistencies)
+		}
+	}
+}
+
+// requests returns the requests of the call: the requests of a batch or
+// the call's own arguments.
+func requests(call Call) []proto.Request {
+	if b, ok := call.Args.(*proto.BatchRequest); ok {
+		var reqs []proto.Request
+		for _, union := range b.Requests {
+			reqs = append(reqs, union.GetValue().(proto.Request))
+		}
+		return reqs
+	}
+	return []proto.Request{call.Args}
+}
+
+func TestRunRetry(t *testing.T) {
+	_, s := newMemDB()
+	failures := 0
+	db := newDB(SenderFunc(func(ctx context.Context, call Call) {
+		if failures > 0 {
+			failures--
+			call.Reply.Reset()
+			call.Reply.Header().SetGoError(proto.NewRangeNotFoundError(1))
+			return
+		}
+		s.Send(ctx, call)
+	}))
+
+	// Without RetryOpt, batches are not retried.
+	failures = 1
+	if err := db.Put("a", "1"); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	RetryOpt(retry.Options{Backoff: time.Millisecond, MaxBackoff: time.Millisecond, Constant: 1, MaxAttempts: 3, UseV1Info: true})(db)
+	failures = 2
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	failures = 3
+	if _, err := db.Get("a"); err == nil {
+		t.Fatal("expected an error after 3 attempts")
+	}
+
+	// Errors which are not retryable are returned immediately.
+	failures = 0
+	attempts := 0
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		attempts++
+		call.Reply.Header().SetGoError(errors.New("permanent"))
+	})
+	if err := db.Put("a", "1"); err == nil || attempts != 1 {
+		t.Errorf("expected 1 failed attempt, but found %d: %v", attempts, err)
+	}
+}
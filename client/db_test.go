@@ -119,6 +119,29 @@ func ExampleDB_CPut() {
 	// bb=4
 }
 
+func ExampleDB_PutUnique() {
+	s, db := setup()
+	defer s.Stop()
+
+	if err := db.PutUnique("aa", "1"); err != nil {
+		panic(err)
+	}
+	if err := db.PutUnique("aa", "1"); err != nil {
+		panic(err)
+	}
+	if err := db.PutUnique("aa", "2"); err == nil {
+		panic("expected error from unique put")
+	}
+	result, err := db.Get("aa")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("aa=%s\n", result.ValueBytes())
+
+	// Output:
+	// aa=1
+}
+
 func ExampleDB_Inc() {
 	s, db := setup()
 	defer s.Stop()
@@ -278,6 +301,8 @@ func TestOpenArgs(t *testing.T) {
 		{"https://root@" + s.ServingAddr() + "?certs=test_certs", false},
 		{"https://" + s.ServingAddr() + "?certs=test_certs", false},
 		{"https://" + s.ServingAddr() + "?certs=foo", true},
+		{"https://" + s.ServingAddr() + "?certs=test_certs&encoding=json", false},
+		{"https://" + s.ServingAddr() + "?certs=test_certs&encoding=xml", true},
 		{s.ServingAddr(), true},
 	}
 
@@ -316,17 +341,113 @@ func TestCommonMethods(t *testing.T) {
 		method string
 	}
 	blacklist := map[key]struct{}{
+		key{batchType, "Errors"}:             {},
 		key{batchType, "InternalAddCall"}:    {},
+		key{batchType, "Requests"}:           {},
+		key{batchType, "Reset"}:              {},
+		key{batchType, "SetReadConsistency"}: {},
+		key{batchType, "SetUserPriority"}:    {},
 		key{dbType, "AdminMerge"}:            {},
 		key{dbType, "AdminSplit"}:            {},
+		key{dbType, "Metrics"}:               {},
+		key{dbType, "PublishMetrics"}:        {},
 		key{dbType, "Run"}:                   {},
+		key{dbType, "RunContext"}:            {},
+		key{dbType, "ScanChunks"}:            {},
 		key{dbType, "Txn"}:                   {},
+		key{dbType, "TxnContext"}:            {},
+		key{dbType, "WithUser"}:              {},
 		key{txnType, "Commit"}:               {},
+		key{txnType, "Deadline"}:             {},
 		key{txnType, "DebugName"}:            {},
+		key{txnType, "GetTableRowForUpdate"}: {},
 		key{txnType, "InternalSetPriority"}:  {},
+		key{txnType, "ReleaseSavepoint"}:     {},
+		key{txnType, "Restarts"}:             {},
+		key{txnType, "RollbackToSavepoint"}:  {},
 		key{txnType, "Run"}:                  {},
+		key{txnType, "Savepoint"}:            {},
+		key{txnType, "ScanChunks"}:           {},
+		key{txnType, "SetDeadline"}:          {},
 		key{txnType, "SetDebugName"}:         {},
+		key{txnType, "SetFixedTimestamp"}:    {},
 		key{txnType, "SetSnapshotIsolation"}: {},
+		key{txnType, "SetUserPriority"}:      {},
+		key{txnType, "UpdateDeadline"}:       {},
+
+		// The structured table API reads and writes table descriptors in
+		// transactions of its own, so it only exists on DB.
+		key{dbType, "AcquireTableLease"}:       {},
+		key{dbType, "AddColumn"}:               {},
+		key{dbType, "AdoptSchemaJobs"}:         {},
+		key{dbType, "AggregateTable"}:          {},
+		key{dbType, "ApplySchemaChanges"}:      {},
+		key{dbType, "BackupTable"}:             {},
+		key{dbType, "CancelSchemaJob"}:         {},
+		key{dbType, "Changefeed"}:              {},
+		key{dbType, "CollectTableStats"}:       {},
+		key{dbType, "CopyTable"}:               {},
+		key{dbType, "CountTable"}:              {},
+		key{dbType, "CreateDatabase"}:          {},
+		key{dbType, "CreateIndex"}:             {},
+		key{dbType, "CreateIndexWithProgress"}: {},
+		key{dbType, "CreateTable"}:             {},
+		key{dbType, "CreateTableIfNotExists"}:  {},
+		key{dbType, "DecodeKey"}:               {},
+		key{dbType, "DeleteTableRows"}:         {},
+		key{dbType, "DescribeIndex"}:           {},
+		key{dbType, "DescribeTable"}:           {},
+		key{dbType, "DescribeTableDesc"}:       {},
+		key{dbType, "DropColumn"}:              {},
+		key{dbType, "DropColumnCascade"}:       {},
+		key{dbType, "DropDatabase"}:            {},
+		key{dbType, "DropIndex"}:               {},
+		key{dbType, "DropIndexAsync"}:          {},
+		key{dbType, "DropTable"}:               {},
+		key{dbType, "DumpTable"}:               {},
+		key{dbType, "ExecSQL"}:                 {},
+		key{dbType, "ExportCSV"}:               {},
+		key{dbType, "GCDroppedTables"}:         {},
+		key{dbType, "GetTableRow"}:             {},
+		key{dbType, "Grant"}:                   {},
+		key{dbType, "ImportCSV"}:               {},
+		key{dbType, "InsertTableRows"}:         {},
+		key{dbType, "ListDatabases"}:           {},
+		key{dbType, "ListDroppedTables"}:       {},
+		key{dbType, "ListExpiringTables"}:      {},
+		key{dbType, "ListIndexes"}:             {},
+		key{dbType, "ListTableDescriptors"}:    {},
+		key{dbType, "ListTables"}:              {},
+		key{dbType, "ListTablesPage"}:          {},
+		key{dbType, "MergeTable"}:              {},
+		key{dbType, "NewIngester"}:             {},
+		key{dbType, "PlanSchemaChanges"}:       {},
+		key{dbType, "RefreshTableStats"}:       {},
+		key{dbType, "ReleaseTableLease"}:       {},
+		key{dbType, "RenameColumn"}:            {},
+		key{dbType, "RenameTable"}:             {},
+		key{dbType, "RestoreTable"}:            {},
+		key{dbType, "ResumeChangefeed"}:        {},
+		key{dbType, "ResumeSchemaJob"}:         {},
+		key{dbType, "Revoke"}:                  {},
+		key{dbType, "RunTableGC"}:              {},
+		key{dbType, "RunTableStats"}:           {},
+		key{dbType, "ScanTable"}:               {},
+		key{dbType, "SchemaJobs"}:              {},
+		key{dbType, "SetColumnComment"}:        {},
+		key{dbType, "SetDatabase"}:             {},
+		key{dbType, "SetTableComment"}:         {},
+		key{dbType, "SetTableTTL"}:             {},
+		key{dbType, "ShowGrants"}:              {},
+		key{dbType, "SplitTable"}:              {},
+		key{dbType, "TableRangeCount"}:         {},
+		key{dbType, "TableStats"}:              {},
+		key{dbType, "TruncateTable"}:           {},
+		key{dbType, "UndropTable"}:             {},
+		key{dbType, "UpdateTableRows"}:         {},
+		key{dbType, "ValidateTable"}:           {},
+		key{dbType, "WaitForSchemaJob"}:        {},
+		key{dbType, "WaitForSchemaVersion"}:    {},
 	}
 
 	for b := range blacklist {
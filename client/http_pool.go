@@ -0,0 +1,146 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// HealthEndpoint is the URL path of the health endpoint of a node.
+	HealthEndpoint = "/_admin/health"
+	// healthCheckInterval is the minimum time between two health checks
+	// of an unhealthy gateway node.
+	healthCheckInterval = 1 * time.Second
+)
+
+// gateway is a node of a gatewayPool.
+type gateway struct {
+	addr    string    // The host:port address of the node
+	healthy bool      // False if the last call or health check failed
+	checked time.Time // The time of the last health check
+	probing bool      // True while a health check is in progress
+}
+
+// gatewayPool holds the gateway nodes an httpSender sends calls to. Calls
+// are spread over the healthy nodes in round-robin order. A node which
+// fails to respond is marked unhealthy and is not used again until a
+// health check of the node succeeds.
+type gatewayPool struct {
+	client *http.Client
+	scheme string
+
+	mu       sync.Mutex
+	gateways []*gateway
+	next     int // The index of the next gateway to try
+}
+
+func newGatewayPool(client *http.Client, scheme string, addrs ...string) *gatewayPool {
+	p := &gatewayPool{client: client, scheme: scheme}
+	for _, addr := range addrs {
+		p.add(addr)
+	}
+	return p
+}
+
+// add adds a gateway node to the pool, ignoring duplicates.
+func (p *gatewayPool) add(addr string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, g := range p.gateways {
+		if g.addr == addr {
+			return
+		}
+	}
+	p.gateways = append(p.gateways, &gateway{addr: addr, healthy: true})
+}
+
+// pick returns the address of the gateway node to send the next call to.
+// Unhealthy nodes are only returned if no node is healthy, so that the
+// retry loop keeps trying while the whole pool is unreachable. Unhealthy
+// nodes which have not been checked recently are health checked in the
+// background.
+func (p *gatewayPool) pick() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var pick *gateway
+	for i := range p.gateways {
+		g := p.gateways[(p.next+i)%len(p.gateways)]
+		if !g.healthy {
+			p.maybeCheckLocked(g)
+			continue
+		}
+		if pick == nil {
+			pick = g
+			p.next = (p.next + i + 1) % len(p.gateways)
+		}
+	}
+	if pick == nil {
+		pick = p.gateways[p.next]
+		p.next = (p.next + 1) % len(p.gateways)
+	}
+	return pick.addr
+}
+
+// markUnhealthy marks the gateway node unhealthy after a call to it
+// failed.
+func (p *gatewayPool) markUnhealthy(addr string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, g := range p.gateways {
+		if g.addr == addr && g.healthy {
+			log.Warningf("gateway node %s unhealthy", addr)
+			g.healthy = false
+			g.checked = time.Now()
+		}
+	}
+}
+
+// maybeCheckLocked starts a health check of the unhealthy gateway node
+// unless one is already in progress or the node was checked recently.
+// p.mu must be held.
+func (p *gatewayPool) maybeCheckLocked(g *gateway) {
+	if g.probing || time.Since(g.checked) < healthCheckInterval {
+		return
+	}
+	g.probing = true
+	go func() {
+		healthy := p.check(g.addr)
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		g.probing = false
+		g.checked = time.Now()
+		if healthy && !g.healthy {
+			log.Infof("gateway node %s healthy", g.addr)
+			g.healthy = true
+		}
+	}()
+}
+
+// check returns true if the health endpoint of the gateway node responds
+// successfully.
+func (p *gatewayPool) check(addr string) bool {
+	resp, err := p.client.Get(p.scheme + "://" + addr + HealthEndpoint)
+	if err != nil {
+		return false
+	}
+	resp.Body.Close()
+	return resp.StatusCode == http.StatusOK
+}
@@ -0,0 +1,143 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+func TestGatewayPoolPick(t *testing.T) {
+	p := newGatewayPool(http.DefaultClient, "http", "a", "b", "c", "a")
+	var picks []string
+	for i := 0; i < 4; i++ {
+		picks = append(picks, p.pick())
+	}
+	if expected := []string{"a", "b", "c", "a"}; !reflect.DeepEqual(expected, picks) {
+		t.Errorf("expected %v, but found %v", expected, picks)
+	}
+
+	// Unhealthy gateways are skipped until all gateways are unhealthy.
+	p.markUnhealthy("b")
+	picks = nil
+	for i := 0; i < 4; i++ {
+		picks = append(picks, p.pick())
+	}
+	if expected := []string{"c", "a", "c", "a"}; !reflect.DeepEqual(expected, picks) {
+		t.Errorf("expected %v, but found %v", expected, picks)
+	}
+	p.markUnhealthy("a")
+	p.markUnhealthy("c")
+	if addr := p.pick(); addr == "" {
+		t.Errorf("expected a gateway to be picked")
+	}
+}
+
+func TestGatewayPoolHealthCheck(t *testing.T) {
+	healthy := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != HealthEndpoint {
+			t.Errorf("expected path %s; got %s", HealthEndpoint, r.URL.Path)
+		}
+		select {
+		case <-healthy:
+		default:
+			http.Error(w, "unhealthy", http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+	addr := server.Listener.Addr().String()
+
+	p := newGatewayPool(http.DefaultClient, "http", addr, "other")
+	p.markUnhealthy(addr)
+	check := func() {
+		p.mu.Lock()
+		p.gateways[0].checked = time.Time{}
+		p.mu.Unlock()
+		p.pick()
+	}
+	// A failing health check leaves the gateway unhealthy.
+	check()
+	if err := util.IsTrueWithin(func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return !p.gateways[0].probing
+	}, 500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if p.gateways[0].healthy {
+		t.Fatalf("expected gateway to remain unhealthy")
+	}
+	// A successful health check makes it healthy again.
+	healthy <- struct{}{}
+	check()
+	if err := util.IsTrueWithin(func() bool {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		return p.gateways[0].healthy
+	}, 500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestGatewayPoolFailover verifies that calls failing to reach a gateway
+// node are retried on another node.
+func TestGatewayPoolFailover(t *testing.T) {
+	var count int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count++
+		body, contentType, err := util.MarshalResponse(r, testPutResp, util.AllEncodings)
+		if err != nil {
+			t.Errorf("failed to marshal response: %s", err)
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Write(body)
+	}))
+	defer server.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	downAddr := down.Listener.Addr().String()
+	down.Close()
+
+	retryOptions := defaultRetryOptions
+	retryOptions.Backoff = 1 * time.Millisecond
+	sender, err := newHTTPSender(downAddr, &base.Context{Insecure: true}, retryOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender.pool.add(server.Listener.Addr().String())
+	for i := 0; i < 3; i++ {
+		reply := &proto.PutResponse{}
+		sender.Send(context.Background(), Call{Args: testPutReq, Reply: reply})
+		if reply.GoError() != nil {
+			t.Fatalf("%d: expected success; got %s", i, reply.GoError())
+		}
+	}
+	if count != 3 {
+		t.Errorf("expected 3 calls to reach the healthy gateway; got %d", count)
+	}
+	if sender.pool.gateways[0].healthy {
+		t.Errorf("expected the unreachable gateway to be unhealthy")
+	}
+}
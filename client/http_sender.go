@@ -19,12 +19,14 @@ package client
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"golang.org/x/net/context"
 
@@ -45,10 +47,40 @@ const (
 	StatusTooManyRequests = 429
 )
 
+// The HTTP sender encodes calls as protobufs. Specifying "encoding=json"
+// in the query of the URL passed to Open selects JSON instead, which the
+// server accepts as well, for environments where protobuf encoding is
+// not an option:
+//
+//   db, err := client.Open("https://root@localhost:8080?encoding=json")
+//
+// Calls are sent to the node given by the URL's host. Additional gateway
+// nodes may be listed with "gateways", in which case calls are spread over
+// all the nodes, avoiding nodes which fail to respond:
+//
+//   db, err := client.Open("https://root@node1:8080?gateways=node2:8080,node3:8080")
 func init() {
 	f := func(u *url.URL, ctx *base.Context, retryOpts retry.Options) (Sender, error) {
 		ctx.Insecure = (u.Scheme != "https")
-		return newHTTPSender(u.Host, ctx, retryOpts)
+		s, err := newHTTPSender(u.Host, ctx, retryOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, gateways := range u.Query()["gateways"] {
+			for _, addr := range strings.Split(gateways, ",") {
+				if addr != "" {
+					s.pool.add(addr)
+				}
+			}
+		}
+		switch encoding := u.Query().Get("encoding"); encoding {
+		case "", "protobuf":
+		case "json":
+			s.json = true
+		default:
+			return nil, fmt.Errorf("unsupported encoding %q", encoding)
+		}
+		return s, nil
 	}
 	RegisterSender("http", f)
 	RegisterSender("https", f)
@@ -62,19 +94,19 @@ type httpSendError struct {
 
 // httpSender is an implementation of Sender which exposes the
 // Key-Value database provided by a Cockroach cluster by connecting
-// via HTTP to a Cockroach node. Overly-busy nodes will redirect
-// this client to other nodes.
+// via HTTP to one or more Cockroach nodes. Overly-busy nodes will
+// redirect this client to other nodes.
 type httpSender struct {
-	server    string        // The host:port address of the Cockroach gateway node
+	pool      *gatewayPool  // The Cockroach gateway nodes
 	client    *http.Client  // The HTTP client
 	context   *base.Context // The base context: needed for client setup.
 	retryOpts retry.Options
+	json      bool // Encode calls as JSON instead of protobufs
 }
 
 // newHTTPSender returns a new instance of httpSender.
 func newHTTPSender(server string, ctx *base.Context, retryOpts retry.Options) (*httpSender, error) {
 	sender := &httpSender{
-		server:    server,
 		context:   ctx,
 		retryOpts: retryOpts,
 	}
@@ -83,6 +115,7 @@ func newHTTPSender(server string, ctx *base.Context, retryOpts retry.Options) (*
 	if err != nil {
 		return nil, err
 	}
+	sender.pool = newGatewayPool(sender.client, ctx.RequestScheme(), server)
 	return sender, nil
 }
 
@@ -93,13 +126,16 @@ func newHTTPSender(server string, ctx *base.Context, retryOpts retry.Options) (*
 // reporting failure when in fact the command may have gone through
 // and been executed successfully. We retry here to eventually get
 // through with the same client command ID and be given the cached
-// response.
+// response. The client command ID makes it safe to retry a call on a
+// different gateway node, which the retry loop does after marking the
+// node which failed to respond unhealthy.
 func (s *httpSender) Send(_ context.Context, call Call) {
 	retryOpts := s.retryOpts
 	retryOpts.Tag = fmt.Sprintf("%s %s", s.context.RequestScheme(), call.Method())
 
 	if err := retry.WithBackoff(retryOpts, func() (retry.Status, error) {
-		resp, err := s.post(call)
+		addr := s.pool.pick()
+		resp, err := s.post(addr, call)
 		if err != nil {
 			if resp != nil {
 				infoErr := util.Errorf("failed to send HTTP request with %s", err)
@@ -125,6 +161,7 @@ func (s *httpSender) Send(_ context.Context, call Call) {
 				// the errors we'll sweep up in this net shouldn't be retried,
 				// but we can't really know for sure which.
 				log.Warningf("failed to send HTTP request or read its response: %s", err)
+				s.pool.markUnhealthy(addr)
 				return retry.Continue, nil
 			default:
 				// Can't retry in order to recover from this error. Propagate.
@@ -138,26 +175,33 @@ func (s *httpSender) Send(_ context.Context, call Call) {
 	}
 }
 
-// post posts the call using the HTTP client. The call's method is
-// appended to KVDBEndpoint and set as the URL path. The call's arguments
-// are protobuf-serialized and written as the POST body. The content
-// type is set to application/x-protobuf.
+// post posts the call to the gateway node at addr using the HTTP client.
+// The call's method is appended to KVDBEndpoint and set as the URL path.
+// The call's arguments are protobuf-serialized (or JSON-serialized if the
+// sender uses JSON) and written as the POST body. The content type is set
+// accordingly.
 //
 // On success, the response body is unmarshalled into call.Reply.
-func (s *httpSender) post(call Call) (*http.Response, error) {
+func (s *httpSender) post(addr string, call Call) (*http.Response, error) {
 	// Marshal the args into a request body.
-	body, err := gogoproto.Marshal(call.Args)
+	marshal, unmarshal, contentType := gogoproto.Marshal, gogoproto.Unmarshal, util.ProtoContentType
+	if s.json {
+		marshal = func(msg gogoproto.Message) ([]byte, error) { return json.Marshal(msg) }
+		unmarshal = func(b []byte, msg gogoproto.Message) error { return json.Unmarshal(b, msg) }
+		contentType = util.JSONContentType
+	}
+	body, err := marshal(call.Args)
 	if err != nil {
 		return nil, err
 	}
 
-	url := s.context.RequestScheme() + "://" + s.server + KVDBEndpoint + call.Method().String()
+	url := s.context.RequestScheme() + "://" + addr + KVDBEndpoint + call.Method().String()
 	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
 	if err != nil {
 		return nil, util.Errorf("unable to create request: %s", err)
 	}
-	req.Header.Add("Content-Type", "application/x-protobuf")
-	req.Header.Add("Accept", "application/x-protobuf")
+	req.Header.Add(util.ContentTypeHeader, contentType)
+	req.Header.Add(util.AcceptHeader, contentType)
 	req.Header.Add("Accept-Encoding", "snappy")
 	resp, err := s.client.Do(req)
 	if resp == nil {
@@ -177,7 +221,7 @@ func (s *httpSender) post(call Call) (*http.Response, error) {
 	if resp.StatusCode != 200 {
 		return resp, errors.New(resp.Status)
 	}
-	if err := gogoproto.Unmarshal(b, call.Reply); err != nil {
+	if err := unmarshal(b, call.Reply); err != nil {
 		log.Errorf("request completed, but unable to unmarshal response from server: %s; body=%q", err, b)
 		return nil, &httpSendError{err}
 	}
@@ -223,3 +223,60 @@ func TestHTTPSenderRetryHTTPSendError(t *testing.T) {
 		server.Close()
 	}
 }
+
+// TestHTTPSenderJSON verifies that a sender using the JSON encoding posts
+// JSON-encoded calls and decodes JSON-encoded replies.
+func TestHTTPSenderJSON(t *testing.T) {
+	server, addr := startTestHTTPServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := util.GetContentType(r); ct != util.JSONContentType {
+			t.Errorf("expected content type %s; got %s", util.JSONContentType, ct)
+		}
+		reqBody, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Errorf("unexpected error reading body: %s", err)
+		}
+		args := &proto.BatchRequest{}
+		if err := util.UnmarshalRequest(r, reqBody, args, util.AllEncodings); err != nil {
+			t.Errorf("unexpected error unmarshalling request: %s", err)
+		}
+		if len(args.Requests) != 2 || !args.Requests[1].GetGet().Key.Equal(testKey) {
+			t.Errorf("unexpected request %+v", args)
+		}
+		reply := &proto.BatchResponse{}
+		reply.Add(testPutResp)
+		reply.Add(&proto.GetResponse{Value: &proto.Value{Bytes: []byte("value")}})
+		body, contentType, err := util.MarshalResponse(r, reply, util.AllEncodings)
+		if err != nil {
+			t.Errorf("failed to marshal response: %s", err)
+		}
+		if contentType != util.JSONContentType {
+			t.Errorf("expected content type %s; got %s", util.JSONContentType, contentType)
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	sender, err := newHTTPSender(addr, testutils.NewTestBaseContext(), defaultRetryOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sender.json = true
+	args := &proto.BatchRequest{}
+	args.Add(testPutReq)
+	args.Add(&proto.GetRequest{RequestHeader: proto.RequestHeader{Key: testKey}})
+	reply := &proto.BatchResponse{}
+	sender.Send(context.Background(), Call{Args: args, Reply: reply})
+	if reply.GoError() != nil {
+		t.Fatalf("expected success; got %s", reply.GoError())
+	}
+	if len(reply.Responses) != 2 {
+		t.Fatalf("expected 2 responses; got %+v", reply)
+	}
+	if ts := reply.Responses[0].GetPut().Timestamp; !ts.Equal(testTS) {
+		t.Errorf("expected timestamp %s; got %s", testTS, ts)
+	}
+	if v := reply.Responses[1].GetGet().Value; v == nil || string(v.Bytes) != "value" {
+		t.Errorf("expected value \"value\"; got %+v", v)
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// DefaultIngestBatchSize is the default number of bytes of keys and values
+// buffered by an Ingester before they are written.
+const DefaultIngestBatchSize = 1 << 20
+
+// An IngestOption configures an Ingester.
+type IngestOption func(*Ingester)
+
+// IngestBatchSizeOpt sets the number of bytes of keys and values an
+// Ingester buffers before writing them. The default is
+// DefaultIngestBatchSize.
+func IngestBatchSizeOpt(n int) IngestOption {
+	return func(in *Ingester) {
+		in.batchSize = n
+	}
+}
+
+// IngestSkipChecksumsOpt writes the values without checksums, deferring
+// the verification of the data to the caller (for example a backup whose
+// frames carry their own checksums).
+func IngestSkipChecksumsOpt() IngestOption {
+	return func(in *Ingester) {
+		in.skipChecksums = true
+	}
+}
+
+// An Ingester writes a large, sorted stream of key/value pairs within a
+// span of keys, such as the data of a table being restored. The pairs are
+// buffered and written with blind puts in batches: existing values are
+// overwritten without conditional checks and the writes are not
+// transactional. Keys must be added in strictly increasing order, which
+// lets the Ingester reject malformed input early:
+//
+//   in, err := db.NewIngester(prefix, prefix.PrefixEnd())
+//   for ... {
+//     if err := in.Add(key, value); err != nil {
+//       ...
+//     }
+//   }
+//   err = in.Flush()
+//
+// An Ingester is not safe for concurrent use.
+type Ingester struct {
+	db            *DB
+	begin, end    proto.Key
+	last          proto.Key
+	b             Batch
+	size          int
+	batchSize     int
+	skipChecksums bool
+	count         int64
+}
+
+// NewIngester returns an Ingester writing keys in the span [begin, end).
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) NewIngester(begin, end interface{}, opts ...IngestOption) (*Ingester, error) {
+	b, err := marshalKey(begin)
+	if err != nil {
+		return nil, err
+	}
+	e, err := marshalKey(end)
+	if err != nil {
+		return nil, err
+	}
+	in := &Ingester{
+		db:        db,
+		begin:     proto.Key(b),
+		end:       proto.Key(e),
+		batchSize: DefaultIngestBatchSize,
+	}
+	for _, opt := range opts {
+		opt(in)
+	}
+	return in, nil
+}
+
+// Add buffers a write of value to key, writing the buffered pairs if they
+// exceed the batch size. An error is returned if the key does not sort
+// after the previously added key or is outside of the Ingester's span.
+func (in *Ingester) Add(key proto.Key, value proto.Value) error {
+	if bytes.Compare(key, in.begin) < 0 || bytes.Compare(key, in.end) >= 0 {
+		return fmt.Errorf("key %q is outside of the span [%q, %q)", key, in.begin, in.end)
+	}
+	if in.last != nil && bytes.Compare(key, in.last) <= 0 {
+		return fmt.Errorf("key %q is not after the previous key %q", key, in.last)
+	}
+	in.last = key
+	if !in.skipChecksums {
+		value.InitChecksum(key)
+	}
+	in.b.InternalAddCall(Call{
+		Args: &proto.PutRequest{
+			RequestHeader: proto.RequestHeader{Key: key},
+			Value:         value,
+		},
+		Reply: &proto.PutResponse{},
+	})
+	in.size += len(key) + len(value.Bytes)
+	if in.size >= in.batchSize {
+		return in.Flush()
+	}
+	return nil
+}
+
+// Flush writes the buffered pairs. It must be called once all pairs have
+// been added.
+func (in *Ingester) Flush() error {
+	n := len(in.b.calls)
+	if n == 0 {
+		return nil
+	}
+	if err := in.db.Run(&in.b); err != nil {
+		return err
+	}
+	in.count += int64(n)
+	in.b.Reset()
+	in.size = 0
+	return nil
+}
+
+// Count returns the number of pairs written so far.
+func (in *Ingester) Count() int64 {
+	return in.count
+}
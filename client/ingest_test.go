@@ -0,0 +1,78 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestIngester(t *testing.T) {
+	db, s := newMemDB()
+	in, err := db.NewIngester("a", "m", IngestBatchSizeOpt(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		if err := in.Add(proto.Key(key), proto.Value{Bytes: []byte(key)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Each pair is 2 bytes: the first 4 pairs were written in 2 batches.
+	if s.batches != 2 || in.Count() != 4 {
+		t.Errorf("expected 2 batches and 4 pairs, but found %d and %d", s.batches, in.Count())
+	}
+	if err := in.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if in.Count() != 5 || len(s.data) != 5 {
+		t.Errorf("expected 5 pairs, but found %d (%d stored)", in.Count(), len(s.data))
+	}
+	if v := s.data["c"]; string(v.Bytes) != "c" || v.Checksum == nil {
+		t.Errorf("unexpected value %+v", v)
+	}
+
+	testCases := []struct {
+		key string
+		err string
+	}{
+		{"e", `key "e" is not after the previous key "e"`},
+		{"b", `key "b" is not after the previous key "e"`},
+		{"m", `key "m" is outside of the span ["a", "m")`},
+		{"f", ""},
+	}
+	for i, c := range testCases {
+		err := in.Add(proto.Key(c.key), proto.Value{})
+		if (err == nil && c.err != "") || (err != nil && err.Error() != c.err) {
+			t.Errorf("%d: expected %q, but found %v", i, c.err, err)
+		}
+	}
+
+	in, err = db.NewIngester("x", "z", IngestSkipChecksumsOpt())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := in.Add(proto.Key("x"), proto.Value{Bytes: []byte("x")}); err != nil {
+		t.Fatal(err)
+	}
+	if err := in.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if v := s.data["x"]; v.Checksum != nil {
+		t.Errorf("expected no checksum, but found %d", *v.Checksum)
+	}
+}
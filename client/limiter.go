@@ -0,0 +1,110 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
+)
+
+// LimitOpt limits the calls a DB sends to the cluster, so that bulk
+// operations such as backfills and imports cannot overwhelm a small
+// cluster. At most maxInFlight calls (a batch counting as a single call)
+// are outstanding at any time and operations are sent at a rate of at
+// most opsPerSec, the operations of a batch counting individually. A
+// value of zero disables the corresponding limit. The limits are shared
+// by the DB handle and its transactions. Callers blocked by the limiter
+// return early with the context's error if the context passed to
+// RunContext or TxnContext is canceled.
+func LimitOpt(maxInFlight int, opsPerSec float64) Option {
+	return func(db *DB) {
+		l := &limiter{}
+		if maxInFlight > 0 {
+			l.sem = make(chan struct{}, maxInFlight)
+		}
+		if opsPerSec > 0 {
+			l.interval = time.Duration(float64(time.Second) / opsPerSec)
+		}
+		db.limiter = l
+	}
+}
+
+// limiter bounds the number of outstanding calls and paces the operations
+// sent to the cluster.
+type limiter struct {
+	// sem holds a token for every outstanding call. It is nil if the
+	// number of outstanding calls is unlimited.
+	sem chan struct{}
+	// interval is the minimum time between two operations, or zero if the
+	// rate of operations is unlimited.
+	interval time.Duration
+
+	mu sync.Mutex
+	// next is the earliest time at which the next operation may be sent.
+	next time.Time
+}
+
+// acquire blocks until the call may be sent or the context is canceled.
+// If acquire returns nil, release must be called once the call has
+// completed.
+func (l *limiter) acquire(ctx context.Context, c Call) error {
+	if l == nil {
+		return nil
+	}
+	if l.interval > 0 {
+		ops := 1
+		if b, ok := c.Args.(*proto.BatchRequest); ok && len(b.Requests) > 0 {
+			ops = len(b.Requests)
+		}
+		// Reserve a slot for the operations; concurrent callers are
+		// scheduled one after the other.
+		l.mu.Lock()
+		now := time.Now()
+		if l.next.Before(now) {
+			l.next = now
+		}
+		delay := l.next.Sub(now)
+		l.next = l.next.Add(time.Duration(ops) * l.interval)
+		l.mu.Unlock()
+		if delay > 0 {
+			timer := time.NewTimer(delay)
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			}
+		}
+	}
+	if l.sem != nil {
+		select {
+		case l.sem <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return nil
+}
+
+// release signals the completion of a call admitted by acquire.
+func (l *limiter) release() {
+	if l != nil && l.sem != nil {
+		<-l.sem
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
+)
+
+func TestLimitInFlight(t *testing.T) {
+	s := &memSender{data: map[string]proto.Value{}}
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	unblock := make(chan struct{})
+	db := newDB(SenderFunc(func(ctx context.Context, call Call) {
+		mu.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mu.Unlock()
+		<-unblock
+		s.Send(ctx, call)
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+	}))
+	LimitOpt(2, 0)(db)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := db.Put("a", "1"); err != nil {
+				t.Error(err)
+			}
+		}()
+	}
+
+	// A caller blocked by the limiter returns once its context is
+	// canceled.
+	time.Sleep(10 * time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+	go func() {
+		b := &Batch{}
+		b.Put("b", "2")
+		errs <- db.RunContext(ctx, b)
+	}()
+	cancel()
+	if err := <-errs; err != context.Canceled {
+		t.Errorf("expected %v, but found %v", context.Canceled, err)
+	}
+
+	close(unblock)
+	wg.Wait()
+	if maxInFlight != 2 {
+		t.Errorf("expected at most 2 calls in flight, but found %d", maxInFlight)
+	}
+	if _, ok := s.data["b"]; ok {
+		t.Errorf("expected the canceled put not to be sent")
+	}
+}
+
+func TestLimitRate(t *testing.T) {
+	db, _ := newMemDB()
+	LimitOpt(0, 200)(db)
+
+	start := time.Now()
+	b := &Batch{}
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		b.Put(key, "1")
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	// The batch of 5 operations delays the next call by 25ms.
+	if err := db.Put("f", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 25*time.Millisecond {
+		t.Errorf("expected the calls to take at least 25ms, but took %s", elapsed)
+	}
+
+	// A caller waiting for its turn returns once its context is canceled.
+	LimitOpt(0, 1)(db)
+	if err := db.Put("g", "1"); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	b = &Batch{}
+	b.Put("h", "1")
+	if err := db.RunContext(ctx, b); err != context.DeadlineExceeded {
+		t.Errorf("expected %v, but found %v", context.DeadlineExceeded, err)
+	}
+}
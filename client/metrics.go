@@ -0,0 +1,175 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"expvar"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// LatencyBuckets are the upper bounds of the buckets of the call latency
+// histograms, in nanoseconds.
+var LatencyBuckets = []int64{
+	int64(time.Millisecond), int64(2 * time.Millisecond), int64(5 * time.Millisecond),
+	int64(10 * time.Millisecond), int64(20 * time.Millisecond), int64(50 * time.Millisecond),
+	int64(100 * time.Millisecond), int64(200 * time.Millisecond), int64(500 * time.Millisecond),
+	int64(time.Second), int64(2 * time.Second), int64(5 * time.Second), int64(10 * time.Second),
+}
+
+// BatchSizeBuckets are the upper bounds of the buckets of the batch size
+// histogram, in operations.
+var BatchSizeBuckets = []int64{1, 2, 5, 10, 20, 50, 100, 200, 500, 1000, 2000, 5000, 10000}
+
+// A Histogram counts values by bucket. Counts[i] is the number of values
+// at most Bounds[i] (and greater than Bounds[i-1]); the last element of
+// Counts, which has no bound, is the number of values exceeding every
+// bound.
+type Histogram struct {
+	Bounds []int64
+	Counts []int64
+}
+
+func newHistogram(bounds []int64) Histogram {
+	return Histogram{Bounds: bounds, Counts: make([]int64, len(bounds)+1)}
+}
+
+func (h *Histogram) add(v int64) {
+	h.Counts[sort.Search(len(h.Bounds), func(i int) bool { return v <= h.Bounds[i] })]++
+}
+
+func (h Histogram) clone() Histogram {
+	return Histogram{Bounds: h.Bounds, Counts: append([]int64(nil), h.Counts...)}
+}
+
+// CallMetrics holds the metrics of the calls of a method sent to the
+// cluster.
+type CallMetrics struct {
+	// Calls is the number of calls.
+	Calls int64
+	// Errors is the number of calls which failed.
+	Errors int64
+	// Latency is a histogram of the latency of the calls in nanoseconds.
+	Latency Histogram
+}
+
+// Metrics is a snapshot of the metrics of the operations performed
+// through a DB.
+type Metrics struct {
+	// Ops holds the number of operations of each method, e.g. "Get",
+	// including the operations sent as part of a batch.
+	Ops map[string]int64
+	// Calls holds the metrics of the calls sent to the cluster by method.
+	// The operations of a batch are sent as a single "Batch" call.
+	Calls map[string]CallMetrics
+	// BatchSizes is a histogram of the number of operations of batches.
+	BatchSizes Histogram
+	// TxnRestarts is the number of times transactions were restarted.
+	TxnRestarts int64
+}
+
+// clientMetrics collects the metrics of a DB and the handles derived
+// from it, e.g. for transactions.
+type clientMetrics struct {
+	sync.Mutex
+	m Metrics
+}
+
+func newClientMetrics() *clientMetrics {
+	return &clientMetrics{
+		m: Metrics{
+			Ops:        map[string]int64{},
+			Calls:      map[string]CallMetrics{},
+			BatchSizes: newHistogram(BatchSizeBuckets),
+		},
+	}
+}
+
+// recordCall records a call which took the given time and returned err.
+func (cm *clientMetrics) recordCall(c Call, latency time.Duration, err error) {
+	if cm == nil {
+		return
+	}
+	cm.Lock()
+	defer cm.Unlock()
+	method := c.Method()
+	if b, ok := c.Args.(*proto.BatchRequest); ok {
+		for _, union := range b.Requests {
+			cm.m.Ops[union.GetValue().(proto.Request).Method().String()]++
+		}
+		cm.m.BatchSizes.add(int64(len(b.Requests)))
+	} else {
+		cm.m.Ops[method.String()]++
+	}
+	call, ok := cm.m.Calls[method.String()]
+	if !ok {
+		call.Latency = newHistogram(LatencyBuckets)
+	}
+	call.Calls++
+	if err != nil {
+		call.Errors++
+	}
+	call.Latency.add(latency.Nanoseconds())
+	cm.m.Calls[method.String()] = call
+}
+
+// recordTxnRestart records the restart of a transaction.
+func (cm *clientMetrics) recordTxnRestart() {
+	if cm == nil {
+		return
+	}
+	cm.Lock()
+	cm.m.TxnRestarts++
+	cm.Unlock()
+}
+
+// Metrics returns a snapshot of the metrics of the operations performed
+// through the DB handle, including its transactions. Metrics are only
+// collected by handles created with Open.
+func (db *DB) Metrics() Metrics {
+	cm := db.metrics
+	if cm == nil {
+		return newClientMetrics().m
+	}
+	cm.Lock()
+	defer cm.Unlock()
+	m := Metrics{
+		Ops:         make(map[string]int64, len(cm.m.Ops)),
+		Calls:       make(map[string]CallMetrics, len(cm.m.Calls)),
+		BatchSizes:  cm.m.BatchSizes.clone(),
+		TxnRestarts: cm.m.TxnRestarts,
+	}
+	for k, v := range cm.m.Ops {
+		m.Ops[k] = v
+	}
+	for k, v := range cm.m.Calls {
+		v.Latency = v.Latency.clone()
+		m.Calls[k] = v
+	}
+	return m
+}
+
+// PublishMetrics publishes the metrics of the DB handle as an expvar
+// variable with the given name, making them available at /debug/vars.
+// Like expvar.Publish, it panics if the name is already in use.
+func (db *DB) PublishMetrics(name string) {
+	expvar.Publish(name, expvar.Func(func() interface{} {
+		return db.Metrics()
+	}))
+}
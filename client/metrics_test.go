@@ -0,0 +1,124 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"encoding/json"
+	"expvar"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestHistogram(t *testing.T) {
+	h := newHistogram([]int64{1, 10, 100})
+	for _, v := range []int64{0, 1, 2, 10, 50, 101, 1000} {
+		h.add(v)
+	}
+	if expected := []int64{2, 2, 1, 2}; !reflect.DeepEqual(expected, h.Counts) {
+		t.Errorf("expected %v, but found %v", expected, h.Counts)
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	db, _ := newMemDB()
+	db.metrics = newClientMetrics()
+
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	b := &Batch{}
+	b.Put("b", "2")
+	b.Put("c", "3")
+	b.Scan("a", "d", 0)
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CPut("a", "2", "3"); err == nil {
+		t.Fatal("expected the conditional put to fail")
+	}
+
+	m := db.Metrics()
+	expectedOps := map[string]int64{"Put": 3, "Get": 1, "Scan": 1, "ConditionalPut": 1}
+	if !reflect.DeepEqual(expectedOps, m.Ops) {
+		t.Errorf("expected %v, but found %v", expectedOps, m.Ops)
+	}
+	for method, expected := range map[string]struct{ calls, errors int64 }{
+		"Put": {1, 0}, "Get": {1, 0}, "Batch": {1, 0}, "ConditionalPut": {1, 1},
+	} {
+		c := m.Calls[method]
+		if c.Calls != expected.calls || c.Errors != expected.errors {
+			t.Errorf("%s: expected %d calls and %d errors, but found %d and %d",
+				method, expected.calls, expected.errors, c.Calls, c.Errors)
+		}
+		var total int64
+		for _, n := range c.Latency.Counts {
+			total += n
+		}
+		if total != c.Calls {
+			t.Errorf("%s: expected %d latencies, but found %d", method, c.Calls, total)
+		}
+	}
+	// The batch of 3 operations falls into the "<= 5" bucket.
+	if m.BatchSizes.Counts[2] != 1 {
+		t.Errorf("expected a batch of size 3, but found %v", m.BatchSizes.Counts)
+	}
+
+	// Snapshots are not affected by later operations.
+	if _, err := db.Get("a"); err != nil {
+		t.Fatal(err)
+	}
+	if m.Ops["Get"] != 1 || m.Calls["Get"].Calls != 1 {
+		t.Errorf("expected the snapshot to be unchanged, but found %v", m.Ops)
+	}
+
+	// Metrics are published as JSON.
+	db.PublishMetrics("client_test")
+	var published Metrics
+	if err := json.Unmarshal([]byte(expvar.Get("client_test").String()), &published); err != nil {
+		t.Fatal(err)
+	}
+	if published.Ops["Get"] != 2 {
+		t.Errorf("expected 2 published gets, but found %d", published.Ops["Get"])
+	}
+}
+
+func TestMetricsTxnRestarts(t *testing.T) {
+	count := 0
+	db := newDB(newTestSender(func(call Call) {
+		if _, ok := call.Args.(*proto.PutRequest); ok {
+			count++
+			if count == 1 {
+				call.Reply.Header().SetGoError(&proto.TransactionRetryError{})
+			}
+		}
+	}))
+	db.metrics = newClientMetrics()
+	db.txnRetryOptions.Backoff = 1 * time.Millisecond
+	if err := db.Txn(func(txn *Txn) error {
+		return txn.Put("a", "b")
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if m := db.Metrics(); m.TxnRestarts != 1 {
+		t.Errorf("expected 1 restart, but found %d", m.TxnRestarts)
+	}
+}
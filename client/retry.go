@@ -0,0 +1,82 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import "reflect"
+
+// ErrorClass is the classification of an error by a Classifier.
+type ErrorClass int
+
+const (
+	// ErrorDefault leaves the error to the default classification: Run
+	// retries errors which are util.Retryable if RetryOpt was specified and
+	// transactions are restarted on proto.TransactionRestartErrors
+	// requesting a restart.
+	ErrorDefault ErrorClass = iota
+	// ErrorRetryable causes the batch or transaction which failed with the
+	// error to be retried with backoff.
+	ErrorRetryable
+	// ErrorTerminal causes the error to be returned without retrying.
+	ErrorTerminal
+)
+
+// A Classifier classifies the errors of batches and transactions.
+type Classifier func(err error) ErrorClass
+
+// ClassifyOpt registers a classifier deciding which errors are retried by
+// Run and Txn, overriding the default classification. For instance, an
+// interactive service might prefer to fail fast:
+//
+//   db, err := client.Open(addr, client.ClassifyOpt(
+//     client.ErrorTypeClassifier(client.ErrorTerminal, &proto.RangeNotFoundError{})))
+//
+// ClassifyOpt may be specified more than once; the first classifier not
+// returning ErrorDefault decides. Retries use the options set with
+// RetryOpt, or DefaultTxnRetryOptions.
+func ClassifyOpt(c Classifier) Option {
+	return func(db *DB) {
+		db.classifiers = append(db.classifiers, c)
+	}
+}
+
+// ErrorTypeClassifier returns a classifier assigning class to the errors
+// of the same types as errs.
+func ErrorTypeClassifier(class ErrorClass, errs ...error) Classifier {
+	types := make(map[reflect.Type]struct{}, len(errs))
+	for _, err := range errs {
+		types[reflect.TypeOf(err)] = struct{}{}
+	}
+	return func(err error) ErrorClass {
+		if _, ok := types[reflect.TypeOf(err)]; ok {
+			return class
+		}
+		return ErrorDefault
+	}
+}
+
+// classify returns the class of err according to the registered
+// classifiers.
+func (db *DB) classify(err error) ErrorClass {
+	if err == nil {
+		return ErrorDefault
+	}
+	for _, c := range db.classifiers {
+		if class := c(err); class != ErrorDefault {
+			return class
+		}
+	}
+	return ErrorDefault
+}
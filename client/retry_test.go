@@ -0,0 +1,154 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/retry"
+)
+
+func TestClassifyRun(t *testing.T) {
+	var failures, attempts int
+	var failure error
+	db := newDB(newTestSender(func(call Call) {
+		attempts++
+		if failures > 0 {
+			failures--
+			call.Reply.Header().SetGoError(failure)
+		}
+	}))
+	db.txnRetryOptions = retry.Options{Backoff: time.Millisecond, MaxBackoff: time.Millisecond, Constant: 1, MaxAttempts: 3, UseV1Info: true}
+	ClassifyOpt(ErrorTypeClassifier(ErrorRetryable, &proto.WriteTooOldError{}))(db)
+	ClassifyOpt(ErrorTypeClassifier(ErrorTerminal, &proto.RangeNotFoundError{}))(db)
+
+	testCases := []struct {
+		err      error
+		attempts int
+		success  bool
+	}{
+		// Errors classified as retryable are retried, even without RetryOpt.
+		{&proto.WriteTooOldError{}, 2, true},
+		// Errors classified as terminal are not retried.
+		{proto.NewRangeNotFoundError(1), 1, false},
+		// Other errors are left to the default classification.
+		{errors.New("permanent"), 1, false},
+	}
+	for i, test := range testCases {
+		attempts, failures, failure = 0, 1, test.err
+		err := db.Put("a", "1")
+		if test.success != (err == nil) || attempts != test.attempts {
+			t.Errorf("%d: expected %d attempts and success %t, but found %d attempts: %v",
+				i, test.attempts, test.success, attempts, err)
+		}
+	}
+
+	// With RetryOpt, terminal errors are still not retried.
+	RetryOpt(db.txnRetryOptions)(db)
+	attempts, failures, failure = 0, 1, proto.NewRangeNotFoundError(1)
+	if err := db.Put("a", "1"); err == nil || attempts != 1 {
+		t.Errorf("expected 1 failed attempt, but found %d: %v", attempts, err)
+	}
+}
+
+func TestClassifyTxn(t *testing.T) {
+	var failures, attempts int
+	var failure error
+	db := newDB(newTestSender(func(call Call) {
+		if _, ok := call.Args.(*proto.PutRequest); ok {
+			attempts++
+			if failures > 0 {
+				failures--
+				call.Reply.Header().SetGoError(failure)
+			}
+		}
+	}))
+	db.txnRetryOptions.Backoff = time.Millisecond
+	ClassifyOpt(ErrorTypeClassifier(ErrorTerminal, &proto.TransactionRetryError{}))(db)
+	ClassifyOpt(ErrorTypeClassifier(ErrorRetryable, &proto.WriteTooOldError{}))(db)
+
+	testCases := []struct {
+		err      error
+		attempts int
+		success  bool
+	}{
+		// Restart errors classified as terminal do not restart the txn.
+		{&proto.TransactionRetryError{}, 1, false},
+		// Unclassified restart errors restart the txn.
+		{&proto.TransactionAbortedError{}, 2, true},
+		// Other errors classified as retryable restart the txn.
+		{&proto.WriteTooOldError{}, 2, true},
+	}
+	for i, test := range testCases {
+		attempts, failures, failure = 0, 1, test.err
+		err := db.Txn(func(txn *Txn) error {
+			return txn.Put("a", "b")
+		})
+		if test.success != (err == nil) || attempts != test.attempts {
+			t.Errorf("%d: expected %d attempts and success %t, but found %d attempts: %v",
+				i, test.attempts, test.success, attempts, err)
+		}
+	}
+}
+
+func TestRetryKeepsClientCmdIDs(t *testing.T) {
+	var attempts [][]proto.ClientCmdID
+	failures := 1
+	db := newDB(newTestSender(func(call Call) {
+		var ids []proto.ClientCmdID
+		for _, req := range requests(call) {
+			ids = append(ids, req.Header().CmdID)
+		}
+		attempts = append(attempts, ids)
+		if failures > 0 {
+			// The batch was applied, but the response is lost.
+			failures--
+			call.Reply.Header().SetGoError(&proto.WriteTooOldError{})
+		}
+	}))
+	db.txnRetryOptions.Backoff = time.Millisecond
+	ClassifyOpt(ErrorTypeClassifier(ErrorRetryable, &proto.WriteTooOldError{}))(db)
+
+	b := &Batch{}
+	b.Put("a", "1")
+	b.Inc("b", 1)
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if len(attempts) != 2 {
+		t.Fatalf("expected 2 attempts, but found %d", len(attempts))
+	}
+	for _, id := range attempts[0] {
+		if id.IsEmpty() {
+			t.Errorf("expected a client command ID on every call")
+		}
+	}
+	if !reflect.DeepEqual(attempts[0], attempts[1]) {
+		t.Errorf("expected the retry to keep the client command IDs %v, but found %v", attempts[0], attempts[1])
+	}
+
+	// Running the batch again is not a retry.
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	if reflect.DeepEqual(attempts[0], attempts[2]) {
+		t.Errorf("expected new client command IDs")
+	}
+}
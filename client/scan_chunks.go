@@ -0,0 +1,83 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import "github.com/cockroachdb/cockroach/proto"
+
+// DefaultScanChunkSize is the number of rows per chunk used by ScanChunks
+// if no chunk size is specified.
+const DefaultScanChunkSize = 1000
+
+// ScanChunks retrieves the rows between begin (inclusive) and end
+// (exclusive) in chunks of up to chunkSize rows, invoking fn with each
+// chunk as soon as it has been read. Unlike Scan, the rows of a large span
+// are never buffered at once. The scan stops when fn returns true or an
+// error, which is returned. A chunkSize of zero uses
+// DefaultScanChunkSize.
+//
+//   err := db.ScanChunks("a", "z", 100, func(rows []KeyValue) (bool, error) {
+//     for _, row := range rows {
+//       ...
+//     }
+//     return false, nil
+//   })
+//
+// Each chunk is read by a separate Scan: outside of a transaction, the
+// chunks need not reflect a single consistent view of the span.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (db *DB) ScanChunks(begin, end interface{}, chunkSize int64, fn func(rows []KeyValue) (bool, error)) error {
+	return scanChunks(db, begin, end, chunkSize, fn)
+}
+
+// ScanChunks retrieves the rows between begin (inclusive) and end
+// (exclusive) in chunks of up to chunkSize rows, invoking fn with each
+// chunk as soon as it has been read. See DB.ScanChunks.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (txn *Txn) ScanChunks(begin, end interface{}, chunkSize int64, fn func(rows []KeyValue) (bool, error)) error {
+	return scanChunks(txn, begin, end, chunkSize, fn)
+}
+
+func scanChunks(r Runner, begin, end interface{}, chunkSize int64, fn func(rows []KeyValue) (bool, error)) error {
+	if chunkSize <= 0 {
+		chunkSize = DefaultScanChunkSize
+	}
+	start, err := marshalKey(begin)
+	if err != nil {
+		return err
+	}
+	for {
+		b := &Batch{}
+		b.Scan(start, end, chunkSize)
+		res, err := runOneResult(r, b)
+		if err != nil {
+			return err
+		}
+		if len(res.Rows) == 0 {
+			return nil
+		}
+		if done, err := fn(res.Rows); done || err != nil {
+			return err
+		}
+		if int64(len(res.Rows)) < chunkSize {
+			return nil
+		}
+		start = proto.Key(res.Rows[len(res.Rows)-1].Key).Next()
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestScanChunks(t *testing.T) {
+	db, _ := newMemDB()
+	for _, key := range []string{"a", "b", "c", "d", "e"} {
+		if err := db.Put(key, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	collect := func(chunkSize int64, stopAfter int) ([][]string, error) {
+		var chunks [][]string
+		err := db.ScanChunks("a", "z", chunkSize, func(rows []KeyValue) (bool, error) {
+			var keys []string
+			for _, row := range rows {
+				keys = append(keys, string(row.Key))
+			}
+			chunks = append(chunks, keys)
+			return len(chunks) == stopAfter, nil
+		})
+		return chunks, err
+	}
+
+	testCases := []struct {
+		chunkSize int64
+		stopAfter int
+		expected  [][]string
+	}{
+		{2, 0, [][]string{{"a", "b"}, {"c", "d"}, {"e"}}},
+		{5, 0, [][]string{{"a", "b", "c", "d", "e"}}},
+		{0, 0, [][]string{{"a", "b", "c", "d", "e"}}},
+		{2, 1, [][]string{{"a", "b"}}},
+	}
+	for i, c := range testCases {
+		chunks, err := collect(c.chunkSize, c.stopAfter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(c.expected, chunks) {
+			t.Errorf("%d: expected %v, but found %v", i, c.expected, chunks)
+		}
+	}
+
+	errStop := errors.New("stop")
+	if err := db.Txn(func(txn *Txn) error {
+		return txn.ScanChunks("a", "z", 1, func(rows []KeyValue) (bool, error) {
+			return false, errStop
+		})
+	}); err != errStop {
+		t.Errorf("expected %v, but found %v", errStop, err)
+	}
+}
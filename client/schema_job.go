@@ -0,0 +1,351 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// SchemaPollInterval is the interval at which WaitForSchemaJob and
+// WaitForSchemaVersion poll the status of a job and the version of a table.
+var SchemaPollInterval = 100 * time.Millisecond
+
+// SchemaJobLeaseDuration is the duration of the lease held by the client
+// running a schema job. The lease is extended each time the job records a
+// checkpoint of its progress; a RUNNING job whose lease has expired is
+// assumed to have been abandoned and may be adopted by another client.
+var SchemaJobLeaseDuration = time.Minute
+
+// SchemaJobs returns the records of all schema change jobs, ordered by job
+// ID. Long-running schema changes (index and column backfills and the
+// reclamation of dropped tables) record their progress and status in a job
+// while they run. A job is run by a single client at a time, which holds a
+// lease on it; jobs abandoned by their client can be resumed with
+// ResumeSchemaJob or AdoptSchemaJobs.
+func (db *DB) SchemaJobs() ([]proto.SchemaJob, error) {
+	kvs, err := db.Scan(keys.SchemaJobPrefix, keys.SchemaJobPrefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []proto.SchemaJob
+	for _, kv := range kvs {
+		if proto.Key(kv.Key).Equal(keys.SchemaJobIDGenerator) {
+			continue
+		}
+		var job proto.SchemaJob
+		if err := kv.ValueProto(&job); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, job)
+	}
+	return jobs, nil
+}
+
+// WaitForSchemaJob blocks until the job with the given ID has finished,
+// returning its final record. An error is returned if the job failed, was
+// canceled or does not exist.
+func (db *DB) WaitForSchemaJob(id uint64) (proto.SchemaJob, error) {
+	for {
+		job, err := getSchemaJob(db.Get, id)
+		if err != nil {
+			return job, err
+		}
+		switch job.Status {
+		case proto.SchemaJob_SUCCEEDED:
+			return job, nil
+		case proto.SchemaJob_FAILED:
+			return job, fmt.Errorf("schema job %d failed: %s", id, job.Error)
+		case proto.SchemaJob_CANCELED:
+			return job, &SchemaJobCanceledError{ID: id}
+		}
+		time.Sleep(SchemaPollInterval)
+	}
+}
+
+// getSchemaJob reads the record of the job with the given ID with get,
+// which is either DB.Get or Txn.Get.
+func getSchemaJob(get func(key interface{}) (KeyValue, error), id uint64) (proto.SchemaJob, error) {
+	var job proto.SchemaJob
+	r, err := get(keys.MakeSchemaJobKey(id))
+	if err != nil {
+		return job, err
+	}
+	if !r.Exists() {
+		return job, fmt.Errorf("schema job %d does not exist", id)
+	}
+	err = r.ValueProto(&job)
+	return job, err
+}
+
+// WaitForSchemaVersion blocks until the version of the descriptor of the
+// named table is at least version, e.g. until a schema change made by
+// another client has been applied. An error is returned if the table does
+// not exist or is dropped while waiting.
+func (db *DB) WaitForSchemaVersion(table string, version uint32) error {
+	for {
+		desc, err := db.DescribeTableDesc(table)
+		if err != nil {
+			return err
+		}
+		if desc.Version >= version {
+			return nil
+		}
+		time.Sleep(SchemaPollInterval)
+	}
+}
+
+// CancelSchemaJob requests the cancellation of a RUNNING schema job. The
+// job stops at its next checkpoint and is marked CANCELED. A canceled index
+// backfill drops the index, while a canceled column backfill leaves the
+// values it has written and a canceled reclamation of a dropped table is
+// attempted again by the next GCDroppedTables. The cancellation of an
+// abandoned job takes effect once it is adopted.
+func (db *DB) CancelSchemaJob(id uint64) error {
+	return db.Txn(func(txn *Txn) error {
+		job, err := getSchemaJob(txn.Get, id)
+		if err != nil {
+			return err
+		}
+		if job.Status != proto.SchemaJob_RUNNING {
+			return fmt.Errorf("schema job %d is not running", id)
+		}
+		job.CancelRequested = true
+		return txn.Put(keys.MakeSchemaJobKey(id), &job)
+	})
+}
+
+// ResumeSchemaJob adopts a RUNNING schema job whose lease has expired
+// because its client died, and resumes it from its last checkpoint. It
+// blocks until the job finishes, returning its error. A
+// *SchemaJobLeaseError is returned if another client holds the job's
+// lease.
+func (db *DB) ResumeSchemaJob(id uint64) error {
+	j := &schemaJob{db: db, owner: []byte(util.NewUUID4())}
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		if j.job, err = getSchemaJob(txn.Get, id); err != nil {
+			return err
+		}
+		if j.job.Status != proto.SchemaJob_RUNNING {
+			return fmt.Errorf("schema job %d is not running", id)
+		}
+		if j.job.LeaseExpiration > time.Now().UnixNano() {
+			return &SchemaJobLeaseError{ID: id}
+		}
+		j.job.LeaseOwner = j.owner
+		j.job.LeaseExpiration = time.Now().Add(SchemaJobLeaseDuration).UnixNano()
+		return txn.Put(keys.MakeSchemaJobKey(id), &j.job)
+	}); err != nil {
+		return err
+	}
+	switch j.job.Type {
+	case proto.SchemaJob_INDEX_BACKFILL:
+		return db.resumeIndexBackfill(j)
+	case proto.SchemaJob_COLUMN_BACKFILL:
+		return db.resumeColumnBackfill(j)
+	case proto.SchemaJob_TABLE_GC:
+		return db.reclaimTable(j)
+	case proto.SchemaJob_TABLE_COPY:
+		return j.finish(db.copyTableData(j))
+	}
+	return j.finish(fmt.Errorf("unknown schema job type %s", j.job.Type))
+}
+
+// AdoptSchemaJobs resumes the RUNNING schema jobs whose leases have
+// expired, one at a time, returning the number of jobs resumed. The
+// failures of the resumed jobs are recorded in their records and logged.
+func (db *DB) AdoptSchemaJobs() (int, error) {
+	jobs, err := db.SchemaJobs()
+	if err != nil {
+		return 0, err
+	}
+	var adopted int
+	for _, job := range jobs {
+		if job.Status != proto.SchemaJob_RUNNING || job.LeaseExpiration > time.Now().UnixNano() {
+			continue
+		}
+		err := db.ResumeSchemaJob(job.Id)
+		if _, ok := err.(*SchemaJobLeaseError); ok {
+			// The job was adopted by another client.
+			continue
+		}
+		adopted++
+		if err != nil {
+			log.Warningf("schema job %d: %s", job.Id, err)
+		}
+	}
+	return adopted, nil
+}
+
+// A SchemaJobCanceledError is returned by a schema change whose job was
+// canceled with CancelSchemaJob.
+type SchemaJobCanceledError struct {
+	ID uint64
+}
+
+// Error implements the error interface.
+func (e *SchemaJobCanceledError) Error() string {
+	return fmt.Sprintf("schema job %d was canceled", e.ID)
+}
+
+// A SchemaJobLeaseError is returned when resuming a schema job whose
+// lease is held by another client, and by a schema change whose job has
+// been adopted by another client after its own lease expired.
+type SchemaJobLeaseError struct {
+	ID uint64
+}
+
+// Error implements the error interface.
+func (e *SchemaJobLeaseError) Error() string {
+	return fmt.Sprintf("schema job %d is leased by another client", e.ID)
+}
+
+// A schemaJob records the progress of a schema change run by this client.
+type schemaJob struct {
+	db    *DB
+	job   proto.SchemaJob
+	owner []byte // the lease owner of this run of the job
+}
+
+// startSchemaJob allocates a job ID and records the start of a job, whose
+// type, table ID and description and any type-specific fields are set on
+// job.
+func (db *DB) startSchemaJob(job proto.SchemaJob) (*schemaJob, error) {
+	r, err := db.Inc(keys.SchemaJobIDGenerator, 1)
+	if err != nil {
+		return nil, err
+	}
+	j := &schemaJob{db: db, job: job, owner: []byte(util.NewUUID4())}
+	j.job.Id = uint64(r.ValueInt())
+	j.job.Status = proto.SchemaJob_RUNNING
+	j.job.StartTime = time.Now().UnixNano()
+	j.job.LeaseOwner = j.owner
+	j.job.LeaseExpiration = time.Now().Add(SchemaJobLeaseDuration).UnixNano()
+	if err := db.Put(keys.MakeSchemaJobKey(j.job.Id), &j.job); err != nil {
+		return nil, err
+	}
+	return j, nil
+}
+
+// save writes the job's record and extends its lease, returning a
+// *SchemaJobLeaseError if the job has been adopted by another client. If
+// checkCancel is true, a *SchemaJobCanceledError is returned if the job
+// has been canceled.
+func (j *schemaJob) save(checkCancel bool) error {
+	return j.db.Txn(func(txn *Txn) error {
+		current, err := getSchemaJob(txn.Get, j.job.Id)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(current.LeaseOwner, j.owner) {
+			return &SchemaJobLeaseError{ID: j.job.Id}
+		}
+		if j.job.CancelRequested = current.CancelRequested; checkCancel && j.job.CancelRequested {
+			return &SchemaJobCanceledError{ID: j.job.Id}
+		}
+		j.job.LeaseExpiration = time.Now().Add(SchemaJobLeaseDuration).UnixNano()
+		return txn.Put(keys.MakeSchemaJobKey(j.job.Id), &j.job)
+	})
+}
+
+// checkpoint records the progress of the job and the key at which it
+// resumes if interrupted, and extends its lease. The job must stop if an
+// error is returned: it has been canceled or adopted by another client.
+// Other failures to record a checkpoint are logged and otherwise ignored.
+func (j *schemaJob) checkpoint(progress int64, resume proto.Key) error {
+	j.job.Progress, j.job.ResumeKey = progress, resume
+	err := j.save(true)
+	switch err.(type) {
+	case nil:
+	case *SchemaJobCanceledError, *SchemaJobLeaseError:
+		return err
+	default:
+		log.Warningf("failed to record progress of schema job %d: %s", j.job.Id, err)
+	}
+	return nil
+}
+
+// finish records the completion of the job, which failed if err is
+// non-nil or was canceled if err is a *SchemaJobCanceledError. The
+// original error, if any, is returned; otherwise the error recording the
+// completion is. Nothing is recorded if the job has been adopted by
+// another client, which records its outcome instead.
+func (j *schemaJob) finish(err error) error {
+	j.job.Status = proto.SchemaJob_SUCCEEDED
+	switch err.(type) {
+	case nil:
+	case *SchemaJobLeaseError:
+		return err
+	case *SchemaJobCanceledError:
+		j.job.Status = proto.SchemaJob_CANCELED
+		j.job.Error = err.Error()
+	default:
+		j.job.Status = proto.SchemaJob_FAILED
+		j.job.Error = err.Error()
+	}
+	j.job.FinishTime = time.Now().UnixNano()
+	if saveErr := j.save(false); saveErr != nil {
+		if err != nil {
+			log.Warningf("failed to record failure of schema job %d: %s", j.job.Id, saveErr)
+			return err
+		}
+		return saveErr
+	}
+	return err
+}
+
+// getJobTable returns the descriptor of the table of a job and its name
+// qualified by the name of its database. A job whose table no longer
+// exists, or has been dropped, is finished with an error.
+func (db *DB) getJobTable(j *schemaJob) (proto.TableDescriptor, string, error) {
+	var desc proto.TableDescriptor
+	var name string
+	if err := db.Txn(func(txn *Txn) error {
+		if err := txn.GetProto(keys.MakeDescMetadataKey(j.job.TableId), &desc); err != nil {
+			return err
+		}
+		if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+			return err
+		}
+		// The default database has no descriptor.
+		dbName := DefaultDatabaseName
+		if desc.ParentId != keys.DefaultDatabaseID {
+			var dbDesc proto.DatabaseDescriptor
+			if err := txn.GetProto(keys.MakeDescMetadataKey(desc.ParentId), &dbDesc); err != nil {
+				return err
+			}
+			if dbDesc.Id != desc.ParentId {
+				return fmt.Errorf("database %d of table %q does not exist", desc.ParentId, desc.Name)
+			}
+			dbName = dbDesc.Name
+		}
+		name = dbName + "." + desc.Name
+		return nil
+	}); err != nil {
+		return desc, "", err
+	}
+	if desc.Id != j.job.TableId || desc.DropTime != 0 {
+		return desc, "", j.finish(fmt.Errorf("table %d does not exist", j.job.TableId))
+	}
+	return desc, name, nil
+}
@@ -0,0 +1,326 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestSchemaJobs(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 2
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "a"},
+		row{"id": 2, "name": "a"},
+		row{"id": 3, "name": "b"})
+	if err := db.AddColumn("users", proto.Column{Name: "age", Type: proto.Column_INT}, 0); err != nil {
+		t.Fatal(err)
+	}
+	err := db.CreateIndex("users", proto.TableSchema_IndexByName{
+		Index:       proto.Index{Name: "by_unique_name", Unique: true},
+		ColumnNames: []string{"name"},
+	})
+	if err == nil {
+		t.Fatal("expected unique violation")
+	}
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.GCDroppedTables(0); err != nil {
+		t.Fatal(err)
+	}
+
+	jobs, err := db.SchemaJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []struct {
+		typ         proto.SchemaJob_Type
+		description string
+		status      proto.SchemaJob_Status
+		err         string
+	}{
+		{proto.SchemaJob_COLUMN_BACKFILL, `backfill column "age" of table "users"`, proto.SchemaJob_SUCCEEDED, ""},
+		{proto.SchemaJob_INDEX_BACKFILL, `backfill index "by_unique_name" of table "users"`, proto.SchemaJob_FAILED,
+			`duplicate key value violates unique index "by_unique_name"`},
+		{proto.SchemaJob_TABLE_GC, `reclaim data of dropped table "users"`, proto.SchemaJob_SUCCEEDED, ""},
+	}
+	if len(jobs) != len(expected) {
+		t.Fatalf("expected %d jobs, but found %+v", len(expected), jobs)
+	}
+	for i, e := range expected {
+		job := jobs[i]
+		if job.Id != uint64(i+1) || job.Type != e.typ || job.Description != e.description ||
+			job.Status != e.status || job.Error != e.err {
+			t.Errorf("%d: unexpected job %+v", i, job)
+		}
+		if job.StartTime == 0 || job.FinishTime < job.StartTime {
+			t.Errorf("%d: unexpected job times %+v", i, job)
+		}
+	}
+	if jobs[0].Progress != 3 {
+		t.Errorf("expected column backfill of 3 rows, but found %d", jobs[0].Progress)
+	}
+	if jobs[2].Progress == 0 {
+		t.Errorf("expected table GC to delete keys")
+	}
+}
+
+func TestWaitForSchemaJob(t *testing.T) {
+	defer func(d time.Duration) { SchemaPollInterval = d }(SchemaPollInterval)
+	SchemaPollInterval = time.Millisecond
+
+	db, _ := newMemDB()
+	for i, jobErr := range []error{nil, errors.New("boom")} {
+		job, err := db.startSchemaJob(proto.SchemaJob{Type: proto.SchemaJob_TABLE_GC, TableId: 1, Description: "test"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			if err := job.finish(jobErr); err != jobErr {
+				t.Error(err)
+			}
+		}()
+		final, err := db.WaitForSchemaJob(job.job.Id)
+		if jobErr == nil {
+			if err != nil || final.Status != proto.SchemaJob_SUCCEEDED {
+				t.Errorf("%d: unexpected result %+v, %v", i, final, err)
+			}
+		} else if err == nil || err.Error() != "schema job 2 failed: boom" {
+			t.Errorf("%d: unexpected error %v", i, err)
+		}
+	}
+	if _, err := db.WaitForSchemaJob(9); err == nil || err.Error() != "schema job 9 does not exist" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestWaitForSchemaVersion(t *testing.T) {
+	defer func(d time.Duration) { SchemaPollInterval = d }(SchemaPollInterval)
+	SchemaPollInterval = time.Millisecond
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.WaitForSchemaVersion("users", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- db.WaitForSchemaVersion("users", 2)
+	}()
+	for _, name := range []string{"age", "email"} {
+		select {
+		case err := <-done:
+			t.Fatalf("wait returned early: %v", err)
+		case <-time.After(10 * time.Millisecond):
+		}
+		if err := db.AddColumn("users", proto.Column{Name: name, Type: proto.Column_STRING}, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	go func() {
+		done <- db.WaitForSchemaVersion("users", 5)
+	}()
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err == nil || err.Error() != `table "users" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCancelSchemaJob(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 2
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "a"},
+		row{"id": 2, "name": "b"},
+		row{"id": 3, "name": "c"})
+
+	// The job is canceled after the first chunk and stops at the next
+	// checkpoint.
+	var progress []int64
+	err := db.CreateIndexWithProgress("users", proto.TableSchema_IndexByName{
+		Index:       proto.Index{Name: "by_name_id"},
+		ColumnNames: []string{"name", "id"},
+	}, func(rows int64) {
+		if progress = append(progress, rows); len(progress) == 1 {
+			if err := db.CancelSchemaJob(1); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	if _, ok := err.(*SchemaJobCanceledError); !ok {
+		t.Fatalf("expected the job to be canceled, but found %v", err)
+	}
+	if len(progress) != 1 {
+		t.Errorf("expected the job to stop after the first chunk, but found progress %v", progress)
+	}
+	schema, err := db.DescribeTable("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(schema.Indexes); n != 2 {
+		t.Errorf("expected the index to be dropped, but found %d indexes", n)
+	}
+	job, err := db.WaitForSchemaJob(1)
+	if _, ok := err.(*SchemaJobCanceledError); !ok || job.Status != proto.SchemaJob_CANCELED {
+		t.Errorf("unexpected job %+v, %v", job, err)
+	}
+	if err := db.CancelSchemaJob(1); err == nil {
+		t.Error("expected an error canceling a finished job")
+	}
+}
+
+func TestAdoptSchemaJobs(t *testing.T) {
+	defer func(d time.Duration) { SchemaJobLeaseDuration = d }(SchemaJobLeaseDuration)
+	SchemaJobLeaseDuration = 0
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"}, row{"id": 2})
+	if err := db.AddColumn("users", proto.Column{Name: "age", Type: proto.Column_INT}, nil); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	column, _ := findColumn(&desc, "age")
+
+	// A backfill abandoned by its client before it began is adopted once
+	// its lease expires.
+	abandoned, err := db.startSchemaJob(proto.SchemaJob{
+		Type:        proto.SchemaJob_COLUMN_BACKFILL,
+		TableId:     desc.Id,
+		ColumnId:    column.Id,
+		ColumnValue: encodeCellValue(int64(7)),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := db.AdoptSchemaJobs(); err != nil || n != 1 {
+		t.Fatalf("expected 1 adopted job, but found %d, %v", n, err)
+	}
+	rows, err := db.ScanTable("users", ScanColumnsOpt("age"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []map[string]interface{}{{"age": int64(7)}, {"age": int64(7)}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, rows)
+	}
+	job, err := db.WaitForSchemaJob(abandoned.job.Id)
+	if err != nil || job.Progress != 2 {
+		t.Errorf("unexpected job %+v, %v", job, err)
+	}
+	// The original client can no longer record the job's outcome.
+	if err := abandoned.finish(nil); err == nil {
+		t.Error("expected an error finishing an adopted job")
+	}
+	if n, err := db.AdoptSchemaJobs(); err != nil || n != 0 {
+		t.Errorf("expected no adopted jobs, but found %d, %v", n, err)
+	}
+}
+
+func TestResumeIndexBackfillDefaultDatabase(t *testing.T) {
+	defer func(d time.Duration) { SchemaJobLeaseDuration = d }(SchemaJobLeaseDuration)
+	SchemaJobLeaseDuration = 0
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"}, row{"id": 2, "name": "a"})
+
+	// Add a unique index which the rows violate, as a client would before
+	// abandoning its backfill.
+	var indexID uint32
+	if err := db.Txn(func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, "users")
+		if err != nil {
+			return err
+		}
+		name, _ := findColumn(&desc, "name")
+		indexID = desc.NextIndexId
+		desc.Indexes = append(desc.Indexes, proto.IndexDescriptor{
+			Id:        indexID,
+			Index:     proto.Index{Name: "by_name_unique", Unique: true},
+			ColumnIds: []uint32{name.Id},
+		})
+		desc.NextIndexId++
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		return txn.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	job, err := db.startSchemaJob(proto.SchemaJob{
+		Type:    proto.SchemaJob_INDEX_BACKFILL,
+		TableId: desc.Id,
+		IndexId: indexID,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The resumed backfill fails and drops the index of the table in the
+	// default database.
+	if err := db.ResumeSchemaJob(job.job.Id); err == nil || strings.Contains(err.Error(), "failed to drop index") {
+		t.Fatalf("expected the backfill to fail and the index to be dropped, but found %v", err)
+	}
+	if desc, err = db.DescribeTableDesc("users"); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := findIndexByID(&desc, indexID); ok {
+		t.Errorf("expected index %d to be dropped", indexID)
+	}
+	if j, _ := db.WaitForSchemaJob(job.job.Id); j.Status != proto.SchemaJob_FAILED {
+		t.Errorf("expected the job to fail, but found %+v", j)
+	}
+}
@@ -0,0 +1,682 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// DefaultDatabaseName is the name of the database holding tables whose
+// names are not qualified with a database name, unless another database
+// has been selected with DB.SetDatabase.
+const DefaultDatabaseName = "default"
+
+// The structured metadata is laid out as follows:
+//
+//   keys.MakeNamespaceMetadataKey(<database>) -> database ID
+//   keys.MakeTableMetadataKey(<database ID>, <table>) -> table ID
+//   keys.MakeDescMetadataKey(<ID>) -> proto.{Database,Table}Descriptor
+//
+// IDs are stored as encoded uvarints. The default database is reserved and
+// has no metadata of its own.
+
+// splitTableName splits a table name of the form [<database>.]<table> into
+// its database and table components. Unqualified names refer to defaultDB.
+func splitTableName(name, defaultDB string) (string, string, error) {
+	parts := strings.Split(name, ".")
+	switch len(parts) {
+	case 1:
+		return defaultDB, parts[0], nil
+	case 2:
+		if parts[0] == "" || parts[1] == "" {
+			break
+		}
+		return parts[0], parts[1], nil
+	}
+	return "", "", fmt.Errorf("invalid table name: %q", name)
+}
+
+func encodeDescID(id uint32) []byte {
+	return encoding.EncodeUvarint(nil, uint64(id))
+}
+
+func decodeDescID(b []byte) uint32 {
+	_, id := encoding.DecodeUvarint(b)
+	return uint32(id)
+}
+
+// allocateDescID allocates a new database or table descriptor ID. The ID is
+// allocated outside of any transaction so that concurrent schema changes do
+// not conflict on the generator; unused IDs are simply skipped.
+func (db *DB) allocateDescID() (uint32, error) {
+	if db.descIDs != nil {
+		return db.descIDs.allocate(db)
+	}
+	r, err := db.Inc(keys.DescIDGenerator, 1)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(keys.MaxReservedDescID + r.ValueInt()), nil
+}
+
+// A descIDAllocator hands out the descriptor IDs of blocks reserved from
+// the ID generator with a single increment. It is shared by the copies of
+// a DB handle.
+type descIDAllocator struct {
+	sync.Mutex
+	blockSize int64
+	next, end uint32 // the reserved IDs not yet allocated are [next, end)
+}
+
+// allocate returns the next reserved ID, reserving a new block if the
+// current one is exhausted.
+func (a *descIDAllocator) allocate(db *DB) (uint32, error) {
+	a.Lock()
+	defer a.Unlock()
+	if a.next == a.end {
+		r, err := db.Inc(keys.DescIDGenerator, a.blockSize)
+		if err != nil {
+			return 0, err
+		}
+		a.end = uint32(keys.MaxReservedDescID+r.ValueInt()) + 1
+		a.next = a.end - uint32(a.blockSize)
+	}
+	id := a.next
+	a.next++
+	return id, nil
+}
+
+// peek returns the ID allocate would return next, or false if a new block
+// must be reserved first.
+func (a *descIDAllocator) peek() (uint32, bool) {
+	a.Lock()
+	defer a.Unlock()
+	return a.next, a.next < a.end
+}
+
+// getDatabaseDesc retrieves the descriptor of the named database.
+func getDatabaseDesc(txn *Txn, name string) (proto.DatabaseDescriptor, error) {
+	if name == DefaultDatabaseName {
+		return proto.DatabaseDescriptor{Id: keys.DefaultDatabaseID, Name: name}, nil
+	}
+	r, err := txn.Get(keys.MakeNamespaceMetadataKey(name))
+	if err != nil {
+		return proto.DatabaseDescriptor{}, err
+	}
+	if !r.Exists() {
+		return proto.DatabaseDescriptor{}, fmt.Errorf("database %q does not exist", name)
+	}
+	var desc proto.DatabaseDescriptor
+	if err := txn.GetProto(keys.MakeDescMetadataKey(decodeDescID(r.ValueBytes())), &desc); err != nil {
+		return proto.DatabaseDescriptor{}, err
+	}
+	return desc, nil
+}
+
+// getTableDesc retrieves the descriptor of the named table in the database
+// with the given ID. The returned bool is false if the table does not exist.
+func getTableDesc(txn *Txn, dbID uint32, name string) (proto.TableDescriptor, bool, error) {
+	r, err := txn.Get(keys.MakeTableMetadataKey(dbID, name))
+	if err != nil || !r.Exists() {
+		return proto.TableDescriptor{}, false, err
+	}
+	var desc proto.TableDescriptor
+	if err := txn.GetProto(keys.MakeDescMetadataKey(decodeDescID(r.ValueBytes())), &desc); err != nil {
+		return proto.TableDescriptor{}, false, err
+	}
+	if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+		return proto.TableDescriptor{}, false, err
+	}
+	return desc, true, nil
+}
+
+// getTableDescByName retrieves the descriptor of the table with the given,
+// possibly database qualified, name. Unqualified names are resolved within
+// the database set on the transaction's DB handle. A *TableNotFoundError is
+// returned if the table does not exist.
+func getTableDescByName(txn *Txn, name string) (proto.TableDescriptor, error) {
+	dbName, tableName, err := splitTableName(name, txn.db.defaultDatabase())
+	if err != nil {
+		return proto.TableDescriptor{}, err
+	}
+	dbDesc, err := getDatabaseDesc(txn, dbName)
+	if err != nil {
+		return proto.TableDescriptor{}, err
+	}
+	desc, ok, err := getTableDesc(txn, dbDesc.Id, tableName)
+	if err != nil {
+		return proto.TableDescriptor{}, err
+	}
+	if !ok {
+		return proto.TableDescriptor{}, &TableNotFoundError{Name: name}
+	}
+	return desc, nil
+}
+
+// A TableNotFoundError is returned when a named table does not exist.
+type TableNotFoundError struct {
+	Name string
+}
+
+// Error implements the error interface.
+func (e *TableNotFoundError) Error() string {
+	return fmt.Sprintf("table %q does not exist", e.Name)
+}
+
+// A TableExistsError is returned when a table is created or renamed and a
+// table of the same name already exists.
+type TableExistsError struct {
+	Name string
+}
+
+// Error implements the error interface.
+func (e *TableExistsError) Error() string {
+	return fmt.Sprintf("table %q already exists", e.Name)
+}
+
+// An UnknownColumnError is returned when an operation refers to a column
+// the table does not have.
+type UnknownColumnError struct {
+	Table, Column string
+}
+
+// Error implements the error interface.
+func (e *UnknownColumnError) Error() string {
+	return fmt.Sprintf("table %q: column %q does not exist", e.Table, e.Column)
+}
+
+// CreateTable creates a table from the specified schema. The table name may
+// be qualified with a database name ("<database>.<table>"); unqualified
+// names refer to the database set by SetDatabase. The schema is validated,
+// a new table ID is allocated and the table's name and descriptor are
+// written within a single transaction. A *TableExistsError is returned if a
+// table with the same name already exists in the database. Options such as
+// PreSplitOpt are applied once the table has been created.
+func (db *DB) CreateTable(schema proto.TableSchema, opts ...TableOption) error {
+	_, err := db.createTable(schema, false /* ifNotExists */, opts)
+	return err
+}
+
+// CreateTableIfNotExists is like CreateTable, but succeeds without
+// modifying anything if the table already exists with a schema compatible
+// with the requested one: every requested column must exist with the same
+// type and every requested index must exist over the same columns. Returns
+// true if the table was created. Options are only applied if the table was
+// created.
+func (db *DB) CreateTableIfNotExists(schema proto.TableSchema, opts ...TableOption) (bool, error) {
+	return db.createTable(schema, true /* ifNotExists */, opts)
+}
+
+func (db *DB) createTable(schema proto.TableSchema, ifNotExists bool, opts []TableOption) (bool, error) {
+	name := schema.Name
+	dbName, tableName, err := splitTableName(name, db.defaultDatabase())
+	if err != nil {
+		return false, err
+	}
+	if err := checkSystemTableName(tableName, opts); err != nil {
+		return false, err
+	}
+	o := makeTableOptions(opts)
+	o.resetPlan()
+	schema.Name = tableName
+	desc, err := proto.TableDescFromSchema(schema)
+	if err != nil {
+		return false, err
+	}
+	if o.dryRun != nil {
+		desc.Id, err = db.peekDescID()
+	} else {
+		desc.Id, err = db.allocateDescID()
+	}
+	if err != nil {
+		return false, err
+	}
+	// Compute the split keys up front so that invalid options are reported
+	// before the table is created.
+	splitKeys, err := makeTableSplitKeys(&desc, opts)
+	if err != nil {
+		return false, err
+	}
+
+	var created bool
+	err = db.Txn(func(txn *Txn) error {
+		created = false
+		dbDesc, err := getDatabaseDesc(txn, dbName)
+		if err != nil {
+			return err
+		}
+		desc.ParentId = dbDesc.Id
+		desc.Privileges = proto.NewDefaultPrivilegeDescriptor()
+		if err := proto.ValidateTableDesc(desc); err != nil {
+			return err
+		}
+
+		existing, ok, err := getTableDesc(txn, dbDesc.Id, tableName)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if !ifNotExists {
+				return &TableExistsError{Name: name}
+			}
+			if err := checkSchemaCompatible(existing, desc); err != nil {
+				return fmt.Errorf("table %q already exists with an incompatible schema: %s", name, err)
+			}
+			return nil
+		}
+		b := &Batch{}
+		b.CPut(keys.MakeTableMetadataKey(dbDesc.Id, tableName), encodeDescID(desc.Id), nil)
+		b.CPut(keys.MakeDescMetadataKey(desc.Id), &desc, nil)
+		if desc.TTLSeconds > 0 {
+			b.Put(keys.MakeTableTTLKey(desc.Id), encodeDescID(desc.Id))
+		}
+		if err := commitSchemaChange(txn, b, &desc, o); err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	if err == errDryRun {
+		o.dryRun.Splits = splitKeys
+		return true, nil
+	}
+	if err != nil || !created {
+		return created, err
+	}
+	for _, key := range splitKeys {
+		if err := db.AdminSplit(key); err != nil {
+			return true, fmt.Errorf("table %q was created, but could not be pre-split: %s", name, err)
+		}
+	}
+	return true, nil
+}
+
+// DescribeTable retrieves the schema of the named table. The schema's
+// primary key is its first index. A *TableNotFoundError is returned if the
+// table does not exist.
+func (db *DB) DescribeTable(name string) (proto.TableSchema, error) {
+	desc, err := db.DescribeTableDesc(name)
+	if err != nil {
+		return proto.TableSchema{}, err
+	}
+	return proto.TableSchemaFromDesc(desc), nil
+}
+
+// DescribeTableDesc is like DescribeTable, but returns the table's
+// descriptor, including the table, column and index IDs.
+func (db *DB) DescribeTableDesc(name string) (proto.TableDescriptor, error) {
+	var desc proto.TableDescriptor
+	err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	})
+	return desc, err
+}
+
+// ListTables returns the sorted names of the tables in the named database
+// which match pattern. An empty database name refers to the database set by
+// SetDatabase. The pattern uses the syntax of path.Match, e.g. "user*"; an
+// empty pattern matches all tables.
+func (db *DB) ListTables(database, pattern string) ([]string, error) {
+	var names []string
+	err := db.Txn(func(txn *Txn) error {
+		var err error
+		names, _, err = listTables(txn, database, pattern)
+		return err
+	})
+	return names, err
+}
+
+// ListTablesPage is like ListTables, but returns at most limit names,
+// starting after the position identified by token. An empty token starts
+// at the first table. The returned token is non-empty if the listing may
+// continue and is passed to the next call to retrieve the following page;
+// the final page may be empty. Tokens are opaque and may be used across
+// transactions: tables created or dropped between calls may or may not be
+// listed.
+func (db *DB) ListTablesPage(database, pattern string, limit int, token string) ([]string, string, error) {
+	if limit <= 0 {
+		return nil, "", fmt.Errorf("invalid limit %d", limit)
+	}
+	var names []string
+	err := db.Txn(func(txn *Txn) error {
+		var err error
+		names, _, err = listTablesAfter(txn, database, pattern, token, limit)
+		return err
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(names) < limit {
+		return names, "", nil
+	}
+	return names, names[len(names)-1], nil
+}
+
+// ListTableDescriptors is like ListTables, but returns the schemas of the
+// matching tables. The descriptors are retrieved in a single batch.
+func (db *DB) ListTableDescriptors(database, pattern string) ([]proto.TableSchema, error) {
+	var schemas []proto.TableSchema
+	err := db.Txn(func(txn *Txn) error {
+		_, ids, err := listTables(txn, database, pattern)
+		if err != nil {
+			return err
+		}
+		descs := make([]proto.TableDescriptor, len(ids))
+		b := &Batch{}
+		for i, id := range ids {
+			b.GetProto(keys.MakeDescMetadataKey(id), &descs[i])
+		}
+		if err := txn.Run(b); err != nil {
+			return err
+		}
+		schemas = make([]proto.TableSchema, len(descs))
+		for i := range descs {
+			if _, err := proto.MaybeUpgradeTableDescriptor(&descs[i]); err != nil {
+				return err
+			}
+			schemas[i] = proto.TableSchemaFromDesc(descs[i])
+		}
+		return nil
+	})
+	return schemas, err
+}
+
+// listTables returns the sorted names and IDs of the tables in the named
+// database which match pattern.
+func listTables(txn *Txn, database, pattern string) ([]string, []uint32, error) {
+	return listTablesAfter(txn, database, pattern, "", 0)
+}
+
+// listTablesAfter is like listTables, but only returns tables whose names
+// sort after the given name, and at most limit of them if limit is
+// positive.
+func listTablesAfter(txn *Txn, database, pattern, after string, limit int) ([]string, []uint32, error) {
+	if database == "" {
+		database = txn.db.defaultDatabase()
+	}
+	if pattern != "" {
+		// Check the pattern up front: path.Match only reports malformed
+		// patterns when it gets far enough to notice.
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, nil, fmt.Errorf("invalid table pattern %q: %s", pattern, err)
+		}
+	}
+	dbDesc, err := getDatabaseDesc(txn, database)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefix := keys.MakeTableMetadataKey(dbDesc.Id, "")
+	start, end := prefix, prefix.PrefixEnd()
+	if after != "" {
+		start = keys.MakeTableMetadataKey(dbDesc.Id, after).Next()
+	}
+	var names []string
+	var ids []uint32
+	for {
+		// Tables not matching the pattern may require further scans to fill
+		// the page.
+		rows, err := txn.Scan(start, end, int64(limit))
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range rows {
+			name := string(bytes.TrimPrefix(row.Key, prefix))
+			if pattern != "" {
+				if ok, _ := path.Match(pattern, name); !ok {
+					continue
+				}
+			}
+			names = append(names, name)
+			ids = append(ids, decodeDescID(row.ValueBytes()))
+			if len(names) == limit {
+				return names, ids, nil
+			}
+		}
+		if limit == 0 || len(rows) < limit {
+			return names, ids, nil
+		}
+		start = proto.Key(rows[len(rows)-1].Key).Next()
+	}
+}
+
+// RenameTable renames a table. Either name may be qualified with a database
+// name, allowing a table to be moved between databases. The table's ID and
+// descriptor are preserved: only the namespace entry moves and the name
+// (and parent ID) recorded in the descriptor are updated, all within a
+// single transaction. An error is returned if a table named newName already
+// exists. System tables can only be renamed, and tables can only be given
+// names reserved for system tables, with ForceOpt. Like other schema
+// changes, a rename publishes a new version of the descriptor, so clients
+// caching it under a lease (see AcquireTableLease) observe the new name
+// once they renew their lease.
+func (db *DB) RenameTable(oldName, newName string, opts ...TableOption) error {
+	newDBName, newTableName, err := splitTableName(newName, db.defaultDatabase())
+	if err != nil {
+		return err
+	}
+	if err := checkSystemTableName(newTableName, opts); err != nil {
+		return err
+	}
+	o := makeTableOptions(opts)
+	o.resetPlan()
+	err = db.schemaChangeTxn(oldName, func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, oldName)
+		if err != nil {
+			return err
+		}
+		if err := checkSystemTable(&desc, oldName, opts); err != nil {
+			return err
+		}
+		newDBDesc, err := getDatabaseDesc(txn, newDBName)
+		if err != nil {
+			return err
+		}
+		if _, ok, err := getTableDesc(txn, newDBDesc.Id, newTableName); err != nil {
+			return err
+		} else if ok {
+			return &TableExistsError{Name: newName}
+		}
+
+		oldKey := keys.MakeTableMetadataKey(desc.ParentId, desc.Name)
+		desc.Name = newTableName
+		desc.ParentId = newDBDesc.Id
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		if err := proto.ValidateTableDesc(desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Del(oldKey)
+		b.CPut(keys.MakeTableMetadataKey(desc.ParentId, desc.Name), encodeDescID(desc.Id), nil)
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err == errDryRun {
+		return nil
+	}
+	return err
+}
+
+// DropTable drops a table. The table's namespace entry is removed and its
+// descriptor is marked as dropped within a single transaction, making the
+// table inaccessible by name. Like other schema changes, the drop
+// increments the version of the descriptor and waits for leases on older
+// versions, so that lease holders notice the drop. The table's data is
+// left in place and is reclaimed by the servers or by GCDroppedTables once
+// a grace period has passed; until then the table can be restored with
+// UndropTable. A *TableNotFoundError is returned if the table does not
+// exist. System tables can only be dropped with ForceOpt.
+func (db *DB) DropTable(name string, opts ...TableOption) error {
+	o := makeTableOptions(opts)
+	o.resetPlan()
+	err := db.schemaChangeTxn(name, func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, name)
+		if err != nil {
+			return err
+		}
+		if err := checkSystemTable(&desc, name, opts); err != nil {
+			return err
+		}
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		desc.DropTime = time.Now().UnixNano()
+		b := &Batch{}
+		b.Del(keys.MakeTableMetadataKey(desc.ParentId, desc.Name))
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		b.Put(keys.MakeDroppedTableKey(desc.Id), encodeDescID(desc.Id))
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err == errDryRun {
+		return nil
+	}
+	return err
+}
+
+// Grant grants privileges on a table to a user. The stores require the
+// READ privilege for reads of the table's data and the WRITE privilege for
+// writes, whether through row requests or raw key-value requests, and
+// honor changes to the privileges of a table once they are gossiped.
+func (db *DB) Grant(table, user string, privileges []proto.PrivilegeDescriptor_Kind) error {
+	return db.updatePrivileges(table, user, func(p *proto.PrivilegeDescriptor) error {
+		p.Grant(user, privileges)
+		return nil
+	})
+}
+
+// Revoke revokes privileges on a table from a user. The privileges of the
+// root user cannot be revoked.
+func (db *DB) Revoke(table, user string, privileges []proto.PrivilegeDescriptor_Kind) error {
+	return db.updatePrivileges(table, user, func(p *proto.PrivilegeDescriptor) error {
+		if user == proto.RootUser {
+			return fmt.Errorf("cannot revoke privileges of user %q", user)
+		}
+		p.Revoke(user, privileges)
+		return nil
+	})
+}
+
+// updatePrivileges applies fn to the privileges of a table and writes the
+// table's descriptor back within a single transaction.
+func (db *DB) updatePrivileges(table, user string, fn func(*proto.PrivilegeDescriptor) error) error {
+	if user == "" {
+		return fmt.Errorf("empty user name")
+	}
+	return db.schemaChangeTxn(table, func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, table)
+		if err != nil {
+			return err
+		}
+		if err := fn(&desc.Privileges); err != nil {
+			return err
+		}
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return txn.Commit(b)
+	})
+}
+
+// ShowGrants returns the privileges held on a table, sorted by user name.
+func (db *DB) ShowGrants(table string) ([]proto.UserPrivilegeString, error) {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		return nil, err
+	}
+	return desc.Privileges.Show(), nil
+}
+
+// checkSchemaCompatible verifies that every column and index of the
+// requested descriptor exists in the existing descriptor. Columns are
+// matched by name and type and indexes by name, uniqueness and the names of
+// the indexed columns. IDs are ignored.
+func checkSchemaCompatible(existing, requested proto.TableDescriptor) error {
+	columnNames := func(desc proto.TableDescriptor, index proto.IndexDescriptor) []string {
+		names := make([]string, 0, len(index.ColumnIds))
+		for _, id := range index.ColumnIds {
+			for _, column := range desc.Columns {
+				if column.Id == id {
+					names = append(names, column.Name)
+				}
+			}
+		}
+		return names
+	}
+	indexesEqual := func(a, b proto.IndexDescriptor) bool {
+		if a.Name != b.Name || a.Unique != b.Unique {
+			return false
+		}
+		aNames, bNames := columnNames(existing, a), columnNames(requested, b)
+		if len(aNames) != len(bNames) {
+			return false
+		}
+		for i := range aNames {
+			if aNames[i] != bNames[i] {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, column := range requested.Columns {
+		found := false
+		for _, other := range existing.Columns {
+			if other.Name == column.Name {
+				if other.Type != column.Type || other.ComputeExpr != column.ComputeExpr {
+					return fmt.Errorf("column %q differs", column.Name)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("column %q does not exist", column.Name)
+		}
+	}
+	if !indexesEqual(existing.PrimaryIndex, requested.PrimaryIndex) {
+		return fmt.Errorf("primary key differs")
+	}
+	for _, index := range requested.Indexes {
+		found := false
+		for _, other := range existing.Indexes {
+			if other.Name == index.Name {
+				if !indexesEqual(other, index) {
+					return fmt.Errorf("index %q differs", index.Name)
+				}
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("index %q does not exist", index.Name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,816 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// TableBackfillChunkSize is the maximum number of keys scanned by each of
+// the transactions which backfill existing rows after a schema change.
+var TableBackfillChunkSize int64 = 1000
+
+// TableBackfillRate limits the rate at which index backfills process rows,
+// in rows per second, to bound their impact on foreground traffic. Zero
+// means no limit.
+var TableBackfillRate int64
+
+// AddColumn adds a column to a table, assigning it a new column ID and
+// incrementing the version of the table's descriptor. If defaultValue is
+// non-nil, it is written to every existing row which does not yet have a
+// value for the column. The backfill runs after the descriptor has been
+// updated, in chunks of TableBackfillChunkSize keys, each in its own
+// transaction, and is recorded as a schema job (see SchemaJobs). Computed
+// columns cannot be added to existing tables. DryRunOpt is the only
+// supported option.
+func (db *DB) AddColumn(table string, column proto.Column, defaultValue interface{},
+	opts ...TableOption) error {
+	o := makeTableOptions(opts)
+	o.resetPlan()
+	var desc proto.TableDescriptor
+	var colDesc proto.ColumnDescriptor
+	var value interface{}
+	err := db.schemaChangeTxn(table, func(txn *Txn) error {
+		var err error
+		if desc, err = getTableDescByName(txn, table); err != nil {
+			return err
+		}
+		if column.ComputeExpr != "" {
+			return fmt.Errorf("table %q: cannot add computed column %q to an existing table",
+				table, column.Name)
+		}
+		colDesc = proto.ColumnDescriptor{Id: desc.NextColumnId, Column: column}
+		if value, err = convertValue(colDesc, defaultValue); err != nil {
+			return err
+		}
+		desc.Columns = append(desc.Columns, colDesc)
+		desc.NextColumnId++
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		if err := proto.ValidateTableDesc(desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err == errDryRun {
+		if value != nil {
+			prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+			o.addSpan(prefix, prefix.PrefixEnd())
+		}
+		return nil
+	}
+	if err != nil || value == nil {
+		return err
+	}
+	job, err := db.startSchemaJob(proto.SchemaJob{
+		Type:        proto.SchemaJob_COLUMN_BACKFILL,
+		TableId:     desc.Id,
+		Description: fmt.Sprintf("backfill column %q of table %q", column.Name, table),
+		ColumnId:    colDesc.Id,
+		ColumnValue: encodeCellValue(value),
+	})
+	if err != nil {
+		return err
+	}
+	return job.finish(db.backfillColumn(&desc, job))
+}
+
+// resumeColumnBackfill resumes the COLUMN_BACKFILL job adopted by
+// ResumeSchemaJob.
+func (db *DB) resumeColumnBackfill(job *schemaJob) error {
+	desc, _, err := db.getJobTable(job)
+	if err != nil {
+		return err
+	}
+	if _, ok := columnsByID(&desc)[job.job.ColumnId]; !ok {
+		return job.finish(fmt.Errorf("table %q: column %d does not exist", desc.Name, job.job.ColumnId))
+	}
+	return job.finish(db.backfillColumn(&desc, job))
+}
+
+// backfillColumn writes the job's column value to every row of the table
+// which does not have a value for the job's column, starting from the
+// job's resume key. The job records a checkpoint after each chunk.
+func (db *DB) backfillColumn(desc *proto.TableDescriptor, job *schemaJob) error {
+	total := job.job.Progress
+	return db.forEachPrimaryChunk(desc, job.job.ResumeKey, func(txn *Txn, kvs []KeyValue, next proto.Key) error {
+		var cellKeys []proto.Key
+		for _, kv := range kvs {
+			rowKey, _, id, err := decodeRowKey(desc, kv.Key)
+			if err != nil {
+				return err
+			}
+			if id == 0 {
+				cellKeys = append(cellKeys, makeCellKey(rowKey, job.job.ColumnId))
+			}
+		}
+		if len(cellKeys) == 0 {
+			return job.checkpoint(total, next)
+		}
+
+		// Rows written since the column was added may already have a value.
+		b := &Batch{}
+		for _, key := range cellKeys {
+			b.Get(key)
+		}
+		if err := txn.Run(b); err != nil {
+			return err
+		}
+		wb := &Batch{}
+		for i, result := range b.Results {
+			if !result.Rows[0].Exists() {
+				wb.Put(cellKeys[i], job.job.ColumnValue)
+			}
+		}
+		if err := txn.Commit(wb); err != nil {
+			return err
+		}
+		total += int64(len(cellKeys))
+		return job.checkpoint(total, next)
+	})
+}
+
+// forEachPrimaryChunk scans the keys of the primary index of the table,
+// from start if non-nil, in chunks of TableBackfillChunkSize keys, invoking
+// fn with each chunk and the start key of the next one from within a new
+// transaction. Only the keys of the chunks are read: the values passed to
+// fn are nil. The transactions run at LowUserPriority.
+func (db *DB) forEachPrimaryChunk(desc *proto.TableDescriptor, start proto.Key,
+	fn func(txn *Txn, kvs []KeyValue, next proto.Key) error) error {
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	end := prefix.PrefixEnd()
+	if start == nil {
+		start = prefix
+	}
+	for done := false; !done; {
+		var next proto.Key
+		err := db.background().Txn(func(txn *Txn) error {
+			kvs, err := txn.ScanKeys(start, end, TableBackfillChunkSize)
+			if err != nil {
+				return err
+			}
+			done = int64(len(kvs)) < TableBackfillChunkSize
+			if len(kvs) == 0 {
+				return nil
+			}
+			next = proto.Key(kvs[len(kvs)-1].Key).Next()
+			return fn(txn, kvs, next)
+		})
+		if err != nil {
+			return err
+		}
+		start = next
+	}
+	return nil
+}
+
+// scanRowChunks reads the rows of the table in chunks of
+// TableBackfillChunkSize keys, each in a new transaction, invoking fn with
+// the rows of each chunk outside of the transaction. A row is never split
+// across chunks. All of the chunks are read at the same fixed timestamp,
+// so the rows reflect a single consistent view of the table. It is
+// intended for operations with side effects which must not be repeated if
+// the transaction restarts.
+func (db *DB) scanRowChunks(desc *proto.TableDescriptor, fn func(rows []row) error) error {
+	readTime := time.Now()
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	for done := false; !done; {
+		var rows []row
+		var next proto.Key
+		err := db.background().Txn(func(txn *Txn) error {
+			txn.SetFixedTimestamp(readTime)
+			var err error
+			rows, _, next, done, err = readRowChunk(txn, desc, start, end)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			if err := fn(rows); err != nil {
+				return err
+			}
+		}
+		start = next
+	}
+	return nil
+}
+
+// readRowChunk reads the complete rows in the first chunk of
+// TableBackfillChunkSize keys of the primary index span [start, end). It
+// returns the rows, their sentinel keys, the start key of the next chunk
+// and whether the span has been exhausted.
+func readRowChunk(txn *Txn, desc *proto.TableDescriptor, start, end proto.Key) (
+	rows []row, rowKeys []proto.Key, next proto.Key, done bool, err error) {
+	kvs, err := txn.Scan(start, end, TableBackfillChunkSize)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+	done = int64(len(kvs)) < TableBackfillChunkSize
+	if rows, rowKeys, err = decodeRows(desc, kvs); err != nil {
+		return nil, nil, nil, false, err
+	}
+	if !done {
+		last := rowKeys[len(rowKeys)-1]
+		if len(rows) > 1 {
+			// The last row may be incomplete: leave it to the next chunk.
+			rows, rowKeys = rows[:len(rows)-1], rowKeys[:len(rowKeys)-1]
+			next = last
+		} else {
+			// The row fills the entire chunk: read all of it.
+			if kvs, err = txn.Scan(last, last.PrefixEnd(), 0); err != nil {
+				return nil, nil, nil, false, err
+			}
+			if rows, rowKeys, err = decodeRows(desc, kvs); err != nil {
+				return nil, nil, nil, false, err
+			}
+			next = last.PrefixEnd()
+		}
+	}
+	return rows, rowKeys, next, done, nil
+}
+
+// CreateIndex adds a secondary index to a table and backfills the index
+// entries of the existing rows. See CreateIndexWithProgress.
+func (db *DB) CreateIndex(table string, index proto.TableSchema_IndexByName, opts ...TableOption) error {
+	return db.CreateIndexWithProgress(table, index, nil, opts...)
+}
+
+// CreateIndexWithProgress adds a secondary index to a table, assigning it a
+// new index ID and incrementing the version of the table's descriptor, and
+// then backfills the index entries of the existing rows. Rows written once
+// the new version is published maintain their own index entries, so the
+// backfill does not block writers: it reads the table at a fixed timestamp
+// in chunks of TableBackfillChunkSize keys, at most TableBackfillRate rows
+// per second, and writes the entries of each chunk in its own transaction,
+// skipping rows which have been rewritten or deleted since the timestamp.
+// If progress is non-nil it is invoked after each chunk with the total
+// number of rows processed so far. The backfill is recorded as a schema job
+// (see SchemaJobs) along with the key at which it resumes if interrupted
+// (see ResumeSchemaJob). If the backfill fails, e.g. because the rows
+// violate the uniqueness of a unique index, the index is dropped again.
+// DryRunOpt is the only supported option.
+func (db *DB) CreateIndexWithProgress(table string, index proto.TableSchema_IndexByName,
+	progress func(rows int64), opts ...TableOption) error {
+	o := makeTableOptions(opts)
+	o.resetPlan()
+	var desc proto.TableDescriptor
+	var indexDesc proto.IndexDescriptor
+	err := db.schemaChangeTxn(table, func(txn *Txn) error {
+		var err error
+		if desc, err = getTableDescByName(txn, table); err != nil {
+			return err
+		}
+		indexDesc = proto.IndexDescriptor{
+			Id:       desc.NextIndexId,
+			Index:    index.Index,
+			KeyExprs: index.KeyExprs,
+		}
+		for _, name := range index.ColumnNames {
+			column, ok := findColumn(&desc, name)
+			if !ok {
+				return fmt.Errorf("table %q: index %q references unknown column %q",
+					table, index.Name, name)
+			}
+			indexDesc.ColumnIds = append(indexDesc.ColumnIds, column.Id)
+		}
+		desc.Indexes = append(desc.Indexes, indexDesc)
+		desc.NextIndexId++
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		if err := proto.ValidateTableDesc(desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err == errDryRun {
+		prefix := makeIndexPrefix(desc.Id, indexDesc.Id)
+		o.addSpan(prefix, prefix.PrefixEnd())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	job, err := db.startSchemaJob(proto.SchemaJob{
+		Type:        proto.SchemaJob_INDEX_BACKFILL,
+		TableId:     desc.Id,
+		Description: fmt.Sprintf("backfill index %q of table %q", index.Name, table),
+		IndexId:     indexDesc.Id,
+	})
+	if err != nil {
+		return err
+	}
+	return db.runIndexBackfill(table, &desc, indexDesc, job, progress)
+}
+
+// resumeIndexBackfill resumes the INDEX_BACKFILL job adopted by
+// ResumeSchemaJob.
+func (db *DB) resumeIndexBackfill(job *schemaJob) error {
+	desc, table, err := db.getJobTable(job)
+	if err != nil {
+		return err
+	}
+	for _, index := range desc.Indexes {
+		if index.Id == job.job.IndexId {
+			return db.runIndexBackfill(table, &desc, index, job, nil)
+		}
+	}
+	return job.finish(fmt.Errorf("table %q: index %d does not exist", desc.Name, job.job.IndexId))
+}
+
+// runIndexBackfill runs the backfill of an index recorded by job, dropping
+// the index again if the backfill fails or is canceled, and records the
+// outcome. The index is left in place if the job has been adopted by
+// another client.
+func (db *DB) runIndexBackfill(table string, desc *proto.TableDescriptor, index proto.IndexDescriptor,
+	job *schemaJob, progress func(rows int64)) error {
+	err := db.backfillIndex(desc, index, job, progress)
+	if _, ok := err.(*SchemaJobLeaseError); !ok && err != nil {
+		if dropErr := db.DropIndex(table, index.Name); dropErr != nil {
+			err = fmt.Errorf("%s; failed to drop index %q: %s", err, index.Name, dropErr)
+		}
+	}
+	return job.finish(err)
+}
+
+// backfillIndex writes the index entries of the rows of the table from the
+// job's resume key onward. Once no leases are held on versions of the
+// table's descriptor without the index, every writer maintains the index:
+// the rows are then read in chunks at a fixed timestamp, and each chunk's
+// entries are written in a separate transaction except for those of rows
+// rewritten or deleted since the timestamp. The job records the progress
+// and resume key of each chunk.
+func (db *DB) backfillIndex(desc *proto.TableDescriptor, index proto.IndexDescriptor,
+	job *schemaJob, progress func(rows int64)) error {
+	if err := db.waitForDescLeases(desc); err != nil {
+		return err
+	}
+	readTime := time.Now()
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	if job.job.ResumeKey != nil {
+		start = job.job.ResumeKey
+	}
+	started, total := time.Now(), job.job.Progress
+	var processed int64
+	for done := false; !done; {
+		var rows []row
+		var rowKeys []proto.Key
+		var next proto.Key
+		err := db.background().Txn(func(txn *Txn) error {
+			txn.SetFixedTimestamp(readTime)
+			var err error
+			rows, rowKeys, next, done, err = readRowChunk(txn, desc, start, end)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if len(rows) > 0 {
+			if err := db.background().Txn(func(txn *Txn) error {
+				return backfillIndexChunk(txn, desc, index, rows, rowKeys, readTime)
+			}); err != nil {
+				return err
+			}
+		}
+		start = next
+		processed += int64(len(rows))
+		total += int64(len(rows))
+		if err := job.checkpoint(total, next); err != nil {
+			return err
+		}
+		if progress != nil {
+			progress(total)
+		}
+		if TableBackfillRate > 0 {
+			wait := time.Duration(processed)*time.Second/time.Duration(TableBackfillRate) - time.Since(started)
+			if wait > 0 {
+				time.Sleep(wait)
+			}
+		}
+	}
+	return nil
+}
+
+// backfillIndexChunk writes the index entries of a chunk of rows read at
+// readTime, skipping the rows rewritten or deleted since then: their
+// writers maintained their index entries.
+func backfillIndexChunk(txn *Txn, desc *proto.TableDescriptor, index proto.IndexDescriptor,
+	rows []row, rowKeys []proto.Key, readTime time.Time) error {
+	sb := &Batch{}
+	for _, key := range rowKeys {
+		sb.Get(key)
+	}
+	if err := txn.Run(sb); err != nil {
+		return err
+	}
+	var entries []indexEntry
+	for i, r := range rows {
+		if sentinel := sb.Results[i].Rows[0]; !sentinel.Exists() || sentinel.Timestamp.After(readTime) {
+			continue
+		}
+		entry, ok, err := makeIndexEntry(desc, index, rowKeys[i], r)
+		if err != nil {
+			return err
+		}
+		if ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	// An existing entry of a unique index must belong to the same row: it
+	// was written by a concurrent writer or by a previous attempt.
+	b := &Batch{}
+	for _, entry := range entries {
+		putIndexEntry(b, index, entry)
+	}
+	return checkUniqueViolation(desc, b, txn.Commit(b))
+}
+
+// DropIndex removes a secondary index from a table, incrementing the
+// version of the table's descriptor, and then deletes the index's entries
+// once no leases are held on versions of the descriptor which include the
+// index, so that no writer adds entries after they have been deleted. The
+// primary index cannot be dropped. DryRunOpt is the only supported option.
+func (db *DB) DropIndex(table, index string, opts ...TableOption) error {
+	desc, prefix, err := db.dropIndex(table, index, makeTableOptions(opts))
+	if err == errDryRun {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return db.deleteIndexData(&desc, prefix)
+}
+
+// DropIndexAsync is like DropIndex, but waits for the leases on older
+// versions of the descriptor and deletes the index's entries in the
+// background once the index has been removed from the descriptor. The
+// result of the deletion is sent on the returned channel. Entries left
+// behind by a failed deletion are never read. For dry runs nil is sent on
+// the channel straight away.
+func (db *DB) DropIndexAsync(table, index string, opts ...TableOption) (<-chan error, error) {
+	desc, prefix, err := db.dropIndex(table, index, makeTableOptions(opts))
+	errCh := make(chan error, 1)
+	if err == errDryRun {
+		errCh <- nil
+		return errCh, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	go func() {
+		errCh <- db.deleteIndexData(&desc, prefix)
+	}()
+	return errCh, nil
+}
+
+// dropIndex removes a secondary index from a table's descriptor, returning
+// the new version of the descriptor and the key prefix of the index's
+// entries. For dry runs the index's entries are recorded in the plan and
+// errDryRun is returned.
+func (db *DB) dropIndex(table, index string, o tableOptions) (proto.TableDescriptor, proto.Key, error) {
+	o.resetPlan()
+	var desc proto.TableDescriptor
+	var indexID uint32
+	err := db.schemaChangeTxn(table, func(txn *Txn) error {
+		var err error
+		if desc, err = getTableDescByName(txn, table); err != nil {
+			return err
+		}
+		if index == desc.PrimaryIndex.Name {
+			return fmt.Errorf("table %q: cannot drop primary index %q", table, index)
+		}
+		indexID = 0
+		for i, other := range desc.Indexes {
+			if other.Name == index {
+				indexID = other.Id
+				desc.Indexes = append(desc.Indexes[:i:i], desc.Indexes[i+1:]...)
+				break
+			}
+		}
+		if indexID == 0 {
+			return fmt.Errorf("table %q: index %q does not exist", table, index)
+		}
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err != nil && err != errDryRun {
+		return desc, nil, err
+	}
+	prefix := makeIndexPrefix(desc.Id, indexID)
+	if err == errDryRun {
+		o.addSpan(prefix, prefix.PrefixEnd())
+	}
+	return desc, prefix, err
+}
+
+// deleteIndexData deletes the entries of an index dropped from desc, once
+// no leases are held on older versions of desc: their holders may still
+// write entries of the index.
+func (db *DB) deleteIndexData(desc *proto.TableDescriptor, prefix proto.Key) error {
+	if err := db.waitForDescLeases(desc); err != nil {
+		return err
+	}
+	return db.DelRange(prefix, prefix.PrefixEnd())
+}
+
+// DropColumn removes a column from a table and then, once no leases are
+// held on older versions of the table's descriptor, deletes the column's
+// values from every row, in chunks of TableBackfillChunkSize keys. Values
+// left behind, e.g. if the deletion fails part way, are ignored by readers.
+// Primary key columns cannot be dropped. An error is returned if the column
+// is referenced by a secondary index or a computed column; DropColumnCascade
+// drops such indexes and columns along with the column. DryRunOpt is the
+// only supported option.
+func (db *DB) DropColumn(table, column string, opts ...TableOption) error {
+	return db.dropColumn(table, column, false /* cascade */, makeTableOptions(opts))
+}
+
+// DropColumnCascade is like DropColumn, but also drops the secondary
+// indexes and computed columns which reference the column, as well as the
+// indexes referencing the dropped computed columns.
+func (db *DB) DropColumnCascade(table, column string, opts ...TableOption) error {
+	return db.dropColumn(table, column, true /* cascade */, makeTableOptions(opts))
+}
+
+func (db *DB) dropColumn(table, column string, cascade bool, o tableOptions) error {
+	o.resetPlan()
+	var desc proto.TableDescriptor
+	var droppedColumns, droppedIndexes []uint32
+	err := db.schemaChangeTxn(table, func(txn *Txn) error {
+		var err error
+		if desc, err = getTableDescByName(txn, table); err != nil {
+			return err
+		}
+		droppedColumns, droppedIndexes = nil, nil
+		col, ok := findColumn(&desc, column)
+		if !ok {
+			return &UnknownColumnError{Table: table, Column: column}
+		}
+		for _, id := range desc.PrimaryIndex.ColumnIds {
+			if id == col.Id {
+				return fmt.Errorf("table %q: cannot drop primary key column %q", table, column)
+			}
+		}
+
+		// Determine the computed columns and indexes depending on the column.
+		dropped := map[string]bool{column: true}
+		droppedColumns = append(droppedColumns, col.Id)
+		var columns []proto.ColumnDescriptor
+		for _, c := range desc.Columns {
+			if dropped[c.Name] {
+				continue
+			}
+			if c.ComputeExpr != "" && exprReferences(c.ComputeExpr, dropped) {
+				if !cascade {
+					return fmt.Errorf("table %q: column %q is referenced by computed column %q",
+						table, column, c.Name)
+				}
+				dropped[c.Name] = true
+				droppedColumns = append(droppedColumns, c.Id)
+				continue
+			}
+			columns = append(columns, c)
+		}
+		var indexes []proto.IndexDescriptor
+		for _, index := range desc.Indexes {
+			if indexReferences(&desc, index, dropped) {
+				if !cascade {
+					return fmt.Errorf("table %q: column %q is referenced by index %q",
+						table, column, index.Name)
+				}
+				droppedIndexes = append(droppedIndexes, index.Id)
+				continue
+			}
+			indexes = append(indexes, index)
+		}
+
+		desc.Columns = columns
+		desc.Indexes = indexes
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		if err := proto.ValidateTableDesc(desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err == errDryRun {
+		for _, id := range droppedIndexes {
+			prefix := makeIndexPrefix(desc.Id, id)
+			o.addSpan(prefix, prefix.PrefixEnd())
+		}
+		prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+		o.addSpan(prefix, prefix.PrefixEnd())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// Holders of leases on older versions may still write the dropped
+	// columns and indexes.
+	if err := db.waitForDescLeases(&desc); err != nil {
+		return err
+	}
+	for _, id := range droppedIndexes {
+		prefix := makeIndexPrefix(desc.Id, id)
+		if err := db.DelRange(prefix, prefix.PrefixEnd()); err != nil {
+			return err
+		}
+	}
+	return db.deleteColumnData(&desc, droppedColumns)
+}
+
+// deleteColumnData deletes the values of the columns with the given IDs
+// from every row of the table.
+func (db *DB) deleteColumnData(desc *proto.TableDescriptor, columnIDs []uint32) error {
+	ids := make(map[uint32]bool, len(columnIDs))
+	for _, id := range columnIDs {
+		ids[id] = true
+	}
+	return db.forEachPrimaryChunk(desc, nil, func(txn *Txn, kvs []KeyValue, _ proto.Key) error {
+		b := &Batch{}
+		for _, kv := range kvs {
+			_, _, id, err := decodeRowKey(desc, kv.Key)
+			if err != nil {
+				return err
+			}
+			if ids[id] {
+				b.Del(kv.Key)
+			}
+		}
+		return txn.Commit(b)
+	})
+}
+
+// exprReferences returns true if the expression references any of the
+// named columns. Unparsable expressions reference no columns.
+func exprReferences(expr string, names map[string]bool) bool {
+	e, err := proto.ParseExpr(expr)
+	if err != nil {
+		return false
+	}
+	for _, name := range proto.ExprColumns(e) {
+		if names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// indexReferences returns true if the index contains any of the named
+// columns, either directly or within one of its expressions.
+func indexReferences(desc *proto.TableDescriptor, index proto.IndexDescriptor, names map[string]bool) bool {
+	columns := columnsByID(desc)
+	for _, id := range index.ColumnIds {
+		if names[columns[id].Name] {
+			return true
+		}
+	}
+	for _, expr := range index.KeyExprs {
+		if exprReferences(expr, names) {
+			return true
+		}
+	}
+	return false
+}
+
+// RenameColumn renames a column of a table. Column values are stored under
+// the column's ID, making the rename a change to the table's descriptor
+// only. Computed column and index expressions referencing the column are
+// rewritten to use the new name. An error is returned if the table already
+// has a column named newName. DryRunOpt is the only supported option.
+func (db *DB) RenameColumn(table, oldName, newName string, opts ...TableOption) error {
+	o := makeTableOptions(opts)
+	o.resetPlan()
+	err := db.schemaChangeTxn(table, func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, table)
+		if err != nil {
+			return err
+		}
+		if _, ok := findColumn(&desc, oldName); !ok {
+			return &UnknownColumnError{Table: table, Column: oldName}
+		}
+		if _, ok := findColumn(&desc, newName); ok {
+			return fmt.Errorf("table %q: column %q already exists", table, newName)
+		}
+
+		renameExpr := func(expr string) (string, error) {
+			if !exprReferences(expr, map[string]bool{oldName: true}) {
+				return expr, nil
+			}
+			e, err := proto.ParseExpr(expr)
+			if err != nil {
+				return "", err
+			}
+			return proto.RenameExprColumn(e, oldName, newName).String(), nil
+		}
+		for i := range desc.Columns {
+			column := &desc.Columns[i]
+			if column.Name == oldName {
+				column.Name = newName
+			}
+			if column.ComputeExpr != "" {
+				if column.ComputeExpr, err = renameExpr(column.ComputeExpr); err != nil {
+					return err
+				}
+			}
+		}
+		for i := range desc.Indexes {
+			for j, expr := range desc.Indexes[i].KeyExprs {
+				if desc.Indexes[i].KeyExprs[j], err = renameExpr(expr); err != nil {
+					return err
+				}
+			}
+		}
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		if err := proto.ValidateTableDesc(desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return commitSchemaChange(txn, b, &desc, o)
+	})
+	if err == errDryRun {
+		return nil
+	}
+	return err
+}
+
+// SetTableComment sets the comment of a table, which is returned as part
+// of the table's schema by DescribeTable. An empty comment removes it.
+func (db *DB) SetTableComment(table, comment string) error {
+	return db.updateTableDesc(table, func(desc *proto.TableDescriptor) error {
+		desc.Comment = comment
+		return nil
+	})
+}
+
+// SetColumnComment sets the comment of a column, which is returned as part
+// of the table's schema by DescribeTable. An empty comment removes it.
+func (db *DB) SetColumnComment(table, column, comment string) error {
+	return db.updateTableDesc(table, func(desc *proto.TableDescriptor) error {
+		for i := range desc.Columns {
+			if desc.Columns[i].Name == column {
+				desc.Columns[i].Comment = comment
+				return nil
+			}
+		}
+		return &UnknownColumnError{Table: table, Column: column}
+	})
+}
+
+// updateTableDesc applies fn to the descriptor of a table, increments its
+// version and writes it back within a single transaction.
+func (db *DB) updateTableDesc(table string, fn func(*proto.TableDescriptor) error) error {
+	return db.schemaChangeTxn(table, func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, table)
+		if err != nil {
+			return err
+		}
+		if err := fn(&desc); err != nil {
+			return err
+		}
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		return txn.Commit(b)
+	})
+}
@@ -0,0 +1,660 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
+)
+
+func TestAddColumn(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "a"},
+		row{"id": 2},
+		row{"id": 3, "name": "c"},
+		row{"id": 4, "name": "d"})
+	before, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.AddColumn("users", proto.Column{Name: "age", Type: proto.Column_INT}, 18); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Version != before.Version+1 {
+		t.Errorf("expected version %d, but found %d", before.Version+1, desc.Version)
+	}
+	column, ok := findColumn(&desc, "age")
+	if !ok || column.Id != before.NextColumnId || desc.NextColumnId != column.Id+1 {
+		t.Errorf("unexpected column: %+v", desc)
+	}
+	expected := []row{
+		{"id": int64(1), "name": "a", "age": int64(18)},
+		{"id": int64(2), "age": int64(18)},
+		{"id": int64(3), "name": "c", "age": int64(18)},
+		{"id": int64(4), "name": "d", "age": int64(18)},
+	}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+
+	// Existing values are not overwritten by the backfill and columns added
+	// without a default are left empty.
+	putTestRows(t, db, "users", row{"id": 5, "age": 30})
+	if err := db.AddColumn("users", proto.Column{Name: "email", Type: proto.Column_STRING}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(row{"id": int64(5), "age": int64(30)}, rows[4]) {
+		t.Errorf("unexpected row: %v", rows[4])
+	}
+
+	testData := []struct {
+		table        string
+		column       proto.Column
+		defaultValue interface{}
+		err          string
+	}{
+		{"accounts", proto.Column{Name: "a", Type: proto.Column_INT}, nil,
+			`table "accounts" does not exist`},
+		{"users", proto.Column{Name: "name", Type: proto.Column_STRING}, nil,
+			`duplicate column name: "name"`},
+		{"users", proto.Column{Name: "a", Type: proto.Column_INT}, "a",
+			`column "a": cannot convert string to INT`},
+		{"users", proto.Column{Name: "a", Type: proto.Column_STRING, ComputeExpr: "lower(name)"}, nil,
+			`table "users": cannot add computed column "a" to an existing table`},
+	}
+	for i, d := range testData {
+		if err := db.AddColumn(d.table, d.column, d.defaultValue); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestDropColumn(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+
+	db, s := newMemDB()
+	// The IDs only satisfy validation: CreateTable assigns its own.
+	desc := proto.NewTableBuilder("users").ID(1).ParentID(1).
+		Column("id", proto.Column_INT).
+		Column("name", proto.Column_STRING).
+		Column("email", proto.Column_STRING).
+		ComputedColumn("lower_email", proto.Column_STRING, "lower(email)").
+		PrimaryKey("id").
+		Index("by_name", "name").
+		Index("by_lower_email", "lower_email").
+		MustBuild()
+	if err := db.CreateTable(proto.TableSchemaFromDesc(desc)); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "a", "email": "A@x"},
+		row{"id": 2, "name": "b", "email": "B@x"})
+
+	testData := []struct {
+		column string
+		err    string
+	}{
+		{"id", `table "users": cannot drop primary key column "id"`},
+		{"age", `table "users": column "age" does not exist`},
+		{"name", `table "users": column "name" is referenced by index "by_name"`},
+		{"email", `table "users": column "email" is referenced by computed column "lower_email"`},
+	}
+	for i, d := range testData {
+		if err := db.DropColumn("users", d.column); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+	if err := db.DropColumn("users", "age"); err == nil {
+		t.Errorf("expected an error")
+	} else if e, ok := err.(*UnknownColumnError); !ok || e.Table != "users" || e.Column != "age" {
+		t.Errorf("expected an *UnknownColumnError, but found %T", err)
+	}
+
+	// Dropping email cascades to lower_email and the index over it.
+	before, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropColumnCascade("users", "email"); err != nil {
+		t.Fatal(err)
+	}
+	after, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Version != before.Version+1 {
+		t.Errorf("expected version %d, but found %d", before.Version+1, after.Version)
+	}
+	schema := proto.TableSchemaFromDesc(after)
+	expected := proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+			{Index: proto.Index{Name: "by_name"}, ColumnNames: []string{"name"}},
+		},
+	}
+	if !reflect.DeepEqual(expected, schema) {
+		t.Errorf("expected %+v, but found %+v", expected, schema)
+	}
+	// Only the sentinel and name cell of each row remain.
+	prefix := makeIndexPrefix(after.Id, after.PrimaryIndex.Id)
+	if n := len(s.sortedKeys(prefix, prefix.PrefixEnd())); n != 4 {
+		t.Errorf("expected 4 keys, but found %d", n)
+	}
+	expectedRows := []row{
+		{"id": int64(1), "name": "a"},
+		{"id": int64(2), "name": "b"},
+	}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expectedRows, rows) {
+		t.Errorf("expected %v, but found %v", expectedRows, rows)
+	}
+
+	// The values of name and the entries of by_name are only deleted once
+	// no leases are held on older versions of the descriptor.
+	byName := makeIndexPrefix(after.Id, after.Indexes[0].Id)
+	lease, err := db.AcquireTableLease("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- db.DropColumnCascade("users", "name")
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected the deletion to wait for the lease, but found %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if n := len(s.sortedKeys(prefix, prefix.PrefixEnd())); n != 4 {
+		t.Errorf("expected 4 keys while the lease is held, but found %d", n)
+	}
+	if n := len(s.sortedKeys(byName, byName.PrefixEnd())); n != 2 {
+		t.Errorf("expected 2 index entries while the lease is held, but found %d", n)
+	}
+	if err := db.ReleaseTableLease(lease); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if n := len(s.sortedKeys(prefix, prefix.PrefixEnd())); n != 2 {
+		t.Errorf("expected 2 keys, but found %d", n)
+	}
+	if n := len(s.sortedKeys(byName, byName.PrefixEnd())); n != 0 {
+		t.Errorf("expected the index to be empty, but found %d entries", n)
+	}
+	if after, err = db.DescribeTableDesc("users"); err != nil {
+		t.Fatal(err)
+	}
+	if len(after.Columns) != 1 || len(after.Indexes) != 0 {
+		t.Errorf("unexpected descriptor: %+v", after)
+	}
+}
+
+func TestRenameColumn(t *testing.T) {
+	db, _ := newMemDB()
+	// The IDs only satisfy validation: CreateTable assigns its own.
+	desc := proto.NewTableBuilder("users").ID(1).ParentID(1).
+		Column("id", proto.Column_INT).
+		Column("name", proto.Column_STRING).
+		ComputedColumn("lower_name", proto.Column_STRING, "lower(name)").
+		PrimaryKey("id").
+		Index("by_name", "name").
+		ExprIndex("by_upper_name", "upper(name)").
+		MustBuild()
+	if err := db.CreateTable(proto.TableSchemaFromDesc(desc)); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "Alice"})
+
+	before, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RenameColumn("users", "name", "full_name"); err != nil {
+		t.Fatal(err)
+	}
+	after, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Version != before.Version+1 {
+		t.Errorf("expected version %d, but found %d", before.Version+1, after.Version)
+	}
+	column, ok := findColumn(&after, "full_name")
+	if !ok || column.Id != 2 {
+		t.Errorf("unexpected descriptor: %+v", after)
+	}
+	if expr := after.Columns[2].ComputeExpr; expr != "lower(full_name)" {
+		t.Errorf("unexpected compute expression: %s", expr)
+	}
+	if exprs := after.Indexes[1].KeyExprs; !reflect.DeepEqual([]string{"upper(full_name)"}, exprs) {
+		t.Errorf("unexpected index expressions: %s", exprs)
+	}
+	// The existing row is visible under the new name.
+	expected := []row{{"id": int64(1), "full_name": "Alice", "lower_name": "alice"}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+
+	testData := []struct {
+		oldName, newName string
+		err              string
+	}{
+		{"name", "n", `table "users": column "name" does not exist`},
+		{"id", "lower_name", `table "users": column "lower_name" already exists`},
+		{"id", "", "empty column name"},
+	}
+	for i, d := range testData {
+		if err := db.RenameColumn("users", d.oldName, d.newName); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+// scanIndex returns the keys of the named index with the index prefix
+// stripped.
+func scanIndex(t *testing.T, db *DB, table, index string) []string {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, other := range desc.Indexes {
+		if other.Name != index {
+			continue
+		}
+		prefix := makeIndexPrefix(desc.Id, other.Id)
+		kvs, err := db.Scan(prefix, prefix.PrefixEnd(), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var keys []string
+		for _, kv := range kvs {
+			keys = append(keys, string(kv.Key[len(prefix):]))
+		}
+		return keys
+	}
+	t.Fatalf("index %q not found", index)
+	return nil
+}
+
+func TestCreateIndex(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "b"},
+		row{"id": 2, "name": "a"},
+		row{"id": 3},
+		row{"id": 4, "name": "a"})
+
+	var progress []int64
+	index := proto.TableSchema_IndexByName{
+		Index:       proto.Index{Name: "by_name_id"},
+		ColumnNames: []string{"name"},
+	}
+	if err := db.CreateIndexWithProgress("users", index, func(rows int64) {
+		progress = append(progress, rows)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Every full chunk of 3 keys defers its possibly incomplete last row to
+	// the next chunk, leaving a single row per chunk.
+	if expected := []int64{1, 2, 3, 4}; !reflect.DeepEqual(expected, progress) {
+		t.Errorf("expected progress %v, but found %v", expected, progress)
+	}
+	entry := func(name string, id int64) string {
+		return string(encodeKeyValue(encodeKeyValue(nil, name), id))
+	}
+	expected := []string{entry("a", 2), entry("a", 4), entry("b", 1)}
+	if keys := scanIndex(t, db, "users", "by_name_id"); !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %q, but found %q", expected, keys)
+	}
+
+	// Rows written after the index was created are indexed as well.
+	putTestRows(t, db, "users", row{"id": 5, "name": "c"})
+	expected = append(expected, entry("c", 5))
+	if keys := scanIndex(t, db, "users", "by_name_id"); !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %q, but found %q", expected, keys)
+	}
+
+	// A unique index cannot be created over duplicate values and is dropped
+	// again.
+	index = proto.TableSchema_IndexByName{
+		Index:       proto.Index{Name: "by_name_unique", Unique: true},
+		ColumnNames: []string{"name"},
+	}
+	if err := db.CreateIndex("users", index); err == nil ||
+		err.Error() != `duplicate key value violates unique index "by_name_unique"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// Expression indexes evaluate their expressions.
+	index = proto.TableSchema_IndexByName{
+		Index:    proto.Index{Name: "by_upper_name", Unique: true},
+		KeyExprs: []string{"upper(name)"},
+	}
+	putTestRows(t, db, "users", row{"id": 4, "name": "d"})
+	if err := db.CreateIndex("users", index); err != nil {
+		t.Fatal(err)
+	}
+	if keys := scanIndex(t, db, "users", "by_upper_name"); len(keys) != 4 ||
+		keys[0] != string(encodeKeyValue(nil, "A")) {
+		t.Errorf("unexpected index keys: %q", keys)
+	}
+
+	// Rows cannot be written with duplicate values of a unique index.
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Batch{}
+	if err := putRow(b, &desc, row{"id": 6, "name": "c"}); err != nil {
+		t.Fatal(err)
+	}
+	err = checkUniqueViolation(&desc, b, db.Run(b))
+	if _, ok := err.(*UniqueViolationError); !ok ||
+		err.Error() != `duplicate key value violates unique index "by_upper_name"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	schema, err := db.DescribeTable("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(schema.Indexes); n != 4 {
+		t.Errorf("expected 4 indexes, but found %d", n)
+	}
+
+	testData := []struct {
+		index proto.TableSchema_IndexByName
+		err   string
+	}{
+		{proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_name"}, ColumnNames: []string{"id"}},
+			`duplicate index name: "by_name"`},
+		{proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_age"}, ColumnNames: []string{"age"}},
+			`table "users": index "by_age" references unknown column "age"`},
+	}
+	for i, d := range testData {
+		if err := db.CreateIndex("users", d.index); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestResumeIndexBackfill(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+	defer func(d time.Duration) { SchemaJobLeaseDuration = d }(SchemaJobLeaseDuration)
+	SchemaJobLeaseDuration = 50 * time.Millisecond
+
+	db, s := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "b"},
+		row{"id": 2, "name": "a"},
+		row{"id": 3},
+		row{"id": 4, "name": "a"})
+
+	// The client dies after the second chunk, leaving the job RUNNING.
+	var dead bool
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		if dead {
+			call.Reply.Header().SetGoError(errors.New("dead"))
+			return
+		}
+		s.Send(ctx, call)
+	})
+	index := proto.TableSchema_IndexByName{
+		Index:       proto.Index{Name: "by_name_id"},
+		ColumnNames: []string{"name"},
+	}
+	if err := db.CreateIndexWithProgress("users", index, func(rows int64) {
+		dead = rows >= 2
+	}); err == nil {
+		t.Fatal("expected the backfill to fail")
+	}
+	dead = false
+	jobs, err := db.SchemaJobs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(jobs) != 1 || jobs[0].Status != proto.SchemaJob_RUNNING || jobs[0].Progress != 2 ||
+		jobs[0].ResumeKey == nil {
+		t.Fatalf("unexpected jobs %+v", jobs)
+	}
+
+	// The job can only be resumed once its lease expires.
+	if err := db.ResumeSchemaJob(jobs[0].Id); err == nil {
+		t.Fatal("expected an error resuming a leased job")
+	} else if _, ok := err.(*SchemaJobLeaseError); !ok {
+		t.Fatalf("expected a SchemaJobLeaseError, but found %T: %s", err, err)
+	}
+	time.Sleep(SchemaJobLeaseDuration)
+
+	defer func(n int64) { TableBackfillRate = n }(TableBackfillRate)
+	TableBackfillRate = 100
+	start := time.Now()
+	if err := db.ResumeSchemaJob(jobs[0].Id); err != nil {
+		t.Fatal(err)
+	}
+	// The remaining 2 rows are processed at 100 rows per second.
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("expected the backfill to be rate limited, but it took %s", elapsed)
+	}
+	entry := func(name string, id int64) string {
+		return string(encodeKeyValue(encodeKeyValue(nil, name), id))
+	}
+	expected := []string{entry("a", 2), entry("a", 4), entry("b", 1)}
+	if keys := scanIndex(t, db, "users", "by_name_id"); !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %q, but found %q", expected, keys)
+	}
+	job, err := db.WaitForSchemaJob(jobs[0].Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if job.Progress != 4 {
+		t.Errorf("expected 4 rows to be processed, but found %d", job.Progress)
+	}
+	if err := db.ResumeSchemaJob(job.Id); err == nil {
+		t.Error("expected an error resuming a finished job")
+	}
+}
+
+func TestDropIndex(t *testing.T) {
+	db, s := newMemDB()
+	schema := testSchema("users")
+	schema.Indexes = append(schema.Indexes, proto.TableSchema_IndexByName{
+		Index:    proto.Index{Name: "by_upper_name"},
+		KeyExprs: []string{"upper(name)"},
+	})
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"}, row{"id": 2, "name": "b"})
+	before, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	countEntries := func(indexID uint32) int {
+		prefix := makeIndexPrefix(before.Id, indexID)
+		return len(s.sortedKeys(prefix, prefix.PrefixEnd()))
+	}
+	for _, index := range before.Indexes {
+		if n := countEntries(index.Id); n != 2 {
+			t.Fatalf("expected 2 entries in index %q, but found %d", index.Name, n)
+		}
+	}
+
+	if err := db.DropIndex("users", "by_name"); err != nil {
+		t.Fatal(err)
+	}
+	errCh, err := db.DropIndexAsync("users", "by_upper_name")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+	after, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(after.Indexes) != 0 || after.Version != before.Version+2 {
+		t.Errorf("unexpected descriptor: %+v", after)
+	}
+	for _, index := range before.Indexes {
+		if n := countEntries(index.Id); n != 0 {
+			t.Errorf("expected index %q to be empty, but found %d entries", index.Name, n)
+		}
+	}
+	if rows := scanTestRows(t, db, "users"); len(rows) != 2 {
+		t.Errorf("expected 2 rows, but found %d", len(rows))
+	}
+
+	// The entries are only deleted once no leases are held on versions of
+	// the descriptor including the index.
+	if err := db.CreateIndex("users", proto.TableSchema_IndexByName{
+		Index:       proto.Index{Name: "by_name"},
+		ColumnNames: []string{"name"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	leased, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	lease, err := db.AcquireTableLease("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- db.DropIndex("users", "by_name")
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected the deletion to wait for the lease, but found %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if n := countEntries(leased.Indexes[0].Id); n != 2 {
+		t.Errorf("expected 2 entries while the lease is held, but found %d", n)
+	}
+	if err := db.ReleaseTableLease(lease); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if n := countEntries(leased.Indexes[0].Id); n != 0 {
+		t.Errorf("expected the index to be empty, but found %d entries", n)
+	}
+
+	testData := []struct {
+		index string
+		err   string
+	}{
+		{"primary", `table "users": cannot drop primary index "primary"`},
+		{"by_name", `table "users": index "by_name" does not exist`},
+	}
+	for i, d := range testData {
+		if err := db.DropIndex("users", d.index); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestTableComments(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetTableComment("users", "registered users"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetColumnComment("users", "name", "display name"); err != nil {
+		t.Fatal(err)
+	}
+	schema, err := db.DescribeTable("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.Comment != "registered users" {
+		t.Errorf("expected table comment \"registered users\", but found %q", schema.Comment)
+	}
+	for _, column := range schema.Columns {
+		expected := ""
+		if column.Name == "name" {
+			expected = "display name"
+		}
+		if column.Comment != expected {
+			t.Errorf("column %q: expected comment %q, but found %q", column.Name, expected, column.Comment)
+		}
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Version != 2 {
+		t.Errorf("expected version 2, but found %d", desc.Version)
+	}
+
+	// Comments survive renames.
+	if err := db.RenameColumn("users", "name", "full_name"); err != nil {
+		t.Fatal(err)
+	}
+	if desc, err = db.DescribeTableDesc("users"); err != nil {
+		t.Fatal(err)
+	}
+	if column, _ := findColumn(&desc, "full_name"); column.Comment != "display name" {
+		t.Errorf("expected comment \"display name\", but found %q", column.Comment)
+	}
+
+	if err := db.SetTableComment("accounts", ""); err == nil || err.Error() != `table "accounts" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.SetColumnComment("users", "name", ""); err == nil ||
+		err.Error() != `table "users": column "name" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// SchemaChangeKind describes a change planned by PlanSchemaChanges.
+type SchemaChangeKind int
+
+const (
+	// CreateDatabaseChange creates the database of a table.
+	CreateDatabaseChange SchemaChangeKind = iota
+	// CreateTableChange creates a table.
+	CreateTableChange
+	// AddColumnChange adds a column to a table.
+	AddColumnChange
+	// CreateIndexChange adds a secondary index to a table.
+	CreateIndexChange
+)
+
+func (k SchemaChangeKind) String() string {
+	switch k {
+	case CreateDatabaseChange:
+		return "create database"
+	case CreateTableChange:
+		return "create table"
+	case AddColumnChange:
+		return "add column"
+	case CreateIndexChange:
+		return "create index"
+	}
+	return fmt.Sprintf("SchemaChangeKind(%d)", int(k))
+}
+
+// A SchemaChange is a change which brings the schema of the cluster in
+// line with a table schema. Depending on its kind, the change creates
+// Database, creates the table described by Schema, or adds Column or
+// Index to Table.
+type SchemaChange struct {
+	Kind     SchemaChangeKind
+	Database string
+	Table    string
+	Schema   proto.TableSchema
+	Column   proto.Column
+	Index    proto.TableSchema_IndexByName
+}
+
+func (c SchemaChange) String() string {
+	switch c.Kind {
+	case CreateDatabaseChange:
+		return fmt.Sprintf("%s %q", c.Kind, c.Database)
+	case CreateTableChange:
+		return fmt.Sprintf("%s %q", c.Kind, c.Table)
+	case AddColumnChange:
+		return fmt.Sprintf("%s %q to table %q: %s", c.Kind, c.Column.Name, c.Table, c.Column.Type)
+	case CreateIndexChange:
+		parts := c.Index.ColumnNames
+		if len(c.Index.KeyExprs) > 0 {
+			parts = c.Index.KeyExprs
+		}
+		var unique string
+		if c.Index.Unique {
+			unique = "unique "
+		}
+		return fmt.Sprintf("%s %q on table %q: %s(%s)", c.Kind, c.Index.Name, c.Table,
+			unique, strings.Join(parts, ", "))
+	}
+	return c.Kind.String()
+}
+
+// PlanSchemaChanges diffs the table schemas against the current
+// descriptors of the tables and returns the changes which would bring the
+// tables in line with the schemas, in the order in which they can be
+// applied: the missing databases are created first, then the missing
+// tables, then the missing columns of existing tables and finally their
+// missing secondary indexes, which may refer to the added columns. Each
+// group is ordered by table name. Columns and indexes of existing tables
+// which are absent from the schemas are left in place. An error is
+// returned if a schema is invalid or changes an existing column, index or
+// primary key, none of which can be altered.
+func (db *DB) PlanSchemaChanges(schemas []proto.TableSchema) ([]SchemaChange, error) {
+	sorted := append([]proto.TableSchema(nil), schemas...)
+	sort.Sort(tableSchemasByName(sorted))
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].Name == sorted[i-1].Name {
+			return nil, fmt.Errorf("duplicate schema for table %q", sorted[i].Name)
+		}
+	}
+
+	var changes []SchemaChange
+	err := db.Txn(func(txn *Txn) error {
+		changes = nil
+		var databases, tables, columns, indexes []SchemaChange
+		planned := map[string]bool{}
+		for _, schema := range sorted {
+			dbName, tableName, err := splitTableName(schema.Name, db.defaultDatabase())
+			if err != nil {
+				return err
+			}
+			if err := validateTableSchema(schema, tableName); err != nil {
+				return fmt.Errorf("table %q: %s", schema.Name, err)
+			}
+			var desc proto.TableDescriptor
+			var exists bool
+			if dbName != DefaultDatabaseName {
+				r, err := txn.Get(keys.MakeNamespaceMetadataKey(dbName))
+				if err != nil {
+					return err
+				}
+				if !r.Exists() {
+					if !planned[dbName] {
+						planned[dbName] = true
+						databases = append(databases, SchemaChange{Kind: CreateDatabaseChange, Database: dbName})
+					}
+					tables = append(tables, SchemaChange{Kind: CreateTableChange, Table: schema.Name, Schema: schema})
+					continue
+				}
+				dbID := decodeDescID(r.ValueBytes())
+				if desc, exists, err = getTableDesc(txn, dbID, tableName); err != nil {
+					return err
+				}
+			} else if desc, exists, err = getTableDesc(txn, keys.DefaultDatabaseID, tableName); err != nil {
+				return err
+			}
+			if !exists {
+				tables = append(tables, SchemaChange{Kind: CreateTableChange, Table: schema.Name, Schema: schema})
+				continue
+			}
+			if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+				return err
+			}
+			c, i, err := diffTableSchema(schema, proto.TableSchemaFromDesc(desc))
+			if err != nil {
+				return fmt.Errorf("table %q: %s", schema.Name, err)
+			}
+			columns = append(columns, c...)
+			indexes = append(indexes, i...)
+		}
+		for _, group := range [][]SchemaChange{databases, tables, columns, indexes} {
+			changes = append(changes, group...)
+		}
+		return nil
+	})
+	return changes, err
+}
+
+// validateTableSchema validates the schema of the named table as
+// CreateTable would.
+func validateTableSchema(schema proto.TableSchema, tableName string) error {
+	schema.Name = tableName
+	desc, err := proto.TableDescFromSchema(schema)
+	if err != nil {
+		return err
+	}
+	desc.Id = keys.MaxReservedDescID + 1
+	desc.ParentId = keys.DefaultDatabaseID
+	desc.Privileges = proto.NewDefaultPrivilegeDescriptor()
+	return proto.ValidateTableDesc(desc)
+}
+
+// diffTableSchema returns the columns and secondary indexes of schema
+// which are missing from current, the schema of the existing table.
+func diffTableSchema(schema, current proto.TableSchema) ([]SchemaChange, []SchemaChange, error) {
+	if !reflect.DeepEqual(indexDefinition(schema.Indexes[0]), indexDefinition(current.Indexes[0])) {
+		return nil, nil, fmt.Errorf("cannot change primary key %q", current.Indexes[0].Name)
+	}
+	var columns, indexes []SchemaChange
+	for _, column := range schema.Columns {
+		var existing *proto.Column
+		for i := range current.Columns {
+			if current.Columns[i].Name == column.Name {
+				existing = &current.Columns[i]
+				break
+			}
+		}
+		switch {
+		case existing == nil && column.ComputeExpr != "":
+			return nil, nil, fmt.Errorf("cannot add computed column %q to an existing table", column.Name)
+		case existing == nil:
+			columns = append(columns, SchemaChange{Kind: AddColumnChange, Table: schema.Name, Column: column})
+		case existing.Type != column.Type || existing.ComputeExpr != column.ComputeExpr:
+			return nil, nil, fmt.Errorf("cannot change column %q", column.Name)
+		}
+	}
+	for _, index := range schema.Indexes[1:] {
+		var existing *proto.TableSchema_IndexByName
+		for i := range current.Indexes {
+			if current.Indexes[i].Name == index.Name {
+				existing = &current.Indexes[i]
+				break
+			}
+		}
+		if existing == nil {
+			indexes = append(indexes, SchemaChange{Kind: CreateIndexChange, Table: schema.Name, Index: index})
+		} else if !reflect.DeepEqual(indexDefinition(index), indexDefinition(*existing)) {
+			return nil, nil, fmt.Errorf("cannot change index %q", index.Name)
+		}
+	}
+	return columns, indexes, nil
+}
+
+// indexDefinition returns the parts of an index which determine its
+// entries, with empty and nil lists made equal.
+func indexDefinition(index proto.TableSchema_IndexByName) []interface{} {
+	return []interface{}{index.Name, index.Unique,
+		append([]string{}, index.ColumnNames...), append([]string{}, index.KeyExprs...)}
+}
+
+// ApplySchemaChanges applies changes, as returned by PlanSchemaChanges, in
+// order. Each change is applied in its own transaction; added columns are
+// NULL in the existing rows and added indexes are backfilled. An error
+// stops the application, leaving the preceding changes applied.
+func (db *DB) ApplySchemaChanges(changes []SchemaChange) error {
+	for _, c := range changes {
+		var err error
+		switch c.Kind {
+		case CreateDatabaseChange:
+			err = db.CreateDatabase(c.Database)
+		case CreateTableChange:
+			err = db.CreateTable(c.Schema)
+		case AddColumnChange:
+			err = db.AddColumn(c.Table, c.Column, nil)
+		case CreateIndexChange:
+			err = db.CreateIndex(c.Table, c.Index)
+		default:
+			err = fmt.Errorf("unknown schema change %s", c.Kind)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %s", c, err)
+		}
+	}
+	return nil
+}
+
+type tableSchemasByName []proto.TableSchema
+
+func (s tableSchemasByName) Len() int           { return len(s) }
+func (s tableSchemasByName) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s tableSchemasByName) Less(i, j int) bool { return s[i].Name < s[j].Name }
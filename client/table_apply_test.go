@@ -0,0 +1,107 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestPlanAndApplySchemaChanges(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"})
+
+	users := testSchema("users")
+	users.Columns = append(users.Columns, proto.Column{Name: "email", Type: proto.Column_STRING})
+	users.Indexes = append(users.Indexes, proto.TableSchema_IndexByName{
+		Index: proto.Index{Name: "by_email", Unique: true}, ColumnNames: []string{"email"}})
+	schemas := []proto.TableSchema{users, testSchema("app.orders"), testSchema("accounts")}
+
+	changes, err := db.PlanSchemaChanges(schemas)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{
+		`create database "app"`,
+		`create table "accounts"`,
+		`create table "app.orders"`,
+		`add column "email" to table "users": STRING`,
+		`create index "by_email" on table "users": unique (email)`,
+	}
+	if s := fmt.Sprint(changes); s != fmt.Sprint(expected) {
+		t.Errorf("expected %s, but found %s", expected, s)
+	}
+	if err := db.ApplySchemaChanges(changes); err != nil {
+		t.Fatal(err)
+	}
+	for _, schema := range schemas {
+		if s, err := db.DescribeTable(schema.Name); err != nil {
+			t.Error(err)
+		} else if len(s.Columns) != len(schema.Columns) || len(s.Indexes) != len(schema.Indexes) {
+			t.Errorf("expected %s to be applied, but found %+v", schema.Name, s)
+		}
+	}
+	expectedRows := []row{{"id": int64(1), "name": "a"}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expectedRows, rows) {
+		t.Errorf("expected %v, but found %v", expectedRows, rows)
+	}
+
+	// Applying the schemas again is a no-op, and columns and indexes missing
+	// from the schemas are left in place.
+	if changes, err := db.PlanSchemaChanges(schemas); err != nil {
+		t.Fatal(err)
+	} else if len(changes) != 0 {
+		t.Errorf("expected no changes, but found %s", changes)
+	}
+	if changes, err := db.PlanSchemaChanges([]proto.TableSchema{testSchema("users")}); err != nil {
+		t.Fatal(err)
+	} else if len(changes) != 0 {
+		t.Errorf("expected no changes, but found %s", changes)
+	}
+}
+
+func TestPlanSchemaChangesErrors(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []func(*proto.TableSchema){
+		func(s *proto.TableSchema) { s.Columns[1].Type = proto.Column_BYTES },
+		func(s *proto.TableSchema) { s.Indexes[0].ColumnNames = []string{"name"} },
+		func(s *proto.TableSchema) { s.Indexes[1].Unique = true },
+		func(s *proto.TableSchema) {
+			s.Columns = append(s.Columns, proto.Column{Name: "n", Type: proto.Column_STRING, ComputeExpr: "lower(name)"})
+		},
+		func(s *proto.TableSchema) { s.Indexes = nil },
+		func(s *proto.TableSchema) { s.Name = "a.b.c" },
+	}
+	for i, modify := range testCases {
+		schema := testSchema("users")
+		modify(&schema)
+		if _, err := db.PlanSchemaChanges([]proto.TableSchema{schema}); err == nil {
+			t.Errorf("%d: expected planning %+v to fail", i, schema)
+		}
+	}
+	if _, err := db.PlanSchemaChanges([]proto.TableSchema{testSchema("t"), testSchema("t")}); err == nil {
+		t.Errorf("expected duplicate schemas to fail")
+	}
+}
@@ -0,0 +1,612 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A table backup is laid out as follows:
+//
+//   <backupMagic> <format version: uint32>
+//   <frame: descriptor>
+//   <frame: timestamps>
+//   <frame: keys | deletes>*
+//   <frame: end>
+//
+// Each frame is encoded as:
+//
+//   <type: uint8> <payload length: uint32> <payload> <CRC-32-IEEE of type + payload: uint32>
+//
+// The descriptor frame holds the marshaled proto.TableDescriptor. The
+// timestamps frame holds the timestamp of the previous backup the backup
+// was taken since, which is zero for full backups, followed by the
+// timestamp at which the backup was taken, each encoded as its wall time
+// and logical component. Each keys frame holds a sequence of key/value
+// pairs, each encoded as a length-prefixed key relative to the table
+// prefix followed by a length-prefixed marshaled proto.Value. Each deletes
+// frame, which only incremental backups hold, holds a sequence of
+// length-prefixed keys relative to the table prefix. The end frame holds
+// the total number of keys, allowing truncated backups to be detected.
+// Integers are big-endian; lengths and timestamps within payloads are
+// uvarints.
+//
+// Backups of format version 1 have no timestamps frame; they are restored
+// as full backups, but incremental backups cannot be applied to them.
+
+const (
+	backupMagic         = "CRDBTABLEBACKUP\n"
+	backupFormatVersion = 2
+)
+
+// Backup frame types.
+const (
+	backupDescriptorFrame byte = iota + 1
+	backupKeysFrame
+	backupEndFrame
+	backupTimestampsFrame
+	backupDeletesFrame
+)
+
+// TableBackupChunkSize is the maximum number of keys scanned at once and
+// written to a single frame of a table backup.
+var TableBackupChunkSize int64 = 1000
+
+// A BackupOption configures a table backup.
+type BackupOption func(*backupOptions)
+
+type backupOptions struct {
+	since proto.Timestamp
+}
+
+// BackupSinceOpt writes an incremental backup holding only the keys
+// written or deleted since the timestamp of a previous backup of the
+// table, as returned by BackupTable. The changes are read from the
+// versions of the keys held by the stores, so the previous backup must be
+// more recent than the GC TTL of the table's zone.
+func BackupSinceOpt(since proto.Timestamp) BackupOption {
+	return func(o *backupOptions) {
+		o.since = since
+	}
+}
+
+// BackupTable writes the descriptor and all of the data of the named table,
+// including its secondary indexes, to w, returning the timestamp at which
+// the data was read. The data is scanned in chunks of TableBackupChunkSize
+// keys, each of which is written as a checksummed frame. Every chunk is
+// read at the timestamp of the first one, so the backup is a consistent
+// snapshot of the table. Use RestoreTable to read the backup.
+//
+// With BackupSinceOpt, only the latest values of the keys written since
+// the given timestamp, and the keys deleted since then, are written. Such
+// incremental backups chain: each is taken since the timestamp returned
+// by the backup preceding it and RestoreTable applies them in sequence on
+// top of the full backup which starts the chain.
+//
+//   ts, err := db.BackupTable("users", full)
+//   ...
+//   ts, err = db.BackupTable("users", incr1, client.BackupSinceOpt(ts))
+//   ...
+//   err = db.RestoreTable(full, client.RestoreIncrementsOpt(incr1, ...))
+func (db *DB) BackupTable(name string, w io.Writer, opts ...BackupOption) (proto.Timestamp, error) {
+	var o backupOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return proto.ZeroTimestamp, err
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(backupMagic); err != nil {
+		return proto.ZeroTimestamp, err
+	}
+	if err := binary.Write(bw, binary.BigEndian, uint32(backupFormatVersion)); err != nil {
+		return proto.ZeroTimestamp, err
+	}
+	descBytes, err := gogoproto.Marshal(&desc)
+	if err != nil {
+		return proto.ZeroTimestamp, err
+	}
+	if err := writeBackupFrame(bw, backupDescriptorFrame, descBytes); err != nil {
+		return proto.ZeroTimestamp, err
+	}
+
+	prefix := keys.MakeTablePrefix(desc.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	// The first chunk is timestamped by the cluster; the following ones
+	// are read at the same timestamp.
+	var now proto.Timestamp
+	var count uint64
+	for first := true; ; first = false {
+		var chunk backupChunk
+		if o.since.Equal(proto.ZeroTimestamp) {
+			chunk, err = db.scanBackupChunk(start, end, now)
+		} else {
+			chunk, err = db.scanBackupChanges(start, end, o.since, now)
+		}
+		if err != nil {
+			return proto.ZeroTimestamp, err
+		}
+		if first {
+			now = chunk.timestamp
+			var payload []byte
+			for _, ts := range []proto.Timestamp{o.since, now} {
+				payload = encoding.EncodeUvarint(payload, uint64(ts.WallTime))
+				payload = encoding.EncodeUvarint(payload, uint64(ts.Logical))
+			}
+			if err := writeBackupFrame(bw, backupTimestampsFrame, payload); err != nil {
+				return proto.ZeroTimestamp, err
+			}
+		}
+		if len(chunk.values) > 0 {
+			var payload []byte
+			for _, kv := range chunk.values {
+				valueBytes, err := gogoproto.Marshal(&kv.Value)
+				if err != nil {
+					return proto.ZeroTimestamp, err
+				}
+				payload = encodeBackupKey(payload, prefix, kv.Key)
+				payload = encoding.EncodeUvarint(payload, uint64(len(valueBytes)))
+				payload = append(payload, valueBytes...)
+			}
+			if err := writeBackupFrame(bw, backupKeysFrame, payload); err != nil {
+				return proto.ZeroTimestamp, err
+			}
+		}
+		if len(chunk.deletes) > 0 {
+			var payload []byte
+			for _, key := range chunk.deletes {
+				payload = encodeBackupKey(payload, prefix, key)
+			}
+			if err := writeBackupFrame(bw, backupDeletesFrame, payload); err != nil {
+				return proto.ZeroTimestamp, err
+			}
+		}
+		count += uint64(len(chunk.values) + len(chunk.deletes))
+		if chunk.n < TableBackupChunkSize {
+			break
+		}
+		start = chunk.last.Next()
+	}
+	if err := writeBackupFrame(bw, backupEndFrame, encoding.EncodeUvarint(nil, count)); err != nil {
+		return proto.ZeroTimestamp, err
+	}
+	return now, bw.Flush()
+}
+
+// A backupChunk holds the keys read by a single request of a backup.
+type backupChunk struct {
+	timestamp proto.Timestamp  // the timestamp at which the keys were read
+	values    []proto.KeyValue // the keys written, with their latest values
+	deletes   []proto.Key      // the keys deleted
+	n         int64            // the number of results of the request
+	last      proto.Key        // the last key read
+}
+
+// scanBackupChunk scans the values of the keys in [start, end) at the
+// given timestamp or, if it is zero, at the current time.
+func (db *DB) scanBackupChunk(start, end proto.Key, timestamp proto.Timestamp) (backupChunk, error) {
+	args := &proto.ScanRequest{
+		RequestHeader: proto.RequestHeader{Key: start, EndKey: end, Timestamp: timestamp},
+		MaxResults:    TableBackupChunkSize,
+	}
+	reply := &proto.ScanResponse{}
+	b := &Batch{}
+	b.InternalAddCall(Call{Args: args, Reply: reply})
+	if err := db.background().Run(b); err != nil {
+		return backupChunk{}, err
+	}
+	chunk := backupChunk{timestamp: reply.Timestamp, n: int64(len(reply.Rows))}
+	for _, kv := range reply.Rows {
+		chunk.values = append(chunk.values, proto.KeyValue{Key: kv.Key, Value: backupValue(kv.Value)})
+		chunk.last = kv.Key
+	}
+	return chunk, nil
+}
+
+// scanBackupChanges scans the changes of the keys in [start, end)
+// committed after since and at or before the given timestamp or, if it
+// is zero, the current time. Only the latest change of each key is kept.
+func (db *DB) scanBackupChanges(start, end proto.Key, since, timestamp proto.Timestamp) (backupChunk, error) {
+	args := &proto.ScanChangesRequest{
+		RequestHeader:  proto.RequestHeader{Key: start, EndKey: end, Timestamp: timestamp},
+		StartTimestamp: since,
+		MaxResults:     TableBackupChunkSize,
+	}
+	reply := &proto.ScanChangesResponse{}
+	b := &Batch{}
+	b.InternalAddCall(Call{Args: args, Reply: reply})
+	if err := db.background().Run(b); err != nil {
+		return backupChunk{}, err
+	}
+	chunk := backupChunk{timestamp: reply.Timestamp, n: int64(len(reply.Changes))}
+	// The changes of each key are ordered by descending timestamp.
+	for _, change := range reply.Changes {
+		if change.Key.Equal(chunk.last) {
+			continue
+		}
+		if change.Deleted {
+			chunk.deletes = append(chunk.deletes, change.Key)
+		} else {
+			chunk.values = append(chunk.values, proto.KeyValue{Key: change.Key, Value: backupValue(change.Value)})
+		}
+		chunk.last = change.Key
+	}
+	return chunk, nil
+}
+
+// backupValue returns the bytes or integer of a value read from the
+// stores, without its timestamp and checksum.
+func backupValue(v proto.Value) proto.Value {
+	return proto.Value{Bytes: v.Bytes, Integer: v.Integer}
+}
+
+// encodeBackupKey appends key, relative to the table prefix, to b as a
+// length-prefixed byte slice.
+func encodeBackupKey(b []byte, prefix, key proto.Key) []byte {
+	b = encoding.EncodeUvarint(b, uint64(len(key)-len(prefix)))
+	return append(b, key[len(prefix):]...)
+}
+
+// A RestoreOption configures the restoration of a table backup.
+type RestoreOption func(*restoreOptions)
+
+type restoreOptions struct {
+	name       string
+	increments []io.Reader
+}
+
+// RestoreNameOpt restores a table under the given, possibly database
+// qualified, name instead of the name recorded in the backup.
+func RestoreNameOpt(name string) RestoreOption {
+	return func(o *restoreOptions) {
+		o.name = name
+	}
+}
+
+// RestoreIncrementsOpt applies incremental backups written by BackupTable
+// with BackupSinceOpt, in the given order, after the full backup. Each
+// must have been taken since the backup preceding it. The table is
+// restored with the descriptor recorded in the last backup.
+func RestoreIncrementsOpt(increments ...io.Reader) RestoreOption {
+	return func(o *restoreOptions) {
+		o.increments = increments
+	}
+}
+
+// A backupHeader holds the descriptor and timestamps which precede the
+// data of a backup.
+type backupHeader struct {
+	desc      proto.TableDescriptor
+	since     proto.Timestamp
+	timestamp proto.Timestamp
+}
+
+// RestoreTable recreates a table from a backup written by BackupTable,
+// followed by the incremental backups given with RestoreIncrementsOpt.
+// The table is restored under the name recorded in the backup, resolved
+// within the database set by SetDatabase, unless RestoreNameOpt is given.
+// A new table ID is allocated, the keys of the backups are rewritten for
+// it and each frame of the backups is written in a single batch after its
+// checksum has been verified. The table only becomes visible once all of
+// its data has been written; on error, the data written so far is
+// deleted. A *TableExistsError is returned if the table already exists.
+func (db *DB) RestoreTable(r io.Reader, opts ...RestoreOption) error {
+	var o restoreOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	readers := []*bufio.Reader{bufio.NewReader(r)}
+	for _, r := range o.increments {
+		readers = append(readers, bufio.NewReader(r))
+	}
+	var headers []backupHeader
+	for i, br := range readers {
+		h, err := readBackupHeader(br)
+		if err != nil {
+			return err
+		}
+		if i == 0 {
+			if !h.since.Equal(proto.ZeroTimestamp) {
+				return fmt.Errorf("cannot restore an incremental backup without the backups preceding it")
+			}
+		} else if prev := headers[i-1]; h.desc.Id != prev.desc.Id {
+			return fmt.Errorf("incremental backup %d is not a backup of table %q", i, prev.desc.Name)
+		} else if h.since.Equal(proto.ZeroTimestamp) || !h.since.Equal(prev.timestamp) {
+			return fmt.Errorf("incremental backup %d was not taken since the backup preceding it", i)
+		}
+		headers = append(headers, h)
+	}
+	desc := headers[len(headers)-1].desc
+
+	name := o.name
+	if name == "" {
+		name = desc.Name
+	}
+	dbName, tableName, err := splitTableName(name, db.defaultDatabase())
+	if err != nil {
+		return err
+	}
+	var dbDesc proto.DatabaseDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		if dbDesc, err = getDatabaseDesc(txn, dbName); err != nil {
+			return err
+		}
+		if _, ok, err := getTableDesc(txn, dbDesc.Id, tableName); err != nil {
+			return err
+		} else if ok {
+			return &TableExistsError{Name: name}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if desc.Id, err = db.allocateDescID(); err != nil {
+		return err
+	}
+	desc.Name = tableName
+	desc.ParentId = dbDesc.Id
+	desc.DropTime = 0
+	if err := proto.ValidateTableDesc(desc); err != nil {
+		return err
+	}
+
+	for i, br := range readers {
+		if err := db.restoreTableData(br, desc.Id, i > 0); err != nil {
+			if delErr := db.deleteTableData(desc.Id, nil); delErr != nil {
+				return fmt.Errorf("%s; additionally, the partially restored data could not be deleted: %s",
+					err, delErr)
+			}
+			return err
+		}
+	}
+	err = db.Txn(func(txn *Txn) error {
+		b := &Batch{}
+		b.CPut(keys.MakeTableMetadataKey(dbDesc.Id, tableName), encodeDescID(desc.Id), nil)
+		b.CPut(keys.MakeDescMetadataKey(desc.Id), &desc, nil)
+		return txn.Commit(b)
+	})
+	if err != nil {
+		if _, ok := err.(*proto.ConditionFailedError); ok {
+			err = &TableExistsError{Name: name}
+		}
+		if delErr := db.deleteTableData(desc.Id, nil); delErr != nil {
+			return fmt.Errorf("%s; additionally, the restored data could not be deleted: %s", err, delErr)
+		}
+	}
+	return err
+}
+
+// readBackupHeader reads the format version, descriptor and timestamps of
+// a backup.
+func readBackupHeader(r io.Reader) (backupHeader, error) {
+	var h backupHeader
+	magic := make([]byte, len(backupMagic))
+	if _, err := io.ReadFull(r, magic); err != nil || string(magic) != backupMagic {
+		return h, fmt.Errorf("not a table backup")
+	}
+	var version uint32
+	if err := binary.Read(r, binary.BigEndian, &version); err != nil {
+		return h, fmt.Errorf("not a table backup")
+	}
+	if version != 1 && version != backupFormatVersion {
+		return h, fmt.Errorf("unsupported backup format version %d", version)
+	}
+	typ, payload, err := readBackupFrame(r)
+	if err != nil {
+		return h, err
+	}
+	if typ != backupDescriptorFrame {
+		return h, fmt.Errorf("expected backup descriptor frame, but found frame type %d", typ)
+	}
+	if err := gogoproto.Unmarshal(payload, &h.desc); err != nil {
+		return h, err
+	}
+	if _, err := proto.MaybeUpgradeTableDescriptor(&h.desc); err != nil {
+		return h, err
+	}
+	if version == 1 {
+		return h, nil
+	}
+
+	if typ, payload, err = readBackupFrame(r); err != nil {
+		return h, err
+	}
+	if typ != backupTimestampsFrame {
+		return h, fmt.Errorf("expected backup timestamps frame, but found frame type %d", typ)
+	}
+	for _, ts := range []*proto.Timestamp{&h.since, &h.timestamp} {
+		var wallTime, logical uint64
+		if payload, wallTime, err = decodeUvarintSafe(payload); err == nil {
+			payload, logical, err = decodeUvarintSafe(payload)
+		}
+		if err != nil {
+			return h, fmt.Errorf("backup is corrupt: malformed timestamps frame")
+		}
+		*ts = proto.Timestamp{WallTime: int64(wallTime), Logical: int32(logical)}
+	}
+	return h, nil
+}
+
+// restoreTableData writes the keys and deletes frames of a backup, which
+// must be followed by its end frame, under the prefix of the table with
+// the given ID. The keys of a full backup are ingested in order, while
+// each frame of an incremental backup is written in a single batch.
+func (db *DB) restoreTableData(r io.Reader, tableID uint32, incremental bool) error {
+	prefix := keys.MakeTablePrefix(tableID)
+	in, err := db.NewIngester(prefix, prefix.PrefixEnd())
+	if err != nil {
+		return err
+	}
+	var count uint64
+	for {
+		typ, payload, err := readBackupFrame(r)
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return fmt.Errorf("backup is truncated")
+		} else if err != nil {
+			return err
+		}
+		switch {
+		case typ == backupKeysFrame:
+		case typ == backupDeletesFrame && incremental:
+		case typ == backupEndFrame:
+			if _, n, err := decodeUvarintSafe(payload); err != nil {
+				return fmt.Errorf("backup is corrupt: malformed end frame")
+			} else if n != count {
+				return fmt.Errorf("backup is corrupt: expected %d keys, but found %d", n, count)
+			}
+			return in.Flush()
+		default:
+			return fmt.Errorf("unexpected backup frame type %d", typ)
+		}
+
+		b := &Batch{}
+		for len(payload) > 0 {
+			var keyBytes, valueBytes []byte
+			if payload, keyBytes, err = decodeBackupBytes(payload); err != nil {
+				return err
+			}
+			key := append(append(proto.Key(nil), prefix...), keyBytes...)
+			count++
+			if typ == backupDeletesFrame {
+				b.Del(key)
+				continue
+			}
+			if payload, valueBytes, err = decodeBackupBytes(payload); err != nil {
+				return err
+			}
+			var value proto.Value
+			if err := gogoproto.Unmarshal(valueBytes, &value); err != nil {
+				return err
+			}
+			if incremental {
+				putProtoValue(b, key, value)
+			} else if err := in.Add(key, value); err != nil {
+				return err
+			}
+		}
+		if len(b.calls) > 0 {
+			if err := db.Run(b); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// decodeBackupBytes decodes a length-prefixed byte slice, returning the
+// remainder of b and the byte slice.
+func decodeBackupBytes(b []byte) ([]byte, []byte, error) {
+	b, n, err := decodeUvarintSafe(b)
+	if err != nil || uint64(len(b)) < n {
+		return nil, nil, fmt.Errorf("backup is corrupt: malformed key frame")
+	}
+	return b[n:], b[:n], nil
+}
+
+// decodeUvarintSafe is like encoding.DecodeUvarint, but returns an error
+// instead of panicking on malformed input.
+func decodeUvarintSafe(b []byte) (rest []byte, v uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	rest, v = encoding.DecodeUvarint(b)
+	return rest, v, nil
+}
+
+// makeProtoValue returns the value of kv, which must have been read by a
+// Get or Scan.
+func makeProtoValue(kv KeyValue) proto.Value {
+	var value proto.Value
+	switch t := kv.Value.(type) {
+	case []byte:
+		value.Bytes = t
+	case *int64:
+		value.Integer = t
+	}
+	return value
+}
+
+// putProtoValue adds a put of value, which may be either a byte slice or an
+// integer value, to the batch.
+func putProtoValue(b *Batch, key proto.Key, value proto.Value) {
+	value.InitChecksum(key)
+	b.InternalAddCall(Call{
+		Args: &proto.PutRequest{
+			RequestHeader: proto.RequestHeader{Key: key},
+			Value:         value,
+		},
+		Reply: &proto.PutResponse{},
+	})
+}
+
+// writeBackupFrame writes a frame of the given type holding payload.
+func writeBackupFrame(w io.Writer, typ byte, payload []byte) error {
+	header := make([]byte, 5)
+	header[0] = typ
+	binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+	crc := crc32.NewIEEE()
+	crc.Write(header[:1])
+	crc.Write(payload)
+	for _, b := range [][]byte{header, payload} {
+		if _, err := w.Write(b); err != nil {
+			return err
+		}
+	}
+	return binary.Write(w, binary.BigEndian, crc.Sum32())
+}
+
+// readBackupFrame reads a frame written by writeBackupFrame, verifying its
+// checksum.
+func readBackupFrame(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, 5)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+	payload := make([]byte, binary.BigEndian.Uint32(header[1:]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	var checksum uint32
+	if err := binary.Read(r, binary.BigEndian, &checksum); err != nil {
+		return 0, nil, err
+	}
+	crc := crc32.NewIEEE()
+	crc.Write(header[:1])
+	crc.Write(payload)
+	if crc.Sum32() != checksum {
+		return 0, nil, fmt.Errorf("backup frame checksum mismatch: expected %08x, but found %08x",
+			checksum, crc.Sum32())
+	}
+	return header[0], payload, nil
+}
@@ -0,0 +1,347 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+func TestBackupTable(t *testing.T) {
+	db, s := newMemDB()
+
+	defer func(n int64) { TableBackupChunkSize = n }(TableBackupChunkSize)
+	TableBackupChunkSize = 2
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": int64(1), "name": "alice"},
+		row{"id": int64(2), "name": "bob"},
+		row{"id": int64(3), "name": "carl"})
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	ts, err := db.BackupTable("users", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := (proto.Timestamp{WallTime: 3}); !ts.Equal(expected) {
+		t.Errorf("expected backup timestamp %s, but found %s", expected, ts)
+	}
+	if !strings.HasPrefix(buf.String(), backupMagic) {
+		t.Fatalf("expected backup to start with %q", backupMagic)
+	}
+	buf.Next(len(backupMagic))
+	if v := binary.BigEndian.Uint32(buf.Next(4)); v != backupFormatVersion {
+		t.Errorf("expected format version %d, but found %d", backupFormatVersion, v)
+	}
+
+	typ, payload, err := readBackupFrame(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var backupDesc proto.TableDescriptor
+	if typ != backupDescriptorFrame {
+		t.Fatalf("expected descriptor frame, but found %d", typ)
+	} else if err := gogoproto.Unmarshal(payload, &backupDesc); err != nil {
+		t.Fatal(err)
+	} else if !reflect.DeepEqual(desc, backupDesc) {
+		t.Errorf("expected %+v, but found %+v", desc, backupDesc)
+	}
+
+	// A full backup is taken since the zero timestamp.
+	if typ, payload, err = readBackupFrame(&buf); err != nil {
+		t.Fatal(err)
+	} else if typ != backupTimestampsFrame {
+		t.Fatalf("expected timestamps frame, but found %d", typ)
+	} else if expected := encodeUvarints(0, 0, 3, 0); !bytes.Equal(expected, payload) {
+		t.Errorf("expected timestamps %x, but found %x", expected, payload)
+	}
+
+	var frames, count int
+	for {
+		typ, payload, err := readBackupFrame(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if typ == backupEndFrame {
+			if _, n := encoding.DecodeUvarint(payload); int(n) != count {
+				t.Errorf("expected end frame to record %d keys, but found %d", count, n)
+			}
+			break
+		}
+		frames++
+		for len(payload) > 0 {
+			for i := 0; i < 2; i++ {
+				var n uint64
+				payload, n = encoding.DecodeUvarint(payload)
+				payload = payload[n:]
+			}
+			count++
+		}
+	}
+	// The backup covers the secondary index entries as well as the rows.
+	if expected := countTableKeys(s, desc.Id); count != expected || frames != (expected+1)/2 {
+		t.Errorf("expected %d keys in %d frames, but found %d in %d", expected, (expected+1)/2, count, frames)
+	}
+	if buf.Len() != 0 {
+		t.Errorf("expected end of backup, but found %d bytes", buf.Len())
+	}
+
+	if _, err := db.BackupTable("missing", &buf); err == nil || err.Error() != `table "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+// encodeUvarints returns the concatenated encodings of the integers.
+func encodeUvarints(vs ...uint64) []byte {
+	var b []byte
+	for _, v := range vs {
+		b = encoding.EncodeUvarint(b, v)
+	}
+	return b
+}
+
+func TestBackupFrameChecksum(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeBackupFrame(&buf, backupKeysFrame, []byte("payload")); err != nil {
+		t.Fatal(err)
+	}
+	b := buf.Bytes()
+	b[len(b)-5] ^= 1
+	if _, _, err := readBackupFrame(&buf); err == nil ||
+		!strings.HasPrefix(err.Error(), "backup frame checksum mismatch") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRestoreTable(t *testing.T) {
+	db, s := newMemDB()
+
+	defer func(n int64) { TableBackupChunkSize = n }(TableBackupChunkSize)
+	TableBackupChunkSize = 2
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": int64(1), "name": "alice"},
+		row{"id": int64(2), "name": "bob"},
+		row{"id": int64(3), "name": "carl"})
+	var buf bytes.Buffer
+	if _, err := db.BackupTable("users", &buf); err != nil {
+		t.Fatal(err)
+	}
+	backup := buf.Bytes()
+
+	if err := db.RestoreTable(bytes.NewReader(backup)); err == nil || err.Error() != `table "users" already exists` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.CreateDatabase("archive"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RestoreTable(bytes.NewReader(backup), RestoreNameOpt("archive.users")); err != nil {
+		t.Fatal(err)
+	}
+	orig, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored, err := db.DescribeTableDesc("archive.users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.Id == orig.Id {
+		t.Errorf("expected restored table to have a new ID")
+	}
+	if expected, rows := scanTestRows(t, db, "users"), scanTestRows(t, db, "archive.users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	if expected, keys := scanIndex(t, db, "users", "by_name"), scanIndex(t, db, "archive.users", "by_name"); !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %q, but found %q", expected, keys)
+	}
+
+	// Damaged backups are rejected and leave no data behind.
+	before := len(s.data)
+	corrupt := append([]byte(nil), backup...)
+	corrupt[len(corrupt)-20] ^= 1
+	testData := []struct {
+		backup []byte
+		err    string
+	}{
+		{[]byte("garbage"), "not a table backup"},
+		{backup[:len(backup)-15], "backup is truncated"},
+		{corrupt, "backup frame checksum mismatch"},
+	}
+	for i, d := range testData {
+		err := db.RestoreTable(bytes.NewReader(d.backup), RestoreNameOpt("damaged"))
+		if err == nil || !strings.HasPrefix(err.Error(), d.err) {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+	// The partially restored data has been deleted.
+	if len(s.data) != before {
+		t.Errorf("expected %d keys, but found %d", before, len(s.data))
+	}
+	if _, err := db.DescribeTable("damaged"); err == nil {
+		t.Errorf("expected damaged table not to be restored")
+	}
+}
+
+func TestRestoreIncrementalBackups(t *testing.T) {
+	db, _ := newMemDB()
+
+	defer func(n int64) { TableBackupChunkSize = n }(TableBackupChunkSize)
+	TableBackupChunkSize = 2
+
+	backup := func(opts ...BackupOption) ([]byte, proto.Timestamp) {
+		var buf bytes.Buffer
+		ts, err := db.BackupTable("users", &buf, opts...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf.Bytes(), ts
+	}
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": int64(1), "name": "alice"},
+		row{"id": int64(2), "name": "bob"},
+		row{"id": int64(3), "name": "carl"})
+	full, ts0 := backup()
+
+	putTestRows(t, db, "users",
+		row{"id": int64(1), "name": "anne"},
+		row{"id": int64(4), "name": "dave"})
+	deleteTestRow(t, db, "users", 2)
+	incr1, ts1 := backup(BackupSinceOpt(ts0))
+	if !ts0.Less(ts1) {
+		t.Errorf("expected backup timestamp after %s, but found %s", ts0, ts1)
+	}
+
+	putTestRows(t, db, "users", row{"id": int64(5), "name": "eve"})
+	incr2, _ := backup(BackupSinceOpt(ts1))
+
+	// The incremental backups hold only the keys changed since the backup
+	// preceding them.
+	if len(incr2) >= len(full) {
+		t.Errorf("expected incremental backup to be smaller than the full backup")
+	}
+
+	if err := db.CreateDatabase("archive"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RestoreTable(bytes.NewReader(full), RestoreNameOpt("archive.users"),
+		RestoreIncrementsOpt(bytes.NewReader(incr1), bytes.NewReader(incr2))); err != nil {
+		t.Fatal(err)
+	}
+	if expected, rows := scanTestRows(t, db, "users"), scanTestRows(t, db, "archive.users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	if expected, keys := scanIndex(t, db, "users", "by_name"), scanIndex(t, db, "archive.users", "by_name"); !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %q, but found %q", expected, keys)
+	}
+
+	// The incremental backups must be applied in sequence after the full
+	// backup.
+	testData := []struct {
+		backup     []byte
+		increments [][]byte
+		err        string
+	}{
+		{incr1, nil, "cannot restore an incremental backup"},
+		{full, [][]byte{incr2}, "incremental backup 1 was not taken since"},
+		{full, [][]byte{incr1, incr1}, "incremental backup 2 was not taken since"},
+		{full, [][]byte{full}, "incremental backup 1 was not taken since"},
+	}
+	for i, d := range testData {
+		var increments []io.Reader
+		for _, b := range d.increments {
+			increments = append(increments, bytes.NewReader(b))
+		}
+		err := db.RestoreTable(bytes.NewReader(d.backup), RestoreNameOpt("broken"), RestoreIncrementsOpt(increments...))
+		if err == nil || !strings.HasPrefix(err.Error(), d.err) {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+// TestRestoreTableFormatVersion1 verifies that backups without timestamps
+// are restored as full backups.
+func TestRestoreTableFormatVersion1(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": int64(1), "name": "alice"})
+	var buf bytes.Buffer
+	ts, err := db.BackupTable("users", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Rewrite the backup in format version 1.
+	buf.Next(len(backupMagic) + 4)
+	typ, descBytes, err := readBackupFrame(&buf)
+	if err != nil || typ != backupDescriptorFrame {
+		t.Fatalf("unexpected frame %d: %v", typ, err)
+	}
+	if typ, _, err = readBackupFrame(&buf); err != nil || typ != backupTimestampsFrame {
+		t.Fatalf("unexpected frame %d: %v", typ, err)
+	}
+	var v1 bytes.Buffer
+	v1.WriteString(backupMagic)
+	if err := binary.Write(&v1, binary.BigEndian, uint32(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeBackupFrame(&v1, backupDescriptorFrame, descBytes); err != nil {
+		t.Fatal(err)
+	}
+	v1.Write(buf.Bytes())
+	backup := v1.Bytes()
+
+	if err := db.RestoreTable(bytes.NewReader(backup), RestoreNameOpt("restored")); err != nil {
+		t.Fatal(err)
+	}
+	if expected, rows := scanTestRows(t, db, "users"), scanTestRows(t, db, "restored"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+
+	// Incremental backups cannot be applied to it.
+	var incr bytes.Buffer
+	if _, err := db.BackupTable("users", &incr, BackupSinceOpt(ts)); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RestoreTable(bytes.NewReader(backup), RestoreNameOpt("broken"),
+		RestoreIncrementsOpt(&incr)); err == nil || !strings.HasPrefix(err.Error(), "incremental backup 1 was not taken since") {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
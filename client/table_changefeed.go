@@ -0,0 +1,317 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// ChangefeedPollInterval is the interval at which a changefeed reads the
+// changes committed to its table since it last read them.
+var ChangefeedPollInterval = 1 * time.Second
+
+// ChangefeedChunkSize is the maximum number of versions read by a single
+// ScanChanges request of a changefeed.
+var ChangefeedChunkSize int64 = 1000
+
+// A ChangefeedCursor is a position in the changes of a table, which are
+// ordered by their timestamps and, for each timestamp, by the primary
+// keys of the changed rows. The changes committed before Timestamp, and
+// those committed at Timestamp to the rows whose sentinel keys sort at or
+// before Key, precede the position. An empty Key places the position
+// after all of the changes committed at Timestamp.
+type ChangefeedCursor struct {
+	Timestamp proto.Timestamp
+	Key       proto.Key
+}
+
+// A ChangefeedEvent is delivered by a Changefeed for each committed change
+// of a row of its table, and after each read of the table's changes as a
+// checkpoint.
+type ChangefeedEvent struct {
+	// Row holds the values of the primary key columns of the changed row
+	// and of the columns written by the change. Columns set to NULL map to
+	// nil. Row is nil for checkpoints.
+	Row map[string]interface{}
+	// Deleted is true if the change deleted the row, in which case Row
+	// holds only the values of its primary key columns.
+	Deleted bool
+	// Timestamp is the timestamp at which the change was committed. For
+	// checkpoints, all of the changes committed at or before Timestamp
+	// have been delivered.
+	Timestamp proto.Timestamp
+	// Cursor is the position following the event. A changefeed resumed
+	// at the cursor with DB.ResumeChangefeed delivers the events which
+	// follow this one.
+	Cursor ChangefeedCursor
+}
+
+// A Changefeed delivers the changes committed to the rows of a table, in
+// the order of their timestamps, on the channel returned by Events. It is
+// created by DB.Changefeed or DB.ResumeChangefeed and must be closed with
+// Close.
+//
+// A changefeed is not a stream pushed by the servers: the RPC transport
+// only carries unary calls, so the client polls for the changes instead,
+// and they are delivered up to ChangefeedPollInterval after they commit.
+// The changes are read every ChangefeedPollInterval with ScanChanges
+// requests, which return the versions written to the primary index of the
+// table within the interval since the previous read. Every version written
+// within the interval is committed by the time it is read: the stores
+// resolve the intents of pending transactions within the interval and
+// push the commit timestamps of later writes past its end.
+//
+// The stores hold the versions of rows until they are garbage collected,
+// so a changefeed cannot be resumed further behind than the GC TTL of the
+// table's zone: the stores refuse to scan changes since a timestamp before
+// which versions may have been garbage collected, and the changefeed fails
+// rather than skipping changes.
+type Changefeed struct {
+	db     *DB
+	name   string
+	id     uint32
+	cursor ChangefeedCursor
+	events chan ChangefeedEvent
+	stop   chan struct{}
+	done   chan struct{}
+	once   sync.Once
+
+	mu  sync.Mutex // Protects err
+	err error
+}
+
+// Changefeed returns a changefeed delivering the changes committed to the
+// named table after the given timestamp. A zero timestamp delivers all of
+// the changes whose versions have not yet been garbage collected.
+//
+//   feed, err := db.Changefeed("users", proto.ZeroTimestamp)
+//   ...
+//   for event := range feed.Events() {
+//     ...
+//   }
+func (db *DB) Changefeed(table string, from proto.Timestamp) (*Changefeed, error) {
+	return db.ResumeChangefeed(table, ChangefeedCursor{Timestamp: from})
+}
+
+// ResumeChangefeed returns a changefeed delivering the changes committed
+// to the named table which follow the cursor of a previously delivered
+// event.
+func (db *DB) ResumeChangefeed(table string, cursor ChangefeedCursor) (*Changefeed, error) {
+	f, err := db.newChangefeed(table, cursor)
+	if err != nil {
+		return nil, err
+	}
+	go f.run()
+	return f, nil
+}
+
+func (db *DB) newChangefeed(table string, cursor ChangefeedCursor) (*Changefeed, error) {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, table)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return &Changefeed{
+		db:     db,
+		name:   table,
+		id:     desc.Id,
+		cursor: cursor,
+		events: make(chan ChangefeedEvent),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}, nil
+}
+
+// Events returns the channel on which the events of the changefeed are
+// delivered. The channel is closed when the changefeed is closed or
+// fails, after which Err returns the error which caused the failure.
+func (f *Changefeed) Events() <-chan ChangefeedEvent {
+	return f.events
+}
+
+// Err returns the error which caused the changefeed to fail, if any. The
+// changefeed fails if its table is dropped or the changes of the table
+// cannot be read, e.g. because they have been garbage collected.
+func (f *Changefeed) Err() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.err
+}
+
+// Close stops the changefeed, returning the error which caused it to
+// fail, if any. Events which have not been received are discarded.
+func (f *Changefeed) Close() error {
+	f.once.Do(func() {
+		close(f.stop)
+	})
+	<-f.done
+	return f.Err()
+}
+
+// run reads the changes of the table every ChangefeedPollInterval and
+// delivers them until the changefeed is closed or fails.
+func (f *Changefeed) run() {
+	defer close(f.done)
+	defer close(f.events)
+	ticker := time.NewTicker(ChangefeedPollInterval)
+	defer ticker.Stop()
+	for {
+		events, err := f.poll()
+		if err != nil {
+			f.mu.Lock()
+			f.err = err
+			f.mu.Unlock()
+			return
+		}
+		for _, event := range events {
+			select {
+			case f.events <- event:
+			case <-f.stop:
+				return
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-f.stop:
+			return
+		}
+	}
+}
+
+// poll reads the changes of the table which follow the cursor of the
+// changefeed, returning their events followed by a checkpoint, and
+// advances the cursor past them.
+func (f *Changefeed) poll() ([]ChangefeedEvent, error) {
+	// The descriptor is reread so that the columns added since the
+	// previous read are decoded.
+	var desc proto.TableDescriptor
+	if err := f.db.GetProto(keys.MakeDescMetadataKey(f.id), &desc); err != nil {
+		return nil, err
+	}
+	if desc.Id != f.id || desc.DropTime != 0 {
+		return nil, &TableNotFoundError{Name: f.name}
+	}
+	if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+		return nil, err
+	}
+
+	from := f.cursor.Timestamp
+	if len(f.cursor.Key) > 0 {
+		from = from.Prev()
+	}
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	// The first request is timestamped by the cluster; the following ones
+	// read the same interval.
+	var now proto.Timestamp
+	var changes []proto.KeyValueChange
+	for {
+		args := &proto.ScanChangesRequest{
+			RequestHeader:  proto.RequestHeader{Key: start, EndKey: end, Timestamp: now},
+			StartTimestamp: from,
+			MaxResults:     ChangefeedChunkSize,
+		}
+		reply := &proto.ScanChangesResponse{}
+		b := &Batch{}
+		b.InternalAddCall(Call{Args: args, Reply: reply})
+		if err := f.db.Run(b); err != nil {
+			return nil, err
+		}
+		now = reply.Timestamp
+		changes = append(changes, reply.Changes...)
+		if int64(len(reply.Changes)) < ChangefeedChunkSize {
+			break
+		}
+		start = reply.Changes[len(reply.Changes)-1].Key.Next()
+	}
+	if !f.cursor.Timestamp.Less(now) {
+		return nil, nil
+	}
+
+	events, err := makeChangefeedEvents(&desc, changes, f.cursor)
+	if err != nil {
+		return nil, err
+	}
+	f.cursor = ChangefeedCursor{Timestamp: now}
+	return append(events, ChangefeedEvent{Timestamp: now, Cursor: f.cursor}), nil
+}
+
+// makeChangefeedEvents returns the events of the changes of the table's
+// primary index which follow the cursor, in the order of their
+// timestamps and primary keys. The versions written to a row by a single
+// transaction are combined into a single event. Cells of columns which
+// no longer exist are ignored.
+func makeChangefeedEvents(desc *proto.TableDescriptor, changes []proto.KeyValueChange,
+	cursor ChangefeedCursor) ([]ChangefeedEvent, error) {
+	sort.Sort(changesByTimestamp(changes))
+	columns := columnsByID(desc)
+	var events []ChangefeedEvent
+	for _, change := range changes {
+		rowKey, values, id, err := decodeRowKey(desc, change.Key)
+		if err != nil {
+			return nil, err
+		}
+		ts := *change.Value.Timestamp
+		if ts.Equal(cursor.Timestamp) && (len(cursor.Key) == 0 || bytes.Compare(rowKey, cursor.Key) <= 0) {
+			continue
+		}
+		if n := len(events); n == 0 || !events[n-1].Timestamp.Equal(ts) || !events[n-1].Cursor.Key.Equal(rowKey) {
+			events = append(events, ChangefeedEvent{
+				Row:       map[string]interface{}(values),
+				Timestamp: ts,
+				Cursor:    ChangefeedCursor{Timestamp: ts, Key: rowKey},
+			})
+		}
+		event := &events[len(events)-1]
+		// The sentinel of a row sorts before its cells, so the cells
+		// deleted along with a row are skipped.
+		if id == 0 {
+			event.Deleted = change.Deleted
+			continue
+		}
+		column, ok := columns[id]
+		if !ok || event.Deleted {
+			continue
+		}
+		if change.Deleted {
+			event.Row[column.Name] = nil
+		} else if event.Row[column.Name], err = decodeCellValue(change.Value.Bytes, column.Type); err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+// changesByTimestamp sorts versions by timestamp and then by key.
+type changesByTimestamp []proto.KeyValueChange
+
+func (c changesByTimestamp) Len() int      { return len(c) }
+func (c changesByTimestamp) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c changesByTimestamp) Less(i, j int) bool {
+	if ti, tj := c[i].Value.Timestamp, c[j].Value.Timestamp; !ti.Equal(*tj) {
+		return ti.Less(*tj)
+	}
+	return c[i].Key.Less(c[j].Key)
+}
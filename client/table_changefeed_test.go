@@ -0,0 +1,211 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// deleteTestRow deletes the row of the table with the given id.
+func deleteTestRow(t *testing.T, db *DB, table string, id int64) {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowKey, err := makeRowKey(&desc, row{"id": id})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Batch{}
+	b.InternalAddCall(Call{
+		Args: &proto.DeleteRowRequest{
+			RequestHeader: proto.RequestHeader{Key: rowKey},
+			TableId:       desc.Id,
+			IndexId:       desc.PrimaryIndex.Id,
+			PrimaryKey:    []byte(rowKey[len(makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)):]),
+		},
+		Reply: &proto.DeleteRowResponse{},
+	})
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// changefeedRows returns the rows of the events, marking deleted rows
+// and checkpoints.
+func changefeedRows(events []ChangefeedEvent) []row {
+	var rows []row
+	for _, e := range events {
+		switch {
+		case e.Row == nil:
+			rows = append(rows, nil)
+		case e.Deleted:
+			rows = append(rows, row{"deleted": e.Row["id"]})
+		default:
+			rows = append(rows, row(e.Row))
+		}
+	}
+	return rows
+}
+
+func TestChangefeedPoll(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"}, row{"id": 2, "name": "b"})
+
+	f, err := db.newChangefeed("users", ChangefeedCursor{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, err := f.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []row{
+		{"id": int64(1), "name": "a"},
+		{"id": int64(2), "name": "b"},
+		nil,
+	}
+	if rows := changefeedRows(first); !reflect.DeepEqual(expected, rows) {
+		t.Fatalf("expected %v, but found %v", expected, rows)
+	}
+	if !first[0].Timestamp.Less(first[1].Timestamp) || first[2].Timestamp.Less(first[1].Timestamp) {
+		t.Errorf("expected increasing timestamps, but found %+v", first)
+	}
+
+	putTestRows(t, db, "users", row{"id": 1, "name": "c"})
+	deleteTestRow(t, db, "users", 2)
+	second, err := f.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []row{
+		{"id": int64(1), "name": "c"},
+		{"deleted": int64(2)},
+		nil,
+	}
+	if rows := changefeedRows(second); !reflect.DeepEqual(expected, rows) {
+		t.Fatalf("expected %v, but found %v", expected, rows)
+	}
+
+	// Nothing has changed since the last checkpoint.
+	if events, err := f.poll(); err != nil {
+		t.Fatal(err)
+	} else if len(events) != 0 {
+		t.Errorf("expected no events, but found %+v", events)
+	}
+
+	// Resuming at the first event delivers the events which follow it.
+	resumed, err := db.newChangefeed("users", first[0].Cursor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := resumed.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []row{
+		{"id": int64(2), "name": "b"},
+		{"id": int64(1), "name": "c"},
+		{"deleted": int64(2)},
+		nil,
+	}
+	if rows := changefeedRows(events); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+
+	// Starting at the timestamp of the first checkpoint delivers only the
+	// later changes.
+	later, err := db.newChangefeed("users", ChangefeedCursor{Timestamp: first[2].Timestamp})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if events, err = later.poll(); err != nil {
+		t.Fatal(err)
+	}
+	if rows := changefeedRows(events); !reflect.DeepEqual(changefeedRows(second), rows) {
+		t.Errorf("expected %v, but found %v", changefeedRows(second), rows)
+	}
+
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.poll(); err == nil {
+		t.Errorf("expected a changefeed of a dropped table to fail")
+	} else if _, ok := err.(*TableNotFoundError); !ok {
+		t.Errorf("expected TableNotFoundError, but found %T: %s", err, err)
+	}
+}
+
+func TestChangefeedChunks(t *testing.T) {
+	defer func(n int64) { ChangefeedChunkSize = n }(ChangefeedChunkSize)
+	ChangefeedChunkSize = 3
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	var expected []row
+	for i := 1; i <= 5; i++ {
+		r := row{"id": int64(i), "name": string(rune('a' + i))}
+		putTestRows(t, db, "users", r)
+		expected = append(expected, r)
+	}
+	f, err := db.newChangefeed("users", ChangefeedCursor{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := f.poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows := changefeedRows(events); !reflect.DeepEqual(append(expected, nil), rows) {
+		t.Errorf("expected %v, but found %v", append(expected, nil), rows)
+	}
+}
+
+func TestChangefeedEvents(t *testing.T) {
+	defer func(d time.Duration) { ChangefeedPollInterval = d }(ChangefeedPollInterval)
+	ChangefeedPollInterval = time.Millisecond
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	f, err := db.Changefeed("users", proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"})
+	for e := range f.Events() {
+		if e.Row != nil {
+			if expected := (row{"id": int64(1), "name": "a"}); !reflect.DeepEqual(expected, row(e.Row)) {
+				t.Errorf("expected %v, but found %v", expected, e.Row)
+			}
+			break
+		}
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := <-f.Events(); ok {
+		t.Errorf("expected the events channel to be closed")
+	}
+}
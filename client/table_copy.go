@@ -0,0 +1,179 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// CopyTable creates the table dst with the schema of the table src and
+// copies all of the rows of src, including their secondary index entries,
+// into it. The new table has its own table ID but the same column and
+// index IDs as src, which allows the data to be copied key by key in
+// chunks of TableBackfillChunkSize keys without decoding it. The copy is
+// recorded as a schema job (see SchemaJobs), which records the last
+// copied key after each chunk.
+//
+// The copy is not atomic: dst is visible, and may be written to, while the
+// copy proceeds. If dst already exists with the columns and indexes of
+// src, as it does after an interrupted copy, the copy is resumed from the
+// last key recorded by the job of the interrupted copy, or adopted if that
+// job is still running and its lease has expired. If the previous copy
+// succeeded, the rows are copied again, overwriting those copied
+// previously. Rows which were deleted from src in the meantime are not
+// deleted from dst.
+func (db *DB) CopyTable(src, dst string) error {
+	dbName, tableName, err := splitTableName(dst, db.defaultDatabase())
+	if err != nil {
+		return err
+	}
+	id, err := db.allocateDescID()
+	if err != nil {
+		return err
+	}
+
+	var srcDesc, dstDesc proto.TableDescriptor
+	var created bool
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		if srcDesc, err = getTableDescByName(txn, src); err != nil {
+			return err
+		}
+		dbDesc, err := getDatabaseDesc(txn, dbName)
+		if err != nil {
+			return err
+		}
+		existing, ok, err := getTableDesc(txn, dbDesc.Id, tableName)
+		if err != nil {
+			return err
+		}
+		if ok {
+			if existing.Id == srcDesc.Id || !sameTableLayout(existing, srcDesc) {
+				return &TableExistsError{Name: dst}
+			}
+			dstDesc, created = existing, false
+			return nil
+		}
+		dstDesc, created = srcDesc, true
+		dstDesc.Id = id
+		dstDesc.Name = tableName
+		dstDesc.ParentId = dbDesc.Id
+		dstDesc.Version = 0
+		dstDesc.Privileges = proto.NewDefaultPrivilegeDescriptor()
+		if err := proto.ValidateTableDesc(dstDesc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.CPut(keys.MakeTableMetadataKey(dbDesc.Id, tableName), encodeDescID(dstDesc.Id), nil)
+		b.CPut(keys.MakeDescMetadataKey(dstDesc.Id), &dstDesc, nil)
+		return txn.Commit(b)
+	}); err != nil {
+		return err
+	}
+
+	job := proto.SchemaJob{
+		Type:          proto.SchemaJob_TABLE_COPY,
+		TableId:       dstDesc.Id,
+		SourceTableId: srcDesc.Id,
+		Description:   fmt.Sprintf("copy table %q to %q", src, dst),
+	}
+	if !created {
+		prev, ok, err := db.lastTableCopyJob(srcDesc.Id, dstDesc.Id)
+		if err != nil {
+			return err
+		}
+		switch {
+		case !ok || prev.Status == proto.SchemaJob_SUCCEEDED:
+		case prev.Status == proto.SchemaJob_RUNNING:
+			return db.ResumeSchemaJob(prev.Id)
+		default:
+			job.Progress, job.ResumeKey = prev.Progress, prev.ResumeKey
+		}
+	}
+	j, err := db.startSchemaJob(job)
+	if err != nil {
+		return err
+	}
+	return j.finish(db.copyTableData(j))
+}
+
+// lastTableCopyJob returns the record of the most recent TABLE_COPY job
+// copying the table srcID into the table dstID, if any.
+func (db *DB) lastTableCopyJob(srcID, dstID uint32) (proto.SchemaJob, bool, error) {
+	jobs, err := db.SchemaJobs()
+	if err != nil {
+		return proto.SchemaJob{}, false, err
+	}
+	for i := len(jobs) - 1; i >= 0; i-- {
+		if job := jobs[i]; job.Type == proto.SchemaJob_TABLE_COPY &&
+			job.TableId == dstID && job.SourceTableId == srcID {
+			return job, true, nil
+		}
+	}
+	return proto.SchemaJob{}, false, nil
+}
+
+// copyTableData runs the TABLE_COPY job, copying the keys of the source
+// table into the destination table from the job's resume key onward. The
+// job records the number of keys copied and the next key to copy after
+// each chunk.
+func (db *DB) copyTableData(job *schemaJob) error {
+	srcPrefix, dstPrefix := keys.MakeTablePrefix(job.job.SourceTableId), keys.MakeTablePrefix(job.job.TableId)
+	start, end := srcPrefix, srcPrefix.PrefixEnd()
+	if job.job.ResumeKey != nil {
+		start = job.job.ResumeKey
+	}
+	total := job.job.Progress
+	bg := db.background()
+	b := &Batch{}
+	for {
+		kvs, err := bg.Scan(start, end, TableBackfillChunkSize)
+		if err != nil {
+			return err
+		}
+		if len(kvs) == 0 {
+			return nil
+		}
+		b.Reset()
+		for _, kv := range kvs {
+			key := append(append(proto.Key(nil), dstPrefix...), kv.Key[len(srcPrefix):]...)
+			putProtoValue(b, key, makeProtoValue(kv))
+		}
+		if err := bg.Run(b); err != nil {
+			return err
+		}
+		start = proto.Key(kvs[len(kvs)-1].Key).Next()
+		total += int64(len(kvs))
+		if err := job.checkpoint(total, start); err != nil {
+			return err
+		}
+		if int64(len(kvs)) < TableBackfillChunkSize {
+			return nil
+		}
+	}
+}
+
+// sameTableLayout returns true if the tables have the same columns and
+// indexes, including their IDs, and therefore the same key layout.
+func sameTableLayout(a, b proto.TableDescriptor) bool {
+	return reflect.DeepEqual(a.Columns, b.Columns) &&
+		reflect.DeepEqual(a.PrimaryIndex, b.PrimaryIndex) &&
+		reflect.DeepEqual(a.Indexes, b.Indexes)
+}
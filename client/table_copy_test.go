@@ -0,0 +1,131 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestCopyTable(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 2
+
+	db, s := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "alice"},
+		row{"id": 2, "name": "bob"},
+		row{"id": 3})
+	// Dropping a column leaves a gap in the column IDs, which the copy
+	// preserves.
+	if err := db.AddColumn("users", proto.Column{Name: "age", Type: proto.Column_INT}, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddColumn("users", proto.Column{Name: "email", Type: proto.Column_STRING}, "none"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropColumn("users", "age"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Grant("users", "bob", []proto.PrivilegeDescriptor_Kind{proto.PrivilegeDescriptor_READ}); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.CopyTable("users", "users_copy"); err != nil {
+		t.Fatal(err)
+	}
+	src, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst, err := db.DescribeTableDesc("users_copy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dst.Id == src.Id || !sameTableLayout(src, dst) {
+		t.Errorf("expected a copy of %+v, but found %+v", src, dst)
+	}
+	if !reflect.DeepEqual(proto.NewDefaultPrivilegeDescriptor(), dst.Privileges) {
+		t.Errorf("expected default privileges, but found %+v", dst.Privileges)
+	}
+	if expected, rows := scanTestRows(t, db, "users"), scanTestRows(t, db, "users_copy"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	if expected, keys := scanIndex(t, db, "users", "by_name"), scanIndex(t, db, "users_copy", "by_name"); !reflect.DeepEqual(expected, keys) {
+		t.Errorf("expected %q, but found %q", expected, keys)
+	}
+
+	// Copying again resumes into the existing table.
+	putTestRows(t, db, "users", row{"id": 4, "name": "dave"})
+	if err := db.CopyTable("users", "users_copy"); err != nil {
+		t.Fatal(err)
+	}
+	if n := countTableKeys(s, dst.Id); n != countTableKeys(s, src.Id) {
+		t.Errorf("expected %d keys, but found %d", countTableKeys(s, src.Id), n)
+	}
+	job, ok, err := db.lastTableCopyJob(src.Id, dst.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ok || job.Status != proto.SchemaJob_SUCCEEDED || job.Progress != int64(countTableKeys(s, src.Id)) {
+		t.Errorf("expected a succeeded job copying %d keys, but found %+v", countTableKeys(s, src.Id), job)
+	}
+
+	// An interrupted copy resumes from the last copied key: a row added
+	// before it is not copied.
+	job.Status = proto.SchemaJob_FAILED
+	job.ResumeKey = keys.MakeTablePrefix(src.Id).PrefixEnd()
+	if err := db.Put(keys.MakeSchemaJobKey(job.Id), &job); err != nil {
+		t.Fatal(err)
+	}
+	n := countTableKeys(s, dst.Id)
+	putTestRows(t, db, "users", row{"id": 5, "name": "eve"})
+	if err := db.CopyTable("users", "users_copy"); err != nil {
+		t.Fatal(err)
+	}
+	if m := countTableKeys(s, dst.Id); m != n {
+		t.Errorf("expected %d keys, but found %d", n, m)
+	}
+	if resumed, _, err := db.lastTableCopyJob(src.Id, dst.Id); err != nil {
+		t.Fatal(err)
+	} else if resumed.Id == job.Id || resumed.Status != proto.SchemaJob_SUCCEEDED || resumed.Progress != job.Progress {
+		t.Errorf("expected a new succeeded job with progress %d, but found %+v", job.Progress, resumed)
+	}
+
+	if err := db.CreateTable(testSchema("other")); err != nil {
+		t.Fatal(err)
+	}
+	testData := []struct {
+		src, dst string
+		err      string
+	}{
+		{"users", "users", `table "users" already exists`},
+		{"users", "other", `table "other" already exists`},
+		{"missing", "copy", `table "missing" does not exist`},
+		{"users", "missing.copy", `database "missing" does not exist`},
+	}
+	for i, d := range testData {
+		if err := db.CopyTable(d.src, d.dst); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
@@ -0,0 +1,444 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// The CSV format used by ExportCSV follows RFC 4180, with a header row of
+// column names and "\n" line endings. Values are formatted according to
+// the type of their column:
+//
+//   INT, FLOAT, BOOL: as by strconv
+//   STRING:           verbatim
+//   BYTES:            base64 (standard encoding)
+//   JSON:             the JSON text
+//
+// NULL is written as an empty, unquoted field. Non-NULL values which are
+// empty, or which contain quotes, commas, line breaks or leading or
+// trailing spaces, are quoted.
+
+// ImportCSV and ExportCSV use the same format, so that NULLs and empty
+// strings survive a round trip.
+
+// ExportCSV writes the rows of the named table to w as CSV, in primary key
+// order. Only the named columns are written; if none are named, all of the
+// table's columns are written in the order of the schema. The table is
+// scanned in chunks of TableBackfillChunkSize keys, all read at the same
+// timestamp.
+func (db *DB) ExportCSV(table string, w io.Writer, columns ...string) error {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, table)
+		return err
+	}); err != nil {
+		return err
+	}
+	var cols []proto.ColumnDescriptor
+	if len(columns) == 0 {
+		cols = desc.Columns
+	}
+	for _, name := range columns {
+		column, ok := findColumn(&desc, name)
+		if !ok {
+			return &UnknownColumnError{Table: desc.Name, Column: name}
+		}
+		cols = append(cols, column)
+	}
+
+	bw := bufio.NewWriter(w)
+	record := make([]*string, len(cols))
+	for i := range cols {
+		record[i] = &cols[i].Name
+	}
+	if err := writeCSVRecord(bw, record); err != nil {
+		return err
+	}
+	if err := db.scanRowChunks(&desc, func(rows []row) error {
+		for _, r := range rows {
+			for i, column := range cols {
+				record[i] = nil
+				if v, ok := r[column.Name]; ok && v != nil {
+					s := formatCSVValue(v)
+					record[i] = &s
+				}
+			}
+			if err := writeCSVRecord(bw, record); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// formatCSVValue formats a value as returned by convertValue.
+func formatCSVValue(v interface{}) string {
+	switch t := v.(type) {
+	case int64:
+		return strconv.FormatInt(t, 10)
+	case float64:
+		return strconv.FormatFloat(t, 'g', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case string:
+		return t
+	case []byte:
+		return base64.StdEncoding.EncodeToString(t)
+	case json.RawMessage:
+		return string(t)
+	}
+	panic(fmt.Sprintf("unable to format value %T", v))
+}
+
+// writeCSVRecord writes a record of fields, where nil fields are NULL.
+func writeCSVRecord(w *bufio.Writer, record []*string) error {
+	for i, field := range record {
+		if i > 0 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if field == nil {
+			continue
+		}
+		s := *field
+		if !csvNeedsQuotes(s) {
+			if _, err := w.WriteString(s); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := w.WriteString(`"` + strings.Replace(s, `"`, `""`, -1) + `"`); err != nil {
+			return err
+		}
+	}
+	return w.WriteByte('\n')
+}
+
+func csvNeedsQuotes(s string) bool {
+	return s == "" || strings.ContainsAny(s, "\",\r\n") ||
+		s[0] == ' ' || s[0] == '\t' || s[len(s)-1] == ' ' || s[len(s)-1] == '\t'
+}
+
+// An ImportOption configures ImportCSV.
+type ImportOption func(*importOptions)
+
+type importOptions struct {
+	batchRows  int
+	batchBytes int
+	maxErrors  int
+}
+
+// ImportBatchRowsOpt sets the maximum number of rows written in a single
+// batch by ImportCSV. The default is 1000.
+func ImportBatchRowsOpt(n int) ImportOption {
+	return func(o *importOptions) {
+		o.batchRows = n
+	}
+}
+
+// ImportBatchBytesOpt sets the size in bytes of the CSV data beyond which
+// ImportCSV writes a batch even if it holds fewer rows than allowed by
+// ImportBatchRowsOpt. The default is 1MB.
+func ImportBatchBytesOpt(n int) ImportOption {
+	return func(o *importOptions) {
+		o.batchBytes = n
+	}
+}
+
+// ImportMaxErrorsOpt sets the number of invalid lines ImportCSV skips
+// before aborting the import. The default is 0.
+func ImportMaxErrorsOpt(n int) ImportOption {
+	return func(o *importOptions) {
+		o.maxErrors = n
+	}
+}
+
+// A CSVLineError describes an invalid line of CSV input.
+type CSVLineError struct {
+	Line int // the line on which the record starts, starting at 1
+	Err  error
+}
+
+// Error implements the error interface.
+func (e CSVLineError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+// A CSVImportResult describes the outcome of ImportCSV.
+type CSVImportResult struct {
+	// Rows is the number of rows written.
+	Rows int
+	// Errors holds the invalid lines which were skipped.
+	Errors []CSVLineError
+}
+
+// ImportCSV writes the rows read from r, in the format written by
+// ExportCSV, to the named table. The header row of the input names the
+// column of each field. Values are parsed according to the types of their
+// columns and the rows are written in batches (see ImportBatchRowsOpt and
+// ImportBatchBytesOpt). Invalid lines are skipped and reported in the
+// result, up to the limit set by ImportMaxErrorsOpt; one more aborts the
+// import. Each batch is written in its own transaction, and batches
+// written before an error remain written. Existing rows with the same
+// primary key as an imported row are replaced, along with their index
+// entries; of the rows of a batch with the same primary key, the last is
+// written.
+func (db *DB) ImportCSV(table string, r io.Reader, opts ...ImportOption) (CSVImportResult, error) {
+	o := importOptions{batchRows: 1000, batchBytes: 1 << 20}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var result CSVImportResult
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, table)
+		return err
+	}); err != nil {
+		return result, err
+	}
+
+	cr := &csvReader{r: bufio.NewReader(r)}
+	header, _, err := cr.readRecord()
+	if err == io.EOF {
+		return result, fmt.Errorf("missing CSV header")
+	} else if err != nil {
+		return result, err
+	}
+	cols := make([]proto.ColumnDescriptor, len(header))
+	for i, name := range header {
+		if name == nil {
+			return result, fmt.Errorf("line 1: empty column name")
+		}
+		column, ok := findColumn(&desc, *name)
+		if !ok {
+			return result, &UnknownColumnError{Table: desc.Name, Column: *name}
+		}
+		cols[i] = column
+	}
+
+	var rows []row
+	var rowKeys []proto.Key
+	pending := map[string]int{}
+	var batchRows, batchBytes int
+	flush := func() error {
+		if batchRows == 0 {
+			return nil
+		}
+		if err := db.Txn(func(txn *Txn) error {
+			return replaceRows(txn, &desc, rows, rowKeys)
+		}); err != nil {
+			return err
+		}
+		result.Rows += batchRows
+		rows, rowKeys = nil, nil
+		pending = map[string]int{}
+		batchRows, batchBytes = 0, 0
+		return nil
+	}
+	for {
+		record, line, err := cr.readRecord()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return result, err
+		}
+		values, rowKey, err := parseCSVRecord(&desc, cols, record)
+		if err != nil {
+			lineErr := CSVLineError{Line: line, Err: err}
+			if len(result.Errors) >= o.maxErrors {
+				if flushErr := flush(); flushErr != nil {
+					return result, flushErr
+				}
+				return result, fmt.Errorf("aborting import after %d invalid lines: %s",
+					len(result.Errors)+1, lineErr)
+			}
+			result.Errors = append(result.Errors, lineErr)
+			continue
+		}
+		if i, ok := pending[string(rowKey)]; ok {
+			rows[i] = values
+		} else {
+			pending[string(rowKey)] = len(rows)
+			rows = append(rows, values)
+			rowKeys = append(rowKeys, rowKey)
+		}
+		batchRows++
+		for _, field := range record {
+			if field != nil {
+				batchBytes += len(*field)
+			}
+		}
+		if batchRows >= o.batchRows || batchBytes >= o.batchBytes {
+			if err := flush(); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, flush()
+}
+
+// parseCSVRecord parses a record of fields of the given columns, returning
+// the values of the row and its sentinel key. The row is converted and its
+// index entries are computed so that an invalid row is reported along with
+// its line rather than failing the write of its batch.
+func parseCSVRecord(desc *proto.TableDescriptor, cols []proto.ColumnDescriptor, record []*string) (row, proto.Key, error) {
+	if len(record) != len(cols) {
+		return nil, nil, fmt.Errorf("expected %d fields, but found %d", len(cols), len(record))
+	}
+	values := make(row, len(cols))
+	for i, field := range record {
+		if field == nil {
+			continue
+		}
+		v, err := parseCSVValue(cols[i], *field)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[cols[i].Name] = v
+	}
+	converted, err := convertRow(desc, values)
+	if err != nil {
+		return nil, nil, err
+	}
+	rowKey, err := makeRowKey(desc, converted)
+	if err != nil {
+		return nil, nil, err
+	}
+	if _, _, err := makeIndexEntries(desc, rowKey, converted); err != nil {
+		return nil, nil, err
+	}
+	return values, rowKey, nil
+}
+
+// parseCSVValue parses a value formatted by formatCSVValue.
+func parseCSVValue(column proto.ColumnDescriptor, s string) (interface{}, error) {
+	var v interface{}
+	var err error
+	switch column.Type {
+	case proto.Column_INT:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case proto.Column_FLOAT:
+		v, err = strconv.ParseFloat(s, 64)
+	case proto.Column_BOOL:
+		v, err = strconv.ParseBool(s)
+	case proto.Column_STRING:
+		v = s
+	case proto.Column_BYTES:
+		v, err = base64.StdEncoding.DecodeString(s)
+	case proto.Column_JSON:
+		var i interface{}
+		if err = json.Unmarshal([]byte(s), &i); err == nil {
+			v = json.RawMessage(s)
+		}
+	default:
+		err = fmt.Errorf("unsupported type %s", column.Type)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("column %q: invalid %s value %q", column.Name, column.Type, s)
+	}
+	return v, nil
+}
+
+// csvReader reads records in the format written by writeCSVRecord. Unlike
+// encoding/csv, it distinguishes empty unquoted fields (NULL) from empty
+// quoted fields.
+type csvReader struct {
+	r    *bufio.Reader
+	line int
+}
+
+// readRecord reads a record, returning its fields and the line on which it
+// starts. io.EOF is returned at the end of the input.
+func (cr *csvReader) readRecord() ([]*string, int, error) {
+	cr.line++
+	start := cr.line
+	c, _, err := cr.r.ReadRune()
+	if err != nil {
+		return nil, start, err
+	}
+	cr.r.UnreadRune()
+
+	var record []*string
+	var buf bytes.Buffer
+	for {
+		// Read a field.
+		buf.Reset()
+		quoted := false
+		if c, _, err = cr.r.ReadRune(); err == nil && c == '"' {
+			quoted = true
+			for {
+				if c, _, err = cr.r.ReadRune(); err != nil {
+					return nil, start, fmt.Errorf("line %d: unterminated quoted field", start)
+				}
+				if c == '"' {
+					if c, _, err = cr.r.ReadRune(); err != nil || c != '"' {
+						break
+					}
+				} else if c == '\n' {
+					cr.line++
+				}
+				buf.WriteRune(c)
+			}
+		} else {
+			for err == nil && c != ',' && c != '\n' {
+				if c == '"' {
+					return nil, start, fmt.Errorf("line %d: unexpected quote in unquoted field", start)
+				}
+				buf.WriteRune(c)
+				c, _, err = cr.r.ReadRune()
+			}
+		}
+		if err != nil && err != io.EOF {
+			return nil, start, err
+		}
+		s := buf.String()
+		if !quoted {
+			s = strings.TrimSuffix(s, "\r")
+		}
+		if quoted || s != "" {
+			record = append(record, &s)
+		} else {
+			record = append(record, nil)
+		}
+		if err == io.EOF || c == '\n' {
+			return record, start, nil
+		}
+		if quoted && c == '\r' {
+			if c, _, err = cr.r.ReadRune(); err == io.EOF || (err == nil && c == '\n') {
+				return record, start, nil
+			}
+		}
+		if c != ',' {
+			return nil, start, fmt.Errorf("line %d: unexpected %q after quoted field", start, c)
+		}
+	}
+}
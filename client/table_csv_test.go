@@ -0,0 +1,227 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func csvTestSchema(name string) proto.TableSchema {
+	return proto.TableSchema{
+		Table: proto.Table{Name: name},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+			{Name: "score", Type: proto.Column_FLOAT},
+			{Name: "active", Type: proto.Column_BOOL},
+			{Name: "avatar", Type: proto.Column_BYTES},
+			{Name: "attrs", Type: proto.Column_JSON},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+}
+
+func TestExportCSV(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 3, "name": "", "score": 0.5, "active": false},
+		row{"id": 1, "name": "alice", "score": 1.25, "active": true,
+			"avatar": []byte("\x00\xff"), "attrs": map[string]int{"a": 1}},
+		row{"id": 2, "name": `bob "the builder", jr.`},
+		row{"id": 4, "name": " padded"})
+
+	var buf bytes.Buffer
+	if err := db.ExportCSV("users", &buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `id,name,score,active,avatar,attrs
+1,alice,1.25,true,AP8=,"{""a"":1}"
+2,"bob ""the builder"", jr.",,,,
+3,"",0.5,false,,
+4," padded",,,,
+`
+	if buf.String() != expected {
+		t.Errorf("expected\n%s\nbut found\n%s", expected, buf.String())
+	}
+
+	buf.Reset()
+	if err := db.ExportCSV("users", &buf, "name", "id"); err != nil {
+		t.Fatal(err)
+	}
+	expected = `name,id
+alice,1
+"bob ""the builder"", jr.",2
+"",3
+" padded",4
+`
+	if buf.String() != expected {
+		t.Errorf("expected\n%s\nbut found\n%s", expected, buf.String())
+	}
+
+	if err := db.ExportCSV("users", &buf, "missing"); err == nil || err.Error() != `table "users": column "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestImportCSV(t *testing.T) {
+	db, s := newMemDB()
+	for _, name := range []string{"users", "copy"} {
+		if err := db.CreateTable(csvTestSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "alice", "score": 1.25, "active": true,
+			"avatar": []byte("\x00\xff"), "attrs": map[string]int{"a": 1}},
+		row{"id": 2, "name": "bob,\n\"jr\""},
+		row{"id": 3, "name": "", "score": 0.5, "active": false})
+
+	// Exported data round trips.
+	var buf bytes.Buffer
+	if err := db.ExportCSV("users", &buf); err != nil {
+		t.Fatal(err)
+	}
+	before := len(s.data)
+	result, err := db.ImportCSV("copy", &buf, ImportBatchRowsOpt(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Rows != 3 || len(result.Errors) != 0 {
+		t.Errorf("unexpected result: %+v", result)
+	}
+	if before == len(s.data) {
+		t.Errorf("expected rows to be written")
+	}
+	if expected, rows := scanTestRows(t, db, "users"), scanTestRows(t, db, "copy"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+
+	// Invalid lines are skipped up to the limit.
+	input := "name,id,score\r\n" +
+		"dave,4,\r\n" +
+		"erin,x,1\r\n" +
+		"\"frank\nfrancis\",,2\r\n" +
+		"gina,7,1.5\r\n" +
+		"hank,8\r\n"
+	result, err = db.ImportCSV("copy", strings.NewReader(input), ImportMaxErrorsOpt(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := CSVImportResult{
+		Rows: 2,
+		Errors: []CSVLineError{
+			{Line: 3, Err: errors.New(`column "id": invalid INT value "x"`)},
+			{Line: 4, Err: errors.New(`missing value for primary key column "id"`)},
+			{Line: 7, Err: errors.New(`expected 3 fields, but found 2`)},
+		},
+	}
+	if fmt.Sprint(expected) != fmt.Sprint(result) {
+		t.Errorf("expected %+v, but found %+v", expected, result)
+	}
+	rows := scanTestRows(t, db, "copy")
+	if len(rows) != 5 || rows[3]["name"] != "dave" || rows[4]["score"] != 1.5 {
+		t.Errorf("unexpected rows: %v", rows)
+	}
+
+	// One more invalid line aborts the import.
+	_, err = db.ImportCSV("copy", strings.NewReader(input), ImportMaxErrorsOpt(2))
+	if expected := `aborting import after 3 invalid lines: line 7: expected 3 fields, but found 2`; err == nil || err.Error() != expected {
+		t.Errorf("expected \"%s\", but found \"%v\"", expected, err)
+	}
+
+	testData := []struct {
+		input string
+		err   string
+	}{
+		{"", "missing CSV header"},
+		{"id,missing\n", `table "copy": column "missing" does not exist`},
+		{"id,name\n1,\"unterminated\n", "line 2: unterminated quoted field"},
+		{"id,name\n1,a\"b\n", "line 2: unexpected quote in unquoted field"},
+		{"id,name\n1,\"a\"b\n", `line 2: unexpected 'b' after quoted field`},
+	}
+	for i, d := range testData {
+		if _, err := db.ImportCSV("copy", strings.NewReader(d.input)); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestImportCSVReplace(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"}, row{"id": 2, "name": "b"})
+
+	// Existing rows are replaced, including their NULL columns and index
+	// entries, and the last of the rows with the same primary key wins.
+	input := "id,name\n1,\n2,c\n2,d\n"
+	if result, err := db.ImportCSV("users", strings.NewReader(input)); err != nil {
+		t.Fatal(err)
+	} else if result.Rows != 3 {
+		t.Errorf("expected 3 rows, but found %d", result.Rows)
+	}
+	expected := []row{{"id": int64(1)}, {"id": int64(2), "name": "d"}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := makeIndexPrefix(desc.Id, desc.Indexes[0].Id)
+	kvs, err := db.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 1 || !bytes.HasPrefix(kvs[0].Key, encodeKeyValue(prefix, "d")) {
+		t.Errorf("expected a single index entry for \"d\", but found %v", kvs)
+	}
+}
+
+func TestCSVBatchBytes(t *testing.T) {
+	db, s := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	before := s.batches
+	input := "id,name\n1,aaaa\n2,bbbb\n3,cccc\n4,dddd\n"
+	if result, err := db.ImportCSV("users", strings.NewReader(input), ImportBatchBytesOpt(8)); err != nil {
+		t.Fatal(err)
+	} else if result.Rows != 4 {
+		t.Errorf("expected 4 rows, but found %d", result.Rows)
+	}
+	// Every two rows fill a batch. The existing rows of each batch are
+	// looked up before it is written.
+	if batches := s.batches - before; batches != 4 {
+		t.Errorf("expected 4 batches, but found %d", batches)
+	}
+}
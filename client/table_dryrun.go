@@ -0,0 +1,109 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// A KeySpan is a span of keys. EndKey is empty for spans holding the
+// single key Key.
+type KeySpan struct {
+	Key, EndKey proto.Key
+}
+
+// A SchemaChangePlan describes the writes a schema change would perform.
+// It is filled in by schema changes performed with DryRunOpt.
+type SchemaChangePlan struct {
+	// Descriptors holds the table descriptors as they would be written.
+	Descriptors []proto.TableDescriptor
+	// Spans holds the keys and key spans which would be written or
+	// deleted, in the order the schema change would touch them: first the
+	// namespace and descriptor keys, then the table data, such as the
+	// entries of a backfilled index.
+	Spans []KeySpan
+	// Splits holds the keys at which the table would be pre-split.
+	Splits []proto.Key
+}
+
+// DryRunOpt performs all of the validation of a schema change without
+// writing anything, recording the writes the schema change would perform
+// in plan. It is supported by CreateTable, CreateTableIfNotExists,
+// RenameTable, DropTable, TruncateTable, AddColumn, CreateIndex,
+// DropIndex, DropIndexAsync, DropColumn, DropColumnCascade and
+// RenameColumn. The plan is left empty if the schema change would not
+// write anything, e.g. because the table to be created by
+// CreateTableIfNotExists exists.
+func DryRunOpt(plan *SchemaChangePlan) TableOption {
+	return func(o *tableOptions) {
+		o.dryRun = plan
+	}
+}
+
+// errDryRun aborts the transaction of a schema change performed with
+// DryRunOpt.
+var errDryRun = errors.New("dry run")
+
+// resetPlan clears the plan of a dry run.
+func (o tableOptions) resetPlan() {
+	if o.dryRun != nil {
+		*o.dryRun = SchemaChangePlan{}
+	}
+}
+
+// addSpan records a span of keys which would be written by a dry run.
+func (o tableOptions) addSpan(key, endKey proto.Key) {
+	o.dryRun.Spans = append(o.dryRun.Spans, KeySpan{Key: key, EndKey: endKey})
+}
+
+// commitSchemaChange commits the batch writing the table's descriptor.
+// For dry runs the descriptor and the keys written by the batch are
+// recorded in the plan instead and errDryRun is returned, aborting the
+// transaction.
+func commitSchemaChange(txn *Txn, b *Batch, desc *proto.TableDescriptor, o tableOptions) error {
+	if o.dryRun == nil {
+		return txn.Commit(b)
+	}
+	// The transaction may be retried, so start afresh.
+	o.resetPlan()
+	o.dryRun.Descriptors = append(o.dryRun.Descriptors, *desc)
+	for _, call := range b.calls {
+		o.addSpan(proto.KeySpan(call.Args))
+	}
+	return errDryRun
+}
+
+// peekDescID returns the descriptor ID which allocateDescID would most
+// likely allocate next, without allocating it.
+func (db *DB) peekDescID() (uint32, error) {
+	if db.descIDs != nil {
+		if id, ok := db.descIDs.peek(); ok {
+			return id, nil
+		}
+	}
+	r, err := db.Get(keys.DescIDGenerator)
+	if err != nil {
+		return 0, err
+	}
+	var n int64
+	if r.Exists() {
+		n = r.ValueInt()
+	}
+	return uint32(keys.MaxReservedDescID + n + 1), nil
+}
@@ -0,0 +1,196 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestDryRun(t *testing.T) {
+	db, s := newMemDB()
+	var plan SchemaChangePlan
+	if err := db.CreateTable(testSchema("users"), DryRunOpt(&plan), PreSplitAtOpt(10)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.DescribeTable("users"); err == nil {
+		t.Fatal("expected the dry run not to create the table")
+	}
+	if len(plan.Descriptors) != 1 || plan.Descriptors[0].Name != "users" {
+		t.Fatalf("unexpected descriptors: %+v", plan.Descriptors)
+	}
+	id := plan.Descriptors[0].Id
+	expected := []KeySpan{
+		{Key: keys.MakeTableMetadataKey(keys.DefaultDatabaseID, "users")},
+		{Key: keys.MakeDescMetadataKey(id)},
+	}
+	if !reflect.DeepEqual(expected, plan.Spans) {
+		t.Errorf("expected %v, but found %v", expected, plan.Spans)
+	}
+	if len(plan.Splits) != 1 {
+		t.Errorf("expected 1 split, but found %v", plan.Splits)
+	}
+
+	// The dry run predicts the ID allocated to the table.
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Id != id {
+		t.Errorf("expected ID %d, but found %d", id, desc.Id)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"})
+
+	// Validation errors are reported by dry runs.
+	if err := db.CreateTable(testSchema("users"), DryRunOpt(&plan)); err == nil ||
+		err.Error() != `table "users" already exists` {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := db.AddColumn("users", proto.Column{Name: "age", Type: proto.Column_INT}, 18,
+		DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	primary := makeIndexPrefix(id, desc.PrimaryIndex.Id)
+	expected = []KeySpan{
+		{Key: keys.MakeDescMetadataKey(id)},
+		{Key: primary, EndKey: primary.PrefixEnd()},
+	}
+	if !reflect.DeepEqual(expected, plan.Spans) {
+		t.Errorf("expected %v, but found %v", expected, plan.Spans)
+	}
+	if len(plan.Descriptors) != 1 || len(plan.Descriptors[0].Columns) != len(desc.Columns)+1 {
+		t.Errorf("unexpected descriptors: %+v", plan.Descriptors)
+	}
+
+	index := proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_name_id"}, ColumnNames: []string{"name", "id"}}
+	if err := db.CreateIndex("users", index, DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	if prefix := makeIndexPrefix(id, desc.NextIndexId); len(plan.Spans) != 2 ||
+		!reflect.DeepEqual(KeySpan{Key: prefix, EndKey: prefix.PrefixEnd()}, plan.Spans[1]) {
+		t.Errorf("unexpected spans: %v", plan.Spans)
+	}
+
+	if err := db.TruncateTable("users", DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	if prefix := keys.MakeTablePrefix(id); !reflect.DeepEqual([]KeySpan{{Key: prefix, EndKey: prefix.PrefixEnd()}}, plan.Spans) {
+		t.Errorf("unexpected spans: %v", plan.Spans)
+	}
+
+	if err := db.RenameTable("users", "accounts", DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Descriptors) != 1 || plan.Descriptors[0].Name != "accounts" || len(plan.Spans) != 3 {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+
+	if err := db.DropTable("users", DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Descriptors) != 1 || plan.Descriptors[0].DropTime == 0 || len(plan.Spans) != 3 {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+
+	// Nothing was written.
+	after, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(desc, after) {
+		t.Errorf("expected %+v, but found %+v", desc, after)
+	}
+	if rows := scanTestRows(t, db, "users"); len(rows) != 1 {
+		t.Errorf("expected 1 row, but found %v", rows)
+	}
+
+	// Dropping and renaming columns and indexes.
+	if err := db.CreateIndex("users", index); err != nil {
+		t.Fatal(err)
+	}
+	if desc, err = db.DescribeTableDesc("users"); err != nil {
+		t.Fatal(err)
+	}
+	byName := makeIndexPrefix(id, desc.Indexes[0].Id)
+	byNameID := makeIndexPrefix(id, desc.Indexes[1].Id)
+	expected = []KeySpan{
+		{Key: keys.MakeDescMetadataKey(id)},
+		{Key: byNameID, EndKey: byNameID.PrefixEnd()},
+	}
+	if err := db.DropIndex("users", "by_name_id", DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Descriptors) != 1 || len(plan.Descriptors[0].Indexes) != 1 ||
+		!reflect.DeepEqual(expected, plan.Spans) {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+	errCh, err := db.DropIndexAsync("users", "by_name_id", DryRunOpt(&plan))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := <-errCh; err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, plan.Spans) {
+		t.Errorf("expected %v, but found %v", expected, plan.Spans)
+	}
+
+	if err := db.DropColumn("users", "name", DryRunOpt(&plan)); err == nil ||
+		err.Error() != `table "users": column "name" is referenced by index "by_name"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.DropColumnCascade("users", "name", DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	expected = []KeySpan{
+		{Key: keys.MakeDescMetadataKey(id)},
+		{Key: byName, EndKey: byName.PrefixEnd()},
+		{Key: byNameID, EndKey: byNameID.PrefixEnd()},
+		{Key: primary, EndKey: primary.PrefixEnd()},
+	}
+	if len(plan.Descriptors) != 1 || len(plan.Descriptors[0].Columns) != len(desc.Columns)-1 ||
+		!reflect.DeepEqual(expected, plan.Spans) {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+
+	if err := db.RenameColumn("users", "name", "first_name", DryRunOpt(&plan)); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := findColumn(&plan.Descriptors[0], "first_name"); len(plan.Descriptors) != 1 || !ok ||
+		!reflect.DeepEqual([]KeySpan{{Key: keys.MakeDescMetadataKey(id)}}, plan.Spans) {
+		t.Errorf("unexpected plan: %+v", plan)
+	}
+
+	if after, err = db.DescribeTableDesc("users"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(desc, after) {
+		t.Errorf("expected %+v, but found %+v", desc, after)
+	}
+	if n := len(s.sortedKeys(byNameID, byNameID.PrefixEnd())); n != 1 {
+		t.Errorf("expected 1 index entry, but found %d", n)
+	}
+	if rows := scanTestRows(t, db, "users"); len(rows) != 1 || rows[0]["name"] != "a" {
+		t.Errorf("unexpected rows: %v", rows)
+	}
+}
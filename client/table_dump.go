@@ -0,0 +1,385 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+)
+
+// The dump format written by DumpTable consists of a CREATE TABLE
+// statement describing the schema of the table, followed by an INSERT
+// statement for each row, one statement per line:
+//
+//   CREATE TABLE `users` (
+//     `id` INT,
+//     `name` STRING,
+//     CONSTRAINT `primary` PRIMARY KEY (`id`),
+//     INDEX `by_name` (`name`)
+//   );
+//   INSERT INTO `users` (`id`, `name`) VALUES (1, 'alice');
+//
+// The statements are accepted by ExecSQL, so the table can be restored by
+// executing them in order once any existing table of the same name has
+// been dropped. Computed columns are described by the CREATE TABLE
+// statement but are not inserted. Table and column comments and the TTL
+// of the table are not dumped.
+
+// DumpTable writes the schema and the rows of the named table to w in
+// primary key order. The table is scanned in chunks of
+// TableBackfillChunkSize keys, so the rows are never all held in memory.
+// All of the chunks are read at the same timestamp, so the dump reflects
+// a single consistent view of the table.
+func (db *DB) DumpTable(name string, w io.Writer) error {
+	desc, err := db.DescribeTableDesc(name)
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(formatCreateTable(proto.TableSchemaFromDesc(desc)) + "\n"); err != nil {
+		return err
+	}
+
+	var cols []proto.ColumnDescriptor
+	var names []string
+	for _, column := range desc.Columns {
+		if column.ComputeExpr == "" {
+			cols = append(cols, column)
+			names = append(names, quoteSQLName(column.Name))
+		}
+	}
+	prefix := fmt.Sprintf("INSERT INTO %s (%s) VALUES (",
+		quoteSQLName(desc.Name), strings.Join(names, ", "))
+	if err := db.scanRowChunks(&desc, func(rows []row) error {
+		for _, r := range rows {
+			buf := []byte(prefix)
+			for i, column := range cols {
+				if i > 0 {
+					buf = append(buf, ", "...)
+				}
+				var err error
+				if buf, err = encodeSQLValue(buf, r[column.Name]); err != nil {
+					return err
+				}
+			}
+			buf = append(buf, ");\n"...)
+			if _, err := bw.Write(buf); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+// formatCreateTable formats the schema as a CREATE TABLE statement. The
+// first index of the schema is the primary key.
+func formatCreateTable(schema proto.TableSchema) string {
+	var defs []string
+	for _, column := range schema.Columns {
+		def := quoteSQLName(column.Name) + " " + column.Type.String()
+		if column.ComputeExpr != "" {
+			def += " AS (" + column.ComputeExpr + ")"
+		}
+		defs = append(defs, def)
+	}
+	for i, index := range schema.Indexes {
+		var parts []string
+		for _, name := range index.ColumnNames {
+			parts = append(parts, quoteSQLName(name))
+		}
+		for _, expr := range index.KeyExprs {
+			parts = append(parts, "("+expr+")")
+		}
+		var kind string
+		switch {
+		case i == 0:
+			kind = "CONSTRAINT %s PRIMARY KEY"
+		case index.Unique:
+			kind = "UNIQUE INDEX %s"
+		default:
+			kind = "INDEX %s"
+		}
+		defs = append(defs, fmt.Sprintf(kind, quoteSQLName(index.Name))+" ("+strings.Join(parts, ", ")+")")
+	}
+	return fmt.Sprintf("CREATE TABLE %s (\n  %s\n);", quoteSQLName(schema.Name), strings.Join(defs, ",\n  "))
+}
+
+// parseCreateTable parses a CREATE TABLE statement in the format written
+// by formatCreateTable into a schema. The primary key becomes the first
+// index of the schema.
+func parseCreateTable(statement string) (proto.TableSchema, error) {
+	var schema proto.TableSchema
+	s := &createTableScanner{s: statement}
+	if !s.keyword("CREATE") || !s.keyword("TABLE") {
+		return schema, s.errorf("CREATE TABLE")
+	}
+	var err error
+	if schema.Name, err = s.name(); err != nil {
+		return schema, err
+	}
+	if !s.punct('(') {
+		return schema, s.errorf("(")
+	}
+	var primary *proto.TableSchema_IndexByName
+	for {
+		var index proto.TableSchema_IndexByName
+		isIndex := true
+		switch {
+		case s.keyword("CONSTRAINT"):
+			if index.Name, err = s.ident(); err != nil {
+				return schema, err
+			}
+			if !s.keyword("PRIMARY") || !s.keyword("KEY") {
+				return schema, s.errorf("PRIMARY KEY")
+			}
+			if primary != nil {
+				return schema, fmt.Errorf("table %q: duplicate primary key", schema.Name)
+			}
+			index.Unique = true
+			primary = &index
+		case s.keyword("UNIQUE"):
+			if !s.keyword("INDEX") {
+				return schema, s.errorf("INDEX")
+			}
+			index.Unique = true
+			fallthrough
+		case s.keyword("INDEX"):
+			if index.Name, err = s.ident(); err != nil {
+				return schema, err
+			}
+		default:
+			isIndex = false
+			var column proto.Column
+			if column.Name, err = s.ident(); err != nil {
+				return schema, err
+			}
+			typ, err := s.ident()
+			if err != nil {
+				return schema, err
+			}
+			t, ok := proto.Column_ColumnType_value[strings.ToUpper(typ)]
+			if !ok {
+				return schema, fmt.Errorf("column %q: unknown type %q", column.Name, typ)
+			}
+			column.Type = proto.Column_ColumnType(t)
+			if s.keyword("AS") {
+				if column.ComputeExpr, err = s.parenExpr(); err != nil {
+					return schema, err
+				}
+			}
+			schema.Columns = append(schema.Columns, column)
+		}
+		if isIndex {
+			if err := s.indexParts(&index); err != nil {
+				return schema, err
+			}
+			if primary != &index {
+				schema.Indexes = append(schema.Indexes, index)
+			}
+		}
+		if s.punct(')') {
+			break
+		}
+		if !s.punct(',') {
+			return schema, s.errorf(", or )")
+		}
+	}
+	s.punct(';')
+	if s.skipSpace(); s.pos < len(s.s) {
+		return schema, s.errorf("end of statement")
+	}
+	if primary == nil {
+		return schema, fmt.Errorf("table %q: no primary key", schema.Name)
+	}
+	schema.Indexes = append([]proto.TableSchema_IndexByName{*primary}, schema.Indexes...)
+	return schema, nil
+}
+
+// createTableScanner scans the tokens of a CREATE TABLE statement.
+type createTableScanner struct {
+	s   string
+	pos int
+}
+
+func (s *createTableScanner) errorf(expected string) error {
+	return fmt.Errorf("CREATE TABLE: expected %s at position %d", expected, s.pos)
+}
+
+func (s *createTableScanner) skipSpace() {
+	for s.pos < len(s.s) && strings.IndexByte(" \t\r\n", s.s[s.pos]) >= 0 {
+		s.pos++
+	}
+}
+
+// word returns the length of the unquoted word at the current position.
+func (s *createTableScanner) word() int {
+	n := 0
+	for s.pos+n < len(s.s) {
+		c := s.s[s.pos+n]
+		if c != '_' && (c < 'a' || c > 'z') && (c < 'A' || c > 'Z') && (c < '0' || c > '9') {
+			break
+		}
+		n++
+	}
+	return n
+}
+
+// keyword consumes the keyword if it is next, ignoring case.
+func (s *createTableScanner) keyword(kw string) bool {
+	s.skipSpace()
+	if n := s.word(); n != len(kw) || !strings.EqualFold(s.s[s.pos:s.pos+n], kw) {
+		return false
+	}
+	s.pos += len(kw)
+	return true
+}
+
+// punct consumes the punctuation character if it is next.
+func (s *createTableScanner) punct(c byte) bool {
+	s.skipSpace()
+	if s.pos < len(s.s) && s.s[s.pos] == c {
+		s.pos++
+		return true
+	}
+	return false
+}
+
+// ident consumes an identifier, which is either an unquoted word or
+// quoted with backquotes as by quoteSQLName.
+func (s *createTableScanner) ident() (string, error) {
+	s.skipSpace()
+	if s.pos < len(s.s) && s.s[s.pos] == '`' {
+		var name []byte
+		for i := s.pos + 1; i < len(s.s); i++ {
+			if s.s[i] != '`' {
+				name = append(name, s.s[i])
+			} else if i+1 < len(s.s) && s.s[i+1] == '`' {
+				name = append(name, '`')
+				i++
+			} else {
+				s.pos = i + 1
+				return string(name), nil
+			}
+		}
+		return "", s.errorf("closing `")
+	}
+	n := s.word()
+	if n == 0 {
+		return "", s.errorf("identifier")
+	}
+	s.pos += n
+	return s.s[s.pos-n : s.pos], nil
+}
+
+// name consumes a table name, which may be qualified with a database name.
+func (s *createTableScanner) name() (string, error) {
+	name, err := s.ident()
+	if err != nil || s.pos >= len(s.s) || s.s[s.pos] != '.' {
+		return name, err
+	}
+	s.pos++
+	table, err := s.ident()
+	return name + "." + table, err
+}
+
+// parenExpr consumes a parenthesized expression and returns it without
+// the enclosing parentheses. Parentheses within string literals are
+// ignored.
+func (s *createTableScanner) parenExpr() (string, error) {
+	if !s.punct('(') {
+		return "", s.errorf("(")
+	}
+	start, depth := s.pos, 1
+	for ; s.pos < len(s.s); s.pos++ {
+		switch s.s[s.pos] {
+		case '\'':
+			for s.pos++; s.pos < len(s.s) && s.s[s.pos] != '\''; s.pos++ {
+				if s.s[s.pos] == '\\' {
+					s.pos++
+				}
+			}
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				s.pos++
+				return s.s[start : s.pos-1], nil
+			}
+		}
+	}
+	return "", s.errorf(")")
+}
+
+// indexParts consumes the parenthesized column names and key expressions
+// of an index.
+func (s *createTableScanner) indexParts(index *proto.TableSchema_IndexByName) error {
+	if !s.punct('(') {
+		return s.errorf("(")
+	}
+	for {
+		if s.skipSpace(); s.pos < len(s.s) && s.s[s.pos] == '(' {
+			expr, err := s.parenExpr()
+			if err != nil {
+				return err
+			}
+			index.KeyExprs = append(index.KeyExprs, expr)
+		} else {
+			name, err := s.ident()
+			if err != nil {
+				return err
+			}
+			index.ColumnNames = append(index.ColumnNames, name)
+		}
+		if s.punct(')') {
+			return nil
+		}
+		if !s.punct(',') {
+			return s.errorf(", or )")
+		}
+	}
+}
+
+// quoteSQLName quotes a table, column or index name as a SQL identifier.
+// The database of a qualified table name is quoted separately.
+func quoteSQLName(name string) string {
+	parts := strings.Split(name, ".")
+	for i := range parts {
+		parts[i] = "`" + strings.Replace(parts[i], "`", "``", -1) + "`"
+	}
+	return strings.Join(parts, ".")
+}
+
+// encodeSQLValue appends a value as returned by convertValue to buf as a
+// SQL literal accepted by ExecSQL. BYTES and JSON values are encoded as
+// strings.
+func encodeSQLValue(buf []byte, v interface{}) ([]byte, error) {
+	switch t := v.(type) {
+	case []byte:
+		v = string(t)
+	case json.RawMessage:
+		v = string(t)
+	}
+	return parser.EncodeSQLValue(buf, v)
+}
@@ -0,0 +1,161 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"golang.org/x/net/context"
+)
+
+func TestFormatCreateTable(t *testing.T) {
+	schema := testSchema("users")
+	schema.Columns[1].Name = "my`name"
+	schema.Indexes[1].ColumnNames[0] = "my`name"
+	expected := "CREATE TABLE `users` (\n" +
+		"  `id` INT,\n" +
+		"  `my``name` STRING,\n" +
+		"  CONSTRAINT `primary` PRIMARY KEY (`id`),\n" +
+		"  INDEX `by_name` (`my``name`)\n" +
+		");"
+	if s := formatCreateTable(schema); s != expected {
+		t.Errorf("expected\n%s\nbut found\n%s", expected, s)
+	}
+}
+
+// TestParseCreateTable verifies that the statements written by
+// formatCreateTable are parsed back into the same schema.
+func TestParseCreateTable(t *testing.T) {
+	schema := testSchema("users")
+	schema.Columns[1].Name = "my`name"
+	schema.Indexes[1].ColumnNames[0] = "my`name"
+	schema.Columns = append(schema.Columns, proto.Column{
+		Name: "upper", Type: proto.Column_STRING, ComputeExpr: "UPPER(name) || ')'"})
+	schema.Indexes = append(schema.Indexes,
+		proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_id", Unique: true}, ColumnNames: []string{"id"}},
+		proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_expr"}, KeyExprs: []string{"LOWER(name)"}})
+	parsed, err := parseCreateTable(formatCreateTable(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(schema, parsed) {
+		t.Errorf("expected %+v, but found %+v", schema, parsed)
+	}
+
+	testCases := []string{
+		"CREATE TABLE t",
+		"CREATE TABLE t (id INT)",
+		"CREATE TABLE t (id INTEGER, CONSTRAINT p PRIMARY KEY (id))",
+		"CREATE TABLE t (id INT, CONSTRAINT p PRIMARY KEY (id)",
+		"CREATE TABLE t (id INT, CONSTRAINT p PRIMARY KEY (id), CONSTRAINT q PRIMARY KEY (id))",
+		"CREATE TABLE t (id INT, CONSTRAINT p PRIMARY KEY (id)) x",
+		"CREATE TABLE t (`id INT, CONSTRAINT p PRIMARY KEY (id))",
+	}
+	for i, statement := range testCases {
+		if _, err := parseCreateTable(statement); err == nil {
+			t.Errorf("%d: expected %q to fail", i, statement)
+		}
+	}
+}
+
+// TestDumpTable verifies that a dumped table can be restored by executing
+// its statements.
+func TestDumpTable(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 3, "name": "", "score": -0.5, "active": false},
+		row{"id": 1, "name": "alice", "score": 1.25, "active": true,
+			"avatar": []byte("\x00\xff'"), "attrs": map[string]int{"a": 1}},
+		row{"id": 2, "name": "bob 'the builder'\n\\jr"},
+		row{"id": -4, "score": 2.0})
+	expected := scanTestRows(t, db, "users")
+
+	var buf bytes.Buffer
+	if err := db.DumpTable("users", &buf); err != nil {
+		t.Fatal(err)
+	}
+	statements := strings.Split(strings.TrimSuffix(buf.String(), ";\n"), ";\n")
+	if !strings.HasPrefix(statements[0], "CREATE TABLE `users` (") {
+		t.Fatalf("expected the dump to start with the schema, but found %q", statements[0])
+	}
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	var inserts int
+	for _, statement := range statements {
+		if strings.HasPrefix(statement, "INSERT") {
+			inserts++
+		}
+		if _, err := db.ExecSQL(statement); err != nil {
+			t.Fatalf("%s: %s", statement, err)
+		}
+	}
+	if inserts != len(expected) {
+		t.Errorf("expected %d INSERT statements, but found %d", len(expected), inserts)
+	}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+
+	if err := db.DumpTable("missing", &buf); err == nil {
+		t.Errorf("expected dumping a missing table to fail")
+	}
+}
+
+// TestDumpTableFixedTimestamp verifies that all of the chunks of a dumped
+// table are read at the same timestamp.
+func TestDumpTableFixedTimestamp(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 2
+
+	db, s := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1}, row{"id": 2}, row{"id": 3})
+	var scans []proto.Request
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		for _, req := range requests(call) {
+			if req.Method() == proto.Scan {
+				scans = append(scans, req)
+			}
+		}
+		s.Send(ctx, call)
+	})
+	var buf bytes.Buffer
+	if err := db.DumpTable("users", &buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(scans) < 2 {
+		t.Fatalf("expected the table to be scanned in chunks, but found %d scans", len(scans))
+	}
+	for i, req := range scans {
+		if header := req.Header(); header.Txn != nil || header.Timestamp.Equal(proto.ZeroTimestamp) ||
+			!header.Timestamp.Equal(scans[0].Header().Timestamp) {
+			t.Errorf("%d: expected a read at %s, but found %+v", i, scans[0].Header().Timestamp, header)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// TableGCChunkSize is the maximum number of keys deleted by a single
+// request when reclaiming the data of a dropped table.
+var TableGCChunkSize int64 = 1000
+
+// ListDroppedTables returns the descriptors of the tables which have been
+// dropped but whose data has not yet been reclaimed.
+func (db *DB) ListDroppedTables() ([]proto.TableDescriptor, error) {
+	var descs []proto.TableDescriptor
+	err := db.Txn(func(txn *Txn) error {
+		var err error
+		descs, err = listDroppedTables(txn)
+		return err
+	})
+	return descs, err
+}
+
+func listDroppedTables(txn *Txn) ([]proto.TableDescriptor, error) {
+	rows, err := txn.Scan(keys.DroppedTablePrefix, keys.DroppedTablePrefix.PrefixEnd(), 0)
+	if err != nil {
+		return nil, err
+	}
+	descs := make([]proto.TableDescriptor, len(rows))
+	for i, row := range rows {
+		if err := txn.GetProto(keys.MakeDescMetadataKey(decodeDescID(row.ValueBytes())), &descs[i]); err != nil {
+			return nil, err
+		}
+		if _, err := proto.MaybeUpgradeTableDescriptor(&descs[i]); err != nil {
+			return nil, err
+		}
+	}
+	return descs, nil
+}
+
+// UndropTable restores a dropped table whose data has not yet been
+// reclaimed. If the table was dropped more than once, the most recently
+// dropped table is restored. An error is returned if a table with the same
+// name has since been created, or if the reclamation of the table's data
+// has begun (see BeginTableReclamation).
+func (db *DB) UndropTable(name string) error {
+	dbName, tableName, err := splitTableName(name, db.defaultDatabase())
+	if err != nil {
+		return err
+	}
+	return db.Txn(func(txn *Txn) error {
+		dbDesc, err := getDatabaseDesc(txn, dbName)
+		if err != nil {
+			return err
+		}
+		descs, err := listDroppedTables(txn)
+		if err != nil {
+			return err
+		}
+		var desc *proto.TableDescriptor
+		for i := range descs {
+			d := &descs[i]
+			if d.ParentId == dbDesc.Id && d.Name == tableName &&
+				(desc == nil || d.DropTime > desc.DropTime) {
+				desc = d
+			}
+		}
+		if desc == nil {
+			return fmt.Errorf("dropped table %q does not exist", name)
+		}
+		if desc.ReclaimTime != 0 {
+			return fmt.Errorf("dropped table %q is being reclaimed", name)
+		}
+		if _, ok, err := getTableDesc(txn, dbDesc.Id, tableName); err != nil {
+			return err
+		} else if ok {
+			return &TableExistsError{Name: name}
+		}
+		desc.DropTime = 0
+		b := &Batch{}
+		b.CPut(keys.MakeTableMetadataKey(desc.ParentId, desc.Name), encodeDescID(desc.Id), nil)
+		b.Put(keys.MakeDescMetadataKey(desc.Id), desc)
+		b.Del(keys.MakeDroppedTableKey(desc.Id))
+		return txn.Commit(b)
+	})
+}
+
+// BeginTableReclamation marks the dropped table with the given ID as being
+// reclaimed, after which it can no longer be restored with UndropTable.
+// Reclaimers must call it before deleting any of the table's data. It
+// returns false, and marks nothing, if the table is no longer dropped or
+// was dropped less than gracePeriod ago.
+func (db *DB) BeginTableReclamation(tableID uint32, gracePeriod time.Duration) (bool, error) {
+	var ok bool
+	err := db.Txn(func(txn *Txn) error {
+		ok = false
+		var desc proto.TableDescriptor
+		if err := txn.GetProto(keys.MakeDescMetadataKey(tableID), &desc); err != nil {
+			return err
+		}
+		if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+			return err
+		}
+		now := time.Now().UnixNano()
+		if desc.Id != tableID || desc.DropTime == 0 || now-desc.DropTime < gracePeriod.Nanoseconds() {
+			return nil
+		}
+		ok = true
+		if desc.ReclaimTime != 0 {
+			return nil
+		}
+		desc.ReclaimTime = now
+		return txn.Put(keys.MakeDescMetadataKey(tableID), &desc)
+	})
+	return ok, err
+}
+
+// GCDroppedTables reclaims the data of tables dropped more than gracePeriod
+// ago, returning the number of tables reclaimed. Data is deleted in chunks
+// of TableGCChunkSize keys so that no single request grows too large; the
+// descriptor and descriptor leases of a table are removed once all of its
+// data has been deleted. A table may no longer be restored once
+// reclamation has begun. The reclamation of each table is recorded as a
+// schema job (see SchemaJobs); tables with a running reclamation job are
+// skipped.
+func (db *DB) GCDroppedTables(gracePeriod time.Duration) (int, error) {
+	descs, err := db.ListDroppedTables()
+	if err != nil {
+		return 0, err
+	}
+	jobs, err := db.SchemaJobs()
+	if err != nil {
+		return 0, err
+	}
+	running := map[uint32]bool{}
+	for _, job := range jobs {
+		if job.Type == proto.SchemaJob_TABLE_GC && job.Status == proto.SchemaJob_RUNNING {
+			running[job.TableId] = true
+		}
+	}
+	now := time.Now().UnixNano()
+	var reclaimed int
+	for _, desc := range descs {
+		if now-desc.DropTime < gracePeriod.Nanoseconds() || running[desc.Id] {
+			continue
+		}
+		if ok, err := db.BeginTableReclamation(desc.Id, gracePeriod); err != nil {
+			return reclaimed, err
+		} else if !ok {
+			// The table has been restored since it was listed.
+			continue
+		}
+		job, err := db.startSchemaJob(proto.SchemaJob{
+			Type:        proto.SchemaJob_TABLE_GC,
+			TableId:     desc.Id,
+			Description: fmt.Sprintf("reclaim data of dropped table %q", desc.Name),
+		})
+		if err != nil {
+			return reclaimed, err
+		}
+		if err := db.reclaimTable(job); err != nil {
+			switch err.(type) {
+			case *SchemaJobCanceledError, *SchemaJobLeaseError:
+				continue
+			}
+			return reclaimed, err
+		}
+		reclaimed++
+	}
+	return reclaimed, nil
+}
+
+// reclaimTable runs the TABLE_GC job of a dropped table, deleting its data
+// and then its descriptor, and records the outcome.
+func (db *DB) reclaimTable(job *schemaJob) error {
+	tableID, deleted := job.job.TableId, job.job.Progress
+	err := db.deleteTableData(tableID, func(total int64) error {
+		return job.checkpoint(deleted+total, nil)
+	})
+	if err == nil {
+		err = db.Txn(func(txn *Txn) error {
+			b := &Batch{}
+			DeleteTableMetadata(b, tableID)
+			return txn.Commit(b)
+		})
+	}
+	return job.finish(err)
+}
+
+// DeleteTableMetadata adds the deletion of the metadata of a reclaimed
+// table to b: its descriptor and the leases on it, its dropped-table, TTL
+// and statistics keys. It is shared by all reclaimers of dropped tables,
+// which must delete the table's data first.
+func DeleteTableMetadata(b *Batch, tableID uint32) {
+	b.Del(keys.MakeDescMetadataKey(tableID), keys.MakeDroppedTableKey(tableID),
+		keys.MakeTableTTLKey(tableID), keys.MakeTableStatsKey(tableID))
+	leases := keys.MakeDescLeasePrefix(tableID)
+	b.DelRange(leases, leases.PrefixEnd())
+}
+
+// TruncateTable deletes all of the rows of the named table, including
+// their secondary index entries, while preserving the table's descriptor.
+// The data is deleted in chunks of TableGCChunkSize keys, each in its own
+// batch, so a truncation is not atomic: rows written concurrently may or
+// may not survive, and a failed truncation leaves some rows behind. It is
+// safe to retry. System tables can only be truncated with ForceOpt.
+func (db *DB) TruncateTable(name string, opts ...TableOption) error {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		if desc, err = getTableDescByName(txn, name); err != nil {
+			return err
+		}
+		return checkSystemTable(&desc, name, opts)
+	}); err != nil {
+		return err
+	}
+	if o := makeTableOptions(opts); o.dryRun != nil {
+		o.resetPlan()
+		prefix := keys.MakeTablePrefix(desc.Id)
+		o.addSpan(prefix, prefix.PrefixEnd())
+		return nil
+	}
+	return db.deleteTableData(desc.Id, nil)
+}
+
+// deleteTableData deletes the data of the table with the given ID in
+// chunks of TableGCChunkSize keys. If progress is non-nil it is invoked
+// after each chunk with the total number of keys deleted so far, stopping
+// the deletion if it returns an error.
+func (db *DB) deleteTableData(tableID uint32, progress func(total int64) error) error {
+	prefix := keys.MakeTablePrefix(tableID)
+	var total int64
+	for {
+		b := &Batch{}
+		b.DelRangeLimit(prefix, prefix.PrefixEnd(), TableGCChunkSize)
+		b.SetUserPriority(LowUserPriority)
+		if err := db.Run(b); err != nil {
+			return err
+		}
+		deleted := b.Results[0].Deleted
+		total += deleted
+		if progress != nil {
+			if err := progress(total); err != nil {
+				return err
+			}
+		}
+		if deleted < TableGCChunkSize {
+			return nil
+		}
+	}
+}
+
+// RunTableGC starts a worker which calls GCDroppedTables every interval
+// until the stopper is stopped. Errors are logged and retried at the next
+// interval.
+func (db *DB) RunTableGC(stopper *util.Stopper, interval, gracePeriod time.Duration) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if !stopper.StartTask() {
+					continue
+				}
+				if _, err := db.GCDroppedTables(gracePeriod); err != nil {
+					log.Warningf("failed to reclaim dropped tables: %s", err)
+				}
+				stopper.FinishTask()
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// createTableWithRows creates a table and writes the given number of keys
+// into its span, returning the table's ID.
+func createTableWithRows(t *testing.T, db *DB, name string, rows int) uint32 {
+	if err := db.CreateTable(testSchema(name)); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < rows; i++ {
+		key := keys.MakeKey(keys.MakeTablePrefix(desc.Id), proto.Key(fmt.Sprintf("%03d", i)))
+		if err := db.Put(key, "value"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return desc.Id
+}
+
+func countTableKeys(s *memSender, tableID uint32) int {
+	prefix := keys.MakeTablePrefix(tableID)
+	return len(s.sortedKeys(prefix, prefix.PrefixEnd()))
+}
+
+func TestUndropTable(t *testing.T) {
+	db, s := newMemDB()
+
+	id := createTableWithRows(t, db, "users", 3)
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if descs, err := db.ListDroppedTables(); err != nil {
+		t.Fatal(err)
+	} else if len(descs) != 1 || descs[0].Id != id {
+		t.Fatalf("expected table %d to be dropped, but found %+v", id, descs)
+	}
+	if err := db.UndropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Id != id || desc.DropTime != 0 {
+		t.Errorf("unexpected restored descriptor: %+v", desc)
+	}
+	if n := countTableKeys(s, id); n != 3 {
+		t.Errorf("expected 3 keys, but found %d", n)
+	}
+	if descs, err := db.ListDroppedTables(); err != nil || len(descs) != 0 {
+		t.Errorf("expected no dropped tables, but found %+v (%v)", descs, err)
+	}
+
+	// A table cannot be restored over a newly created table of the same name.
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.UndropTable("users"); err == nil || err.Error() != `table "users" already exists` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.UndropTable("accounts"); err == nil ||
+		err.Error() != `dropped table "accounts" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// A table can no longer be restored once its reclamation has begun.
+	other, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := db.BeginTableReclamation(other.Id, time.Hour); err != nil || ok {
+		t.Errorf("expected the grace period to prevent reclamation, but found %t (%v)", ok, err)
+	}
+	if ok, err := db.BeginTableReclamation(other.Id, 0); err != nil || !ok {
+		t.Fatalf("expected the reclamation to begin, but found %t (%v)", ok, err)
+	}
+	if err := db.UndropTable("users"); err == nil ||
+		err.Error() != `dropped table "users" is being reclaimed` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGCDroppedTables(t *testing.T) {
+	defer func(n int64) { TableGCChunkSize = n }(TableGCChunkSize)
+	TableGCChunkSize = 2
+
+	db, s := newMemDB()
+
+	usersID := createTableWithRows(t, db, "users", 5)
+	accountsID := createTableWithRows(t, db, "accounts", 4)
+	if _, err := db.AcquireTableLease("users"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"users", "accounts"} {
+		if err := db.DropTable(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Nothing is reclaimed within the grace period.
+	if n, err := db.GCDroppedTables(time.Hour); err != nil || n != 0 {
+		t.Errorf("expected no tables to be reclaimed, but found %d (%v)", n, err)
+	}
+	if n := countTableKeys(s, usersID); n != 5 {
+		t.Errorf("expected 5 keys, but found %d", n)
+	}
+
+	// A table whose reclamation is already running is skipped.
+	job, err := db.startSchemaJob(proto.SchemaJob{Type: proto.SchemaJob_TABLE_GC, TableId: accountsID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := db.GCDroppedTables(0); err != nil || n != 1 {
+		t.Errorf("expected 1 table to be reclaimed, but found %d (%v)", n, err)
+	}
+	if n := countTableKeys(s, accountsID); n != 4 {
+		t.Errorf("expected 4 keys, but found %d", n)
+	}
+	if err := job.finish(errors.New("interrupted")); err == nil {
+		t.Fatal("expected the job's error")
+	}
+
+	if n, err := db.GCDroppedTables(0); err != nil || n != 1 {
+		t.Errorf("expected 2 tables to be reclaimed, but found %d (%v)", n, err)
+	}
+	for _, id := range []uint32{usersID, accountsID} {
+		if n := countTableKeys(s, id); n != 0 {
+			t.Errorf("%d: expected table data to be deleted, but found %d keys", id, n)
+		}
+		if r, err := db.Get(keys.MakeDescMetadataKey(id)); err != nil || r.Exists() {
+			t.Errorf("%d: expected descriptor to be deleted (%v)", id, err)
+		}
+		leases := keys.MakeDescLeasePrefix(id)
+		if kvs, err := db.Scan(leases, leases.PrefixEnd(), 0); err != nil || len(kvs) != 0 {
+			t.Errorf("%d: expected leases to be deleted, but found %d (%v)", id, len(kvs), err)
+		}
+	}
+	if descs, err := db.ListDroppedTables(); err != nil || len(descs) != 0 {
+		t.Errorf("expected no dropped tables, but found %+v (%v)", descs, err)
+	}
+}
+
+func TestTruncateTable(t *testing.T) {
+	db, s := newMemDB()
+
+	defer func(n int64) { TableGCChunkSize = n }(TableGCChunkSize)
+	TableGCChunkSize = 2
+
+	usersID := createTableWithRows(t, db, "users", 5)
+	ordersID := createTableWithRows(t, db, "orders", 3)
+	before, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.TruncateTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if n := countTableKeys(s, usersID); n != 0 {
+		t.Errorf("expected truncated table to be empty, but found %d keys", n)
+	}
+	if n := countTableKeys(s, ordersID); n != 3 {
+		t.Errorf("expected other table to be untouched, but found %d keys", n)
+	}
+	after, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(before, after) {
+		t.Errorf("expected descriptor %+v to be preserved, but found %+v", before, after)
+	}
+
+	if err := db.TruncateTable("missing"); err == nil || err.Error() != `table "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// IndexDirection is the sort order of a key column of an index.
+type IndexDirection int
+
+const (
+	// Ascending indexes sort their keys in increasing order. All indexes are
+	// currently ascending.
+	Ascending IndexDirection = iota
+)
+
+func (d IndexDirection) String() string {
+	if d == Ascending {
+		return "ASC"
+	}
+	return fmt.Sprintf("IndexDirection(%d)", int(d))
+}
+
+// IndexInfo describes an index of a table.
+type IndexInfo struct {
+	Name    string
+	Unique  bool
+	Primary bool
+	// ColumnNames and KeyExprs are the key columns or expressions of the
+	// index; an index has one or the other.
+	ColumnNames []string
+	KeyExprs    []string
+	// Directions holds the sort order of each key column or expression.
+	Directions []IndexDirection
+	// StoredColumns lists the columns, other than the key columns, whose
+	// values can be read from the index: every other column for the
+	// primary index and the primary key columns for secondary indexes.
+	StoredColumns []string
+}
+
+// ListIndexes describes the indexes of the named table, starting with the
+// primary index.
+func (db *DB) ListIndexes(table string) ([]IndexInfo, error) {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		return nil, err
+	}
+	var infos []IndexInfo
+	for i, index := range desc.AllIndexes() {
+		infos = append(infos, makeIndexInfo(&desc, index, i == 0))
+	}
+	return infos, nil
+}
+
+// DescribeIndex describes the named index of the named table.
+func (db *DB) DescribeIndex(table, index string) (IndexInfo, error) {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		return IndexInfo{}, err
+	}
+	for i, other := range desc.AllIndexes() {
+		if other.Name == index {
+			return makeIndexInfo(&desc, other, i == 0), nil
+		}
+	}
+	return IndexInfo{}, fmt.Errorf("table %q: index %q does not exist", table, index)
+}
+
+func makeIndexInfo(desc *proto.TableDescriptor, index proto.IndexDescriptor, primary bool) IndexInfo {
+	info := IndexInfo{
+		Name:     index.Name,
+		Unique:   index.Unique,
+		Primary:  primary,
+		KeyExprs: index.KeyExprs,
+	}
+	columns := columnsByID(desc)
+	keyColumns := map[uint32]bool{}
+	for _, id := range index.ColumnIds {
+		info.ColumnNames = append(info.ColumnNames, columns[id].Name)
+		keyColumns[id] = true
+	}
+	for i := 0; i < len(index.ColumnIds)+len(index.KeyExprs); i++ {
+		info.Directions = append(info.Directions, Ascending)
+	}
+	if primary {
+		for _, column := range desc.Columns {
+			if !keyColumns[column.Id] {
+				info.StoredColumns = append(info.StoredColumns, column.Name)
+			}
+		}
+	} else {
+		for _, id := range desc.PrimaryIndex.ColumnIds {
+			if !keyColumns[id] {
+				info.StoredColumns = append(info.StoredColumns, columns[id].Name)
+			}
+		}
+	}
+	return info
+}
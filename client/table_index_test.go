@@ -0,0 +1,70 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestListIndexes(t *testing.T) {
+	db, _ := newMemDB()
+	schema := testSchema("users")
+	schema.Columns = append(schema.Columns, proto.Column{Name: "email", Type: proto.Column_STRING})
+	schema.Indexes = append(schema.Indexes,
+		proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_email", Unique: true}, ColumnNames: []string{"email", "id"}},
+		proto.TableSchema_IndexByName{Index: proto.Index{Name: "by_lower_name"}, KeyExprs: []string{"lower(name)"}})
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []IndexInfo{
+		{Name: "primary", Unique: true, Primary: true, ColumnNames: []string{"id"},
+			Directions: []IndexDirection{Ascending}, StoredColumns: []string{"name", "email"}},
+		{Name: "by_name", ColumnNames: []string{"name"},
+			Directions: []IndexDirection{Ascending}, StoredColumns: []string{"id"}},
+		{Name: "by_email", Unique: true, ColumnNames: []string{"email", "id"},
+			Directions: []IndexDirection{Ascending, Ascending}},
+		{Name: "by_lower_name", KeyExprs: []string{"lower(name)"},
+			Directions: []IndexDirection{Ascending}, StoredColumns: []string{"id"}},
+	}
+	infos, err := db.ListIndexes("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, infos) {
+		t.Errorf("expected %+v, but found %+v", expected, infos)
+	}
+	for _, e := range expected {
+		info, err := db.DescribeIndex("users", e.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(e, info) {
+			t.Errorf("expected %+v, but found %+v", e, info)
+		}
+	}
+
+	if _, err := db.DescribeIndex("users", "missing"); err == nil ||
+		err.Error() != `table "users": index "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := db.ListIndexes("missing"); err == nil || err.Error() != `table "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
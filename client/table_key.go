@@ -0,0 +1,178 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// A DecodedKey describes a key of the data of a table. See DecodeKey.
+type DecodedKey struct {
+	// Table is the name of the table, qualified with the name of its
+	// database unless it is in the default database.
+	Table   string
+	TableID uint32
+	// Index is the name of the index, which is empty for keys holding only
+	// the table ID.
+	Index   string
+	IndexID uint32
+	// Columns and Values hold the names and values of the columns encoded
+	// in the key, in order. The entries of non-unique secondary indexes
+	// are followed by the columns of the primary key.
+	Columns []string
+	Values  []interface{}
+	// Column is the name of the column stored under a cell key of the
+	// primary index; it is empty for other keys.
+	Column string
+	// Rest holds the suffix of the key which could not be decoded, such
+	// as the values of expression indexes.
+	Rest proto.Key
+}
+
+// String formats the decoded key, e.g.
+//
+//   /users(51)/primary(1)/id=1/name
+func (k *DecodedKey) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "/%s(%d)", k.Table, k.TableID)
+	if k.Index != "" {
+		fmt.Fprintf(&buf, "/%s(%d)", k.Index, k.IndexID)
+	}
+	for i, name := range k.Columns {
+		switch v := k.Values[i].(type) {
+		case string, []byte:
+			fmt.Fprintf(&buf, "/%s=%q", name, v)
+		default:
+			fmt.Fprintf(&buf, "/%s=%v", name, v)
+		}
+	}
+	if k.Column != "" {
+		fmt.Fprintf(&buf, "/%s", k.Column)
+	}
+	if len(k.Rest) > 0 {
+		fmt.Fprintf(&buf, "/%q", []byte(k.Rest))
+	}
+	return buf.String()
+}
+
+// DecodeKey decodes a key of the data of a table, returning the table,
+// index, column values and column it addresses. An error is returned if
+// the key is not in the structured keyspace or its table does not exist.
+// The descriptor of a dropped table is used until its data has been
+// reclaimed.
+func (db *DB) DecodeKey(key proto.Key) (*DecodedKey, error) {
+	b, id, err := decodeUvarintSafe(key)
+	if err != nil || id == 0 || id > uint64(^uint32(0)) {
+		return nil, fmt.Errorf("key %q is not in the structured keyspace", key)
+	}
+	var desc proto.TableDescriptor
+	var dbDesc proto.DatabaseDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		if err := txn.GetProto(keys.MakeDescMetadataKey(uint32(id)), &desc); err != nil {
+			return err
+		}
+		if desc.Id != uint32(id) || desc.PrimaryIndex.Id == 0 {
+			return fmt.Errorf("key %q: table %d does not exist", key, id)
+		}
+		if desc.ParentId == keys.DefaultDatabaseID {
+			return nil
+		}
+		return txn.GetProto(keys.MakeDescMetadataKey(desc.ParentId), &dbDesc)
+	}); err != nil {
+		return nil, err
+	}
+	if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+		return nil, err
+	}
+	return decodeTableKey(&desc, dbDesc.Name, key, b)
+}
+
+// decodeTableKey decodes the suffix b of a key of the described table,
+// following the table ID.
+func decodeTableKey(desc *proto.TableDescriptor, database string, key proto.Key, b []byte) (*DecodedKey, error) {
+	k := &DecodedKey{Table: desc.Name, TableID: desc.Id}
+	if database != "" {
+		k.Table = database + "." + desc.Name
+	}
+	if len(b) == 0 {
+		return k, nil
+	}
+	b, indexID, err := decodeUvarintSafe(b)
+	if err != nil {
+		return nil, fmt.Errorf("key %q: %s", key, err)
+	}
+	index, ok := findIndexByID(desc, uint32(indexID))
+	if !ok {
+		return nil, fmt.Errorf("key %q: index %d of table %q does not exist", key, indexID, desc.Name)
+	}
+	k.Index, k.IndexID = index.Name, index.Id
+
+	// The values are decoded for as long as possible: a malformed suffix
+	// is left in Rest.
+	columns := columnsByID(desc)
+	decode := func(ids []uint32) bool {
+		for _, id := range ids {
+			if len(b) == 0 {
+				return false
+			}
+			column := columns[id]
+			rest, v, err := decodeKeyValue(b, column.Type)
+			if err != nil {
+				return false
+			}
+			b = rest
+			k.Columns = append(k.Columns, column.Name)
+			k.Values = append(k.Values, v)
+		}
+		return true
+	}
+	if decode(index.ColumnIds) {
+		switch {
+		case index.Id != desc.PrimaryIndex.Id:
+			if len(index.KeyExprs) == 0 && !index.Unique {
+				decode(desc.PrimaryIndex.ColumnIds)
+			}
+		case len(b) > 0:
+			if rest, id, err := decodeUvarintSafe(b); err == nil && len(rest) == 0 {
+				if column, ok := columns[uint32(id)]; ok {
+					k.Column, b = column.Name, nil
+				}
+			}
+		}
+	}
+	if len(b) > 0 {
+		k.Rest = proto.Key(b)
+	}
+	return k, nil
+}
+
+// findIndexByID returns the index of the table with the given ID,
+// including the primary index.
+func findIndexByID(desc *proto.TableDescriptor, id uint32) (proto.IndexDescriptor, bool) {
+	if desc.PrimaryIndex.Id == id {
+		return desc.PrimaryIndex, true
+	}
+	for _, index := range desc.Indexes {
+		if index.Id == id {
+			return index, true
+		}
+	}
+	return proto.IndexDescriptor{}, false
+}
@@ -0,0 +1,90 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestDecodeKey(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("app.users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "app.users", row{"id": 1, "name": "alice"})
+	desc, err := db.DescribeTableDesc("app.users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tablePrefix := keys.MakeTablePrefix(desc.Id)
+	kvs, err := db.Scan(tablePrefix, tablePrefix.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded []string
+	for _, kv := range kvs {
+		k, err := db.DecodeKey(kv.Key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded = append(decoded, k.String())
+	}
+	users := fmt.Sprintf("/app.users(%d)", desc.Id)
+	primary := fmt.Sprintf("%s/primary(%d)", users, desc.PrimaryIndex.Id)
+	byName := fmt.Sprintf("%s/by_name(%d)", users, desc.Indexes[0].Id)
+	expected := []string{
+		primary + "/id=1",
+		primary + "/id=1/name",
+		byName + `/name="alice"/id=1`,
+	}
+	if fmt.Sprint(expected) != fmt.Sprint(decoded) {
+		t.Errorf("expected %q, but found %q", expected, decoded)
+	}
+
+	testCases := []struct {
+		key      proto.Key
+		expected string
+	}{
+		{tablePrefix, users},
+		{makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id), primary},
+		{append(makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id), "\x8a\xff"...), primary + `/"\x8a\xff"`},
+	}
+	for i, c := range testCases {
+		if k, err := db.DecodeKey(c.key); err != nil {
+			t.Errorf("%d: %s", i, err)
+		} else if s := k.String(); s != c.expected {
+			t.Errorf("%d: expected %q, but found %q", i, c.expected, s)
+		}
+	}
+
+	for i, key := range []proto.Key{
+		proto.Key("a"),
+		keys.SystemPrefix,
+		keys.MakeTablePrefix(desc.Id + 100),
+		makeIndexPrefix(desc.Id, 100),
+	} {
+		if _, err := db.DecodeKey(key); err == nil {
+			t.Errorf("%d: expected decoding %q to fail", i, key)
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/retry"
+)
+
+// Table descriptor leases guarantee that the holders of a lease on a
+// version of a table's schema use a schema which is at most one version
+// behind the current one. Leases are stored in the system keyspace:
+//
+//   keys.MakeDescLeaseKey(<table ID>, <version>, <lease ID>) -> expiration
+//
+// where the expiration is a varint of nanoseconds since the epoch. A schema
+// change publishing version v+1 of a descriptor first waits until no
+// unexpired leases are held on versions older than v, deleting the expired
+// leases on those versions, and checks again within its transaction.
+// Leases are acquired within a transaction which reads the current
+// descriptor, so a lease is never acquired on a version older than the
+// current one.
+//
+// The servers neither assign nor enforce expirations: the client acquiring
+// a lease computes its expiration from its own clock, and the client
+// performing a schema change compares it to its own clock. The clocks of
+// the two clients must therefore be within TableLeaseDuration of each
+// other for the guarantee to hold.
+
+// TableLeaseDuration is the duration of table descriptor leases. A
+// schema change blocks for at most this long waiting for the holders of
+// leases on older versions of the table's schema.
+var TableLeaseDuration = 5 * time.Minute
+
+// tableLeaseRetryOptions sets the backoff with which schema changes poll
+// for the release or expiration of leases on older versions.
+var tableLeaseRetryOptions = retry.Options{
+	Backoff:     10 * time.Millisecond,
+	MaxBackoff:  time.Second,
+	Constant:    2,
+	MaxAttempts: 0,
+	UseV1Info:   true,
+}
+
+// A TableLease is a lease on a version of a table's descriptor. See
+// DB.AcquireTableLease.
+type TableLease struct {
+	// Desc is the leased version of the descriptor.
+	Desc proto.TableDescriptor
+	// Expiration is the time at which the lease expires, according to the
+	// clock of the client which acquired it. Desc must not be used after
+	// the lease expires.
+	Expiration time.Time
+	key        proto.Key
+}
+
+// A TableLeaseError is returned by a schema change if unexpired leases
+// are held on a version of the table's descriptor older than the current
+// one, so that publishing the next version would leave their holders two
+// versions behind. Schema changes wait for such leases before starting,
+// so the error only occurs if a concurrent schema change published a new
+// version in the meantime.
+type TableLeaseError struct {
+	Table   string
+	Version uint32
+}
+
+// Error implements the error interface.
+func (e *TableLeaseError) Error() string {
+	return fmt.Sprintf("table %q: leases are held on version %d", e.Table, e.Version)
+}
+
+// AcquireTableLease acquires a lease on the current version of the
+// descriptor of the named table. While the lease is unexpired, the
+// version of the table's descriptor is at most one greater than the
+// leased version: clients caching a table descriptor should hold a lease
+// on it and acquire a new one before the lease expires. The lease should
+// be released with ReleaseTableLease once the descriptor is no longer
+// used, so that schema changes need not wait for it to expire.
+func (db *DB) AcquireTableLease(name string) (*TableLease, error) {
+	lease := &TableLease{}
+	if err := db.Txn(func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, name)
+		if err != nil {
+			return err
+		}
+		lease.Desc = desc
+		lease.Expiration = time.Now().Add(TableLeaseDuration)
+		lease.key = keys.MakeDescLeaseKey(desc.Id, desc.Version, []byte(util.NewUUID4()))
+		b := &Batch{}
+		b.Put(lease.key, encoding.EncodeVarint(nil, lease.Expiration.UnixNano()))
+		return txn.Commit(b)
+	}); err != nil {
+		return nil, err
+	}
+	return lease, nil
+}
+
+// ReleaseTableLease releases a lease acquired with AcquireTableLease.
+func (db *DB) ReleaseTableLease(lease *TableLease) error {
+	return db.Del(lease.key)
+}
+
+// schemaChangeTxn runs retryable, which publishes a new version of the
+// descriptor of the named table with bumpTableVersion, in a transaction
+// once no unexpired leases are held on versions older than the current
+// one. The transaction is retried if a concurrent schema change publishes
+// a new version in the meantime.
+func (db *DB) schemaChangeTxn(name string, retryable func(txn *Txn) error) error {
+	for {
+		if err := db.waitForTableLeases(name); err != nil {
+			return err
+		}
+		err := db.Txn(retryable)
+		if _, ok := err.(*TableLeaseError); !ok {
+			return err
+		}
+	}
+}
+
+// waitForTableLeases blocks until no unexpired leases are held on
+// versions of the named table's descriptor older than its current
+// version. It returns immediately if the table does not exist.
+func (db *DB) waitForTableLeases(name string) error {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		if _, ok := err.(*TableNotFoundError); ok {
+			return nil
+		}
+		return err
+	}
+	return db.waitForDescLeases(&desc)
+}
+
+// waitForDescLeases blocks until no unexpired leases are held on versions
+// of the table's descriptor older than desc.Version.
+func (db *DB) waitForDescLeases(desc *proto.TableDescriptor) error {
+	return retry.WithBackoff(tableLeaseRetryOptions, func() (retry.Status, error) {
+		kvs, err := db.Scan(keys.MakeDescLeasePrefix(desc.Id), keys.MakeDescLeaseKey(desc.Id, desc.Version, nil), 0)
+		if err != nil {
+			return retry.Break, err
+		}
+		now := time.Now()
+		if err := db.deleteExpiredTableLeases(kvs, now); err != nil {
+			return retry.Break, err
+		}
+		if version, ok := oldestTableLease(desc.Id, kvs, now); ok {
+			return retry.Continue, &TableLeaseError{Table: desc.Name, Version: version}
+		}
+		return retry.Break, nil
+	})
+}
+
+// deleteExpiredTableLeases deletes the leases among kvs which are expired
+// at now. Leases which are not released by their holders are otherwise
+// never deleted.
+func (db *DB) deleteExpiredTableLeases(kvs []KeyValue, now time.Time) error {
+	b := &Batch{}
+	var expired int
+	for _, kv := range kvs {
+		if _, expiration := encoding.DecodeVarint(kv.ValueBytes()); expiration <= now.UnixNano() {
+			b.Del(kv.Key)
+			expired++
+		}
+	}
+	if expired == 0 {
+		return nil
+	}
+	return db.Run(b)
+}
+
+// bumpTableVersion increments the version of the table's descriptor
+// within the transaction of a schema change run by schemaChangeTxn. A
+// *TableLeaseError is returned if unexpired leases are held on versions
+// older than the current one.
+func bumpTableVersion(txn *Txn, desc *proto.TableDescriptor) error {
+	kvs, err := txn.Scan(keys.MakeDescLeasePrefix(desc.Id), keys.MakeDescLeaseKey(desc.Id, desc.Version, nil), 0)
+	if err != nil {
+		return err
+	}
+	if version, ok := oldestTableLease(desc.Id, kvs, time.Now()); ok {
+		return &TableLeaseError{Table: desc.Name, Version: version}
+	}
+	desc.Version++
+	return nil
+}
+
+// oldestTableLease returns the version of the oldest lease among the
+// leases on the descriptor with the given ID which is unexpired at now.
+func oldestTableLease(descID uint32, kvs []KeyValue, now time.Time) (uint32, bool) {
+	prefix := keys.MakeDescLeasePrefix(descID)
+	for _, kv := range kvs {
+		_, expiration := encoding.DecodeVarint(kv.ValueBytes())
+		if expiration <= now.UnixNano() {
+			continue
+		}
+		_, version := encoding.DecodeUvarint(kv.Key[len(prefix):])
+		return uint32(version), true
+	}
+	return 0, false
+}
@@ -0,0 +1,97 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestTableLease(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	lease, err := db.AcquireTableLease("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease.Desc.Name != "users" || !lease.Expiration.After(time.Now()) {
+		t.Fatalf("unexpected lease %+v", lease)
+	}
+
+	// The leased version is current, so the next version can be published.
+	if err := db.RenameColumn("users", "name", "first_name"); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Version != lease.Desc.Version+1 {
+		t.Fatalf("expected version %d, but found %d", lease.Desc.Version+1, desc.Version)
+	}
+
+	// Publishing another version would leave the lease holder two versions
+	// behind.
+	if err := db.Txn(func(txn *Txn) error {
+		return bumpTableVersion(txn, &desc)
+	}); err == nil {
+		t.Fatal("expected an error bumping the version with an older lease held")
+	} else if _, ok := err.(*TableLeaseError); !ok {
+		t.Fatalf("expected a TableLeaseError, but found %T: %s", err, err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- db.RenameColumn("users", "first_name", "name")
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected the schema change to wait for the lease, but found %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if err := db.ReleaseTableLease(lease); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+
+	// Expired leases are ignored, and deleted by the schema changes which
+	// wait for them.
+	defer func(d time.Duration) { TableLeaseDuration = d }(TableLeaseDuration)
+	TableLeaseDuration = 0
+	if _, err := db.AcquireTableLease("users"); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if err := db.AddColumn("users", proto.Column{Name: fmt.Sprintf("c%d", i), Type: proto.Column_INT}, nil); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+	}
+	leases := keys.MakeDescLeasePrefix(desc.Id)
+	if kvs, err := db.Scan(leases, leases.PrefixEnd(), 0); err != nil || len(kvs) != 0 {
+		t.Errorf("expected expired leases to be deleted, but found %d (%v)", len(kvs), err)
+	}
+
+	if _, err := db.AcquireTableLease("missing"); err == nil {
+		t.Error("expected an error leasing a missing table")
+	}
+}
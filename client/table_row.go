@@ -0,0 +1,545 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// The rows of a table are stored in its primary index:
+//
+//   <table ID><index ID><primary key values> -> empty
+//   <table ID><index ID><primary key values><column ID> -> column value
+//
+// The first key, the row's sentinel, marks the existence of the row. Every
+// other column with a value is stored under its own key, suffixed by the
+// column's ID, so that renaming a column does not touch any data. IDs are
+// encoded as uvarints and primary key values using an order-preserving
+// encoding, making a scan of the index return the rows in primary key
+// order. Column values are stored as the bytes of the values.
+//
+// The keys of the layout are built by the keys package and rows are
+// written with a single PutRow request, which the server expands into the
+// writes of the sentinel and the cells.
+
+// A row maps column names to values.
+type row map[string]interface{}
+
+// makeIndexPrefix returns the key prefix of the index with the given ID.
+func makeIndexPrefix(tableID, indexID uint32) proto.Key {
+	return keys.MakeIndexPrefix(tableID, indexID)
+}
+
+// makeCellKey returns the key of the specified column within the row whose
+// sentinel key is rowKey.
+func makeCellKey(rowKey proto.Key, columnID uint32) proto.Key {
+	return keys.MakeCellKey(rowKey, columnID)
+}
+
+// convertValue converts v into the canonical Go type used for values of
+// the column: int64, bool, float64, string, []byte or, for JSON columns,
+// json.RawMessage. A nil value is returned as nil.
+func convertValue(column proto.ColumnDescriptor, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	if column.Type == proto.Column_JSON {
+		if raw, ok := v.(json.RawMessage); ok {
+			return raw, nil
+		}
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %s", column.Name, err)
+		}
+		return json.RawMessage(raw), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch column.Type {
+	case proto.Column_BYTES:
+		switch rv.Kind() {
+		case reflect.String:
+			return []byte(rv.String()), nil
+		case reflect.Slice:
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				return rv.Bytes(), nil
+			}
+		}
+	case proto.Column_STRING:
+		switch rv.Kind() {
+		case reflect.String:
+			return rv.String(), nil
+		case reflect.Slice:
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				return string(rv.Bytes()), nil
+			}
+		}
+	case proto.Column_BOOL:
+		if rv.Kind() == reflect.Bool {
+			return rv.Bool(), nil
+		}
+	case proto.Column_INT:
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return rv.Int(), nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if u := rv.Uint(); u <= math.MaxInt64 {
+				return int64(u), nil
+			}
+		}
+	case proto.Column_FLOAT:
+		switch rv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return rv.Float(), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(rv.Int()), nil
+		}
+	}
+	return nil, fmt.Errorf("column %q: cannot convert %T to %s", column.Name, v, column.Type)
+}
+
+// encodeKeyValue appends the order-preserving encoding of v, which must
+// have been converted by convertValue, to b.
+func encodeKeyValue(b []byte, v interface{}) []byte {
+	switch t := v.(type) {
+	case int64:
+		return encoding.EncodeVarint(b, t)
+	case bool:
+		if t {
+			return encoding.EncodeVarint(b, 1)
+		}
+		return encoding.EncodeVarint(b, 0)
+	case float64:
+		return encoding.EncodeNumericFloat(b, t)
+	case string:
+		return encoding.EncodeBytes(b, []byte(t))
+	case []byte:
+		return encoding.EncodeBytes(b, t)
+	}
+	panic(fmt.Sprintf("unable to encode key value %T", v))
+}
+
+// decodeKeyValue decodes a value of the specified column type encoded by
+// encodeKeyValue, returning the remainder of b and the value.
+func decodeKeyValue(b []byte, typ proto.Column_ColumnType) (rest []byte, v interface{}, err error) {
+	// The decoding routines panic on malformed input.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to decode %s key value: %v", typ, r)
+		}
+	}()
+	switch typ {
+	case proto.Column_INT:
+		rest, i := encoding.DecodeVarint(b)
+		return rest, i, nil
+	case proto.Column_BOOL:
+		rest, i := encoding.DecodeVarint(b)
+		return rest, i != 0, nil
+	case proto.Column_FLOAT:
+		rest, f := encoding.DecodeNumericFloat(b)
+		return rest, f, nil
+	case proto.Column_STRING:
+		rest, s := encoding.DecodeBytes(b, nil)
+		return rest, string(s), nil
+	case proto.Column_BYTES:
+		rest, s := encoding.DecodeBytes(b, nil)
+		return rest, s, nil
+	}
+	return nil, nil, fmt.Errorf("unable to decode %s key value", typ)
+}
+
+// encodeCellValue returns the stored representation of v, which must have
+// been converted by convertValue.
+func encodeCellValue(v interface{}) []byte {
+	switch t := v.(type) {
+	case int64, bool:
+		return encodeKeyValue(nil, t)
+	case float64:
+		return encoding.EncodeUint64(nil, math.Float64bits(t))
+	case string:
+		return []byte(t)
+	case []byte:
+		return t
+	case json.RawMessage:
+		return t
+	}
+	panic(fmt.Sprintf("unable to encode value %T", v))
+}
+
+// decodeCellValue decodes a value of the specified column type encoded by
+// encodeCellValue.
+func decodeCellValue(b []byte, typ proto.Column_ColumnType) (interface{}, error) {
+	switch typ {
+	case proto.Column_STRING:
+		return string(b), nil
+	case proto.Column_BYTES:
+		return b, nil
+	case proto.Column_JSON:
+		return json.RawMessage(b), nil
+	case proto.Column_FLOAT:
+		if len(b) != 8 {
+			return nil, fmt.Errorf("unable to decode %s value: %q", typ, b)
+		}
+		_, u := encoding.DecodeUint64(b)
+		return math.Float64frombits(u), nil
+	}
+	rest, v, err := decodeKeyValue(b, typ)
+	if err == nil && len(rest) > 0 {
+		err = fmt.Errorf("unable to decode %s value: %q", typ, b)
+	}
+	return v, err
+}
+
+// columnsByID returns the columns of the table indexed by column ID.
+func columnsByID(desc *proto.TableDescriptor) map[uint32]proto.ColumnDescriptor {
+	m := make(map[uint32]proto.ColumnDescriptor, len(desc.Columns))
+	for _, column := range desc.Columns {
+		m[column.Id] = column
+	}
+	return m
+}
+
+// makeRowKey returns the sentinel key of the row with the given values.
+// The values must have been converted by convertRow.
+func makeRowKey(desc *proto.TableDescriptor, values row) (proto.Key, error) {
+	columns := columnsByID(desc)
+	key := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	for _, id := range desc.PrimaryIndex.ColumnIds {
+		column := columns[id]
+		v, ok := values[column.Name]
+		if !ok || v == nil {
+			return nil, fmt.Errorf("missing value for primary key column %q", column.Name)
+		}
+		key = encodeKeyValue(key, v)
+	}
+	return key, nil
+}
+
+// convertRow converts the values of a row using convertValue and computes
+// the values of computed columns. An error is returned if a value does not
+// correspond to a column of the table or if a computed column is assigned
+// a value.
+func convertRow(desc *proto.TableDescriptor, values row) (row, error) {
+	converted := make(row, len(values))
+	for name, v := range values {
+		column, ok := findColumn(desc, name)
+		if !ok {
+			return nil, &UnknownColumnError{Table: desc.Name, Column: name}
+		}
+		if column.ComputeExpr != "" {
+			return nil, fmt.Errorf("table %q: cannot assign computed column %q", desc.Name, name)
+		}
+		var err error
+		if converted[name], err = convertValue(column, v); err != nil {
+			return nil, err
+		}
+	}
+	for _, column := range desc.Columns {
+		if column.ComputeExpr == "" {
+			continue
+		}
+		e, err := proto.ParseExpr(column.ComputeExpr)
+		if err != nil {
+			return nil, err
+		}
+		if !hasExprInputs(e, converted) {
+			continue
+		}
+		v, err := proto.EvalExpr(e, converted)
+		if err != nil {
+			return nil, fmt.Errorf("column %q: %s", column.Name, err)
+		}
+		if converted[column.Name], err = convertValue(column, v); err != nil {
+			return nil, err
+		}
+	}
+	return converted, nil
+}
+
+// hasExprInputs returns true if values holds a value for every column
+// referenced by the expression. Computed columns and index expressions
+// missing an input have no value.
+func hasExprInputs(e proto.Expr, values row) bool {
+	for _, name := range proto.ExprColumns(e) {
+		if values[name] == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// findColumn returns the named column of the table.
+func findColumn(desc *proto.TableDescriptor, name string) (proto.ColumnDescriptor, bool) {
+	for _, column := range desc.Columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+	return proto.ColumnDescriptor{}, false
+}
+
+// Secondary index entries are laid out as follows:
+//
+//   <table ID><index ID><index values><primary key values> -> empty
+//   <table ID><index ID><index values> -> primary key values
+//
+// The first form is used by non-unique indexes and the second by unique
+// indexes, whose entries are unique by construction. Index values are the
+// values of the indexed columns or the results of the index expressions.
+// Rows with a missing index value have no entry in the index. The entries
+// of unique indexes are written with PutUnique, so that the stores verify
+// that no other row holds them.
+
+// A UniqueViolationError is returned by writes of rows whose entry in a
+// unique index is held by another row.
+type UniqueViolationError struct {
+	Table string
+	Index string
+}
+
+// Error implements the error interface.
+func (e *UniqueViolationError) Error() string {
+	return fmt.Sprintf("duplicate key value violates unique index %q", e.Index)
+}
+
+// An indexEntry is a key/value pair of a secondary index.
+type indexEntry struct {
+	key   proto.Key
+	value []byte
+}
+
+// makeIndexEntry returns the entry of the index for a row with the given
+// sentinel key and values, which must have been converted by convertRow.
+// The returned bool is false if the row has no entry in the index.
+func makeIndexEntry(desc *proto.TableDescriptor, index proto.IndexDescriptor, rowKey proto.Key, values row) (indexEntry, bool, error) {
+	key := makeIndexPrefix(desc.Id, index.Id)
+	columns := columnsByID(desc)
+	for _, id := range index.ColumnIds {
+		v := values[columns[id].Name]
+		if v == nil {
+			return indexEntry{}, false, nil
+		}
+		key = encodeKeyValue(key, v)
+	}
+	for _, expr := range index.KeyExprs {
+		e, err := proto.ParseExpr(expr)
+		if err != nil {
+			return indexEntry{}, false, err
+		}
+		if !hasExprInputs(e, values) {
+			return indexEntry{}, false, nil
+		}
+		v, err := proto.EvalExpr(e, values)
+		if err != nil {
+			return indexEntry{}, false, fmt.Errorf("index %q: %s", index.Name, err)
+		}
+		key = encodeKeyValue(key, v)
+	}
+	primaryKey := rowKey[len(makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)):]
+	if index.Unique {
+		return indexEntry{key: key, value: primaryKey}, true, nil
+	}
+	return indexEntry{key: append(key, primaryKey...), value: []byte{}}, true, nil
+}
+
+// putIndexEntry adds the write of an index entry to the batch.
+func putIndexEntry(b *Batch, index proto.IndexDescriptor, entry indexEntry) {
+	if index.Unique {
+		b.PutUnique(entry.key, entry.value)
+	} else {
+		b.Put(entry.key, entry.value)
+	}
+}
+
+// checkUniqueViolation returns a *UniqueViolationError in place of err,
+// the error of running the batch, if the batch failed to write an entry
+// of a unique index of the table.
+func checkUniqueViolation(desc *proto.TableDescriptor, b *Batch, err error) error {
+	if _, ok := err.(*proto.ConditionFailedError); !ok {
+		return err
+	}
+	for _, result := range b.Results {
+		if result.Err == nil || len(result.Rows) == 0 {
+			continue
+		}
+		for _, index := range desc.Indexes {
+			if index.Unique && bytes.HasPrefix(result.Rows[0].Key, makeIndexPrefix(desc.Id, index.Id)) {
+				return &UniqueViolationError{Table: desc.Name, Index: index.Name}
+			}
+		}
+	}
+	return err
+}
+
+// makeIndexEntries returns the entries of the secondary indexes for a row
+// with the given sentinel key and values, which must have been converted
+// by convertRow, along with the index of each entry.
+func makeIndexEntries(desc *proto.TableDescriptor, rowKey proto.Key, values row) ([]indexEntry, []proto.IndexDescriptor, error) {
+	var entries []indexEntry
+	var indexes []proto.IndexDescriptor
+	for _, index := range desc.Indexes {
+		entry, ok, err := makeIndexEntry(desc, index, rowKey, values)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ok {
+			entries = append(entries, entry)
+			indexes = append(indexes, index)
+		}
+	}
+	return entries, indexes, nil
+}
+
+// putRow adds the writes of a row, including its sentinel and its
+// secondary index entries, to the batch. Columns of the table missing from
+// values are not written, and neither the cells nor the index entries of a
+// previous version of the row are removed. Errors of the batch should be
+// passed through checkUniqueViolation.
+func putRow(b *Batch, desc *proto.TableDescriptor, values row) error {
+	values, err := convertRow(desc, values)
+	if err != nil {
+		return err
+	}
+	rowKey, err := makeRowKey(desc, values)
+	if err != nil {
+		return err
+	}
+	// Compute the index entries first so that nothing is added to the batch
+	// if the row is invalid.
+	entries, indexes, err := makeIndexEntries(desc, rowKey, values)
+	if err != nil {
+		return err
+	}
+	primary := make(map[uint32]bool, len(desc.PrimaryIndex.ColumnIds))
+	for _, id := range desc.PrimaryIndex.ColumnIds {
+		primary[id] = true
+	}
+	args := &proto.PutRowRequest{
+		RequestHeader: proto.RequestHeader{Key: rowKey},
+		TableId:       desc.Id,
+		IndexId:       desc.PrimaryIndex.Id,
+		PrimaryKey:    []byte(rowKey[len(makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)):]),
+		TTLSeconds:    desc.TTLSeconds,
+	}
+	for _, column := range desc.Columns {
+		if primary[column.Id] {
+			continue
+		}
+		if v := values[column.Name]; v != nil {
+			args.Cells = append(args.Cells, proto.RowCell{ColumnId: column.Id, Value: encodeCellValue(v)})
+		}
+	}
+	b.InternalAddCall(Call{Args: args, Reply: &proto.PutRowResponse{}})
+	for i, entry := range entries {
+		putIndexEntry(b, indexes[i], entry)
+	}
+	return nil
+}
+
+// decodeRowKey decodes a key of the table's primary index, returning the
+// sentinel key of the row, the values of its primary key columns and the
+// ID of the column stored under the key. The column ID is 0 for sentinel
+// keys.
+func decodeRowKey(desc *proto.TableDescriptor, key proto.Key) (proto.Key, row, uint32, error) {
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	if !bytes.HasPrefix(key, prefix) {
+		return nil, nil, 0, fmt.Errorf("key %q is not in table %q", key, desc.Name)
+	}
+	columns := columnsByID(desc)
+	values := make(row, len(desc.PrimaryIndex.ColumnIds))
+	b := []byte(key[len(prefix):])
+	for _, id := range desc.PrimaryIndex.ColumnIds {
+		column := columns[id]
+		var err error
+		if b, values[column.Name], err = decodeKeyValue(b, column.Type); err != nil {
+			return nil, nil, 0, fmt.Errorf("key %q: %s", key, err)
+		}
+	}
+	rowKey := key[:len(key)-len(b)]
+	if len(b) == 0 {
+		return rowKey, values, 0, nil
+	}
+	rest, id := encoding.DecodeUvarint(b)
+	if len(rest) > 0 || id == 0 {
+		return nil, nil, 0, fmt.Errorf("key %q: invalid column suffix", key)
+	}
+	return rowKey, values, uint32(id), nil
+}
+
+// decodeRow decodes a row of the table's primary index returned by a
+// GetRow or ScanRows request. prefix is the key prefix of the primary
+// index. Cells of columns which no longer exist are ignored.
+func decodeRow(desc *proto.TableDescriptor, prefix proto.Key, r *proto.Row) (row, error) {
+	rowKey := make(proto.Key, 0, len(prefix)+len(r.PrimaryKey))
+	rowKey = append(append(rowKey, prefix...), r.PrimaryKey...)
+	_, values, id, err := decodeRowKey(desc, rowKey)
+	if err != nil {
+		return nil, err
+	}
+	if id != 0 {
+		return nil, fmt.Errorf("key %q is not the sentinel of a row", rowKey)
+	}
+	columns := columnsByID(desc)
+	for _, cell := range r.Cells {
+		column, ok := columns[cell.ColumnId]
+		if !ok {
+			continue
+		}
+		if values[column.Name], err = decodeCellValue(cell.Value, column.Type); err != nil {
+			return nil, fmt.Errorf("key %q: column %q: %s", rowKey, column.Name, err)
+		}
+	}
+	return values, nil
+}
+
+// decodeRows decodes the rows stored in a sorted sequence of keys of the
+// table's primary index, such as the result of a scan, returning the rows
+// and their sentinel keys. Cells of columns which no longer exist are
+// ignored.
+func decodeRows(desc *proto.TableDescriptor, kvs []KeyValue) ([]row, []proto.Key, error) {
+	columns := columnsByID(desc)
+	var rows []row
+	var rowKeys []proto.Key
+	for _, kv := range kvs {
+		rowKey, values, id, err := decodeRowKey(desc, kv.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		if len(rows) == 0 || !rowKey.Equal(rowKeys[len(rowKeys)-1]) {
+			rows = append(rows, values)
+			rowKeys = append(rowKeys, rowKey)
+		}
+		if id == 0 {
+			continue
+		}
+		column, ok := columns[id]
+		if !ok {
+			continue
+		}
+		if rows[len(rows)-1][column.Name], err = decodeCellValue(kv.ValueBytes(), column.Type); err != nil {
+			return nil, nil, fmt.Errorf("key %q: %s", kv.Key, err)
+		}
+	}
+	return rows, rowKeys, nil
+}
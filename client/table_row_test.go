@@ -0,0 +1,164 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// putTestRows writes rows to the named table.
+func putTestRows(t *testing.T, db *DB, table string, rows ...row) {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &Batch{}
+	for _, r := range rows {
+		if err := putRow(b, &desc, r); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// scanTestRows returns all of the rows of the named table.
+func scanTestRows(t *testing.T, db *DB, table string) []row {
+	desc, err := db.DescribeTableDesc(table)
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	kvs, err := db.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, _, err := decodeRows(&desc, kvs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
+
+func TestConvertValue(t *testing.T) {
+	testData := []struct {
+		typ      proto.Column_ColumnType
+		v        interface{}
+		expected interface{}
+	}{
+		{proto.Column_INT, 1, int64(1)},
+		{proto.Column_INT, uint8(2), int64(2)},
+		{proto.Column_FLOAT, float32(0.5), float64(0.5)},
+		{proto.Column_FLOAT, 3, float64(3)},
+		{proto.Column_BOOL, true, true},
+		{proto.Column_STRING, "a", "a"},
+		{proto.Column_STRING, []byte("b"), "b"},
+		{proto.Column_BYTES, "c", []byte("c")},
+		{proto.Column_JSON, map[string]int{"a": 1}, json.RawMessage(`{"a":1}`)},
+		{proto.Column_INT, nil, nil},
+	}
+	for i, d := range testData {
+		column := proto.ColumnDescriptor{Column: proto.Column{Name: "c", Type: d.typ}}
+		v, err := convertValue(column, d.v)
+		if err != nil {
+			t.Errorf("%d: %s", i, err)
+			continue
+		}
+		if !reflect.DeepEqual(d.expected, v) {
+			t.Errorf("%d: expected %v (%T), but found %v (%T)", i, d.expected, d.expected, v, v)
+		}
+		if v == nil {
+			continue
+		}
+		decoded, err := decodeCellValue(encodeCellValue(v), d.typ)
+		if err != nil {
+			t.Errorf("%d: %s", i, err)
+		} else if !reflect.DeepEqual(v, decoded) {
+			t.Errorf("%d: expected %v (%T), but found %v (%T)", i, v, v, decoded, decoded)
+		}
+	}
+
+	column := proto.ColumnDescriptor{Column: proto.Column{Name: "c", Type: proto.Column_INT}}
+	expected := `column "c": cannot convert string to INT`
+	if _, err := convertValue(column, "a"); err == nil || err.Error() != expected {
+		t.Errorf("expected \"%s\", but found \"%v\"", expected, err)
+	}
+}
+
+func TestRowLayout(t *testing.T) {
+	desc := proto.NewTableBuilder("t").ID(51).ParentID(1).
+		Column("a", proto.Column_STRING).
+		Column("b", proto.Column_INT).
+		Column("c", proto.Column_FLOAT).
+		ComputedColumn("d", proto.Column_STRING, "upper(a)").
+		PrimaryKey("a", "b").
+		MustBuild()
+
+	rows := []row{
+		{"a": "x", "b": int64(2), "c": float64(1.5), "d": "X"},
+		{"a": "x", "b": int64(10), "d": "X"},
+		{"a": "y", "b": int64(-1), "c": float64(0), "d": "Y"},
+	}
+	// Write the rows in reverse order: the scan returns them sorted.
+	s := &memSender{data: map[string]proto.Value{}}
+	db := newDB(s)
+	b := &Batch{}
+	for i := len(rows) - 1; i >= 0; i-- {
+		r := rows[i]
+		if err := putRow(b, &desc, row{"a": r["a"], "b": r["b"], "c": r["c"]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	kvs, err := db.Scan(prefix, prefix.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Sentinels plus the c (where present) and d cells of each row.
+	if len(kvs) != 8 {
+		t.Errorf("expected 8 keys, but found %d", len(kvs))
+	}
+	decoded, _, err := decodeRows(&desc, kvs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(rows, decoded) {
+		t.Errorf("expected %v, but found %v", rows, decoded)
+	}
+
+	testData := []struct {
+		values row
+		err    string
+	}{
+		{row{"a": "x"}, `missing value for primary key column "b"`},
+		{row{"a": "x", "b": 1, "e": 1}, `table "t": column "e" does not exist`},
+		{row{"a": "x", "b": 1, "d": "X"}, `table "t": cannot assign computed column "d"`},
+		{row{"a": "x", "b": "1"}, `column "b": cannot convert string to INT`},
+	}
+	for i, d := range testData {
+		if err := putRow(&Batch{}, &desc, d.values); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
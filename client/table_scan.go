@@ -0,0 +1,578 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// rowFilterOps maps the comparison operators accepted by ScanFilterOpt to
+// the ops of proto.RowFilter.
+var rowFilterOps = map[string]proto.RowFilter_Op{
+	"=":           proto.RowFilter_EQ,
+	"!=":          proto.RowFilter_NE,
+	"<":           proto.RowFilter_LT,
+	"<=":          proto.RowFilter_LE,
+	">":           proto.RowFilter_GT,
+	">=":          proto.RowFilter_GE,
+	"IS NULL":     proto.RowFilter_IS_NULL,
+	"IS NOT NULL": proto.RowFilter_IS_NOT_NULL,
+}
+
+// rowAggregateFuncs maps the functions accepted by AggregateTable to the
+// functions of proto.RowAggregate.
+var rowAggregateFuncs = map[string]proto.RowAggregate_Func{
+	"COUNT": proto.RowAggregate_COUNT,
+	"SUM":   proto.RowAggregate_SUM,
+	"MIN":   proto.RowAggregate_MIN,
+	"MAX":   proto.RowAggregate_MAX,
+}
+
+// A ScanOption configures ScanTable and AggregateTable.
+type ScanOption func(*scanOptions)
+
+type scanOptions struct {
+	filters     []scanFilter
+	limit       int64
+	columns     []string
+	consistency *proto.ReadConsistencyType
+	asOf        time.Time
+	priority    int32
+}
+
+type scanFilter struct {
+	column, op string
+	value      interface{}
+}
+
+// ScanFilterOpt restricts ScanTable to the rows whose column compares to
+// value with op: one of "=", "!=", "<", "<=", ">" and ">=", or "IS NULL"
+// and "IS NOT NULL", for which value is ignored. A NULL column satisfies
+// only "IS NULL". JSON columns can only be compared with "=" and "!=".
+// Multiple filters must all be satisfied.
+//
+// Filters on columns which are not part of the primary key are evaluated
+// by the replicas, so that rows which do not satisfy them are never sent
+// to the client.
+func ScanFilterOpt(column, op string, value interface{}) ScanOption {
+	return func(o *scanOptions) {
+		o.filters = append(o.filters, scanFilter{column: column, op: op, value: value})
+	}
+}
+
+// ScanLimitOpt limits ScanTable to the first n rows satisfying the
+// filters.
+func ScanLimitOpt(n int64) ScanOption {
+	return func(o *scanOptions) {
+		o.limit = n
+	}
+}
+
+// ScanColumnsOpt restricts the rows returned by ScanTable to the named
+// columns. Only the cells of those columns, and of the columns of any
+// filters, are read by the replicas, and only those of the named columns
+// are returned.
+func ScanColumnsOpt(columns ...string) ScanOption {
+	return func(o *scanOptions) {
+		o.columns = columns
+	}
+}
+
+// ScanConsistencyOpt sets the consistency of the reads of ScanTable and
+// AggregateTable. proto.INCONSISTENT reads are served by any replica
+// without waiting for pending writes and may return stale rows. It cannot
+// be combined with ScanAsOfOpt, nor used to select the rows written by
+// UpdateTableRows and DeleteTableRows.
+func ScanConsistencyOpt(consistency proto.ReadConsistencyType) ScanOption {
+	return func(o *scanOptions) {
+		o.consistency = &consistency
+	}
+}
+
+// ScanAsOfOpt reads the rows of ScanTable and AggregateTable as of the
+// historical time t, so that all the chunks of a scan observe the same
+// consistent view of the table. See Txn.SetFixedTimestamp. It cannot be
+// used to select the rows written by UpdateTableRows and DeleteTableRows.
+func ScanAsOfOpt(t time.Time) ScanOption {
+	return func(o *scanOptions) {
+		o.asOf = t
+	}
+}
+
+// ScanPriorityOpt sets the user priority of the operations, such as
+// LowUserPriority for scans run in the background.
+func ScanPriorityOpt(priority int32) ScanOption {
+	return func(o *scanOptions) {
+		o.priority = priority
+	}
+}
+
+// reader returns the function with which the reads selected by the
+// options are run outside of a transaction.
+func (o scanOptions) reader(db *DB) (func(*Batch) error, error) {
+	if o.consistency != nil && !o.asOf.IsZero() {
+		return nil, fmt.Errorf("cannot set the read consistency of a read as of a timestamp")
+	}
+	if o.asOf.IsZero() {
+		return func(b *Batch) error {
+			if o.priority != 0 {
+				b.SetUserPriority(o.priority)
+			}
+			if o.consistency != nil {
+				b.SetReadConsistency(*o.consistency)
+			}
+			return db.Run(b)
+		}, nil
+	}
+	return func(b *Batch) error {
+		return db.Txn(func(txn *Txn) error {
+			txn.SetFixedTimestamp(o.asOf)
+			if o.priority != 0 {
+				txn.SetUserPriority(o.priority)
+			}
+			return txn.Run(b)
+		})
+	}, nil
+}
+
+// writer checks that the options can select the rows written by a
+// transaction and sets its user priority.
+func (o scanOptions) writer(txn *Txn) error {
+	if o.consistency != nil || !o.asOf.IsZero() {
+		return fmt.Errorf("cannot write rows read with a read consistency or as of a timestamp")
+	}
+	if o.priority != 0 {
+		txn.SetUserPriority(o.priority)
+	}
+	return nil
+}
+
+// GetTableRow returns the row of the named table with the given primary
+// key values, or nil if there is no such row. If columns are specified,
+// only those columns are read and returned.
+func (db *DB) GetTableRow(name string, key map[string]interface{}, columns ...string) (map[string]interface{}, error) {
+	var result map[string]interface{}
+	err := db.Txn(func(txn *Txn) error {
+		var err error
+		result, err = getTableRow(txn, name, key, columns, false)
+		return err
+	})
+	return result, err
+}
+
+// GetTableRowForUpdate is like DB.GetTableRow, but also locks the row
+// until the transaction ends: transactions which write the row, or insert
+// it if it does not exist, conflict with this one. This keeps the row
+// from changing between reading and writing it in the transaction.
+//
+//   err := db.Txn(func(txn *client.Txn) error {
+//     r, err := txn.GetTableRowForUpdate("accounts", map[string]interface{}{"id": 1}, "balance")
+//     ...
+//   })
+func (txn *Txn) GetTableRowForUpdate(name string, key map[string]interface{}, columns ...string) (map[string]interface{}, error) {
+	return getTableRow(txn, name, key, columns, true)
+}
+
+func getTableRow(txn *Txn, name string, key map[string]interface{}, columns []string, forUpdate bool) (map[string]interface{}, error) {
+	desc, err := getTableDescByName(txn, name)
+	if err != nil {
+		return nil, err
+	}
+	values, err := convertRow(&desc, row(key))
+	if err != nil {
+		return nil, err
+	}
+	rowKey, err := makeRowKey(&desc, values)
+	if err != nil {
+		return nil, err
+	}
+	columnIDs, err := makeColumnIDs(&desc, columns)
+	if err != nil {
+		return nil, err
+	}
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	header := proto.RequestHeader{Key: rowKey}
+	primaryKey := []byte(rowKey[len(prefix):])
+	var call Call
+	if forUpdate {
+		call = Call{
+			Args: &proto.LockRowRequest{RequestHeader: header, TableId: desc.Id, IndexId: desc.PrimaryIndex.Id,
+				PrimaryKey: primaryKey, ColumnIds: columnIDs, TTLSeconds: desc.TTLSeconds},
+			Reply: &proto.LockRowResponse{},
+		}
+	} else {
+		call = Call{
+			Args: &proto.GetRowRequest{RequestHeader: header, TableId: desc.Id, IndexId: desc.PrimaryIndex.Id,
+				PrimaryKey: primaryKey, ColumnIds: columnIDs, TTLSeconds: desc.TTLSeconds},
+			Reply: &proto.GetRowResponse{},
+		}
+	}
+	b := &Batch{}
+	b.InternalAddCall(call)
+	if err := txn.Run(b); err != nil {
+		return nil, err
+	}
+	var r *proto.Row
+	switch t := call.Reply.(type) {
+	case *proto.GetRowResponse:
+		r = t.Row
+	case *proto.LockRowResponse:
+		r = t.Row
+	}
+	if r == nil {
+		return nil, nil
+	}
+	if values, err = decodeRow(&desc, prefix, r); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}(projectValues(values, columns)), nil
+}
+
+// ScanTable returns the rows of the named table in primary key order. Each
+// row maps the names of its non-NULL columns to their values, which are
+// int64, bool, float64, string, []byte or, for JSON columns,
+// json.RawMessage.
+//
+//   rows, err := db.ScanTable("users", client.ScanFilterOpt("age", ">=", 18))
+//
+// The table is read in chunks of up to TableBackfillChunkSize rows, each
+// with a separate request: the chunks need not reflect a single
+// consistent view of the table.
+func (db *DB) ScanTable(name string, opts ...ScanOption) ([]map[string]interface{}, error) {
+	var o scanOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	run, err := o.reader(db)
+	if err != nil {
+		return nil, err
+	}
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return scanTable(run, &desc, o)
+}
+
+// scanTable returns the rows of the described table selected by the
+// options, running the requests which read them with run.
+func scanTable(run func(*Batch) error, desc *proto.TableDescriptor, o scanOptions) ([]map[string]interface{}, error) {
+	remote, local, err := makeRowFilters(desc, o.filters)
+	if err != nil {
+		return nil, err
+	}
+	columnIDs, err := makeColumnIDs(desc, o.columns)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	rows := []map[string]interface{}{}
+	for {
+		n := TableBackfillChunkSize
+		if o.limit > 0 && o.limit-int64(len(rows)) < n {
+			n = o.limit - int64(len(rows))
+		}
+		args := &proto.ScanRowsRequest{
+			RequestHeader: proto.RequestHeader{Key: start, EndKey: end},
+			TableId:       desc.Id,
+			IndexId:       desc.PrimaryIndex.Id,
+			MaxRows:       n,
+			Filters:       remote,
+			ColumnIds:     columnIDs,
+			TTLSeconds:    desc.TTLSeconds,
+		}
+		reply := &proto.ScanRowsResponse{}
+		b := &Batch{}
+		b.InternalAddCall(Call{Args: args, Reply: reply})
+		if err := run(b); err != nil {
+			return nil, err
+		}
+		for i := range reply.Rows {
+			r := &reply.Rows[i]
+			values, err := decodeRow(desc, prefix, r)
+			if err != nil {
+				return nil, err
+			}
+			if ok, err := matchPrimaryKey(desc, values, local); err != nil {
+				return nil, err
+			} else if ok {
+				rows = append(rows, map[string]interface{}(projectValues(values, o.columns)))
+			}
+		}
+		if int64(len(reply.Rows)) < n || (o.limit > 0 && int64(len(rows)) >= o.limit) {
+			return rows, nil
+		}
+		last := reply.Rows[len(reply.Rows)-1]
+		start = proto.Key(append(append([]byte(nil), prefix...), last.PrimaryKey...)).PrefixEnd()
+	}
+}
+
+// CountTable returns the number of rows of the named table which satisfy
+// the filters of the options.
+func (db *DB) CountTable(name string, opts ...ScanOption) (int64, error) {
+	v, err := db.AggregateTable(name, "COUNT", "", opts...)
+	if err != nil {
+		return 0, err
+	}
+	return v.(int64), nil
+}
+
+// AggregateTable returns the result of the aggregate function fn of a
+// column over the rows of the named table which satisfy the filters of
+// the options:
+//
+//   COUNT  the number of non-NULL values of the column as an int64, or the
+//          number of rows if column is ""
+//   SUM    the sum of the values of an INT or FLOAT column as an int64 or
+//          float64
+//   MIN    the smallest value of the column
+//   MAX    the largest value of the column
+//
+// SUM, MIN and MAX return nil if the column has no non-NULL values. For
+// example:
+//
+//   total, err := db.AggregateTable("orders", "SUM", "amount",
+//     client.ScanFilterOpt("status", "=", "shipped"))
+//
+// Each range aggregates its own rows and returns only the result, unless
+// the column or one of the filters is on the primary key, in which case
+// the rows are scanned with ScanTable and aggregated by the client.
+func (db *DB) AggregateTable(name, fn, column string, opts ...ScanOption) (interface{}, error) {
+	var o scanOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.limit != 0 {
+		return nil, fmt.Errorf("cannot limit the rows of an aggregate")
+	}
+	run, err := o.reader(db)
+	if err != nil {
+		return nil, err
+	}
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	f, ok := rowAggregateFuncs[fn]
+	if !ok {
+		return nil, fmt.Errorf("unknown aggregate function %q", fn)
+	}
+	agg := proto.RowAggregate{Func: f}
+	var col proto.ColumnDescriptor
+	if column != "" {
+		if col, ok = findColumn(&desc, column); !ok {
+			return nil, &UnknownColumnError{Table: desc.Name, Column: column}
+		}
+		agg.ColumnId, agg.Type = col.Id, rowValueType(col)
+	} else if f != proto.RowAggregate_COUNT {
+		return nil, fmt.Errorf("%s requires a column", fn)
+	}
+	switch {
+	case f == proto.RowAggregate_SUM && agg.Type == proto.RowFilter_BYTES:
+		return nil, fmt.Errorf("column %q: cannot sum %s values", column, col.Type)
+	case f != proto.RowAggregate_COUNT && col.Type == proto.Column_JSON:
+		return nil, fmt.Errorf("column %q: JSON values cannot be ordered", column)
+	}
+	remote, local, err := makeRowFilters(&desc, o.filters)
+	if err != nil {
+		return nil, err
+	}
+
+	result := proto.RowAggregateResult{Aggregate: agg}
+	if len(local) > 0 || (column != "" && isPrimaryKeyColumn(&desc, col.Id)) {
+		// Only the aggregated column, or just the primary key when counting
+		// rows, is read.
+		read := column
+		if read == "" {
+			read = columnsByID(&desc)[desc.PrimaryIndex.ColumnIds[0]].Name
+		}
+		rows, err := db.ScanTable(name, append(opts[:len(opts):len(opts)], ScanColumnsOpt(read))...)
+		if err != nil {
+			return nil, err
+		}
+		for _, values := range rows {
+			r := &proto.Row{}
+			if v := values[col.Name]; column != "" && v != nil {
+				r.Cells = append(r.Cells, proto.RowCell{ColumnId: col.Id, Value: encodeCellValue(v)})
+			}
+			if err := result.Add(r); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+		args := &proto.ScanRowsRequest{
+			RequestHeader: proto.RequestHeader{Key: prefix, EndKey: prefix.PrefixEnd()},
+			TableId:       desc.Id,
+			IndexId:       desc.PrimaryIndex.Id,
+			Filters:       remote,
+			Aggregates:    []proto.RowAggregate{agg},
+			TTLSeconds:    desc.TTLSeconds,
+		}
+		reply := &proto.ScanRowsResponse{}
+		b := &Batch{}
+		b.InternalAddCall(Call{Args: args, Reply: reply})
+		if err := run(b); err != nil {
+			return nil, err
+		}
+		if len(reply.Aggregates) != 1 {
+			return nil, fmt.Errorf("expected 1 aggregate result, but found %d", len(reply.Aggregates))
+		}
+		result = reply.Aggregates[0]
+	}
+
+	switch {
+	case f == proto.RowAggregate_COUNT:
+		return result.Count, nil
+	case result.Value == nil:
+		return nil, nil
+	}
+	return decodeCellValue(result.Value, col.Type)
+}
+
+// rowValueType returns the type with which the replicas decode the
+// stored values of a column.
+func rowValueType(column proto.ColumnDescriptor) proto.RowFilter_Type {
+	switch column.Type {
+	case proto.Column_INT:
+		return proto.RowFilter_INT
+	case proto.Column_FLOAT:
+		return proto.RowFilter_FLOAT
+	}
+	return proto.RowFilter_BYTES
+}
+
+// isPrimaryKeyColumn returns true if the column is part of the primary key
+// of the table.
+func isPrimaryKeyColumn(desc *proto.TableDescriptor, columnID uint32) bool {
+	for _, id := range desc.PrimaryIndex.ColumnIds {
+		if id == columnID {
+			return true
+		}
+	}
+	return false
+}
+
+// makeColumnIDs returns the IDs of the named columns which are stored in
+// cells, for the column_ids of a GetRow or ScanRows request. Primary key
+// columns are decoded from the keys of the rows and are not included. If
+// only primary key columns are named, the result is column 0, which has
+// no cells, so that only the sentinels of the rows are read. No columns
+// means all columns.
+func makeColumnIDs(desc *proto.TableDescriptor, columns []string) ([]uint32, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+	ids := []uint32{}
+	for _, name := range columns {
+		column, ok := findColumn(desc, name)
+		if !ok {
+			return nil, &UnknownColumnError{Table: desc.Name, Column: name}
+		}
+		if !isPrimaryKeyColumn(desc, column.Id) {
+			ids = append(ids, column.Id)
+		}
+	}
+	if len(ids) == 0 {
+		ids = append(ids, 0)
+	}
+	return ids, nil
+}
+
+// projectValues drops the values of the columns which are not named,
+// unless no columns are named.
+func projectValues(values row, columns []string) row {
+	if len(columns) == 0 {
+		return values
+	}
+	projected := make(row, len(columns))
+	for _, name := range columns {
+		if v, ok := values[name]; ok {
+			projected[name] = v
+		}
+	}
+	return projected
+}
+
+// makeRowFilters converts the filters of ScanFilterOpt into row filters,
+// split between those evaluated by the replicas and those on primary key
+// columns, which are not stored in cells and are evaluated by the client.
+func makeRowFilters(desc *proto.TableDescriptor, filters []scanFilter) (remote, local []proto.RowFilter, err error) {
+	primary := make(map[uint32]bool, len(desc.PrimaryIndex.ColumnIds))
+	for _, id := range desc.PrimaryIndex.ColumnIds {
+		primary[id] = true
+	}
+	for _, f := range filters {
+		column, ok := findColumn(desc, f.column)
+		if !ok {
+			return nil, nil, &UnknownColumnError{Table: desc.Name, Column: f.column}
+		}
+		op, ok := rowFilterOps[f.op]
+		if !ok {
+			return nil, nil, fmt.Errorf("column %q: unknown comparison operator %q", f.column, f.op)
+		}
+		rf := proto.RowFilter{ColumnId: column.Id, Op: op, Type: rowValueType(column)}
+		if op != proto.RowFilter_IS_NULL && op != proto.RowFilter_IS_NOT_NULL {
+			if column.Type == proto.Column_JSON && op != proto.RowFilter_EQ && op != proto.RowFilter_NE {
+				return nil, nil, fmt.Errorf("column %q: JSON values can only be compared for equality", f.column)
+			}
+			v, err := convertValue(column, f.value)
+			if err != nil {
+				return nil, nil, err
+			}
+			if v == nil {
+				return nil, nil, fmt.Errorf("column %q: cannot compare to NULL with %q", f.column, f.op)
+			}
+			rf.Value = encodeCellValue(v)
+		}
+		if primary[column.Id] {
+			local = append(local, rf)
+		} else {
+			remote = append(remote, rf)
+		}
+	}
+	return remote, local, nil
+}
+
+// matchPrimaryKey returns true if the primary key values of a row satisfy
+// the filters, which must be on primary key columns.
+func matchPrimaryKey(desc *proto.TableDescriptor, values row, filters []proto.RowFilter) (bool, error) {
+	if len(filters) == 0 {
+		return true, nil
+	}
+	columns := columnsByID(desc)
+	r := &proto.Row{}
+	for _, f := range filters {
+		column := columns[f.ColumnId]
+		if v := values[column.Name]; v != nil {
+			r.Cells = append(r.Cells, proto.RowCell{ColumnId: column.Id, Value: encodeCellValue(v)})
+		}
+	}
+	return proto.MatchRow(r, filters)
+}
@@ -0,0 +1,310 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestScanTable(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 2
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "alice", "score": -1.5},
+		row{"id": 2, "name": "bob", "score": 2.0},
+		row{"id": 3, "name": "carl"},
+		row{"id": 4, "name": "dave", "score": 0.5},
+		row{"id": 5, "name": "eve", "score": -3.0})
+
+	ids := func(rows []map[string]interface{}) []int64 {
+		result := []int64{}
+		for _, r := range rows {
+			result = append(result, r["id"].(int64))
+		}
+		return result
+	}
+	testCases := []struct {
+		opts   []ScanOption
+		expIDs []int64
+	}{
+		{nil, []int64{1, 2, 3, 4, 5}},
+		{[]ScanOption{ScanFilterOpt("name", ">", "bob")}, []int64{3, 4, 5}},
+		{[]ScanOption{ScanFilterOpt("score", "<", 1)}, []int64{1, 4, 5}},
+		{[]ScanOption{ScanFilterOpt("score", "IS NULL", nil)}, []int64{3}},
+		{[]ScanOption{ScanFilterOpt("score", "!=", 0.5)}, []int64{1, 2, 5}},
+		{[]ScanOption{ScanFilterOpt("score", "<", 1), ScanLimitOpt(2)}, []int64{1, 4}},
+		// Filters on the primary key are evaluated by the client.
+		{[]ScanOption{ScanFilterOpt("id", ">=", 2), ScanFilterOpt("score", "IS NOT NULL", nil)}, []int64{2, 4, 5}},
+	}
+	for i, test := range testCases {
+		rows, err := db.ScanTable("users", test.opts...)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if found := ids(rows); !reflect.DeepEqual(found, test.expIDs) {
+			t.Errorf("%d: expected %v, but found %v", i, test.expIDs, found)
+		}
+	}
+
+	rows, err := db.ScanTable("users", ScanFilterOpt("name", "=", "bob"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []map[string]interface{}{{"id": int64(2), "name": "bob", "score": 2.0}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, rows)
+	}
+
+	for i, opt := range []ScanOption{
+		ScanFilterOpt("missing", "=", 1),
+		ScanFilterOpt("name", "~", "a"),
+		ScanFilterOpt("attrs", "<", 1),
+		ScanFilterOpt("name", "=", nil),
+	} {
+		if _, err := db.ScanTable("users", opt); err == nil {
+			t.Errorf("%d: expected an error", i)
+		}
+	}
+}
+
+func TestAggregateTable(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "alice", "score": -1.5},
+		row{"id": 2, "name": "bob", "score": 2.0},
+		row{"id": 3, "name": "carl"},
+		row{"id": 4, "name": "dave", "score": 0.5})
+
+	if n, err := db.CountTable("users"); err != nil || n != 4 {
+		t.Errorf("expected 4 rows, but found %d, %v", n, err)
+	}
+	if n, err := db.CountTable("users", ScanFilterOpt("score", ">", 0)); err != nil || n != 2 {
+		t.Errorf("expected 2 rows, but found %d, %v", n, err)
+	}
+
+	testCases := []struct {
+		fn, column string
+		opts       []ScanOption
+		expected   interface{}
+	}{
+		{"COUNT", "score", nil, int64(3)},
+		{"SUM", "score", nil, 1.0},
+		{"MIN", "score", nil, -1.5},
+		{"MAX", "name", nil, "dave"},
+		{"MAX", "score", []ScanOption{ScanFilterOpt("name", "<", "bob")}, -1.5},
+		{"SUM", "score", []ScanOption{ScanFilterOpt("name", "=", "carl")}, nil},
+		// Aggregates of the primary key, or with filters on it, are computed
+		// by the client.
+		{"SUM", "id", nil, int64(10)},
+		{"MAX", "id", []ScanOption{ScanFilterOpt("score", "IS NOT NULL", nil)}, int64(4)},
+		{"SUM", "score", []ScanOption{ScanFilterOpt("id", ">", 1)}, 2.5},
+		{"COUNT", "", []ScanOption{ScanFilterOpt("id", "<=", 3)}, int64(3)},
+	}
+	for i, test := range testCases {
+		v, err := db.AggregateTable("users", test.fn, test.column, test.opts...)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(v, test.expected) {
+			t.Errorf("%d: expected %v, but found %v", i, test.expected, v)
+		}
+	}
+
+	for i, test := range []struct {
+		fn, column string
+		opts       []ScanOption
+	}{
+		{"AVG", "score", nil},
+		{"SUM", "", nil},
+		{"SUM", "name", nil},
+		{"MAX", "attrs", nil},
+		{"MAX", "missing", nil},
+		{"COUNT", "", []ScanOption{ScanLimitOpt(1)}},
+	} {
+		if _, err := db.AggregateTable("users", test.fn, test.column, test.opts...); err == nil {
+			t.Errorf("%d: expected an error", i)
+		}
+	}
+}
+
+func TestScanTableColumns(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "alice", "score": -1.5, "active": true},
+		row{"id": 2, "name": "bob", "score": 2.0})
+
+	rows, err := db.ScanTable("users", ScanColumnsOpt("name", "active"), ScanFilterOpt("score", "<", 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []map[string]interface{}{{"name": "alice", "active": true}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, rows)
+	}
+	rows, err = db.ScanTable("users", ScanColumnsOpt("id"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []map[string]interface{}{{"id": int64(1)}, {"id": int64(2)}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, rows)
+	}
+	if _, err := db.ScanTable("users", ScanColumnsOpt("missing")); err == nil {
+		t.Error("expected an error for an unknown column")
+	}
+
+	testCases := []struct {
+		id       int
+		columns  []string
+		expected map[string]interface{}
+	}{
+		{1, nil, map[string]interface{}{"id": int64(1), "name": "alice", "score": -1.5, "active": true}},
+		{1, []string{"score", "id"}, map[string]interface{}{"id": int64(1), "score": -1.5}},
+		{2, []string{"active"}, map[string]interface{}{}},
+		{3, []string{"name"}, nil},
+	}
+	for i, test := range testCases {
+		r, err := db.GetTableRow("users", map[string]interface{}{"id": test.id}, test.columns...)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(r, test.expected) {
+			t.Errorf("%d: expected %+v, but found %+v", i, test.expected, r)
+		}
+	}
+	if _, err := db.GetTableRow("users", map[string]interface{}{"name": "alice"}); err == nil {
+		t.Error("expected an error for a missing primary key")
+	}
+}
+
+func TestScanTableReadOptions(t *testing.T) {
+	db, s := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "alice"})
+	var scans []proto.RequestHeader
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		for _, req := range requests(call) {
+			if req.Method() == proto.ScanRows {
+				scans = append(scans, *req.Header())
+			}
+		}
+		s.Send(ctx, call)
+	})
+
+	ts := time.Unix(100, 0)
+	testCases := []struct {
+		opts     []ScanOption
+		validate func(proto.RequestHeader) bool
+	}{
+		{[]ScanOption{ScanConsistencyOpt(proto.INCONSISTENT)}, func(h proto.RequestHeader) bool {
+			return h.ReadConsistency == proto.INCONSISTENT
+		}},
+		{[]ScanOption{ScanAsOfOpt(ts)}, func(h proto.RequestHeader) bool {
+			return h.Txn == nil && h.Timestamp.WallTime == ts.UnixNano()
+		}},
+		{[]ScanOption{ScanPriorityOpt(LowUserPriority)}, func(h proto.RequestHeader) bool {
+			return h.GetUserPriority() == LowUserPriority
+		}},
+	}
+	for i, test := range testCases {
+		scans = nil
+		if _, err := db.ScanTable("users", test.opts...); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if _, err := db.CountTable("users", test.opts...); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if len(scans) != 2 {
+			t.Fatalf("%d: expected 2 scans, but found %d", i, len(scans))
+		}
+		for _, h := range scans {
+			if !test.validate(h) {
+				t.Errorf("%d: unexpected request header %+v", i, h)
+			}
+		}
+	}
+
+	if _, err := db.ScanTable("users", ScanConsistencyOpt(proto.INCONSISTENT), ScanAsOfOpt(ts)); err == nil {
+		t.Error("expected an error for an inconsistent read as of a timestamp")
+	}
+	if _, err := db.DeleteTableRows("users", ScanAsOfOpt(ts)); err == nil {
+		t.Error("expected an error for a delete as of a timestamp")
+	}
+}
+
+func TestGetTableRowForUpdate(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(csvTestSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "alice", "score": 1.5})
+
+	key := map[string]interface{}{"id": 1}
+	if err := db.Txn(func(txn *Txn) error {
+		r, err := txn.GetTableRowForUpdate("users", key, "score")
+		if err != nil {
+			return err
+		}
+		desc, err := getTableDescByName(txn, "users")
+		if err != nil {
+			return err
+		}
+		b := &Batch{}
+		if err := putRow(b, &desc, row{"id": 1, "score": r["score"].(float64) + 1}); err != nil {
+			return err
+		}
+		return txn.Commit(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	r, err := db.GetTableRow("users", key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]interface{}{"id": int64(1), "name": "alice", "score": 2.5}
+	if !reflect.DeepEqual(r, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, r)
+	}
+
+	if err := db.Txn(func(txn *Txn) error {
+		r, err := txn.GetTableRowForUpdate("users", map[string]interface{}{"id": 2})
+		if r != nil {
+			t.Errorf("expected no row, but found %+v", r)
+		}
+		return err
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
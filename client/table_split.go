@@ -0,0 +1,255 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// rangeCountChunkSize is the number of range metadata records read at a
+// time when counting the ranges of a table.
+const rangeCountChunkSize = 1000
+
+// A TableOption configures the creation or modification of a table.
+type TableOption func(*tableOptions)
+
+type tableOptions struct {
+	preSplit   int
+	preSplitAt []interface{}
+	force      bool
+	dryRun     *SchemaChangePlan
+}
+
+func makeTableOptions(opts []TableOption) tableOptions {
+	var o tableOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
+
+// PreSplitOpt pre-splits a new table's primary index into n ranges with
+// evenly spaced boundaries on the first primary key column. This assumes
+// the keys are uniformly distributed and is only supported for INT, STRING
+// and BYTES columns; use PreSplitAtOpt otherwise.
+//
+// The new ranges are not scattered: like any split, each keeps the
+// replicas of the range it was split from, so all of them are initially
+// served by the same stores. Spreading them would require moving
+// replicas, which the stores only do to up-replicate a range.
+func PreSplitOpt(n int) TableOption {
+	return func(o *tableOptions) {
+		o.preSplit = n
+	}
+}
+
+// PreSplitAtOpt pre-splits a new table at the given primary keys, each
+// specified as for SplitTable. It takes precedence over PreSplitOpt.
+func PreSplitAtOpt(at ...interface{}) TableOption {
+	return func(o *tableOptions) {
+		o.preSplitAt = at
+	}
+}
+
+// SplitTable splits the range containing the named table at the row with
+// the given primary key. For tables with a single primary key column, at
+// is the value of that column. For composite primary keys, at is an
+// []interface{} holding the values of a prefix of the primary key columns,
+// in order. Rows sorting before the key remain in the left range; the row
+// itself, if it exists, begins the right range.
+func (db *DB) SplitTable(name string, at interface{}) error {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return err
+	}
+	key, err := makePrimaryKeyPrefix(&desc, at)
+	if err != nil {
+		return err
+	}
+	return db.AdminSplit(key)
+}
+
+// MergeTable merges the range containing the row of the named table with
+// the given primary key into the subsequent range. The primary key is
+// specified as for SplitTable. It is intended to compact a table's ranges
+// after the rows they held have been deleted.
+func (db *DB) MergeTable(name string, at interface{}) error {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return err
+	}
+	key, err := makePrimaryKeyPrefix(&desc, at)
+	if err != nil {
+		return err
+	}
+	return db.AdminMerge(key)
+}
+
+// TableRangeCount returns the number of ranges holding the data of the
+// named table, as recorded by the range metadata. Ranges spanning the
+// boundaries of the table are counted, and may hold the data of other
+// tables.
+func (db *DB) TableRangeCount(name string) (int, error) {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return 0, err
+	}
+	prefix := keys.MakeTablePrefix(desc.Id)
+	return db.countRanges(prefix, prefix.PrefixEnd())
+}
+
+// countRanges returns the number of ranges overlapping [start, end). The
+// meta2 record of a range is addressed by its end key, so the first range
+// is the one whose record follows the meta key of start.
+func (db *DB) countRanges(start, end proto.Key) (int, error) {
+	metaStart, metaEnd := keys.RangeMetaKey(start).Next(), keys.Meta2Prefix.PrefixEnd()
+	var count int
+	for {
+		rows, err := db.Scan(metaStart, metaEnd, rangeCountChunkSize)
+		if err != nil {
+			return 0, err
+		}
+		for _, row := range rows {
+			var desc proto.RangeDescriptor
+			if err := row.ValueProto(&desc); err != nil {
+				return 0, err
+			}
+			count++
+			if !desc.EndKey.Less(end) {
+				return count, nil
+			}
+		}
+		if int64(len(rows)) < rangeCountChunkSize {
+			return count, nil
+		}
+		metaStart = proto.Key(rows[len(rows)-1].Key).Next()
+	}
+}
+
+// makePrimaryKeyPrefix returns the primary index key prefix for the primary
+// key values in at: either a single value or an []interface{} of values
+// for the leading primary key columns.
+func makePrimaryKeyPrefix(desc *proto.TableDescriptor, at interface{}) (proto.Key, error) {
+	values, ok := at.([]interface{})
+	if !ok {
+		values = []interface{}{at}
+	}
+	pk := desc.PrimaryIndex.ColumnIds
+	if len(values) == 0 || len(values) > len(pk) {
+		return nil, fmt.Errorf("table %q: expected between 1 and %d primary key values, but found %d",
+			desc.Name, len(pk), len(values))
+	}
+	columns := columnsByID(desc)
+	key := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	for i, v := range values {
+		column := columns[pk[i]]
+		v, err := convertValue(column, v)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			return nil, fmt.Errorf("missing value for primary key column %q", column.Name)
+		}
+		key = encodeKeyValue(key, v)
+	}
+	return key, nil
+}
+
+// makeTableSplitKeys returns the sorted, distinct keys at which a new table
+// is pre-split according to opts.
+func makeTableSplitKeys(desc *proto.TableDescriptor, opts []TableOption) ([]proto.Key, error) {
+	o := makeTableOptions(opts)
+	at := o.preSplitAt
+	if at == nil && o.preSplit != 0 {
+		var err error
+		if at, err = uniformSplitValues(desc, o.preSplit); err != nil {
+			return nil, err
+		}
+	}
+	splitKeys := make([]proto.Key, 0, len(at))
+	for _, v := range at {
+		key, err := makePrimaryKeyPrefix(desc, v)
+		if err != nil {
+			return nil, err
+		}
+		splitKeys = append(splitKeys, key)
+	}
+	sort.Sort(proto.KeySlice(splitKeys))
+	var prev proto.Key
+	deduped := splitKeys[:0]
+	for _, key := range splitKeys {
+		if !bytes.Equal(prev, key) {
+			deduped = append(deduped, key)
+		}
+		prev = key
+	}
+	return deduped, nil
+}
+
+// uniformSplitValues returns the n-1 values of the first primary key
+// column which divide its domain into n equal parts.
+func uniformSplitValues(desc *proto.TableDescriptor, n int) ([]interface{}, error) {
+	column := columnsByID(desc)[desc.PrimaryIndex.ColumnIds[0]]
+	var values []interface{}
+	switch column.Type {
+	case proto.Column_INT:
+		if n < 1 {
+			break
+		}
+		step := math.MaxUint64 / uint64(n)
+		for i := 1; i < n; i++ {
+			// Offset from the smallest int64, relying on wraparound.
+			values = append(values, int64(uint64(i)*step+1<<63))
+		}
+		return values, nil
+	case proto.Column_STRING, proto.Column_BYTES:
+		if n < 1 || n > 256 {
+			break
+		}
+		for i := 1; i < n; i++ {
+			b := []byte{byte(i * 256 / n)}
+			if column.Type == proto.Column_STRING {
+				values = append(values, string(b))
+			} else {
+				values = append(values, b)
+			}
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("table %q: cannot pre-split on column %q of type %s",
+			desc.Name, column.Name, column.Type)
+	}
+	return nil, fmt.Errorf("table %q: cannot pre-split on column %q into %d ranges",
+		desc.Name, column.Name, n)
+}
@@ -0,0 +1,245 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestSplitTable(t *testing.T) {
+	db, s := newMemDB()
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowKey, err := makeRowKey(&desc, row{"id": int64(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Both forms of the key, as well as values needing conversion, split
+	// at the row's sentinel key.
+	for i, at := range []interface{}{int64(2), 2, []interface{}{int32(2)}} {
+		if err := db.SplitTable("users", at); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !bytes.Equal(rowKey, s.splits[i]) {
+			t.Errorf("%d: expected split at %q, but found %q", i, rowKey, s.splits[i])
+		}
+	}
+
+	testData := []struct {
+		table string
+		at    interface{}
+		err   string
+	}{
+		{"users", []interface{}{}, `table "users": expected between 1 and 1 primary key values, but found 0`},
+		{"users", []interface{}{1, 2}, `table "users": expected between 1 and 1 primary key values, but found 2`},
+		{"users", nil, `missing value for primary key column "id"`},
+		{"users", "a", `column "id": cannot convert string to INT`},
+		{"missing", 1, `table "missing" does not exist`},
+	}
+	for i, d := range testData {
+		if err := db.SplitTable(d.table, d.at); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+	if len(s.splits) != 3 {
+		t.Errorf("expected 3 splits, but found %d", len(s.splits))
+	}
+}
+
+func TestMergeTable(t *testing.T) {
+	db, s := newMemDB()
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowKey, err := makeRowKey(&desc, row{"id": int64(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.MergeTable("users", 2); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.merges) != 1 || !bytes.Equal(rowKey, s.merges[0]) {
+		t.Errorf("expected merge at %q, but found %q", rowKey, s.merges)
+	}
+	if err := db.MergeTable("missing", 2); err == nil {
+		t.Errorf("expected merge of a missing table to fail")
+	}
+}
+
+func TestMakePrimaryKeyPrefix(t *testing.T) {
+	desc := proto.NewTableBuilder("events").ID(1).ParentID(1).
+		Column("user", proto.Column_STRING).
+		Column("ts", proto.Column_INT).
+		PrimaryKey("user", "ts").
+		MustBuild()
+
+	full, err := makeRowKey(&desc, row{"user": "bob", "ts": int64(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	prefix, err := makePrimaryKeyPrefix(&desc, "bob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(full, prefix) || bytes.Equal(full, prefix) {
+		t.Errorf("expected %q to be a proper prefix of %q", prefix, full)
+	}
+	key, err := makePrimaryKeyPrefix(&desc, []interface{}{"bob", 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(full, key) {
+		t.Errorf("expected %q, but found %q", full, key)
+	}
+}
+
+func TestCreateTablePreSplit(t *testing.T) {
+	db, s := newMemDB()
+
+	if err := db.CreateTable(testSchema("users"), PreSplitOpt(4)); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.splits) != 3 {
+		t.Fatalf("expected 3 splits, but found %d", len(s.splits))
+	}
+	for i, v := range []int64{math.MinInt64 / 2, 0, math.MaxInt64 / 2} {
+		// The boundaries are within rounding distance of the quartiles.
+		_, decoded, err := decodeKeyValue(s.splits[i][len(makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)):], proto.Column_INT)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := decoded.(int64) - v; d < -2 || d > 2 {
+			t.Errorf("%d: expected split at %d, but found %d", i, v, decoded)
+		}
+	}
+
+	// Explicit split points are sorted and deduplicated and take precedence
+	// over the number of ranges.
+	s.splits = nil
+	if err := db.CreateTable(testSchema("orders"), PreSplitOpt(10), PreSplitAtOpt(200, 100, 200)); err != nil {
+		t.Fatal(err)
+	}
+	if desc, err = db.DescribeTableDesc("orders"); err != nil {
+		t.Fatal(err)
+	}
+	for i, id := range []int64{100, 200} {
+		key, err := makeRowKey(&desc, row{"id": id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(key, s.splits[i]) {
+			t.Errorf("%d: expected split at %q, but found %q", i, key, s.splits[i])
+		}
+	}
+
+	// Existing tables are not split again.
+	s.splits = nil
+	if created, err := db.CreateTableIfNotExists(testSchema("orders"), PreSplitOpt(2)); err != nil || created {
+		t.Fatalf("unexpected result: %t, %v", created, err)
+	}
+	if len(s.splits) != 0 {
+		t.Errorf("expected no splits, but found %q", s.splits)
+	}
+
+	// Invalid options are reported before the table is created.
+	schema := testSchema("names")
+	schema.Indexes[0].ColumnNames = []string{"name"}
+	testData := []struct {
+		opt TableOption
+		err string
+	}{
+		{PreSplitOpt(-1), `table "names": cannot pre-split on column "name" into -1 ranges`},
+		{PreSplitOpt(257), `table "names": cannot pre-split on column "name" into 257 ranges`},
+		{PreSplitAtOpt(1), `column "name": cannot convert int to STRING`},
+	}
+	for i, d := range testData {
+		if err := db.CreateTable(schema, d.opt); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+	if _, err := db.DescribeTable("names"); err == nil {
+		t.Errorf("expected table not to be created")
+	}
+	if err := db.CreateTable(schema, PreSplitOpt(4)); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.splits) != 3 || string(s.splits[1][len(s.splits[1])-3:]) != "\x80\x00\x01" {
+		t.Errorf("unexpected splits: %q", s.splits)
+	}
+}
+
+func TestTableRangeCount(t *testing.T) {
+	db, _ := newMemDB()
+
+	for _, name := range []string{"users", "orders"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rowKey, err := makeRowKey(&desc, row{"id": int64(2)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The first range ends within users, the second at its end and the
+	// third holds the tables which follow it.
+	bounds := []proto.Key{proto.KeyMin, rowKey, keys.MakeTablePrefix(desc.Id).PrefixEnd(), proto.KeyMax}
+	for i := 1; i < len(bounds); i++ {
+		rangeDesc := proto.RangeDescriptor{
+			RaftID:   proto.RaftID(i),
+			StartKey: bounds[i-1],
+			EndKey:   bounds[i],
+		}
+		if err := db.Put(keys.RangeMetaKey(bounds[i]), &rangeDesc); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for table, expected := range map[string]int{"users": 2, "orders": 1} {
+		if count, err := db.TableRangeCount(table); err != nil {
+			t.Fatal(err)
+		} else if count != expected {
+			t.Errorf("%s: expected %d ranges, but found %d", table, expected, count)
+		}
+	}
+	if _, err := db.TableRangeCount("missing"); err == nil {
+		t.Errorf("expected counting the ranges of a missing table to fail")
+	}
+}
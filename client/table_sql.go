@@ -0,0 +1,592 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/base"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/sql/parser"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// SQLEndpoint is the URL path which accepts SQLRequests, executing their
+// statements with DB.ExecSQL and returning SQLResults.
+const SQLEndpoint = "/sql/"
+
+// An SQLRequest holds a statement posted to SQLEndpoint.
+type SQLRequest struct {
+	Statement string `json:"statement"`
+}
+
+// An SQLResult holds the result of a statement executed by ExecSQL.
+type SQLResult struct {
+	// Columns holds the names of the columns of the rows returned by a
+	// SELECT.
+	Columns []string `json:"columns,omitempty"`
+	// Rows holds the values of the rows returned by a SELECT, in the order
+	// of Columns. NULL values are nil.
+	Rows [][]interface{} `json:"rows,omitempty"`
+	// RowsAffected is the number of rows inserted, updated or deleted.
+	RowsAffected int64 `json:"rows_affected"`
+}
+
+// ExecSQL executes a single SELECT, INSERT, UPDATE or DELETE statement
+// on a table, translating it into the same reads and writes as the other
+// methods of the table API:
+//
+//   SELECT * FROM users WHERE age >= 18 AND name IS NOT NULL LIMIT 10
+//   SELECT COUNT(*) FROM users WHERE age >= 18
+//   INSERT INTO users (id, name) VALUES (1, 'alice'), (2, 'bob')
+//   UPDATE users SET name = 'carol' WHERE id = 2
+//   DELETE FROM users WHERE name = 'alice'
+//
+// Conditions are conjunctions of comparisons of columns to values, which
+// become the filters of ScanFilterOpt. SELECT returns either columns or a
+// single aggregate: COUNT, SUM, MIN or MAX. Joins, subqueries, grouping
+// and ordering are not supported; rows are returned in primary key order.
+// INSERT fails with a *UniqueViolationError if a row with the same
+// primary key exists, and UPDATE cannot change primary key columns.
+//
+// Like ScanTable, SELECT reads the table in chunks which need not reflect
+// a single consistent view of it. INSERT, UPDATE and DELETE each run in a
+// single transaction.
+//
+// ExecSQL also executes CREATE TABLE statements in the format written by
+// DumpTable, creating the table with CreateTable:
+//
+//   CREATE TABLE users (id INT, name STRING, CONSTRAINT pk PRIMARY KEY (id))
+func (db *DB) ExecSQL(statement string) (SQLResult, error) {
+	stmt, err := parser.Parse(statement)
+	if err != nil {
+		return SQLResult{}, err
+	}
+	switch t := stmt.(type) {
+	case *parser.Select:
+		return db.execSelect(t)
+	case *parser.Insert:
+		return db.execInsert(t)
+	case *parser.Update:
+		return db.execUpdate(t)
+	case *parser.Delete:
+		return db.execDelete(t)
+	case *parser.DDL:
+		if t.Action == "CREATE TABLE" {
+			return db.execCreateTable(statement)
+		}
+	}
+	return SQLResult{}, fmt.Errorf("unsupported statement %q", statement)
+}
+
+// execCreateTable creates the table described by a CREATE TABLE
+// statement. The parser does not retain the definitions of the columns
+// and indexes, so the statement is parsed by parseCreateTable.
+func (db *DB) execCreateTable(statement string) (SQLResult, error) {
+	schema, err := parseCreateTable(statement)
+	if err != nil {
+		return SQLResult{}, err
+	}
+	return SQLResult{}, db.CreateTable(schema)
+}
+
+func (db *DB) execSelect(stmt *parser.Select) (SQLResult, error) {
+	switch {
+	case stmt.Distinct != "":
+		return SQLResult{}, fmt.Errorf("DISTINCT is not supported")
+	case len(stmt.GroupBy) > 0 || stmt.Having != nil:
+		return SQLResult{}, fmt.Errorf("GROUP BY is not supported")
+	case len(stmt.OrderBy) > 0:
+		return SQLResult{}, fmt.Errorf("ORDER BY is not supported")
+	case stmt.Lock != "":
+		return SQLResult{}, fmt.Errorf("locking reads are not supported")
+	case len(stmt.From) != 1:
+		return SQLResult{}, fmt.Errorf("SELECT must read a single table")
+	}
+	from, ok := stmt.From[0].(*parser.AliasedTableExpr)
+	if !ok {
+		return SQLResult{}, fmt.Errorf("joins are not supported")
+	}
+	tableName, ok := from.Expr.(*parser.TableName)
+	if !ok {
+		return SQLResult{}, fmt.Errorf("subqueries are not supported")
+	}
+	table := sqlTableName(tableName)
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, table)
+		return err
+	}); err != nil {
+		return SQLResult{}, err
+	}
+	qualifier := tableName.Name
+	if from.As != "" {
+		qualifier = from.As
+	}
+	opts, err := sqlFilters(&desc, qualifier, stmt.Where)
+	if err != nil {
+		return SQLResult{}, err
+	}
+
+	if len(stmt.Exprs) == 1 {
+		if e, ok := stmt.Exprs[0].(*parser.NonStarExpr); ok {
+			if f, ok := e.Expr.(*parser.FuncExpr); ok {
+				if stmt.Limit != nil {
+					return SQLResult{}, fmt.Errorf("cannot limit the rows of an aggregate")
+				}
+				return db.execAggregate(table, qualifier, e, f, opts)
+			}
+		}
+	}
+	var columns, labels []string
+	for _, expr := range stmt.Exprs {
+		switch e := expr.(type) {
+		case *parser.StarExpr:
+			if len(stmt.Exprs) != 1 {
+				return SQLResult{}, fmt.Errorf("* cannot be combined with other columns")
+			}
+			for _, column := range desc.Columns {
+				columns = append(columns, column.Name)
+			}
+			labels = columns
+		case *parser.NonStarExpr:
+			c, ok := e.Expr.(*parser.ColName)
+			if !ok {
+				return SQLResult{}, fmt.Errorf("unsupported expression %q", e.Expr)
+			}
+			name, err := sqlColumnName(&desc, qualifier, c)
+			if err != nil {
+				return SQLResult{}, err
+			}
+			label := e.As
+			if label == "" {
+				label = name
+			}
+			columns = append(columns, name)
+			labels = append(labels, label)
+		}
+	}
+	var offset, limit int64
+	if stmt.Limit != nil {
+		if stmt.Limit.Offset != nil {
+			if offset, err = sqlCount(stmt.Limit.Offset); err != nil {
+				return SQLResult{}, err
+			}
+		}
+		if limit, err = sqlCount(stmt.Limit.Rowcount); err != nil {
+			return SQLResult{}, err
+		}
+		if limit == 0 {
+			return SQLResult{Columns: labels}, nil
+		}
+		opts = append(opts, ScanLimitOpt(offset+limit))
+	}
+	rows, err := db.ScanTable(table, append(opts, ScanColumnsOpt(columns...))...)
+	if err != nil {
+		return SQLResult{}, err
+	}
+	result := SQLResult{Columns: labels}
+	for i, values := range rows {
+		if int64(i) < offset {
+			continue
+		}
+		r := make([]interface{}, len(columns))
+		for j, name := range columns {
+			r[j] = values[name]
+		}
+		result.Rows = append(result.Rows, r)
+	}
+	return result, nil
+}
+
+// execAggregate returns the result of the aggregate function of a SELECT
+// as a single row.
+func (db *DB) execAggregate(table, qualifier string, e *parser.NonStarExpr, f *parser.FuncExpr,
+	opts []ScanOption) (SQLResult, error) {
+	if f.Distinct || len(f.Exprs) != 1 {
+		return SQLResult{}, fmt.Errorf("unsupported aggregate %q", f)
+	}
+	var column string
+	switch arg := f.Exprs[0].(type) {
+	case *parser.StarExpr:
+		if f.Name != "COUNT" {
+			return SQLResult{}, fmt.Errorf("%s requires a column", f.Name)
+		}
+	case *parser.NonStarExpr:
+		c, ok := arg.Expr.(*parser.ColName)
+		if !ok {
+			return SQLResult{}, fmt.Errorf("unsupported aggregate %q", f)
+		}
+		if c.Qualifier != "" && c.Qualifier != qualifier {
+			return SQLResult{}, fmt.Errorf("unknown table %q", c.Qualifier)
+		}
+		column = c.Name
+	}
+	if f.Name == "COUNT" && column != "" {
+		// COUNT(column) counts the rows whose column is not NULL.
+		opts = append(opts, ScanFilterOpt(column, "IS NOT NULL", nil))
+		column = ""
+	}
+	v, err := db.AggregateTable(table, f.Name, column, opts...)
+	if err != nil {
+		return SQLResult{}, err
+	}
+	label := e.As
+	if label == "" {
+		label = f.String()
+	}
+	return SQLResult{Columns: []string{label}, Rows: [][]interface{}{{v}}}, nil
+}
+
+func (db *DB) execInsert(stmt *parser.Insert) (SQLResult, error) {
+	if len(stmt.OnDup) > 0 {
+		return SQLResult{}, fmt.Errorf("ON DUPLICATE KEY UPDATE is not supported")
+	}
+	values, ok := stmt.Rows.(parser.Values)
+	if !ok {
+		return SQLResult{}, fmt.Errorf("INSERT ... SELECT is not supported")
+	}
+	var result SQLResult
+	err := db.Txn(func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, sqlTableName(stmt.Table))
+		if err != nil {
+			return err
+		}
+		var columns []proto.ColumnDescriptor
+		if len(stmt.Columns) == 0 {
+			columns = desc.Columns
+		}
+		for _, expr := range stmt.Columns {
+			e, ok := expr.(*parser.NonStarExpr)
+			if !ok {
+				return fmt.Errorf("unsupported column %q", expr)
+			}
+			c, ok := e.Expr.(*parser.ColName)
+			if !ok {
+				return fmt.Errorf("unsupported column %q", expr)
+			}
+			name, err := sqlColumnName(&desc, stmt.Table.Name, c)
+			if err != nil {
+				return err
+			}
+			column, _ := findColumn(&desc, name)
+			columns = append(columns, column)
+		}
+
+		rows := make([]row, len(values))
+		for i, tuple := range values {
+			exprs, ok := tuple.(parser.ValTuple)
+			if !ok {
+				return fmt.Errorf("subqueries are not supported")
+			}
+			if len(exprs) != len(columns) {
+				return fmt.Errorf("expected %d values, but found %d", len(columns), len(exprs))
+			}
+			rows[i] = make(row, len(columns))
+			for j, expr := range exprs {
+				if rows[i][columns[j].Name], err = sqlValue(columns[j], expr); err != nil {
+					return err
+				}
+			}
+		}
+		if err := insertRows(txn, &desc, rows); err != nil {
+			return err
+		}
+		result.RowsAffected = int64(len(rows))
+		return nil
+	})
+	return result, err
+}
+
+func (db *DB) execUpdate(stmt *parser.Update) (SQLResult, error) {
+	if len(stmt.OrderBy) > 0 {
+		return SQLResult{}, fmt.Errorf("ORDER BY is not supported")
+	}
+	var result SQLResult
+	err := db.Txn(func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, sqlTableName(stmt.Table))
+		if err != nil {
+			return err
+		}
+		updates := row{}
+		for _, e := range stmt.Exprs {
+			name, err := sqlColumnName(&desc, stmt.Table.Name, e.Name)
+			if err != nil {
+				return err
+			}
+			column, _ := findColumn(&desc, name)
+			if updates[name], err = sqlValue(column, e.Expr); err != nil {
+				return err
+			}
+		}
+		o, ok, err := sqlScanOptions(&desc, stmt.Table.Name, stmt.Where, stmt.Limit)
+		if err != nil || !ok {
+			return err
+		}
+		result.RowsAffected, err = updateRows(txn, &desc, updates, o)
+		return err
+	})
+	return result, err
+}
+
+func (db *DB) execDelete(stmt *parser.Delete) (SQLResult, error) {
+	if len(stmt.OrderBy) > 0 {
+		return SQLResult{}, fmt.Errorf("ORDER BY is not supported")
+	}
+	var result SQLResult
+	err := db.Txn(func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, sqlTableName(stmt.Table))
+		if err != nil {
+			return err
+		}
+		o, ok, err := sqlScanOptions(&desc, stmt.Table.Name, stmt.Where, stmt.Limit)
+		if err != nil || !ok {
+			return err
+		}
+		result.RowsAffected, err = deleteRows(txn, &desc, o)
+		return err
+	})
+	return result, err
+}
+
+// sqlScanOptions returns the options selecting the rows of the described
+// table which satisfy the condition and limit of an UPDATE or DELETE. The
+// returned bool is false if no rows are selected.
+func sqlScanOptions(desc *proto.TableDescriptor, qualifier string, where *parser.Where,
+	limit *parser.Limit) (scanOptions, bool, error) {
+	var o scanOptions
+	filters, err := sqlFilters(desc, qualifier, where)
+	if err != nil {
+		return o, false, err
+	}
+	for _, opt := range filters {
+		opt(&o)
+	}
+	if limit != nil {
+		if limit.Offset != nil {
+			return o, false, fmt.Errorf("OFFSET is not supported")
+		}
+		if o.limit, err = sqlCount(limit.Rowcount); err != nil {
+			return o, false, err
+		}
+	}
+	return o, limit == nil || o.limit > 0, nil
+}
+
+// sqlTableName returns the name of a table as understood by the table
+// API.
+func sqlTableName(t *parser.TableName) string {
+	if t.Qualifier != "" {
+		return t.Qualifier + "." + t.Name
+	}
+	return t.Name
+}
+
+// sqlColumnName returns the name of the column of the described table,
+// which may be qualified by the given name of the table.
+func sqlColumnName(desc *proto.TableDescriptor, qualifier string, c *parser.ColName) (string, error) {
+	if c.Qualifier != "" && c.Qualifier != qualifier {
+		return "", fmt.Errorf("unknown table %q", c.Qualifier)
+	}
+	if _, ok := findColumn(desc, c.Name); !ok {
+		return "", &UnknownColumnError{Table: desc.Name, Column: c.Name}
+	}
+	return c.Name, nil
+}
+
+// sqlComparisonOps maps the operators of comparisons with a value on the
+// left to the equivalent operators with the value on the right.
+var sqlComparisonOps = map[string]string{
+	"=":  "=",
+	"!=": "!=",
+	"<":  ">",
+	"<=": ">=",
+	">":  "<",
+	">=": "<=",
+}
+
+// sqlFilters converts a condition into the filters of ScanFilterOpt. The
+// condition must be a conjunction of comparisons of columns to values.
+func sqlFilters(desc *proto.TableDescriptor, qualifier string, where *parser.Where) ([]ScanOption, error) {
+	var opts []ScanOption
+	var add func(e parser.BoolExpr) error
+	addComparison := func(c *parser.ColName, op string, e parser.ValExpr) error {
+		name, err := sqlColumnName(desc, qualifier, c)
+		if err != nil {
+			return err
+		}
+		var v interface{}
+		if e != nil {
+			column, _ := findColumn(desc, name)
+			if v, err = sqlValue(column, e); err != nil {
+				return err
+			}
+			if v == nil {
+				return fmt.Errorf("column %q: cannot compare to NULL with %q; use IS NULL", name, op)
+			}
+		}
+		opts = append(opts, ScanFilterOpt(name, op, v))
+		return nil
+	}
+	add = func(e parser.BoolExpr) error {
+		switch t := e.(type) {
+		case *parser.AndExpr:
+			if err := add(t.Left); err != nil {
+				return err
+			}
+			return add(t.Right)
+		case *parser.ParenBoolExpr:
+			return add(t.Expr)
+		case *parser.ComparisonExpr:
+			if _, ok := sqlComparisonOps[t.Operator]; !ok {
+				break
+			}
+			if c, ok := t.Left.(*parser.ColName); ok {
+				return addComparison(c, t.Operator, t.Right)
+			}
+			if c, ok := t.Right.(*parser.ColName); ok {
+				return addComparison(c, sqlComparisonOps[t.Operator], t.Left)
+			}
+		case *parser.NullCheck:
+			if c, ok := t.Expr.(*parser.ColName); ok {
+				return addComparison(c, t.Operator, nil)
+			}
+		}
+		return fmt.Errorf("unsupported condition %q", e)
+	}
+	if where != nil {
+		if err := add(where.Expr); err != nil {
+			return nil, err
+		}
+	}
+	return opts, nil
+}
+
+// sqlValue converts a literal into a value of the column. Strings are
+// parsed as JSON for JSON columns and as "true" or "false" for BOOL
+// columns, which also accept the numbers 0 and 1.
+func sqlValue(column proto.ColumnDescriptor, e parser.ValExpr) (interface{}, error) {
+	var v interface{}
+	switch t := e.(type) {
+	case *parser.NullVal:
+		return nil, nil
+	case parser.StrVal:
+		switch column.Type {
+		case proto.Column_BOOL:
+			b, err := strconv.ParseBool(strings.ToLower(string(t)))
+			if err != nil {
+				return nil, fmt.Errorf("column %q: invalid boolean %s", column.Name, t)
+			}
+			return b, nil
+		case proto.Column_JSON:
+			var j interface{}
+			if err := json.Unmarshal([]byte(t), &j); err != nil {
+				return nil, fmt.Errorf("column %q: %s", column.Name, err)
+			}
+			return json.RawMessage(t), nil
+		}
+		v = string(t)
+	case parser.BytesVal:
+		v = []byte(t)
+	case parser.NumVal:
+		v = sqlNumber(string(t))
+	case *parser.UnaryExpr:
+		if n, ok := t.Expr.(parser.NumVal); ok && t.Operator == '-' {
+			v = sqlNumber("-" + string(n))
+		}
+	}
+	if column.Type == proto.Column_BOOL {
+		switch v {
+		case int64(0):
+			return false, nil
+		case int64(1):
+			return true, nil
+		}
+	}
+	if v == nil {
+		return nil, fmt.Errorf("column %q: unsupported value %s", column.Name, e)
+	}
+	return convertValue(column, v)
+}
+
+// sqlNumber parses a number as an int64 if possible and as a float64
+// otherwise, returning nil if it is neither.
+func sqlNumber(s string) interface{} {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return i
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f
+	}
+	return nil
+}
+
+// sqlCount returns the non-negative integer of a LIMIT clause.
+func sqlCount(e parser.ValExpr) (int64, error) {
+	if n, ok := e.(parser.NumVal); ok {
+		if v, err := strconv.ParseInt(string(n), 10, 64); err == nil && v >= 0 {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid LIMIT %q", e)
+}
+
+// PostSQL posts the statement to the SQLEndpoint of the node at addr,
+// returning the result of executing it. The values of the rows are
+// decoded from JSON: numbers are returned as json.Number and the values of
+// BYTES columns as base64-encoded strings.
+func PostSQL(ctx *base.Context, addr, statement string) (SQLResult, error) {
+	body, err := json.Marshal(&SQLRequest{Statement: statement})
+	if err != nil {
+		return SQLResult{}, err
+	}
+	client, err := ctx.GetHTTPClient()
+	if err != nil {
+		return SQLResult{}, err
+	}
+	req, err := http.NewRequest("POST", ctx.RequestScheme()+"://"+addr+SQLEndpoint, bytes.NewReader(body))
+	if err != nil {
+		return SQLResult{}, util.Errorf("unable to create request: %s", err)
+	}
+	req.Header.Add(util.ContentTypeHeader, util.JSONContentType)
+	req.Header.Add(util.AcceptHeader, util.JSONContentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return SQLResult{}, err
+	}
+	defer resp.Body.Close()
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return SQLResult{}, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return SQLResult{}, fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(b)))
+	}
+	var result SQLResult
+	d := json.NewDecoder(bytes.NewReader(b))
+	d.UseNumber()
+	if err := d.Decode(&result); err != nil {
+		return SQLResult{}, err
+	}
+	return result, nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestExecSQL(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	exec := func(statement string) SQLResult {
+		result, err := db.ExecSQL(statement)
+		if err != nil {
+			t.Fatalf("%s: %s", statement, err)
+		}
+		return result
+	}
+
+	if r := exec("INSERT INTO users (id, name) VALUES (1, 'a'), (2, 'b'), (3, NULL)"); r.RowsAffected != 3 {
+		t.Errorf("expected 3 rows to be inserted, but found %d", r.RowsAffected)
+	}
+	exec("INSERT INTO users VALUES (-4, 'd')")
+
+	testCases := []struct {
+		statement string
+		expected  SQLResult
+	}{
+		{"SELECT * FROM users", SQLResult{
+			Columns: []string{"id", "name"},
+			Rows:    [][]interface{}{{int64(-4), "d"}, {int64(1), "a"}, {int64(2), "b"}, {int64(3), nil}},
+		}},
+		{"SELECT name, id AS user_id FROM users WHERE id >= 1 AND name IS NOT NULL", SQLResult{
+			Columns: []string{"name", "user_id"},
+			Rows:    [][]interface{}{{"a", int64(1)}, {"b", int64(2)}},
+		}},
+		{"SELECT u.name FROM users u WHERE 2 > u.id AND (name != 'd')", SQLResult{
+			Columns: []string{"name"},
+			Rows:    [][]interface{}{{"a"}},
+		}},
+		{"SELECT id FROM users LIMIT 1, 2", SQLResult{
+			Columns: []string{"id"},
+			Rows:    [][]interface{}{{int64(1)}, {int64(2)}},
+		}},
+		{"SELECT COUNT(*) FROM users", SQLResult{
+			Columns: []string{"COUNT(*)"},
+			Rows:    [][]interface{}{{int64(4)}},
+		}},
+		{"SELECT COUNT(name) AS n FROM users", SQLResult{
+			Columns: []string{"n"},
+			Rows:    [][]interface{}{{int64(3)}},
+		}},
+		{"SELECT MAX(name) FROM users WHERE id < 3", SQLResult{
+			Columns: []string{"MAX(name)"},
+			Rows:    [][]interface{}{{"d"}},
+		}},
+	}
+	for i, c := range testCases {
+		if r := exec(c.statement); !reflect.DeepEqual(c.expected, r) {
+			t.Errorf("%d: expected %+v, but found %+v", i, c.expected, r)
+		}
+	}
+
+	if r := exec("UPDATE users SET name = 'c' WHERE name IS NULL"); r.RowsAffected != 1 {
+		t.Errorf("expected 1 row to be updated, but found %d", r.RowsAffected)
+	}
+	exec("UPDATE users SET name = NULL WHERE id = 1")
+	if r := exec("DELETE FROM users WHERE id < 0"); r.RowsAffected != 1 {
+		t.Errorf("expected 1 row to be deleted, but found %d", r.RowsAffected)
+	}
+	// The index entries of the updated and deleted rows were removed.
+	if keys := scanIndex(t, db, "users", "by_name"); len(keys) != 2 {
+		t.Errorf("expected 2 index entries, but found %q", keys)
+	}
+	expected := []row{{"id": int64(1)}, {"id": int64(2), "name": "b"}, {"id": int64(3), "name": "c"}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+}
+
+func TestExecSQLErrors(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ExecSQL("INSERT INTO users (id, name) VALUES (1, 'a')"); err != nil {
+		t.Fatal(err)
+	}
+	testCases := []string{
+		"SELECT",
+		"SELECT * FROM missing",
+		"SELECT missing FROM users",
+		"SELECT * FROM users WHERE id = 1 OR id = 2",
+		"SELECT * FROM users WHERE id = name",
+		"SELECT * FROM users WHERE id = NULL",
+		"SELECT * FROM users WHERE id = 'a'",
+		"SELECT * FROM users ORDER BY name",
+		"SELECT * FROM users, users",
+		"SELECT COUNT(*) FROM users LIMIT 1",
+		"INSERT INTO users (id, name) VALUES (1, 'b')",
+		"INSERT INTO users (id, name) VALUES (2, 'b'), (2, 'c')",
+		"INSERT INTO users (id) VALUES (2, 'b')",
+		"UPDATE users SET id = 2",
+		"DELETE FROM users WHERE x.id = 1",
+		"CREATE TABLE t",
+	}
+	for i, statement := range testCases {
+		if _, err := db.ExecSQL(statement); err == nil {
+			t.Errorf("%d: expected %q to fail", i, statement)
+		}
+	}
+	if _, err := db.ExecSQL("INSERT INTO users (id) VALUES (1)"); err == nil {
+		t.Errorf("expected a duplicate primary key to fail")
+	} else if _, ok := err.(*UniqueViolationError); !ok {
+		t.Errorf("expected UniqueViolationError, but found %T: %s", err, err)
+	}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual([]row{{"id": int64(1), "name": "a"}}, rows) {
+		t.Errorf("expected the table to be unchanged, but found %v", rows)
+	}
+}
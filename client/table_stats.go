@@ -0,0 +1,484 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// TableStatsSampleSize is the maximum number of rows sampled to build the
+// histograms of a table's columns.
+var TableStatsSampleSize = 10000
+
+// TableStatsHistogramBuckets is the maximum number of buckets of the
+// histogram of a column.
+var TableStatsHistogramBuckets = 20
+
+// hllPrecision is the number of bits of the hash of a value which select
+// the register of a sketch. The 1024 registers of a sketch estimate the
+// number of distinct values with a standard error of about 3%.
+const hllPrecision = 10
+
+// TableStats holds approximate statistics of the rows of a table.
+type TableStats struct {
+	RowCount int64
+	// DataSize is the number of bytes of the encoded primary keys and the
+	// stored values of the cells of the rows.
+	DataSize int64
+	// SampleSize is the number of rows the histograms were built from.
+	SampleSize  int64
+	CollectedAt time.Time
+	// Columns holds the statistics of the table's columns by name. Columns
+	// added since the statistics were collected are missing.
+	Columns map[string]ColumnStats
+}
+
+// ColumnStats holds approximate statistics of the values of a column.
+type ColumnStats struct {
+	NullCount     int64
+	DistinctCount int64
+	// Histogram holds the buckets of an equi-depth histogram of the
+	// non-NULL values, in increasing order. Columns of type JSON have no
+	// histogram.
+	Histogram []HistogramBucket
+}
+
+// A HistogramBucket holds the estimated number of rows whose values are
+// greater than the upper bound of the preceding bucket and at most
+// UpperBound.
+type HistogramBucket struct {
+	UpperBound interface{}
+	Count      int64
+}
+
+// TableStats returns the statistics of the named table most recently
+// collected by CollectTableStats, or nil if none have been collected.
+func (db *DB) TableStats(table string) (*TableStats, error) {
+	var desc proto.TableDescriptor
+	var stats proto.TableStatistics
+	var ok bool
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		if desc, err = getTableDescByName(txn, table); err != nil {
+			return err
+		}
+		kv, err := txn.Get(keys.MakeTableStatsKey(desc.Id))
+		if err != nil || !kv.Exists() {
+			ok = false
+			return err
+		}
+		ok = true
+		return kv.ValueProto(&stats)
+	}); err != nil || !ok {
+		return nil, err
+	}
+	return decodeTableStats(&desc, &stats)
+}
+
+// CollectTableStats scans the rows of the named table, storing and
+// returning statistics of them: the number and size of the rows, and for
+// each column the number of NULL values, the number of distinct values,
+// estimated with a HyperLogLog sketch, and an equi-depth histogram of a
+// sample of at most TableStatsSampleSize rows. The table is read at low
+// priority in chunks of TableBackfillChunkSize rows, which need not
+// reflect a single consistent view of the table.
+func (db *DB) CollectTableStats(table string) (*TableStats, error) {
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, table)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	stats, err := db.collectTableStats(&desc)
+	if err != nil {
+		return nil, err
+	}
+	return decodeTableStats(&desc, &stats)
+}
+
+// RefreshTableStats collects the statistics of the tables whose
+// statistics were collected more than maxAge ago, or with an older
+// version of their descriptors, returning the number of tables whose
+// statistics were collected.
+func (db *DB) RefreshTableStats(maxAge time.Duration) (int, error) {
+	return db.refreshTableStats(maxAge, nil)
+}
+
+// refreshTableStats implements RefreshTableStats. If renew is not nil,
+// it is called before the statistics of each table are collected, and
+// the refresh stops if it returns false.
+func (db *DB) refreshTableStats(maxAge time.Duration, renew func() (bool, error)) (int, error) {
+	var descs []proto.TableDescriptor
+	var stats []proto.TableStatistics
+	if err := db.Txn(func(txn *Txn) error {
+		rows, err := txn.Scan(keys.TableMetadataPrefix, keys.TableMetadataPrefix.PrefixEnd(), 0)
+		if err != nil {
+			return err
+		}
+		descs = make([]proto.TableDescriptor, len(rows))
+		stats = make([]proto.TableStatistics, len(rows))
+		b := &Batch{}
+		for i, row := range rows {
+			b.GetProto(keys.MakeDescMetadataKey(decodeDescID(row.ValueBytes())), &descs[i])
+		}
+		for _, row := range rows {
+			b.Get(keys.MakeTableStatsKey(decodeDescID(row.ValueBytes())))
+		}
+		if err := txn.Run(b); err != nil {
+			return err
+		}
+		// The statistics of some tables may not have been collected yet.
+		for i, result := range b.Results[len(rows):] {
+			if kv := &result.Rows[0]; kv.Exists() {
+				if err := kv.ValueProto(&stats[i]); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+	now := time.Now().UnixNano()
+	var refreshed int
+	for i := range descs {
+		desc := &descs[i]
+		if stats[i].TableId == desc.Id && stats[i].TableVersion == desc.Version &&
+			now-stats[i].CollectedAt < maxAge.Nanoseconds() {
+			continue
+		}
+		if renew != nil {
+			if ok, err := renew(); !ok || err != nil {
+				return refreshed, err
+			}
+		}
+		if _, err := proto.MaybeUpgradeTableDescriptor(desc); err != nil {
+			return refreshed, err
+		}
+		if _, err := db.collectTableStats(desc); err != nil {
+			return refreshed, err
+		}
+		refreshed++
+	}
+	return refreshed, nil
+}
+
+// RunTableStats starts a worker which calls RefreshTableStats every
+// interval, refreshing the statistics which are older than the interval,
+// until the stopper is stopped. Errors are logged and retried at the next
+// interval.
+//
+// Every node of a cluster runs the worker, but only the holder of the
+// lease stored at keys.TableStatsLeaseKey refreshes the statistics. The
+// holder renews the lease for twice the interval before each table it
+// refreshes; the worker of another node acquires the lease once it has
+// expired.
+func (db *DB) RunTableStats(stopper *util.Stopper, interval time.Duration) {
+	owner := []byte(util.NewUUID4())
+	renew := func() (bool, error) {
+		return db.acquireTableStatsLease(owner, 2*interval)
+	}
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if !stopper.StartTask() {
+					continue
+				}
+				if ok, err := renew(); err != nil {
+					log.Warningf("failed to acquire the table statistics lease: %s", err)
+				} else if ok {
+					if _, err := db.refreshTableStats(interval, renew); err != nil {
+						log.Warningf("failed to refresh table statistics: %s", err)
+					}
+				}
+				stopper.FinishTask()
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// acquireTableStatsLease acquires or renews the lease on refreshing the
+// statistics of tables for the given owner, expiring after duration. It
+// returns false if another owner holds an unexpired lease. The lease is
+// stored as the owner encoded with encoding.EncodeBytes followed by the
+// expiration as a varint of nanoseconds since the epoch.
+func (db *DB) acquireTableStatsLease(owner []byte, duration time.Duration) (bool, error) {
+	var acquired bool
+	err := db.Txn(func(txn *Txn) error {
+		acquired = false
+		kv, err := txn.Get(keys.TableStatsLeaseKey)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		if kv.Exists() {
+			b, holder := encoding.DecodeBytes(kv.ValueBytes(), nil)
+			_, expiration := encoding.DecodeVarint(b)
+			if !bytes.Equal(holder, owner) && expiration > now.UnixNano() {
+				return nil
+			}
+		}
+		acquired = true
+		return txn.Put(keys.TableStatsLeaseKey,
+			encoding.EncodeVarint(encoding.EncodeBytes(nil, owner), now.Add(duration).UnixNano()))
+	})
+	return acquired, err
+}
+
+// columnStatsCollector accumulates the statistics of the values of a
+// column.
+type columnStatsCollector struct {
+	column    proto.ColumnDescriptor
+	nullCount int64
+	sketch    hllSketch
+}
+
+// collectTableStats scans the rows of the described table and stores the
+// statistics of them.
+func (db *DB) collectTableStats(desc *proto.TableDescriptor) (proto.TableStatistics, error) {
+	collectors := make([]columnStatsCollector, len(desc.Columns))
+	for i, column := range desc.Columns {
+		collectors[i] = columnStatsCollector{column: column, sketch: newHLLSketch()}
+	}
+	// The sample holds the key encodings of the values of the sampled rows,
+	// which is nil for NULL values and the values of JSON columns.
+	var sample [][][]byte
+	rnd := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	var rowCount, dataSize int64
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	for {
+		args := &proto.ScanRowsRequest{
+			RequestHeader: proto.RequestHeader{Key: start, EndKey: end},
+			TableId:       desc.Id,
+			IndexId:       desc.PrimaryIndex.Id,
+			MaxRows:       TableBackfillChunkSize,
+			TTLSeconds:    desc.TTLSeconds,
+		}
+		reply := &proto.ScanRowsResponse{}
+		b := &Batch{}
+		b.InternalAddCall(Call{Args: args, Reply: reply})
+		b.SetUserPriority(LowUserPriority)
+		if err := db.Run(b); err != nil {
+			return proto.TableStatistics{}, err
+		}
+		for i := range reply.Rows {
+			dataSize += int64(len(reply.Rows[i].PrimaryKey))
+			for _, cell := range reply.Rows[i].Cells {
+				dataSize += int64(len(cell.Value))
+			}
+			values, err := decodeRow(desc, prefix, &reply.Rows[i])
+			if err != nil {
+				return proto.TableStatistics{}, err
+			}
+			encoded := make([][]byte, len(collectors))
+			for j := range collectors {
+				c := &collectors[j]
+				v := values[c.column.Name]
+				if v == nil {
+					c.nullCount++
+					continue
+				}
+				c.sketch.add(encodeCellValue(v))
+				if c.column.Type != proto.Column_JSON {
+					encoded[j] = encodeKeyValue(nil, v)
+				}
+			}
+			// Reservoir sampling keeps each row with equal probability.
+			if len(sample) < TableStatsSampleSize {
+				sample = append(sample, encoded)
+			} else if j := rnd.Int63n(rowCount + 1); j < int64(TableStatsSampleSize) {
+				sample[j] = encoded
+			}
+			rowCount++
+		}
+		if int64(len(reply.Rows)) < TableBackfillChunkSize {
+			break
+		}
+		last := reply.Rows[len(reply.Rows)-1]
+		start = proto.Key(append(append([]byte(nil), prefix...), last.PrimaryKey...)).PrefixEnd()
+	}
+
+	stats := proto.TableStatistics{
+		TableId:      desc.Id,
+		TableVersion: desc.Version,
+		RowCount:     rowCount,
+		SampleSize:   int64(len(sample)),
+		CollectedAt:  time.Now().UnixNano(),
+		DataSize:     dataSize,
+	}
+	for j := range collectors {
+		c := &collectors[j]
+		cs := proto.ColumnStatistics{
+			ColumnId:      c.column.Id,
+			NullCount:     c.nullCount,
+			DistinctCount: c.sketch.estimate(),
+			Sketch:        []byte(c.sketch),
+		}
+		if c.column.Type != proto.Column_JSON {
+			var values [][]byte
+			for _, encoded := range sample {
+				if encoded[j] != nil {
+					values = append(values, encoded[j])
+				}
+			}
+			if len(values) > 0 {
+				scale := float64(rowCount-c.nullCount) / float64(len(values))
+				cs.Histogram = makeHistogram(values, scale, TableStatsHistogramBuckets)
+			}
+		}
+		stats.Columns = append(stats.Columns, cs)
+	}
+	if err := db.Put(keys.MakeTableStatsKey(desc.Id), &stats); err != nil {
+		return proto.TableStatistics{}, err
+	}
+	return stats, nil
+}
+
+// makeHistogram returns an equi-depth histogram of at most maxBuckets
+// buckets of the key encoded values, scaling the number of values of each
+// bucket by scale. The duplicates of the upper bound of a bucket are
+// included in the bucket, which may therefore exceed its depth.
+func makeHistogram(values [][]byte, scale float64, maxBuckets int) []proto.HistogramBucket {
+	sort.Sort(byteSlices(values))
+	depth := (len(values) + maxBuckets - 1) / maxBuckets
+	var buckets []proto.HistogramBucket
+	for i := 0; i < len(values); {
+		j := i + depth
+		if j > len(values) {
+			j = len(values)
+		}
+		for j < len(values) && bytes.Equal(values[j], values[j-1]) {
+			j++
+		}
+		buckets = append(buckets, proto.HistogramBucket{
+			UpperBound: values[j-1],
+			Count:      int64(float64(j-i)*scale + 0.5),
+		})
+		i = j
+	}
+	return buckets
+}
+
+// decodeTableStats decodes the stored statistics of the described table.
+// The statistics of columns which no longer exist are ignored.
+func decodeTableStats(desc *proto.TableDescriptor, stats *proto.TableStatistics) (*TableStats, error) {
+	ts := &TableStats{
+		RowCount:    stats.RowCount,
+		DataSize:    stats.DataSize,
+		SampleSize:  stats.SampleSize,
+		CollectedAt: time.Unix(0, stats.CollectedAt),
+		Columns:     map[string]ColumnStats{},
+	}
+	columns := columnsByID(desc)
+	for _, cs := range stats.Columns {
+		column, ok := columns[cs.ColumnId]
+		if !ok {
+			continue
+		}
+		c := ColumnStats{NullCount: cs.NullCount, DistinctCount: cs.DistinctCount}
+		for _, bucket := range cs.Histogram {
+			_, v, err := decodeKeyValue(bucket.UpperBound, column.Type)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %s", column.Name, err)
+			}
+			c.Histogram = append(c.Histogram, HistogramBucket{UpperBound: v, Count: bucket.Count})
+		}
+		ts.Columns[column.Name] = c
+	}
+	return ts, nil
+}
+
+// byteSlices sorts byte slices in increasing order.
+type byteSlices [][]byte
+
+func (b byteSlices) Len() int           { return len(b) }
+func (b byteSlices) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+func (b byteSlices) Less(i, j int) bool { return bytes.Compare(b[i], b[j]) < 0 }
+
+// An hllSketch is a HyperLogLog sketch estimating the number of distinct
+// values added to it. Each register holds the largest number of leading
+// zero bits, plus one, of the hashes of the values selecting it.
+type hllSketch []uint8
+
+func newHLLSketch() hllSketch {
+	return make(hllSketch, 1<<hllPrecision)
+}
+
+// add adds a value to the sketch.
+func (s hllSketch) add(v []byte) {
+	h := fnv.New64a()
+	h.Write(v)
+	x := mix64(h.Sum64())
+	i := x >> (64 - hllPrecision)
+	var rank uint8 = 1
+	for w := x << hllPrecision; w&(1<<63) == 0 && rank <= 64-hllPrecision; w <<= 1 {
+		rank++
+	}
+	if rank > s[i] {
+		s[i] = rank
+	}
+}
+
+// estimate returns the estimated number of distinct values added to the
+// sketch, using linear counting for small cardinalities.
+func (s hllSketch) estimate() int64 {
+	m := float64(len(s))
+	var sum float64
+	var zeros int
+	for _, r := range s {
+		sum += math.Pow(2, -float64(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	e := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if e <= 2.5*m && zeros > 0 {
+		e = m * math.Log(m/float64(zeros))
+	}
+	return int64(e + 0.5)
+}
+
+// mix64 scrambles the bits of a hash, whose high bits select the register
+// of a sketch.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
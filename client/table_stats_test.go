@@ -0,0 +1,202 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestCollectTableStats(t *testing.T) {
+	defer func(n int) { TableStatsHistogramBuckets = n }(TableStatsHistogramBuckets)
+	TableStatsHistogramBuckets = 4
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 3
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if stats, err := db.TableStats("users"); err != nil {
+		t.Fatal(err)
+	} else if stats != nil {
+		t.Errorf("expected no statistics, but found %+v", stats)
+	}
+
+	names := []string{"a", "b", "a", "c", "", "a", "b", "", "d", "a"}
+	for i, name := range names {
+		r := row{"id": int64(i + 1)}
+		if name != "" {
+			r["name"] = name
+		}
+		putTestRows(t, db, "users", r)
+	}
+	collected, err := db.CollectTableStats("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if collected.RowCount != 10 || collected.SampleSize != 10 {
+		t.Errorf("expected 10 rows and samples, but found %d and %d", collected.RowCount, collected.SampleSize)
+	}
+	if collected.DataSize == 0 {
+		t.Errorf("expected the size of the rows to be recorded")
+	}
+	expected := map[string]ColumnStats{
+		"id": {
+			DistinctCount: 10,
+			Histogram: []HistogramBucket{
+				{int64(3), 3}, {int64(6), 3}, {int64(9), 3}, {int64(10), 1},
+			},
+		},
+		// The duplicates of "a" extend the first bucket.
+		"name": {
+			NullCount:     2,
+			DistinctCount: 4,
+			Histogram: []HistogramBucket{
+				{"a", 4}, {"b", 2}, {"d", 2},
+			},
+		},
+	}
+	if !reflect.DeepEqual(expected, collected.Columns) {
+		t.Errorf("expected %+v, but found %+v", expected, collected.Columns)
+	}
+
+	stats, err := db.TableStats("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(collected, stats) {
+		t.Errorf("expected %+v, but found %+v", collected, stats)
+	}
+
+	if _, err := db.TableStats("missing"); err == nil {
+		t.Errorf("expected statistics of a missing table to fail")
+	}
+}
+
+// TestCollectTableStatsSample verifies that the histograms of large tables
+// are built from a sample of their rows and scaled to the whole table.
+func TestCollectTableStatsSample(t *testing.T) {
+	defer func(n int) { TableStatsSampleSize = n }(TableStatsSampleSize)
+	TableStatsSampleSize = 10
+	defer func(n int) { TableStatsHistogramBuckets = n }(TableStatsHistogramBuckets)
+	TableStatsHistogramBuckets = 2
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		putTestRows(t, db, "users", row{"id": int64(i), "name": fmt.Sprintf("user%d", i%50)})
+	}
+	stats, err := db.CollectTableStats("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.RowCount != 100 || stats.SampleSize != 10 {
+		t.Errorf("expected 100 rows and 10 samples, but found %d and %d", stats.RowCount, stats.SampleSize)
+	}
+	id := stats.Columns["id"]
+	if len(id.Histogram) != 2 || id.Histogram[0].Count+id.Histogram[1].Count != 100 {
+		t.Errorf("expected 2 buckets of 100 rows, but found %+v", id.Histogram)
+	}
+	if d := stats.Columns["name"].DistinctCount; d < 45 || d > 55 {
+		t.Errorf("expected about 50 distinct names, but found %d", d)
+	}
+}
+
+func TestRefreshTableStats(t *testing.T) {
+	db, _ := newMemDB()
+	for _, name := range []string{"users", "orders"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	refresh := func(maxAge time.Duration, expected int) {
+		if n, err := db.RefreshTableStats(maxAge); err != nil {
+			t.Fatal(err)
+		} else if n != expected {
+			t.Errorf("expected %d tables to be refreshed, but found %d", expected, n)
+		}
+	}
+	refresh(time.Hour, 2)
+	refresh(time.Hour, 0)
+
+	// Changing the schema of a table refreshes its statistics.
+	if err := db.SetTableTTL("users", time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	refresh(time.Hour, 1)
+	refresh(0, 2)
+}
+
+// TestTableStatsLease verifies that the statistics of tables are refreshed
+// by a single holder of the lease, and that the lease can be acquired by
+// another owner once it expires.
+func TestTableStatsLease(t *testing.T) {
+	db, s := newMemDB()
+	other := newDB(s)
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	acquire := func(db *DB, owner string, duration time.Duration, expected bool) {
+		if ok, err := db.acquireTableStatsLease([]byte(owner), duration); err != nil {
+			t.Fatal(err)
+		} else if ok != expected {
+			t.Errorf("%s: expected acquired=%t, but found %t", owner, expected, ok)
+		}
+	}
+	acquire(db, "a", time.Hour, true)
+	acquire(other, "b", time.Hour, false)
+	acquire(db, "a", -time.Hour, true)
+	acquire(other, "b", time.Hour, true)
+
+	// A refresh stops once the lease has been lost.
+	if n, err := db.refreshTableStats(0, func() (bool, error) {
+		return db.acquireTableStatsLease([]byte("a"), time.Hour)
+	}); err != nil {
+		t.Fatal(err)
+	} else if n != 0 {
+		t.Errorf("expected no tables to be refreshed without the lease, but found %d", n)
+	}
+	if n, err := other.refreshTableStats(0, func() (bool, error) {
+		return other.acquireTableStatsLease([]byte("b"), time.Hour)
+	}); err != nil {
+		t.Fatal(err)
+	} else if n != 1 {
+		t.Errorf("expected 1 table to be refreshed by the lease holder, but found %d", n)
+	}
+}
+
+func TestHLLSketch(t *testing.T) {
+	s := newHLLSketch()
+	if n := s.estimate(); n != 0 {
+		t.Errorf("expected an empty sketch to estimate 0, but found %d", n)
+	}
+	for _, n := range []int{10, 1000, 100000} {
+		s := newHLLSketch()
+		for i := 0; i < n; i++ {
+			s.add([]byte(fmt.Sprintf("value%d", i)))
+			// Duplicates do not change the estimate.
+			s.add([]byte(fmt.Sprintf("value%d", i/2)))
+		}
+		if e := s.estimate(); float64(e) < 0.9*float64(n) || float64(e) > 1.1*float64(n) {
+			t.Errorf("%d: expected an estimate within 10%%, but found %d", n, e)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// SystemTableNamePrefix is the table name prefix reserved for system
+// tables, such as the tables holding descriptor and namespace bookkeeping.
+// Tables whose IDs are at most keys.MaxReservedDescID are system tables as
+// well. System tables are protected from CreateTable, RenameTable,
+// DropTable and TruncateTable unless ForceOpt is specified.
+const SystemTableNamePrefix = "system_"
+
+// ForceOpt allows a schema change to operate on a system table or to use a
+// name reserved for system tables.
+func ForceOpt() TableOption {
+	return func(o *tableOptions) {
+		o.force = true
+	}
+}
+
+// isSystemTable returns true if the descriptor describes a system table.
+func isSystemTable(desc *proto.TableDescriptor) bool {
+	return desc.Id <= keys.MaxReservedDescID || strings.HasPrefix(desc.Name, SystemTableNamePrefix)
+}
+
+// checkSystemTable returns an error if the described table, referred to as
+// name, is a system table and ForceOpt was not specified.
+func checkSystemTable(desc *proto.TableDescriptor, name string, opts []TableOption) error {
+	if isSystemTable(desc) && !makeTableOptions(opts).force {
+		return fmt.Errorf("table %q is a system table", name)
+	}
+	return nil
+}
+
+// checkSystemTableName returns an error if the unqualified table name is
+// reserved for system tables and ForceOpt was not specified.
+func checkSystemTableName(name string, opts []TableOption) error {
+	if strings.HasPrefix(name, SystemTableNamePrefix) && !makeTableOptions(opts).force {
+		return fmt.Errorf("table name %q is reserved for system tables", name)
+	}
+	return nil
+}
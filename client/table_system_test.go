@@ -0,0 +1,74 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import "testing"
+
+func TestSystemTables(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("system_namespace")); err == nil ||
+		err.Error() != `table name "system_namespace" is reserved for system tables` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.CreateTable(testSchema("system_namespace"), ForceOpt()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("system_jobs"), ForceOpt()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "system_namespace", row{"id": 1, "name": "a"})
+
+	testData := []struct {
+		fn  func(opts ...TableOption) error
+		err string
+	}{
+		{func(opts ...TableOption) error { return db.TruncateTable("system_namespace", opts...) },
+			`table "system_namespace" is a system table`},
+		{func(opts ...TableOption) error { return db.RenameTable("system_namespace", "ns", opts...) },
+			`table "system_namespace" is a system table`},
+		{func(opts ...TableOption) error { return db.RenameTable("users", "system_users", opts...) },
+			`table name "system_users" is reserved for system tables`},
+		{func(opts ...TableOption) error { return db.DropTable("system_jobs", opts...) },
+			`table "system_jobs" is a system table`},
+	}
+	for i, d := range testData {
+		if err := d.fn(); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+	if rows := scanTestRows(t, db, "system_namespace"); len(rows) != 1 {
+		t.Errorf("expected 1 row, but found %v", rows)
+	}
+
+	// Each operation succeeds when forced.
+	for i, d := range testData {
+		if err := d.fn(ForceOpt()); err != nil {
+			t.Errorf("%d: unexpected error: %v", i, err)
+		}
+	}
+	if _, err := db.DescribeTable("system_users"); err != nil {
+		t.Error(err)
+	}
+	if _, err := db.DescribeTable("ns"); err != nil {
+		t.Error(err)
+	}
+	if _, err := db.DescribeTable("system_jobs"); err == nil {
+		t.Error("expected system_jobs to be dropped")
+	}
+}
@@ -0,0 +1,792 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// memSender is a Sender which applies calls to an in-memory map. Writes are
+// applied immediately; transactions are not isolated.
+type memSender struct {
+	sync.Mutex
+	data    map[string]proto.Value
+	splits  []proto.Key // split keys, in the order of the AdminSplit calls
+	merges  []proto.Key // merge keys, in the order of the AdminMerge calls
+	batches int         // number of BatchRequests received
+	clock   int64       // timestamp of the last row write
+	changes []proto.KeyValueChange
+}
+
+func newMemDB() (*DB, *memSender) {
+	s := &memSender{data: map[string]proto.Value{}}
+	return newDB(s), s
+}
+
+func (s *memSender) sortedKeys(start, end proto.Key) []string {
+	var keys []string
+	for k := range s.data {
+		if bytes.Compare([]byte(k), start) >= 0 && bytes.Compare([]byte(k), end) < 0 {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *memSender) Send(_ context.Context, call Call) {
+	s.Lock()
+	defer s.Unlock()
+	s.send(call.Args, call.Reply)
+}
+
+func (s *memSender) send(args proto.Request, reply proto.Response) {
+	reply.Reset()
+	header := args.Header()
+	if header.Txn != nil {
+		txn := gogoproto.Clone(header.Txn).(*proto.Transaction)
+		if len(txn.ID) == 0 {
+			txn.ID = []byte("mem-txn")
+		}
+		reply.Header().Txn = txn
+	}
+
+	switch t := args.(type) {
+	case *proto.BatchRequest:
+		s.batches++
+		br := reply.(*proto.BatchResponse)
+		for _, union := range t.Requests {
+			req := union.GetValue().(proto.Request)
+			resp := req.CreateReply()
+			s.send(req, resp)
+			br.Add(resp)
+		}
+	case *proto.GetRequest:
+		if v, ok := s.data[string(t.Key)]; ok {
+			reply.(*proto.GetResponse).Value = gogoproto.Clone(&v).(*proto.Value)
+		}
+	case *proto.PutRequest:
+		s.data[string(t.Key)] = t.Value
+		s.recordChange(t.Key, &t.Value)
+	case *proto.ConditionalPutRequest:
+		v, ok := s.data[string(t.Key)]
+		if (t.ExpValue == nil && ok) || (t.ExpValue != nil && (!ok || !bytes.Equal(t.ExpValue.Bytes, v.Bytes))) {
+			var actual *proto.Value
+			if ok {
+				actual = &v
+			}
+			reply.Header().SetGoError(&proto.ConditionFailedError{ActualValue: actual})
+			return
+		}
+		s.data[string(t.Key)] = t.Value
+		s.recordChange(t.Key, &t.Value)
+	case *proto.PutUniqueRequest:
+		if v, ok := s.data[string(t.Key)]; ok && !bytes.Equal(t.Value.Bytes, v.Bytes) {
+			reply.Header().SetGoError(&proto.ConditionFailedError{ActualValue: &v})
+			return
+		}
+		s.data[string(t.Key)] = t.Value
+		s.recordChange(t.Key, &t.Value)
+	case *proto.IncrementRequest:
+		v := s.data[string(t.Key)]
+		var n int64
+		if v.Integer != nil {
+			n = *v.Integer
+		}
+		n += t.Increment
+		s.data[string(t.Key)] = proto.Value{Integer: &n}
+		reply.(*proto.IncrementResponse).NewValue = n
+	case *proto.ScanRequest:
+		resp := reply.(*proto.ScanResponse)
+		resp.Timestamp = t.Timestamp
+		if resp.Timestamp.Equal(proto.ZeroTimestamp) {
+			resp.Timestamp = proto.Timestamp{WallTime: s.clock}
+		}
+		keys := s.sortedKeys(t.Key, t.EndKey)
+		if t.Reverse {
+			sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+		}
+		for _, k := range keys {
+			if t.MaxResults > 0 && int64(len(resp.Rows)) >= t.MaxResults {
+				break
+			}
+			v := s.data[k]
+			if t.KeysOnly {
+				v = proto.Value{}
+			}
+			resp.Rows = append(resp.Rows, proto.KeyValue{Key: proto.Key(k), Value: v})
+		}
+	case *proto.DeleteRequest:
+		delete(s.data, string(t.Key))
+		s.recordChange(t.Key, nil)
+	case *proto.DeleteRangeRequest:
+		resp := reply.(*proto.DeleteRangeResponse)
+		for _, k := range s.sortedKeys(t.Key, t.EndKey) {
+			if t.MaxEntriesToDelete > 0 && resp.NumDeleted >= t.MaxEntriesToDelete {
+				break
+			}
+			delete(s.data, k)
+			s.recordChange(proto.Key(k), nil)
+			resp.NumDeleted++
+		}
+	case *proto.ScanRowsRequest:
+		resp := reply.(*proto.ScanRowsResponse)
+		prefix := keys.MakeIndexPrefix(t.TableId, t.IndexId)
+		for _, a := range t.Aggregates {
+			resp.Aggregates = append(resp.Aggregates, proto.RowAggregateResult{Aggregate: a})
+		}
+		var row *proto.Row
+		var rowKey proto.Key
+		finishRow := func() {
+			if row == nil {
+				return
+			}
+			if ok, _ := proto.MatchRow(row, t.Filters); ok && len(t.Aggregates) == 0 {
+				resp.Rows = append(resp.Rows, projectRow(*row, t.ColumnIds))
+			} else if ok {
+				for i := range resp.Aggregates {
+					if err := resp.Aggregates[i].Add(row); err != nil {
+						resp.SetGoError(err)
+					}
+				}
+			}
+			row = nil
+		}
+		for _, k := range s.sortedKeys(t.Key, t.EndKey) {
+			if id, ok := keys.DecodeCellKey(rowKey, proto.Key(k)); rowKey != nil && ok {
+				row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: s.data[k].Bytes})
+				continue
+			}
+			finishRow()
+			if t.MaxRows > 0 && int64(len(resp.Rows)) >= t.MaxRows {
+				break
+			}
+			rowKey = proto.Key(k)
+			row = &proto.Row{PrimaryKey: []byte(k[len(prefix):])}
+		}
+		finishRow()
+	case *proto.GetRowRequest:
+		if _, ok := s.data[string(t.Key)]; !ok {
+			break
+		}
+		prefix := keys.MakeIndexPrefix(t.TableId, t.IndexId)
+		row := proto.Row{PrimaryKey: []byte(t.Key[len(prefix):])}
+		start, end := proto.KeySpan(t)
+		for _, k := range s.sortedKeys(start, end) {
+			if id, ok := keys.DecodeCellKey(t.Key, proto.Key(k)); ok {
+				row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: s.data[k].Bytes})
+			}
+		}
+		row = projectRow(row, t.ColumnIds)
+		reply.(*proto.GetRowResponse).Row = &row
+	case *proto.LockRowRequest:
+		// There are no concurrent transactions to lock the row against.
+		getReply := &proto.GetRowResponse{}
+		s.send(&proto.GetRowRequest{
+			RequestHeader: t.RequestHeader,
+			TableId:       t.TableId,
+			IndexId:       t.IndexId,
+			PrimaryKey:    t.PrimaryKey,
+			ColumnIds:     t.ColumnIds,
+		}, getReply)
+		reply.(*proto.LockRowResponse).Row = getReply.Row
+	case *proto.PutRowRequest:
+		s.clock++
+		s.data[string(t.Key)] = proto.Value{Bytes: []byte{}}
+		s.recordChange(t.Key, &proto.Value{Bytes: []byte{}})
+		for _, cell := range t.Cells {
+			key := keys.MakeCellKey(t.Key, cell.ColumnId)
+			if cell.Value == nil {
+				delete(s.data, string(key))
+				s.recordChange(key, nil)
+			} else {
+				s.data[string(key)] = proto.Value{Bytes: cell.Value}
+				s.recordChange(key, &proto.Value{Bytes: cell.Value})
+			}
+		}
+	case *proto.DeleteRowRequest:
+		s.clock++
+		start, end := proto.KeySpan(t)
+		for _, k := range s.sortedKeys(start, end) {
+			delete(s.data, k)
+			s.recordChange(proto.Key(k), nil)
+			reply.(*proto.DeleteRowResponse).Deleted = true
+		}
+	case *proto.ScanChangesRequest:
+		// Only the changes of rows and of plain writes are recorded.
+		now := t.Timestamp
+		if now.Equal(proto.ZeroTimestamp) {
+			now = proto.Timestamp{WallTime: s.clock}
+		}
+		resp := reply.(*proto.ScanChangesResponse)
+		resp.Timestamp = now
+		var changes []proto.KeyValueChange
+		for _, c := range s.changes {
+			if !c.Key.Less(t.Key) && c.Key.Less(t.EndKey) &&
+				t.StartTimestamp.Less(*c.Value.Timestamp) && !now.Less(*c.Value.Timestamp) {
+				changes = append(changes, c)
+			}
+		}
+		sort.Sort(changesByKey(changes))
+		for i, c := range changes {
+			if t.MaxResults > 0 && int64(i) >= t.MaxResults && !c.Key.Equal(changes[i-1].Key) {
+				break
+			}
+			resp.Changes = append(resp.Changes, c)
+		}
+	case *proto.AdminSplitRequest:
+		s.splits = append(s.splits, t.SplitKey)
+	case *proto.AdminMergeRequest:
+		s.merges = append(s.merges, t.Key)
+	case *proto.EndTransactionRequest:
+	}
+}
+
+// recordChange records the version of a key written at the timestamp of
+// the last row write, replacing a version of the key written at the same
+// timestamp. A nil value records a deletion.
+func (s *memSender) recordChange(key proto.Key, value *proto.Value) {
+	c := proto.KeyValueChange{Key: key, Deleted: value == nil}
+	if value != nil {
+		c.Value = *gogoproto.Clone(value).(*proto.Value)
+	}
+	c.Value.Timestamp = &proto.Timestamp{WallTime: s.clock}
+	for i := len(s.changes) - 1; i >= 0 && s.changes[i].Value.Timestamp.Equal(*c.Value.Timestamp); i-- {
+		if s.changes[i].Key.Equal(key) {
+			s.changes[i] = c
+			return
+		}
+	}
+	s.changes = append(s.changes, c)
+}
+
+// changesByKey sorts versions like a ScanChanges response: by key and
+// then by descending timestamp.
+type changesByKey []proto.KeyValueChange
+
+func (c changesByKey) Len() int      { return len(c) }
+func (c changesByKey) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c changesByKey) Less(i, j int) bool {
+	if !c[i].Key.Equal(c[j].Key) {
+		return c[i].Key.Less(c[j].Key)
+	}
+	return c[j].Value.Timestamp.Less(*c[i].Value.Timestamp)
+}
+
+func testSchema(name string) proto.TableSchema {
+	return proto.TableSchema{
+		Table: proto.Table{Name: name},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+			{Index: proto.Index{Name: "by_name"}, ColumnNames: []string{"name"}},
+		},
+	}
+}
+
+func TestAllocateDescIDBlocks(t *testing.T) {
+	db, s := newMemDB()
+	DescIDBlockOpt(3)(db)
+	other := newDB(s)
+	DescIDBlockOpt(3)(other)
+
+	var ids []uint32
+	for _, d := range []*DB{db, db, other, db, db} {
+		id, err := d.allocateDescID()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, id)
+	}
+	// Each handle reserves blocks of 3 IDs with a single increment.
+	base := uint32(keys.MaxReservedDescID)
+	expected := []uint32{base + 1, base + 2, base + 4, base + 3, base + 7}
+	if !reflect.DeepEqual(ids, expected) {
+		t.Errorf("expected %v, but found %v", expected, ids)
+	}
+	r, err := db.Get(keys.DescIDGenerator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := r.ValueInt(); v != 9 {
+		t.Errorf("expected the generator to be at 9, but found %d", v)
+	}
+	if id, err := db.peekDescID(); err != nil || id != base+8 {
+		t.Errorf("expected the next ID to be %d, but found %d, %v", base+8, id, err)
+	}
+}
+
+func TestCreateTable(t *testing.T) {
+	db, _ := newMemDB()
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := db.Get(keys.MakeTableMetadataKey(keys.DefaultDatabaseID, "users"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Exists() {
+		t.Fatalf("expected table name to be written")
+	}
+	id := decodeDescID(r.ValueBytes())
+	if id <= keys.MaxReservedDescID {
+		t.Errorf("expected table ID > %d, but found %d", keys.MaxReservedDescID, id)
+	}
+	var desc proto.TableDescriptor
+	if err := db.GetProto(keys.MakeDescMetadataKey(id), &desc); err != nil {
+		t.Fatal(err)
+	}
+	if desc.Id != id || desc.ParentId != keys.DefaultDatabaseID || desc.Name != "users" {
+		t.Errorf("unexpected descriptor: %+v", desc)
+	}
+	if err := proto.ValidateTableDesc(desc); err != nil {
+		t.Error(err)
+	}
+
+	// Table names must be unique within a database.
+	if err := db.CreateTable(testSchema("users")); err == nil ||
+		err.Error() != `table "users" already exists` {
+		t.Errorf("unexpected error: %v", err)
+	} else if e, ok := err.(*TableExistsError); !ok || e.Name != "users" {
+		t.Errorf("expected a *TableExistsError, but found %T", err)
+	}
+	// A second table receives a new ID.
+	if err := db.CreateTable(testSchema("default.accounts")); err != nil {
+		t.Fatal(err)
+	}
+	r, err = db.Get(keys.MakeTableMetadataKey(keys.DefaultDatabaseID, "accounts"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id2 := decodeDescID(r.ValueBytes()); id2 == id {
+		t.Errorf("expected a new table ID, but found %d", id2)
+	}
+}
+
+func TestCreateTableIfNotExists(t *testing.T) {
+	db, _ := newMemDB()
+
+	created, err := db.CreateTableIfNotExists(testSchema("users"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !created {
+		t.Errorf("expected table to be created")
+	}
+	created, err = db.CreateTableIfNotExists(testSchema("users"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created {
+		t.Errorf("expected existing table to be left alone")
+	}
+
+	// A subset of the existing schema is compatible.
+	schema := testSchema("users")
+	schema.Columns = schema.Columns[:1]
+	schema.Indexes = schema.Indexes[:1]
+	if _, err := db.CreateTableIfNotExists(schema); err != nil {
+		t.Error(err)
+	}
+
+	testData := []struct {
+		modify func(*proto.TableSchema)
+		err    string
+	}{
+		{func(s *proto.TableSchema) { s.Columns[1].Type = proto.Column_BYTES },
+			`column "name" differs`},
+		{func(s *proto.TableSchema) { s.Columns = append(s.Columns, proto.Column{Name: "age"}) },
+			`column "age" does not exist`},
+		{func(s *proto.TableSchema) { s.Indexes[0].ColumnNames = []string{"name"} },
+			`primary key differs`},
+		{func(s *proto.TableSchema) { s.Indexes[1].Unique = true },
+			`index "by_name" differs`},
+		{func(s *proto.TableSchema) { s.Indexes[1].Name = "by_name2" },
+			`index "by_name2" does not exist`},
+	}
+	for i, d := range testData {
+		schema := testSchema("users")
+		d.modify(&schema)
+		expected := `table "users" already exists with an incompatible schema: ` + d.err
+		if _, err := db.CreateTableIfNotExists(schema); err == nil || err.Error() != expected {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, expected, err)
+		}
+	}
+}
+
+func TestDescribeTable(t *testing.T) {
+	db, _ := newMemDB()
+
+	expected := testSchema("users")
+	if err := db.CreateTable(expected); err != nil {
+		t.Fatal(err)
+	}
+	schema, err := db.DescribeTable("default.users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, schema) {
+		t.Errorf("expected %+v, but found %+v", expected, schema)
+	}
+
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if desc.Id <= keys.MaxReservedDescID || desc.ParentId != keys.DefaultDatabaseID {
+		t.Errorf("unexpected descriptor IDs: %+v", desc)
+	}
+	if desc.Columns[1].Id != 2 || desc.Indexes[0].Id != 2 {
+		t.Errorf("unexpected column or index IDs: %+v", desc)
+	}
+
+	_, err = db.DescribeTable("accounts")
+	if _, ok := err.(*TableNotFoundError); !ok {
+		t.Errorf("expected TableNotFoundError, but found %v", err)
+	} else if err.Error() != `table "accounts" does not exist` {
+		t.Errorf("unexpected error: %s", err)
+	}
+	if _, err := db.DescribeTable("missing.users"); err == nil ||
+		err.Error() != `database "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestListTables(t *testing.T) {
+	db, _ := newMemDB()
+
+	if err := db.CreateDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"users", "accounts", "user_emails", "app.users", "app.orders"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testData := []struct {
+		database, pattern string
+		expected          []string
+	}{
+		{"", "", []string{"accounts", "user_emails", "users"}},
+		{"default", "user*", []string{"user_emails", "users"}},
+		{"", "*s", []string{"accounts", "user_emails", "users"}},
+		{"", "users", []string{"users"}},
+		{"", "orders", nil},
+		{"app", "", []string{"orders", "users"}},
+		{"app", "o*", []string{"orders"}},
+	}
+	for i, d := range testData {
+		names, err := db.ListTables(d.database, d.pattern)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(d.expected, names) {
+			t.Errorf("%d: expected %q, but found %q", i, d.expected, names)
+		}
+	}
+
+	if _, err := db.ListTables("missing", ""); err == nil ||
+		err.Error() != `database "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := db.ListTables("", "[a"); err == nil ||
+		err.Error() != `invalid table pattern "[a": syntax error in pattern` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestListTablesPage(t *testing.T) {
+	db, _ := newMemDB()
+
+	for _, name := range []string{"a1", "b1", "a2", "b2", "a3", "b3", "a4"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testData := []struct {
+		pattern  string
+		limit    int
+		expected [][]string
+	}{
+		{"", 3, [][]string{{"a1", "a2", "a3"}, {"a4", "b1", "b2"}, {"b3"}}},
+		{"", 7, [][]string{{"a1", "a2", "a3", "a4", "b1", "b2", "b3"}, nil}},
+		{"b*", 2, [][]string{{"b1", "b2"}, {"b3"}}},
+		{"a*", 2, [][]string{{"a1", "a2"}, {"a3", "a4"}, nil}},
+		{"c*", 2, [][]string{nil}},
+	}
+	for i, d := range testData {
+		var pages [][]string
+		for token := ""; ; {
+			names, next, err := db.ListTablesPage("", d.pattern, d.limit, token)
+			if err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+			pages = append(pages, names)
+			if next == "" {
+				break
+			}
+			token = next
+		}
+		if !reflect.DeepEqual(d.expected, pages) {
+			t.Errorf("%d: expected %q, but found %q", i, d.expected, pages)
+		}
+	}
+
+	if _, _, err := db.ListTablesPage("", "", 0, ""); err == nil || err.Error() != "invalid limit 0" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestListTableDescriptors(t *testing.T) {
+	db, _ := newMemDB()
+
+	for _, name := range []string{"users", "accounts", "user_emails"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	schemas, err := db.ListTableDescriptors("", "user*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []proto.TableSchema{testSchema("user_emails"), testSchema("users")}
+	if !reflect.DeepEqual(expected, schemas) {
+		t.Errorf("expected %+v, but found %+v", expected, schemas)
+	}
+	if schemas, err := db.ListTableDescriptors("", "orders"); err != nil || len(schemas) != 0 {
+		t.Errorf("expected no schemas, but found %+v (%v)", schemas, err)
+	}
+}
+
+func TestRenameTable(t *testing.T) {
+	db, _ := newMemDB()
+
+	for _, name := range []string{"users", "accounts"} {
+		if err := db.CreateTable(testSchema(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	before, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RenameTable("users", "default.people"); err != nil {
+		t.Fatal(err)
+	}
+	after, err := db.DescribeTableDesc("people")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if after.Id != before.Id || after.Name != "people" {
+		t.Errorf("unexpected descriptor after rename: %+v", after)
+	}
+	if _, err := db.DescribeTable("users"); err == nil {
+		t.Errorf("expected old name to be removed")
+	}
+
+	testData := []struct {
+		oldName, newName string
+		err              string
+	}{
+		{"users", "customers", `table "users" does not exist`},
+		{"people", "accounts", `table "accounts" already exists`},
+		{"people", "missing.people", `database "missing" does not exist`},
+		{"people", "a.b.c", `invalid table name: "a.b.c"`},
+		{"people", "", "empty table name"},
+	}
+	for i, d := range testData {
+		if err := db.RenameTable(d.oldName, d.newName); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestDropTable(t *testing.T) {
+	db, _ := newMemDB()
+
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	users, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.DescribeTable("users"); err == nil {
+		t.Errorf("expected table to be dropped")
+	}
+	var desc proto.TableDescriptor
+	if err := db.GetProto(keys.MakeDescMetadataKey(users.Id), &desc); err != nil {
+		t.Fatal(err)
+	}
+	if desc.DropTime == 0 || desc.Version != users.Version+1 {
+		t.Errorf("expected descriptor to be marked as dropped at version %d: %+v", users.Version+1, desc)
+	}
+
+	if err := db.DropTable("users"); err == nil || err.Error() != `table "users" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	// The drop waits for leases on older versions of the descriptor.
+	if err := db.CreateTable(testSchema("accounts")); err != nil {
+		t.Fatal(err)
+	}
+	lease, err := db.AcquireTableLease("accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.RenameColumn("accounts", "name", "first_name"); err != nil {
+		t.Fatal(err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		done <- db.DropTable("accounts")
+	}()
+	select {
+	case err := <-done:
+		t.Fatalf("expected the drop to wait for the lease, but found %v", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+	if _, err := db.DescribeTable("accounts"); err != nil {
+		t.Errorf("expected the table to exist while the lease is held: %s", err)
+	}
+	if err := db.ReleaseTableLease(lease); err != nil {
+		t.Fatal(err)
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.DescribeTable("accounts"); err == nil {
+		t.Errorf("expected table to be dropped")
+	}
+}
+
+func TestGrants(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	read, write := proto.PrivilegeDescriptor_READ, proto.PrivilegeDescriptor_WRITE
+
+	if err := db.Grant("users", "bob", []proto.PrivilegeDescriptor_Kind{read, write}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Revoke("users", "bob", []proto.PrivilegeDescriptor_Kind{write}); err != nil {
+		t.Fatal(err)
+	}
+	grants, err := db.ShowGrants("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []proto.UserPrivilegeString{
+		{User: "bob", Privileges: []string{"READ"}},
+		{User: "root", Privileges: []string{"ALL"}},
+	}
+	if !reflect.DeepEqual(expected, grants) {
+		t.Errorf("expected %v, but found %v", expected, grants)
+	}
+
+	if err := db.Revoke("users", "root", []proto.PrivilegeDescriptor_Kind{read}); err == nil ||
+		err.Error() != `cannot revoke privileges of user "root"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := db.Grant("users", "", []proto.PrivilegeDescriptor_Kind{read}); err == nil ||
+		err.Error() != "empty user name" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := db.ShowGrants("accounts"); err == nil ||
+		err.Error() != `table "accounts" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCreateTableErrors(t *testing.T) {
+	db, _ := newMemDB()
+
+	testData := []struct {
+		schema proto.TableSchema
+		err    string
+	}{
+		{testSchema("a.b.c"), `invalid table name: "a.b.c"`},
+		{testSchema(".b"), `invalid table name: ".b"`},
+		{testSchema("missing.users"), `database "missing" does not exist`},
+		{proto.TableSchema{Table: proto.Table{Name: "users"}},
+			"table must contain at least 1 column; table must contain a primary key"},
+	}
+	for i, d := range testData {
+		if err := db.CreateTable(d.schema); err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestSplitTableName(t *testing.T) {
+	testData := []struct {
+		name, db, table string
+	}{
+		{"users", "app", "users"},
+		{"app.users", "app", "users"},
+		{"billing.users", "billing", "users"},
+	}
+	for i, d := range testData {
+		db, table, err := splitTableName(d.name, "app")
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if db != d.db || table != d.table {
+			t.Errorf("%d: expected %s.%s, but found %s.%s", i, d.db, d.table, db, table)
+		}
+	}
+}
+
+// projectRow drops the cells of the row whose columns are not listed in
+// columnIDs, unless columnIDs is empty.
+func projectRow(row proto.Row, columnIDs []uint32) proto.Row {
+	if len(columnIDs) == 0 {
+		return row
+	}
+	var cells []proto.RowCell
+	for _, cell := range row.Cells {
+		for _, id := range columnIDs {
+			if cell.ColumnId == id {
+				cells = append(cells, cell)
+				break
+			}
+		}
+	}
+	row.Cells = cells
+	return row
+}
@@ -0,0 +1,149 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// SetTableTTL sets the time to live of the rows of a table, measured from
+// the last write of each row and truncated to whole seconds. Expired rows
+// are skipped by reads and deleted by the garbage collection of the
+// ranges holding them. A zero ttl removes the time to live. The time to
+// live can also be set when creating a table with the ttl_seconds field
+// of the schema.
+func (db *DB) SetTableTTL(table string, ttl time.Duration) error {
+	if ttl < 0 || (ttl > 0 && ttl < time.Second) {
+		return fmt.Errorf("invalid TTL %s: must be zero or at least 1s", ttl)
+	}
+	return db.schemaChangeTxn(table, func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, table)
+		if err != nil {
+			return err
+		}
+		desc.TTLSeconds = int32(ttl / time.Second)
+		if err := bumpTableVersion(txn, &desc); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Put(keys.MakeDescMetadataKey(desc.Id), &desc)
+		if desc.TTLSeconds > 0 {
+			b.Put(keys.MakeTableTTLKey(desc.Id), encodeDescID(desc.Id))
+		} else {
+			b.Del(keys.MakeTableTTLKey(desc.Id))
+		}
+		return txn.Commit(b)
+	})
+}
+
+// ListExpiringTables returns the descriptors of the tables whose rows have
+// a time to live, excluding dropped tables.
+func (db *DB) ListExpiringTables() ([]proto.TableDescriptor, error) {
+	var descs []proto.TableDescriptor
+	err := db.Txn(func(txn *Txn) error {
+		descs = nil
+		rows, err := txn.Scan(keys.TableTTLPrefix, keys.TableTTLPrefix.PrefixEnd(), 0)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			var desc proto.TableDescriptor
+			if err := txn.GetProto(keys.MakeDescMetadataKey(decodeDescID(row.ValueBytes())), &desc); err != nil {
+				return err
+			}
+			if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+				return err
+			}
+			if desc.TTLSeconds > 0 && desc.DropTime == 0 {
+				descs = append(descs, desc)
+			}
+		}
+		return nil
+	})
+	return descs, err
+}
+
+// DeleteExpiredRow deletes the row of the described table with the given
+// sentinel key, along with its secondary index entries, if the row has
+// expired. The row is read, checked and deleted in a single transaction,
+// so a row rewritten since it was found expired is kept along with its
+// index entries. Returns true if the row was deleted. It is used by the
+// garbage collection of the ranges of expiring tables.
+func (db *DB) DeleteExpiredRow(desc *proto.TableDescriptor, rowKey proto.Key) (bool, error) {
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	if !bytes.HasPrefix(rowKey, prefix) {
+		return false, fmt.Errorf("key %q is not in table %q", rowKey, desc.Name)
+	}
+	var deleted bool
+	err := db.Txn(func(txn *Txn) error {
+		deleted = false
+		get := &proto.GetRowResponse{}
+		b := &Batch{}
+		b.InternalAddCall(Call{
+			Args: &proto.GetRowRequest{
+				RequestHeader: proto.RequestHeader{Key: rowKey},
+				TableId:       desc.Id,
+				IndexId:       desc.PrimaryIndex.Id,
+				PrimaryKey:    []byte(rowKey[len(prefix):]),
+			},
+			Reply: get,
+		})
+		if err := txn.Run(b); err != nil {
+			return err
+		}
+		if get.Row == nil {
+			return nil
+		}
+		values, err := decodeRow(desc, prefix, get.Row)
+		if err != nil {
+			return err
+		}
+		entries, _, err := makeIndexEntries(desc, rowKey, values)
+		if err != nil {
+			return err
+		}
+		del := &proto.DeleteRowResponse{}
+		b = &Batch{}
+		b.InternalAddCall(Call{
+			Args: &proto.DeleteRowRequest{
+				RequestHeader: proto.RequestHeader{Key: rowKey},
+				TableId:       desc.Id,
+				IndexId:       desc.PrimaryIndex.Id,
+				PrimaryKey:    []byte(rowKey[len(prefix):]),
+				TTLSeconds:    desc.TTLSeconds,
+			},
+			Reply: del,
+		})
+		if err := txn.Run(b); err != nil {
+			return err
+		}
+		if !del.Deleted {
+			return nil
+		}
+		deleted = true
+		b = &Batch{}
+		for _, entry := range entries {
+			b.Del(entry.key)
+		}
+		return txn.Commit(b)
+	})
+	return deleted, err
+}
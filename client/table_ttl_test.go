@@ -0,0 +1,78 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetTableTTL(t *testing.T) {
+	db, _ := newMemDB()
+	schema := testSchema("users")
+	schema.TTLSeconds = 60
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CreateTable(testSchema("accounts")); err != nil {
+		t.Fatal(err)
+	}
+	expiring := func() []string {
+		descs, err := db.ListExpiringTables()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, desc := range descs {
+			names = append(names, desc.Name)
+		}
+		return names
+	}
+	if names := expiring(); len(names) != 1 || names[0] != "users" {
+		t.Errorf("expected [users], but found %v", names)
+	}
+
+	if err := db.SetTableTTL("accounts", 90*time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.SetTableTTL("users", 0); err != nil {
+		t.Fatal(err)
+	}
+	if names := expiring(); len(names) != 1 || names[0] != "accounts" {
+		t.Errorf("expected [accounts], but found %v", names)
+	}
+	schema, err := db.DescribeTable("accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.TTLSeconds != 90*60 {
+		t.Errorf("expected a TTL of %d, but found %d", 90*60, schema.TTLSeconds)
+	}
+
+	// Dropped tables are not listed.
+	if err := db.DropTable("accounts"); err != nil {
+		t.Fatal(err)
+	}
+	if names := expiring(); len(names) != 0 {
+		t.Errorf("expected no tables, but found %v", names)
+	}
+
+	for i, ttl := range []time.Duration{-time.Second, time.Millisecond} {
+		if err := db.SetTableTTL("users", ttl); err == nil {
+			t.Errorf("%d: expected an error for a TTL of %s", i, ttl)
+		}
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// IndexViolationKind describes how a secondary index is inconsistent with
+// the rows of its table.
+type IndexViolationKind int
+
+const (
+	// MissingIndexEntry indicates that a row has no entry in an index, or
+	// that its entry differs from the expected one.
+	MissingIndexEntry IndexViolationKind = iota
+	// DanglingIndexEntry indicates that an index entry does not belong to
+	// any row.
+	DanglingIndexEntry
+	// UniqueViolation indicates that two rows have the same entry in a
+	// unique index.
+	UniqueViolation
+)
+
+func (k IndexViolationKind) String() string {
+	switch k {
+	case MissingIndexEntry:
+		return "missing index entry"
+	case DanglingIndexEntry:
+		return "dangling index entry"
+	case UniqueViolation:
+		return "unique violation"
+	}
+	return fmt.Sprintf("IndexViolationKind(%d)", int(k))
+}
+
+// An IndexViolation describes an inconsistency between a secondary index
+// and the rows of its table.
+type IndexViolation struct {
+	Kind  IndexViolationKind
+	Index string
+	// Key is the key of the index entry: the expected key for missing
+	// entries and the actual key otherwise.
+	Key proto.Key
+	// RowKey is the sentinel key of the row, if any, the entry belongs to.
+	// For unique violations, it is the key of the row without the entry and
+	// OtherRowKey is the key of the row the entry refers to.
+	RowKey      proto.Key
+	OtherRowKey proto.Key
+}
+
+func (v IndexViolation) String() string {
+	switch v.Kind {
+	case DanglingIndexEntry:
+		return fmt.Sprintf("index %q: %s %q", v.Index, v.Kind, v.Key)
+	case UniqueViolation:
+		return fmt.Sprintf("index %q: %s: rows %q and %q have entry %q",
+			v.Index, v.Kind, v.RowKey, v.OtherRowKey, v.Key)
+	}
+	return fmt.Sprintf("index %q: %s %q for row %q", v.Index, v.Kind, v.Key, v.RowKey)
+}
+
+// A TableValidationReport describes the outcome of ValidateTable.
+type TableValidationReport struct {
+	Rows         int64 // the number of rows checked
+	IndexEntries int64 // the number of index entries checked
+	Violations   []IndexViolation
+}
+
+// OK returns true if no violations were found.
+func (r TableValidationReport) OK() bool {
+	return len(r.Violations) == 0
+}
+
+// ValidateTable cross-checks the secondary indexes of the named table
+// against its rows. Every row must have the expected entry in each index
+// and every index entry must belong to a row. The rows and the entries of
+// each index are checked in chunks of TableBackfillChunkSize keys, each
+// within its own transaction, so concurrent writes may be reported as
+// violations. An error is returned if the table cannot be read; the
+// violations found are returned in the report.
+func (db *DB) ValidateTable(name string) (TableValidationReport, error) {
+	var report TableValidationReport
+	var desc proto.TableDescriptor
+	if err := db.Txn(func(txn *Txn) error {
+		var err error
+		desc, err = getTableDescByName(txn, name)
+		return err
+	}); err != nil {
+		return report, err
+	}
+
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	start, end := prefix, prefix.PrefixEnd()
+	for done := false; !done; {
+		var rows int
+		var violations []IndexViolation
+		var next proto.Key
+		err := db.background().Txn(func(txn *Txn) error {
+			var err error
+			var chunk []row
+			var rowKeys []proto.Key
+			if chunk, rowKeys, next, done, err = readRowChunk(txn, &desc, start, end); err != nil {
+				return err
+			}
+			rows = len(chunk)
+			violations, err = checkRowIndexEntries(txn, &desc, chunk, rowKeys)
+			return err
+		})
+		if err != nil {
+			return report, err
+		}
+		report.Rows += int64(rows)
+		report.Violations = append(report.Violations, violations...)
+		start = next
+	}
+
+	for _, index := range desc.Indexes {
+		prefix := makeIndexPrefix(desc.Id, index.Id)
+		start, end := prefix, prefix.PrefixEnd()
+		for done := false; !done; {
+			var entries int
+			var violations []IndexViolation
+			var next proto.Key
+			err := db.background().Txn(func(txn *Txn) error {
+				kvs, err := txn.Scan(start, end, TableBackfillChunkSize)
+				if err != nil {
+					return err
+				}
+				done = int64(len(kvs)) < TableBackfillChunkSize
+				if len(kvs) > 0 {
+					next = proto.Key(kvs[len(kvs)-1].Key).Next()
+				}
+				entries = len(kvs)
+				violations, err = checkIndexEntries(txn, &desc, index, kvs)
+				return err
+			})
+			if err != nil {
+				return report, err
+			}
+			report.IndexEntries += int64(entries)
+			report.Violations = append(report.Violations, violations...)
+			start = next
+		}
+	}
+	return report, nil
+}
+
+// checkRowIndexEntries verifies that the rows have the expected entries in
+// each secondary index.
+func checkRowIndexEntries(txn *Txn, desc *proto.TableDescriptor, rows []row, rowKeys []proto.Key) ([]IndexViolation, error) {
+	type expected struct {
+		index  proto.IndexDescriptor
+		entry  indexEntry
+		rowKey proto.Key
+	}
+	var entries []expected
+	b := &Batch{}
+	for i, r := range rows {
+		for _, index := range desc.Indexes {
+			entry, ok, err := makeIndexEntry(desc, index, rowKeys[i], r)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				entries = append(entries, expected{index, entry, rowKeys[i]})
+				b.Get(entry.key)
+			}
+		}
+	}
+	if len(entries) == 0 {
+		return nil, nil
+	}
+	if err := txn.Run(b); err != nil {
+		return nil, err
+	}
+	var violations []IndexViolation
+	for i, e := range entries {
+		kv := b.Results[i].Rows[0]
+		if kv.Exists() && bytes.Equal(kv.ValueBytes(), e.entry.value) {
+			continue
+		}
+		v := IndexViolation{Kind: MissingIndexEntry, Index: e.index.Name, Key: e.entry.key, RowKey: e.rowKey}
+		if kv.Exists() && e.index.Unique {
+			// The entry refers to another row. If that row has the same entry,
+			// the rows violate the uniqueness of the index.
+			otherRowKey := append(makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id), kv.ValueBytes()...)
+			ok, err := hasIndexEntry(txn, desc, e.index, otherRowKey, kv)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				v.Kind = UniqueViolation
+				v.OtherRowKey = otherRowKey
+			}
+		}
+		violations = append(violations, v)
+	}
+	return violations, nil
+}
+
+// checkIndexEntries verifies that the entries of the index belong to rows
+// of the table.
+func checkIndexEntries(txn *Txn, desc *proto.TableDescriptor, index proto.IndexDescriptor, kvs []KeyValue) ([]IndexViolation, error) {
+	primaryPrefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	indexPrefixLen := len(makeIndexPrefix(desc.Id, index.Id))
+	var violations []IndexViolation
+	for _, kv := range kvs {
+		var candidates []proto.Key
+		if index.Unique {
+			candidates = []proto.Key{append(append(proto.Key(nil), primaryPrefix...), kv.ValueBytes()...)}
+		} else {
+			// The primary key values are a suffix of the entry's key, but the
+			// index values preceding them need not be decodable (their types
+			// are unknown for expression indexes). Consider every suffix which
+			// decodes as a primary key.
+			for p := len(kv.Key) - 1; p > indexPrefixLen; p-- {
+				rowKey := append(append(proto.Key(nil), primaryPrefix...), kv.Key[p:]...)
+				if _, _, id, err := decodeRowKey(desc, rowKey); err == nil && id == 0 {
+					candidates = append(candidates, rowKey)
+				}
+			}
+		}
+		found := false
+		for _, rowKey := range candidates {
+			ok, err := hasIndexEntry(txn, desc, index, rowKey, kv)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				found = true
+				break
+			}
+		}
+		if !found {
+			violations = append(violations, IndexViolation{Kind: DanglingIndexEntry, Index: index.Name, Key: kv.Key})
+		}
+	}
+	return violations, nil
+}
+
+// hasIndexEntry returns true if the row with the given sentinel key exists
+// and its entry in the index has the key of kv.
+func hasIndexEntry(txn *Txn, desc *proto.TableDescriptor, index proto.IndexDescriptor, rowKey proto.Key, kv KeyValue) (bool, error) {
+	kvs, err := txn.Scan(rowKey, rowKey.PrefixEnd(), 0)
+	if err != nil {
+		return false, err
+	}
+	// The scan may include the cells of the row, but not other rows: the
+	// sentinel key is a prefix of the keys of its cells only.
+	if len(kvs) == 0 || !bytes.Equal(kvs[0].Key, rowKey) {
+		return false, nil
+	}
+	rows, _, err := decodeRows(desc, kvs)
+	if err != nil || len(rows) != 1 {
+		return false, err
+	}
+	entry, ok, err := makeIndexEntry(desc, index, rowKey, rows[0])
+	if err != nil || !ok {
+		return false, err
+	}
+	return bytes.Equal(entry.key, kv.Key), nil
+}
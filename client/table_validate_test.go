@@ -0,0 +1,133 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestValidateTable(t *testing.T) {
+	defer func(n int64) { TableBackfillChunkSize = n }(TableBackfillChunkSize)
+	TableBackfillChunkSize = 2
+
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddColumn("users", proto.Column{Name: "email", Type: proto.Column_STRING}, nil); err != nil {
+		t.Fatal(err)
+	}
+	for _, index := range []proto.TableSchema_IndexByName{
+		{Index: proto.Index{Name: "by_email", Unique: true}, ColumnNames: []string{"email"}},
+		{Index: proto.Index{Name: "by_lower_name"}, KeyExprs: []string{"lower(name)"}},
+	} {
+		if err := db.CreateIndex("users", index); err != nil {
+			t.Fatal(err)
+		}
+	}
+	putTestRows(t, db, "users",
+		row{"id": 1, "name": "Alice", "email": "alice@example.com"},
+		row{"id": 2, "name": "Bob", "email": "bob@example.com"},
+		row{"id": 3})
+
+	report, err := db.ValidateTable("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !report.OK() || report.Rows != 3 || report.IndexEntries != 6 {
+		t.Errorf("unexpected report: %+v", report)
+	}
+
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	indexes := map[string]proto.IndexDescriptor{}
+	for _, index := range desc.Indexes {
+		indexes[index.Name] = index
+	}
+	entryKey := func(index string, id int, values row) proto.Key {
+		values["id"] = int64(id)
+		rowKey, err := makeRowKey(&desc, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry, _, err := makeIndexEntry(&desc, indexes[index], rowKey, values)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return entry.key
+	}
+	rowKey := func(id int) proto.Key {
+		key, err := makeRowKey(&desc, row{"id": int64(id)})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return key
+	}
+
+	// Remove an entry, add an entry for a row which does not exist and
+	// write a row which duplicates the unique email of another.
+	missing := entryKey("by_name", 1, row{"name": "Alice"})
+	if err := db.Del(missing); err != nil {
+		t.Fatal(err)
+	}
+	dangling := entryKey("by_lower_name", 9, row{"name": "zed"})
+	if err := db.Put(dangling, []byte{}); err != nil {
+		t.Fatal(err)
+	}
+	// Write a row sharing the entry of by_email with another row, bypassing
+	// the verification of the uniqueness of the entry.
+	bypass := desc
+	bypass.Indexes = nil
+	for _, index := range desc.Indexes {
+		if index.Name != "by_email" {
+			bypass.Indexes = append(bypass.Indexes, index)
+		}
+	}
+	b := &Batch{}
+	if err := putRow(b, &bypass, row{"id": 4, "name": "Carl", "email": "bob@example.com"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+
+	if report, err = db.ValidateTable("users"); err != nil {
+		t.Fatal(err)
+	}
+	expected := []IndexViolation{
+		{Kind: MissingIndexEntry, Index: "by_name", Key: missing, RowKey: rowKey(1)},
+		{Kind: UniqueViolation, Index: "by_email", Key: entryKey("by_email", 2, row{"email": "bob@example.com"}),
+			RowKey: rowKey(4), OtherRowKey: rowKey(2)},
+		{Kind: DanglingIndexEntry, Index: "by_lower_name", Key: dangling},
+	}
+	if report.OK() || report.Rows != 4 || !reflect.DeepEqual(expected, report.Violations) {
+		t.Errorf("expected %s, but found %+v", expected, report)
+	}
+	if s := fmt.Sprint(expected[1]); s != fmt.Sprintf(`index "by_email": unique violation: rows %q and %q have entry %q`,
+		rowKey(4), rowKey(2), expected[1].Key) {
+		t.Errorf("unexpected string: %s", s)
+	}
+
+	if _, err := db.ValidateTable("missing"); err == nil || err.Error() != `table "missing" does not exist` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
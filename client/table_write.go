@@ -0,0 +1,313 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// InsertTableRows inserts rows into the named table in a single
+// transaction. Each row maps column names to values, which are converted
+// as by ScanFilterOpt; missing columns are NULL. It fails with a
+// *UniqueViolationError if a row with the same primary key exists, or
+// if the rows violate a unique index.
+func (db *DB) InsertTableRows(name string, rows ...map[string]interface{}) error {
+	return db.Txn(func(txn *Txn) error {
+		desc, err := getTableDescByName(txn, name)
+		if err != nil {
+			return err
+		}
+		values := make([]row, len(rows))
+		for i := range rows {
+			values[i] = row(rows[i])
+		}
+		return insertRows(txn, &desc, values)
+	})
+}
+
+// UpdateTableRows sets the columns of the rows of the named table which
+// satisfy the filters of the options to the given values, of which nil
+// values set columns to NULL, returning the number of updated rows.
+// Primary key columns cannot be updated. The rows are read and written in
+// a single transaction.
+func (db *DB) UpdateTableRows(name string, values map[string]interface{}, opts ...ScanOption) (int64, error) {
+	var o scanOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var n int64
+	err := db.Txn(func(txn *Txn) error {
+		if err := o.writer(txn); err != nil {
+			return err
+		}
+		desc, err := getTableDescByName(txn, name)
+		if err != nil {
+			return err
+		}
+		n, err = updateRows(txn, &desc, row(values), o)
+		return err
+	})
+	return n, err
+}
+
+// DeleteTableRows deletes the rows of the named table which satisfy the
+// filters of the options, returning the number of deleted rows. The rows
+// are read and deleted in a single transaction.
+func (db *DB) DeleteTableRows(name string, opts ...ScanOption) (int64, error) {
+	var o scanOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	var n int64
+	err := db.Txn(func(txn *Txn) error {
+		if err := o.writer(txn); err != nil {
+			return err
+		}
+		desc, err := getTableDescByName(txn, name)
+		if err != nil {
+			return err
+		}
+		n, err = deleteRows(txn, &desc, o)
+		return err
+	})
+	return n, err
+}
+
+// insertRows writes the rows of the described table in the transaction,
+// after verifying that none of them exist.
+func insertRows(txn *Txn, desc *proto.TableDescriptor, rows []row) error {
+	seen := map[string]bool{}
+	lookups := &Batch{}
+	replies := make([]*proto.GetRowResponse, len(rows))
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	for i, values := range rows {
+		values, err := convertRow(desc, values)
+		if err != nil {
+			return err
+		}
+		rowKey, err := makeRowKey(desc, values)
+		if err != nil {
+			return err
+		}
+		if seen[string(rowKey)] {
+			return &UniqueViolationError{Table: desc.Name, Index: desc.PrimaryIndex.Name}
+		}
+		seen[string(rowKey)] = true
+		replies[i] = &proto.GetRowResponse{}
+		lookups.InternalAddCall(Call{
+			Args: &proto.GetRowRequest{
+				RequestHeader: proto.RequestHeader{Key: rowKey},
+				TableId:       desc.Id,
+				IndexId:       desc.PrimaryIndex.Id,
+				PrimaryKey:    []byte(rowKey[len(prefix):]),
+				TTLSeconds:    desc.TTLSeconds,
+			},
+			Reply: replies[i],
+		})
+	}
+	if err := txn.Run(lookups); err != nil {
+		return err
+	}
+	for _, reply := range replies {
+		if reply.Row != nil {
+			return &UniqueViolationError{Table: desc.Name, Index: desc.PrimaryIndex.Name}
+		}
+	}
+
+	b := &Batch{}
+	for _, values := range rows {
+		if err := putRow(b, desc, values); err != nil {
+			return err
+		}
+	}
+	if err := txn.Run(b); err != nil {
+		return checkUniqueViolation(desc, b, err)
+	}
+	return nil
+}
+
+// replaceRows writes the rows of the described table, whose sentinel keys
+// are given, in the transaction and commits it. The cells and index
+// entries of existing rows with the same primary keys are deleted first.
+// The rows must have distinct primary keys.
+func replaceRows(txn *Txn, desc *proto.TableDescriptor, rows []row, rowKeys []proto.Key) error {
+	lookups := &Batch{}
+	replies := make([]*proto.GetRowResponse, len(rows))
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	for i, rowKey := range rowKeys {
+		replies[i] = &proto.GetRowResponse{}
+		lookups.InternalAddCall(Call{
+			Args: &proto.GetRowRequest{
+				RequestHeader: proto.RequestHeader{Key: rowKey},
+				TableId:       desc.Id,
+				IndexId:       desc.PrimaryIndex.Id,
+				PrimaryKey:    []byte(rowKey[len(prefix):]),
+				TTLSeconds:    desc.TTLSeconds,
+			},
+			Reply: replies[i],
+		})
+	}
+	if err := txn.Run(lookups); err != nil {
+		return err
+	}
+
+	b := &Batch{}
+	for i, values := range rows {
+		if reply := replies[i]; reply.Row != nil {
+			old, err := decodeRow(desc, prefix, reply.Row)
+			if err != nil {
+				return err
+			}
+			entries, _, err := makeIndexEntries(desc, rowKeys[i], old)
+			if err != nil {
+				return err
+			}
+			for _, entry := range entries {
+				b.Del(entry.key)
+			}
+			b.InternalAddCall(Call{
+				Args: &proto.DeleteRowRequest{
+					RequestHeader: proto.RequestHeader{Key: rowKeys[i]},
+					TableId:       desc.Id,
+					IndexId:       desc.PrimaryIndex.Id,
+					PrimaryKey:    []byte(rowKeys[i][len(prefix):]),
+				},
+				Reply: &proto.DeleteRowResponse{},
+			})
+		}
+		if err := putRow(b, desc, values); err != nil {
+			return err
+		}
+	}
+	if err := txn.Commit(b); err != nil {
+		return checkUniqueViolation(desc, b, err)
+	}
+	return nil
+}
+
+// updateRows sets the columns of the rows of the described table selected
+// by the options to the given values in the transaction.
+func updateRows(txn *Txn, desc *proto.TableDescriptor, updates row, o scanOptions) (int64, error) {
+	converted := make(row, len(updates))
+	for name, v := range updates {
+		column, ok := findColumn(desc, name)
+		if !ok {
+			return 0, &UnknownColumnError{Table: desc.Name, Column: name}
+		}
+		if isPrimaryKeyColumn(desc, column.Id) {
+			return 0, fmt.Errorf("cannot update primary key column %q", name)
+		}
+		var err error
+		if converted[name], err = convertValue(column, v); err != nil {
+			return 0, err
+		}
+	}
+	o.columns = nil
+	rows, err := scanTable(txn.Run, desc, o)
+	if err != nil {
+		return 0, err
+	}
+
+	b := &Batch{}
+	for _, values := range rows {
+		rowKey, err := makeRowKey(desc, row(values))
+		if err != nil {
+			return 0, err
+		}
+		updated := make(row, len(values)+len(converted))
+		for name, v := range values {
+			updated[name] = v
+		}
+		for name, v := range converted {
+			updated[name] = v
+		}
+		// The index entries of the previous values of the row which differ
+		// from those of its new values are deleted.
+		for _, index := range desc.Indexes {
+			old, ok, err := makeIndexEntry(desc, index, rowKey, row(values))
+			if err != nil {
+				return 0, err
+			}
+			if !ok {
+				continue
+			}
+			entry, ok, err := makeIndexEntry(desc, index, rowKey, updated)
+			if err != nil {
+				return 0, err
+			}
+			if !ok || !entry.key.Equal(old.key) {
+				b.Del(old.key)
+			}
+		}
+		if err := putRow(b, desc, updated); err != nil {
+			return 0, err
+		}
+		// putRow does not write NULL values, so the cells of the columns
+		// set to NULL are deleted.
+		for name, v := range converted {
+			if column, _ := findColumn(desc, name); v == nil {
+				b.Del(makeCellKey(rowKey, column.Id))
+			}
+		}
+	}
+	if err := txn.Run(b); err != nil {
+		return 0, checkUniqueViolation(desc, b, err)
+	}
+	return int64(len(rows)), nil
+}
+
+// deleteRows deletes the rows of the described table selected by the
+// options, along with their index entries, in the transaction.
+func deleteRows(txn *Txn, desc *proto.TableDescriptor, o scanOptions) (int64, error) {
+	o.columns = nil
+	rows, err := scanTable(txn.Run, desc, o)
+	if err != nil {
+		return 0, err
+	}
+
+	b := &Batch{}
+	prefix := makeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+	for _, values := range rows {
+		rowKey, err := makeRowKey(desc, row(values))
+		if err != nil {
+			return 0, err
+		}
+		for _, index := range desc.Indexes {
+			entry, ok, err := makeIndexEntry(desc, index, rowKey, row(values))
+			if err != nil {
+				return 0, err
+			}
+			if ok {
+				b.Del(entry.key)
+			}
+		}
+		b.InternalAddCall(Call{
+			Args: &proto.DeleteRowRequest{
+				RequestHeader: proto.RequestHeader{Key: rowKey},
+				TableId:       desc.Id,
+				IndexId:       desc.PrimaryIndex.Id,
+				PrimaryKey:    []byte(rowKey[len(prefix):]),
+			},
+			Reply: &proto.DeleteRowResponse{},
+		})
+	}
+	if err := txn.Run(b); err != nil {
+		return 0, err
+	}
+	return int64(len(rows)), nil
+}
@@ -0,0 +1,95 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestInsertTableRows(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.InsertTableRows("users",
+		map[string]interface{}{"id": 1, "name": "a"},
+		map[string]interface{}{"id": 2}); err != nil {
+		t.Fatal(err)
+	}
+	testCases := [][]map[string]interface{}{
+		{{"id": 3}, {"id": 1, "name": "b"}},
+		{{"id": 3}, {"id": 3}},
+		{{"id": "x"}},
+		{{"name": "c"}},
+	}
+	for i, rows := range testCases {
+		if err := db.InsertTableRows("users", rows...); err == nil {
+			t.Errorf("%d: expected inserting %v to fail", i, rows)
+		}
+	}
+	expected := []row{{"id": int64(1), "name": "a"}, {"id": int64(2)}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	if keys := scanIndex(t, db, "users", "by_name"); len(keys) != 1 {
+		t.Errorf("expected 1 index entry, but found %q", keys)
+	}
+}
+
+func TestUpdateAndDeleteTableRows(t *testing.T) {
+	db, _ := newMemDB()
+	if err := db.CreateTable(testSchema("users")); err != nil {
+		t.Fatal(err)
+	}
+	putTestRows(t, db, "users", row{"id": 1, "name": "a"}, row{"id": 2, "name": "b"}, row{"id": 3})
+
+	if n, err := db.UpdateTableRows("users", map[string]interface{}{"name": "c"},
+		ScanFilterOpt("id", ">=", 2)); err != nil {
+		t.Fatal(err)
+	} else if n != 2 {
+		t.Errorf("expected 2 updated rows, but found %d", n)
+	}
+	if _, err := db.UpdateTableRows("users", map[string]interface{}{"name": nil},
+		ScanFilterOpt("id", "=", 1)); err != nil {
+		t.Fatal(err)
+	}
+	for i, values := range []map[string]interface{}{{"id": 4}, {"missing": 1}, {"name": 1}} {
+		if _, err := db.UpdateTableRows("users", values); err == nil {
+			t.Errorf("%d: expected setting %v to fail", i, values)
+		}
+	}
+	expected := []row{{"id": int64(1)}, {"id": int64(2), "name": "c"}, {"id": int64(3), "name": "c"}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	if keys := scanIndex(t, db, "users", "by_name"); len(keys) != 2 {
+		t.Errorf("expected 2 index entries, but found %q", keys)
+	}
+
+	if n, err := db.DeleteTableRows("users", ScanFilterOpt("name", "=", "c"), ScanLimitOpt(1)); err != nil {
+		t.Fatal(err)
+	} else if n != 1 {
+		t.Errorf("expected 1 deleted row, but found %d", n)
+	}
+	expected = []row{{"id": int64(1)}, {"id": int64(3), "name": "c"}}
+	if rows := scanTestRows(t, db, "users"); !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %v, but found %v", expected, rows)
+	}
+	if keys := scanIndex(t, db, "users", "by_name"); len(keys) != 1 {
+		t.Errorf("expected 1 index entry, but found %q", keys)
+	}
+}
@@ -0,0 +1,342 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package testutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	yaml "gopkg.in/yaml.v1"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// Fixtures hold the rows of tables, keyed by table name. Each row maps
+// column names to values; a missing column is NULL. Fixture files are
+// written in JSON or YAML:
+//
+//   users:
+//   - {id: 1, name: alice, avatar: aGVsbG8=, prefs: {theme: dark}}
+//   - {id: 2, name: bob}
+//
+// Values are given as the natural JSON or YAML type of their column, with
+// the exception of BYTES, which are base64 encoded strings. The value of
+// a JSON column may be any JSON document.
+type Fixtures map[string][]map[string]interface{}
+
+// ReadFixtureFile reads fixtures from a file. The format of the file is
+// determined by its extension: ".json" or ".yaml" (".yml").
+func ReadFixtureFile(path string) (Fixtures, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f Fixtures
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		// Decode numbers as json.Number so that large integers are not
+		// rounded to float64.
+		d := json.NewDecoder(bytes.NewReader(data))
+		d.UseNumber()
+		err = d.Decode(&f)
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(data, &f)
+	default:
+		err = fmt.Errorf("unsupported fixture file extension %q", ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", path, err)
+	}
+	return f, nil
+}
+
+// WriteFile writes the fixtures to a file, in the format determined by
+// its extension as for ReadFixtureFile.
+func (f Fixtures) WriteFile(path string) error {
+	var data []byte
+	var err error
+	switch ext := filepath.Ext(path); ext {
+	case ".json":
+		if data, err = json.MarshalIndent(f, "", "  "); err == nil {
+			data = append(data, '\n')
+		}
+	case ".yaml", ".yml":
+		data, err = yaml.Marshal(f)
+	default:
+		err = fmt.Errorf("unsupported fixture file extension %q", ext)
+	}
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, data, 0644)
+}
+
+// LoadFixtureFile reads the fixtures from a file and writes their rows.
+// See ReadFixtureFile and LoadFixtures.
+func LoadFixtureFile(db *client.DB, path string) error {
+	f, err := ReadFixtureFile(path)
+	if err != nil {
+		return err
+	}
+	return LoadFixtures(db, f)
+}
+
+// LoadFixtures writes the rows of the fixtures to their tables, which must
+// exist. Existing rows with the same primary key are overwritten. The
+// tables are loaded in the order of their names, each one with ImportCSV:
+// the rows of a table are not written atomically.
+func LoadFixtures(db *client.DB, f Fixtures) error {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := loadTable(db, name, f[name]); err != nil {
+			return fmt.Errorf("table %q: %s", name, err)
+		}
+	}
+	return nil
+}
+
+func loadTable(db *client.DB, name string, rows []map[string]interface{}) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	schema, err := db.DescribeTable(name)
+	if err != nil {
+		return err
+	}
+	types := map[string]proto.Column_ColumnType{}
+	for _, column := range schema.Columns {
+		types[column.Name] = column.Type
+	}
+	var cols []string
+	seen := map[string]bool{}
+	for _, r := range rows {
+		for name := range r {
+			if !seen[name] {
+				seen[name] = true
+				cols = append(cols, name)
+			}
+		}
+	}
+	sort.Strings(cols)
+
+	// Every non-NULL field is quoted, so that empty strings are not read
+	// as NULL.
+	var buf bytes.Buffer
+	buf.WriteString(strings.Join(cols, ","))
+	buf.WriteByte('\n')
+	for i, r := range rows {
+		for j, name := range cols {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			v, ok := r[name]
+			if !ok || v == nil {
+				continue
+			}
+			s, err := formatFixtureValue(types[name], v)
+			if err != nil {
+				return fmt.Errorf("row %d: column %q: %s", i, name, err)
+			}
+			buf.WriteString(`"` + strings.Replace(s, `"`, `""`, -1) + `"`)
+		}
+		buf.WriteByte('\n')
+	}
+	_, err = db.ImportCSV(name, &buf)
+	return err
+}
+
+// formatFixtureValue formats a fixture value in the CSV format of
+// client.ImportCSV.
+func formatFixtureValue(typ proto.Column_ColumnType, v interface{}) (string, error) {
+	switch typ {
+	case proto.Column_JSON:
+		b, err := json.Marshal(jsonValue(v))
+		return string(b), err
+	case proto.Column_BYTES, proto.Column_STRING:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+		return "", fmt.Errorf("expected a string, but found %T", v)
+	}
+	return fmt.Sprint(v), nil
+}
+
+// jsonValue converts the maps decoded from YAML, which have interface{}
+// keys, into maps which can be marshaled to JSON.
+func jsonValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			m[fmt.Sprint(k)] = jsonValue(v)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, v := range t {
+			m[k] = jsonValue(v)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(t))
+		for i, v := range t {
+			s[i] = jsonValue(v)
+		}
+		return s
+	}
+	return v
+}
+
+// DumpFixtures returns the rows of the named tables, in primary key order,
+// as fixtures which LoadFixtures restores. NULL values are omitted from
+// the rows.
+func DumpFixtures(db *client.DB, tables ...string) (Fixtures, error) {
+	f := Fixtures{}
+	for _, name := range tables {
+		rows, err := dumpTable(db, name)
+		if err != nil {
+			return nil, fmt.Errorf("table %q: %s", name, err)
+		}
+		f[name] = rows
+	}
+	return f, nil
+}
+
+func dumpTable(db *client.DB, name string) ([]map[string]interface{}, error) {
+	schema, err := db.DescribeTable(name)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(schema.Columns))
+	for i, column := range schema.Columns {
+		names[i] = column.Name
+	}
+	var buf bytes.Buffer
+	if err := db.ExportCSV(name, &buf, names...); err != nil {
+		return nil, err
+	}
+	records, err := parseCSV(buf.String())
+	if err != nil {
+		return nil, err
+	}
+	rows := []map[string]interface{}{}
+	// The first record is the header.
+	for _, record := range records[1:] {
+		r := map[string]interface{}{}
+		for i, field := range record {
+			if field == nil {
+				continue
+			}
+			v, err := parseFixtureValue(schema.Columns[i].Type, *field)
+			if err != nil {
+				return nil, fmt.Errorf("column %q: %s", names[i], err)
+			}
+			r[names[i]] = v
+		}
+		rows = append(rows, r)
+	}
+	return rows, nil
+}
+
+// parseFixtureValue parses a value written by client.ExportCSV.
+func parseFixtureValue(typ proto.Column_ColumnType, s string) (interface{}, error) {
+	switch typ {
+	case proto.Column_INT:
+		return strconv.ParseInt(s, 10, 64)
+	case proto.Column_FLOAT:
+		return strconv.ParseFloat(s, 64)
+	case proto.Column_BOOL:
+		return strconv.ParseBool(s)
+	case proto.Column_JSON:
+		var v interface{}
+		err := json.Unmarshal([]byte(s), &v)
+		return v, err
+	}
+	// Strings are returned verbatim and bytes remain base64 encoded.
+	return s, nil
+}
+
+// parseCSV parses the output of client.ExportCSV, returning nil for NULL
+// fields, which are empty and unquoted.
+func parseCSV(s string) ([][]*string, error) {
+	var records [][]*string
+	var record []*string
+	for len(s) > 0 {
+		var field *string
+		if s[0] == '"' {
+			var buf bytes.Buffer
+			i := 1
+			for {
+				j := strings.IndexByte(s[i:], '"')
+				if j < 0 {
+					return nil, fmt.Errorf("unterminated quoted field")
+				}
+				buf.WriteString(s[i : i+j])
+				i += j + 1
+				if i < len(s) && s[i] == '"' {
+					buf.WriteByte('"')
+					i++
+					continue
+				}
+				break
+			}
+			str := buf.String()
+			field, s = &str, s[i:]
+		} else {
+			j := strings.IndexAny(s, ",\n")
+			if j < 0 {
+				j = len(s)
+			}
+			if j > 0 {
+				str := s[:j]
+				field = &str
+			}
+			s = s[j:]
+		}
+		record = append(record, field)
+		switch {
+		case len(s) == 0:
+			records = append(records, record)
+			record = nil
+		case s[0] == '\n':
+			records = append(records, record)
+			record, s = nil, s[1:]
+		case s[0] == ',':
+			s = s[1:]
+			if len(s) == 0 {
+				records = append(records, append(record, nil))
+				record = nil
+			}
+		default:
+			return nil, fmt.Errorf("unexpected %q after quoted field", s[0])
+		}
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("missing CSV header")
+	}
+	return records, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package testutils
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+const testFixturesYAML = `
+users:
+- {id: 2, name: bob}
+- id: 1
+  name: ""
+  score: 1.5
+  active: true
+  avatar: AP8=
+  prefs: {theme: dark, tags: [a, b]}
+`
+
+func TestFixtures(t *testing.T) {
+	dir, err := ioutil.TempDir("", "fixtures")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	yamlPath := filepath.Join(dir, "users.yaml")
+	if err := ioutil.WriteFile(yamlPath, []byte(testFixturesYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	db, _ := NewMemDB()
+	schema := proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+			{Name: "score", Type: proto.Column_FLOAT},
+			{Name: "active", Type: proto.Column_BOOL},
+			{Name: "avatar", Type: proto.Column_BYTES},
+			{Name: "prefs", Type: proto.Column_JSON},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadFixtureFile(db, yamlPath); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := DumpFixtures(db, "users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := Fixtures{"users": {
+		{"id": int64(1), "name": "", "score": 1.5, "active": true, "avatar": "AP8=",
+			"prefs": map[string]interface{}{"theme": "dark", "tags": []interface{}{"a", "b"}}},
+		{"id": int64(2), "name": "bob"},
+	}}
+	if !reflect.DeepEqual(expected, f) {
+		t.Errorf("expected %+v, but found %+v", expected, f)
+	}
+
+	// A JSON dump loads back into an empty table unchanged.
+	jsonPath := filepath.Join(dir, "users.json")
+	if err := f.WriteFile(jsonPath); err != nil {
+		t.Fatal(err)
+	}
+	db, _ = NewMemDB()
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	if err := LoadFixtureFile(db, jsonPath); err != nil {
+		t.Fatal(err)
+	}
+	if f, err = DumpFixtures(db, "users"); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(expected, f) {
+		t.Errorf("expected %+v, but found %+v", expected, f)
+	}
+
+	if err := LoadFixtures(db, Fixtures{"users": {{"id": 3, "name": 4}}}); err == nil ||
+		err.Error() != `table "users": row 0: column "name": expected a string, but found int` {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := ReadFixtureFile(filepath.Join(dir, "users.txt")); err == nil {
+		t.Errorf("expected an error reading a missing file")
+	}
+}
@@ -0,0 +1,407 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+// Package testutils provides an in-memory implementation of the client
+// transport so that code using client.DB can be unit tested without
+// starting a server.
+package testutils
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A MemSender is a client.Sender which applies calls to an in-memory map.
+// It supports the key/value operations used by client.DB, client.Batch
+// and client.Txn, and therefore the table API built on them. Writes are
+// applied immediately: transactions are not isolated and are never
+// restarted, and aborting a transaction does not undo its writes.
+type MemSender struct {
+	mu     sync.Mutex
+	data   map[string]proto.Value
+	log    []proto.KeyValueChange // Every version written, for ScanChanges
+	errFn  func(proto.Request) error
+	clock  int64
+	txnSeq int
+}
+
+// NewMemSender returns an empty MemSender.
+func NewMemSender() *MemSender {
+	return &MemSender{data: map[string]proto.Value{}}
+}
+
+// NewMemDB returns a client.DB backed by a new MemSender.
+func NewMemDB(opts ...client.Option) (*client.DB, *MemSender) {
+	s := NewMemSender()
+	db, err := client.Open("//root@mem", append([]client.Option{client.SenderOpt(s)}, opts...)...)
+	if err != nil {
+		// Open only fails for malformed addresses.
+		panic(err)
+	}
+	return db, s
+}
+
+// AdvanceClock advances the clock with which requests are timestamped,
+// such as to expire rows whose tables have a time to live. The clock
+// otherwise advances by a nanosecond per request.
+func (s *MemSender) AdvanceClock(d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clock += d.Nanoseconds()
+}
+
+// SetErrorHook sets a function invoked with every request, including the
+// requests of batches, before it is applied. If the function returns an
+// error, the request is not applied and fails with the error. A nil
+// function removes the hook.
+func (s *MemSender) SetErrorHook(fn func(proto.Request) error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errFn = fn
+}
+
+// FailNth returns an error hook which fails the nth request (counting from
+// 1) with the given method with err. Other requests succeed.
+func FailNth(method proto.Method, n int, err error) func(proto.Request) error {
+	var count int
+	return func(args proto.Request) error {
+		if args.Method() != method {
+			return nil
+		}
+		if count++; count == n {
+			return err
+		}
+		return nil
+	}
+}
+
+// Keys returns the keys stored in the sender, in sorted order.
+func (s *MemSender) Keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.sortedKeys(proto.KeyMin, proto.KeyMax)
+}
+
+// Send implements the client.Sender interface.
+func (s *MemSender) Send(_ context.Context, call client.Call) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.send(call.Args, call.Reply)
+}
+
+func (s *MemSender) sortedKeys(start, end proto.Key) []string {
+	var keys []string
+	for k := range s.data {
+		if k >= string(start) && k < string(end) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *MemSender) send(args proto.Request, reply proto.Response) {
+	reply.Reset()
+	header := args.Header()
+	if header.Txn != nil {
+		txn := gogoproto.Clone(header.Txn).(*proto.Transaction)
+		if len(txn.ID) == 0 {
+			s.txnSeq++
+			txn.ID = []byte(fmt.Sprintf("mem-txn-%d", s.txnSeq))
+		}
+		reply.Header().Txn = txn
+	}
+	if s.errFn != nil {
+		if _, ok := args.(*proto.BatchRequest); !ok {
+			if err := s.errFn(args); err != nil {
+				reply.Header().SetGoError(err)
+				return
+			}
+		}
+	}
+	// Every request is applied at a new timestamp.
+	s.clock++
+	now := proto.Timestamp{WallTime: s.clock}
+	reply.Header().Timestamp = now
+
+	switch t := args.(type) {
+	case *proto.BatchRequest:
+		br := reply.(*proto.BatchResponse)
+		for _, union := range t.Requests {
+			req := union.GetValue().(proto.Request)
+			resp := req.CreateReply()
+			s.send(req, resp)
+			br.Add(resp)
+			if err := resp.Header().GoError(); err != nil {
+				// Like the transaction coordinator, stop at the first error.
+				br.Header().SetGoError(err)
+				return
+			}
+		}
+	case *proto.GetRequest:
+		if v, ok := s.data[string(t.Key)]; ok {
+			reply.(*proto.GetResponse).Value = gogoproto.Clone(&v).(*proto.Value)
+		}
+	case *proto.PutRequest:
+		s.put(t.Key, t.Value, now)
+	case *proto.ConditionalPutRequest:
+		v, ok := s.data[string(t.Key)]
+		if (t.ExpValue == nil && ok) || (t.ExpValue != nil &&
+			(!ok || !bytes.Equal(t.ExpValue.Bytes, v.Bytes) || !equalInt(t.ExpValue.Integer, v.Integer))) {
+			var actual *proto.Value
+			if ok {
+				actual = &v
+			}
+			reply.Header().SetGoError(&proto.ConditionFailedError{ActualValue: actual})
+			return
+		}
+		s.put(t.Key, t.Value, now)
+	case *proto.PutUniqueRequest:
+		if v, ok := s.data[string(t.Key)]; ok && !bytes.Equal(t.Value.Bytes, v.Bytes) {
+			reply.Header().SetGoError(&proto.ConditionFailedError{ActualValue: &v})
+			return
+		}
+		s.put(t.Key, t.Value, now)
+	case *proto.IncrementRequest:
+		v, ok := s.data[string(t.Key)]
+		if ok && v.Integer == nil {
+			reply.Header().SetGoError(fmt.Errorf("key %q does not contain an integer value", t.Key))
+			return
+		}
+		var n int64
+		if ok {
+			n = *v.Integer
+		}
+		n += t.Increment
+		s.put(t.Key, proto.Value{Integer: &n}, now)
+		reply.(*proto.IncrementResponse).NewValue = n
+	case *proto.ScanRequest:
+		resp := reply.(*proto.ScanResponse)
+		keys := s.sortedKeys(t.Key, t.EndKey)
+		if t.Reverse {
+			sort.Sort(sort.Reverse(sort.StringSlice(keys)))
+		}
+		for _, k := range keys {
+			if t.MaxResults > 0 && int64(len(resp.Rows)) >= t.MaxResults {
+				break
+			}
+			v := s.data[k]
+			if t.KeysOnly {
+				v = proto.Value{Timestamp: v.Timestamp}
+			}
+			resp.Rows = append(resp.Rows, proto.KeyValue{Key: proto.Key(k), Value: v})
+		}
+	case *proto.DeleteRequest:
+		s.del(t.Key, now)
+	case *proto.DeleteRangeRequest:
+		resp := reply.(*proto.DeleteRangeResponse)
+		for _, k := range s.sortedKeys(t.Key, t.EndKey) {
+			if t.MaxEntriesToDelete > 0 && resp.NumDeleted >= t.MaxEntriesToDelete {
+				break
+			}
+			s.del(proto.Key(k), now)
+			resp.NumDeleted++
+		}
+	case *proto.ScanRowsRequest:
+		resp := reply.(*proto.ScanRowsResponse)
+		prefix := keys.MakeIndexPrefix(t.TableId, t.IndexId)
+		for _, a := range t.Aggregates {
+			resp.Aggregates = append(resp.Aggregates, proto.RowAggregateResult{Aggregate: a})
+		}
+		var row *proto.Row
+		var rowKey proto.Key
+		finishRow := func() {
+			if row == nil {
+				return
+			}
+			if ok, _ := proto.MatchRow(row, t.Filters); ok && len(t.Aggregates) == 0 {
+				resp.Rows = append(resp.Rows, projectRow(*row, t.ColumnIds))
+			} else if ok {
+				for i := range resp.Aggregates {
+					if err := resp.Aggregates[i].Add(row); err != nil {
+						resp.SetGoError(err)
+					}
+				}
+			}
+			row = nil
+		}
+		for _, k := range s.sortedKeys(t.Key, t.EndKey) {
+			if id, ok := keys.DecodeCellKey(rowKey, proto.Key(k)); rowKey != nil && ok {
+				if row != nil {
+					row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: s.data[k].Bytes})
+				}
+				continue
+			}
+			finishRow()
+			if t.MaxRows > 0 && int64(len(resp.Rows)) >= t.MaxRows {
+				break
+			}
+			rowKey = proto.Key(k)
+			if v := s.data[k]; !proto.RowExpired(&v, t.TTLSeconds, now) {
+				row = &proto.Row{PrimaryKey: []byte(k[len(prefix):])}
+			}
+		}
+		finishRow()
+	case *proto.GetRowRequest:
+		if v, ok := s.data[string(t.Key)]; !ok || proto.RowExpired(&v, t.TTLSeconds, now) {
+			break
+		}
+		prefix := keys.MakeIndexPrefix(t.TableId, t.IndexId)
+		row := proto.Row{PrimaryKey: []byte(t.Key[len(prefix):])}
+		start, end := proto.KeySpan(t)
+		for _, k := range s.sortedKeys(start, end) {
+			if id, ok := keys.DecodeCellKey(t.Key, proto.Key(k)); ok {
+				row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: s.data[k].Bytes})
+			}
+		}
+		row = projectRow(row, t.ColumnIds)
+		reply.(*proto.GetRowResponse).Row = &row
+	case *proto.LockRowRequest:
+		// There are no concurrent transactions to lock the row against.
+		getReply := &proto.GetRowResponse{}
+		s.send(&proto.GetRowRequest{
+			RequestHeader: t.RequestHeader,
+			TableId:       t.TableId,
+			IndexId:       t.IndexId,
+			PrimaryKey:    t.PrimaryKey,
+			ColumnIds:     t.ColumnIds,
+			TTLSeconds:    t.TTLSeconds,
+		}, getReply)
+		reply.(*proto.LockRowResponse).Row = getReply.Row
+	case *proto.PutRowRequest:
+		if v, ok := s.data[string(t.Key)]; ok && proto.RowExpired(&v, t.TTLSeconds, now) {
+			start, end := proto.KeySpan(t)
+			for _, k := range s.sortedKeys(start, end) {
+				s.del(proto.Key(k), now)
+			}
+		}
+		s.put(t.Key, proto.Value{Bytes: []byte{}}, now)
+		for _, cell := range t.Cells {
+			key := keys.MakeCellKey(t.Key, cell.ColumnId)
+			if cell.Value == nil {
+				s.del(key, now)
+			} else {
+				s.put(key, proto.Value{Bytes: cell.Value}, now)
+			}
+		}
+	case *proto.DeleteRowRequest:
+		if v, ok := s.data[string(t.Key)]; t.TTLSeconds > 0 && (!ok || !proto.RowExpired(&v, t.TTLSeconds, now)) {
+			break
+		}
+		start, end := proto.KeySpan(t)
+		for _, k := range s.sortedKeys(start, end) {
+			s.del(proto.Key(k), now)
+			reply.(*proto.DeleteRowResponse).Deleted = true
+		}
+	case *proto.ScanChangesRequest:
+		end := t.Timestamp
+		if end.Equal(proto.ZeroTimestamp) {
+			end = now
+		}
+		reply.Header().Timestamp = end
+		var changes []proto.KeyValueChange
+		for _, c := range s.log {
+			ts := *c.Value.Timestamp
+			if !c.Key.Less(t.Key) && c.Key.Less(t.EndKey) && t.StartTimestamp.Less(ts) && !end.Less(ts) {
+				changes = append(changes, c)
+			}
+		}
+		sort.Sort(changesByKey(changes))
+		resp := reply.(*proto.ScanChangesResponse)
+		for i, c := range changes {
+			// All of the versions of the last key are returned.
+			if t.MaxResults > 0 && int64(i) >= t.MaxResults && !c.Key.Equal(changes[i-1].Key) {
+				break
+			}
+			resp.Changes = append(resp.Changes, c)
+		}
+	case *proto.AdminSplitRequest, *proto.AdminMergeRequest, *proto.EndTransactionRequest:
+		// There are no ranges or transaction records.
+	default:
+		reply.Header().SetGoError(fmt.Errorf("unsupported request: %s", args.Method()))
+	}
+}
+
+func (s *MemSender) put(key proto.Key, value proto.Value, now proto.Timestamp) {
+	value.Timestamp = &now
+	s.data[string(key)] = value
+	s.record(proto.KeyValueChange{Key: key, Value: value})
+}
+
+func (s *MemSender) del(key proto.Key, now proto.Timestamp) {
+	delete(s.data, string(key))
+	s.record(proto.KeyValueChange{Key: key, Value: proto.Value{Timestamp: &now}, Deleted: true})
+}
+
+// record appends a version to the log, replacing the version of the key
+// written earlier by the same request, if any.
+func (s *MemSender) record(c proto.KeyValueChange) {
+	for i := len(s.log) - 1; i >= 0 && s.log[i].Value.Timestamp.Equal(*c.Value.Timestamp); i-- {
+		if s.log[i].Key.Equal(c.Key) {
+			s.log = append(s.log[:i], s.log[i+1:]...)
+			break
+		}
+	}
+	s.log = append(s.log, c)
+}
+
+// changesByKey sorts versions like a ScanChanges response: by key and
+// then by descending timestamp.
+type changesByKey []proto.KeyValueChange
+
+func (c changesByKey) Len() int      { return len(c) }
+func (c changesByKey) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+func (c changesByKey) Less(i, j int) bool {
+	if !c[i].Key.Equal(c[j].Key) {
+		return c[i].Key.Less(c[j].Key)
+	}
+	return c[j].Value.Timestamp.Less(*c[i].Value.Timestamp)
+}
+
+func equalInt(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// projectRow drops the cells of the row whose columns are not listed in
+// columnIDs, unless columnIDs is empty.
+func projectRow(row proto.Row, columnIDs []uint32) proto.Row {
+	if len(columnIDs) == 0 {
+		return row
+	}
+	var cells []proto.RowCell
+	for _, cell := range row.Cells {
+		for _, id := range columnIDs {
+			if cell.ColumnId == id {
+				cells = append(cells, cell)
+				break
+			}
+		}
+	}
+	row.Cells = cells
+	return row
+}
@@ -0,0 +1,201 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package testutils
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestMemDB(t *testing.T) {
+	db, s := NewMemDB()
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CPut("a", "2", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.CPut("a", "3", "1"); err == nil {
+		t.Errorf("expected the conditional put to fail")
+	}
+	if err := db.Txn(func(txn *client.Txn) error {
+		b := &client.Batch{}
+		b.Inc("b", 5)
+		b.Put("c", "x")
+		return txn.Commit(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if kv, err := db.Get("b"); err != nil || kv.ValueInt() != 5 || kv.Timestamp.IsZero() {
+		t.Errorf("unexpected value: %v, %v", kv, err)
+	}
+	rows, err := db.Scan("a", "z", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 3 || string(rows[0].ValueBytes()) != "2" {
+		t.Errorf("unexpected rows: %v", rows)
+	}
+	if n, err := db.DelRangeLimit("a", "z", 2); err != nil || n != 2 {
+		t.Errorf("expected 2 deleted keys, but found %d: %v", n, err)
+	}
+	if keys := s.Keys(); !reflect.DeepEqual([]string{"c"}, keys) {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+}
+
+func TestMemDBTables(t *testing.T) {
+	db, _ := NewMemDB()
+	schema := proto.TableSchema{
+		Table:   proto.Table{Name: "users"},
+		Columns: []proto.Column{{Name: "id", Type: proto.Column_INT}},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	names, err := db.ListTables("", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual([]string{"users"}, names) {
+		t.Errorf("unexpected tables: %v", names)
+	}
+}
+
+func TestMemDBTableTTL(t *testing.T) {
+	db, s := NewMemDB()
+	schema := proto.TableSchema{
+		Table: proto.Table{Name: "sessions", TTLSeconds: 10},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "user", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ImportCSV("sessions", strings.NewReader("id,user\n1,alice\n")); err != nil {
+		t.Fatal(err)
+	}
+	s.AdvanceClock(5 * time.Second)
+	if _, err := db.ImportCSV("sessions", strings.NewReader("id,user\n2,bob\n")); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := db.CountTable("sessions"); err != nil || n != 2 {
+		t.Errorf("expected 2 rows, but found %d, %v", n, err)
+	}
+
+	// The first row expires before the second.
+	s.AdvanceClock(6 * time.Second)
+	rows, err := db.ScanTable("sessions")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []map[string]interface{}{{"id": int64(2), "user": "bob"}}
+	if !reflect.DeepEqual(rows, expected) {
+		t.Errorf("expected %+v, but found %+v", expected, rows)
+	}
+	if r, err := db.GetTableRow("sessions", map[string]interface{}{"id": 1}); err != nil || r != nil {
+		t.Errorf("expected no row, but found %+v, %v", r, err)
+	}
+
+	// Without a time to live, the rows are visible again.
+	if err := db.SetTableTTL("sessions", 0); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := db.CountTable("sessions"); err != nil || n != 2 {
+		t.Errorf("expected 2 rows, but found %d, %v", n, err)
+	}
+}
+
+func TestMemDBChangefeed(t *testing.T) {
+	defer func(d time.Duration) { client.ChangefeedPollInterval = d }(client.ChangefeedPollInterval)
+	client.ChangefeedPollInterval = time.Millisecond
+	db, _ := NewMemDB()
+	schema := proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ImportCSV("users", strings.NewReader("id,name\n1,alice\n2,bob\n")); err != nil {
+		t.Fatal(err)
+	}
+	feed, err := db.Changefeed("users", proto.ZeroTimestamp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer feed.Close()
+	var rows []map[string]interface{}
+	for e := range feed.Events() {
+		if e.Row == nil {
+			break
+		}
+		rows = append(rows, e.Row)
+	}
+	expected := []map[string]interface{}{
+		{"id": int64(1), "name": "alice"},
+		{"id": int64(2), "name": "bob"},
+	}
+	if !reflect.DeepEqual(expected, rows) {
+		t.Errorf("expected %+v, but found %+v", expected, rows)
+	}
+}
+
+func TestMemSenderErrorHook(t *testing.T) {
+	db, s := NewMemDB()
+	boom := errors.New("boom")
+	s.SetErrorHook(FailNth(proto.Put, 2, boom))
+
+	b := &client.Batch{}
+	b.Put("a", "1")
+	b.Put("b", "2")
+	b.Put("c", "3")
+	if err := db.Run(b); err == nil || err.Error() != "boom" {
+		t.Errorf("expected %v, but found %v", boom, err)
+	}
+	// The batch stops at the failing request.
+	if keys := s.Keys(); !reflect.DeepEqual([]string{"a"}, keys) {
+		t.Errorf("unexpected keys: %v", keys)
+	}
+	if err := db.Put("d", "4"); err != nil {
+		t.Fatal(err)
+	}
+
+	s.SetErrorHook(nil)
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+}
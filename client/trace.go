@@ -0,0 +1,93 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// A CallInfo describes a call sent to the cluster. The operations of a
+// batch are sent as a single call of method proto.Batch.
+type CallInfo struct {
+	// Method is the method of the call, e.g. proto.Put.
+	Method proto.Method
+	// Key and EndKey are the keys addressed by the call. For batches these
+	// are the keys of the batch's first operation.
+	Key, EndKey proto.Key
+	// Calls is the number of operations of a batch, or 1.
+	Calls int
+	// Duration is the time it took to perform the call. It is only set for
+	// the After hook.
+	Duration time.Duration
+	// Err is the error returned by the call, if any. It is only set for the
+	// After hook.
+	Err error
+}
+
+// TraceHooks are invoked before and after every call a DB sends to the
+// cluster, allowing applications to trace or log the calls. The Restart
+// hook is invoked whenever a transaction restarts. Any hook may be nil.
+// The hooks are invoked synchronously and must be safe for concurrent use.
+type TraceHooks struct {
+	Before  func(info CallInfo)
+	After   func(info CallInfo)
+	Restart func(restart TxnRestart)
+}
+
+// TraceOpt registers hooks invoked before and after every call sent to
+// the cluster. It may be specified more than once; the hooks are invoked
+// in the order they were registered.
+func TraceOpt(hooks TraceHooks) Option {
+	return func(db *DB) {
+		db.traces = append(db.traces, hooks)
+	}
+}
+
+// traceBefore invokes the Before hooks for the call, returning the
+// description of the call to pass to traceAfter.
+func (db *DB) traceBefore(c Call) CallInfo {
+	if len(db.traces) == 0 {
+		return CallInfo{}
+	}
+	h := c.Args.Header()
+	info := CallInfo{Method: c.Method(), Key: h.Key, EndKey: h.EndKey, Calls: 1}
+	if b, ok := c.Args.(*proto.BatchRequest); ok {
+		info.Calls = len(b.Requests)
+	}
+	for _, hooks := range db.traces {
+		if hooks.Before != nil {
+			hooks.Before(info)
+		}
+	}
+	return info
+}
+
+// traceAfter invokes the After hooks for the call described by info,
+// which was started at start and returned err.
+func (db *DB) traceAfter(info CallInfo, start time.Time, err error) {
+	if len(db.traces) == 0 {
+		return
+	}
+	info.Duration = time.Since(start)
+	info.Err = err
+	for _, hooks := range db.traces {
+		if hooks.After != nil {
+			hooks.After(info)
+		}
+	}
+}
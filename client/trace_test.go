@@ -0,0 +1,70 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestTraceHooks(t *testing.T) {
+	db, _ := newMemDB()
+	var events []string
+	TraceOpt(TraceHooks{
+		Before: func(info CallInfo) {
+			events = append(events, fmt.Sprintf("before %s %s-%s %d", info.Method, info.Key, info.EndKey, info.Calls))
+		},
+		After: func(info CallInfo) {
+			if info.Duration <= 0 {
+				t.Errorf("expected a positive duration, but found %s", info.Duration)
+			}
+			events = append(events, fmt.Sprintf("after %s %v", info.Method, info.Err))
+		},
+	})(db)
+	var afters int
+	TraceOpt(TraceHooks{After: func(CallInfo) { afters++ }})(db)
+
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	b := &Batch{}
+	b.Scan("a", "c", 0)
+	b.Put("b", "2")
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+	err := db.CPut("b", "3", "4")
+	if _, ok := err.(*proto.ConditionFailedError); !ok {
+		t.Fatalf("expected a *proto.ConditionFailedError, but found %v", err)
+	}
+	expected := []string{
+		`before Put "a"-"" 1`,
+		`after Put <nil>`,
+		`before Batch "a"-"c" 2`,
+		`after Batch <nil>`,
+		`before ConditionalPut "b"-"" 1`,
+		`after ConditionalPut ` + err.Error(),
+	}
+	if !reflect.DeepEqual(expected, events) {
+		t.Errorf("expected %q, but found %q", expected, events)
+	}
+	if afters != 3 {
+		t.Errorf("expected 3 calls of the second hook, but found %d", afters)
+	}
+}
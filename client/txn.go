@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"sync"
 	"time"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -53,7 +54,11 @@ func (ts *txnSender) Send(ctx context.Context, call Call) {
 	ts.wrapped.Send(ctx, call)
 	ts.txn.Update(call.Reply.Header().Txn)
 
-	if err, ok := call.Reply.Header().GoError().(*proto.TransactionAbortedError); ok {
+	err := call.Reply.Header().GoError()
+	if err != nil {
+		(*Txn)(ts).noteRestartError(call.Args, err)
+	}
+	if err, ok := err.(*proto.TransactionAbortedError); ok {
 		// On Abort, reset the transaction so we start anew on restart.
 		ts.txn = proto.Transaction{
 			Name:      ts.txn.Name,
@@ -71,6 +76,37 @@ type Txn struct {
 	txn          proto.Transaction
 	haveTxnWrite bool // True if there were transactional writes
 	haveEndTxn   bool // True if there was an explicit EndTransaction
+	// savepoints holds the length of the undo log at each active
+	// savepoint. See Savepoint.
+	savepoints     []int
+	undo           []undoEntry
+	savepointEpoch int
+	// deadline, if non-zero, is the time after which the transaction
+	// fails with a *TxnDeadlineExceededError.
+	deadline time.Time
+	// fixedTimestamp, if non-zero, is the timestamp at which all reads of
+	// the transaction are performed. See SetFixedTimestamp.
+	fixedTimestamp proto.Timestamp
+	// inflight tracks the calls abandoned because the context of the
+	// transaction was canceled. See DB.TxnContext.
+	inflight sync.WaitGroup
+	// restarts holds the restarts of the transaction. lastRestart, if
+	// non-nil, describes the last call which failed with an error
+	// restarting the transaction. See Restarts.
+	restarts    []TxnRestart
+	lastRestart *TxnRestart
+}
+
+// A TxnDeadlineExceededError is returned by the operations of a
+// transaction once its deadline has passed. Transactions failing with a
+// TxnDeadlineExceededError are aborted and not retried.
+type TxnDeadlineExceededError struct {
+	Deadline time.Time
+}
+
+// Error implements the error interface.
+func (e *TxnDeadlineExceededError) Error() string {
+	return fmt.Sprintf("transaction deadline %s exceeded", e.Deadline.Format(time.RFC3339Nano))
 }
 
 func newTxn(db DB, depth int) *Txn {
@@ -79,6 +115,7 @@ func newTxn(db DB, depth int) *Txn {
 		wrapped: db.Sender,
 	}
 	txn.db.Sender = (*txnSender)(txn)
+	txn.db.inflight = &txn.inflight
 
 	if _, file, line, ok := runtime.Caller(depth + 1); ok {
 		// TODO(pmattis): include the parent directory?
@@ -126,6 +163,58 @@ func (txn *Txn) InternalSetPriority(priority int32) {
 	txn.db.userPriority = -priority
 }
 
+// SetDeadline sets the deadline of the transaction, replacing any previous
+// deadline. Once the deadline has passed, the transaction's operations,
+// including its commit, fail with a *TxnDeadlineExceededError and the
+// transaction is not retried. The deadline is checked by the client
+// before each operation is sent; an operation already in flight is not
+// interrupted. A zero deadline removes the deadline.
+func (txn *Txn) SetDeadline(deadline time.Time) {
+	txn.deadline = deadline
+}
+
+// UpdateDeadline lowers the deadline of the transaction to deadline if it
+// is earlier than the current deadline, or if there is none.
+func (txn *Txn) UpdateDeadline(deadline time.Time) {
+	if txn.deadline.IsZero() || deadline.Before(txn.deadline) {
+		txn.deadline = deadline
+	}
+}
+
+// Deadline returns the deadline of the transaction, which is zero if none
+// has been set.
+func (txn *Txn) Deadline() time.Time {
+	return txn.deadline
+}
+
+// checkDeadline returns a *TxnDeadlineExceededError if the deadline of the
+// transaction has passed.
+func (txn *Txn) checkDeadline() error {
+	if !txn.deadline.IsZero() && !time.Now().Before(txn.deadline) {
+		return &TxnDeadlineExceededError{Deadline: txn.deadline}
+	}
+	return nil
+}
+
+// SetFixedTimestamp pins the transaction to the historical time t: all of
+// its reads observe the database as of t, giving a consistent view across
+// any number of reads. Such a transaction is read-only; writes fail with
+// an error and committing it is a no-op. The timestamp must be set before
+// any operations are performed on the transaction.
+//
+// Reads of data which has since been garbage collected return no values,
+// so t should be more recent than the GC TTL of the data read.
+func (txn *Txn) SetFixedTimestamp(t time.Time) {
+	txn.fixedTimestamp = proto.Timestamp{WallTime: t.UnixNano()}
+}
+
+// SetUserPriority sets the user priority of the transaction, such as
+// LowUserPriority. The priority must be set before any operations are
+// performed on the transaction.
+func (txn *Txn) SetUserPriority(priority int32) {
+	txn.db.userPriority = priority
+}
+
 // Get retrieves the value for a key, returning the retrieved key/value or an
 // error.
 //
@@ -135,7 +224,8 @@ func (txn *Txn) InternalSetPriority(priority int32) {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (txn *Txn) Get(key interface{}) (KeyValue, error) {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Get(key)
 	return runOneRow(txn, b)
 }
@@ -158,7 +248,8 @@ func (txn *Txn) GetProto(key interface{}, msg gogoproto.Message) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler. value can be any key type or a proto.Message.
 func (txn *Txn) Put(key, value interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Put(key, value)
 	_, err := runOneResult(txn, b)
 	return err
@@ -171,12 +262,27 @@ func (txn *Txn) Put(key, value interface{}) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler. value can be any key type or a proto.Message.
 func (txn *Txn) CPut(key, value, expValue interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.CPut(key, value, expValue)
 	_, err := runOneResult(txn, b)
 	return err
 }
 
+// PutUnique sets the value for a key unless the key holds a different
+// value, in which case a *proto.ConditionFailedError holding the existing
+// value is returned.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler. value can be any key type or a proto.Message.
+func (txn *Txn) PutUnique(key, value interface{}) error {
+	b := getBatch()
+	defer putBatch(b)
+	b.PutUnique(key, value)
+	_, err := runOneResult(txn, b)
+	return err
+}
+
 // Inc increments the integer value at key. If the key does not exist it will
 // be created with an initial value of 0 which will then be incremented. If the
 // key exists but was set using Put or CPut an error will be returned.
@@ -187,7 +293,8 @@ func (txn *Txn) CPut(key, value, expValue interface{}) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (txn *Txn) Inc(key interface{}, value int64) (KeyValue, error) {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Inc(key, value)
 	return runOneRow(txn, b)
 }
@@ -205,12 +312,42 @@ func (txn *Txn) Scan(begin, end interface{}, maxRows int64) ([]KeyValue, error)
 	return r.Rows, err
 }
 
+// ScanKeys retrieves the keys of the rows between begin (inclusive) and end
+// (exclusive). The values of the returned rows are nil.
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (txn *Txn) ScanKeys(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	b := &Batch{}
+	b.ScanKeys(begin, end, maxRows)
+	r, err := runOneResult(txn, b)
+	return r.Rows, err
+}
+
+// ReverseScan retrieves the rows between begin (inclusive) and end
+// (exclusive) in descending key order. For example, the latest n rows of a
+// range of keys ordered by time are returned by ReverseScan(begin, end, n).
+//
+// The returned []KeyValue will contain up to maxRows elements.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (txn *Txn) ReverseScan(begin, end interface{}, maxRows int64) ([]KeyValue, error) {
+	b := &Batch{}
+	b.ReverseScan(begin, end, maxRows)
+	r, err := runOneResult(txn, b)
+	return r.Rows, err
+}
+
 // Del deletes one or more keys.
 //
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (txn *Txn) Del(keys ...interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.Del(keys...)
 	_, err := runOneResult(txn, b)
 	return err
@@ -224,12 +361,27 @@ func (txn *Txn) Del(keys ...interface{}) error {
 // key can be either a byte slice, a string, a fmt.Stringer or an
 // encoding.BinaryMarshaler.
 func (txn *Txn) DelRange(begin, end interface{}) error {
-	b := &Batch{}
+	b := getBatch()
+	defer putBatch(b)
 	b.DelRange(begin, end)
 	_, err := runOneResult(txn, b)
 	return err
 }
 
+// DelRangeLimit deletes at most maxKeys of the rows between begin
+// (inclusive) and end (exclusive), returning the number of rows deleted.
+// See DB.DelRangeLimit.
+//
+// key can be either a byte slice, a string, a fmt.Stringer or an
+// encoding.BinaryMarshaler.
+func (txn *Txn) DelRangeLimit(begin, end interface{}, maxKeys int64) (int64, error) {
+	b := getBatch()
+	defer putBatch(b)
+	b.DelRangeLimit(begin, end, maxKeys)
+	r, err := runOneResult(txn, b)
+	return r.Deleted, err
+}
+
 // Run executes the operations queued up within a batch. Before executing any
 // of the operations the batch is first checked to see if there were any errors
 // during its construction (e.g. failure to marshal a proto message).
@@ -245,9 +397,13 @@ func (txn *Txn) Run(b *Batch) error {
 	if err := b.prepare(); err != nil {
 		return err
 	}
-	if err := txn.send(b.calls...); err != nil {
+	if err := txn.db.checkBatchLimits(b.calls); err != nil {
 		return err
 	}
+	if b.err = txn.send(b.calls...); b.err != nil {
+		b.fillResults()
+		return b.err
+	}
 	return b.fillResults()
 }
 
@@ -270,6 +426,14 @@ func (txn *Txn) exec(retryable func(txn *Txn) error) error {
 	retryOpts.Tag = txn.txn.Name
 	err := retry.WithBackoff(retryOpts, func() (retry.Status, error) {
 		txn.haveTxnWrite, txn.haveEndTxn = false, false // always reset before [re]starting txn
+		txn.lastRestart = nil
+		txn.resetSavepoints()
+		if err := txn.checkDeadline(); err != nil {
+			return retry.Break, err
+		}
+		if err := txn.db.context().Err(); err != nil {
+			return retry.Break, err
+		}
 		err := retryable(txn)
 		if err == nil {
 			if !txn.haveEndTxn && txn.haveTxnWrite {
@@ -282,10 +446,19 @@ func (txn *Txn) exec(retryable func(txn *Txn) error) error {
 				err = txn.send(Call{Args: etArgs, Reply: etReply})
 			}
 		}
+		switch txn.db.classify(err) {
+		case ErrorRetryable:
+			txn.recordRestart(err)
+			return retry.Continue, err
+		case ErrorTerminal:
+			return retry.Break, err
+		}
 		if restartErr, ok := err.(proto.TransactionRestartError); ok {
 			if restartErr.CanRestartTransaction() == proto.TransactionRestart_IMMEDIATE {
+				txn.recordRestart(err)
 				return retry.Reset, err
 			} else if restartErr.CanRestartTransaction() == proto.TransactionRestart_BACKOFF {
+				txn.recordRestart(err)
 				return retry.Continue, err
 			}
 			// By default, fall through and return Break.
@@ -293,27 +466,78 @@ func (txn *Txn) exec(retryable func(txn *Txn) error) error {
 		return retry.Break, err
 	})
 	if err != nil && txn.haveTxnWrite {
-		if replyErr := txn.send(Call{
-			Args:  &proto.EndTransactionRequest{Commit: false},
-			Reply: &proto.EndTransactionResponse{},
-		}); replyErr != nil {
-			log.Errorf("failure aborting transaction: %s; abort caused by: %s", replyErr, err)
+		if txn.db.context().Err() != nil {
+			// Calls of the transaction may still be in flight: abort the
+			// transaction once they have completed, without blocking the
+			// caller.
+			go func() {
+				txn.inflight.Wait()
+				txn.abort(err)
+			}()
+			return err
 		}
-		return err
+		txn.abort(err)
 	}
 	return err
 }
 
+// abort aborts the transaction, which failed with err. The abort bypasses
+// txn.send and the context of the transaction so that it is sent even if
+// the deadline of the transaction has passed or its context is canceled.
+func (txn *Txn) abort(err error) {
+	db := txn.db
+	db.ctx = nil
+	if replyErr := db.send(Call{
+		Args:  &proto.EndTransactionRequest{Commit: false},
+		Reply: &proto.EndTransactionResponse{},
+	}); replyErr != nil {
+		log.Errorf("failure aborting transaction: %s; abort caused by: %s", replyErr, err)
+	}
+}
+
 // send runs the specified calls synchronously in a single batch and
 // returns any errors.
 func (txn *Txn) send(calls ...Call) error {
 	if len(calls) == 0 {
 		return nil
 	}
+	if err := txn.checkDeadline(); err != nil {
+		return err
+	}
+	resetClientCmdIDs(calls)
+	if !txn.fixedTimestamp.Equal(proto.ZeroTimestamp) {
+		return txn.sendFixed(calls)
+	}
 	txn.updateState(calls)
+	if len(txn.savepoints) > 0 {
+		if err := txn.recordUndo(calls); err != nil {
+			return err
+		}
+	}
 	return txn.db.send(calls...)
 }
 
+// sendFixed sends the calls of a transaction with a fixed timestamp. The
+// reads are sent outside of the transaction at the fixed timestamp, which
+// needs neither a transaction record nor intents.
+func (txn *Txn) sendFixed(calls []Call) error {
+	reads := make([]Call, 0, len(calls))
+	for _, c := range calls {
+		switch {
+		case proto.IsReadOnly(c.Args):
+			c.Args.Header().Timestamp = txn.fixedTimestamp
+			reads = append(reads, c)
+		case c.Method() == proto.EndTransaction:
+			// There is nothing to commit.
+		default:
+			return fmt.Errorf("cannot %s in a transaction with a fixed timestamp", c.Method())
+		}
+	}
+	db := txn.db
+	db.Sender = txn.wrapped
+	return db.send(reads...)
+}
+
 func (txn *Txn) updateState(calls []Call) {
 	for _, c := range calls {
 		if b, ok := c.Args.(*proto.BatchRequest); ok {
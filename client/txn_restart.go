@@ -0,0 +1,143 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// A TxnRestart describes a restart of a transaction. Restarts are caused
+// by contention: collecting them (see Txn.Restarts and TraceHooks.Restart)
+// helps find the keys on which transactions conflict.
+type TxnRestart struct {
+	// Attempt is the attempt of the transaction which failed, starting at
+	// 1.
+	Attempt int
+	// Err is the error which caused the restart.
+	Err error
+	// Key and EndKey are the keys addressed by the call which failed. For
+	// batches they span the keys of all of the batch's operations. They
+	// are nil if the error was not returned by a call of the transaction,
+	// e.g. if it was classified as retryable by a Classifier.
+	Key, EndKey proto.Key
+	// Priority and Timestamp are the priority and timestamp of the
+	// transaction when the call failed. If the transaction failed to push
+	// another transaction, Priority is the priority of the pusher.
+	Priority  int32
+	Timestamp proto.Timestamp
+	// Conflict is the transaction with which the transaction conflicted,
+	// if it is known.
+	Conflict *proto.Transaction
+}
+
+// String returns a description of the restart suitable for logging.
+func (r TxnRestart) String() string {
+	s := fmt.Sprintf("attempt %d", r.Attempt)
+	if r.Key != nil {
+		if r.EndKey != nil {
+			s += fmt.Sprintf(" on %q-%q", r.Key, r.EndKey)
+		} else {
+			s += fmt.Sprintf(" on %q", r.Key)
+		}
+	}
+	s += fmt.Sprintf(" at priority %d, timestamp %s", r.Priority, r.Timestamp)
+	if r.Conflict != nil {
+		s += fmt.Sprintf(", conflicting with %q", r.Conflict.Name)
+	}
+	return fmt.Sprintf("%s: %s", s, r.Err)
+}
+
+// Restarts returns the restarts of the transaction so far: while running
+// the nth attempt of the transaction, the n-1 restarts which preceded it.
+func (txn *Txn) Restarts() []TxnRestart {
+	return txn.restarts
+}
+
+// noteRestartError records the details of a call of the transaction which
+// failed with an error restarting the transaction. It must be called
+// before the transaction is reset by an abort.
+func (txn *Txn) noteRestartError(args proto.Request, err error) {
+	restartErr, ok := err.(proto.TransactionRestartError)
+	if !ok || restartErr.CanRestartTransaction() == proto.TransactionRestart_ABORT {
+		return
+	}
+	r := &TxnRestart{
+		Err:       err,
+		Priority:  txn.txn.Priority,
+		Timestamp: txn.txn.Timestamp,
+	}
+	r.Key, r.EndKey = callSpan(args)
+	switch t := err.(type) {
+	case *proto.TransactionPushError:
+		r.Conflict = &t.PusheeTxn
+	case *proto.TransactionAbortedError:
+		r.Priority = t.Txn.Priority
+	case *proto.ReadWithinUncertaintyIntervalError:
+		r.Timestamp = t.Timestamp
+	case *proto.WriteTooOldError:
+		r.Timestamp = t.Timestamp
+	}
+	txn.lastRestart = r
+}
+
+// recordRestart records a restart of the transaction caused by err.
+func (txn *Txn) recordRestart(err error) {
+	txn.db.metrics.recordTxnRestart()
+	r := TxnRestart{Err: err}
+	if txn.lastRestart != nil && txn.lastRestart.Err == err {
+		r = *txn.lastRestart
+	}
+	r.Attempt = len(txn.restarts) + 1
+	txn.restarts = append(txn.restarts, r)
+	for _, hooks := range txn.db.traces {
+		if hooks.Restart != nil {
+			hooks.Restart(r)
+		}
+	}
+}
+
+// callSpan returns the keys addressed by a call. For batches, it returns
+// the span of the keys of all of the batch's operations.
+func callSpan(args proto.Request) (proto.Key, proto.Key) {
+	b, ok := args.(*proto.BatchRequest)
+	if !ok {
+		h := args.Header()
+		if len(h.EndKey) == 0 {
+			return h.Key, nil
+		}
+		return h.Key, h.EndKey
+	}
+	var key, endKey proto.Key
+	for _, union := range b.Requests {
+		h := union.GetValue().(proto.Request).Header()
+		if len(h.Key) == 0 {
+			continue
+		}
+		end := h.EndKey
+		if len(end) == 0 {
+			end = h.Key.Next()
+		}
+		if key == nil || h.Key.Less(key) {
+			key = h.Key
+		}
+		if endKey == nil || endKey.Less(end) {
+			endKey = end
+		}
+	}
+	return key, endKey
+}
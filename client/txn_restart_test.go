@@ -0,0 +1,75 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+func TestTxnRestarts(t *testing.T) {
+	count := 0
+	db := newDB(newTestSender(func(call Call) {
+		if _, ok := call.Args.(*proto.BatchRequest); !ok {
+			return
+		}
+		count++
+		switch count {
+		case 1:
+			call.Reply.Header().Txn.Priority = 7
+			call.Reply.Header().SetGoError(&proto.TransactionPushError{
+				PusheeTxn: proto.Transaction{Name: "other"},
+			})
+		case 2:
+			call.Reply.Header().SetGoError(&proto.TransactionRetryError{})
+		}
+	}))
+	db.txnRetryOptions.Backoff = 1 * time.Millisecond
+	var traced []TxnRestart
+	TraceOpt(TraceHooks{Restart: func(r TxnRestart) { traced = append(traced, r) }})(db)
+
+	var restarts []TxnRestart
+	if err := db.Txn(func(txn *Txn) error {
+		restarts = txn.Restarts()
+		b := &Batch{}
+		b.Put("b", "1")
+		b.Get("a")
+		return txn.Run(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(restarts) != 2 || len(traced) != 2 {
+		t.Fatalf("expected 2 restarts, but found %v and %v", restarts, traced)
+	}
+	r := restarts[0]
+	if r.Attempt != 1 || r.Priority != 7 || r.Conflict == nil || r.Conflict.Name != "other" {
+		t.Errorf("unexpected restart: %+v", r)
+	}
+	if !r.Key.Equal(proto.Key("a")) || !r.EndKey.Equal(proto.Key("b").Next()) {
+		t.Errorf("expected the span of the batch, but found %q-%q", r.Key, r.EndKey)
+	}
+	if _, ok := r.Err.(*proto.TransactionPushError); !ok {
+		t.Errorf("expected a *proto.TransactionPushError, but found %v", r.Err)
+	}
+	if r := restarts[1]; r.Attempt != 2 || r.Conflict != nil {
+		t.Errorf("unexpected restart: %+v", r)
+	}
+	if traced[1].String() != restarts[1].String() {
+		t.Errorf("expected %s, but found %s", restarts[1], traced[1])
+	}
+}
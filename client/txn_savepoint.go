@@ -0,0 +1,159 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package client
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// Savepoints are implemented by the client: while a savepoint is active,
+// the transaction reads the values of the keys about to be written and
+// records them in an undo log. Rolling back to a savepoint writes the
+// recorded values back, in reverse order, within the transaction. This
+// costs an additional read per batch of writes, but only while a
+// savepoint is active. The stores cannot roll an intent back to an
+// earlier write of the same transaction, which would be needed to
+// implement savepoints on the servers instead.
+
+// A Savepoint marks a point within a transaction to which its writes can
+// be rolled back. See Txn.Savepoint.
+type Savepoint struct {
+	depth int
+	epoch int
+}
+
+// An undoEntry records the value of a key before it was written. A nil
+// value indicates that the key did not exist.
+type undoEntry struct {
+	key   proto.Key
+	value *proto.Value
+}
+
+// Savepoint returns a savepoint marking the current state of the
+// transaction's writes. Savepoints may be nested. A savepoint remains
+// active until it is released, or until the transaction is retried, which
+// invalidates all savepoints.
+func (txn *Txn) Savepoint() Savepoint {
+	txn.savepoints = append(txn.savepoints, len(txn.undo))
+	return Savepoint{depth: len(txn.savepoints) - 1, epoch: txn.savepointEpoch}
+}
+
+// RollbackToSavepoint undoes the writes performed by the transaction since
+// the savepoint was created, allowing the transaction to continue as if
+// they had never been performed. Savepoints created after sp are released;
+// sp itself remains active.
+func (txn *Txn) RollbackToSavepoint(sp Savepoint) error {
+	if err := txn.checkSavepoint(sp); err != nil {
+		return err
+	}
+	start := txn.savepoints[sp.depth]
+	b := &Batch{}
+	for i := len(txn.undo) - 1; i >= start; i-- {
+		e := txn.undo[i]
+		if e.value == nil {
+			b.Del(e.key)
+		} else {
+			value := *e.value
+			value.Timestamp = nil
+			putProtoValue(b, e.key, value)
+		}
+	}
+	if err := b.prepare(); err != nil {
+		return err
+	}
+	txn.updateState(b.calls)
+	if err := txn.db.send(b.calls...); err != nil {
+		return err
+	}
+	if err := b.fillResults(); err != nil {
+		return err
+	}
+	txn.undo = txn.undo[:start]
+	txn.savepoints = txn.savepoints[:sp.depth+1]
+	return nil
+}
+
+// ReleaseSavepoint releases the savepoint and the savepoints created after
+// it, keeping the writes performed since. Once no savepoint is active, the
+// transaction stops recording its writes.
+func (txn *Txn) ReleaseSavepoint(sp Savepoint) error {
+	if err := txn.checkSavepoint(sp); err != nil {
+		return err
+	}
+	txn.savepoints = txn.savepoints[:sp.depth]
+	if len(txn.savepoints) == 0 {
+		txn.undo = nil
+	}
+	return nil
+}
+
+func (txn *Txn) checkSavepoint(sp Savepoint) error {
+	if sp.epoch != txn.savepointEpoch || sp.depth >= len(txn.savepoints) {
+		return fmt.Errorf("savepoint is no longer active")
+	}
+	return nil
+}
+
+// resetSavepoints invalidates all savepoints, e.g. when the transaction
+// is retried.
+func (txn *Txn) resetSavepoints() {
+	txn.savepoints, txn.undo = nil, nil
+	txn.savepointEpoch++
+}
+
+// recordUndo reads the current values of the keys the calls are about to
+// write and appends them to the undo log.
+func (txn *Txn) recordUndo(calls []Call) error {
+	var reads []Call
+	for _, c := range calls {
+		if c.Args == nil || !proto.IsTransactionWrite(c.Args) {
+			continue
+		}
+		h := c.Args.Header()
+		switch t := c.Args.(type) {
+		case *proto.EndTransactionRequest:
+		case *proto.DeleteRangeRequest:
+			reads = append(reads, Scan(h.Key, h.EndKey, 0))
+		case *proto.DeleteRowRequest:
+			start, end := proto.KeySpan(t)
+			reads = append(reads, Scan(start, end, 0))
+		case *proto.PutRowRequest:
+			reads = append(reads, Get(h.Key))
+			for _, cell := range t.Cells {
+				reads = append(reads, Get(keys.MakeCellKey(h.Key, cell.ColumnId)))
+			}
+		default:
+			reads = append(reads, Get(h.Key))
+		}
+	}
+	if err := txn.db.send(reads...); err != nil {
+		return err
+	}
+	for _, c := range reads {
+		switch t := c.Reply.(type) {
+		case *proto.GetResponse:
+			txn.undo = append(txn.undo, undoEntry{key: c.Args.Header().Key, value: t.Value})
+		case *proto.ScanResponse:
+			for i := range t.Rows {
+				txn.undo = append(txn.undo, undoEntry{key: t.Rows[i].Key, value: &t.Rows[i].Value})
+			}
+		}
+	}
+	return nil
+}
@@ -284,3 +284,231 @@ func TestRunTransactionRetryOnErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestTxnSavepoints(t *testing.T) {
+	db, _ := newMemDB()
+	for _, kv := range []struct{ key, value string }{{"a", "1"}, {"b", "2"}, {"r1", "x"}, {"r2", "y"}} {
+		if err := db.Put(kv.key, kv.value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := db.Inc("n", 5); err != nil {
+		t.Fatal(err)
+	}
+
+	err := db.Txn(func(txn *Txn) error {
+		if err := txn.Put("a", "10"); err != nil {
+			return err
+		}
+		sp := txn.Savepoint()
+		b := &Batch{}
+		b.Put("a", "11")
+		b.Put("a", "12")
+		b.Put("c", "3")
+		b.Del("b")
+		b.Inc("n", 1)
+		b.DelRange("r", "s")
+		if err := txn.Run(b); err != nil {
+			return err
+		}
+		inner := txn.Savepoint()
+		if err := txn.Put("d", "4"); err != nil {
+			return err
+		}
+		if err := txn.RollbackToSavepoint(sp); err != nil {
+			return err
+		}
+		// Rolling back released the inner savepoint.
+		if err := txn.ReleaseSavepoint(inner); err == nil {
+			t.Errorf("expected the inner savepoint to be released")
+		}
+		// The savepoint itself remains active.
+		if err := txn.Put("e", "5"); err != nil {
+			return err
+		}
+		if err := txn.RollbackToSavepoint(sp); err != nil {
+			return err
+		}
+		return txn.ReleaseSavepoint(sp)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[string]string{"a": "10", "b": "2", "r1": "x", "r2": "y"}
+	for _, key := range []string{"a", "b", "c", "d", "e", "r1", "r2"} {
+		kv, err := db.Get(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v := string(kv.ValueBytes()); v != expected[key] {
+			t.Errorf("%s: expected %q, but found %q", key, expected[key], v)
+		}
+	}
+	if kv, err := db.Get("n"); err != nil || kv.ValueInt() != 5 {
+		t.Errorf("expected 5, but found %v (%v)", kv, err)
+	}
+}
+
+func TestTxnDeadline(t *testing.T) {
+	db, s := newMemDB()
+	var aborted bool
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		if et, ok := call.Args.(*proto.EndTransactionRequest); ok && !et.Commit {
+			aborted = true
+		}
+		s.Send(ctx, call)
+	})
+
+	attempts := 0
+	err := db.Txn(func(txn *Txn) error {
+		attempts++
+		txn.SetDeadline(time.Now().Add(time.Hour))
+		txn.UpdateDeadline(time.Now().Add(2 * time.Hour))
+		if err := txn.Put("a", "1"); err != nil {
+			return err
+		}
+		txn.UpdateDeadline(time.Now().Add(-time.Second))
+		return txn.Put("b", "2")
+	})
+	e, ok := err.(*TxnDeadlineExceededError)
+	if !ok {
+		t.Fatalf("expected a *TxnDeadlineExceededError, but found %v", err)
+	}
+	if time.Now().Before(e.Deadline) {
+		t.Errorf("unexpected deadline: %s", e.Deadline)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt, but found %d", attempts)
+	}
+	if !aborted {
+		t.Errorf("expected the transaction to be aborted")
+	}
+
+	// A deadline in the future does not interfere.
+	if err := db.Txn(func(txn *Txn) error {
+		txn.SetDeadline(time.Now().Add(time.Hour))
+		return txn.Put("c", "3")
+	}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTxnFixedTimestamp(t *testing.T) {
+	db, s := newMemDB()
+	if err := db.Put("a", "1"); err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Unix(100, 0)
+	var reqs []proto.Request
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		reqs = append(reqs, requests(call)...)
+		s.Send(ctx, call)
+	})
+
+	if err := db.Txn(func(txn *Txn) error {
+		txn.SetFixedTimestamp(ts)
+		if _, err := txn.Get("a"); err != nil {
+			return err
+		}
+		b := &Batch{}
+		b.Get("a")
+		b.Scan("a", "z", 0)
+		return txn.Commit(b)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if len(reqs) != 3 {
+		t.Fatalf("expected 3 requests, but found %d", len(reqs))
+	}
+	for i, req := range reqs {
+		if req.Method() == proto.EndTransaction {
+			t.Errorf("%d: unexpected EndTransaction", i)
+		}
+		if header := req.Header(); header.Txn != nil || header.Timestamp.WallTime != ts.UnixNano() {
+			t.Errorf("%d: expected a non-transactional read at %s, but found %+v", i, ts, header)
+		}
+	}
+
+	err := db.Txn(func(txn *Txn) error {
+		txn.SetFixedTimestamp(ts)
+		return txn.Put("b", "2")
+	})
+	if expected := "cannot Put in a transaction with a fixed timestamp"; err == nil || err.Error() != expected {
+		t.Errorf("expected %q, but found %v", expected, err)
+	}
+	if _, ok := s.data["b"]; ok {
+		t.Errorf("expected the write to fail")
+	}
+}
+
+func TestTxnContextCancel(t *testing.T) {
+	db, s := newMemDB()
+	started := make(chan struct{}, 2)
+	block := make(chan struct{})
+	aborted := make(chan struct{})
+	db.Sender = SenderFunc(func(ctx context.Context, call Call) {
+		switch t := call.Args.(type) {
+		case *proto.GetRequest:
+			started <- struct{}{}
+			<-block
+		case *proto.EndTransactionRequest:
+			if !t.Commit {
+				close(aborted)
+			}
+		}
+		s.Send(ctx, call)
+	})
+
+	// A batch blocked in flight is abandoned.
+	ctx, cancel := context.WithCancel(context.Background())
+	errCh := make(chan error, 1)
+	go func() {
+		b := &Batch{}
+		b.Get("a")
+		errCh <- db.RunContext(ctx, b)
+	}()
+	<-started
+	cancel()
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("expected %v, but found %v", context.Canceled, err)
+	}
+
+	// Operations are not sent once the context is canceled.
+	if err := db.RunContext(ctx, &Batch{}); err != nil {
+		t.Errorf("unexpected error for an empty batch: %v", err)
+	}
+	b := &Batch{}
+	b.Put("a", "1")
+	if err := db.RunContext(ctx, b); err != context.Canceled {
+		t.Errorf("expected %v, but found %v", context.Canceled, err)
+	}
+
+	// A canceled transaction is aborted once its in-flight calls complete.
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		errCh <- db.TxnContext(ctx, func(txn *Txn) error {
+			if err := txn.Put("b", "2"); err != nil {
+				return err
+			}
+			_, err := txn.Get("b")
+			return err
+		})
+	}()
+	<-started
+	cancel()
+	if err := <-errCh; err != context.Canceled {
+		t.Errorf("expected %v, but found %v", context.Canceled, err)
+	}
+	select {
+	case <-aborted:
+		t.Fatal("expected the abort to wait for the in-flight call")
+	default:
+	}
+	close(block)
+	select {
+	case <-aborted:
+	case <-time.After(5 * time.Second):
+		t.Fatal("transaction was not aborted")
+	}
+}
@@ -48,6 +48,11 @@ const (
 	// KeyConfigZone is the zone configuration map.
 	KeyConfigZone = "zones"
 
+	// KeyTablePrivileges is the map from table IDs to the privileges of
+	// the tables, which are enforced by every store. The value is a
+	// storage.TablePrivilegeMap.
+	KeyTablePrivileges = "table-privileges"
+
 	// KeyCapacityPrefix is the key prefix for gossiping available
 	// store capacity. The suffix is composed of: <node ID>-<store ID>.
 	// The value is a storage.StoreDescriptor struct.
@@ -19,6 +19,17 @@ package keys
 
 import "github.com/cockroachdb/cockroach/proto"
 
+// Reserved database and table descriptor IDs. IDs allocated from
+// DescIDGenerator start above MaxReservedDescID.
+const (
+	// DefaultDatabaseID is the ID of the database holding tables whose
+	// names are not qualified with a database name.
+	DefaultDatabaseID = 1
+	// MaxReservedDescID is the largest descriptor ID reserved for
+	// well-known databases and tables.
+	MaxReservedDescID = 49
+)
+
 // Constants for system-reserved keys in the KV map.
 var (
 	// LocalPrefix is the prefix for keys which hold data local to a
@@ -161,6 +172,34 @@ var (
 	NamespaceMetadataPrefix = MakeKey(SystemPrefix, proto.Key("ns-"))
 	// TableMetadataPrefix is the key prefix for all table metadata.
 	TableMetadataPrefix = MakeKey(SystemPrefix, proto.Key("tbl-"))
+	// DescMetadataPrefix is the key prefix for all database and table
+	// descriptors, keyed by descriptor ID. The namespace and table metadata
+	// keys map names to these IDs.
+	DescMetadataPrefix = MakeKey(SystemPrefix, proto.Key("desc-"))
+	// DroppedTablePrefix is the key prefix for the IDs of dropped tables
+	// whose data has not yet been reclaimed.
+	DroppedTablePrefix = MakeKey(SystemPrefix, proto.Key("dropped-"))
+	// TableTTLPrefix is the key prefix for the IDs of tables whose rows
+	// have a time to live.
+	TableTTLPrefix = MakeKey(SystemPrefix, proto.Key("ttl-"))
+	// TableStatsPrefix is the key prefix for the statistics of tables,
+	// keyed by table ID.
+	TableStatsPrefix = MakeKey(SystemPrefix, proto.Key("stats-"))
+	// TableStatsLeaseKey holds the lease of the node which refreshes the
+	// statistics of tables.
+	TableStatsLeaseKey = MakeKey(SystemPrefix, proto.Key("stats-lease"))
+	// DescIDGenerator is the global database and table descriptor ID
+	// generator sequence.
+	DescIDGenerator = MakeKey(SystemPrefix, proto.Key("desc-idgen"))
+	// DescLeasePrefix is the key prefix for the leases held on versions of
+	// table descriptors, keyed by descriptor ID, version and lease ID.
+	DescLeasePrefix = MakeKey(SystemPrefix, proto.Key("lease-"))
+	// SchemaJobPrefix is the key prefix for the records of schema change
+	// jobs, keyed by job ID.
+	SchemaJobPrefix = MakeKey(SystemPrefix, proto.Key("job-"))
+	// SchemaJobIDGenerator is the global schema change job ID generator
+	// sequence.
+	SchemaJobIDGenerator = MakeKey(SystemPrefix, proto.Key("job-idgen"))
 	// StoreIDGenerator is the global store ID generator sequence.
 	StoreIDGenerator = MakeKey(SystemPrefix, proto.Key("store-idgen"))
 	// RangeTreeRoot specifies the root range in the range tree.
@@ -21,6 +21,7 @@ package keys
 import (
 	"bytes"
 	"fmt"
+	"math"
 
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/util/encoding"
@@ -70,6 +71,94 @@ func MakeTableMetadataKey(namespaceID uint32, tableName string) proto.Key {
 	return k
 }
 
+// MakeDescMetadataKey returns the key for the descriptor with the given ID.
+func MakeDescMetadataKey(descID uint32) proto.Key {
+	return MakeKey(DescMetadataPrefix, encoding.EncodeUvarint(nil, uint64(descID)))
+}
+
+// MakeDroppedTableKey returns the key marking the table with the given ID
+// as dropped.
+func MakeDroppedTableKey(tableID uint32) proto.Key {
+	return MakeKey(DroppedTablePrefix, encoding.EncodeUvarint(nil, uint64(tableID)))
+}
+
+// MakeTableTTLKey returns the key marking the rows of the table with the
+// given ID as having a time to live.
+func MakeTableTTLKey(tableID uint32) proto.Key {
+	return MakeKey(TableTTLPrefix, encoding.EncodeUvarint(nil, uint64(tableID)))
+}
+
+// MakeTableStatsKey returns the key holding the statistics of the table
+// with the given ID.
+func MakeTableStatsKey(tableID uint32) proto.Key {
+	return MakeKey(TableStatsPrefix, encoding.EncodeUvarint(nil, uint64(tableID)))
+}
+
+// MakeDescLeasePrefix returns the key prefix of the leases held on the
+// descriptor with the given ID.
+func MakeDescLeasePrefix(descID uint32) proto.Key {
+	return MakeKey(DescLeasePrefix, encoding.EncodeUvarint(nil, uint64(descID)))
+}
+
+// MakeDescLeaseKey returns the key of the lease with the given ID held on
+// a version of the descriptor with the given ID. The leases on a
+// descriptor sort by version.
+func MakeDescLeaseKey(descID, version uint32, leaseID []byte) proto.Key {
+	return MakeKey(MakeDescLeasePrefix(descID), encoding.EncodeUvarint(nil, uint64(version)), leaseID)
+}
+
+// MakeSchemaJobKey returns the key of the record of the schema change job
+// with the given ID.
+func MakeSchemaJobKey(jobID uint64) proto.Key {
+	return MakeKey(SchemaJobPrefix, encoding.EncodeUvarint(nil, jobID))
+}
+
+// MakeTablePrefix returns the key prefix under which the data of the
+// table with the given ID is stored.
+func MakeTablePrefix(tableID uint32) proto.Key {
+	return encoding.EncodeUvarint(nil, uint64(tableID))
+}
+
+// MakeIndexPrefix returns the key prefix under which the entries of the
+// index with the given ID are stored.
+func MakeIndexPrefix(tableID, indexID uint32) proto.Key {
+	return encoding.EncodeUvarint(MakeTablePrefix(tableID), uint64(indexID))
+}
+
+// MakeRowKey returns the sentinel key of the row with the given encoded
+// primary key in the specified index.
+func MakeRowKey(tableID, indexID uint32, primaryKey []byte) proto.Key {
+	return MakeKey(MakeIndexPrefix(tableID, indexID), primaryKey)
+}
+
+// MakeCellKey returns the key of the specified column within the row whose
+// sentinel key is rowKey.
+func MakeCellKey(rowKey proto.Key, columnID uint32) proto.Key {
+	k := make(proto.Key, 0, len(rowKey)+5)
+	k = append(k, rowKey...)
+	return encoding.EncodeUvarint(k, uint64(columnID))
+}
+
+// DecodeCellKey returns the ID of the column stored under key, which must
+// be the key of a cell within the row whose sentinel key is rowKey. It
+// returns false if key is not such a cell key.
+func DecodeCellKey(rowKey, key proto.Key) (uint32, bool) {
+	if !bytes.HasPrefix(key, rowKey) {
+		return 0, false
+	}
+	suffix := key[len(rowKey):]
+	// A column ID is encoded as a uvarint, whose first byte is 8 plus the
+	// number of bytes which follow it.
+	if len(suffix) == 0 || suffix[0] < 9 || suffix[0] > 16 || len(suffix) != int(suffix[0])-7 {
+		return 0, false
+	}
+	_, id := encoding.DecodeUvarint(suffix)
+	if id == 0 || id > math.MaxUint32 {
+		return 0, false
+	}
+	return uint32(id), true
+}
+
 // MakeRangeIDKey creates a range-local key based on the range's
 // Raft ID, metadata key suffix, and optional detail (e.g. the
 // encoded command ID for a response cache entry, etc.).
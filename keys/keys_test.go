@@ -19,6 +19,7 @@ package keys
 
 import (
 	"bytes"
+	"math"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/proto"
@@ -65,6 +66,14 @@ func TestKeyAddress(t *testing.T) {
 		{TransactionKey(proto.KeyMax, proto.Key(util.NewUUID4())), proto.KeyMax},
 		{MakeNamespaceMetadataKey("foo"), proto.Key("\x00ns-foo")},
 		{MakeTableMetadataKey(123, "bar"), proto.Key("\x00tbl-\t{bar")},
+		{MakeDescMetadataKey(123), proto.Key("\x00desc-\t{")},
+		{MakeDroppedTableKey(123), proto.Key("\x00dropped-\t{")},
+		{MakeTableTTLKey(123), proto.Key("\x00ttl-\t{")},
+		{MakeTableStatsKey(123), proto.Key("\x00stats-\t{")},
+		{MakeSchemaJobKey(123), proto.Key("\x00job-\t{")},
+		{MakeTablePrefix(123), proto.Key("\t{")},
+		{MakeRowKey(123, 1, []byte("a")), proto.Key("\t{\t\x01a")},
+		{MakeCellKey(MakeRowKey(123, 1, []byte("a")), 2), proto.Key("\t{\t\x01a\t\x02")},
 		{nil, nil},
 	}
 	for i, test := range testCases {
@@ -180,3 +189,28 @@ func TestValidateRangeMetaKey(t *testing.T) {
 		}
 	}
 }
+
+func TestDecodeCellKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	rowKey := MakeRowKey(1, 1, []byte("a"))
+	testCases := []struct {
+		key   proto.Key
+		expID uint32
+		expOK bool
+	}{
+		{MakeCellKey(rowKey, 1), 1, true},
+		{MakeCellKey(rowKey, 1000), 1000, true},
+		{MakeCellKey(rowKey, math.MaxUint32), math.MaxUint32, true},
+		{rowKey, 0, false},
+		{MakeCellKey(rowKey, 0), 0, false},
+		{MakeKey(MakeCellKey(rowKey, 1), proto.Key("b")), 0, false},
+		{MakeCellKey(MakeRowKey(1, 1, []byte("b")), 1), 0, false},
+		{MakeKey(rowKey, proto.Key("\x0a\x01")), 0, false},
+	}
+	for i, test := range testCases {
+		id, ok := DecodeCellKey(rowKey, test.key)
+		if id != test.expID || ok != test.expOK {
+			t.Errorf("%d: expected %d, %t, but found %d, %t", i, test.expID, test.expOK, id, ok)
+		}
+	}
+}
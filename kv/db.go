@@ -59,6 +59,13 @@ var allPublicMethods = map[string]proto.Method{
 	proto.DeleteRange.String():    proto.DeleteRange,
 	proto.Scan.String():           proto.Scan,
 	proto.EndTransaction.String(): proto.EndTransaction,
+	proto.GetRow.String():         proto.GetRow,
+	proto.PutRow.String():         proto.PutRow,
+	proto.DeleteRow.String():      proto.DeleteRow,
+	proto.ScanRows.String():       proto.ScanRows,
+	proto.LockRow.String():        proto.LockRow,
+	proto.PutUnique.String():      proto.PutUnique,
+	proto.ScanChanges.String():    proto.ScanChanges,
 	proto.Batch.String():          proto.Batch,
 	proto.AdminSplit.String():     proto.AdminSplit,
 	proto.AdminMerge.String():     proto.AdminMerge,
@@ -88,6 +95,20 @@ func createArgsAndReply(method string) (proto.Request, proto.Response) {
 			return &proto.ScanRequest{}, &proto.ScanResponse{}
 		case proto.EndTransaction:
 			return &proto.EndTransactionRequest{}, &proto.EndTransactionResponse{}
+		case proto.GetRow:
+			return &proto.GetRowRequest{}, &proto.GetRowResponse{}
+		case proto.PutRow:
+			return &proto.PutRowRequest{}, &proto.PutRowResponse{}
+		case proto.DeleteRow:
+			return &proto.DeleteRowRequest{}, &proto.DeleteRowResponse{}
+		case proto.ScanRows:
+			return &proto.ScanRowsRequest{}, &proto.ScanRowsResponse{}
+		case proto.LockRow:
+			return &proto.LockRowRequest{}, &proto.LockRowResponse{}
+		case proto.PutUnique:
+			return &proto.PutUniqueRequest{}, &proto.PutUniqueResponse{}
+		case proto.ScanChanges:
+			return &proto.ScanChangesRequest{}, &proto.ScanChangesResponse{}
 		case proto.Batch:
 			return &proto.BatchRequest{}, &proto.BatchResponse{}
 		case proto.AdminSplit:
@@ -209,6 +230,34 @@ func (s *rpcDBServer) EndTransaction(args *proto.EndTransactionRequest, reply *p
 	return s.executeCmd(args, reply)
 }
 
+func (s *rpcDBServer) GetRow(args *proto.GetRowRequest, reply *proto.GetRowResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+func (s *rpcDBServer) PutRow(args *proto.PutRowRequest, reply *proto.PutRowResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+func (s *rpcDBServer) DeleteRow(args *proto.DeleteRowRequest, reply *proto.DeleteRowResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+func (s *rpcDBServer) ScanRows(args *proto.ScanRowsRequest, reply *proto.ScanRowsResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+func (s *rpcDBServer) LockRow(args *proto.LockRowRequest, reply *proto.LockRowResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+func (s *rpcDBServer) PutUnique(args *proto.PutUniqueRequest, reply *proto.PutUniqueResponse) error {
+	return s.executeCmd(args, reply)
+}
+
+func (s *rpcDBServer) ScanChanges(args *proto.ScanChangesRequest, reply *proto.ScanChangesResponse) error {
+	return s.executeCmd(args, reply)
+}
+
 func (s *rpcDBServer) Batch(args *proto.BatchRequest, reply *proto.BatchResponse) error {
 	return s.executeCmd(args, reply)
 }
@@ -268,6 +268,10 @@ func (ds *DistSender) verifyPermissions(args proto.Request) error {
 // lookupOptions capture additional options to pass to InternalRangeLookup.
 type lookupOptions struct {
 	ignoreIntents bool
+	// reverse looks up the range whose end key is the first one at or
+	// after the requested key, i.e. the range containing the keys just
+	// before it, and pre-fetches the ranges preceding it.
+	reverse bool
 }
 
 // internalRangeLookup dispatches an InternalRangeLookup request for the given
@@ -288,6 +292,7 @@ func (ds *DistSender) internalRangeLookup(key proto.Key, options lookupOptions,
 		},
 		MaxRanges:     ds.rangeLookupMaxRanges,
 		IgnoreIntents: options.ignoreIntents,
+		Reverse:       options.reverse,
 	}
 	reply := &proto.InternalRangeLookupResponse{}
 	replicas := newReplicaSlice(ds.gossip, desc)
@@ -344,8 +349,11 @@ func (ds *DistSender) getRangeDescriptors(key proto.Key, options lookupOptions)
 		}
 	} else {
 		// Look up desc from the cache, which will recursively call into
-		// ds.getRangeDescriptors if it is not cached.
-		desc, err = ds.rangeCache.LookupRangeDescriptor(metadataKey, options)
+		// ds.getRangeDescriptors if it is not cached. The metadata range
+		// is the one containing metadataKey even for a reverse lookup.
+		metaOptions := options
+		metaOptions.reverse = false
+		desc, err = ds.rangeCache.LookupRangeDescriptor(metadataKey, metaOptions)
 		if err != nil {
 			return nil, err
 		}
@@ -562,7 +570,9 @@ func (ds *DistSender) sendAttempt(desc *proto.RangeDescriptor, call client.Call)
 // getDescriptors takes a call and looks up the corresponding range descriptors
 // associated to it. First, the range descriptor for call.Args.Key is looked up;
 // second, if call.Args.EndKey exceeds that of the returned descriptor, the
-// next descriptor is obtained as well.
+// next descriptor is obtained as well. For a reverse scan, the ranges are
+// addressed backwards from call.Args.EndKey instead, and the next descriptor
+// is the one preceding the returned descriptor.
 func (ds *DistSender) getDescriptors(call client.Call) (*proto.RangeDescriptor, *proto.RangeDescriptor, error) {
 	// If this is an InternalPushTxn, set ignoreIntents option as
 	// necessary. This prevents a potential infinite loop; see the
@@ -571,31 +581,50 @@ func (ds *DistSender) getDescriptors(call client.Call) (*proto.RangeDescriptor,
 	if pushArgs, ok := call.Args.(*proto.InternalPushTxnRequest); ok {
 		options.ignoreIntents = pushArgs.RangeLookup
 	}
+	if scanArgs, ok := call.Args.(*proto.ScanRequest); ok {
+		options.reverse = scanArgs.Reverse
+	}
 
-	desc, err := ds.rangeCache.LookupRangeDescriptor(call.Args.Header().Key, options)
+	var desc *proto.RangeDescriptor
+	var err error
+	if options.reverse {
+		desc, err = ds.rangeCache.LookupRangeDescriptor(call.Args.Header().EndKey, options)
+	} else {
+		desc, err = ds.rangeCache.LookupRangeDescriptor(call.Args.Header().Key, options)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
 
 	var descNext *proto.RangeDescriptor
-	// If the request accesses keys beyond the end of this range,
-	// get the descriptor of the adjacent range to address next.
-	if desc.EndKey.Less(call.Args.Header().EndKey) {
+	// If the request accesses keys beyond the end of this range (or,
+	// for a reverse scan, before its start), get the descriptor of the
+	// adjacent range to address next.
+	if (!options.reverse && desc.EndKey.Less(call.Args.Header().EndKey)) ||
+		(options.reverse && call.Args.Header().Key.Less(desc.StartKey)) {
 		if _, ok := call.Reply.(proto.Combinable); !ok {
 			return nil, nil, util.Error("illegal cross-range operation")
 		}
 		// If there's no transaction and op spans ranges, possibly
 		// re-run as part of a transaction for consistency. The
 		// case where we don't need to re-run is if the read
-		// consistency is not required.
-		if call.Args.Header().Txn == nil &&
+		// consistency is not required, or for reads at an explicit
+		// timestamp, such as ScanChanges (see Send), which read every
+		// range at the same timestamp.
+		fixedRead := proto.IsReadOnly(call.Args) &&
+			!call.Args.Header().Timestamp.Equal(proto.ZeroTimestamp)
+		if call.Args.Header().Txn == nil && !fixedRead &&
 			call.Args.Header().ReadConsistency != proto.INCONSISTENT {
 			return nil, nil, &proto.OpRequiresTxnError{}
 		}
 		// This next lookup is likely for free since we've read the
 		// previous descriptor and range lookups use cache
 		// prefetching.
-		descNext, err = ds.rangeCache.LookupRangeDescriptor(desc.EndKey, options)
+		if options.reverse {
+			descNext, err = ds.rangeCache.LookupRangeDescriptor(desc.StartKey, options)
+		} else {
+			descNext, err = ds.rangeCache.LookupRangeDescriptor(desc.EndKey, options)
+		}
 		if err != nil {
 			return nil, nil, err
 		}
@@ -609,13 +638,15 @@ func (ds *DistSender) getDescriptors(call client.Call) (*proto.RangeDescriptor,
 //
 // If the request spans multiple ranges (which is possible for Scan or
 // DeleteRange requests), Send sends requests to the individual ranges
-// sequentially and combines the results transparently.
+// sequentially and combines the results transparently. A reverse scan
+// visits the ranges in descending order.
 //
 // This may temporarily adjust the request headers, so the client.Call
 // must not be used concurrently until Send has returned.
 func (ds *DistSender) Send(_ context.Context, call client.Call) {
 	args := call.Args
 	finalReply := call.Reply
+	startKey := args.Header().Key
 	endKey := args.Header().EndKey
 
 	// Verify permissions.
@@ -625,8 +656,11 @@ func (ds *DistSender) Send(_ context.Context, call client.Call) {
 	}
 
 	// In the event that timestamp isn't set and read consistency isn't
-	// required, set the timestamp using the local clock.
-	if args.Header().ReadConsistency == proto.INCONSISTENT && args.Header().Timestamp.Equal(proto.ZeroTimestamp) {
+	// required, set the timestamp using the local clock. The same applies
+	// to ScanChanges, whose timestamp bounds the interval read from every
+	// range it spans.
+	_, scanChanges := args.(*proto.ScanChangesRequest)
+	if (args.Header().ReadConsistency == proto.INCONSISTENT || scanChanges) && args.Header().Timestamp.Equal(proto.ZeroTimestamp) {
 		// Make sure that after the call, args hasn't changed.
 		defer func(timestamp proto.Timestamp) {
 			args.Header().Timestamp = timestamp
@@ -644,6 +678,14 @@ func (ds *DistSender) Send(_ context.Context, call client.Call) {
 		}(boundedArgs.GetBound())
 	}
 
+	// A reverse scan returns the rows of each range in descending order
+	// and visits the ranges in descending order, so that the replies
+	// combine and the bound applies as for a forward scan.
+	var reverse bool
+	if sArgs, ok := args.(*proto.ScanRequest); ok {
+		reverse = sArgs.Reverse
+	}
+
 	// Retry logic for lookup of range by key and RPCs to range replicas.
 	retryOpts := ds.rpcRetryOptions
 	retryOpts.Tag = "routing " + call.Method().String() + " rpc"
@@ -668,11 +710,19 @@ func (ds *DistSender) Send(_ context.Context, call client.Call) {
 			// touch it unless we have to (it is illegal to send EndKey on
 			// commands which do not operate on ranges).
 			if descNext != nil {
-				args.Header().EndKey = desc.EndKey
-				defer func() {
-					// "Untruncate" EndKey to original.
-					args.Header().EndKey = endKey
-				}()
+				if reverse {
+					args.Header().Key = desc.StartKey
+					defer func() {
+						// "Untruncate" Key to original.
+						args.Header().Key = startKey
+					}()
+				} else {
+					args.Header().EndKey = desc.EndKey
+					defer func() {
+						// "Untruncate" EndKey to original.
+						args.Header().EndKey = endKey
+					}()
+				}
 			}
 			return ds.sendAttempt(desc, call)
 		})
@@ -724,14 +774,24 @@ func (ds *DistSender) Send(_ context.Context, call client.Call) {
 			// so it's a convenient place to clean up changes to the args in
 			// the case of multi-range requests.
 			// Reset original start key (the EndKey is taken care of without
-			// defer above).
-			defer func(k proto.Key) {
-				args.Header().Key = k
-			}(args.Header().Key)
+			// defer above), or the original end key for a reverse scan.
+			if reverse {
+				defer func(k proto.Key) {
+					args.Header().EndKey = k
+				}(args.Header().EndKey)
+			} else {
+				defer func(k proto.Key) {
+					args.Header().Key = k
+				}(args.Header().Key)
+			}
 		}
 
 		// In next iteration, query next range.
-		args.Header().Key = descNext.StartKey
+		if reverse {
+			args.Header().EndKey = descNext.EndKey
+		} else {
+			args.Header().Key = descNext.StartKey
+		}
 
 		// This is a multi-range request, make a new reply object for
 		// subsequent iterations of the loop.
@@ -161,6 +161,41 @@ func TestMultiRangeScanInconsistent(t *testing.T) {
 	}
 }
 
+// TestMultiRangeScanAtTimestamp verifies that a consistent scan across
+// ranges at an explicit timestamp reads every range at that timestamp
+// and doesn't receive an OpRequiresTxnError.
+func TestMultiRangeScanAtTimestamp(t *testing.T) {
+	s, db := setupMultipleRanges(t)
+	defer s.Stop()
+
+	// Write keys "a" and "b".
+	b := &client.Batch{}
+	for _, key := range []string{"a", "b"} {
+		b.Put(key, "value")
+	}
+	if err := db.Run(b); err != nil {
+		t.Fatal(err)
+	}
+
+	// Scan just before key "b" was written, which reads only key "a".
+	ds := kv.NewDistSender(&kv.DistSenderContext{Clock: s.Clock()}, s.Gossip())
+	call := client.Scan(proto.Key("a"), proto.Key("c"), 0)
+	sr := call.Reply.(*proto.ScanResponse)
+	sa := call.Args.(*proto.ScanRequest)
+	sa.Timestamp = proto.Timestamp{WallTime: b.Results[1].Rows[0].Timestamp.UnixNano() - 1}
+	sa.User = storage.UserRoot
+	ds.Send(context.Background(), call)
+	if err := sr.GoError(); err != nil {
+		t.Fatal(err)
+	}
+	if l := len(sr.Rows); l != 1 {
+		t.Fatalf("expected 1 row; got %d", l)
+	}
+	if key := string(sr.Rows[0].Key); key != "a" {
+		t.Errorf("expected key %q; got %q", "a", key)
+	}
+}
+
 // TestStartEqualsEndKeyScan verifies that specifying start==end on scan
 // returns an empty set.
 func TestStartEqualsEndKeyScan(t *testing.T) {
@@ -400,7 +400,7 @@ func TestEvictCacheOnError(t *testing.T) {
 		if cur := ds.leaderCache.Lookup(1); reflect.DeepEqual(cur, &proto.Replica{}) && !tc.shouldClearLeader {
 			t.Errorf("%d: leader cache eviction: shouldClearLeader=%t, but value is %v", i, tc.shouldClearLeader, cur)
 		}
-		_, cachedDesc := ds.rangeCache.getCachedRangeDescriptor(call.Args.Header().Key)
+		_, cachedDesc := ds.rangeCache.getCachedRangeDescriptor(call.Args.Header().Key, false)
 		if cachedDesc == nil != tc.shouldClearReplica {
 			t.Errorf("%d: unexpected second replica lookup behaviour: wanted=%t", i, tc.shouldClearReplica)
 		}
@@ -439,6 +439,69 @@ func TestRangeLookupOnPushTxnIgnoresIntents(t *testing.T) {
 	}
 }
 
+// TestReverseScanVisitsRangesDescending verifies that a reverse scan
+// spanning ranges addresses them backwards from the end key, truncates
+// the request to each range and combines the replies in order.
+func TestReverseScanVisitsRangesDescending(t *testing.T) {
+	g := makeTestGossip(t)
+	descs := []proto.RangeDescriptor{testRangeDescriptor, testRangeDescriptor}
+	descs[0].EndKey = proto.Key("m")
+	descs[1].RaftID = 2
+	descs[1].StartKey = proto.Key("m")
+
+	var spans [][2]proto.Key
+	var testFn rpcSendFn = func(_ rpc.Options, _ string, addrs []net.Addr, getArgs func(addr net.Addr) interface{}, getReply func() interface{}, _ *rpc.Context) ([]interface{}, error) {
+		header := getArgs(addrs[0]).(proto.Request).Header()
+		spans = append(spans, [2]proto.Key{header.Key, header.EndKey})
+		reply := getReply().(*proto.ScanResponse)
+		reply.Rows = []proto.KeyValue{{Key: header.EndKey}}
+		return []interface{}{reply}, nil
+	}
+
+	ctx := &DistSenderContext{
+		rpcSend: testFn,
+		rangeDescriptorDB: mockRangeDescriptorDB(func(k proto.Key, opts lookupOptions) ([]proto.RangeDescriptor, error) {
+			if !opts.reverse {
+				t.Fatalf("expected reverse lookup of %q", k)
+			}
+			if k.Less(proto.Key("n")) {
+				return descs[:1], nil
+			}
+			return []proto.RangeDescriptor{descs[1], descs[0]}, nil
+		}),
+	}
+	ds := NewDistSender(ctx, g)
+	call := client.Call{
+		Args: &proto.ScanRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:             proto.Key("b"),
+				EndKey:          proto.Key("x"),
+				ReadConsistency: proto.INCONSISTENT,
+			},
+			Reverse: true,
+		},
+		Reply: &proto.ScanResponse{},
+	}
+	ds.Send(context.Background(), call)
+	if err := call.Reply.Header().GoError(); err != nil {
+		t.Fatal(err)
+	}
+	expSpans := [][2]proto.Key{{proto.Key("m"), proto.Key("x")}, {proto.Key("b"), proto.Key("m")}}
+	if !reflect.DeepEqual(spans, expSpans) {
+		t.Errorf("expected spans %q; got %q", expSpans, spans)
+	}
+	var rows []proto.Key
+	for _, kv := range call.Reply.(*proto.ScanResponse).Rows {
+		rows = append(rows, kv.Key)
+	}
+	if expRows := []proto.Key{proto.Key("x"), proto.Key("m")}; !reflect.DeepEqual(rows, expRows) {
+		t.Errorf("expected rows %q; got %q", expRows, rows)
+	}
+	if header := call.Args.Header(); !header.Key.Equal(proto.Key("b")) || !header.EndKey.Equal(proto.Key("x")) {
+		t.Errorf("expected the request span to be restored; got %q-%q", header.Key, header.EndKey)
+	}
+}
+
 // TestRetryOnWrongReplicaError sets up a DistSender on a minimal gossip
 // network and a mock of rpc.Send, and verifies that the DistSender correctly
 // retries upon encountering a stale entry in its range descriptor cache.
@@ -107,10 +107,13 @@ func (rmc *rangeDescriptorCache) stringLocked() string {
 // cached for subsequent lookups.
 //
 // This method returns the RangeDescriptor for the range containing
-// the key's data, or an error if any occurred.
+// the key's data, or an error if any occurred. If options.reverse is
+// set, it instead returns the RangeDescriptor for the range containing
+// the data just before the key, i.e. the range which ends at or after
+// the key and starts before it.
 func (rmc *rangeDescriptorCache) LookupRangeDescriptor(key proto.Key,
 	options lookupOptions) (*proto.RangeDescriptor, error) {
-	if _, r := rmc.getCachedRangeDescriptor(key); r != nil {
+	if _, r := rmc.getCachedRangeDescriptor(key, options.reverse); r != nil {
 		return r, nil
 	}
 
@@ -160,7 +163,7 @@ func (rmc *rangeDescriptorCache) EvictCachedRangeDescriptor(descKey proto.Key, s
 	rmc.rangeCacheMu.Lock()
 	defer rmc.rangeCacheMu.Unlock()
 
-	rngKey, cachedDesc := rmc.getCachedRangeDescriptorLocked(descKey)
+	rngKey, cachedDesc := rmc.getCachedRangeDescriptorLocked(descKey, false)
 	// Note that we're doing a "compare-and-erase": If seenDesc is not nil,
 	// we want to clean the cache only if it equals the cached range
 	// descriptor as a pointer. If not, then likely some other caller
@@ -183,7 +186,7 @@ func (rmc *rangeDescriptorCache) EvictCachedRangeDescriptor(descKey proto.Key, s
 		// evict that key as well. This loop ends after the meta1 range, which
 		// returns KeyMin as its metadata key.
 		descKey = keys.RangeMetaKey(descKey)
-		rngKey, cachedDesc = rmc.getCachedRangeDescriptorLocked(descKey)
+		rngKey, cachedDesc = rmc.getCachedRangeDescriptorLocked(descKey, false)
 	}
 }
 
@@ -191,22 +194,28 @@ func (rmc *rangeDescriptorCache) EvictCachedRangeDescriptor(descKey proto.Key, s
 // the range which contains the given key, if present in the cache. It
 // acquires a read lock on rmc.rangeCacheMu before delegating to
 // getCachedRangeDescriptorLocked.
-func (rmc *rangeDescriptorCache) getCachedRangeDescriptor(key proto.Key) (
+func (rmc *rangeDescriptorCache) getCachedRangeDescriptor(key proto.Key, reverse bool) (
 	rangeCacheKey, *proto.RangeDescriptor) {
 	rmc.rangeCacheMu.RLock()
 	defer rmc.rangeCacheMu.RUnlock()
-	return rmc.getCachedRangeDescriptorLocked(key)
+	return rmc.getCachedRangeDescriptorLocked(key, reverse)
 }
 
 // getCachedRangeDescriptorLocked is a helper function to retrieve the
 // descriptor of the range which contains the given key, if present in the
 // cache. It is assumed that the caller holds a read lock on rmc.rangeCacheMu.
-func (rmc *rangeDescriptorCache) getCachedRangeDescriptorLocked(key proto.Key) (
+// If reverse is set, the range which ends at or after the key and starts
+// before it is returned instead.
+func (rmc *rangeDescriptorCache) getCachedRangeDescriptorLocked(key proto.Key, reverse bool) (
 	rangeCacheKey, *proto.RangeDescriptor) {
 	// We want to look up the range descriptor for key. The cache is
 	// indexed using the end-key of the range, but the end-key is
-	// non-inclusive. So we access the cache using key.Next().
+	// non-inclusive. So we access the cache using key.Next(), unless
+	// we're looking for the range that ends at the key.
 	metaKey := keys.RangeMetaKey(key.Next())
+	if reverse {
+		metaKey = keys.RangeMetaKey(key)
+	}
 
 	k, v, ok := rmc.rangeCache.Ceil(rangeCacheKey(metaKey))
 	if !ok {
@@ -216,7 +225,12 @@ func (rmc *rangeDescriptorCache) getCachedRangeDescriptorLocked(key proto.Key) (
 	rd := v.(*proto.RangeDescriptor)
 
 	// Check that key actually belongs to range
-	if !rd.ContainsKey(keys.KeyAddress(key)) {
+	addr := keys.KeyAddress(key)
+	if reverse {
+		if !rd.StartKey.Less(addr) || rd.EndKey.Less(addr) {
+			return nil, nil
+		}
+	} else if !rd.ContainsKey(addr) {
 		return nil, nil
 	}
 	return metaEndKey, rd
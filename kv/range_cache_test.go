@@ -213,4 +213,12 @@ func TestRangeCache(t *testing.T) {
 	doLookup(t, db.cache, "cz")
 	db.assertHitCount(t, 2)
 
+	// A reverse lookup of an end-key finds the range ending there rather
+	// than the one starting there.
+	if _, rd := db.cache.getCachedRangeDescriptor(proto.Key("b"), true); rd == nil || !rd.EndKey.Equal(proto.Key("b")) {
+		t.Errorf("expected reverse lookup of \"b\" to return the range ending at \"b\"; got %+v", rd)
+	}
+	if _, rd := db.cache.getCachedRangeDescriptor(proto.Key("b"), false); rd == nil || !rd.StartKey.Equal(proto.Key("b")) {
+		t.Errorf("expected lookup of \"b\" to return the range starting at \"b\"; got %+v", rd)
+	}
 }
@@ -418,7 +418,7 @@ func (tc *TxnCoordSender) sendOne(call client.Call) {
 					tc.txns[id] = txnMeta
 					tc.heartbeat(id)
 				}
-				txnMeta.addKeyRange(header.Key, header.EndKey)
+				txnMeta.addKeyRange(proto.KeySpan(call.Args))
 			}
 			// Update our record of this transaction.
 			if txnMeta != nil {
@@ -39,6 +39,7 @@ const (
 	isWrite
 	isTxnWrite
 	isRange
+	isRow
 )
 
 // IsAdmin returns true if the request requires admin permissions.
@@ -74,6 +75,24 @@ func IsRange(args Request) bool {
 	return (args.flags() & isRange) != 0
 }
 
+// IsRow returns true if the operation addresses a table row. The key of
+// a row operation is the row's sentinel key and the operation affects
+// every key prefixed by it.
+func IsRow(args Request) bool {
+	return (args.flags() & isRow) != 0
+}
+
+// KeySpan returns the span of keys affected by the request: [Key, EndKey)
+// for range operations and [Key, Key.PrefixEnd()) for row operations.
+// Otherwise, only Key is affected and the returned end key is nil.
+func KeySpan(args Request) (Key, Key) {
+	header := args.Header()
+	if IsRow(args) {
+		return header.Key, header.Key.PrefixEnd()
+	}
+	return header.Key, header.EndKey
+}
+
 // Request is an interface for RPC requests.
 type Request interface {
 	gogoproto.Message
@@ -142,6 +161,39 @@ func (sr *ScanResponse) Combine(c Response) {
 	}
 }
 
+// Combine implements the Combinable interface for ScanChangesResponse.
+func (sr *ScanChangesResponse) Combine(c Response) {
+	otherSR := c.(*ScanChangesResponse)
+	if sr != nil {
+		sr.Changes = append(sr.Changes, otherSR.GetChanges()...)
+		sr.Header().Combine(otherSR.Header())
+	}
+}
+
+// Combine implements the Combinable interface for ScanRowsResponse.
+func (sr *ScanRowsResponse) Combine(c Response) {
+	otherSR := c.(*ScanRowsResponse)
+	if sr != nil {
+		sr.Rows = append(sr.Rows, otherSR.GetRows()...)
+		sr.Header().Combine(otherSR.Header())
+		if len(sr.Aggregates) == 0 {
+			sr.Aggregates = otherSR.GetAggregates()
+			return
+		}
+		if len(otherSR.GetAggregates()) != len(sr.Aggregates) {
+			sr.Header().SetGoError(fmt.Errorf("cannot combine %d aggregates with %d",
+				len(sr.Aggregates), len(otherSR.GetAggregates())))
+			return
+		}
+		for i := range sr.Aggregates {
+			if err := sr.Aggregates[i].Merge(&otherSR.Aggregates[i]); err != nil {
+				sr.Header().SetGoError(err)
+				return
+			}
+		}
+	}
+}
+
 // Combine implements the Combinable interface for DeleteRangeResponse.
 func (dr *DeleteRangeResponse) Combine(c Response) {
 	otherDR := c.(*DeleteRangeResponse)
@@ -281,7 +333,7 @@ func (br *BatchResponse) Add(reply Response) {
 }
 
 // Bounded is implemented by request types which have a bounded number of
-// result rows, such as Scan.
+// result rows, such as Scan and DeleteRange.
 type Bounded interface {
 	GetBound() int64
 	SetBound(bound int64)
@@ -297,8 +349,38 @@ func (sr *ScanRequest) SetBound(bound int64) {
 	sr.MaxResults = bound
 }
 
+// GetBound returns the MaxRows field in ScanRowsRequest.
+func (sr *ScanRowsRequest) GetBound() int64 {
+	return sr.GetMaxRows()
+}
+
+// SetBound sets the MaxRows field in ScanRowsRequest.
+func (sr *ScanRowsRequest) SetBound(bound int64) {
+	sr.MaxRows = bound
+}
+
+// GetBound returns the MaxResults field in ScanChangesRequest.
+func (sr *ScanChangesRequest) GetBound() int64 {
+	return sr.GetMaxResults()
+}
+
+// SetBound sets the MaxResults field in ScanChangesRequest.
+func (sr *ScanChangesRequest) SetBound(bound int64) {
+	sr.MaxResults = bound
+}
+
+// GetBound returns the MaxEntriesToDelete field in DeleteRangeRequest.
+func (dr *DeleteRangeRequest) GetBound() int64 {
+	return dr.GetMaxEntriesToDelete()
+}
+
+// SetBound sets the MaxEntriesToDelete field in DeleteRangeRequest.
+func (dr *DeleteRangeRequest) SetBound(bound int64) {
+	dr.MaxEntriesToDelete = bound
+}
+
 // Countable is implemented by response types which have a number of
-// result rows, such as Scan.
+// result rows, such as Scan and DeleteRange.
 type Countable interface {
 	Count() int64
 }
@@ -308,6 +390,21 @@ func (sr *ScanResponse) Count() int64 {
 	return int64(len(sr.Rows))
 }
 
+// Count returns the number of rows in ScanRowsResponse.
+func (sr *ScanRowsResponse) Count() int64 {
+	return int64(len(sr.Rows))
+}
+
+// Count returns the number of versions in ScanChangesResponse.
+func (sr *ScanChangesResponse) Count() int64 {
+	return int64(len(sr.Changes))
+}
+
+// Count returns the number of deleted rows in DeleteRangeResponse.
+func (dr *DeleteRangeResponse) Count() int64 {
+	return dr.NumDeleted
+}
+
 // Method implements the Request interface.
 func (*GetRequest) Method() Method { return Get }
 
@@ -332,6 +429,27 @@ func (*ScanRequest) Method() Method { return Scan }
 // Method implements the Request interface.
 func (*EndTransactionRequest) Method() Method { return EndTransaction }
 
+// Method implements the Request interface.
+func (*GetRowRequest) Method() Method { return GetRow }
+
+// Method implements the Request interface.
+func (*PutRowRequest) Method() Method { return PutRow }
+
+// Method implements the Request interface.
+func (*DeleteRowRequest) Method() Method { return DeleteRow }
+
+// Method implements the Request interface.
+func (*ScanRowsRequest) Method() Method { return ScanRows }
+
+// Method implements the Request interface.
+func (*LockRowRequest) Method() Method { return LockRow }
+
+// Method implements the Request interface.
+func (*PutUniqueRequest) Method() Method { return PutUnique }
+
+// Method implements the Request interface.
+func (*ScanChangesRequest) Method() Method { return ScanChanges }
+
 // Method implements the Request interface.
 func (*BatchRequest) Method() Method { return Batch }
 
@@ -395,6 +513,27 @@ func (*ScanRequest) CreateReply() Response { return &ScanResponse{} }
 // CreateReply implements the Request interface.
 func (*EndTransactionRequest) CreateReply() Response { return &EndTransactionResponse{} }
 
+// CreateReply implements the Request interface.
+func (*GetRowRequest) CreateReply() Response { return &GetRowResponse{} }
+
+// CreateReply implements the Request interface.
+func (*PutRowRequest) CreateReply() Response { return &PutRowResponse{} }
+
+// CreateReply implements the Request interface.
+func (*DeleteRowRequest) CreateReply() Response { return &DeleteRowResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ScanRowsRequest) CreateReply() Response { return &ScanRowsResponse{} }
+
+// CreateReply implements the Request interface.
+func (*LockRowRequest) CreateReply() Response { return &LockRowResponse{} }
+
+// CreateReply implements the Request interface.
+func (*PutUniqueRequest) CreateReply() Response { return &PutUniqueResponse{} }
+
+// CreateReply implements the Request interface.
+func (*ScanChangesRequest) CreateReply() Response { return &ScanChangesResponse{} }
+
 // CreateReply implements the Request interface.
 func (*BatchRequest) CreateReply() Response { return &BatchResponse{} }
 
@@ -444,6 +583,13 @@ func (*DeleteRequest) flags() int                     { return isWrite | isTxnWr
 func (*DeleteRangeRequest) flags() int                { return isWrite | isTxnWrite | isRange }
 func (*ScanRequest) flags() int                       { return isRead | isRange }
 func (*EndTransactionRequest) flags() int             { return isWrite }
+func (*GetRowRequest) flags() int                     { return isRead | isRow }
+func (*PutRowRequest) flags() int                     { return isWrite | isTxnWrite | isRow }
+func (*DeleteRowRequest) flags() int                  { return isWrite | isTxnWrite | isRow }
+func (*ScanRowsRequest) flags() int                   { return isRead | isRange }
+func (*LockRowRequest) flags() int                    { return isRead | isWrite | isTxnWrite | isRow }
+func (*PutUniqueRequest) flags() int                  { return isRead | isWrite | isTxnWrite }
+func (*ScanChangesRequest) flags() int                { return isRead | isRange }
 func (*BatchRequest) flags() int                      { return isWrite }
 func (*AdminSplitRequest) flags() int                 { return isAdmin }
 func (*AdminMergeRequest) flags() int                 { return isAdmin }
@@ -140,6 +140,31 @@ func TestCombinable(t *testing.T) {
 	}
 }
 
+func TestBounded(t *testing.T) {
+	for _, args := range []Request{&ScanRequest{}, &DeleteRangeRequest{}} {
+		bounded, ok := args.(Bounded)
+		if !ok {
+			t.Fatalf("%T does not implement Bounded", args)
+		}
+		bounded.SetBound(10)
+		if bound := bounded.GetBound(); bound != 10 {
+			t.Errorf("%T: expected bound 10, got %d", args, bound)
+		}
+	}
+	for _, reply := range []Response{
+		&ScanResponse{Rows: []KeyValue{{}, {}}},
+		&DeleteRangeResponse{NumDeleted: 2},
+	} {
+		countable, ok := reply.(Countable)
+		if !ok {
+			t.Fatalf("%T does not implement Countable", reply)
+		}
+		if count := countable.Count(); count != 2 {
+			t.Errorf("%T: expected count 2, got %d", reply, count)
+		}
+	}
+}
+
 func TestSetGoErrorCopy(t *testing.T) {
 	rh := ResponseHeader{}
 	err := &Error{Message: "test123"}
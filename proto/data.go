@@ -19,6 +19,7 @@ package proto
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/rand"
@@ -195,9 +196,14 @@ func (k *Key) Unmarshal(bytes []byte) error {
 // The following methods implement custom unmarshalling necessary
 // for key objects to be converted from JSON.
 
-// UnmarshalJSON implements the json Unmarshaler interface.
+// UnmarshalJSON implements the json Unmarshaler interface. Like other
+// byte slices, keys are encoded as base64 strings.
 func (k *Key) UnmarshalJSON(bytes []byte) error {
-	*k = Key(append([]byte(nil), bytes...))
+	var b []byte
+	if err := json.Unmarshal(bytes, &b); err != nil {
+		return err
+	}
+	*k = Key(b)
 	return nil
 }
 
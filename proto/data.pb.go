@@ -697,7 +697,11 @@ type GCMetadata struct {
 	// The oldest unresolved write intent in nanoseconds since epoch.
 	// Null if there are no unresolved write intents.
 	OldestIntentNanos *int64 `protobuf:"varint,2,opt,name=oldest_intent_nanos" json:"oldest_intent_nanos,omitempty"`
-	XXX_unrecognized  []byte `json:"-"`
+	// The timestamp in nanoseconds since epoch before which superseded
+	// versions have been garbage collected. Null if no versions have been
+	// garbage collected by age.
+	ThresholdNanos   *int64 `protobuf:"varint,3,opt,name=threshold_nanos" json:"threshold_nanos,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *GCMetadata) Reset()         { *m = GCMetadata{} }
@@ -718,6 +722,13 @@ func (m *GCMetadata) GetOldestIntentNanos() int64 {
 	return 0
 }
 
+func (m *GCMetadata) GetThresholdNanos() int64 {
+	if m != nil && m.ThresholdNanos != nil {
+		return *m.ThresholdNanos
+	}
+	return 0
+}
+
 // MVCCStats tracks byte and instance counts for:
 //  - Live key/values (i.e. what a scan at current time will reveal;
 //    note that this includes intent keys and values, but not keys and
@@ -2565,6 +2576,23 @@ func (m *GCMetadata) Unmarshal(data []byte) error {
 				}
 			}
 			m.OldestIntentNanos = &v
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThresholdNanos", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ThresholdNanos = &v
 		default:
 			var sizeOfWire int
 			for {
@@ -3088,6 +3116,9 @@ func (m *GCMetadata) Size() (n int) {
 	if m.OldestIntentNanos != nil {
 		n += 1 + sovData(uint64(*m.OldestIntentNanos))
 	}
+	if m.ThresholdNanos != nil {
+		n += 1 + sovData(uint64(*m.ThresholdNanos))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3773,6 +3804,11 @@ func (m *GCMetadata) MarshalTo(data []byte) (n int, err error) {
 		i++
 		i = encodeVarintData(data, i, uint64(*m.OldestIntentNanos))
 	}
+	if m.ThresholdNanos != nil {
+		data[i] = 0x18
+		i++
+		i = encodeVarintData(data, i, uint64(*m.ThresholdNanos))
+	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
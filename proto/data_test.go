@@ -19,6 +19,7 @@ package proto
 
 import (
 	"bytes"
+	"encoding/json"
 	"math"
 	"math/rand"
 	"testing"
@@ -166,6 +167,22 @@ func TestKeyString(t *testing.T) {
 	}
 }
 
+func TestKeyJSON(t *testing.T) {
+	for i, k := range []Key{Key("hello"), KeyMax, Key{}} {
+		b, err := json.Marshal(k)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var decoded Key
+		if err := json.Unmarshal(b, &decoded); err != nil {
+			t.Fatal(err)
+		}
+		if !decoded.Equal(k) {
+			t.Errorf("%d: expected %s, but found %s", i, k, decoded)
+		}
+	}
+}
+
 func makeTS(walltime int64, logical int32) Timestamp {
 	return Timestamp{
 		WallTime: walltime,
@@ -0,0 +1,474 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Expr is a parsed schema expression. Schema expressions are simple
+// expressions over the columns of a single row, such as "a + b" or
+// "lower(name)", used by computed columns and expression indexes.
+type Expr interface {
+	fmt.Stringer
+	// walk calls fn for the expression and each of its subexpressions.
+	walk(fn func(Expr))
+}
+
+// ColumnRef is an expression referencing the value of a column by name.
+type ColumnRef struct {
+	Name string
+}
+
+// Literal is a constant int64, float64 or string expression.
+type Literal struct {
+	Value interface{}
+}
+
+// FuncExpr is a call of one of the builtin functions.
+type FuncExpr struct {
+	Name string
+	Args []Expr
+}
+
+// BinaryExpr is an arithmetic expression. The "+" operator also
+// concatenates strings.
+type BinaryExpr struct {
+	Op          byte
+	Left, Right Expr
+}
+
+func (e ColumnRef) String() string { return e.Name }
+
+func (e Literal) String() string {
+	if s, ok := e.Value.(string); ok {
+		return "'" + strings.Replace(s, "'", "''", -1) + "'"
+	}
+	return fmt.Sprint(e.Value)
+}
+
+func (e FuncExpr) String() string {
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = arg.String()
+	}
+	return fmt.Sprintf("%s(%s)", e.Name, strings.Join(args, ", "))
+}
+
+func (e BinaryExpr) String() string {
+	return fmt.Sprintf("(%s %c %s)", e.Left, e.Op, e.Right)
+}
+
+func (e ColumnRef) walk(fn func(Expr)) { fn(e) }
+func (e Literal) walk(fn func(Expr))   { fn(e) }
+
+func (e FuncExpr) walk(fn func(Expr)) {
+	fn(e)
+	for _, arg := range e.Args {
+		arg.walk(fn)
+	}
+}
+
+func (e BinaryExpr) walk(fn func(Expr)) {
+	fn(e)
+	e.Left.walk(fn)
+	e.Right.walk(fn)
+}
+
+// ExprColumns returns the names of the columns referenced by the
+// expression in the order of their first appearance.
+func ExprColumns(e Expr) []string {
+	var names []string
+	seen := map[string]struct{}{}
+	e.walk(func(e Expr) {
+		if ref, ok := e.(ColumnRef); ok {
+			if _, ok := seen[ref.Name]; !ok {
+				seen[ref.Name] = struct{}{}
+				names = append(names, ref.Name)
+			}
+		}
+	})
+	return names
+}
+
+// RenameExprColumn returns a copy of the expression in which references to
+// the column oldName are replaced by references to newName.
+func RenameExprColumn(e Expr, oldName, newName string) Expr {
+	switch t := e.(type) {
+	case ColumnRef:
+		if t.Name == oldName {
+			return ColumnRef{Name: newName}
+		}
+	case FuncExpr:
+		args := make([]Expr, len(t.Args))
+		for i, arg := range t.Args {
+			args[i] = RenameExprColumn(arg, oldName, newName)
+		}
+		return FuncExpr{Name: t.Name, Args: args}
+	case BinaryExpr:
+		return BinaryExpr{
+			Op:    t.Op,
+			Left:  RenameExprColumn(t.Left, oldName, newName),
+			Right: RenameExprColumn(t.Right, oldName, newName),
+		}
+	}
+	return e
+}
+
+// builtins maps the name of each builtin function to its implementation
+// and the number of arguments it takes; -1 means variadic.
+var builtins = map[string]struct {
+	nArgs int
+	fn    func(args []interface{}) (interface{}, error)
+}{
+	"lower": {1, func(args []interface{}) (interface{}, error) {
+		s, err := stringArg("lower", args[0])
+		return strings.ToLower(s), err
+	}},
+	"upper": {1, func(args []interface{}) (interface{}, error) {
+		s, err := stringArg("upper", args[0])
+		return strings.ToUpper(s), err
+	}},
+	"length": {1, func(args []interface{}) (interface{}, error) {
+		s, err := stringArg("length", args[0])
+		return int64(len(s)), err
+	}},
+	"concat": {-1, func(args []interface{}) (interface{}, error) {
+		var buf bytes.Buffer
+		for _, arg := range args {
+			s, err := stringArg("concat", arg)
+			if err != nil {
+				return nil, err
+			}
+			buf.WriteString(s)
+		}
+		return buf.String(), nil
+	}},
+}
+
+func stringArg(name string, v interface{}) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case []byte:
+		return string(t), nil
+	}
+	return "", fmt.Errorf("%s: expected string argument, but found %T", name, v)
+}
+
+// ParseExpr parses a schema expression. The grammar supports column
+// references, integer, float and single-quoted string literals, calls of
+// the builtin functions (lower, upper, length and concat), the binary
+// operators +, -, * and / and parentheses.
+func ParseExpr(s string) (Expr, error) {
+	p := exprParser{s: s}
+	p.next()
+	e, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok != "" {
+		return nil, fmt.Errorf("unexpected %q at position %d in %q", p.tok, p.tokPos, s)
+	}
+	return e, nil
+}
+
+type exprParser struct {
+	s      string
+	pos    int
+	tok    string
+	tokPos int
+}
+
+// next advances to the next token. The empty token denotes the end of the
+// input.
+func (p *exprParser) next() {
+	for p.pos < len(p.s) && unicode.IsSpace(rune(p.s[p.pos])) {
+		p.pos++
+	}
+	p.tokPos = p.pos
+	if p.pos >= len(p.s) {
+		p.tok = ""
+		return
+	}
+	c := p.s[p.pos]
+	switch {
+	case c == '_' || unicode.IsLetter(rune(c)):
+		end := p.pos
+		for end < len(p.s) && (p.s[end] == '_' || unicode.IsLetter(rune(p.s[end])) ||
+			unicode.IsDigit(rune(p.s[end]))) {
+			end++
+		}
+		p.tok, p.pos = p.s[p.pos:end], end
+	case unicode.IsDigit(rune(c)):
+		end := p.pos
+		for end < len(p.s) && (p.s[end] == '.' || unicode.IsDigit(rune(p.s[end]))) {
+			end++
+		}
+		p.tok, p.pos = p.s[p.pos:end], end
+	case c == '\'':
+		end := p.pos + 1
+		for end < len(p.s) {
+			if p.s[end] == '\'' {
+				if end+1 < len(p.s) && p.s[end+1] == '\'' {
+					end += 2
+					continue
+				}
+				break
+			}
+			end++
+		}
+		if end >= len(p.s) {
+			// Unterminated; a lone quote is reported by the parser.
+			p.tok, p.pos = "'", end
+			return
+		}
+		p.tok, p.pos = p.s[p.pos:end+1], end+1
+	default:
+		p.tok, p.pos = p.s[p.pos:p.pos+1], p.pos+1
+	}
+}
+
+func (p *exprParser) parseSum() (Expr, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok == "+" || p.tok == "-" {
+		op := p.tok[0]
+		p.next()
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = BinaryExpr{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseProduct() (Expr, error) {
+	left, err := p.parseFactor()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok == "*" || p.tok == "/" {
+		op := p.tok[0]
+		p.next()
+		right, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		left = BinaryExpr{Op: op, Left: left, Right: right}
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseFactor() (Expr, error) {
+	tok, pos := p.tok, p.tokPos
+	if tok == "" {
+		return nil, fmt.Errorf("unexpected end of expression %q", p.s)
+	}
+	p.next()
+
+	switch c := tok[0]; {
+	case tok == "(":
+		e, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok != ")" {
+			return nil, fmt.Errorf("expected \")\" at position %d in %q", p.tokPos, p.s)
+		}
+		p.next()
+		return e, nil
+
+	case tok == "-":
+		e, err := p.parseFactor()
+		if err != nil {
+			return nil, err
+		}
+		return BinaryExpr{Op: '-', Left: Literal{Value: int64(0)}, Right: e}, nil
+
+	case c == '\'':
+		if len(tok) < 2 {
+			return nil, fmt.Errorf("unterminated string at position %d in %q", pos, p.s)
+		}
+		return Literal{Value: strings.Replace(tok[1:len(tok)-1], "''", "'", -1)}, nil
+
+	case unicode.IsDigit(rune(c)):
+		if i, err := strconv.ParseInt(tok, 10, 64); err == nil {
+			return Literal{Value: i}, nil
+		}
+		f, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q at position %d in %q", tok, pos, p.s)
+		}
+		return Literal{Value: f}, nil
+
+	case c == '_' || unicode.IsLetter(rune(c)):
+		if p.tok != "(" {
+			return ColumnRef{Name: tok}, nil
+		}
+		p.next()
+		name := strings.ToLower(tok)
+		builtin, ok := builtins[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown function %q", tok)
+		}
+		var args []Expr
+		for p.tok != ")" {
+			if len(args) > 0 {
+				if p.tok != "," {
+					return nil, fmt.Errorf("expected \",\" at position %d in %q", p.tokPos, p.s)
+				}
+				p.next()
+			}
+			arg, err := p.parseSum()
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+		}
+		p.next()
+		if builtin.nArgs >= 0 && len(args) != builtin.nArgs {
+			return nil, fmt.Errorf("%s: expected %d argument(s), but found %d",
+				name, builtin.nArgs, len(args))
+		}
+		return FuncExpr{Name: name, Args: args}, nil
+	}
+	return nil, fmt.Errorf("unexpected %q at position %d in %q", tok, pos, p.s)
+}
+
+// EvalExpr evaluates the expression against a row, given as a map from
+// column name to value. Integer values of any width are treated as int64
+// and float32 values as float64.
+func EvalExpr(e Expr, row map[string]interface{}) (interface{}, error) {
+	switch t := e.(type) {
+	case ColumnRef:
+		v, ok := row[t.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown column %q", t.Name)
+		}
+		return normalizeExprValue(v), nil
+
+	case Literal:
+		return t.Value, nil
+
+	case FuncExpr:
+		args := make([]interface{}, len(t.Args))
+		for i, arg := range t.Args {
+			v, err := EvalExpr(arg, row)
+			if err != nil {
+				return nil, err
+			}
+			args[i] = v
+		}
+		return builtins[t.Name].fn(args)
+
+	case BinaryExpr:
+		left, err := EvalExpr(t.Left, row)
+		if err != nil {
+			return nil, err
+		}
+		right, err := EvalExpr(t.Right, row)
+		if err != nil {
+			return nil, err
+		}
+		return evalBinary(t.Op, left, right)
+	}
+	return nil, fmt.Errorf("unsupported expression %T", e)
+}
+
+func normalizeExprValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case int:
+		return int64(t)
+	case int8:
+		return int64(t)
+	case int16:
+		return int64(t)
+	case int32:
+		return int64(t)
+	case uint:
+		return int64(t)
+	case uint8:
+		return int64(t)
+	case uint16:
+		return int64(t)
+	case uint32:
+		return int64(t)
+	case uint64:
+		return int64(t)
+	case float32:
+		return float64(t)
+	}
+	return v
+}
+
+func evalBinary(op byte, left, right interface{}) (interface{}, error) {
+	if l, ok := left.(string); ok {
+		if r, ok := right.(string); ok && op == '+' {
+			return l + r, nil
+		}
+	}
+	if l, ok := left.(int64); ok {
+		if r, ok := right.(int64); ok {
+			switch op {
+			case '+':
+				return l + r, nil
+			case '-':
+				return l - r, nil
+			case '*':
+				return l * r, nil
+			case '/':
+				if r == 0 {
+					return nil, fmt.Errorf("division by zero")
+				}
+				return l / r, nil
+			}
+		}
+	}
+	l, lok := toFloat(left)
+	r, rok := toFloat(right)
+	if !lok || !rok {
+		return nil, fmt.Errorf("unsupported operands for %c: %T and %T", op, left, right)
+	}
+	switch op {
+	case '+':
+		return l + r, nil
+	case '-':
+		return l - r, nil
+	case '*':
+		return l * r, nil
+	case '/':
+		return l / r, nil
+	}
+	return nil, fmt.Errorf("unsupported operator %c", op)
+}
+
+func toFloat(v interface{}) (float64, bool) {
+	switch t := v.(type) {
+	case int64:
+		return float64(t), true
+	case float64:
+		return t, true
+	}
+	return 0, false
+}
@@ -0,0 +1,150 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseExpr(t *testing.T) {
+	testData := []struct {
+		expr     string
+		expected string
+		columns  []string
+	}{
+		{"a", "a", []string{"a"}},
+		{"1", "1", nil},
+		{"1.5", "1.5", nil},
+		{"'it''s'", "'it''s'", nil},
+		{"a + b * 2", "(a + (b * 2))", []string{"a", "b"}},
+		{"(a + b) * 2", "((a + b) * 2)", []string{"a", "b"}},
+		{"a - b - c", "((a - b) - c)", []string{"a", "b", "c"}},
+		{"-a", "(0 - a)", []string{"a"}},
+		{"LOWER(name)", "lower(name)", []string{"name"}},
+		{"concat(first, ' ', last, first)", "concat(first, ' ', last, first)", []string{"first", "last"}},
+		{"concat()", "concat()", nil},
+	}
+	for i, d := range testData {
+		e, err := ParseExpr(d.expr)
+		if err != nil {
+			t.Errorf("%d: unexpected error parsing %q: %s", i, d.expr, err)
+			continue
+		}
+		if s := e.String(); s != d.expected {
+			t.Errorf("%d: expected %q, but found %q", i, d.expected, s)
+		}
+		if columns := ExprColumns(e); !reflect.DeepEqual(d.columns, columns) {
+			t.Errorf("%d: expected columns %q, but found %q", i, d.columns, columns)
+		}
+	}
+}
+
+func TestParseExprError(t *testing.T) {
+	testData := []struct {
+		expr string
+		err  string
+	}{
+		{"", `unexpected end of expression ""`},
+		{"a +", `unexpected end of expression "a +"`},
+		{"a b", `unexpected "b" at position 2 in "a b"`},
+		{"(a", `expected ")" at position 2 in "(a"`},
+		{"'abc", `unterminated string at position 0 in "'abc"`},
+		{"1.2.3", `invalid number "1.2.3" at position 0 in "1.2.3"`},
+		{"foo(a)", `unknown function "foo"`},
+		{"lower(a, b)", "lower: expected 1 argument(s), but found 2"},
+		{"lower(a b)", `expected "," at position 8 in "lower(a b)"`},
+		{"*", `unexpected "*" at position 0 in "*"`},
+	}
+	for i, d := range testData {
+		_, err := ParseExpr(d.expr)
+		if err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestRenameExprColumn(t *testing.T) {
+	testData := []struct {
+		expr     string
+		expected string
+	}{
+		{"a", "b"},
+		{"c", "c"},
+		{"a + 1", "(b + 1)"},
+		{"concat(a, 'a', lower(a), c)", "concat(b, 'a', lower(b), c)"},
+	}
+	for i, d := range testData {
+		e, err := ParseExpr(d.expr)
+		if err != nil {
+			t.Fatalf("%d: unexpected error parsing %q: %s", i, d.expr, err)
+		}
+		if s := RenameExprColumn(e, "a", "b").String(); s != d.expected {
+			t.Errorf("%d: expected %q, but found %q", i, d.expected, s)
+		}
+	}
+}
+
+func TestEvalExpr(t *testing.T) {
+	row := map[string]interface{}{
+		"i":    int32(7),
+		"u":    uint8(2),
+		"f":    float32(0.5),
+		"name": "Bob",
+		"raw":  []byte("Raw"),
+	}
+	testData := []struct {
+		expr     string
+		expected interface{}
+		err      string
+	}{
+		{"i", int64(7), ""},
+		{"i + u * 3", int64(13), ""},
+		{"i / u", int64(3), ""},
+		{"i - 10", int64(-3), ""},
+		{"i * f", float64(3.5), ""},
+		{"f / 2", float64(0.25), ""},
+		{"lower(name)", "bob", ""},
+		{"upper(raw)", "RAW", ""},
+		{"length(name)", int64(3), ""},
+		{"concat(name, '-', raw)", "Bob-Raw", ""},
+		{"name + '!'", "Bob!", ""},
+		{"i / 0", nil, "division by zero"},
+		{"name - 1", nil, "unsupported operands for -: string and int64"},
+		{"lower(i)", nil, "lower: expected string argument, but found int64"},
+		{"missing", nil, `unknown column "missing"`},
+	}
+	for i, d := range testData {
+		e, err := ParseExpr(d.expr)
+		if err != nil {
+			t.Fatalf("%d: unexpected error parsing %q: %s", i, d.expr, err)
+		}
+		v, err := EvalExpr(e, row)
+		if d.err != "" {
+			if err == nil || err.Error() != d.err {
+				t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%d: unexpected error evaluating %q: %s", i, d.expr, err)
+			continue
+		}
+		if !reflect.DeepEqual(d.expected, v) {
+			t.Errorf("%d: expected %v (%T), but found %v (%T)", i, d.expected, d.expected, v, v)
+		}
+	}
+}
@@ -108,7 +108,13 @@ type InternalRangeLookupRequest struct {
 	// be false in general, except for the case where the lookup is
 	// already in service of pushing intents on meta records. Attempting
 	// to resolve intents in this case would lead to infinite recursion.
-	IgnoreIntents    bool   `protobuf:"varint,3,opt,name=ignore_intents" json:"ignore_intents"`
+	IgnoreIntents bool `protobuf:"varint,3,opt,name=ignore_intents" json:"ignore_intents"`
+	// Reverse indicates that the range which is requested is the one
+	// holding the keys immediately before the key, that is, the first
+	// range whose end key is at or after it. The additional range
+	// descriptors returned are those of the preceding ranges, in
+	// descending order. The key must not be KeyMin.
+	Reverse          bool   `protobuf:"varint,4,opt,name=reverse" json:"reverse"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -130,6 +136,13 @@ func (m *InternalRangeLookupRequest) GetIgnoreIntents() bool {
 	return false
 }
 
+func (m *InternalRangeLookupRequest) GetReverse() bool {
+	if m != nil {
+		return m.Reverse
+	}
+	return false
+}
+
 // An InternalRangeLookupResponse is the return value from the
 // InternalRangeLookup() method. It returns metadata for the range
 // containing the requested key, optionally returning the metadata for
@@ -218,6 +231,13 @@ func (m *InternalGCRequest_GCKey) Reset()         { *m = InternalGCRequest_GCKey
 func (m *InternalGCRequest_GCKey) String() string { return proto1.CompactTextString(m) }
 func (*InternalGCRequest_GCKey) ProtoMessage()    {}
 
+func (m *InternalGCRequest_GCKey) GetKey() Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
 func (m *InternalGCRequest_GCKey) GetTimestamp() Timestamp {
 	if m != nil {
 		return m.Timestamp
@@ -480,6 +500,13 @@ type InternalRequestUnion struct {
 	DeleteRange                *DeleteRangeRequest                `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan                       *ScanRequest                       `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction             *EndTransactionRequest             `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	GetRow                     *GetRowRequest                     `protobuf:"bytes,10,opt,name=get_row" json:"get_row,omitempty"`
+	PutRow                     *PutRowRequest                     `protobuf:"bytes,11,opt,name=put_row" json:"put_row,omitempty"`
+	DeleteRow                  *DeleteRowRequest                  `protobuf:"bytes,12,opt,name=delete_row" json:"delete_row,omitempty"`
+	ScanRows                   *ScanRowsRequest                   `protobuf:"bytes,13,opt,name=scan_rows" json:"scan_rows,omitempty"`
+	LockRow                    *LockRowRequest                    `protobuf:"bytes,14,opt,name=lock_row" json:"lock_row,omitempty"`
+	PutUnique                  *PutUniqueRequest                  `protobuf:"bytes,15,opt,name=put_unique" json:"put_unique,omitempty"`
+	ScanChanges                *ScanChangesRequest                `protobuf:"bytes,16,opt,name=scan_changes" json:"scan_changes,omitempty"`
 	InternalPushTxn            *InternalPushTxnRequest            `protobuf:"bytes,30,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent      *InternalResolveIntentRequest      `protobuf:"bytes,31,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
 	InternalResolveIntentRange *InternalResolveIntentRangeRequest `protobuf:"bytes,32,opt,name=internal_resolve_intent_range" json:"internal_resolve_intent_range,omitempty"`
@@ -546,6 +573,55 @@ func (m *InternalRequestUnion) GetEndTransaction() *EndTransactionRequest {
 	return nil
 }
 
+func (m *InternalRequestUnion) GetGetRow() *GetRowRequest {
+	if m != nil {
+		return m.GetRow
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetPutRow() *PutRowRequest {
+	if m != nil {
+		return m.PutRow
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetDeleteRow() *DeleteRowRequest {
+	if m != nil {
+		return m.DeleteRow
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetScanRows() *ScanRowsRequest {
+	if m != nil {
+		return m.ScanRows
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetLockRow() *LockRowRequest {
+	if m != nil {
+		return m.LockRow
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetPutUnique() *PutUniqueRequest {
+	if m != nil {
+		return m.PutUnique
+	}
+	return nil
+}
+
+func (m *InternalRequestUnion) GetScanChanges() *ScanChangesRequest {
+	if m != nil {
+		return m.ScanChanges
+	}
+	return nil
+}
+
 func (m *InternalRequestUnion) GetInternalPushTxn() *InternalPushTxnRequest {
 	if m != nil {
 		return m.InternalPushTxn
@@ -578,6 +654,13 @@ type InternalResponseUnion struct {
 	DeleteRange                *DeleteRangeResponse                `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan                       *ScanResponse                       `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction             *EndTransactionResponse             `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	GetRow                     *GetRowResponse                     `protobuf:"bytes,10,opt,name=get_row" json:"get_row,omitempty"`
+	PutRow                     *PutRowResponse                     `protobuf:"bytes,11,opt,name=put_row" json:"put_row,omitempty"`
+	DeleteRow                  *DeleteRowResponse                  `protobuf:"bytes,12,opt,name=delete_row" json:"delete_row,omitempty"`
+	ScanRows                   *ScanRowsResponse                   `protobuf:"bytes,13,opt,name=scan_rows" json:"scan_rows,omitempty"`
+	LockRow                    *LockRowResponse                    `protobuf:"bytes,14,opt,name=lock_row" json:"lock_row,omitempty"`
+	PutUnique                  *PutUniqueResponse                  `protobuf:"bytes,15,opt,name=put_unique" json:"put_unique,omitempty"`
+	ScanChanges                *ScanChangesResponse                `protobuf:"bytes,16,opt,name=scan_changes" json:"scan_changes,omitempty"`
 	InternalPushTxn            *InternalPushTxnResponse            `protobuf:"bytes,30,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent      *InternalResolveIntentResponse      `protobuf:"bytes,31,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
 	InternalResolveIntentRange *InternalResolveIntentRangeResponse `protobuf:"bytes,32,opt,name=internal_resolve_intent_range" json:"internal_resolve_intent_range,omitempty"`
@@ -644,6 +727,55 @@ func (m *InternalResponseUnion) GetEndTransaction() *EndTransactionResponse {
 	return nil
 }
 
+func (m *InternalResponseUnion) GetGetRow() *GetRowResponse {
+	if m != nil {
+		return m.GetRow
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetPutRow() *PutRowResponse {
+	if m != nil {
+		return m.PutRow
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetDeleteRow() *DeleteRowResponse {
+	if m != nil {
+		return m.DeleteRow
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetScanRows() *ScanRowsResponse {
+	if m != nil {
+		return m.ScanRows
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetLockRow() *LockRowResponse {
+	if m != nil {
+		return m.LockRow
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetPutUnique() *PutUniqueResponse {
+	if m != nil {
+		return m.PutUnique
+	}
+	return nil
+}
+
+func (m *InternalResponseUnion) GetScanChanges() *ScanChangesResponse {
+	if m != nil {
+		return m.ScanChanges
+	}
+	return nil
+}
+
 func (m *InternalResponseUnion) GetInternalPushTxn() *InternalPushTxnResponse {
 	if m != nil {
 		return m.InternalPushTxn
@@ -716,6 +848,9 @@ type ReadWriteCmdResponse struct {
 	Delete                     *DeleteResponse                     `protobuf:"bytes,4,opt,name=delete" json:"delete,omitempty"`
 	DeleteRange                *DeleteRangeResponse                `protobuf:"bytes,5,opt,name=delete_range" json:"delete_range,omitempty"`
 	EndTransaction             *EndTransactionResponse             `protobuf:"bytes,6,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	PutRow                     *PutRowResponse                     `protobuf:"bytes,7,opt,name=put_row" json:"put_row,omitempty"`
+	DeleteRow                  *DeleteRowResponse                  `protobuf:"bytes,8,opt,name=delete_row" json:"delete_row,omitempty"`
+	LockRow                    *LockRowResponse                    `protobuf:"bytes,9,opt,name=lock_row" json:"lock_row,omitempty"`
 	InternalHeartbeatTxn       *InternalHeartbeatTxnResponse       `protobuf:"bytes,10,opt,name=internal_heartbeat_txn" json:"internal_heartbeat_txn,omitempty"`
 	InternalPushTxn            *InternalPushTxnResponse            `protobuf:"bytes,11,opt,name=internal_push_txn" json:"internal_push_txn,omitempty"`
 	InternalResolveIntent      *InternalResolveIntentResponse      `protobuf:"bytes,12,opt,name=internal_resolve_intent" json:"internal_resolve_intent,omitempty"`
@@ -724,6 +859,7 @@ type ReadWriteCmdResponse struct {
 	InternalTruncateLog        *InternalTruncateLogResponse        `protobuf:"bytes,15,opt,name=internal_truncate_log" json:"internal_truncate_log,omitempty"`
 	InternalGc                 *InternalGCResponse                 `protobuf:"bytes,16,opt,name=internal_gc" json:"internal_gc,omitempty"`
 	InternalLeaderLease        *InternalLeaderLeaseResponse        `protobuf:"bytes,17,opt,name=internal_leader_lease" json:"internal_leader_lease,omitempty"`
+	PutUnique                  *PutUniqueResponse                  `protobuf:"bytes,18,opt,name=put_unique" json:"put_unique,omitempty"`
 	XXX_unrecognized           []byte                              `json:"-"`
 }
 
@@ -773,6 +909,27 @@ func (m *ReadWriteCmdResponse) GetEndTransaction() *EndTransactionResponse {
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetPutRow() *PutRowResponse {
+	if m != nil {
+		return m.PutRow
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetDeleteRow() *DeleteRowResponse {
+	if m != nil {
+		return m.DeleteRow
+	}
+	return nil
+}
+
+func (m *ReadWriteCmdResponse) GetLockRow() *LockRowResponse {
+	if m != nil {
+		return m.LockRow
+	}
+	return nil
+}
+
 func (m *ReadWriteCmdResponse) GetInternalHeartbeatTxn() *InternalHeartbeatTxnResponse {
 	if m != nil {
 		return m.InternalHeartbeatTxn
@@ -829,6 +986,13 @@ func (m *ReadWriteCmdResponse) GetInternalLeaderLease() *InternalLeaderLeaseResp
 	return nil
 }
 
+func (m *ReadWriteCmdResponse) GetPutUnique() *PutUniqueResponse {
+	if m != nil {
+		return m.PutUnique
+	}
+	return nil
+}
+
 // An InternalRaftCommandUnion is the union of all commands which can be
 // sent via raft.
 type InternalRaftCommandUnion struct {
@@ -841,6 +1005,13 @@ type InternalRaftCommandUnion struct {
 	DeleteRange    *DeleteRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
 	Scan           *ScanRequest           `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
 	EndTransaction *EndTransactionRequest `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	GetRow         *GetRowRequest         `protobuf:"bytes,10,opt,name=get_row" json:"get_row,omitempty"`
+	PutRow         *PutRowRequest         `protobuf:"bytes,11,opt,name=put_row" json:"put_row,omitempty"`
+	DeleteRow      *DeleteRowRequest      `protobuf:"bytes,12,opt,name=delete_row" json:"delete_row,omitempty"`
+	ScanRows       *ScanRowsRequest       `protobuf:"bytes,13,opt,name=scan_rows" json:"scan_rows,omitempty"`
+	LockRow        *LockRowRequest        `protobuf:"bytes,14,opt,name=lock_row" json:"lock_row,omitempty"`
+	PutUnique      *PutUniqueRequest      `protobuf:"bytes,15,opt,name=put_unique" json:"put_unique,omitempty"`
+	ScanChanges    *ScanChangesRequest    `protobuf:"bytes,16,opt,name=scan_changes" json:"scan_changes,omitempty"`
 	// Other requests. Allow a gap in tag numbers so the previous list can
 	// be copy/pasted from RequestUnion.
 	Batch                      *BatchRequest                      `protobuf:"bytes,30,opt,name=batch" json:"batch,omitempty"`
@@ -917,6 +1088,55 @@ func (m *InternalRaftCommandUnion) GetEndTransaction() *EndTransactionRequest {
 	return nil
 }
 
+func (m *InternalRaftCommandUnion) GetGetRow() *GetRowRequest {
+	if m != nil {
+		return m.GetRow
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetPutRow() *PutRowRequest {
+	if m != nil {
+		return m.PutRow
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetDeleteRow() *DeleteRowRequest {
+	if m != nil {
+		return m.DeleteRow
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetScanRows() *ScanRowsRequest {
+	if m != nil {
+		return m.ScanRows
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetLockRow() *LockRowRequest {
+	if m != nil {
+		return m.LockRow
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetPutUnique() *PutUniqueRequest {
+	if m != nil {
+		return m.PutUnique
+	}
+	return nil
+}
+
+func (m *InternalRaftCommandUnion) GetScanChanges() *ScanChangesRequest {
+	if m != nil {
+		return m.ScanChanges
+	}
+	return nil
+}
+
 func (m *InternalRaftCommandUnion) GetBatch() *BatchRequest {
 	if m != nil {
 		return m.Batch
@@ -1007,6 +1227,20 @@ func (m *InternalRaftCommand) Reset()         { *m = InternalRaftCommand{} }
 func (m *InternalRaftCommand) String() string { return proto1.CompactTextString(m) }
 func (*InternalRaftCommand) ProtoMessage()    {}
 
+func (m *InternalRaftCommand) GetRaftID() RaftID {
+	if m != nil {
+		return m.RaftID
+	}
+	return 0
+}
+
+func (m *InternalRaftCommand) GetOriginNodeID() RaftNodeID {
+	if m != nil {
+		return m.OriginNodeID
+	}
+	return 0
+}
+
 func (m *InternalRaftCommand) GetCmd() InternalRaftCommandUnion {
 	if m != nil {
 		return m.Cmd
@@ -1033,6 +1267,13 @@ func (m *RaftMessageRequest) Reset()         { *m = RaftMessageRequest{} }
 func (m *RaftMessageRequest) String() string { return proto1.CompactTextString(m) }
 func (*RaftMessageRequest) ProtoMessage()    {}
 
+func (m *RaftMessageRequest) GetGroupID() RaftID {
+	if m != nil {
+		return m.GroupID
+	}
+	return 0
+}
+
 func (m *RaftMessageRequest) GetMsg() []byte {
 	if m != nil {
 		return m.Msg
@@ -1337,6 +1578,23 @@ func (m *InternalRangeLookupRequest) Unmarshal(data []byte) error {
 				}
 			}
 			m.IgnoreIntents = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverse", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reverse = bool(v != 0)
 		default:
 			var sizeOfWire int
 			for {
@@ -3068,9 +3326,9 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
-		case 30:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3088,16 +3346,16 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalPushTxn == nil {
-				m.InternalPushTxn = &InternalPushTxnRequest{}
+			if m.GetRow == nil {
+				m.GetRow = &GetRowRequest{}
 			}
-			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.GetRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 31:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3115,16 +3373,16 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntent == nil {
-				m.InternalResolveIntent = &InternalResolveIntentRequest{}
+			if m.PutRow == nil {
+				m.PutRow = &PutRowRequest{}
 			}
-			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.PutRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 32:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntentRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3142,59 +3400,16 @@ func (m *InternalRequestUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntentRange == nil {
-				m.InternalResolveIntentRange = &InternalResolveIntentRangeRequest{}
+			if m.DeleteRow == nil {
+				m.DeleteRow = &DeleteRowRequest{}
 			}
-			if err := m.InternalResolveIntentRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.DeleteRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
-			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *InternalResponseUnion) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanRows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3212,16 +3427,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetResponse{}
+			if m.ScanRows == nil {
+				m.ScanRows = &ScanRowsRequest{}
 			}
-			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ScanRows.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3239,16 +3454,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutResponse{}
+			if m.LockRow == nil {
+				m.LockRow = &LockRowRequest{}
 			}
-			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.LockRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutUnique", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3266,16 +3481,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConditionalPut == nil {
-				m.ConditionalPut = &ConditionalPutResponse{}
+			if m.PutUnique == nil {
+				m.PutUnique = &PutUniqueRequest{}
 			}
-			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.PutUnique.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3293,16 +3508,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementResponse{}
+			if m.ScanChanges == nil {
+				m.ScanChanges = &ScanChangesRequest{}
 			}
-			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ScanChanges.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 6:
+		case 30:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3320,7 +3535,239 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
+			if m.InternalPushTxn == nil {
+				m.InternalPushTxn = &InternalPushTxnRequest{}
+			}
+			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalResolveIntent == nil {
+				m.InternalResolveIntent = &InternalResolveIntentRequest{}
+			}
+			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntentRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalResolveIntentRange == nil {
+				m.InternalResolveIntentRange = &InternalResolveIntentRangeRequest{}
+			}
+			if err := m.InternalResolveIntentRange.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *InternalResponseUnion) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Get == nil {
+				m.Get = &GetResponse{}
+			}
+			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutResponse{}
+			}
+			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConditionalPut == nil {
+				m.ConditionalPut = &ConditionalPutResponse{}
+			}
+			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Increment == nil {
+				m.Increment = &IncrementResponse{}
+			}
+			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
 				m.Delete = &DeleteResponse{}
 			}
 			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
@@ -3408,9 +3855,9 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 				return err
 			}
 			index = postIndex
-		case 30:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3428,16 +3875,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalPushTxn == nil {
-				m.InternalPushTxn = &InternalPushTxnResponse{}
+			if m.GetRow == nil {
+				m.GetRow = &GetRowResponse{}
 			}
-			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.GetRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 31:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3455,16 +3902,16 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntent == nil {
-				m.InternalResolveIntent = &InternalResolveIntentResponse{}
+			if m.PutRow == nil {
+				m.PutRow = &PutRowResponse{}
 			}
-			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.PutRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 32:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntentRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3482,18 +3929,207 @@ func (m *InternalResponseUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntentRange == nil {
-				m.InternalResolveIntentRange = &InternalResolveIntentRangeResponse{}
+			if m.DeleteRow == nil {
+				m.DeleteRow = &DeleteRowResponse{}
 			}
-			if err := m.InternalResolveIntentRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.DeleteRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanRows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanRows == nil {
+				m.ScanRows = &ScanRowsResponse{}
+			}
+			if err := m.ScanRows.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LockRow == nil {
+				m.LockRow = &LockRowResponse{}
+			}
+			if err := m.LockRow.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PutUnique", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PutUnique == nil {
+				m.PutUnique = &PutUniqueResponse{}
+			}
+			if err := m.PutUnique.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScanChanges == nil {
+				m.ScanChanges = &ScanChangesResponse{}
+			}
+			if err := m.ScanChanges.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalPushTxn == nil {
+				m.InternalPushTxn = &InternalPushTxnResponse{}
+			}
+			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 31:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalResolveIntent == nil {
+				m.InternalResolveIntent = &InternalResolveIntentResponse{}
+			}
+			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntentRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InternalResolveIntentRange == nil {
+				m.InternalResolveIntentRange = &InternalResolveIntentRangeResponse{}
+			}
+			if err := m.InternalResolveIntentRange.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
 				if wire == 0 {
 					break
 				}
@@ -3522,19 +4158,338 @@ func (m *InternalBatchRequest) Unmarshal(data []byte) error {
 			if index >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, InternalRequestUnion{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *InternalBatchResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Responses = append(m.Responses, InternalResponseUnion{})
+			if err := m.Responses[len(m.Responses)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Put == nil {
+				m.Put = &PutResponse{}
+			}
+			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConditionalPut == nil {
+				m.ConditionalPut = &ConditionalPutResponse{}
+			}
+			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Increment == nil {
+				m.Increment = &IncrementResponse{}
+			}
+			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Delete == nil {
+				m.Delete = &DeleteResponse{}
+			}
+			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
+			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3552,13 +4507,16 @@ func (m *InternalBatchRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RequestHeader.Unmarshal(data[index:postIndex]); err != nil {
+			if m.EndTransaction == nil {
+				m.EndTransaction = &EndTransactionResponse{}
+			}
+			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3576,57 +4534,43 @@ func (m *InternalBatchRequest) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, InternalRequestUnion{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(data[index:postIndex]); err != nil {
+			if m.PutRow == nil {
+				m.PutRow = &PutRowResponse{}
+			}
+			if err := m.PutRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
+			postIndex := index + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *InternalBatchResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
+			if m.DeleteRow == nil {
+				m.DeleteRow = &DeleteRowResponse{}
 			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.DeleteRow.Unmarshal(data[index:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
+			index = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResponseHeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3644,13 +4588,16 @@ func (m *InternalBatchResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ResponseHeader.Unmarshal(data[index:postIndex]); err != nil {
+			if m.LockRow == nil {
+				m.LockRow = &LockRowResponse{}
+			}
+			if err := m.LockRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Responses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3668,57 +4615,43 @@ func (m *InternalBatchResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Responses = append(m.Responses, InternalResponseUnion{})
-			if err := m.Responses[len(m.Responses)-1].Unmarshal(data[index:postIndex]); err != nil {
+			if m.InternalHeartbeatTxn == nil {
+				m.InternalHeartbeatTxn = &InternalHeartbeatTxnResponse{}
+			}
+			if err := m.InternalHeartbeatTxn.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
+			postIndex := index + msglen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
+			if m.InternalPushTxn == nil {
+				m.InternalPushTxn = &InternalPushTxnResponse{}
 			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
+			index = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3736,16 +4669,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutResponse{}
+			if m.InternalResolveIntent == nil {
+				m.InternalResolveIntent = &InternalResolveIntentResponse{}
 			}
-			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntentRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3763,16 +4696,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConditionalPut == nil {
-				m.ConditionalPut = &ConditionalPutResponse{}
+			if m.InternalResolveIntentRange == nil {
+				m.InternalResolveIntentRange = &InternalResolveIntentRangeResponse{}
 			}
-			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalResolveIntentRange.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalMerge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3790,16 +4723,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementResponse{}
+			if m.InternalMerge == nil {
+				m.InternalMerge = &InternalMergeResponse{}
 			}
-			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalMerge.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 4:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalTruncateLog", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3817,16 +4750,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteResponse{}
+			if m.InternalTruncateLog == nil {
+				m.InternalTruncateLog = &InternalTruncateLogResponse{}
 			}
-			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalTruncateLog.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 5:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalGc", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3844,16 +4777,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRange == nil {
-				m.DeleteRange = &DeleteRangeResponse{}
+			if m.InternalGc == nil {
+				m.InternalGc = &InternalGCResponse{}
 			}
-			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalGc.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 6:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalLeaderLease", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3871,16 +4804,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTransaction == nil {
-				m.EndTransaction = &EndTransactionResponse{}
+			if m.InternalLeaderLease == nil {
+				m.InternalLeaderLease = &InternalLeaderLeaseResponse{}
 			}
-			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.InternalLeaderLease.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 10:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalHeartbeatTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutUnique", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3898,16 +4831,59 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalHeartbeatTxn == nil {
-				m.InternalHeartbeatTxn = &InternalHeartbeatTxnResponse{}
-			}
-			if err := m.InternalHeartbeatTxn.Unmarshal(data[index:postIndex]); err != nil {
-				return err
+			if m.PutUnique == nil {
+				m.PutUnique = &PutUniqueResponse{}
+			}
+			if err := m.PutUnique.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			index = postIndex
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPushTxn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3925,16 +4901,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalPushTxn == nil {
-				m.InternalPushTxn = &InternalPushTxnResponse{}
+			if m.Get == nil {
+				m.Get = &GetRequest{}
 			}
-			if err := m.InternalPushTxn.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3952,16 +4928,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntent == nil {
-				m.InternalResolveIntent = &InternalResolveIntentResponse{}
+			if m.Put == nil {
+				m.Put = &PutRequest{}
 			}
-			if err := m.InternalResolveIntent.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 13:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalResolveIntentRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3979,16 +4955,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalResolveIntentRange == nil {
-				m.InternalResolveIntentRange = &InternalResolveIntentRangeResponse{}
+			if m.ConditionalPut == nil {
+				m.ConditionalPut = &ConditionalPutRequest{}
 			}
-			if err := m.InternalResolveIntentRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalMerge", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4006,16 +4982,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalMerge == nil {
-				m.InternalMerge = &InternalMergeResponse{}
+			if m.Increment == nil {
+				m.Increment = &IncrementRequest{}
 			}
-			if err := m.InternalMerge.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalTruncateLog", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4033,16 +5009,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalTruncateLog == nil {
-				m.InternalTruncateLog = &InternalTruncateLogResponse{}
+			if m.Delete == nil {
+				m.Delete = &DeleteRequest{}
 			}
-			if err := m.InternalTruncateLog.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalGc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4060,16 +5036,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalGc == nil {
-				m.InternalGc = &InternalGCResponse{}
+			if m.DeleteRange == nil {
+				m.DeleteRange = &DeleteRangeRequest{}
 			}
-			if err := m.InternalGc.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 17:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalLeaderLease", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4087,59 +5063,16 @@ func (m *ReadWriteCmdResponse) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InternalLeaderLease == nil {
-				m.InternalLeaderLease = &InternalLeaderLeaseResponse{}
+			if m.Scan == nil {
+				m.Scan = &ScanRequest{}
 			}
-			if err := m.InternalLeaderLease.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.Scan.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
-			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Get", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4157,16 +5090,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Get == nil {
-				m.Get = &GetRequest{}
+			if m.EndTransaction == nil {
+				m.EndTransaction = &EndTransactionRequest{}
 			}
-			if err := m.Get.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Put", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GetRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4184,16 +5117,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Put == nil {
-				m.Put = &PutRequest{}
+			if m.GetRow == nil {
+				m.GetRow = &GetRowRequest{}
 			}
-			if err := m.Put.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.GetRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConditionalPut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4211,16 +5144,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConditionalPut == nil {
-				m.ConditionalPut = &ConditionalPutRequest{}
+			if m.PutRow == nil {
+				m.PutRow = &PutRowRequest{}
 			}
-			if err := m.ConditionalPut.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.PutRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 5:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4238,16 +5171,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Increment == nil {
-				m.Increment = &IncrementRequest{}
+			if m.DeleteRow == nil {
+				m.DeleteRow = &DeleteRowRequest{}
 			}
-			if err := m.Increment.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.DeleteRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 6:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delete", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanRows", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4265,16 +5198,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Delete == nil {
-				m.Delete = &DeleteRequest{}
+			if m.ScanRows == nil {
+				m.ScanRows = &ScanRowsRequest{}
 			}
-			if err := m.Delete.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ScanRows.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 7:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeleteRange", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockRow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4292,16 +5225,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DeleteRange == nil {
-				m.DeleteRange = &DeleteRangeRequest{}
+			if m.LockRow == nil {
+				m.LockRow = &LockRowRequest{}
 			}
-			if err := m.DeleteRange.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.LockRow.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 8:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PutUnique", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4319,16 +5252,16 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Scan == nil {
-				m.Scan = &ScanRequest{}
+			if m.PutUnique == nil {
+				m.PutUnique = &PutUniqueRequest{}
 			}
-			if err := m.Scan.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.PutUnique.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 9:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTransaction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ScanChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4346,10 +5279,10 @@ func (m *InternalRaftCommandUnion) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTransaction == nil {
-				m.EndTransaction = &EndTransactionRequest{}
+			if m.ScanChanges == nil {
+				m.ScanChanges = &ScanChangesRequest{}
 			}
-			if err := m.EndTransaction.Unmarshal(data[index:postIndex]); err != nil {
+			if err := m.ScanChanges.Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
@@ -5397,6 +6330,27 @@ func (this *InternalRequestUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.GetRow != nil {
+		return this.GetRow
+	}
+	if this.PutRow != nil {
+		return this.PutRow
+	}
+	if this.DeleteRow != nil {
+		return this.DeleteRow
+	}
+	if this.ScanRows != nil {
+		return this.ScanRows
+	}
+	if this.LockRow != nil {
+		return this.LockRow
+	}
+	if this.PutUnique != nil {
+		return this.PutUnique
+	}
+	if this.ScanChanges != nil {
+		return this.ScanChanges
+	}
 	if this.InternalPushTxn != nil {
 		return this.InternalPushTxn
 	}
@@ -5427,6 +6381,20 @@ func (this *InternalRequestUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionRequest:
 		this.EndTransaction = vt
+	case *GetRowRequest:
+		this.GetRow = vt
+	case *PutRowRequest:
+		this.PutRow = vt
+	case *DeleteRowRequest:
+		this.DeleteRow = vt
+	case *ScanRowsRequest:
+		this.ScanRows = vt
+	case *LockRowRequest:
+		this.LockRow = vt
+	case *PutUniqueRequest:
+		this.PutUnique = vt
+	case *ScanChangesRequest:
+		this.ScanChanges = vt
 	case *InternalPushTxnRequest:
 		this.InternalPushTxn = vt
 	case *InternalResolveIntentRequest:
@@ -5463,6 +6431,27 @@ func (this *InternalResponseUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.GetRow != nil {
+		return this.GetRow
+	}
+	if this.PutRow != nil {
+		return this.PutRow
+	}
+	if this.DeleteRow != nil {
+		return this.DeleteRow
+	}
+	if this.ScanRows != nil {
+		return this.ScanRows
+	}
+	if this.LockRow != nil {
+		return this.LockRow
+	}
+	if this.PutUnique != nil {
+		return this.PutUnique
+	}
+	if this.ScanChanges != nil {
+		return this.ScanChanges
+	}
 	if this.InternalPushTxn != nil {
 		return this.InternalPushTxn
 	}
@@ -5493,6 +6482,20 @@ func (this *InternalResponseUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionResponse:
 		this.EndTransaction = vt
+	case *GetRowResponse:
+		this.GetRow = vt
+	case *PutRowResponse:
+		this.PutRow = vt
+	case *DeleteRowResponse:
+		this.DeleteRow = vt
+	case *ScanRowsResponse:
+		this.ScanRows = vt
+	case *LockRowResponse:
+		this.LockRow = vt
+	case *PutUniqueResponse:
+		this.PutUnique = vt
+	case *ScanChangesResponse:
+		this.ScanChanges = vt
 	case *InternalPushTxnResponse:
 		this.InternalPushTxn = vt
 	case *InternalResolveIntentResponse:
@@ -5523,6 +6526,15 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.PutRow != nil {
+		return this.PutRow
+	}
+	if this.DeleteRow != nil {
+		return this.DeleteRow
+	}
+	if this.LockRow != nil {
+		return this.LockRow
+	}
 	if this.InternalHeartbeatTxn != nil {
 		return this.InternalHeartbeatTxn
 	}
@@ -5547,6 +6559,9 @@ func (this *ReadWriteCmdResponse) GetValue() interface{} {
 	if this.InternalLeaderLease != nil {
 		return this.InternalLeaderLease
 	}
+	if this.PutUnique != nil {
+		return this.PutUnique
+	}
 	return nil
 }
 
@@ -5564,6 +6579,12 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.DeleteRange = vt
 	case *EndTransactionResponse:
 		this.EndTransaction = vt
+	case *PutRowResponse:
+		this.PutRow = vt
+	case *DeleteRowResponse:
+		this.DeleteRow = vt
+	case *LockRowResponse:
+		this.LockRow = vt
 	case *InternalHeartbeatTxnResponse:
 		this.InternalHeartbeatTxn = vt
 	case *InternalPushTxnResponse:
@@ -5580,6 +6601,8 @@ func (this *ReadWriteCmdResponse) SetValue(value interface{}) bool {
 		this.InternalGc = vt
 	case *InternalLeaderLeaseResponse:
 		this.InternalLeaderLease = vt
+	case *PutUniqueResponse:
+		this.PutUnique = vt
 	default:
 		return false
 	}
@@ -5610,6 +6633,27 @@ func (this *InternalRaftCommandUnion) GetValue() interface{} {
 	if this.EndTransaction != nil {
 		return this.EndTransaction
 	}
+	if this.GetRow != nil {
+		return this.GetRow
+	}
+	if this.PutRow != nil {
+		return this.PutRow
+	}
+	if this.DeleteRow != nil {
+		return this.DeleteRow
+	}
+	if this.ScanRows != nil {
+		return this.ScanRows
+	}
+	if this.LockRow != nil {
+		return this.LockRow
+	}
+	if this.PutUnique != nil {
+		return this.PutUnique
+	}
+	if this.ScanChanges != nil {
+		return this.ScanChanges
+	}
 	if this.Batch != nil {
 		return this.Batch
 	}
@@ -5664,6 +6708,20 @@ func (this *InternalRaftCommandUnion) SetValue(value interface{}) bool {
 		this.Scan = vt
 	case *EndTransactionRequest:
 		this.EndTransaction = vt
+	case *GetRowRequest:
+		this.GetRow = vt
+	case *PutRowRequest:
+		this.PutRow = vt
+	case *DeleteRowRequest:
+		this.DeleteRow = vt
+	case *ScanRowsRequest:
+		this.ScanRows = vt
+	case *LockRowRequest:
+		this.LockRow = vt
+	case *PutUniqueRequest:
+		this.PutUnique = vt
+	case *ScanChangesRequest:
+		this.ScanChanges = vt
 	case *BatchRequest:
 		this.Batch = vt
 	case *InternalRangeLookupRequest:
@@ -5698,6 +6756,7 @@ func (m *InternalRangeLookupRequest) Size() (n int) {
 	n += 1 + l + sovInternal(uint64(l))
 	n += 1 + sovInternal(uint64(m.MaxRanges))
 	n += 2
+	n += 2
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5970,6 +7029,34 @@ func (m *InternalRequestUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.GetRow != nil {
+		l = m.GetRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PutRow != nil {
+		l = m.PutRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteRow != nil {
+		l = m.DeleteRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScanRows != nil {
+		l = m.ScanRows.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LockRow != nil {
+		l = m.LockRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PutUnique != nil {
+		l = m.PutUnique.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScanChanges != nil {
+		l = m.ScanChanges.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.InternalPushTxn != nil {
 		l = m.InternalPushTxn.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -6023,6 +7110,34 @@ func (m *InternalResponseUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.GetRow != nil {
+		l = m.GetRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PutRow != nil {
+		l = m.PutRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteRow != nil {
+		l = m.DeleteRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScanRows != nil {
+		l = m.ScanRows.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LockRow != nil {
+		l = m.LockRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PutUnique != nil {
+		l = m.PutUnique.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScanChanges != nil {
+		l = m.ScanChanges.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.InternalPushTxn != nil {
 		l = m.InternalPushTxn.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -6102,6 +7217,18 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.PutRow != nil {
+		l = m.PutRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteRow != nil {
+		l = m.DeleteRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LockRow != nil {
+		l = m.LockRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
 	if m.InternalHeartbeatTxn != nil {
 		l = m.InternalHeartbeatTxn.Size()
 		n += 1 + l + sovInternal(uint64(l))
@@ -6134,6 +7261,10 @@ func (m *ReadWriteCmdResponse) Size() (n int) {
 		l = m.InternalLeaderLease.Size()
 		n += 2 + l + sovInternal(uint64(l))
 	}
+	if m.PutUnique != nil {
+		l = m.PutUnique.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -6175,6 +7306,34 @@ func (m *InternalRaftCommandUnion) Size() (n int) {
 		l = m.EndTransaction.Size()
 		n += 1 + l + sovInternal(uint64(l))
 	}
+	if m.GetRow != nil {
+		l = m.GetRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PutRow != nil {
+		l = m.PutRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.DeleteRow != nil {
+		l = m.DeleteRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScanRows != nil {
+		l = m.ScanRows.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.LockRow != nil {
+		l = m.LockRow.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.PutUnique != nil {
+		l = m.PutUnique.Size()
+		n += 1 + l + sovInternal(uint64(l))
+	}
+	if m.ScanChanges != nil {
+		l = m.ScanChanges.Size()
+		n += 2 + l + sovInternal(uint64(l))
+	}
 	if m.Batch != nil {
 		l = m.Batch.Size()
 		n += 2 + l + sovInternal(uint64(l))
@@ -6388,6 +7547,14 @@ func (m *InternalRangeLookupRequest) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0
 	}
 	i++
+	data[i] = 0x20
+	i++
+	if m.Reverse {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -7105,17 +8272,89 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		}
 		i += n33
 	}
+	if m.GetRow != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.GetRow.Size()))
+		n34, err := m.GetRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n34
+	}
+	if m.PutRow != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutRow.Size()))
+		n35, err := m.PutRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n35
+	}
+	if m.DeleteRow != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.DeleteRow.Size()))
+		n36, err := m.DeleteRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n36
+	}
+	if m.ScanRows != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ScanRows.Size()))
+		n37, err := m.ScanRows.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n37
+	}
+	if m.LockRow != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.LockRow.Size()))
+		n38, err := m.LockRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n38
+	}
+	if m.PutUnique != nil {
+		data[i] = 0x7a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutUnique.Size()))
+		n39, err := m.PutUnique.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n39
+	}
+	if m.ScanChanges != nil {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ScanChanges.Size()))
+		n40, err := m.ScanChanges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n40
+	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0xf2
 		i++
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n34, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n41, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n34
+		i += n41
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0xfa
@@ -7123,11 +8362,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n35, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n42, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n35
+		i += n42
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x82
@@ -7135,11 +8374,11 @@ func (m *InternalRequestUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n36, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n43, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n36
+		i += n43
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7166,81 +8405,153 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n37, err := m.Get.MarshalTo(data[i:])
+		n44, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n37
+		i += n44
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n38, err := m.Put.MarshalTo(data[i:])
+		n45, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n38
+		i += n45
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n39, err := m.ConditionalPut.MarshalTo(data[i:])
+		n46, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n39
+		i += n46
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n40, err := m.Increment.MarshalTo(data[i:])
+		n47, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n40
+		i += n47
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n41, err := m.Delete.MarshalTo(data[i:])
+		n48, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n41
+		i += n48
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n42, err := m.DeleteRange.MarshalTo(data[i:])
+		n49, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n42
+		i += n49
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n43, err := m.Scan.MarshalTo(data[i:])
+		n50, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n43
+		i += n50
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n44, err := m.EndTransaction.MarshalTo(data[i:])
+		n51, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n44
+		i += n51
+	}
+	if m.GetRow != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.GetRow.Size()))
+		n52, err := m.GetRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n52
+	}
+	if m.PutRow != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutRow.Size()))
+		n53, err := m.PutRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n53
+	}
+	if m.DeleteRow != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.DeleteRow.Size()))
+		n54, err := m.DeleteRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n54
+	}
+	if m.ScanRows != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ScanRows.Size()))
+		n55, err := m.ScanRows.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n55
+	}
+	if m.LockRow != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.LockRow.Size()))
+		n56, err := m.LockRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n56
+	}
+	if m.PutUnique != nil {
+		data[i] = 0x7a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutUnique.Size()))
+		n57, err := m.PutUnique.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n57
+	}
+	if m.ScanChanges != nil {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ScanChanges.Size()))
+		n58, err := m.ScanChanges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n58
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0xf2
@@ -7248,11 +8559,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n45, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n59, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n59
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0xfa
@@ -7260,11 +8571,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n46, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n60, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n60
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x82
@@ -7272,11 +8583,11 @@ func (m *InternalResponseUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n47, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n61, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n61
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7385,121 +8696,151 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n50, err := m.Put.MarshalTo(data[i:])
+		n62, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n62
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n51, err := m.ConditionalPut.MarshalTo(data[i:])
+		n63, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n63
 	}
 	if m.Increment != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n52, err := m.Increment.MarshalTo(data[i:])
+		n64, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n52
+		i += n64
 	}
 	if m.Delete != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n53, err := m.Delete.MarshalTo(data[i:])
+		n65, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n65
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n54, err := m.DeleteRange.MarshalTo(data[i:])
+		n66, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n66
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n55, err := m.EndTransaction.MarshalTo(data[i:])
+		n67, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n67
+	}
+	if m.PutRow != nil {
+		data[i] = 0x3a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutRow.Size()))
+		n68, err := m.PutRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n68
+	}
+	if m.DeleteRow != nil {
+		data[i] = 0x42
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.DeleteRow.Size()))
+		n69, err := m.DeleteRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n69
+	}
+	if m.LockRow != nil {
+		data[i] = 0x4a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.LockRow.Size()))
+		n70, err := m.LockRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n70
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x52
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n56, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n71, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n71
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x5a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n57, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n72, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n72
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x62
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n58, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n73, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n73
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x6a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n59, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n74, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n74
 	}
 	if m.InternalMerge != nil {
 		data[i] = 0x72
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMerge.Size()))
-		n60, err := m.InternalMerge.MarshalTo(data[i:])
+		n75, err := m.InternalMerge.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n60
+		i += n75
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0x7a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n61, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n76, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n61
+		i += n76
 	}
 	if m.InternalGc != nil {
 		data[i] = 0x82
@@ -7507,11 +8848,11 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGc.Size()))
-		n62, err := m.InternalGc.MarshalTo(data[i:])
+		n77, err := m.InternalGc.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n62
+		i += n77
 	}
 	if m.InternalLeaderLease != nil {
 		data[i] = 0x8a
@@ -7519,11 +8860,23 @@ func (m *ReadWriteCmdResponse) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLeaderLease.Size()))
-		n63, err := m.InternalLeaderLease.MarshalTo(data[i:])
+		n78, err := m.InternalLeaderLease.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n63
+		i += n78
+	}
+	if m.PutUnique != nil {
+		data[i] = 0x92
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutUnique.Size()))
+		n79, err := m.PutUnique.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n79
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -7550,81 +8903,153 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x12
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Get.Size()))
-		n64, err := m.Get.MarshalTo(data[i:])
+		n82, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n64
+		i += n82
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Put.Size()))
-		n65, err := m.Put.MarshalTo(data[i:])
+		n83, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n65
+		i += n83
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.ConditionalPut.Size()))
-		n66, err := m.ConditionalPut.MarshalTo(data[i:])
+		n84, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n66
+		i += n84
 	}
 	if m.Increment != nil {
 		data[i] = 0x2a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Increment.Size()))
-		n67, err := m.Increment.MarshalTo(data[i:])
+		n85, err := m.Increment.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n67
+		i += n85
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Delete.Size()))
-		n68, err := m.Delete.MarshalTo(data[i:])
+		n86, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n68
+		i += n86
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.DeleteRange.Size()))
-		n69, err := m.DeleteRange.MarshalTo(data[i:])
+		n87, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n69
+		i += n87
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Scan.Size()))
-		n70, err := m.Scan.MarshalTo(data[i:])
+		n88, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n70
+		i += n88
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.EndTransaction.Size()))
-		n71, err := m.EndTransaction.MarshalTo(data[i:])
+		n89, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n71
+		i += n89
+	}
+	if m.GetRow != nil {
+		data[i] = 0x52
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.GetRow.Size()))
+		n90, err := m.GetRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n90
+	}
+	if m.PutRow != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutRow.Size()))
+		n91, err := m.PutRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n91
+	}
+	if m.DeleteRow != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.DeleteRow.Size()))
+		n92, err := m.DeleteRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n92
+	}
+	if m.ScanRows != nil {
+		data[i] = 0x6a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ScanRows.Size()))
+		n93, err := m.ScanRows.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n93
+	}
+	if m.LockRow != nil {
+		data[i] = 0x72
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.LockRow.Size()))
+		n94, err := m.LockRow.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n94
+	}
+	if m.PutUnique != nil {
+		data[i] = 0x7a
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.PutUnique.Size()))
+		n95, err := m.PutUnique.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n95
+	}
+	if m.ScanChanges != nil {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintInternal(data, i, uint64(m.ScanChanges.Size()))
+		n96, err := m.ScanChanges.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n96
 	}
 	if m.Batch != nil {
 		data[i] = 0xf2
@@ -7632,11 +9057,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.Batch.Size()))
-		n72, err := m.Batch.MarshalTo(data[i:])
+		n97, err := m.Batch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n72
+		i += n97
 	}
 	if m.InternalRangeLookup != nil {
 		data[i] = 0xfa
@@ -7644,11 +9069,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x1
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalRangeLookup.Size()))
-		n73, err := m.InternalRangeLookup.MarshalTo(data[i:])
+		n98, err := m.InternalRangeLookup.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n73
+		i += n98
 	}
 	if m.InternalHeartbeatTxn != nil {
 		data[i] = 0x82
@@ -7656,11 +9081,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalHeartbeatTxn.Size()))
-		n74, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
+		n99, err := m.InternalHeartbeatTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n74
+		i += n99
 	}
 	if m.InternalPushTxn != nil {
 		data[i] = 0x8a
@@ -7668,11 +9093,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalPushTxn.Size()))
-		n75, err := m.InternalPushTxn.MarshalTo(data[i:])
+		n100, err := m.InternalPushTxn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n75
+		i += n100
 	}
 	if m.InternalResolveIntent != nil {
 		data[i] = 0x92
@@ -7680,11 +9105,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntent.Size()))
-		n76, err := m.InternalResolveIntent.MarshalTo(data[i:])
+		n101, err := m.InternalResolveIntent.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n76
+		i += n101
 	}
 	if m.InternalResolveIntentRange != nil {
 		data[i] = 0x9a
@@ -7692,11 +9117,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalResolveIntentRange.Size()))
-		n77, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
+		n102, err := m.InternalResolveIntentRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n77
+		i += n102
 	}
 	if m.InternalMergeResponse != nil {
 		data[i] = 0xa2
@@ -7704,11 +9129,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalMergeResponse.Size()))
-		n78, err := m.InternalMergeResponse.MarshalTo(data[i:])
+		n103, err := m.InternalMergeResponse.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n78
+		i += n103
 	}
 	if m.InternalTruncateLog != nil {
 		data[i] = 0xaa
@@ -7716,11 +9141,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalTruncateLog.Size()))
-		n79, err := m.InternalTruncateLog.MarshalTo(data[i:])
+		n104, err := m.InternalTruncateLog.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n79
+		i += n104
 	}
 	if m.InternalGC != nil {
 		data[i] = 0xb2
@@ -7728,11 +9153,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalGC.Size()))
-		n80, err := m.InternalGC.MarshalTo(data[i:])
+		n105, err := m.InternalGC.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n80
+		i += n105
 	}
 	if m.InternalLease != nil {
 		data[i] = 0xba
@@ -7740,11 +9165,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalLease.Size()))
-		n81, err := m.InternalLease.MarshalTo(data[i:])
+		n106, err := m.InternalLease.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n81
+		i += n106
 	}
 	if m.InternalBatch != nil {
 		data[i] = 0xc2
@@ -7752,11 +9177,11 @@ func (m *InternalRaftCommandUnion) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x2
 		i++
 		i = encodeVarintInternal(data, i, uint64(m.InternalBatch.Size()))
-		n82, err := m.InternalBatch.MarshalTo(data[i:])
+		n107, err := m.InternalBatch.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n82
+		i += n107
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -49,6 +49,30 @@ const (
 	Scan
 	// EndTransaction either commits or aborts an ongoing transaction.
 	EndTransaction
+	// GetRow fetches the cells of a table row, identified by its table,
+	// index and encoded primary key, in a single request.
+	GetRow
+	// PutRow writes the cells of a table row in a single request. Cells
+	// with a nil value are deleted; the other cells of the row are left
+	// untouched.
+	PutRow
+	// DeleteRow deletes all of the cells of a table row.
+	DeleteRow
+	// ScanRows fetches the rows of a table index which fall between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey, with the
+	// latter endpoint excluded, grouping their cells by row.
+	ScanRows
+	// LockRow fetches the cells of a table row like GetRow and lays a
+	// write intent on it without modifying it, so that the row cannot be
+	// written by other transactions until the transaction ends.
+	LockRow
+	// PutUnique sets the value for a key like Put unless the key holds a
+	// different value, verifying the uniqueness of unique index entries.
+	PutUnique
+	// ScanChanges fetches the committed versions of the keys between
+	// args.RequestHeader.Key and args.RequestHeader.EndKey which were
+	// written within a time interval, including deletions.
+	ScanChanges
 	// ReapQueue scans and deletes messages from a recipient message
 	// queue. ReapQueueRequest invocations must be part of an extant
 	// transaction or they fail. Returns the reaped queue messsages, up to
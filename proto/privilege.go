@@ -0,0 +1,140 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"fmt"
+	"sort"
+)
+
+// RootUser is the name of the user holding all privileges on every table.
+const RootUser = "root"
+
+// allBit is the bit of the ALL privilege.
+const allBit = 1 << uint32(PrivilegeDescriptor_ALL)
+
+// allPrivileges lists every privilege implied by ALL, in display order.
+var allPrivileges = []PrivilegeDescriptor_Kind{
+	PrivilegeDescriptor_READ,
+	PrivilegeDescriptor_WRITE,
+	PrivilegeDescriptor_GRANT,
+	PrivilegeDescriptor_DROP,
+}
+
+func privilegeBits(kinds []PrivilegeDescriptor_Kind) uint32 {
+	var bits uint32
+	for _, kind := range kinds {
+		bits |= 1 << uint32(kind)
+	}
+	return bits
+}
+
+// NewDefaultPrivilegeDescriptor returns the privileges of a new table:
+// the root user holds ALL privileges.
+func NewDefaultPrivilegeDescriptor() PrivilegeDescriptor {
+	return PrivilegeDescriptor{
+		Users: []UserPrivileges{
+			{User: RootUser, Privileges: allBit},
+		},
+	}
+}
+
+// findUser returns the index of the user within p.Users, or the index at
+// which the user would be inserted and false.
+func (p *PrivilegeDescriptor) findUser(user string) (int, bool) {
+	i := sort.Search(len(p.Users), func(i int) bool { return p.Users[i].User >= user })
+	return i, i < len(p.Users) && p.Users[i].User == user
+}
+
+// Grant grants the privileges to the user.
+func (p *PrivilegeDescriptor) Grant(user string, kinds []PrivilegeDescriptor_Kind) {
+	i, ok := p.findUser(user)
+	if !ok {
+		p.Users = append(p.Users, UserPrivileges{})
+		copy(p.Users[i+1:], p.Users[i:])
+		p.Users[i] = UserPrivileges{User: user}
+	}
+	p.Users[i].Privileges |= privilegeBits(kinds)
+	if p.Users[i].Privileges&allBit != 0 {
+		// ALL subsumes every other privilege.
+		p.Users[i].Privileges = allBit
+	}
+}
+
+// Revoke revokes the privileges from the user. Revoking a privilege
+// implied by ALL leaves the user with the remaining privileges. Users left
+// without privileges are removed.
+func (p *PrivilegeDescriptor) Revoke(user string, kinds []PrivilegeDescriptor_Kind) {
+	i, ok := p.findUser(user)
+	if !ok {
+		return
+	}
+	bits := p.Users[i].Privileges
+	if bits&allBit != 0 {
+		bits = privilegeBits(allPrivileges)
+	}
+	if revoked := privilegeBits(kinds); revoked&allBit != 0 {
+		bits = 0
+	} else {
+		bits &^= revoked
+	}
+	if bits == 0 {
+		p.Users = append(p.Users[:i], p.Users[i+1:]...)
+		return
+	}
+	p.Users[i].Privileges = bits
+}
+
+// CheckPrivilege returns true if the user holds the privilege, either
+// directly or through ALL.
+func (p *PrivilegeDescriptor) CheckPrivilege(user string, kind PrivilegeDescriptor_Kind) bool {
+	i, ok := p.findUser(user)
+	if !ok {
+		return false
+	}
+	return p.Users[i].Privileges&(allBit|1<<uint32(kind)) != 0
+}
+
+// UserPrivilegeString describes the privileges of a user by name.
+type UserPrivilegeString struct {
+	User       string
+	Privileges []string
+}
+
+// Show returns the privileges of every user, sorted by user name.
+func (p *PrivilegeDescriptor) Show() []UserPrivilegeString {
+	var ret []UserPrivilegeString
+	for _, u := range p.Users {
+		s := UserPrivilegeString{User: u.User}
+		for _, kind := range append([]PrivilegeDescriptor_Kind{PrivilegeDescriptor_ALL}, allPrivileges...) {
+			if u.Privileges&(1<<uint32(kind)) != 0 {
+				s.Privileges = append(s.Privileges, kind.String())
+			}
+		}
+		ret = append(ret, s)
+	}
+	return ret
+}
+
+// ParsePrivilege returns the privilege with the given name, such as
+// "READ".
+func ParsePrivilege(name string) (PrivilegeDescriptor_Kind, error) {
+	v, ok := PrivilegeDescriptor_Kind_value[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown privilege %q", name)
+	}
+	return PrivilegeDescriptor_Kind(v), nil
+}
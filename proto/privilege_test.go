@@ -0,0 +1,94 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestPrivilegeDescriptor(t *testing.T) {
+	const (
+		all   = PrivilegeDescriptor_ALL
+		read  = PrivilegeDescriptor_READ
+		write = PrivilegeDescriptor_WRITE
+		grant = PrivilegeDescriptor_GRANT
+	)
+	type kinds []PrivilegeDescriptor_Kind
+
+	p := NewDefaultPrivilegeDescriptor()
+	testData := []struct {
+		grant    bool
+		user     string
+		kinds    kinds
+		expected []UserPrivilegeString
+	}{
+		{true, "bob", kinds{read}, []UserPrivilegeString{
+			{"bob", []string{"READ"}},
+			{"root", []string{"ALL"}},
+		}},
+		{true, "alice", kinds{read, write}, []UserPrivilegeString{
+			{"alice", []string{"READ", "WRITE"}},
+			{"bob", []string{"READ"}},
+			{"root", []string{"ALL"}},
+		}},
+		{true, "bob", kinds{write, all}, []UserPrivilegeString{
+			{"alice", []string{"READ", "WRITE"}},
+			{"bob", []string{"ALL"}},
+			{"root", []string{"ALL"}},
+		}},
+		{false, "bob", kinds{grant}, []UserPrivilegeString{
+			{"alice", []string{"READ", "WRITE"}},
+			{"bob", []string{"READ", "WRITE", "DROP"}},
+			{"root", []string{"ALL"}},
+		}},
+		{false, "alice", kinds{read, write}, []UserPrivilegeString{
+			{"bob", []string{"READ", "WRITE", "DROP"}},
+			{"root", []string{"ALL"}},
+		}},
+		{false, "bob", kinds{all}, []UserPrivilegeString{
+			{"root", []string{"ALL"}},
+		}},
+		{false, "carl", kinds{all}, []UserPrivilegeString{
+			{"root", []string{"ALL"}},
+		}},
+	}
+	for i, d := range testData {
+		if d.grant {
+			p.Grant(d.user, d.kinds)
+		} else {
+			p.Revoke(d.user, d.kinds)
+		}
+		if show := p.Show(); !reflect.DeepEqual(d.expected, show) {
+			t.Errorf("%d: expected %v, but found %v", i, d.expected, show)
+		}
+	}
+
+	p.Grant("bob", kinds{read})
+	if !p.CheckPrivilege("bob", read) || p.CheckPrivilege("bob", write) ||
+		!p.CheckPrivilege("root", write) || p.CheckPrivilege("carl", read) {
+		t.Errorf("unexpected privileges: %v", p.Show())
+	}
+}
+
+func TestParsePrivilege(t *testing.T) {
+	if kind, err := ParsePrivilege("WRITE"); err != nil || kind != PrivilegeDescriptor_WRITE {
+		t.Errorf("unexpected result: %s, %v", kind, err)
+	}
+	if _, err := ParsePrivilege("FLY"); err == nil || err.Error() != `unknown privilege "FLY"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
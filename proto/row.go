@@ -0,0 +1,211 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+// Cell returns the value of the specified column of the row, or nil if
+// the column is NULL.
+func (r *Row) Cell(columnID uint32) []byte {
+	for _, cell := range r.Cells {
+		if cell.ColumnId == columnID {
+			return cell.Value
+		}
+	}
+	return nil
+}
+
+// Match returns true if the row satisfies the filter.
+func (f *RowFilter) Match(r *Row) (bool, error) {
+	v := r.Cell(f.ColumnId)
+	switch f.Op {
+	case RowFilter_IS_NULL:
+		return v == nil, nil
+	case RowFilter_IS_NOT_NULL:
+		return v != nil, nil
+	}
+	if v == nil {
+		return false, nil
+	}
+	c, err := compareCells(f.Type, v, f.Value)
+	if err != nil {
+		return false, err
+	}
+	switch f.Op {
+	case RowFilter_EQ:
+		return c == 0, nil
+	case RowFilter_NE:
+		return c != 0, nil
+	case RowFilter_LT:
+		return c < 0, nil
+	case RowFilter_LE:
+		return c <= 0, nil
+	case RowFilter_GT:
+		return c > 0, nil
+	case RowFilter_GE:
+		return c >= 0, nil
+	}
+	return false, fmt.Errorf("unknown row filter op %s", f.Op)
+}
+
+// compareCells compares the stored representations of two values of the
+// specified type, returning -1, 0 or 1.
+func compareCells(typ RowFilter_Type, a, b []byte) (int, error) {
+	if typ != RowFilter_FLOAT {
+		return bytes.Compare(a, b), nil
+	}
+	fa, err := decodeFloatCell(a)
+	if err != nil {
+		return 0, err
+	}
+	fb, err := decodeFloatCell(b)
+	if err != nil {
+		return 0, err
+	}
+	switch {
+	case fa < fb:
+		return -1, nil
+	case fa > fb:
+		return 1, nil
+	}
+	return 0, nil
+}
+
+func decodeFloatCell(b []byte) (float64, error) {
+	if len(b) != 8 {
+		return 0, fmt.Errorf("unable to decode float value: %q", b)
+	}
+	_, u := encoding.DecodeUint64(b)
+	return math.Float64frombits(u), nil
+}
+
+func decodeIntCell(b []byte) (i int64, err error) {
+	// DecodeVarint panics on malformed input.
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("unable to decode int value: %q", b)
+		}
+	}()
+	rest, i := encoding.DecodeVarint(b)
+	if len(rest) > 0 {
+		return 0, fmt.Errorf("unable to decode int value: %q", b)
+	}
+	return i, nil
+}
+
+// RowExpired returns true if the row whose sentinel value is given has
+// expired at now given the time to live of the rows of its table. Rows
+// never expire if ttlSeconds is zero.
+func RowExpired(sentinel *Value, ttlSeconds int32, now Timestamp) bool {
+	if ttlSeconds <= 0 || sentinel == nil || sentinel.Timestamp == nil {
+		return false
+	}
+	return sentinel.Timestamp.WallTime+int64(ttlSeconds)*1E9 <= now.WallTime
+}
+
+// MatchRow returns true if the row satisfies all of the filters.
+func MatchRow(r *Row, filters []RowFilter) (bool, error) {
+	for i := range filters {
+		if ok, err := filters[i].Match(r); !ok || err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// Add adds the value of the aggregated column of a row to the result.
+func (r *RowAggregateResult) Add(row *Row) error {
+	if r.Aggregate.Func == RowAggregate_COUNT && r.Aggregate.ColumnId == 0 {
+		r.Count++
+		return nil
+	}
+	if v := row.Cell(r.Aggregate.ColumnId); v != nil {
+		return r.add(1, v)
+	}
+	return nil
+}
+
+// Merge merges the result of the same aggregate over other rows, such as
+// those of another range, into the result.
+func (r *RowAggregateResult) Merge(other *RowAggregateResult) error {
+	a, b := r.Aggregate, other.Aggregate
+	if a.Func != b.Func || a.ColumnId != b.ColumnId || a.Type != b.Type {
+		return fmt.Errorf("cannot merge results of different aggregates %s and %s", &a, &b)
+	}
+	if other.Value == nil {
+		r.Count += other.Count
+		return nil
+	}
+	return r.add(other.Count, other.Value)
+}
+
+// add folds count values aggregated into v into the result.
+func (r *RowAggregateResult) add(count int64, v []byte) error {
+	typ := r.Aggregate.Type
+	switch r.Aggregate.Func {
+	case RowAggregate_COUNT:
+	case RowAggregate_SUM:
+		switch {
+		case typ == RowFilter_INT:
+			a, err := decodeIntCell(v)
+			if err != nil {
+				return err
+			}
+			var b int64
+			if r.Value != nil {
+				if b, err = decodeIntCell(r.Value); err != nil {
+					return err
+				}
+			}
+			r.Value = encoding.EncodeVarint(nil, a+b)
+		case typ == RowFilter_FLOAT:
+			a, err := decodeFloatCell(v)
+			if err != nil {
+				return err
+			}
+			var b float64
+			if r.Value != nil {
+				if b, err = decodeFloatCell(r.Value); err != nil {
+					return err
+				}
+			}
+			r.Value = encoding.EncodeUint64(nil, math.Float64bits(a+b))
+		default:
+			return fmt.Errorf("cannot sum %s values", typ)
+		}
+	case RowAggregate_MIN, RowAggregate_MAX:
+		if r.Value != nil {
+			c, err := compareCells(typ, v, r.Value)
+			if err != nil {
+				return err
+			}
+			if (r.Aggregate.Func == RowAggregate_MIN) != (c < 0) || c == 0 {
+				break
+			}
+		}
+		r.Value = append([]byte(nil), v...)
+	default:
+		return fmt.Errorf("unknown aggregate function %s", r.Aggregate.Func)
+	}
+	r.Count += count
+	return nil
+}
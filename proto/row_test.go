@@ -0,0 +1,159 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"bytes"
+	"math"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util/encoding"
+)
+
+func floatCell(f float64) []byte {
+	return encoding.EncodeUint64(nil, math.Float64bits(f))
+}
+
+func TestRowFilterMatch(t *testing.T) {
+	r := &Row{Cells: []RowCell{
+		{ColumnId: 1, Value: []byte("b")},
+		{ColumnId: 2, Value: floatCell(-1.5)},
+	}}
+	testCases := []struct {
+		filter RowFilter
+		expOK  bool
+	}{
+		{RowFilter{ColumnId: 1, Op: RowFilter_EQ, Value: []byte("b")}, true},
+		{RowFilter{ColumnId: 1, Op: RowFilter_NE, Value: []byte("b")}, false},
+		{RowFilter{ColumnId: 1, Op: RowFilter_LT, Value: []byte("c")}, true},
+		{RowFilter{ColumnId: 1, Op: RowFilter_LE, Value: []byte("a")}, false},
+		{RowFilter{ColumnId: 1, Op: RowFilter_GT, Value: []byte("a")}, true},
+		{RowFilter{ColumnId: 1, Op: RowFilter_GE, Value: []byte("c")}, false},
+		{RowFilter{ColumnId: 1, Op: RowFilter_IS_NOT_NULL}, true},
+		// Negative floats do not order as their bytes.
+		{RowFilter{ColumnId: 2, Op: RowFilter_LT, Type: RowFilter_FLOAT, Value: floatCell(1)}, true},
+		{RowFilter{ColumnId: 2, Op: RowFilter_GT, Type: RowFilter_FLOAT, Value: floatCell(-2)}, true},
+		// NULL only satisfies IS NULL.
+		{RowFilter{ColumnId: 3, Op: RowFilter_IS_NULL}, true},
+		{RowFilter{ColumnId: 3, Op: RowFilter_NE, Value: []byte("b")}, false},
+	}
+	for i, test := range testCases {
+		ok, err := test.filter.Match(r)
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if ok != test.expOK {
+			t.Errorf("%d: expected %t, but found %t", i, test.expOK, ok)
+		}
+	}
+
+	if _, err := (&RowFilter{ColumnId: 1, Op: RowFilter_EQ, Type: RowFilter_FLOAT}).Match(r); err == nil {
+		t.Error("expected an error comparing a malformed float")
+	}
+	filters := []RowFilter{
+		{ColumnId: 1, Op: RowFilter_EQ, Value: []byte("b")},
+		{ColumnId: 3, Op: RowFilter_IS_NOT_NULL},
+	}
+	if ok, err := MatchRow(r, filters); ok || err != nil {
+		t.Errorf("expected no match, but found %t, %v", ok, err)
+	}
+	if ok, err := MatchRow(r, filters[:1]); !ok || err != nil {
+		t.Errorf("expected a match, but found %t, %v", ok, err)
+	}
+}
+
+func TestRowAggregateResult(t *testing.T) {
+	intCell := func(i int64) []byte { return encoding.EncodeVarint(nil, i) }
+	rows := []Row{
+		{Cells: []RowCell{{ColumnId: 1, Value: intCell(-3)}, {ColumnId: 2, Value: floatCell(1.5)}}},
+		{Cells: []RowCell{{ColumnId: 1, Value: intCell(5)}}},
+		{Cells: []RowCell{{ColumnId: 1, Value: intCell(2)}, {ColumnId: 2, Value: floatCell(-4)}}},
+	}
+	testCases := []struct {
+		aggregate RowAggregate
+		expCount  int64
+		expValue  []byte
+	}{
+		{RowAggregate{Func: RowAggregate_COUNT}, 3, nil},
+		{RowAggregate{Func: RowAggregate_COUNT, ColumnId: 2}, 2, nil},
+		{RowAggregate{Func: RowAggregate_COUNT, ColumnId: 3}, 0, nil},
+		{RowAggregate{Func: RowAggregate_SUM, ColumnId: 1, Type: RowFilter_INT}, 3, intCell(4)},
+		{RowAggregate{Func: RowAggregate_SUM, ColumnId: 2, Type: RowFilter_FLOAT}, 2, floatCell(-2.5)},
+		{RowAggregate{Func: RowAggregate_MIN, ColumnId: 1, Type: RowFilter_INT}, 3, intCell(-3)},
+		{RowAggregate{Func: RowAggregate_MAX, ColumnId: 1, Type: RowFilter_INT}, 3, intCell(5)},
+		{RowAggregate{Func: RowAggregate_MIN, ColumnId: 2, Type: RowFilter_FLOAT}, 2, floatCell(-4)},
+		{RowAggregate{Func: RowAggregate_MAX, ColumnId: 2, Type: RowFilter_FLOAT}, 2, floatCell(1.5)},
+		{RowAggregate{Func: RowAggregate_MAX, ColumnId: 3}, 0, nil},
+	}
+	for i, test := range testCases {
+		// Aggregate the rows one by one, and as two separately aggregated
+		// results merged together.
+		all := RowAggregateResult{Aggregate: test.aggregate}
+		first := RowAggregateResult{Aggregate: test.aggregate}
+		second := RowAggregateResult{Aggregate: test.aggregate}
+		for j := range rows {
+			if err := all.Add(&rows[j]); err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+			part := &first
+			if j > 0 {
+				part = &second
+			}
+			if err := part.Add(&rows[j]); err != nil {
+				t.Fatalf("%d: %s", i, err)
+			}
+		}
+		if err := first.Merge(&second); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		for _, r := range []RowAggregateResult{all, first} {
+			if r.Count != test.expCount || !bytes.Equal(r.Value, test.expValue) {
+				t.Errorf("%d: expected %d, %q, but found %d, %q", i, test.expCount, test.expValue, r.Count, r.Value)
+			}
+		}
+	}
+
+	sum := RowAggregateResult{Aggregate: RowAggregate{Func: RowAggregate_SUM, ColumnId: 1}}
+	if err := sum.Add(&rows[0]); err == nil {
+		t.Error("expected an error summing bytes")
+	}
+	count := RowAggregateResult{Aggregate: RowAggregate{Func: RowAggregate_COUNT}}
+	if err := count.Merge(&sum); err == nil {
+		t.Error("expected an error merging different aggregates")
+	}
+}
+
+func TestRowExpired(t *testing.T) {
+	written := &Value{Bytes: []byte{}, Timestamp: &Timestamp{WallTime: 10E9}}
+	testCases := []struct {
+		sentinel *Value
+		ttl      int32
+		now      int64
+		expected bool
+	}{
+		{written, 0, 100E9, false},
+		{written, 5, 14E9, false},
+		{written, 5, 15E9, true},
+		{written, 5, 100E9, true},
+		{&Value{Bytes: []byte{}}, 5, 100E9, false},
+		{nil, 5, 100E9, false},
+	}
+	for i, test := range testCases {
+		if expired := RowExpired(test.sentinel, test.ttl, Timestamp{WallTime: test.now}); expired != test.expected {
+			t.Errorf("%d: expected %t, but found %t", i, test.expected, expired)
+		}
+	}
+}
@@ -0,0 +1,455 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+func validateName(name, typ string) error {
+	if len(name) == 0 {
+		return fmt.Errorf("empty %s name", typ)
+	}
+	return nil
+}
+
+// IsIndexable returns true if values of the column type have a key
+// encoding and can thus be part of an index.
+func (t Column_ColumnType) IsIndexable() bool {
+	switch t {
+	case Column_JSON:
+		return false
+	}
+	return true
+}
+
+// CurrentFormatVersion is the descriptor layout written by this version of
+// the code. Descriptors in older layouts are upgraded when read.
+const CurrentFormatVersion = TableDescriptor_PRIMARY_INDEX
+
+// AllIndexes returns the primary index followed by the secondary indexes of
+// the table.
+func (desc *TableDescriptor) AllIndexes() []IndexDescriptor {
+	indexes := make([]IndexDescriptor, 0, 1+len(desc.Indexes))
+	indexes = append(indexes, desc.PrimaryIndex)
+	return append(indexes, desc.Indexes...)
+}
+
+// MaybeUpgradeTableDescriptor translates a table descriptor read from disk in
+// an older format version into the current layout. It must be invoked on
+// every descriptor read before the descriptor is used or validated. Returns
+// true if the descriptor was modified, in which case callers may choose to
+// write back the upgraded descriptor.
+func MaybeUpgradeTableDescriptor(desc *TableDescriptor) (bool, error) {
+	if desc.FormatVersion > CurrentFormatVersion {
+		return false, fmt.Errorf("table %q: unsupported format version %d", desc.Name, desc.FormatVersion)
+	}
+	if desc.FormatVersion == CurrentFormatVersion {
+		return false, nil
+	}
+
+	if desc.FormatVersion < TableDescriptor_COLUMN_IDS {
+		// Index columns were referenced by name. Translate them to IDs.
+		columnIDs := map[string]uint32{}
+		for _, column := range desc.Columns {
+			columnIDs[column.Name] = column.Id
+		}
+		for i := range desc.Indexes {
+			index := &desc.Indexes[i]
+			if len(index.ColumnIds) > 0 {
+				continue
+			}
+			for _, name := range index.ColumnNames {
+				id, ok := columnIDs[name]
+				if !ok {
+					return false, fmt.Errorf("table %q: index %q contains unknown column %q",
+						desc.Name, index.Name, name)
+				}
+				index.ColumnIds = append(index.ColumnIds, id)
+			}
+			index.ColumnNames = nil
+		}
+	}
+
+	if desc.FormatVersion < TableDescriptor_PRIMARY_INDEX {
+		// The primary key was stored as the first of the indexes.
+		if len(desc.Indexes) > 0 {
+			desc.PrimaryIndex = desc.Indexes[0]
+			desc.Indexes = desc.Indexes[1:]
+			if len(desc.Indexes) == 0 {
+				desc.Indexes = nil
+			}
+		}
+	}
+
+	desc.FormatVersion = CurrentFormatVersion
+	return true, nil
+}
+
+// ValidateTableDesc validates that the table descriptor is well formed. Checks
+// include validating the table, column and index names, verifying that column
+// names and index names are unique, verifying that column IDs and index IDs
+// are consistent, verifying that every indexed column is of an indexable
+// type, verifying that computed columns and index expressions only reference
+// existing columns and that computed columns do not reference other computed
+// columns. Validation does not stop at the first problem: if any are found, a
+// util.Errors listing all of them is returned.
+func ValidateTableDesc(desc TableDescriptor) error {
+	var errs util.Errors
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	if err := validateName(desc.Name, "table"); err != nil {
+		errs = append(errs, err)
+	}
+	if desc.Id == 0 {
+		addErr("invalid table ID 0")
+	}
+	if desc.ParentId == 0 {
+		addErr("invalid parent ID 0")
+	}
+	if desc.TTLSeconds < 0 {
+		addErr("invalid TTL %ds", desc.TTLSeconds)
+	}
+
+	if len(desc.Columns) == 0 {
+		addErr("table must contain at least 1 column")
+	}
+
+	columnNames := map[string]uint32{}
+	columns := map[uint32]*ColumnDescriptor{}
+	for i := range desc.Columns {
+		column := &desc.Columns[i]
+		if err := validateName(column.Name, "column"); err != nil {
+			errs = append(errs, err)
+		}
+		if column.Id == 0 {
+			addErr("invalid column ID 0")
+		}
+
+		if _, ok := columnNames[column.Name]; ok {
+			addErr("duplicate column name: %q", column.Name)
+		} else {
+			columnNames[column.Name] = column.Id
+		}
+
+		if other, ok := columns[column.Id]; ok {
+			addErr("column %q duplicate ID of column %q: %d",
+				column.Name, other.Name, column.Id)
+		} else {
+			columns[column.Id] = column
+		}
+
+		if column.Id >= desc.NextColumnId {
+			addErr("column %q invalid ID (%d) >= next column ID (%d)",
+				column.Name, column.Id, desc.NextColumnId)
+		}
+	}
+
+	for i := range desc.Columns {
+		column := &desc.Columns[i]
+		if column.ComputeExpr == "" {
+			continue
+		}
+		expr, err := ParseExpr(column.ComputeExpr)
+		if err != nil {
+			addErr("column %q invalid compute expression: %s", column.Name, err)
+			continue
+		}
+		for _, name := range ExprColumns(expr) {
+			id, ok := columnNames[name]
+			if !ok {
+				addErr("column %q compute expression references unknown column %q",
+					column.Name, name)
+			} else if columns[id].ComputeExpr != "" {
+				addErr("column %q compute expression references computed column %q",
+					column.Name, name)
+			}
+		}
+	}
+
+	if len(desc.PrimaryIndex.KeyExprs) > 0 {
+		addErr("primary index %q cannot contain expressions", desc.PrimaryIndex.Name)
+	} else if len(desc.PrimaryIndex.ColumnIds) == 0 {
+		addErr("table must contain a primary key")
+	}
+
+	indexNames := map[string]struct{}{}
+	indexIDs := map[uint32]string{}
+	for i, index := range desc.AllIndexes() {
+		if i == 0 && (len(index.ColumnIds) == 0 || len(index.KeyExprs) > 0) {
+			// The invalid primary key has been reported above.
+			continue
+		}
+		if err := validateName(index.Name, "index"); err != nil {
+			errs = append(errs, err)
+		}
+		if index.Id == 0 {
+			addErr("invalid index ID 0")
+		}
+
+		if _, ok := indexNames[index.Name]; ok {
+			addErr("duplicate index name: %q", index.Name)
+		} else {
+			indexNames[index.Name] = struct{}{}
+		}
+
+		if other, ok := indexIDs[index.Id]; ok {
+			addErr("index %q duplicate ID of index %q: %d",
+				index.Name, other, index.Id)
+		} else {
+			indexIDs[index.Id] = index.Name
+		}
+
+		if index.Id >= desc.NextIndexId {
+			addErr("index %q invalid ID (%d) >= next index ID (%d)",
+				index.Name, index.Id, desc.NextIndexId)
+		}
+
+		if len(index.ColumnIds) == 0 && len(index.KeyExprs) == 0 {
+			addErr("index %q must contain at least 1 column", index.Name)
+		}
+		if len(index.ColumnIds) > 0 && len(index.KeyExprs) > 0 {
+			addErr("index %q cannot contain both columns and expressions", index.Name)
+		}
+		for _, keyExpr := range index.KeyExprs {
+			expr, err := ParseExpr(keyExpr)
+			if err != nil {
+				addErr("index %q invalid expression: %s", index.Name, err)
+				continue
+			}
+			for _, name := range ExprColumns(expr) {
+				id, ok := columnNames[name]
+				if !ok {
+					addErr("index %q expression %q references unknown column %q",
+						index.Name, keyExpr, name)
+					continue
+				}
+				if column := columns[id]; !column.Type.IsIndexable() {
+					addErr("index %q expression %q references column %q of unindexable type %s",
+						index.Name, keyExpr, column.Name, column.Type)
+				}
+			}
+		}
+		for _, id := range index.ColumnIds {
+			column, ok := columns[id]
+			if !ok {
+				addErr("index %q contains unknown column ID %d", index.Name, id)
+				continue
+			}
+			if !column.Type.IsIndexable() {
+				addErr("index %q contains column %q of unindexable type %s",
+					index.Name, column.Name, column.Type)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// ValidateSchemaSet validates a set of table descriptors, such as the
+// contents of a backup or a schema about to be applied in bulk. In addition
+// to validating each descriptor individually, it checks the invariants
+// spanning tables: table IDs are unique, table names are unique within their
+// database, foreign keys reference existing unique indexes and interleave
+// parents exist. A util.Errors listing all problems is returned.
+func ValidateSchemaSet(descs []TableDescriptor) error {
+	var errs util.Errors
+	addErr := func(format string, args ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, args...))
+	}
+
+	type qualifiedName struct {
+		parentID uint32
+		name     string
+	}
+	byID := map[uint32]*TableDescriptor{}
+	names := map[qualifiedName]struct{}{}
+	for i := range descs {
+		desc := &descs[i]
+		if err := ValidateTableDesc(*desc); err != nil {
+			for _, err := range err.(util.Errors) {
+				addErr("table %q: %s", desc.Name, err)
+			}
+		}
+		if other, ok := byID[desc.Id]; ok {
+			addErr("table %q duplicate ID of table %q: %d", desc.Name, other.Name, desc.Id)
+		} else {
+			byID[desc.Id] = desc
+		}
+		name := qualifiedName{desc.ParentId, desc.Name}
+		if _, ok := names[name]; ok {
+			addErr("duplicate table name %q in database %d", desc.Name, desc.ParentId)
+		} else {
+			names[name] = struct{}{}
+		}
+	}
+
+	for i := range descs {
+		desc := &descs[i]
+		if parentID := desc.InterleaveParentId; parentID != 0 {
+			if parentID == desc.Id {
+				addErr("table %q: cannot be interleaved with itself", desc.Name)
+			} else if _, ok := byID[parentID]; !ok {
+				addErr("table %q: interleave parent %d does not exist", desc.Name, parentID)
+			}
+		}
+
+		for _, index := range desc.AllIndexes() {
+			fk := index.ForeignKey
+			if fk == nil {
+				continue
+			}
+			ref, ok := byID[fk.TableId]
+			if !ok {
+				addErr("table %q: index %q foreign key references unknown table %d",
+					desc.Name, index.Name, fk.TableId)
+				continue
+			}
+			var refIndex *IndexDescriptor
+			for _, other := range ref.AllIndexes() {
+				if other.Id == fk.IndexId {
+					refIndex = &other
+					break
+				}
+			}
+			if refIndex == nil {
+				addErr("table %q: index %q foreign key references unknown index %d of table %q",
+					desc.Name, index.Name, fk.IndexId, ref.Name)
+			} else if !refIndex.Unique {
+				addErr("table %q: index %q foreign key references non-unique index %q of table %q",
+					desc.Name, index.Name, refIndex.Name, ref.Name)
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// MaybeFixDescriptor repairs the column and index ID counters of a table
+// descriptor which are not greater than the largest column and index IDs in
+// use, such as after a faulty manual edit. The counters are only ever raised
+// so that deleted IDs are never reused. A description of each change is
+// returned; a nil result means the descriptor was left untouched.
+func MaybeFixDescriptor(desc *TableDescriptor) []string {
+	var changes []string
+
+	var maxColumnID uint32
+	for _, column := range desc.Columns {
+		if column.Id > maxColumnID {
+			maxColumnID = column.Id
+		}
+	}
+	if desc.NextColumnId <= maxColumnID {
+		changes = append(changes, fmt.Sprintf("next column ID: %d -> %d",
+			desc.NextColumnId, maxColumnID+1))
+		desc.NextColumnId = maxColumnID + 1
+	}
+
+	var maxIndexID uint32
+	for _, index := range desc.AllIndexes() {
+		if index.Id > maxIndexID {
+			maxIndexID = index.Id
+		}
+	}
+	if desc.NextIndexId <= maxIndexID {
+		changes = append(changes, fmt.Sprintf("next index ID: %d -> %d",
+			desc.NextIndexId, maxIndexID+1))
+		desc.NextIndexId = maxIndexID + 1
+	}
+
+	return changes
+}
+
+// ValidateDatabaseDesc validates that the database descriptor is well
+// formed. Checks include validating the database name and ID.
+func ValidateDatabaseDesc(desc DatabaseDescriptor) error {
+	if err := validateName(desc.Name, "database"); err != nil {
+		return err
+	}
+	if desc.Id == 0 {
+		return fmt.Errorf("invalid database ID 0")
+	}
+	return nil
+}
+
+// ValidateSequenceDesc validates that the sequence descriptor is well
+// formed. Checks include validating the sequence name and that the
+// sequence can make progress.
+func ValidateSequenceDesc(desc SequenceDescriptor) error {
+	if err := validateName(desc.Name, "sequence"); err != nil {
+		return err
+	}
+	if desc.Id == 0 {
+		return fmt.Errorf("invalid sequence ID 0")
+	}
+	if desc.Increment == 0 {
+		return fmt.Errorf("sequence %q: increment must not be zero", desc.Name)
+	}
+	if desc.CacheSize < 0 {
+		return fmt.Errorf("sequence %q: invalid cache size %d", desc.Name, desc.CacheSize)
+	}
+	return nil
+}
+
+// Value returns the n-th value handed out by the sequence, where the
+// first value (n == 0) is the sequence start.
+func (desc SequenceDescriptor) Value(n int64) int64 {
+	return desc.Start + n*desc.Increment
+}
+
+// ValidateViewDesc validates that the view descriptor is well formed
+// with respect to its base table. Checks include validating the view name
+// and that every referenced column exists in the base table exactly once.
+func ValidateViewDesc(desc ViewDescriptor, table TableDescriptor) error {
+	if err := validateName(desc.Name, "view"); err != nil {
+		return err
+	}
+	if desc.Id == 0 {
+		return fmt.Errorf("invalid view ID 0")
+	}
+	if desc.TableId != table.Id {
+		return fmt.Errorf("view %q: references table %d, not %d", desc.Name, desc.TableId, table.Id)
+	}
+	if len(desc.ColumnIds) == 0 {
+		return fmt.Errorf("view %q: must contain at least 1 column", desc.Name)
+	}
+
+	columnIDs := map[uint32]struct{}{}
+	for _, column := range table.Columns {
+		columnIDs[column.Id] = struct{}{}
+	}
+	seen := map[uint32]struct{}{}
+	for _, id := range desc.ColumnIds {
+		if _, ok := columnIDs[id]; !ok {
+			return fmt.Errorf("view %q: column %d does not exist in table %q", desc.Name, id, table.Name)
+		}
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("view %q: duplicate column %d", desc.Name, id)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
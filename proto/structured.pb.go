@@ -20,14 +20,31 @@ var _ = math.Inf
 type Column_ColumnType int32
 
 const (
-	Column_BYTES Column_ColumnType = 0
+	Column_BYTES  Column_ColumnType = 0
+	Column_BOOL   Column_ColumnType = 1
+	Column_INT    Column_ColumnType = 2
+	Column_FLOAT  Column_ColumnType = 3
+	Column_STRING Column_ColumnType = 4
+	// JSON columns hold documents which have no key encoding and thus
+	// cannot be part of an index.
+	Column_JSON Column_ColumnType = 5
 )
 
 var Column_ColumnType_name = map[int32]string{
 	0: "BYTES",
+	1: "BOOL",
+	2: "INT",
+	3: "FLOAT",
+	4: "STRING",
+	5: "JSON",
 }
 var Column_ColumnType_value = map[string]int32{
-	"BYTES": 0,
+	"BYTES":  0,
+	"BOOL":   1,
+	"INT":    2,
+	"FLOAT":  3,
+	"STRING": 4,
+	"JSON":   5,
 }
 
 func (x Column_ColumnType) Enum() *Column_ColumnType {
@@ -47,8 +64,190 @@ func (x *Column_ColumnType) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Kind is a kind of privilege.
+type PrivilegeDescriptor_Kind int32
+
+const (
+	// ALL implies every other privilege.
+	PrivilegeDescriptor_ALL PrivilegeDescriptor_Kind = 0
+	// READ allows reading rows.
+	PrivilegeDescriptor_READ PrivilegeDescriptor_Kind = 1
+	// WRITE allows writing and deleting rows.
+	PrivilegeDescriptor_WRITE PrivilegeDescriptor_Kind = 2
+	// GRANT allows granting and revoking privileges.
+	PrivilegeDescriptor_GRANT PrivilegeDescriptor_Kind = 3
+	// DROP allows dropping the table.
+	PrivilegeDescriptor_DROP PrivilegeDescriptor_Kind = 4
+)
+
+var PrivilegeDescriptor_Kind_name = map[int32]string{
+	0: "ALL",
+	1: "READ",
+	2: "WRITE",
+	3: "GRANT",
+	4: "DROP",
+}
+var PrivilegeDescriptor_Kind_value = map[string]int32{
+	"ALL":   0,
+	"READ":  1,
+	"WRITE": 2,
+	"GRANT": 3,
+	"DROP":  4,
+}
+
+func (x PrivilegeDescriptor_Kind) Enum() *PrivilegeDescriptor_Kind {
+	p := new(PrivilegeDescriptor_Kind)
+	*p = x
+	return p
+}
+func (x PrivilegeDescriptor_Kind) String() string {
+	return proto1.EnumName(PrivilegeDescriptor_Kind_name, int32(x))
+}
+func (x *PrivilegeDescriptor_Kind) UnmarshalJSON(data []byte) error {
+	value, err := proto1.UnmarshalJSONEnum(PrivilegeDescriptor_Kind_value, data, "PrivilegeDescriptor_Kind")
+	if err != nil {
+		return err
+	}
+	*x = PrivilegeDescriptor_Kind(value)
+	return nil
+}
+
+// FormatVersion identifies the on-disk layout of a descriptor. Older
+// layouts are translated by MaybeUpgradeTableDescriptor when read.
+type TableDescriptor_FormatVersion int32
+
+const (
+	// BASE descriptors reference index columns by name.
+	TableDescriptor_BASE TableDescriptor_FormatVersion = 0
+	// COLUMN_IDS descriptors reference index columns by ID and store the
+	// primary key as the first of indexes.
+	TableDescriptor_COLUMN_IDS TableDescriptor_FormatVersion = 1
+	// PRIMARY_INDEX descriptors store the primary key in primary_index and
+	// only secondary indexes in indexes.
+	TableDescriptor_PRIMARY_INDEX TableDescriptor_FormatVersion = 2
+)
+
+var TableDescriptor_FormatVersion_name = map[int32]string{
+	0: "BASE",
+	1: "COLUMN_IDS",
+	2: "PRIMARY_INDEX",
+}
+var TableDescriptor_FormatVersion_value = map[string]int32{
+	"BASE":          0,
+	"COLUMN_IDS":    1,
+	"PRIMARY_INDEX": 2,
+}
+
+func (x TableDescriptor_FormatVersion) Enum() *TableDescriptor_FormatVersion {
+	p := new(TableDescriptor_FormatVersion)
+	*p = x
+	return p
+}
+func (x TableDescriptor_FormatVersion) String() string {
+	return proto1.EnumName(TableDescriptor_FormatVersion_name, int32(x))
+}
+func (x *TableDescriptor_FormatVersion) UnmarshalJSON(data []byte) error {
+	value, err := proto1.UnmarshalJSONEnum(TableDescriptor_FormatVersion_value, data, "TableDescriptor_FormatVersion")
+	if err != nil {
+		return err
+	}
+	*x = TableDescriptor_FormatVersion(value)
+	return nil
+}
+
+type SchemaJob_Type int32
+
+const (
+	// INDEX_BACKFILL adds the entries of a new index for existing rows.
+	SchemaJob_INDEX_BACKFILL SchemaJob_Type = 0
+	// COLUMN_BACKFILL writes the default value of a new column to existing
+	// rows.
+	SchemaJob_COLUMN_BACKFILL SchemaJob_Type = 1
+	// TABLE_GC reclaims the data of a dropped table.
+	SchemaJob_TABLE_GC SchemaJob_Type = 2
+	// TABLE_COPY copies the data of the table source_table_id into the
+	// table table_id.
+	SchemaJob_TABLE_COPY SchemaJob_Type = 3
+)
+
+var SchemaJob_Type_name = map[int32]string{
+	0: "INDEX_BACKFILL",
+	1: "COLUMN_BACKFILL",
+	2: "TABLE_GC",
+	3: "TABLE_COPY",
+}
+var SchemaJob_Type_value = map[string]int32{
+	"INDEX_BACKFILL":  0,
+	"COLUMN_BACKFILL": 1,
+	"TABLE_GC":        2,
+	"TABLE_COPY":      3,
+}
+
+func (x SchemaJob_Type) Enum() *SchemaJob_Type {
+	p := new(SchemaJob_Type)
+	*p = x
+	return p
+}
+func (x SchemaJob_Type) String() string {
+	return proto1.EnumName(SchemaJob_Type_name, int32(x))
+}
+func (x *SchemaJob_Type) UnmarshalJSON(data []byte) error {
+	value, err := proto1.UnmarshalJSONEnum(SchemaJob_Type_value, data, "SchemaJob_Type")
+	if err != nil {
+		return err
+	}
+	*x = SchemaJob_Type(value)
+	return nil
+}
+
+type SchemaJob_Status int32
+
+const (
+	SchemaJob_RUNNING   SchemaJob_Status = 0
+	SchemaJob_SUCCEEDED SchemaJob_Status = 1
+	SchemaJob_FAILED    SchemaJob_Status = 2
+	// CANCELED jobs were stopped by CancelSchemaJob.
+	SchemaJob_CANCELED SchemaJob_Status = 3
+)
+
+var SchemaJob_Status_name = map[int32]string{
+	0: "RUNNING",
+	1: "SUCCEEDED",
+	2: "FAILED",
+	3: "CANCELED",
+}
+var SchemaJob_Status_value = map[string]int32{
+	"RUNNING":   0,
+	"SUCCEEDED": 1,
+	"FAILED":    2,
+	"CANCELED":  3,
+}
+
+func (x SchemaJob_Status) Enum() *SchemaJob_Status {
+	p := new(SchemaJob_Status)
+	*p = x
+	return p
+}
+func (x SchemaJob_Status) String() string {
+	return proto1.EnumName(SchemaJob_Status_name, int32(x))
+}
+func (x *SchemaJob_Status) UnmarshalJSON(data []byte) error {
+	value, err := proto1.UnmarshalJSONEnum(SchemaJob_Status_value, data, "SchemaJob_Status")
+	if err != nil {
+		return err
+	}
+	*x = SchemaJob_Status(value)
+	return nil
+}
+
 type Table struct {
-	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// comment is a free-form description of the table.
+	Comment string `protobuf:"bytes,2,opt,name=comment" json:"comment"`
+	// ttl_seconds, if non-zero, is the time to live of the rows of the
+	// table, measured from the last write of each row. Expired rows are
+	// skipped by reads and deleted by garbage collection.
+	TTLSeconds       int32  `protobuf:"varint,3,opt,name=ttl_seconds" json:"ttl_seconds"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -63,10 +262,31 @@ func (m *Table) GetName() string {
 	return ""
 }
 
+func (m *Table) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *Table) GetTTLSeconds() int32 {
+	if m != nil {
+		return m.TTLSeconds
+	}
+	return 0
+}
+
 type Column struct {
-	Name             string            `protobuf:"bytes,1,opt,name=name" json:"name"`
-	Type             Column_ColumnType `protobuf:"varint,2,opt,name=type,enum=cockroach.proto.Column_ColumnType" json:"type"`
-	XXX_unrecognized []byte            `json:"-"`
+	Name string            `protobuf:"bytes,1,opt,name=name" json:"name"`
+	Type Column_ColumnType `protobuf:"varint,2,opt,name=type,enum=cockroach.proto.Column_ColumnType" json:"type"`
+	// compute_expr, if set, makes this a computed column. The value of a
+	// computed column is derived from sibling columns by evaluating the
+	// expression (e.g. "lower(name)" or "price * quantity") whenever the
+	// row is written. See ParseExpr for the supported grammar.
+	ComputeExpr string `protobuf:"bytes,3,opt,name=compute_expr" json:"compute_expr"`
+	// comment is a free-form description of the column.
+	Comment          string `protobuf:"bytes,4,opt,name=comment" json:"comment"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
 func (m *Column) Reset()         { *m = Column{} }
@@ -87,6 +307,20 @@ func (m *Column) GetType() Column_ColumnType {
 	return Column_BYTES
 }
 
+func (m *Column) GetComputeExpr() string {
+	if m != nil {
+		return m.ComputeExpr
+	}
+	return ""
+}
+
+func (m *Column) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
 type Index struct {
 	Name             string `protobuf:"bytes,1,opt,name=name" json:"name"`
 	Unique           bool   `protobuf:"varint,2,opt,name=unique" json:"unique"`
@@ -142,7 +376,10 @@ type TableSchema_IndexByName struct {
 	Index `protobuf:"bytes,1,opt,name=index,embedded=index" json:"index"`
 	// An ordered list of column names of which the index is comprised. Each
 	// column_name refers to a column in the TableSchema's columns.
-	ColumnNames      []string `protobuf:"bytes,2,rep,name=column_names" json:"column_names,omitempty"`
+	ColumnNames []string `protobuf:"bytes,2,rep,name=column_names" json:"column_names,omitempty"`
+	// An ordered list of expressions of which an expression index is
+	// comprised. Mutually exclusive with column_names.
+	KeyExprs         []string `protobuf:"bytes,3,rep,name=key_exprs" json:"key_exprs,omitempty"`
 	XXX_unrecognized []byte   `json:"-"`
 }
 
@@ -157,6 +394,13 @@ func (m *TableSchema_IndexByName) GetColumnNames() []string {
 	return nil
 }
 
+func (m *TableSchema_IndexByName) GetKeyExprs() []string {
+	if m != nil {
+		return m.KeyExprs
+	}
+	return nil
+}
+
 type ColumnDescriptor struct {
 	Id               uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
 	Column           `protobuf:"bytes,2,opt,name=column,embedded=column" json:"column"`
@@ -180,8 +424,21 @@ type IndexDescriptor struct {
 	// An ordered list of column ids of which the index is comprised. Each
 	// column_id refers to a column in the TableDescriptor's columns; special
 	// care is taken to update this when deleting columns.
-	ColumnIds        []uint32 `protobuf:"varint,3,rep,name=column_ids" json:"column_ids,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+	ColumnIds []uint32 `protobuf:"varint,3,rep,name=column_ids" json:"column_ids,omitempty"`
+	// An ordered list of column names of which the index is comprised. This
+	// is the legacy (BASE format version) encoding of column_ids and is only
+	// present in descriptors which have not been upgraded.
+	ColumnNames []string `protobuf:"bytes,4,rep,name=column_names" json:"column_names,omitempty"`
+	// An ordered list of expressions over the table's columns of which an
+	// expression index is comprised, e.g. "lower(name)" for case-insensitive
+	// lookups. A plain column name is a valid expression. Indexes contain
+	// either column_ids or key_exprs, never both, and the primary index may
+	// not contain expressions. See ParseExpr for the supported grammar.
+	KeyExprs []string `protobuf:"bytes,5,rep,name=key_exprs" json:"key_exprs,omitempty"`
+	// foreign_key, if set, requires every key of this index to exist in the
+	// referenced index of another table.
+	ForeignKey       *ForeignKeyReference `protobuf:"bytes,6,opt,name=foreign_key" json:"foreign_key,omitempty"`
+	XXX_unrecognized []byte               `json:"-"`
 }
 
 func (m *IndexDescriptor) Reset()         { *m = IndexDescriptor{} }
@@ -202,6 +459,97 @@ func (m *IndexDescriptor) GetColumnIds() []uint32 {
 	return nil
 }
 
+func (m *IndexDescriptor) GetColumnNames() []string {
+	if m != nil {
+		return m.ColumnNames
+	}
+	return nil
+}
+
+func (m *IndexDescriptor) GetKeyExprs() []string {
+	if m != nil {
+		return m.KeyExprs
+	}
+	return nil
+}
+
+func (m *IndexDescriptor) GetForeignKey() *ForeignKeyReference {
+	if m != nil {
+		return m.ForeignKey
+	}
+	return nil
+}
+
+// ForeignKeyReference identifies the index referenced by a foreign key.
+type ForeignKeyReference struct {
+	TableId          uint32 `protobuf:"varint,1,opt,name=table_id" json:"table_id"`
+	IndexId          uint32 `protobuf:"varint,2,opt,name=index_id" json:"index_id"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ForeignKeyReference) Reset()         { *m = ForeignKeyReference{} }
+func (m *ForeignKeyReference) String() string { return proto1.CompactTextString(m) }
+func (*ForeignKeyReference) ProtoMessage()    {}
+
+func (m *ForeignKeyReference) GetTableId() uint32 {
+	if m != nil {
+		return m.TableId
+	}
+	return 0
+}
+
+func (m *ForeignKeyReference) GetIndexId() uint32 {
+	if m != nil {
+		return m.IndexId
+	}
+	return 0
+}
+
+// UserPrivileges describes the privileges of a user.
+type UserPrivileges struct {
+	User string `protobuf:"bytes,1,opt,name=user" json:"user"`
+	// privileges is a bit field with bit 1 << kind set for each
+	// PrivilegeDescriptor.Kind granted to the user.
+	Privileges       uint32 `protobuf:"varint,2,opt,name=privileges" json:"privileges"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *UserPrivileges) Reset()         { *m = UserPrivileges{} }
+func (m *UserPrivileges) String() string { return proto1.CompactTextString(m) }
+func (*UserPrivileges) ProtoMessage()    {}
+
+func (m *UserPrivileges) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *UserPrivileges) GetPrivileges() uint32 {
+	if m != nil {
+		return m.Privileges
+	}
+	return 0
+}
+
+// A PrivilegeDescriptor describes the privileges users hold on a table.
+type PrivilegeDescriptor struct {
+	// users is sorted by user name.
+	Users            []UserPrivileges `protobuf:"bytes,1,rep,name=users" json:"users"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *PrivilegeDescriptor) Reset()         { *m = PrivilegeDescriptor{} }
+func (m *PrivilegeDescriptor) String() string { return proto1.CompactTextString(m) }
+func (*PrivilegeDescriptor) ProtoMessage()    {}
+
+func (m *PrivilegeDescriptor) GetUsers() []UserPrivileges {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
 // A TableDescriptor represents a table and is stored in a structured metadata
 // key. The TableDescriptor has a globally-unique ID, while its member
 // {Column,Index}Descriptors have locally-unique IDs.
@@ -210,10 +558,30 @@ type TableDescriptor struct {
 	Table   `protobuf:"bytes,2,opt,name=table,embedded=table" json:"table"`
 	Columns []ColumnDescriptor `protobuf:"bytes,3,rep,name=columns" json:"columns"`
 	// next_column_id is used to ensure that deleted column ids are not reused
-	NextColumnId uint32            `protobuf:"varint,4,opt,name=next_column_id" json:"next_column_id"`
-	Indexes      []IndexDescriptor `protobuf:"bytes,5,rep,name=indexes" json:"indexes"`
+	NextColumnId uint32 `protobuf:"varint,4,opt,name=next_column_id" json:"next_column_id"`
+	// indexes holds the secondary indexes of the table.
+	Indexes []IndexDescriptor `protobuf:"bytes,5,rep,name=indexes" json:"indexes"`
 	// next_index_id is used to ensure that deleted index ids are not reused
-	NextIndexId      uint32 `protobuf:"varint,6,opt,name=next_index_id" json:"next_index_id"`
+	NextIndexId   uint32                        `protobuf:"varint,6,opt,name=next_index_id" json:"next_index_id"`
+	PrimaryIndex  IndexDescriptor               `protobuf:"bytes,7,opt,name=primary_index" json:"primary_index"`
+	FormatVersion TableDescriptor_FormatVersion `protobuf:"varint,8,opt,name=format_version,enum=cockroach.proto.TableDescriptor_FormatVersion" json:"format_version"`
+	// parent_id is the ID of the database containing the table. Table names
+	// are only unique within their database.
+	ParentId uint32 `protobuf:"varint,9,opt,name=parent_id" json:"parent_id"`
+	// interleave_parent_id, if non-zero, is the ID of the table whose rows
+	// this table's rows are interleaved with.
+	InterleaveParentId uint32 `protobuf:"varint,10,opt,name=interleave_parent_id" json:"interleave_parent_id"`
+	// drop_time, if non-zero, is the time in nanoseconds since the epoch at
+	// which the table was dropped. The data of dropped tables is reclaimed
+	// once a grace period has passed.
+	DropTime int64 `protobuf:"varint,11,opt,name=drop_time" json:"drop_time"`
+	// version is incremented by every change to the table's schema.
+	Version    uint32              `protobuf:"varint,12,opt,name=version" json:"version"`
+	Privileges PrivilegeDescriptor `protobuf:"bytes,13,opt,name=privileges" json:"privileges"`
+	// reclaim_time, if non-zero, is the time in nanoseconds since the epoch
+	// at which the reclamation of the data of the dropped table began. The
+	// table can no longer be restored once its data is being reclaimed.
+	ReclaimTime      int64  `protobuf:"varint,14,opt,name=reclaim_time" json:"reclaim_time"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
@@ -256,755 +624,2787 @@ func (m *TableDescriptor) GetNextIndexId() uint32 {
 	return 0
 }
 
-type CreateTableRequest struct {
-	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Schema           TableSchema `protobuf:"bytes,2,opt,name=schema" json:"schema"`
-	XXX_unrecognized []byte      `json:"-"`
-}
-
-func (m *CreateTableRequest) Reset()         { *m = CreateTableRequest{} }
-func (m *CreateTableRequest) String() string { return proto1.CompactTextString(m) }
-func (*CreateTableRequest) ProtoMessage()    {}
-
-func (m *CreateTableRequest) GetSchema() TableSchema {
+func (m *TableDescriptor) GetPrimaryIndex() IndexDescriptor {
 	if m != nil {
-		return m.Schema
+		return m.PrimaryIndex
 	}
-	return TableSchema{}
+	return IndexDescriptor{}
 }
 
-type CreateTableResponse struct {
-	Error            Error  `protobuf:"bytes,1,opt,name=error" json:"error"`
-	TableId          uint32 `protobuf:"varint,2,opt,name=table_id" json:"table_id"`
-	XXX_unrecognized []byte `json:"-"`
+func (m *TableDescriptor) GetFormatVersion() TableDescriptor_FormatVersion {
+	if m != nil {
+		return m.FormatVersion
+	}
+	return TableDescriptor_BASE
 }
 
-func (m *CreateTableResponse) Reset()         { *m = CreateTableResponse{} }
-func (m *CreateTableResponse) String() string { return proto1.CompactTextString(m) }
-func (*CreateTableResponse) ProtoMessage()    {}
-
-func (m *CreateTableResponse) GetError() Error {
+func (m *TableDescriptor) GetParentId() uint32 {
 	if m != nil {
-		return m.Error
+		return m.ParentId
 	}
-	return Error{}
+	return 0
 }
 
-func (m *CreateTableResponse) GetTableId() uint32 {
+func (m *TableDescriptor) GetInterleaveParentId() uint32 {
 	if m != nil {
-		return m.TableId
+		return m.InterleaveParentId
 	}
 	return 0
 }
 
-// TableRequestHeader is supplied with every structured API request.
-type TableRequestHeader struct {
-	// The name of the table this request references.
-	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
-	// The name of the index. Defaults to the primary index when empty.
-	Index string `protobuf:"bytes,2,opt,name=index" json:"index"`
-	// Timestamp specifies time at which read or writes should be
-	// performed. If the timestamp is set to zero value, its value
-	// is initialized to the wall time of the receiving gateway node.
-	Timestamp Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp"`
-	// CmdID is optionally specified for request idempotence
-	// (i.e. replay protection).
-	CmdID ClientCmdID `protobuf:"bytes,4,opt,name=cmd_id" json:"cmd_id"`
-	// User is the originating user. Used to lookup priority when
-	// scheduling queued operations at target node.
-	User string `protobuf:"bytes,5,opt,name=user" json:"user"`
-	// UserPriority specifies priority multiple for non-transactional
-	// commands. This value should be a positive integer [1, 2^31-1).
-	// It's properly viewed as a multiple for how likely this
-	// transaction will be to prevail if a write conflict occurs.
-	// Commands with UserPriority=100 will be 100x less likely to be
-	// aborted as conflicting transactions or non-transactional commands
-	// with UserPriority=1. This value is ignored if Txn is
-	// specified. If neither this value nor Txn is specified, the value
-	// defaults to 1.
-	UserPriority *int32 `protobuf:"varint,6,opt,name=user_priority,def=1" json:"user_priority,omitempty"`
-	// Txn is set non-nil if a transaction is underway. To start a txn,
-	// the first request should set this field to non-nil with name and
-	// isolation level set as desired. The response will contain the
-	// fully-initialized transaction with txn ID, priority, initial
-	// timestamp, and maximum timestamp. The transaction returned in a
-	// response is feed to the next request.
-	Txn *Transaction `protobuf:"bytes,7,opt,name=txn" json:"txn,omitempty"`
-	// ReadConsistency specifies the consistency for read
-	// operations. The default is CONSISTENT. This value is ignored for
-	// write operations.
-	ReadConsistency  ReadConsistencyType `protobuf:"varint,8,opt,name=read_consistency,enum=cockroach.proto.ReadConsistencyType" json:"read_consistency"`
-	XXX_unrecognized []byte              `json:"-"`
+func (m *TableDescriptor) GetDropTime() int64 {
+	if m != nil {
+		return m.DropTime
+	}
+	return 0
 }
 
-func (m *TableRequestHeader) Reset()         { *m = TableRequestHeader{} }
-func (m *TableRequestHeader) String() string { return proto1.CompactTextString(m) }
-func (*TableRequestHeader) ProtoMessage()    {}
-
-const Default_TableRequestHeader_UserPriority int32 = 1
-
-func (m *TableRequestHeader) GetName() string {
+func (m *TableDescriptor) GetVersion() uint32 {
 	if m != nil {
-		return m.Name
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *TableRequestHeader) GetIndex() string {
+func (m *TableDescriptor) GetPrivileges() PrivilegeDescriptor {
 	if m != nil {
-		return m.Index
+		return m.Privileges
 	}
-	return ""
+	return PrivilegeDescriptor{}
 }
 
-func (m *TableRequestHeader) GetTimestamp() Timestamp {
+func (m *TableDescriptor) GetReclaimTime() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.ReclaimTime
 	}
-	return Timestamp{}
+	return 0
 }
 
-func (m *TableRequestHeader) GetCmdID() ClientCmdID {
+// A DatabaseDescriptor represents a database (namespace) and is stored in a
+// structured metadata key. Databases form the first level of the two-level
+// database -> table namespace, allowing different applications to use the
+// same table names without collisions.
+type DatabaseDescriptor struct {
+	Id               uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name             string `protobuf:"bytes,2,opt,name=name" json:"name"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *DatabaseDescriptor) Reset()         { *m = DatabaseDescriptor{} }
+func (m *DatabaseDescriptor) String() string { return proto1.CompactTextString(m) }
+func (*DatabaseDescriptor) ProtoMessage()    {}
+
+func (m *DatabaseDescriptor) GetId() uint32 {
 	if m != nil {
-		return m.CmdID
+		return m.Id
 	}
-	return ClientCmdID{}
+	return 0
 }
 
-func (m *TableRequestHeader) GetUser() string {
+func (m *DatabaseDescriptor) GetName() string {
 	if m != nil {
-		return m.User
+		return m.Name
 	}
 	return ""
 }
 
-func (m *TableRequestHeader) GetUserPriority() int32 {
-	if m != nil && m.UserPriority != nil {
-		return *m.UserPriority
-	}
-	return Default_TableRequestHeader_UserPriority
+// A SequenceDescriptor represents a sequence and is stored in a structured
+// metadata key. Sequences back auto-increment keys and shared counters.
+type SequenceDescriptor struct {
+	Id   uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name"`
+	// start is the first value handed out by the sequence.
+	Start int64 `protobuf:"varint,3,opt,name=start" json:"start"`
+	// increment is added to the sequence value on every step. It may be
+	// negative for descending sequences but must not be zero.
+	Increment int64 `protobuf:"varint,4,opt,name=increment" json:"increment"`
+	// cache_size is the number of values reserved by a client with a single
+	// increment of the underlying counter. Zero is treated as one.
+	CacheSize        int64  `protobuf:"varint,5,opt,name=cache_size" json:"cache_size"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *TableRequestHeader) GetTxn() *Transaction {
+func (m *SequenceDescriptor) Reset()         { *m = SequenceDescriptor{} }
+func (m *SequenceDescriptor) String() string { return proto1.CompactTextString(m) }
+func (*SequenceDescriptor) ProtoMessage()    {}
+
+func (m *SequenceDescriptor) GetId() uint32 {
 	if m != nil {
-		return m.Txn
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-func (m *TableRequestHeader) GetReadConsistency() ReadConsistencyType {
+func (m *SequenceDescriptor) GetName() string {
 	if m != nil {
-		return m.ReadConsistency
+		return m.Name
 	}
-	return CONSISTENT
-}
-
-// TableResponseHeader is returned with every structured API response.
-type TableResponseHeader struct {
-	// Error is non-nil if an error occurred.
-	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
-	// Timestamp specifies time at which read or write actually was
-	// performed. In the case of both reads and writes, if the timestamp
-	// supplied to the request was 0, the wall time of the node
-	// servicing the request will be set here. Additionally, in the case
-	// of writes, this value may be increased from the timestamp passed
-	// with the RequestHeader if the key being written was either read
-	// or written more recently.
-	//
-	// TODO(vivek): Is this only relevant in the non-transactional case?
-	Timestamp Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
-	// Transaction is non-nil if the request specified a non-nil
-	// transaction. The transaction timestamp and/or priority may have
-	// been updated, depending on the outcome of the request.
-	Txn              *Transaction `protobuf:"bytes,3,opt,name=txn" json:"txn,omitempty"`
-	XXX_unrecognized []byte       `json:"-"`
+	return ""
 }
 
-func (m *TableResponseHeader) Reset()         { *m = TableResponseHeader{} }
-func (m *TableResponseHeader) String() string { return proto1.CompactTextString(m) }
-func (*TableResponseHeader) ProtoMessage()    {}
-
-func (m *TableResponseHeader) GetError() *Error {
+func (m *SequenceDescriptor) GetStart() int64 {
 	if m != nil {
-		return m.Error
+		return m.Start
 	}
-	return nil
+	return 0
 }
 
-func (m *TableResponseHeader) GetTimestamp() Timestamp {
+func (m *SequenceDescriptor) GetIncrement() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.Increment
 	}
-	return Timestamp{}
+	return 0
 }
 
-func (m *TableResponseHeader) GetTxn() *Transaction {
+func (m *SequenceDescriptor) GetCacheSize() int64 {
 	if m != nil {
-		return m.Txn
+		return m.CacheSize
 	}
-	return nil
+	return 0
 }
 
-// A ColumnCollection represents a collection of columns as name:value pairs.
-type ColumnCollection struct {
-	Columns          []*ColumnCollection_ColumnNameValue `protobuf:"bytes,1,rep,name=columns" json:"columns,omitempty"`
-	XXX_unrecognized []byte                              `json:"-"`
+// A ViewDescriptor represents a named, read-only projection over the
+// columns of a base table. View descriptors are only validated: they are
+// not written to the table namespace, so views are not listed by
+// ListTables or ListTableDescriptors.
+type ViewDescriptor struct {
+	Id   uint32 `protobuf:"varint,1,opt,name=id" json:"id"`
+	Name string `protobuf:"bytes,2,opt,name=name" json:"name"`
+	// table_id is the ID of the base table the view projects.
+	TableId uint32 `protobuf:"varint,3,opt,name=table_id" json:"table_id"`
+	// An ordered list of the base table column ids exposed by the view.
+	ColumnIds []uint32 `protobuf:"varint,4,rep,name=column_ids" json:"column_ids,omitempty"`
+	// query is the text the view was defined with. It is informational only;
+	// column_ids is authoritative.
+	Query            string `protobuf:"bytes,5,opt,name=query" json:"query"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *ColumnCollection) Reset()         { *m = ColumnCollection{} }
-func (m *ColumnCollection) String() string { return proto1.CompactTextString(m) }
-func (*ColumnCollection) ProtoMessage()    {}
+func (m *ViewDescriptor) Reset()         { *m = ViewDescriptor{} }
+func (m *ViewDescriptor) String() string { return proto1.CompactTextString(m) }
+func (*ViewDescriptor) ProtoMessage()    {}
 
-func (m *ColumnCollection) GetColumns() []*ColumnCollection_ColumnNameValue {
+func (m *ViewDescriptor) GetId() uint32 {
 	if m != nil {
-		return m.Columns
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-type ColumnCollection_ColumnNameValue struct {
-	// Column name.
-	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
-	// A Nil Value indicates non-existence of the value.
-	Value            *Value `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
-	XXX_unrecognized []byte `json:"-"`
+func (m *ViewDescriptor) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ColumnCollection_ColumnNameValue) Reset()         { *m = ColumnCollection_ColumnNameValue{} }
-func (m *ColumnCollection_ColumnNameValue) String() string { return proto1.CompactTextString(m) }
-func (*ColumnCollection_ColumnNameValue) ProtoMessage()    {}
-
-func (m *ColumnCollection_ColumnNameValue) GetName() string {
-	if m != nil && m.Name != nil {
-		return *m.Name
+func (m *ViewDescriptor) GetTableId() uint32 {
+	if m != nil {
+		return m.TableId
 	}
-	return ""
+	return 0
 }
 
-func (m *ColumnCollection_ColumnNameValue) GetValue() *Value {
+func (m *ViewDescriptor) GetColumnIds() []uint32 {
 	if m != nil {
-		return m.Value
+		return m.ColumnIds
 	}
 	return nil
 }
 
-// A ValueCollection is a collection of values. A non-existent value
-// is to be represented by Nil Bytes.
-type ValueCollection struct {
-	Values           []*Value `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+func (m *ViewDescriptor) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
 }
 
-func (m *ValueCollection) Reset()         { *m = ValueCollection{} }
-func (m *ValueCollection) String() string { return proto1.CompactTextString(m) }
-func (*ValueCollection) ProtoMessage()    {}
+// A SchemaJob records the progress of a long-running schema change, such
+// as the backfill of a new index.
+type SchemaJob struct {
+	Id      uint64         `protobuf:"varint,1,opt,name=id" json:"id"`
+	Type    SchemaJob_Type `protobuf:"varint,2,opt,name=type,enum=cockroach.proto.SchemaJob_Type" json:"type"`
+	TableId uint32         `protobuf:"varint,3,opt,name=table_id" json:"table_id"`
+	// description is a human-readable description of the job.
+	Description string           `protobuf:"bytes,4,opt,name=description" json:"description"`
+	Status      SchemaJob_Status `protobuf:"varint,5,opt,name=status,enum=cockroach.proto.SchemaJob_Status" json:"status"`
+	// progress is the number of rows or keys processed so far.
+	Progress int64 `protobuf:"varint,6,opt,name=progress" json:"progress"`
+	// error is the error the job failed with.
+	Error string `protobuf:"bytes,7,opt,name=error" json:"error"`
+	// start_time and finish_time are in nanoseconds since the epoch.
+	StartTime  int64 `protobuf:"varint,8,opt,name=start_time" json:"start_time"`
+	FinishTime int64 `protobuf:"varint,9,opt,name=finish_time" json:"finish_time"`
+	// index_id is the ID of the index an INDEX_BACKFILL job backfills.
+	IndexId uint32 `protobuf:"varint,10,opt,name=index_id" json:"index_id"`
+	// resume_key is the key of the primary index at which an interrupted
+	// backfill resumes. The rows before it have been processed.
+	ResumeKey Key `protobuf:"bytes,11,opt,name=resume_key,casttype=Key" json:"resume_key,omitempty"`
+	// lease_owner identifies the client running a RUNNING job, which holds a
+	// lease on the job until lease_expiration, in nanoseconds since the
+	// epoch. The lease is extended at each checkpoint of the job's progress;
+	// a job whose lease has expired may be adopted by another client.
+	LeaseOwner      []byte `protobuf:"bytes,12,opt,name=lease_owner" json:"lease_owner,omitempty"`
+	LeaseExpiration int64  `protobuf:"varint,13,opt,name=lease_expiration" json:"lease_expiration"`
+	// cancel_requested is set by CancelSchemaJob. The job stops at its next
+	// checkpoint.
+	CancelRequested bool `protobuf:"varint,14,opt,name=cancel_requested" json:"cancel_requested"`
+	// column_id and column_value are the ID of the column a COLUMN_BACKFILL
+	// job backfills and the encoded value it writes.
+	ColumnId    uint32 `protobuf:"varint,15,opt,name=column_id" json:"column_id"`
+	ColumnValue []byte `protobuf:"bytes,16,opt,name=column_value" json:"column_value,omitempty"`
+	// source_table_id is the ID of the table a TABLE_COPY job copies.
+	SourceTableId    uint32 `protobuf:"varint,17,opt,name=source_table_id" json:"source_table_id"`
+	XXX_unrecognized []byte `json:"-"`
+}
 
-func (m *ValueCollection) GetValues() []*Value {
+func (m *SchemaJob) Reset()         { *m = SchemaJob{} }
+func (m *SchemaJob) String() string { return proto1.CompactTextString(m) }
+func (*SchemaJob) ProtoMessage()    {}
+
+func (m *SchemaJob) GetId() uint64 {
 	if m != nil {
-		return m.Values
+		return m.Id
 	}
-	return nil
+	return 0
 }
 
-// A GetTableRowRequest is used to read a table row.
-type GetTableRowRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Key                ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
-	// Get specified columns from the row.
-	Columns          []string `protobuf:"bytes,3,rep,name=columns" json:"columns,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+func (m *SchemaJob) GetType() SchemaJob_Type {
+	if m != nil {
+		return m.Type
+	}
+	return SchemaJob_INDEX_BACKFILL
 }
 
-func (m *GetTableRowRequest) Reset()         { *m = GetTableRowRequest{} }
-func (m *GetTableRowRequest) String() string { return proto1.CompactTextString(m) }
-func (*GetTableRowRequest) ProtoMessage()    {}
-
-func (m *GetTableRowRequest) GetKey() ColumnCollection {
+func (m *SchemaJob) GetTableId() uint32 {
 	if m != nil {
-		return m.Key
+		return m.TableId
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-func (m *GetTableRowRequest) GetColumns() []string {
+func (m *SchemaJob) GetDescription() string {
 	if m != nil {
-		return m.Columns
+		return m.Description
 	}
-	return nil
+	return ""
 }
 
-// A GetTableRowResponse returns a row.
-// If the key doesn't exist, it returns an empty row.
-type GetTableRowResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Row                 ValueCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
-	XXX_unrecognized    []byte          `json:"-"`
+func (m *SchemaJob) GetStatus() SchemaJob_Status {
+	if m != nil {
+		return m.Status
+	}
+	return SchemaJob_RUNNING
 }
 
-func (m *GetTableRowResponse) Reset()         { *m = GetTableRowResponse{} }
-func (m *GetTableRowResponse) String() string { return proto1.CompactTextString(m) }
-func (*GetTableRowResponse) ProtoMessage()    {}
+func (m *SchemaJob) GetProgress() int64 {
+	if m != nil {
+		return m.Progress
+	}
+	return 0
+}
 
-func (m *GetTableRowResponse) GetRow() ValueCollection {
+func (m *SchemaJob) GetError() string {
 	if m != nil {
-		return m.Row
+		return m.Error
 	}
-	return ValueCollection{}
+	return ""
 }
 
-// A PutTableRowRequest inserts/updates a row in the table. To write
-// an empty value in a cell specify an empty Bytes in the Value. To Delete
-// a column specify Nil Bytes.
-type PutTableRowRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	// The columns forming the primary key columns must be part of the ColumnCollection.
-	Row              ColumnCollection `protobuf:"bytes,3,opt,name=row" json:"row"`
-	XXX_unrecognized []byte           `json:"-"`
+func (m *SchemaJob) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-func (m *PutTableRowRequest) Reset()         { *m = PutTableRowRequest{} }
-func (m *PutTableRowRequest) String() string { return proto1.CompactTextString(m) }
-func (*PutTableRowRequest) ProtoMessage()    {}
+func (m *SchemaJob) GetFinishTime() int64 {
+	if m != nil {
+		return m.FinishTime
+	}
+	return 0
+}
 
-func (m *PutTableRowRequest) GetRow() ColumnCollection {
+func (m *SchemaJob) GetIndexId() uint32 {
 	if m != nil {
-		return m.Row
+		return m.IndexId
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-// A PutTableRowResponse is the response.
-type PutTableRowResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	XXX_unrecognized    []byte `json:"-"`
+func (m *SchemaJob) GetResumeKey() Key {
+	if m != nil {
+		return m.ResumeKey
+	}
+	return nil
 }
 
-func (m *PutTableRowResponse) Reset()         { *m = PutTableRowResponse{} }
-func (m *PutTableRowResponse) String() string { return proto1.CompactTextString(m) }
-func (*PutTableRowResponse) ProtoMessage()    {}
+func (m *SchemaJob) GetLeaseOwner() []byte {
+	if m != nil {
+		return m.LeaseOwner
+	}
+	return nil
+}
 
-// A ConditionalPutTableRowRequest.
-//
-// Puts row if exp_row equals an existing row in the DB. Returns error
-// and the actual value of the row in the response if exp_row doesn't
-// match the full row in the DB. Both exp_row and row are not allowed
-// to contain any of the primary key columns.
-type ConditionalPutTableRowRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Key                ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
-	ExpRow             ColumnCollection `protobuf:"bytes,3,opt,name=exp_row" json:"exp_row"`
-	Row                ColumnCollection `protobuf:"bytes,4,opt,name=row" json:"row"`
-	XXX_unrecognized   []byte           `json:"-"`
+func (m *SchemaJob) GetLeaseExpiration() int64 {
+	if m != nil {
+		return m.LeaseExpiration
+	}
+	return 0
 }
 
-func (m *ConditionalPutTableRowRequest) Reset()         { *m = ConditionalPutTableRowRequest{} }
-func (m *ConditionalPutTableRowRequest) String() string { return proto1.CompactTextString(m) }
-func (*ConditionalPutTableRowRequest) ProtoMessage()    {}
+func (m *SchemaJob) GetCancelRequested() bool {
+	if m != nil {
+		return m.CancelRequested
+	}
+	return false
+}
 
-func (m *ConditionalPutTableRowRequest) GetKey() ColumnCollection {
+func (m *SchemaJob) GetColumnId() uint32 {
 	if m != nil {
-		return m.Key
+		return m.ColumnId
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-func (m *ConditionalPutTableRowRequest) GetExpRow() ColumnCollection {
+func (m *SchemaJob) GetColumnValue() []byte {
 	if m != nil {
-		return m.ExpRow
+		return m.ColumnValue
 	}
-	return ColumnCollection{}
+	return nil
 }
 
-func (m *ConditionalPutTableRowRequest) GetRow() ColumnCollection {
+func (m *SchemaJob) GetSourceTableId() uint32 {
 	if m != nil {
-		return m.Row
+		return m.SourceTableId
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-// A ConditionalPutTableRowResponse. The row returned is the actual row in
-// the DB that doesn't full match exp_row in the request. Only the columns
-// forming the index key match up with those in exp_row.
-type ConditionalPutTableRowResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Row                 ValueCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
-	XXX_unrecognized    []byte          `json:"-"`
+// HistogramBucket is a bucket of an equi-depth histogram of the values of
+// a column.
+type HistogramBucket struct {
+	// upper_bound is the largest value of the bucket, in the order-preserving
+	// key encoding of the column's type.
+	UpperBound []byte `protobuf:"bytes,1,opt,name=upper_bound" json:"upper_bound,omitempty"`
+	// count is the estimated number of rows whose values are greater than the
+	// upper bound of the preceding bucket and at most upper_bound.
+	Count            int64  `protobuf:"varint,2,opt,name=count" json:"count"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *ConditionalPutTableRowResponse) Reset()         { *m = ConditionalPutTableRowResponse{} }
-func (m *ConditionalPutTableRowResponse) String() string { return proto1.CompactTextString(m) }
-func (*ConditionalPutTableRowResponse) ProtoMessage()    {}
+func (m *HistogramBucket) Reset()         { *m = HistogramBucket{} }
+func (m *HistogramBucket) String() string { return proto1.CompactTextString(m) }
+func (*HistogramBucket) ProtoMessage()    {}
 
-func (m *ConditionalPutTableRowResponse) GetRow() ValueCollection {
+func (m *HistogramBucket) GetUpperBound() []byte {
 	if m != nil {
-		return m.Row
+		return m.UpperBound
 	}
-	return ValueCollection{}
+	return nil
 }
 
-// An IncrementTableRequest increments the value of the columns in row.
-// row can only contain the columns forming the index key and columns
-// of type integer. Incrementing by 0 is not a noop, and creates a zero value.
-type IncrementTableRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Row                ColumnCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
-	XXX_unrecognized   []byte           `json:"-"`
+func (m *HistogramBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *IncrementTableRequest) Reset()         { *m = IncrementTableRequest{} }
-func (m *IncrementTableRequest) String() string { return proto1.CompactTextString(m) }
-func (*IncrementTableRequest) ProtoMessage()    {}
+// ColumnStatistics holds approximate statistics of the values of a column.
+type ColumnStatistics struct {
+	ColumnId  uint32 `protobuf:"varint,1,opt,name=column_id" json:"column_id"`
+	NullCount int64  `protobuf:"varint,2,opt,name=null_count" json:"null_count"`
+	// distinct_count is the number of distinct non-NULL values, estimated
+	// from sketch.
+	DistinctCount int64 `protobuf:"varint,3,opt,name=distinct_count" json:"distinct_count"`
+	// sketch holds the registers of a HyperLogLog sketch of the non-NULL
+	// values.
+	Sketch []byte `protobuf:"bytes,4,opt,name=sketch" json:"sketch,omitempty"`
+	// histogram holds the buckets of a histogram of a sample of the non-NULL
+	// values, in increasing order. Columns of type JSON have no histogram.
+	Histogram        []HistogramBucket `protobuf:"bytes,5,rep,name=histogram" json:"histogram"`
+	XXX_unrecognized []byte            `json:"-"`
+}
 
-func (m *IncrementTableRequest) GetRow() ColumnCollection {
+func (m *ColumnStatistics) Reset()         { *m = ColumnStatistics{} }
+func (m *ColumnStatistics) String() string { return proto1.CompactTextString(m) }
+func (*ColumnStatistics) ProtoMessage()    {}
+
+func (m *ColumnStatistics) GetColumnId() uint32 {
 	if m != nil {
-		return m.Row
+		return m.ColumnId
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-// An IncrementTableResponse returns the row with the new column values.
-type IncrementTableResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Row                 ValueCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
-	XXX_unrecognized    []byte          `json:"-"`
-}
-
-func (m *IncrementTableResponse) Reset()         { *m = IncrementTableResponse{} }
-func (m *IncrementTableResponse) String() string { return proto1.CompactTextString(m) }
-func (*IncrementTableResponse) ProtoMessage()    {}
-
-func (m *IncrementTableResponse) GetRow() ValueCollection {
-	if m != nil {
-		return m.Row
-	}
-	return ValueCollection{}
-}
-
-// A DeleteTableRowRequest deletes the entire row. key contains the columns
-// forming the index key.
-type DeleteTableRowRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Key                ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
-	XXX_unrecognized   []byte           `json:"-"`
-}
-
-func (m *DeleteTableRowRequest) Reset()         { *m = DeleteTableRowRequest{} }
-func (m *DeleteTableRowRequest) String() string { return proto1.CompactTextString(m) }
-func (*DeleteTableRowRequest) ProtoMessage()    {}
-
-func (m *DeleteTableRowRequest) GetKey() ColumnCollection {
+func (m *ColumnStatistics) GetNullCount() int64 {
 	if m != nil {
-		return m.Key
+		return m.NullCount
 	}
-	return ColumnCollection{}
-}
-
-// A DeleteTableRowResponse.
-type DeleteTableRowResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	XXX_unrecognized    []byte `json:"-"`
-}
-
-func (m *DeleteTableRowResponse) Reset()         { *m = DeleteTableRowResponse{} }
-func (m *DeleteTableRowResponse) String() string { return proto1.CompactTextString(m) }
-func (*DeleteTableRowResponse) ProtoMessage()    {}
-
-// A DeleteTableRowRangeRequest deletes the specified rows. Both key and end_key
-// must only contain the columns forming the index key.
-type DeleteTableRowRangeRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	// Delete *all* entries between Key (inclusive) and EndKey
-	// (exclusive).
-	Key    ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
-	EndKey ColumnCollection `protobuf:"bytes,3,opt,name=end_key" json:"end_key"`
-	// Must be > 0.
-	MaxEntriesToDelete int64  `protobuf:"varint,4,opt,name=max_entries_to_delete" json:"max_entries_to_delete"`
-	XXX_unrecognized   []byte `json:"-"`
+	return 0
 }
 
-func (m *DeleteTableRowRangeRequest) Reset()         { *m = DeleteTableRowRangeRequest{} }
-func (m *DeleteTableRowRangeRequest) String() string { return proto1.CompactTextString(m) }
-func (*DeleteTableRowRangeRequest) ProtoMessage()    {}
-
-func (m *DeleteTableRowRangeRequest) GetKey() ColumnCollection {
+func (m *ColumnStatistics) GetDistinctCount() int64 {
 	if m != nil {
-		return m.Key
+		return m.DistinctCount
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-func (m *DeleteTableRowRangeRequest) GetEndKey() ColumnCollection {
+func (m *ColumnStatistics) GetSketch() []byte {
 	if m != nil {
-		return m.EndKey
+		return m.Sketch
 	}
-	return ColumnCollection{}
+	return nil
 }
 
-func (m *DeleteTableRowRangeRequest) GetMaxEntriesToDelete() int64 {
+func (m *ColumnStatistics) GetHistogram() []HistogramBucket {
 	if m != nil {
-		return m.MaxEntriesToDelete
+		return m.Histogram
 	}
-	return 0
+	return nil
 }
 
-// A DeleteTableRowRangeResponse.
-type DeleteTableRowRangeResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	// Number of entries deleted.
-	NumDeleted       int64  `protobuf:"varint,2,opt,name=num_deleted" json:"num_deleted"`
+// TableStatistics holds approximate statistics of the rows of a table,
+// which are collected periodically and stored under the table's ID.
+type TableStatistics struct {
+	TableId uint32 `protobuf:"varint,1,opt,name=table_id" json:"table_id"`
+	// table_version is the version of the table's descriptor the statistics
+	// were collected with.
+	TableVersion uint32 `protobuf:"varint,2,opt,name=table_version" json:"table_version"`
+	RowCount     int64  `protobuf:"varint,3,opt,name=row_count" json:"row_count"`
+	// sample_size is the number of rows the histograms were built from.
+	SampleSize int64 `protobuf:"varint,4,opt,name=sample_size" json:"sample_size"`
+	// collected_at is in nanoseconds since the epoch.
+	CollectedAt int64              `protobuf:"varint,5,opt,name=collected_at" json:"collected_at"`
+	Columns     []ColumnStatistics `protobuf:"bytes,6,rep,name=columns" json:"columns"`
+	// data_size is the number of bytes of the encoded primary keys and the
+	// stored values of the cells of the rows.
+	DataSize         int64  `protobuf:"varint,7,opt,name=data_size" json:"data_size"`
 	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *DeleteTableRowRangeResponse) Reset()         { *m = DeleteTableRowRangeResponse{} }
-func (m *DeleteTableRowRangeResponse) String() string { return proto1.CompactTextString(m) }
-func (*DeleteTableRowRangeResponse) ProtoMessage()    {}
+func (m *TableStatistics) Reset()         { *m = TableStatistics{} }
+func (m *TableStatistics) String() string { return proto1.CompactTextString(m) }
+func (*TableStatistics) ProtoMessage()    {}
 
-func (m *DeleteTableRowRangeResponse) GetNumDeleted() int64 {
+func (m *TableStatistics) GetTableId() uint32 {
 	if m != nil {
-		return m.NumDeleted
+		return m.TableId
 	}
 	return 0
 }
 
-// A ScanTableRequest. It specifies the start and end keys for the scan
-// and the maximum number of results.
-type ScanTableRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	// Both key and end_key must only contain the columns forming the index key.
-	Key        ColumnCollection `protobuf:"bytes,3,opt,name=key" json:"key"`
-	EndKey     ColumnCollection `protobuf:"bytes,4,opt,name=end_key" json:"end_key"`
-	MaxResults int64            `protobuf:"varint,5,opt,name=max_results" json:"max_results"`
-	// Get the specified columns from the scanned rows.
-	Columns          []string `protobuf:"bytes,6,rep,name=columns" json:"columns,omitempty"`
-	XXX_unrecognized []byte   `json:"-"`
+func (m *TableStatistics) GetTableVersion() uint32 {
+	if m != nil {
+		return m.TableVersion
+	}
+	return 0
 }
 
-func (m *ScanTableRequest) Reset()         { *m = ScanTableRequest{} }
-func (m *ScanTableRequest) String() string { return proto1.CompactTextString(m) }
-func (*ScanTableRequest) ProtoMessage()    {}
-
-func (m *ScanTableRequest) GetKey() ColumnCollection {
+func (m *TableStatistics) GetRowCount() int64 {
 	if m != nil {
-		return m.Key
+		return m.RowCount
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-func (m *ScanTableRequest) GetEndKey() ColumnCollection {
+func (m *TableStatistics) GetSampleSize() int64 {
 	if m != nil {
-		return m.EndKey
+		return m.SampleSize
 	}
-	return ColumnCollection{}
+	return 0
 }
 
-func (m *ScanTableRequest) GetMaxResults() int64 {
+func (m *TableStatistics) GetCollectedAt() int64 {
 	if m != nil {
-		return m.MaxResults
+		return m.CollectedAt
 	}
 	return 0
 }
 
-func (m *ScanTableRequest) GetColumns() []string {
+func (m *TableStatistics) GetColumns() []ColumnStatistics {
 	if m != nil {
 		return m.Columns
 	}
 	return nil
 }
 
-// A ScanTableResponse is the response.
-type ScanTableResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	// Empty in case of error.
-	Rows             []*ValueCollection `protobuf:"bytes,2,rep,name=rows" json:"rows,omitempty"`
-	XXX_unrecognized []byte             `json:"-"`
-}
-
-func (m *ScanTableResponse) Reset()         { *m = ScanTableResponse{} }
-func (m *ScanTableResponse) String() string { return proto1.CompactTextString(m) }
-func (*ScanTableResponse) ProtoMessage()    {}
-
-func (m *ScanTableResponse) GetRows() []*ValueCollection {
+func (m *TableStatistics) GetDataSize() int64 {
 	if m != nil {
-		return m.Rows
+		return m.DataSize
 	}
-	return nil
+	return 0
 }
 
-// A BatchTableRequest contains one or more requests to be executed in
-// parallel, or if applicable (based on write-only commands and
-// range-locality), as a single update.
-type BatchTableRequest struct {
-	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Requests           []*BatchTableRequest_TableRequestUnion `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
-	XXX_unrecognized   []byte                                 `json:"-"`
+type CreateTableRequest struct {
+	RequestHeader    `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Schema           TableSchema `protobuf:"bytes,2,opt,name=schema" json:"schema"`
+	XXX_unrecognized []byte      `json:"-"`
 }
 
-func (m *BatchTableRequest) Reset()         { *m = BatchTableRequest{} }
-func (m *BatchTableRequest) String() string { return proto1.CompactTextString(m) }
-func (*BatchTableRequest) ProtoMessage()    {}
+func (m *CreateTableRequest) Reset()         { *m = CreateTableRequest{} }
+func (m *CreateTableRequest) String() string { return proto1.CompactTextString(m) }
+func (*CreateTableRequest) ProtoMessage()    {}
 
-func (m *BatchTableRequest) GetRequests() []*BatchTableRequest_TableRequestUnion {
+func (m *CreateTableRequest) GetSchema() TableSchema {
 	if m != nil {
-		return m.Requests
+		return m.Schema
 	}
-	return nil
+	return TableSchema{}
 }
 
-// A TableRequestUnion contains exactly one of the optional requests.
-type BatchTableRequest_TableRequestUnion struct {
-	Get              *GetTableRowRequest            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put              *PutTableRowRequest            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
-	ConditionalPut   *ConditionalPutTableRowRequest `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Delete           *DeleteTableRowRequest         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange      *DeleteTableRowRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
-	Scan             *ScanTableRequest              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
-	EndTransaction   *EndTransactionRequest         `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	XXX_unrecognized []byte                         `json:"-"`
+type CreateTableResponse struct {
+	Error            Error  `protobuf:"bytes,1,opt,name=error" json:"error"`
+	TableId          uint32 `protobuf:"varint,2,opt,name=table_id" json:"table_id"`
+	XXX_unrecognized []byte `json:"-"`
 }
 
-func (m *BatchTableRequest_TableRequestUnion) Reset()         { *m = BatchTableRequest_TableRequestUnion{} }
-func (m *BatchTableRequest_TableRequestUnion) String() string { return proto1.CompactTextString(m) }
-func (*BatchTableRequest_TableRequestUnion) ProtoMessage()    {}
+func (m *CreateTableResponse) Reset()         { *m = CreateTableResponse{} }
+func (m *CreateTableResponse) String() string { return proto1.CompactTextString(m) }
+func (*CreateTableResponse) ProtoMessage()    {}
 
-func (m *BatchTableRequest_TableRequestUnion) GetGet() *GetTableRowRequest {
+func (m *CreateTableResponse) GetError() Error {
 	if m != nil {
-		return m.Get
+		return m.Error
 	}
-	return nil
+	return Error{}
 }
 
-func (m *BatchTableRequest_TableRequestUnion) GetPut() *PutTableRowRequest {
+func (m *CreateTableResponse) GetTableId() uint32 {
 	if m != nil {
-		return m.Put
+		return m.TableId
 	}
-	return nil
+	return 0
 }
 
-func (m *BatchTableRequest_TableRequestUnion) GetConditionalPut() *ConditionalPutTableRowRequest {
-	if m != nil {
-		return m.ConditionalPut
-	}
-	return nil
+// TableRequestHeader is supplied with every structured API request.
+type TableRequestHeader struct {
+	// The name of the table this request references.
+	Name string `protobuf:"bytes,1,opt,name=name" json:"name"`
+	// The name of the index. Defaults to the primary index when empty.
+	Index string `protobuf:"bytes,2,opt,name=index" json:"index"`
+	// Timestamp specifies time at which read or writes should be
+	// performed. If the timestamp is set to zero value, its value
+	// is initialized to the wall time of the receiving gateway node.
+	Timestamp Timestamp `protobuf:"bytes,3,opt,name=timestamp" json:"timestamp"`
+	// CmdID is optionally specified for request idempotence
+	// (i.e. replay protection).
+	CmdID ClientCmdID `protobuf:"bytes,4,opt,name=cmd_id" json:"cmd_id"`
+	// User is the originating user. Used to lookup priority when
+	// scheduling queued operations at target node.
+	User string `protobuf:"bytes,5,opt,name=user" json:"user"`
+	// UserPriority specifies priority multiple for non-transactional
+	// commands. This value should be a positive integer [1, 2^31-1).
+	// It's properly viewed as a multiple for how likely this
+	// transaction will be to prevail if a write conflict occurs.
+	// Commands with UserPriority=100 will be 100x less likely to be
+	// aborted as conflicting transactions or non-transactional commands
+	// with UserPriority=1. This value is ignored if Txn is
+	// specified. If neither this value nor Txn is specified, the value
+	// defaults to 1.
+	UserPriority *int32 `protobuf:"varint,6,opt,name=user_priority,def=1" json:"user_priority,omitempty"`
+	// Txn is set non-nil if a transaction is underway. To start a txn,
+	// the first request should set this field to non-nil with name and
+	// isolation level set as desired. The response will contain the
+	// fully-initialized transaction with txn ID, priority, initial
+	// timestamp, and maximum timestamp. The transaction returned in a
+	// response is feed to the next request.
+	Txn *Transaction `protobuf:"bytes,7,opt,name=txn" json:"txn,omitempty"`
+	// ReadConsistency specifies the consistency for read
+	// operations. The default is CONSISTENT. This value is ignored for
+	// write operations.
+	ReadConsistency  ReadConsistencyType `protobuf:"varint,8,opt,name=read_consistency,enum=cockroach.proto.ReadConsistencyType" json:"read_consistency"`
+	XXX_unrecognized []byte              `json:"-"`
 }
 
-func (m *BatchTableRequest_TableRequestUnion) GetDelete() *DeleteTableRowRequest {
+func (m *TableRequestHeader) Reset()         { *m = TableRequestHeader{} }
+func (m *TableRequestHeader) String() string { return proto1.CompactTextString(m) }
+func (*TableRequestHeader) ProtoMessage()    {}
+
+const Default_TableRequestHeader_UserPriority int32 = 1
+
+func (m *TableRequestHeader) GetName() string {
 	if m != nil {
-		return m.Delete
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-func (m *BatchTableRequest_TableRequestUnion) GetDeleteRange() *DeleteTableRowRangeRequest {
+func (m *TableRequestHeader) GetIndex() string {
 	if m != nil {
-		return m.DeleteRange
+		return m.Index
 	}
-	return nil
+	return ""
 }
 
-func (m *BatchTableRequest_TableRequestUnion) GetScan() *ScanTableRequest {
+func (m *TableRequestHeader) GetTimestamp() Timestamp {
 	if m != nil {
-		return m.Scan
+		return m.Timestamp
 	}
-	return nil
+	return Timestamp{}
 }
 
-func (m *BatchTableRequest_TableRequestUnion) GetEndTransaction() *EndTransactionRequest {
+func (m *TableRequestHeader) GetCmdID() ClientCmdID {
 	if m != nil {
-		return m.EndTransaction
+		return m.CmdID
 	}
-	return nil
+	return ClientCmdID{}
 }
 
-// A BatchTableResponse contains one or more responses, one per request
-// corresponding to the requests in the matching BatchRequest. The
-// error in the response header is set to the first error from the
-// slice of responses, if applicable.
-type BatchTableResponse struct {
-	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
-	Responses           []*BatchTableResponse_TableResponseUnion `protobuf:"bytes,2,rep,name=responses" json:"responses,omitempty"`
-	XXX_unrecognized    []byte                                   `json:"-"`
+func (m *TableRequestHeader) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
 }
 
-func (m *BatchTableResponse) Reset()         { *m = BatchTableResponse{} }
-func (m *BatchTableResponse) String() string { return proto1.CompactTextString(m) }
-func (*BatchTableResponse) ProtoMessage()    {}
+func (m *TableRequestHeader) GetUserPriority() int32 {
+	if m != nil && m.UserPriority != nil {
+		return *m.UserPriority
+	}
+	return Default_TableRequestHeader_UserPriority
+}
 
-func (m *BatchTableResponse) GetResponses() []*BatchTableResponse_TableResponseUnion {
+func (m *TableRequestHeader) GetTxn() *Transaction {
 	if m != nil {
-		return m.Responses
+		return m.Txn
 	}
 	return nil
 }
 
-// A TableResponseUnion contains exactly one of the optional responses.
-type BatchTableResponse_TableResponseUnion struct {
-	Get              *GetTableRowResponse            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
-	Put              *PutTableRowResponse            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
-	ConditionalPut   *ConditionalPutTableRowResponse `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
-	Delete           *DeleteTableRowResponse         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
-	DeleteRange      *DeleteTableRowRangeResponse    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
-	Scan             *ScanTableResponse              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
-	EndTransaction   *EndTransactionResponse         `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
-	XXX_unrecognized []byte                          `json:"-"`
+func (m *TableRequestHeader) GetReadConsistency() ReadConsistencyType {
+	if m != nil {
+		return m.ReadConsistency
+	}
+	return CONSISTENT
 }
 
-func (m *BatchTableResponse_TableResponseUnion) Reset()         { *m = BatchTableResponse_TableResponseUnion{} }
-func (m *BatchTableResponse_TableResponseUnion) String() string { return proto1.CompactTextString(m) }
-func (*BatchTableResponse_TableResponseUnion) ProtoMessage()    {}
+// TableResponseHeader is returned with every structured API response.
+type TableResponseHeader struct {
+	// Error is non-nil if an error occurred.
+	Error *Error `protobuf:"bytes,1,opt,name=error" json:"error,omitempty"`
+	// Timestamp specifies time at which read or write actually was
+	// performed. In the case of both reads and writes, if the timestamp
+	// supplied to the request was 0, the wall time of the node
+	// servicing the request will be set here. Additionally, in the case
+	// of writes, this value may be increased from the timestamp passed
+	// with the RequestHeader if the key being written was either read
+	// or written more recently.
+	//
+	// TODO(vivek): Is this only relevant in the non-transactional case?
+	Timestamp Timestamp `protobuf:"bytes,2,opt,name=timestamp" json:"timestamp"`
+	// Transaction is non-nil if the request specified a non-nil
+	// transaction. The transaction timestamp and/or priority may have
+	// been updated, depending on the outcome of the request.
+	Txn              *Transaction `protobuf:"bytes,3,opt,name=txn" json:"txn,omitempty"`
+	XXX_unrecognized []byte       `json:"-"`
+}
 
-func (m *BatchTableResponse_TableResponseUnion) GetGet() *GetTableRowResponse {
+func (m *TableResponseHeader) Reset()         { *m = TableResponseHeader{} }
+func (m *TableResponseHeader) String() string { return proto1.CompactTextString(m) }
+func (*TableResponseHeader) ProtoMessage()    {}
+
+func (m *TableResponseHeader) GetError() *Error {
 	if m != nil {
-		return m.Get
+		return m.Error
 	}
 	return nil
 }
 
-func (m *BatchTableResponse_TableResponseUnion) GetPut() *PutTableRowResponse {
+func (m *TableResponseHeader) GetTimestamp() Timestamp {
 	if m != nil {
-		return m.Put
+		return m.Timestamp
+	}
+	return Timestamp{}
+}
+
+func (m *TableResponseHeader) GetTxn() *Transaction {
+	if m != nil {
+		return m.Txn
 	}
 	return nil
 }
 
-func (m *BatchTableResponse_TableResponseUnion) GetConditionalPut() *ConditionalPutTableRowResponse {
+// A ColumnCollection represents a collection of columns as name:value pairs.
+type ColumnCollection struct {
+	Columns          []*ColumnCollection_ColumnNameValue `protobuf:"bytes,1,rep,name=columns" json:"columns,omitempty"`
+	XXX_unrecognized []byte                              `json:"-"`
+}
+
+func (m *ColumnCollection) Reset()         { *m = ColumnCollection{} }
+func (m *ColumnCollection) String() string { return proto1.CompactTextString(m) }
+func (*ColumnCollection) ProtoMessage()    {}
+
+func (m *ColumnCollection) GetColumns() []*ColumnCollection_ColumnNameValue {
 	if m != nil {
-		return m.ConditionalPut
+		return m.Columns
 	}
 	return nil
 }
 
-func (m *BatchTableResponse_TableResponseUnion) GetDelete() *DeleteTableRowResponse {
+type ColumnCollection_ColumnNameValue struct {
+	// Column name.
+	Name *string `protobuf:"bytes,1,opt,name=name" json:"name,omitempty"`
+	// A Nil Value indicates non-existence of the value.
+	Value            *Value `protobuf:"bytes,2,opt,name=value" json:"value,omitempty"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *ColumnCollection_ColumnNameValue) Reset()         { *m = ColumnCollection_ColumnNameValue{} }
+func (m *ColumnCollection_ColumnNameValue) String() string { return proto1.CompactTextString(m) }
+func (*ColumnCollection_ColumnNameValue) ProtoMessage()    {}
+
+func (m *ColumnCollection_ColumnNameValue) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ColumnCollection_ColumnNameValue) GetValue() *Value {
 	if m != nil {
-		return m.Delete
+		return m.Value
 	}
 	return nil
 }
 
-func (m *BatchTableResponse_TableResponseUnion) GetDeleteRange() *DeleteTableRowRangeResponse {
+// A ValueCollection is a collection of values. A non-existent value
+// is to be represented by Nil Bytes.
+type ValueCollection struct {
+	Values           []*Value `protobuf:"bytes,1,rep,name=values" json:"values,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ValueCollection) Reset()         { *m = ValueCollection{} }
+func (m *ValueCollection) String() string { return proto1.CompactTextString(m) }
+func (*ValueCollection) ProtoMessage()    {}
+
+func (m *ValueCollection) GetValues() []*Value {
 	if m != nil {
-		return m.DeleteRange
+		return m.Values
 	}
 	return nil
 }
 
-func (m *BatchTableResponse_TableResponseUnion) GetScan() *ScanTableResponse {
+// A GetTableRowRequest is used to read a table row.
+type GetTableRowRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Key                ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
+	// Get specified columns from the row.
+	Columns          []string `protobuf:"bytes,3,rep,name=columns" json:"columns,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *GetTableRowRequest) Reset()         { *m = GetTableRowRequest{} }
+func (m *GetTableRowRequest) String() string { return proto1.CompactTextString(m) }
+func (*GetTableRowRequest) ProtoMessage()    {}
+
+func (m *GetTableRowRequest) GetKey() ColumnCollection {
 	if m != nil {
-		return m.Scan
+		return m.Key
+	}
+	return ColumnCollection{}
+}
+
+func (m *GetTableRowRequest) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+// A GetTableRowResponse returns a row.
+// If the key doesn't exist, it returns an empty row.
+type GetTableRowResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Row                 ValueCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
+	XXX_unrecognized    []byte          `json:"-"`
+}
+
+func (m *GetTableRowResponse) Reset()         { *m = GetTableRowResponse{} }
+func (m *GetTableRowResponse) String() string { return proto1.CompactTextString(m) }
+func (*GetTableRowResponse) ProtoMessage()    {}
+
+func (m *GetTableRowResponse) GetRow() ValueCollection {
+	if m != nil {
+		return m.Row
+	}
+	return ValueCollection{}
+}
+
+// A PutTableRowRequest inserts/updates a row in the table. To write
+// an empty value in a cell specify an empty Bytes in the Value. To Delete
+// a column specify Nil Bytes.
+type PutTableRowRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// The columns forming the primary key columns must be part of the ColumnCollection.
+	Row              ColumnCollection `protobuf:"bytes,3,opt,name=row" json:"row"`
+	XXX_unrecognized []byte           `json:"-"`
+}
+
+func (m *PutTableRowRequest) Reset()         { *m = PutTableRowRequest{} }
+func (m *PutTableRowRequest) String() string { return proto1.CompactTextString(m) }
+func (*PutTableRowRequest) ProtoMessage()    {}
+
+func (m *PutTableRowRequest) GetRow() ColumnCollection {
+	if m != nil {
+		return m.Row
+	}
+	return ColumnCollection{}
+}
+
+// A PutTableRowResponse is the response.
+type PutTableRowResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized    []byte `json:"-"`
+}
+
+func (m *PutTableRowResponse) Reset()         { *m = PutTableRowResponse{} }
+func (m *PutTableRowResponse) String() string { return proto1.CompactTextString(m) }
+func (*PutTableRowResponse) ProtoMessage()    {}
+
+// A ConditionalPutTableRowRequest.
+//
+// Puts row if exp_row equals an existing row in the DB. Returns error
+// and the actual value of the row in the response if exp_row doesn't
+// match the full row in the DB. Both exp_row and row are not allowed
+// to contain any of the primary key columns.
+type ConditionalPutTableRowRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Key                ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
+	ExpRow             ColumnCollection `protobuf:"bytes,3,opt,name=exp_row" json:"exp_row"`
+	Row                ColumnCollection `protobuf:"bytes,4,opt,name=row" json:"row"`
+	XXX_unrecognized   []byte           `json:"-"`
+}
+
+func (m *ConditionalPutTableRowRequest) Reset()         { *m = ConditionalPutTableRowRequest{} }
+func (m *ConditionalPutTableRowRequest) String() string { return proto1.CompactTextString(m) }
+func (*ConditionalPutTableRowRequest) ProtoMessage()    {}
+
+func (m *ConditionalPutTableRowRequest) GetKey() ColumnCollection {
+	if m != nil {
+		return m.Key
+	}
+	return ColumnCollection{}
+}
+
+func (m *ConditionalPutTableRowRequest) GetExpRow() ColumnCollection {
+	if m != nil {
+		return m.ExpRow
+	}
+	return ColumnCollection{}
+}
+
+func (m *ConditionalPutTableRowRequest) GetRow() ColumnCollection {
+	if m != nil {
+		return m.Row
+	}
+	return ColumnCollection{}
+}
+
+// A ConditionalPutTableRowResponse. The row returned is the actual row in
+// the DB that doesn't full match exp_row in the request. Only the columns
+// forming the index key match up with those in exp_row.
+type ConditionalPutTableRowResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Row                 ValueCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
+	XXX_unrecognized    []byte          `json:"-"`
+}
+
+func (m *ConditionalPutTableRowResponse) Reset()         { *m = ConditionalPutTableRowResponse{} }
+func (m *ConditionalPutTableRowResponse) String() string { return proto1.CompactTextString(m) }
+func (*ConditionalPutTableRowResponse) ProtoMessage()    {}
+
+func (m *ConditionalPutTableRowResponse) GetRow() ValueCollection {
+	if m != nil {
+		return m.Row
+	}
+	return ValueCollection{}
+}
+
+// An IncrementTableRequest increments the value of the columns in row.
+// row can only contain the columns forming the index key and columns
+// of type integer. Incrementing by 0 is not a noop, and creates a zero value.
+type IncrementTableRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Row                ColumnCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
+	XXX_unrecognized   []byte           `json:"-"`
+}
+
+func (m *IncrementTableRequest) Reset()         { *m = IncrementTableRequest{} }
+func (m *IncrementTableRequest) String() string { return proto1.CompactTextString(m) }
+func (*IncrementTableRequest) ProtoMessage()    {}
+
+func (m *IncrementTableRequest) GetRow() ColumnCollection {
+	if m != nil {
+		return m.Row
+	}
+	return ColumnCollection{}
+}
+
+// An IncrementTableResponse returns the row with the new column values.
+type IncrementTableResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Row                 ValueCollection `protobuf:"bytes,2,opt,name=row" json:"row"`
+	XXX_unrecognized    []byte          `json:"-"`
+}
+
+func (m *IncrementTableResponse) Reset()         { *m = IncrementTableResponse{} }
+func (m *IncrementTableResponse) String() string { return proto1.CompactTextString(m) }
+func (*IncrementTableResponse) ProtoMessage()    {}
+
+func (m *IncrementTableResponse) GetRow() ValueCollection {
+	if m != nil {
+		return m.Row
+	}
+	return ValueCollection{}
+}
+
+// A DeleteTableRowRequest deletes the entire row. key contains the columns
+// forming the index key.
+type DeleteTableRowRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Key                ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
+	XXX_unrecognized   []byte           `json:"-"`
+}
+
+func (m *DeleteTableRowRequest) Reset()         { *m = DeleteTableRowRequest{} }
+func (m *DeleteTableRowRequest) String() string { return proto1.CompactTextString(m) }
+func (*DeleteTableRowRequest) ProtoMessage()    {}
+
+func (m *DeleteTableRowRequest) GetKey() ColumnCollection {
+	if m != nil {
+		return m.Key
+	}
+	return ColumnCollection{}
+}
+
+// A DeleteTableRowResponse.
+type DeleteTableRowResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	XXX_unrecognized    []byte `json:"-"`
+}
+
+func (m *DeleteTableRowResponse) Reset()         { *m = DeleteTableRowResponse{} }
+func (m *DeleteTableRowResponse) String() string { return proto1.CompactTextString(m) }
+func (*DeleteTableRowResponse) ProtoMessage()    {}
+
+// A DeleteTableRowRangeRequest deletes the specified rows. Both key and end_key
+// must only contain the columns forming the index key.
+type DeleteTableRowRangeRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Delete *all* entries between Key (inclusive) and EndKey
+	// (exclusive).
+	Key    ColumnCollection `protobuf:"bytes,2,opt,name=key" json:"key"`
+	EndKey ColumnCollection `protobuf:"bytes,3,opt,name=end_key" json:"end_key"`
+	// Must be > 0.
+	MaxEntriesToDelete int64  `protobuf:"varint,4,opt,name=max_entries_to_delete" json:"max_entries_to_delete"`
+	XXX_unrecognized   []byte `json:"-"`
+}
+
+func (m *DeleteTableRowRangeRequest) Reset()         { *m = DeleteTableRowRangeRequest{} }
+func (m *DeleteTableRowRangeRequest) String() string { return proto1.CompactTextString(m) }
+func (*DeleteTableRowRangeRequest) ProtoMessage()    {}
+
+func (m *DeleteTableRowRangeRequest) GetKey() ColumnCollection {
+	if m != nil {
+		return m.Key
+	}
+	return ColumnCollection{}
+}
+
+func (m *DeleteTableRowRangeRequest) GetEndKey() ColumnCollection {
+	if m != nil {
+		return m.EndKey
+	}
+	return ColumnCollection{}
+}
+
+func (m *DeleteTableRowRangeRequest) GetMaxEntriesToDelete() int64 {
+	if m != nil {
+		return m.MaxEntriesToDelete
+	}
+	return 0
+}
+
+// A DeleteTableRowRangeResponse.
+type DeleteTableRowRangeResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Number of entries deleted.
+	NumDeleted       int64  `protobuf:"varint,2,opt,name=num_deleted" json:"num_deleted"`
+	XXX_unrecognized []byte `json:"-"`
+}
+
+func (m *DeleteTableRowRangeResponse) Reset()         { *m = DeleteTableRowRangeResponse{} }
+func (m *DeleteTableRowRangeResponse) String() string { return proto1.CompactTextString(m) }
+func (*DeleteTableRowRangeResponse) ProtoMessage()    {}
+
+func (m *DeleteTableRowRangeResponse) GetNumDeleted() int64 {
+	if m != nil {
+		return m.NumDeleted
+	}
+	return 0
+}
+
+// A ScanTableRequest. It specifies the start and end keys for the scan
+// and the maximum number of results.
+type ScanTableRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Both key and end_key must only contain the columns forming the index key.
+	Key        ColumnCollection `protobuf:"bytes,3,opt,name=key" json:"key"`
+	EndKey     ColumnCollection `protobuf:"bytes,4,opt,name=end_key" json:"end_key"`
+	MaxResults int64            `protobuf:"varint,5,opt,name=max_results" json:"max_results"`
+	// Get the specified columns from the scanned rows.
+	Columns          []string `protobuf:"bytes,6,rep,name=columns" json:"columns,omitempty"`
+	XXX_unrecognized []byte   `json:"-"`
+}
+
+func (m *ScanTableRequest) Reset()         { *m = ScanTableRequest{} }
+func (m *ScanTableRequest) String() string { return proto1.CompactTextString(m) }
+func (*ScanTableRequest) ProtoMessage()    {}
+
+func (m *ScanTableRequest) GetKey() ColumnCollection {
+	if m != nil {
+		return m.Key
+	}
+	return ColumnCollection{}
+}
+
+func (m *ScanTableRequest) GetEndKey() ColumnCollection {
+	if m != nil {
+		return m.EndKey
+	}
+	return ColumnCollection{}
+}
+
+func (m *ScanTableRequest) GetMaxResults() int64 {
+	if m != nil {
+		return m.MaxResults
+	}
+	return 0
+}
+
+func (m *ScanTableRequest) GetColumns() []string {
+	if m != nil {
+		return m.Columns
+	}
+	return nil
+}
+
+// A ScanTableResponse is the response.
+type ScanTableResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	// Empty in case of error.
+	Rows             []*ValueCollection `protobuf:"bytes,2,rep,name=rows" json:"rows,omitempty"`
+	XXX_unrecognized []byte             `json:"-"`
+}
+
+func (m *ScanTableResponse) Reset()         { *m = ScanTableResponse{} }
+func (m *ScanTableResponse) String() string { return proto1.CompactTextString(m) }
+func (*ScanTableResponse) ProtoMessage()    {}
+
+func (m *ScanTableResponse) GetRows() []*ValueCollection {
+	if m != nil {
+		return m.Rows
+	}
+	return nil
+}
+
+// A BatchTableRequest contains one or more requests to be executed in
+// parallel, or if applicable (based on write-only commands and
+// range-locality), as a single update.
+type BatchTableRequest struct {
+	TableRequestHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Requests           []*BatchTableRequest_TableRequestUnion `protobuf:"bytes,2,rep,name=requests" json:"requests,omitempty"`
+	XXX_unrecognized   []byte                                 `json:"-"`
+}
+
+func (m *BatchTableRequest) Reset()         { *m = BatchTableRequest{} }
+func (m *BatchTableRequest) String() string { return proto1.CompactTextString(m) }
+func (*BatchTableRequest) ProtoMessage()    {}
+
+func (m *BatchTableRequest) GetRequests() []*BatchTableRequest_TableRequestUnion {
+	if m != nil {
+		return m.Requests
+	}
+	return nil
+}
+
+// A TableRequestUnion contains exactly one of the optional requests.
+type BatchTableRequest_TableRequestUnion struct {
+	Get              *GetTableRowRequest            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put              *PutTableRowRequest            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
+	ConditionalPut   *ConditionalPutTableRowRequest `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Delete           *DeleteTableRowRequest         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange      *DeleteTableRowRangeRequest    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
+	Scan             *ScanTableRequest              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
+	EndTransaction   *EndTransactionRequest         `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	XXX_unrecognized []byte                         `json:"-"`
+}
+
+func (m *BatchTableRequest_TableRequestUnion) Reset()         { *m = BatchTableRequest_TableRequestUnion{} }
+func (m *BatchTableRequest_TableRequestUnion) String() string { return proto1.CompactTextString(m) }
+func (*BatchTableRequest_TableRequestUnion) ProtoMessage()    {}
+
+func (m *BatchTableRequest_TableRequestUnion) GetGet() *GetTableRowRequest {
+	if m != nil {
+		return m.Get
+	}
+	return nil
+}
+
+func (m *BatchTableRequest_TableRequestUnion) GetPut() *PutTableRowRequest {
+	if m != nil {
+		return m.Put
+	}
+	return nil
+}
+
+func (m *BatchTableRequest_TableRequestUnion) GetConditionalPut() *ConditionalPutTableRowRequest {
+	if m != nil {
+		return m.ConditionalPut
+	}
+	return nil
+}
+
+func (m *BatchTableRequest_TableRequestUnion) GetDelete() *DeleteTableRowRequest {
+	if m != nil {
+		return m.Delete
+	}
+	return nil
+}
+
+func (m *BatchTableRequest_TableRequestUnion) GetDeleteRange() *DeleteTableRowRangeRequest {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
+func (m *BatchTableRequest_TableRequestUnion) GetScan() *ScanTableRequest {
+	if m != nil {
+		return m.Scan
+	}
+	return nil
+}
+
+func (m *BatchTableRequest_TableRequestUnion) GetEndTransaction() *EndTransactionRequest {
+	if m != nil {
+		return m.EndTransaction
+	}
+	return nil
+}
+
+// A BatchTableResponse contains one or more responses, one per request
+// corresponding to the requests in the matching BatchRequest. The
+// error in the response header is set to the first error from the
+// slice of responses, if applicable.
+type BatchTableResponse struct {
+	TableResponseHeader `protobuf:"bytes,1,opt,name=header,embedded=header" json:"header"`
+	Responses           []*BatchTableResponse_TableResponseUnion `protobuf:"bytes,2,rep,name=responses" json:"responses,omitempty"`
+	XXX_unrecognized    []byte                                   `json:"-"`
+}
+
+func (m *BatchTableResponse) Reset()         { *m = BatchTableResponse{} }
+func (m *BatchTableResponse) String() string { return proto1.CompactTextString(m) }
+func (*BatchTableResponse) ProtoMessage()    {}
+
+func (m *BatchTableResponse) GetResponses() []*BatchTableResponse_TableResponseUnion {
+	if m != nil {
+		return m.Responses
+	}
+	return nil
+}
+
+// A TableResponseUnion contains exactly one of the optional responses.
+type BatchTableResponse_TableResponseUnion struct {
+	Get              *GetTableRowResponse            `protobuf:"bytes,2,opt,name=get" json:"get,omitempty"`
+	Put              *PutTableRowResponse            `protobuf:"bytes,3,opt,name=put" json:"put,omitempty"`
+	ConditionalPut   *ConditionalPutTableRowResponse `protobuf:"bytes,4,opt,name=conditional_put" json:"conditional_put,omitempty"`
+	Delete           *DeleteTableRowResponse         `protobuf:"bytes,6,opt,name=delete" json:"delete,omitempty"`
+	DeleteRange      *DeleteTableRowRangeResponse    `protobuf:"bytes,7,opt,name=delete_range" json:"delete_range,omitempty"`
+	Scan             *ScanTableResponse              `protobuf:"bytes,8,opt,name=scan" json:"scan,omitempty"`
+	EndTransaction   *EndTransactionResponse         `protobuf:"bytes,9,opt,name=end_transaction" json:"end_transaction,omitempty"`
+	XXX_unrecognized []byte                          `json:"-"`
+}
+
+func (m *BatchTableResponse_TableResponseUnion) Reset()         { *m = BatchTableResponse_TableResponseUnion{} }
+func (m *BatchTableResponse_TableResponseUnion) String() string { return proto1.CompactTextString(m) }
+func (*BatchTableResponse_TableResponseUnion) ProtoMessage()    {}
+
+func (m *BatchTableResponse_TableResponseUnion) GetGet() *GetTableRowResponse {
+	if m != nil {
+		return m.Get
+	}
+	return nil
+}
+
+func (m *BatchTableResponse_TableResponseUnion) GetPut() *PutTableRowResponse {
+	if m != nil {
+		return m.Put
+	}
+	return nil
+}
+
+func (m *BatchTableResponse_TableResponseUnion) GetConditionalPut() *ConditionalPutTableRowResponse {
+	if m != nil {
+		return m.ConditionalPut
+	}
+	return nil
+}
+
+func (m *BatchTableResponse_TableResponseUnion) GetDelete() *DeleteTableRowResponse {
+	if m != nil {
+		return m.Delete
+	}
+	return nil
+}
+
+func (m *BatchTableResponse_TableResponseUnion) GetDeleteRange() *DeleteTableRowRangeResponse {
+	if m != nil {
+		return m.DeleteRange
+	}
+	return nil
+}
+
+func (m *BatchTableResponse_TableResponseUnion) GetScan() *ScanTableResponse {
+	if m != nil {
+		return m.Scan
+	}
+	return nil
+}
+
+func (m *BatchTableResponse_TableResponseUnion) GetEndTransaction() *EndTransactionResponse {
+	if m != nil {
+		return m.EndTransaction
+	}
+	return nil
+}
+
+func init() {
+	proto1.RegisterEnum("cockroach.proto.Column_ColumnType", Column_ColumnType_name, Column_ColumnType_value)
+	proto1.RegisterEnum("cockroach.proto.PrivilegeDescriptor_Kind", PrivilegeDescriptor_Kind_name, PrivilegeDescriptor_Kind_value)
+	proto1.RegisterEnum("cockroach.proto.TableDescriptor_FormatVersion", TableDescriptor_FormatVersion_name, TableDescriptor_FormatVersion_value)
+	proto1.RegisterEnum("cockroach.proto.SchemaJob_Type", SchemaJob_Type_name, SchemaJob_Type_value)
+	proto1.RegisterEnum("cockroach.proto.SchemaJob_Status", SchemaJob_Status_name, SchemaJob_Status_value)
+}
+func (m *Table) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSeconds", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.TTLSeconds |= (int32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *Column) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Type |= (Column_ColumnType(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputeExpr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ComputeExpr = string(data[index:postIndex])
+			index = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *Index) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Unique = bool(v != 0)
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *TableSchema) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Table.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, Column{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, TableSchema_IndexByName{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *TableSchema_IndexByName) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnNames = append(m.ColumnNames, string(data[index:postIndex]))
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyExprs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyExprs = append(m.KeyExprs, string(data[index:postIndex]))
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ColumnDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Column.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *IndexDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Index.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIds", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnIds = append(m.ColumnIds, v)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ColumnNames = append(m.ColumnNames, string(data[index:postIndex]))
+			index = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyExprs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyExprs = append(m.KeyExprs, string(data[index:postIndex]))
+			index = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForeignKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ForeignKey == nil {
+				m.ForeignKey = &ForeignKeyReference{}
+			}
+			if err := m.ForeignKey.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *ForeignKeyReference) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.TableId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.IndexId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *UserPrivileges) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(data[index:postIndex])
+			index = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Privileges |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *PrivilegeDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Users", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Users = append(m.Users, UserPrivileges{})
+			if err := m.Users[len(m.Users)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *TableDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Table.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Columns = append(m.Columns, ColumnDescriptor{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextColumnId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.NextColumnId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Indexes = append(m.Indexes, IndexDescriptor{})
+			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextIndexId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.NextIndexId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrimaryIndex", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PrimaryIndex.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FormatVersion", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.FormatVersion |= (TableDescriptor_FormatVersion(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.ParentId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterleaveParentId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.InterleaveParentId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DropTime", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.DropTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Version |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Privileges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				msglen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Privileges.Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReclaimTime", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.ReclaimTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *DatabaseDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[index:postIndex])
+			index = postIndex
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
+	}
+
+	return nil
+}
+func (m *SequenceDescriptor) Unmarshal(data []byte) error {
+	l := len(data)
+	index := 0
+	for index < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if index >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := data[index]
+			index++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(data[index:postIndex])
+			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Start |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Increment", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Increment |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CacheSize", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.CacheSize |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			var sizeOfWire int
+			for {
+				sizeOfWire++
+				wire >>= 7
+				if wire == 0 {
+					break
+				}
+			}
+			index -= sizeOfWire
+			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
+			if err != nil {
+				return err
+			}
+			if (index + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
+			index += skippy
+		}
 	}
-	return nil
-}
 
-func (m *BatchTableResponse_TableResponseUnion) GetEndTransaction() *EndTransactionResponse {
-	if m != nil {
-		return m.EndTransaction
-	}
 	return nil
 }
-
-func init() {
-	proto1.RegisterEnum("cockroach.proto.Column_ColumnType", Column_ColumnType_name, Column_ColumnType_value)
-}
-func (m *Table) Unmarshal(data []byte) error {
+func (m *ViewDescriptor) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -1024,6 +3424,21 @@ func (m *Table) Unmarshal(data []byte) error {
 		wireType := int(wire & 0x7)
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -1045,6 +3460,60 @@ func (m *Table) Unmarshal(data []byte) error {
 			}
 			m.Name = string(data[index:postIndex])
 			index = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.TableId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIds", wireType)
+			}
+			var v uint32
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ColumnIds = append(m.ColumnIds, v)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(data[index:postIndex])
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1069,7 +3538,7 @@ func (m *Table) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *Column) Unmarshal(data []byte) error {
+func (m *SchemaJob) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -1089,8 +3558,53 @@ func (m *Column) Unmarshal(data []byte) error {
 		wireType := int(wire & 0x7)
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Id |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Type |= (SchemaJob_Type(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.TableId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1108,11 +3622,11 @@ func (m *Column) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[index:postIndex])
+			m.Description = string(data[index:postIndex])
 			index = postIndex
-		case 2:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -1120,210 +3634,221 @@ func (m *Column) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.Type |= (Column_ColumnType(b) & 0x7F) << shift
+				m.Status |= (SchemaJob_Status(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Progress", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.Progress |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				stringLen |= (uint64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			postIndex := index + int(stringLen)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(data[index:postIndex])
+			index = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.StartTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.FinishTime |= (int64(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.IndexId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumeKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				byteLen |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *Index) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
+			postIndex := index + byteLen
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
+			m.ResumeKey = append([]byte{}, data[index:postIndex]...)
+			index = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseOwner", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + int(stringLen)
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(data[index:postIndex])
+			m.LeaseOwner = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
-		case 2:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unique", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseExpiration", wireType)
 			}
-			var v int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				v |= (int(b) & 0x7F) << shift
+				m.LeaseExpiration |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Unique = bool(v != 0)
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelRequested", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				v |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
 					break
 				}
 			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *TableSchema) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			m.CancelRequested = bool(v != 0)
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnId", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.ColumnId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Table.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
-		case 2:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnValue", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Columns = append(m.Columns, Column{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
+			m.ColumnValue = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceTableId", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SourceTableId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Indexes = append(m.Indexes, TableSchema_IndexByName{})
-			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1348,7 +3873,7 @@ func (m *TableSchema) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *TableSchema_IndexByName) Unmarshal(data []byte) error {
+func (m *HistogramBucket) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -1369,50 +3894,41 @@ func (m *TableSchema_IndexByName) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperBound", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Index.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
+			m.UpperBound = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnNames", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
-			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				stringLen |= (uint64(b) & 0x7F) << shift
+				m.Count |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + int(stringLen)
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ColumnNames = append(m.ColumnNames, string(data[index:postIndex]))
-			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1437,7 +3953,7 @@ func (m *TableSchema_IndexByName) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *ColumnDescriptor) Unmarshal(data []byte) error {
+func (m *ColumnStatistics) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -1458,7 +3974,7 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnId", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -1466,81 +3982,29 @@ func (m *ColumnDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.ColumnId |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Column", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NullCount", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.NullCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Column.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
-		default:
-			var sizeOfWire int
-			for {
-				sizeOfWire++
-				wire >>= 7
-				if wire == 0 {
-					break
-				}
-			}
-			index -= sizeOfWire
-			skippy, err := github_com_gogo_protobuf_proto.Skip(data[index:])
-			if err != nil {
-				return err
-			}
-			if (index + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, data[index:index+skippy]...)
-			index += skippy
-		}
-	}
-
-	return nil
-}
-func (m *IndexDescriptor) Unmarshal(data []byte) error {
-	l := len(data)
-	index := 0
-	for index < l {
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if index >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := data[index]
-			index++
-			wire |= (uint64(b) & 0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistinctCount", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -1548,52 +4012,58 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.DistinctCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sketch", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				byteLen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
+			postIndex := index + byteLen
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Index.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
+			m.Sketch = append([]byte{}, data[index:postIndex]...)
 			index = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIds", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histogram", wireType)
 			}
-			var v uint32
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				v |= (uint32(b) & 0x7F) << shift
+				msglen |= (int(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.ColumnIds = append(m.ColumnIds, v)
+			postIndex := index + msglen
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histogram = append(m.Histogram, HistogramBucket{})
+			if err := m.Histogram[len(m.Histogram)-1].Unmarshal(data[index:postIndex]); err != nil {
+				return err
+			}
+			index = postIndex
 		default:
 			var sizeOfWire int
 			for {
@@ -1618,7 +4088,7 @@ func (m *IndexDescriptor) Unmarshal(data []byte) error {
 
 	return nil
 }
-func (m *TableDescriptor) Unmarshal(data []byte) error {
+func (m *TableStatistics) Unmarshal(data []byte) error {
 	l := len(data)
 	index := 0
 	for index < l {
@@ -1639,7 +4109,22 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TableId", wireType)
+			}
+			for shift := uint(0); ; shift += 7 {
+				if index >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := data[index]
+				index++
+				m.TableId |= (uint32(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableVersion", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -1647,63 +4132,44 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.Id |= (uint32(b) & 0x7F) << shift
+				m.TableVersion |= (uint32(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowCount", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.RowCount |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Table.Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SampleSize", wireType)
 			}
-			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
 					return io.ErrUnexpectedEOF
 				}
 				b := data[index]
 				index++
-				msglen |= (int(b) & 0x7F) << shift
+				m.SampleSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			postIndex := index + msglen
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Columns = append(m.Columns, ColumnDescriptor{})
-			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[index:postIndex]); err != nil {
-				return err
-			}
-			index = postIndex
-		case 4:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextColumnId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedAt", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -1711,14 +4177,14 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.NextColumnId |= (uint32(b) & 0x7F) << shift
+				m.CollectedAt |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Indexes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Columns", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1736,14 +4202,14 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Indexes = append(m.Indexes, IndexDescriptor{})
-			if err := m.Indexes[len(m.Indexes)-1].Unmarshal(data[index:postIndex]); err != nil {
+			m.Columns = append(m.Columns, ColumnStatistics{})
+			if err := m.Columns[len(m.Columns)-1].Unmarshal(data[index:postIndex]); err != nil {
 				return err
 			}
 			index = postIndex
-		case 6:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextIndexId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSize", wireType)
 			}
 			for shift := uint(0); ; shift += 7 {
 				if index >= l {
@@ -1751,7 +4217,7 @@ func (m *TableDescriptor) Unmarshal(data []byte) error {
 				}
 				b := data[index]
 				index++
-				m.NextIndexId |= (uint32(b) & 0x7F) << shift
+				m.DataSize |= (int64(b) & 0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4652,6 +7118,9 @@ func (m *Table) Size() (n int) {
 	_ = l
 	l = len(m.Name)
 	n += 1 + l + sovStructured(uint64(l))
+	l = len(m.Comment)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.TTLSeconds))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4664,6 +7133,10 @@ func (m *Column) Size() (n int) {
 	l = len(m.Name)
 	n += 1 + l + sovStructured(uint64(l))
 	n += 1 + sovStructured(uint64(m.Type))
+	l = len(m.ComputeExpr)
+	n += 1 + l + sovStructured(uint64(l))
+	l = len(m.Comment)
+	n += 1 + l + sovStructured(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4716,6 +7189,12 @@ func (m *TableSchema_IndexByName) Size() (n int) {
 			n += 1 + l + sovStructured(uint64(l))
 		}
 	}
+	if len(m.KeyExprs) > 0 {
+		for _, s := range m.KeyExprs {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4745,6 +7224,60 @@ func (m *IndexDescriptor) Size() (n int) {
 			n += 1 + sovStructured(uint64(e))
 		}
 	}
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if len(m.KeyExprs) > 0 {
+		for _, s := range m.KeyExprs {
+			l = len(s)
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if m.ForeignKey != nil {
+		l = m.ForeignKey.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ForeignKeyReference) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.TableId))
+	n += 1 + sovStructured(uint64(m.IndexId))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *UserPrivileges) Size() (n int) {
+	var l int
+	_ = l
+	l = len(m.User)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.Privileges))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PrivilegeDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	if len(m.Users) > 0 {
+		for _, e := range m.Users {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4771,6 +7304,157 @@ func (m *TableDescriptor) Size() (n int) {
 		}
 	}
 	n += 1 + sovStructured(uint64(m.NextIndexId))
+	l = m.PrimaryIndex.Size()
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.FormatVersion))
+	n += 1 + sovStructured(uint64(m.ParentId))
+	n += 1 + sovStructured(uint64(m.InterleaveParentId))
+	n += 1 + sovStructured(uint64(m.DropTime))
+	n += 1 + sovStructured(uint64(m.Version))
+	l = m.Privileges.Size()
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.ReclaimTime))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DatabaseDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.Id))
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SequenceDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.Id))
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.Start))
+	n += 1 + sovStructured(uint64(m.Increment))
+	n += 1 + sovStructured(uint64(m.CacheSize))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ViewDescriptor) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.Id))
+	l = len(m.Name)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.TableId))
+	if len(m.ColumnIds) > 0 {
+		for _, e := range m.ColumnIds {
+			n += 1 + sovStructured(uint64(e))
+		}
+	}
+	l = len(m.Query)
+	n += 1 + l + sovStructured(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SchemaJob) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.Id))
+	n += 1 + sovStructured(uint64(m.Type))
+	n += 1 + sovStructured(uint64(m.TableId))
+	l = len(m.Description)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.Status))
+	n += 1 + sovStructured(uint64(m.Progress))
+	l = len(m.Error)
+	n += 1 + l + sovStructured(uint64(l))
+	n += 1 + sovStructured(uint64(m.StartTime))
+	n += 1 + sovStructured(uint64(m.FinishTime))
+	n += 1 + sovStructured(uint64(m.IndexId))
+	if m.ResumeKey != nil {
+		l = len(m.ResumeKey)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.LeaseOwner != nil {
+		l = len(m.LeaseOwner)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	n += 1 + sovStructured(uint64(m.LeaseExpiration))
+	n += 2
+	n += 1 + sovStructured(uint64(m.ColumnId))
+	if m.ColumnValue != nil {
+		l = len(m.ColumnValue)
+		n += 2 + l + sovStructured(uint64(l))
+	}
+	n += 2 + sovStructured(uint64(m.SourceTableId))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *HistogramBucket) Size() (n int) {
+	var l int
+	_ = l
+	if m.UpperBound != nil {
+		l = len(m.UpperBound)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	n += 1 + sovStructured(uint64(m.Count))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ColumnStatistics) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.ColumnId))
+	n += 1 + sovStructured(uint64(m.NullCount))
+	n += 1 + sovStructured(uint64(m.DistinctCount))
+	if m.Sketch != nil {
+		l = len(m.Sketch)
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if len(m.Histogram) > 0 {
+		for _, e := range m.Histogram {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TableStatistics) Size() (n int) {
+	var l int
+	_ = l
+	n += 1 + sovStructured(uint64(m.TableId))
+	n += 1 + sovStructured(uint64(m.TableVersion))
+	n += 1 + sovStructured(uint64(m.RowCount))
+	n += 1 + sovStructured(uint64(m.SampleSize))
+	n += 1 + sovStructured(uint64(m.CollectedAt))
+	if len(m.Columns) > 0 {
+		for _, e := range m.Columns {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	n += 1 + sovStructured(uint64(m.DataSize))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5075,22 +7759,76 @@ func (m *ScanTableRequest) Size() (n int) {
 			n += 1 + l + sovStructured(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScanTableResponse) Size() (n int) {
+	var l int
+	_ = l
+	l = m.TableResponseHeader.Size()
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.Rows) > 0 {
+		for _, e := range m.Rows {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchTableRequest) Size() (n int) {
+	var l int
+	_ = l
+	l = m.TableRequestHeader.Size()
+	n += 1 + l + sovStructured(uint64(l))
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovStructured(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BatchTableRequest_TableRequestUnion) Size() (n int) {
+	var l int
+	_ = l
+	if m.Get != nil {
+		l = m.Get.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.Put != nil {
+		l = m.Put.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.ConditionalPut != nil {
+		l = m.ConditionalPut.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.Delete != nil {
+		l = m.Delete.Size()
+		n += 1 + l + sovStructured(uint64(l))
 	}
-	return n
-}
-
-func (m *ScanTableResponse) Size() (n int) {
-	var l int
-	_ = l
-	l = m.TableResponseHeader.Size()
-	n += 1 + l + sovStructured(uint64(l))
-	if len(m.Rows) > 0 {
-		for _, e := range m.Rows {
-			l = e.Size()
-			n += 1 + l + sovStructured(uint64(l))
-		}
+	if m.DeleteRange != nil {
+		l = m.DeleteRange.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.Scan != nil {
+		l = m.Scan.Size()
+		n += 1 + l + sovStructured(uint64(l))
+	}
+	if m.EndTransaction != nil {
+		l = m.EndTransaction.Size()
+		n += 1 + l + sovStructured(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -5098,13 +7836,13 @@ func (m *ScanTableResponse) Size() (n int) {
 	return n
 }
 
-func (m *BatchTableRequest) Size() (n int) {
+func (m *BatchTableResponse) Size() (n int) {
 	var l int
 	_ = l
-	l = m.TableRequestHeader.Size()
+	l = m.TableResponseHeader.Size()
 	n += 1 + l + sovStructured(uint64(l))
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
+	if len(m.Responses) > 0 {
+		for _, e := range m.Responses {
 			l = e.Size()
 			n += 1 + l + sovStructured(uint64(l))
 		}
@@ -5115,7 +7853,7 @@ func (m *BatchTableRequest) Size() (n int) {
 	return n
 }
 
-func (m *BatchTableRequest_TableRequestUnion) Size() (n int) {
+func (m *BatchTableResponse_TableResponseUnion) Size() (n int) {
 	var l int
 	_ = l
 	if m.Get != nil {
@@ -5149,77 +7887,347 @@ func (m *BatchTableRequest_TableRequestUnion) Size() (n int) {
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
-	return n
+	return n
+}
+
+func sovStructured(x uint64) (n int) {
+	for {
+		n++
+		x >>= 7
+		if x == 0 {
+			break
+		}
+	}
+	return n
+}
+func sozStructured(x uint64) (n int) {
+	return sovStructured(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Table) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Table) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Comment)))
+	i += copy(data[i:], m.Comment)
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.TTLSeconds))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Column) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Column) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Type))
+	data[i] = 0x1a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.ComputeExpr)))
+	i += copy(data[i:], m.ComputeExpr)
+	data[i] = 0x22
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Comment)))
+	i += copy(data[i:], m.Comment)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *Index) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *Index) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x10
+	i++
+	if m.Unique {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TableSchema) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TableSchema) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Table.Size()))
+	n1, err := m.Table.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n1
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			data[i] = 0x12
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if len(m.Indexes) > 0 {
+		for _, msg := range m.Indexes {
+			data[i] = 0x1a
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TableSchema_IndexByName) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TableSchema_IndexByName) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0xa
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Index.Size()))
+	n2, err := m.Index.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n2
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			data[i] = 0x12
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if len(m.KeyExprs) > 0 {
+		for _, s := range m.KeyExprs {
+			data[i] = 0x1a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *ColumnDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
 }
 
-func (m *BatchTableResponse) Size() (n int) {
+func (m *ColumnDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	l = m.TableResponseHeader.Size()
-	n += 1 + l + sovStructured(uint64(l))
-	if len(m.Responses) > 0 {
-		for _, e := range m.Responses {
-			l = e.Size()
-			n += 1 + l + sovStructured(uint64(l))
-		}
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Id))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Column.Size()))
+	n3, err := m.Column.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
+	i += n3
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func (m *BatchTableResponse_TableResponseUnion) Size() (n int) {
+func (m *IndexDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *IndexDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
 	var l int
 	_ = l
-	if m.Get != nil {
-		l = m.Get.Size()
-		n += 1 + l + sovStructured(uint64(l))
-	}
-	if m.Put != nil {
-		l = m.Put.Size()
-		n += 1 + l + sovStructured(uint64(l))
-	}
-	if m.ConditionalPut != nil {
-		l = m.ConditionalPut.Size()
-		n += 1 + l + sovStructured(uint64(l))
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Id))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Index.Size()))
+	n4, err := m.Index.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
 	}
-	if m.Delete != nil {
-		l = m.Delete.Size()
-		n += 1 + l + sovStructured(uint64(l))
+	i += n4
+	if len(m.ColumnIds) > 0 {
+		for _, num := range m.ColumnIds {
+			data[i] = 0x18
+			i++
+			i = encodeVarintStructured(data, i, uint64(num))
+		}
 	}
-	if m.DeleteRange != nil {
-		l = m.DeleteRange.Size()
-		n += 1 + l + sovStructured(uint64(l))
+	if len(m.ColumnNames) > 0 {
+		for _, s := range m.ColumnNames {
+			data[i] = 0x22
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	if m.Scan != nil {
-		l = m.Scan.Size()
-		n += 1 + l + sovStructured(uint64(l))
+	if len(m.KeyExprs) > 0 {
+		for _, s := range m.KeyExprs {
+			data[i] = 0x2a
+			i++
+			l = len(s)
+			for l >= 1<<7 {
+				data[i] = uint8(uint64(l)&0x7f | 0x80)
+				l >>= 7
+				i++
+			}
+			data[i] = uint8(l)
+			i++
+			i += copy(data[i:], s)
+		}
 	}
-	if m.EndTransaction != nil {
-		l = m.EndTransaction.Size()
-		n += 1 + l + sovStructured(uint64(l))
+	if m.ForeignKey != nil {
+		data[i] = 0x32
+		i++
+		i = encodeVarintStructured(data, i, uint64(m.ForeignKey.Size()))
+		n5, err := m.ForeignKey.MarshalTo(data[i:])
+		if err != nil {
+			return 0, err
+		}
+		i += n5
 	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i += copy(data[i:], m.XXX_unrecognized)
 	}
-	return n
+	return i, nil
 }
 
-func sovStructured(x uint64) (n int) {
-	for {
-		n++
-		x >>= 7
-		if x == 0 {
-			break
-		}
-	}
-	return n
-}
-func sozStructured(x uint64) (n int) {
-	return sovStructured(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *Table) Marshal() (data []byte, err error) {
+func (m *ForeignKeyReference) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5229,22 +8237,24 @@ func (m *Table) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *Table) MarshalTo(data []byte) (n int, err error) {
+func (m *ForeignKeyReference) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
+	data[i] = 0x8
 	i++
-	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
-	i += copy(data[i:], m.Name)
+	i = encodeVarintStructured(data, i, uint64(m.TableId))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.IndexId))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *Column) Marshal() (data []byte, err error) {
+func (m *UserPrivileges) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5254,25 +8264,25 @@ func (m *Column) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *Column) MarshalTo(data []byte) (n int, err error) {
+func (m *UserPrivileges) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	data[i] = 0xa
 	i++
-	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
-	i += copy(data[i:], m.Name)
+	i = encodeVarintStructured(data, i, uint64(len(m.User)))
+	i += copy(data[i:], m.User)
 	data[i] = 0x10
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.Type))
+	i = encodeVarintStructured(data, i, uint64(m.Privileges))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *Index) Marshal() (data []byte, err error) {
+func (m *PrivilegeDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5282,30 +8292,30 @@ func (m *Index) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *Index) MarshalTo(data []byte) (n int, err error) {
+func (m *PrivilegeDescriptor) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
-	i++
-	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
-	i += copy(data[i:], m.Name)
-	data[i] = 0x10
-	i++
-	if m.Unique {
-		data[i] = 1
-	} else {
-		data[i] = 0
+	if len(m.Users) > 0 {
+		for _, msg := range m.Users {
+			data[i] = 0xa
+			i++
+			i = encodeVarintStructured(data, i, uint64(msg.Size()))
+			n, err := msg.MarshalTo(data[i:])
+			if err != nil {
+				return 0, err
+			}
+			i += n
+		}
 	}
-	i++
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *TableSchema) Marshal() (data []byte, err error) {
+func (m *TableDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5315,22 +8325,25 @@ func (m *TableSchema) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TableSchema) MarshalTo(data []byte) (n int, err error) {
+func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Id))
+	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Table.Size()))
-	n1, err := m.Table.MarshalTo(data[i:])
+	n6, err := m.Table.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n1
+	i += n6
 	if len(m.Columns) > 0 {
 		for _, msg := range m.Columns {
-			data[i] = 0x12
+			data[i] = 0x1a
 			i++
 			i = encodeVarintStructured(data, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(data[i:])
@@ -5340,9 +8353,12 @@ func (m *TableSchema) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextColumnId))
 	if len(m.Indexes) > 0 {
 		for _, msg := range m.Indexes {
-			data[i] = 0x1a
+			data[i] = 0x2a
 			i++
 			i = encodeVarintStructured(data, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(data[i:])
@@ -5352,13 +8368,115 @@ func (m *TableSchema) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	data[i] = 0x30
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.NextIndexId))
+	data[i] = 0x3a
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.PrimaryIndex.Size()))
+	n7, err := m.PrimaryIndex.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n7
+	data[i] = 0x40
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.FormatVersion))
+	data[i] = 0x48
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ParentId))
+	data[i] = 0x50
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.InterleaveParentId))
+	data[i] = 0x58
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.DropTime))
+	data[i] = 0x60
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Version))
+	data[i] = 0x6a
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Privileges.Size()))
+	n8, err := m.Privileges.MarshalTo(data[i:])
+	if err != nil {
+		return 0, err
+	}
+	i += n8
+	data[i] = 0x70
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ReclaimTime))
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *DatabaseDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *DatabaseDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Id))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *SequenceDescriptor) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *SequenceDescriptor) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Id))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Start))
+	data[i] = 0x20
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Increment))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.CacheSize))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *TableSchema_IndexByName) Marshal() (data []byte, err error) {
+func (m *ViewDescriptor) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5368,41 +8486,39 @@ func (m *TableSchema_IndexByName) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TableSchema_IndexByName) MarshalTo(data []byte) (n int, err error) {
+func (m *ViewDescriptor) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0xa
+	data[i] = 0x8
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.Index.Size()))
-	n2, err := m.Index.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n2
-	if len(m.ColumnNames) > 0 {
-		for _, s := range m.ColumnNames {
-			data[i] = 0x12
-			i++
-			l = len(s)
-			for l >= 1<<7 {
-				data[i] = uint8(uint64(l)&0x7f | 0x80)
-				l >>= 7
-				i++
-			}
-			data[i] = uint8(l)
+	i = encodeVarintStructured(data, i, uint64(m.Id))
+	data[i] = 0x12
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Name)))
+	i += copy(data[i:], m.Name)
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.TableId))
+	if len(m.ColumnIds) > 0 {
+		for _, num := range m.ColumnIds {
+			data[i] = 0x20
 			i++
-			i += copy(data[i:], s)
+			i = encodeVarintStructured(data, i, uint64(num))
 		}
 	}
+	data[i] = 0x2a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Query)))
+	i += copy(data[i:], m.Query)
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *ColumnDescriptor) Marshal() (data []byte, err error) {
+func (m *SchemaJob) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5412,7 +8528,7 @@ func (m *ColumnDescriptor) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *ColumnDescriptor) MarshalTo(data []byte) (n int, err error) {
+func (m *SchemaJob) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
@@ -5420,21 +8536,81 @@ func (m *ColumnDescriptor) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x8
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Id))
-	data[i] = 0x12
+	data[i] = 0x10
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.Column.Size()))
-	n3, err := m.Column.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	i = encodeVarintStructured(data, i, uint64(m.Type))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.TableId))
+	data[i] = 0x22
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Description)))
+	i += copy(data[i:], m.Description)
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Status))
+	data[i] = 0x30
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Progress))
+	data[i] = 0x3a
+	i++
+	i = encodeVarintStructured(data, i, uint64(len(m.Error)))
+	i += copy(data[i:], m.Error)
+	data[i] = 0x40
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.StartTime))
+	data[i] = 0x48
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.FinishTime))
+	data[i] = 0x50
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.IndexId))
+	if m.ResumeKey != nil {
+		data[i] = 0x5a
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(m.ResumeKey)))
+		i += copy(data[i:], m.ResumeKey)
 	}
-	i += n3
+	if m.LeaseOwner != nil {
+		data[i] = 0x62
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(m.LeaseOwner)))
+		i += copy(data[i:], m.LeaseOwner)
+	}
+	data[i] = 0x68
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.LeaseExpiration))
+	data[i] = 0x70
+	i++
+	if m.CancelRequested {
+		data[i] = 1
+	} else {
+		data[i] = 0
+	}
+	i++
+	data[i] = 0x78
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.ColumnId))
+	if m.ColumnValue != nil {
+		data[i] = 0x82
+		i++
+		data[i] = 0x1
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(m.ColumnValue)))
+		i += copy(data[i:], m.ColumnValue)
+	}
+	data[i] = 0x88
+	i++
+	data[i] = 0x1
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.SourceTableId))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *IndexDescriptor) Marshal() (data []byte, err error) {
+func (m *HistogramBucket) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5444,36 +8620,27 @@ func (m *IndexDescriptor) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *IndexDescriptor) MarshalTo(data []byte) (n int, err error) {
+func (m *HistogramBucket) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
-	data[i] = 0x8
-	i++
-	i = encodeVarintStructured(data, i, uint64(m.Id))
-	data[i] = 0x12
-	i++
-	i = encodeVarintStructured(data, i, uint64(m.Index.Size()))
-	n4, err := m.Index.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
-	}
-	i += n4
-	if len(m.ColumnIds) > 0 {
-		for _, num := range m.ColumnIds {
-			data[i] = 0x18
-			i++
-			i = encodeVarintStructured(data, i, uint64(num))
-		}
+	if m.UpperBound != nil {
+		data[i] = 0xa
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(m.UpperBound)))
+		i += copy(data[i:], m.UpperBound)
 	}
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.Count))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
 	return i, nil
 }
 
-func (m *TableDescriptor) Marshal() (data []byte, err error) {
+func (m *ColumnStatistics) Marshal() (data []byte, err error) {
 	size := m.Size()
 	data = make([]byte, size)
 	n, err := m.MarshalTo(data)
@@ -5483,25 +8650,29 @@ func (m *TableDescriptor) Marshal() (data []byte, err error) {
 	return data[:n], nil
 }
 
-func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
+func (m *ColumnStatistics) MarshalTo(data []byte) (n int, err error) {
 	var i int
 	_ = i
 	var l int
 	_ = l
 	data[i] = 0x8
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.Id))
-	data[i] = 0x12
+	i = encodeVarintStructured(data, i, uint64(m.ColumnId))
+	data[i] = 0x10
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.Table.Size()))
-	n5, err := m.Table.MarshalTo(data[i:])
-	if err != nil {
-		return 0, err
+	i = encodeVarintStructured(data, i, uint64(m.NullCount))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.DistinctCount))
+	if m.Sketch != nil {
+		data[i] = 0x22
+		i++
+		i = encodeVarintStructured(data, i, uint64(len(m.Sketch)))
+		i += copy(data[i:], m.Sketch)
 	}
-	i += n5
-	if len(m.Columns) > 0 {
-		for _, msg := range m.Columns {
-			data[i] = 0x1a
+	if len(m.Histogram) > 0 {
+		for _, msg := range m.Histogram {
+			data[i] = 0x2a
 			i++
 			i = encodeVarintStructured(data, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(data[i:])
@@ -5511,12 +8682,45 @@ func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
+	if m.XXX_unrecognized != nil {
+		i += copy(data[i:], m.XXX_unrecognized)
+	}
+	return i, nil
+}
+
+func (m *TableStatistics) Marshal() (data []byte, err error) {
+	size := m.Size()
+	data = make([]byte, size)
+	n, err := m.MarshalTo(data)
+	if err != nil {
+		return nil, err
+	}
+	return data[:n], nil
+}
+
+func (m *TableStatistics) MarshalTo(data []byte) (n int, err error) {
+	var i int
+	_ = i
+	var l int
+	_ = l
+	data[i] = 0x8
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.TableId))
+	data[i] = 0x10
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.TableVersion))
+	data[i] = 0x18
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.RowCount))
 	data[i] = 0x20
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.NextColumnId))
-	if len(m.Indexes) > 0 {
-		for _, msg := range m.Indexes {
-			data[i] = 0x2a
+	i = encodeVarintStructured(data, i, uint64(m.SampleSize))
+	data[i] = 0x28
+	i++
+	i = encodeVarintStructured(data, i, uint64(m.CollectedAt))
+	if len(m.Columns) > 0 {
+		for _, msg := range m.Columns {
+			data[i] = 0x32
 			i++
 			i = encodeVarintStructured(data, i, uint64(msg.Size()))
 			n, err := msg.MarshalTo(data[i:])
@@ -5526,9 +8730,9 @@ func (m *TableDescriptor) MarshalTo(data []byte) (n int, err error) {
 			i += n
 		}
 	}
-	data[i] = 0x30
+	data[i] = 0x38
 	i++
-	i = encodeVarintStructured(data, i, uint64(m.NextIndexId))
+	i = encodeVarintStructured(data, i, uint64(m.DataSize))
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5553,19 +8757,19 @@ func (m *CreateTableRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.RequestHeader.Size()))
-	n6, err := m.RequestHeader.MarshalTo(data[i:])
+	n9, err := m.RequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n6
+	i += n9
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Schema.Size()))
-	n7, err := m.Schema.MarshalTo(data[i:])
+	n10, err := m.Schema.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n7
+	i += n10
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5590,11 +8794,11 @@ func (m *CreateTableResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Error.Size()))
-	n8, err := m.Error.MarshalTo(data[i:])
+	n11, err := m.Error.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n8
+	i += n11
 	data[i] = 0x10
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableId))
@@ -5630,19 +8834,19 @@ func (m *TableRequestHeader) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0x1a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Timestamp.Size()))
-	n9, err := m.Timestamp.MarshalTo(data[i:])
+	n12, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n9
+	i += n12
 	data[i] = 0x22
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.CmdID.Size()))
-	n10, err := m.CmdID.MarshalTo(data[i:])
+	n13, err := m.CmdID.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n10
+	i += n13
 	data[i] = 0x2a
 	i++
 	i = encodeVarintStructured(data, i, uint64(len(m.User)))
@@ -5656,11 +8860,11 @@ func (m *TableRequestHeader) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Txn.Size()))
-		n11, err := m.Txn.MarshalTo(data[i:])
+		n14, err := m.Txn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n11
+		i += n14
 	}
 	data[i] = 0x40
 	i++
@@ -5690,29 +8894,29 @@ func (m *TableResponseHeader) MarshalTo(data []byte) (n int, err error) {
 		data[i] = 0xa
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Error.Size()))
-		n12, err := m.Error.MarshalTo(data[i:])
+		n15, err := m.Error.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n12
+		i += n15
 	}
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Timestamp.Size()))
-	n13, err := m.Timestamp.MarshalTo(data[i:])
+	n16, err := m.Timestamp.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n13
+	i += n16
 	if m.Txn != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Txn.Size()))
-		n14, err := m.Txn.MarshalTo(data[i:])
+		n17, err := m.Txn.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n14
+		i += n17
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -5778,11 +8982,11 @@ func (m *ColumnCollection_ColumnNameValue) MarshalTo(data []byte) (n int, err er
 		data[i] = 0x12
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Value.Size()))
-		n15, err := m.Value.MarshalTo(data[i:])
+		n18, err := m.Value.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n15
+		i += n18
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -5841,19 +9045,19 @@ func (m *GetTableRowRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n16, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n19, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n16
+	i += n19
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Key.Size()))
-	n17, err := m.Key.MarshalTo(data[i:])
+	n20, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n17
+	i += n20
 	if len(m.Columns) > 0 {
 		for _, s := range m.Columns {
 			data[i] = 0x1a
@@ -5893,19 +9097,19 @@ func (m *GetTableRowResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n18, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n21, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n18
+	i += n21
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Row.Size()))
-	n19, err := m.Row.MarshalTo(data[i:])
+	n22, err := m.Row.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n19
+	i += n22
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5930,19 +9134,19 @@ func (m *PutTableRowRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n20, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n23, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n20
+	i += n23
 	data[i] = 0x1a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Row.Size()))
-	n21, err := m.Row.MarshalTo(data[i:])
+	n24, err := m.Row.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n21
+	i += n24
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5967,11 +9171,11 @@ func (m *PutTableRowResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n22, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n25, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n22
+	i += n25
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -5996,35 +9200,35 @@ func (m *ConditionalPutTableRowRequest) MarshalTo(data []byte) (n int, err error
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n23, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n26, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n23
+	i += n26
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Key.Size()))
-	n24, err := m.Key.MarshalTo(data[i:])
+	n27, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n24
+	i += n27
 	data[i] = 0x1a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.ExpRow.Size()))
-	n25, err := m.ExpRow.MarshalTo(data[i:])
+	n28, err := m.ExpRow.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n25
+	i += n28
 	data[i] = 0x22
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Row.Size()))
-	n26, err := m.Row.MarshalTo(data[i:])
+	n29, err := m.Row.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n26
+	i += n29
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6049,19 +9253,19 @@ func (m *ConditionalPutTableRowResponse) MarshalTo(data []byte) (n int, err erro
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n27, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n30, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n27
+	i += n30
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Row.Size()))
-	n28, err := m.Row.MarshalTo(data[i:])
+	n31, err := m.Row.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n28
+	i += n31
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6086,19 +9290,19 @@ func (m *IncrementTableRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n29, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n32, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n29
+	i += n32
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Row.Size()))
-	n30, err := m.Row.MarshalTo(data[i:])
+	n33, err := m.Row.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n30
+	i += n33
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6123,19 +9327,19 @@ func (m *IncrementTableResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n31, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n34, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n31
+	i += n34
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Row.Size()))
-	n32, err := m.Row.MarshalTo(data[i:])
+	n35, err := m.Row.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n32
+	i += n35
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6160,19 +9364,19 @@ func (m *DeleteTableRowRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n33, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n36, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n33
+	i += n36
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Key.Size()))
-	n34, err := m.Key.MarshalTo(data[i:])
+	n37, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n34
+	i += n37
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6197,11 +9401,11 @@ func (m *DeleteTableRowResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n35, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n38, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n35
+	i += n38
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
 	}
@@ -6226,27 +9430,27 @@ func (m *DeleteTableRowRangeRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n36, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n39, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n36
+	i += n39
 	data[i] = 0x12
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Key.Size()))
-	n37, err := m.Key.MarshalTo(data[i:])
+	n40, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n37
+	i += n40
 	data[i] = 0x1a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.EndKey.Size()))
-	n38, err := m.EndKey.MarshalTo(data[i:])
+	n41, err := m.EndKey.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n38
+	i += n41
 	data[i] = 0x20
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.MaxEntriesToDelete))
@@ -6274,11 +9478,11 @@ func (m *DeleteTableRowRangeResponse) MarshalTo(data []byte) (n int, err error)
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n39, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n42, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n39
+	i += n42
 	data[i] = 0x10
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.NumDeleted))
@@ -6306,27 +9510,27 @@ func (m *ScanTableRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n40, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n43, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n40
+	i += n43
 	data[i] = 0x1a
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.Key.Size()))
-	n41, err := m.Key.MarshalTo(data[i:])
+	n44, err := m.Key.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n41
+	i += n44
 	data[i] = 0x22
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.EndKey.Size()))
-	n42, err := m.EndKey.MarshalTo(data[i:])
+	n45, err := m.EndKey.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n42
+	i += n45
 	data[i] = 0x28
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.MaxResults))
@@ -6369,11 +9573,11 @@ func (m *ScanTableResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n43, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n46, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n43
+	i += n46
 	if len(m.Rows) > 0 {
 		for _, msg := range m.Rows {
 			data[i] = 0x12
@@ -6410,11 +9614,11 @@ func (m *BatchTableRequest) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableRequestHeader.Size()))
-	n44, err := m.TableRequestHeader.MarshalTo(data[i:])
+	n47, err := m.TableRequestHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n44
+	i += n47
 	if len(m.Requests) > 0 {
 		for _, msg := range m.Requests {
 			data[i] = 0x12
@@ -6452,71 +9656,71 @@ func (m *BatchTableRequest_TableRequestUnion) MarshalTo(data []byte) (n int, err
 		data[i] = 0x12
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Get.Size()))
-		n45, err := m.Get.MarshalTo(data[i:])
+		n48, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n45
+		i += n48
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Put.Size()))
-		n46, err := m.Put.MarshalTo(data[i:])
+		n49, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n46
+		i += n49
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.ConditionalPut.Size()))
-		n47, err := m.ConditionalPut.MarshalTo(data[i:])
+		n50, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n47
+		i += n50
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Delete.Size()))
-		n48, err := m.Delete.MarshalTo(data[i:])
+		n51, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n48
+		i += n51
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.DeleteRange.Size()))
-		n49, err := m.DeleteRange.MarshalTo(data[i:])
+		n52, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n49
+		i += n52
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Scan.Size()))
-		n50, err := m.Scan.MarshalTo(data[i:])
+		n53, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n50
+		i += n53
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.EndTransaction.Size()))
-		n51, err := m.EndTransaction.MarshalTo(data[i:])
+		n54, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n51
+		i += n54
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
@@ -6542,11 +9746,11 @@ func (m *BatchTableResponse) MarshalTo(data []byte) (n int, err error) {
 	data[i] = 0xa
 	i++
 	i = encodeVarintStructured(data, i, uint64(m.TableResponseHeader.Size()))
-	n52, err := m.TableResponseHeader.MarshalTo(data[i:])
+	n55, err := m.TableResponseHeader.MarshalTo(data[i:])
 	if err != nil {
 		return 0, err
 	}
-	i += n52
+	i += n55
 	if len(m.Responses) > 0 {
 		for _, msg := range m.Responses {
 			data[i] = 0x12
@@ -6584,71 +9788,71 @@ func (m *BatchTableResponse_TableResponseUnion) MarshalTo(data []byte) (n int, e
 		data[i] = 0x12
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Get.Size()))
-		n53, err := m.Get.MarshalTo(data[i:])
+		n56, err := m.Get.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n53
+		i += n56
 	}
 	if m.Put != nil {
 		data[i] = 0x1a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Put.Size()))
-		n54, err := m.Put.MarshalTo(data[i:])
+		n57, err := m.Put.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n54
+		i += n57
 	}
 	if m.ConditionalPut != nil {
 		data[i] = 0x22
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.ConditionalPut.Size()))
-		n55, err := m.ConditionalPut.MarshalTo(data[i:])
+		n58, err := m.ConditionalPut.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n55
+		i += n58
 	}
 	if m.Delete != nil {
 		data[i] = 0x32
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Delete.Size()))
-		n56, err := m.Delete.MarshalTo(data[i:])
+		n59, err := m.Delete.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n56
+		i += n59
 	}
 	if m.DeleteRange != nil {
 		data[i] = 0x3a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.DeleteRange.Size()))
-		n57, err := m.DeleteRange.MarshalTo(data[i:])
+		n60, err := m.DeleteRange.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n57
+		i += n60
 	}
 	if m.Scan != nil {
 		data[i] = 0x42
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.Scan.Size()))
-		n58, err := m.Scan.MarshalTo(data[i:])
+		n61, err := m.Scan.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n58
+		i += n61
 	}
 	if m.EndTransaction != nil {
 		data[i] = 0x4a
 		i++
 		i = encodeVarintStructured(data, i, uint64(m.EndTransaction.Size()))
-		n59, err := m.EndTransaction.MarshalTo(data[i:])
+		n62, err := m.EndTransaction.MarshalTo(data[i:])
 		if err != nil {
 			return 0, err
 		}
-		i += n59
+		i += n62
 	}
 	if m.XXX_unrecognized != nil {
 		i += copy(data[i:], m.XXX_unrecognized)
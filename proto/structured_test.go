@@ -0,0 +1,604 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+func TestValidateSequenceDesc(t *testing.T) {
+	testData := []struct {
+		err  string
+		desc SequenceDescriptor
+	}{
+		{"empty sequence name",
+			SequenceDescriptor{}},
+		{"invalid sequence ID 0",
+			SequenceDescriptor{Name: "foo"}},
+		{`sequence "foo": increment must not be zero`,
+			SequenceDescriptor{Id: 1, Name: "foo"}},
+		{`sequence "foo": invalid cache size -1`,
+			SequenceDescriptor{Id: 1, Name: "foo", Increment: 1, CacheSize: -1}},
+		{"",
+			SequenceDescriptor{Id: 1, Name: "foo", Increment: -1}},
+	}
+	for i, d := range testData {
+		err := ValidateSequenceDesc(d.desc)
+		if d.err == "" {
+			if err != nil {
+				t.Errorf("%d: expected success, but found %s", i, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestSequenceDescValue(t *testing.T) {
+	desc := SequenceDescriptor{Start: 10, Increment: -2}
+	for n, expected := range []int64{10, 8, 6} {
+		if v := desc.Value(int64(n)); v != expected {
+			t.Errorf("%d: expected %d, but found %d", n, expected, v)
+		}
+	}
+}
+
+func TestValidateViewDesc(t *testing.T) {
+	table := TableDescriptor{
+		Id:    2,
+		Table: Table{Name: "foo"},
+		Columns: []ColumnDescriptor{
+			{Id: 1, Column: Column{Name: "bar"}},
+			{Id: 2, Column: Column{Name: "baz"}},
+		},
+	}
+	testData := []struct {
+		err  string
+		desc ViewDescriptor
+	}{
+		{"empty view name",
+			ViewDescriptor{}},
+		{"invalid view ID 0",
+			ViewDescriptor{Name: "v"}},
+		{`view "v": references table 3, not 2`,
+			ViewDescriptor{Id: 3, Name: "v", TableId: 3}},
+		{`view "v": must contain at least 1 column`,
+			ViewDescriptor{Id: 3, Name: "v", TableId: 2}},
+		{`view "v": column 3 does not exist in table "foo"`,
+			ViewDescriptor{Id: 3, Name: "v", TableId: 2, ColumnIds: []uint32{1, 3}}},
+		{`view "v": duplicate column 2`,
+			ViewDescriptor{Id: 3, Name: "v", TableId: 2, ColumnIds: []uint32{2, 2}}},
+		{"",
+			ViewDescriptor{Id: 3, Name: "v", TableId: 2, ColumnIds: []uint32{2, 1}}},
+	}
+	for i, d := range testData {
+		err := ValidateViewDesc(d.desc, table)
+		if d.err == "" {
+			if err != nil {
+				t.Errorf("%d: expected success, but found %s", i, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestValidateTableDesc(t *testing.T) {
+	testData := []struct {
+		err  string
+		desc TableDescriptor
+	}{
+		{"empty table name; invalid table ID 0; invalid parent ID 0; table must contain at least 1 column; table must contain a primary key",
+			TableDescriptor{}},
+		{"invalid table ID 0; invalid parent ID 0; table must contain at least 1 column; table must contain a primary key",
+			TableDescriptor{Table: Table{Name: "foo"}}},
+		{"invalid parent ID 0; table must contain at least 1 column; table must contain a primary key",
+			TableDescriptor{Id: 1, Table: Table{Name: "foo"}}},
+		{"table must contain at least 1 column; table must contain a primary key",
+			TableDescriptor{Id: 1, ParentId: 1, Table: Table{Name: "foo"}}},
+		{"empty column name; table must contain a primary key",
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1},
+				},
+				NextColumnId: 2,
+			}},
+		{"invalid column ID 0; table must contain a primary key",
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 0, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+			}},
+		{"table must contain a primary key",
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+			}},
+		{`duplicate column name: "bar"; column "bar" duplicate ID of column "bar": 1; table must contain a primary key`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+			}},
+		{`column "blah" duplicate ID of column "bar": 1; table must contain a primary key`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+					{Id: 1, Column: Column{Name: "blah"}},
+				},
+				NextColumnId: 2,
+			}},
+		{`column "blah" invalid ID (2) >= next column ID (2); table must contain a primary key`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+					{Id: 2, Column: Column{Name: "blah"}},
+				},
+				NextColumnId: 2,
+			}},
+		{`duplicate index name: "bar"`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar"}, ColumnIds: []uint32{1}},
+				Indexes: []IndexDescriptor{
+					{Id: 2, Index: Index{Name: "bar"}, ColumnIds: []uint32{1}},
+				},
+				NextIndexId: 3,
+			}},
+		{`index "blah" duplicate ID of index "bar": 1`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar"}, ColumnIds: []uint32{1}},
+				Indexes: []IndexDescriptor{
+					{Id: 1, Index: Index{Name: "blah"}, ColumnIds: []uint32{1}},
+				},
+				NextIndexId: 2,
+			}},
+		{`index "bar" invalid ID (2) >= next index ID (2)`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 2, Index: Index{Name: "bar"}, ColumnIds: []uint32{1}},
+				NextIndexId:  2,
+			}},
+		{`index "blah" must contain at least 1 column`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar"}, ColumnIds: []uint32{1}},
+				Indexes: []IndexDescriptor{
+					{Id: 2, Index: Index{Name: "blah"}},
+				},
+				NextIndexId: 3,
+			}},
+		{`index "bar" contains unknown column ID 2`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar"}, ColumnIds: []uint32{2}},
+				NextIndexId:  2,
+			}},
+		{`index "bar" contains column "doc" of unindexable type JSON`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+					{Id: 2, Column: Column{Name: "doc", Type: Column_JSON}},
+				},
+				NextColumnId: 3,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar"}, ColumnIds: []uint32{1, 2}},
+				NextIndexId:  2,
+			}},
+		{`column "lower" invalid compute expression: unknown function "foo"; ` +
+			`column "sum" compute expression references unknown column "baz"; ` +
+			`column "twice" compute expression references computed column "sum"`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+					{Id: 2, Column: Column{Name: "lower", ComputeExpr: "foo(bar)"}},
+					{Id: 3, Column: Column{Name: "sum", ComputeExpr: "bar + baz"}},
+					{Id: 4, Column: Column{Name: "twice", ComputeExpr: "sum * 2"}},
+				},
+				NextColumnId: 5,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar"}, ColumnIds: []uint32{1}},
+				NextIndexId:  2,
+			}},
+		{`primary index "primary" cannot contain expressions`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "primary"}, KeyExprs: []string{"lower(bar)"}},
+				NextIndexId:  2,
+			}},
+		{`index "both" cannot contain both columns and expressions; ` +
+			`index "bad" invalid expression: unexpected end of expression "lower("; ` +
+			`index "unknown" expression "lower(baz)" references unknown column "baz"; ` +
+			`index "doc" expression "length(doc)" references column "doc" of unindexable type JSON`,
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar"}},
+					{Id: 2, Column: Column{Name: "doc", Type: Column_JSON}},
+				},
+				NextColumnId: 3,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "primary"}, ColumnIds: []uint32{1}},
+				Indexes: []IndexDescriptor{
+					{Id: 2, Index: Index{Name: "both"}, ColumnIds: []uint32{1}, KeyExprs: []string{"bar"}},
+					{Id: 3, Index: Index{Name: "bad"}, KeyExprs: []string{"lower("}},
+					{Id: 4, Index: Index{Name: "unknown"}, KeyExprs: []string{"lower(baz)"}},
+					{Id: 5, Index: Index{Name: "doc"}, KeyExprs: []string{"length(doc)"}},
+				},
+				NextIndexId: 6,
+			}},
+		{"",
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar", Type: Column_STRING}},
+				},
+				NextColumnId: 2,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "primary"}, ColumnIds: []uint32{1}},
+				Indexes: []IndexDescriptor{
+					{Id: 2, Index: Index{Name: "lower"}, KeyExprs: []string{"lower(bar)", "bar"}},
+				},
+				NextIndexId: 3,
+			}},
+		{"",
+			TableDescriptor{
+				Id:       1,
+				ParentId: 1,
+				Table:    Table{Name: "foo"},
+				Columns: []ColumnDescriptor{
+					{Id: 1, Column: Column{Name: "bar", Type: Column_INT}},
+					{Id: 2, Column: Column{Name: "doc", Type: Column_JSON}},
+				},
+				NextColumnId: 3,
+				PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "bar", Unique: true}, ColumnIds: []uint32{1}},
+				NextIndexId:  2,
+			}},
+	}
+	for i, d := range testData {
+		err := ValidateTableDesc(d.desc)
+		if d.err == "" {
+			if err != nil {
+				t.Errorf("%d: expected success, but found %s", i, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestValidateTableDescAggregatesErrors(t *testing.T) {
+	desc := TableDescriptor{
+		Id:       1,
+		ParentId: 1,
+		Table:    Table{Name: "foo"},
+		Columns: []ColumnDescriptor{
+			{Id: 1, Column: Column{Name: "bar"}},
+			{Id: 2, Column: Column{Name: "doc", Type: Column_JSON}},
+		},
+		NextColumnId: 3,
+		PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "primary"}, ColumnIds: []uint32{1, 3}},
+		Indexes: []IndexDescriptor{
+			{Id: 2, Index: Index{Name: "doc"}, ColumnIds: []uint32{2}},
+		},
+		NextIndexId: 2,
+	}
+	err := ValidateTableDesc(desc)
+	errs, ok := err.(util.Errors)
+	if !ok {
+		t.Fatalf("expected util.Errors, but found %T: %v", err, err)
+	}
+	expected := []string{
+		`index "primary" contains unknown column ID 3`,
+		`index "doc" invalid ID (2) >= next index ID (2)`,
+		`index "doc" contains column "doc" of unindexable type JSON`,
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, but found %d: %v", len(expected), len(errs), errs)
+	}
+	for i, e := range expected {
+		if errs[i].Error() != e {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, e, errs[i])
+		}
+	}
+}
+
+func TestMaybeFixDescriptor(t *testing.T) {
+	testData := []struct {
+		desc         TableDescriptor
+		expected     []string
+		nextColumnID uint32
+		nextIndexID  uint32
+	}{
+		{TableDescriptor{}, []string{"next column ID: 0 -> 1", "next index ID: 0 -> 1"}, 1, 1},
+		{TableDescriptor{NextColumnId: 3, NextIndexId: 2}, nil, 3, 2},
+		{TableDescriptor{
+			Columns:      []ColumnDescriptor{{Id: 1}, {Id: 4}},
+			NextColumnId: 2,
+			Indexes:      []IndexDescriptor{{Id: 1}},
+			NextIndexId:  2,
+		}, []string{"next column ID: 2 -> 5"}, 5, 2},
+		{TableDescriptor{
+			Columns:      []ColumnDescriptor{{Id: 1}},
+			NextColumnId: 7,
+			Indexes:      []IndexDescriptor{{Id: 1}, {Id: 2}},
+			NextIndexId:  0,
+		}, []string{"next index ID: 0 -> 3"}, 7, 3},
+		{TableDescriptor{
+			Columns: []ColumnDescriptor{{Id: 2}},
+			Indexes: []IndexDescriptor{{Id: 1}},
+		}, []string{"next column ID: 0 -> 3", "next index ID: 0 -> 2"}, 3, 2},
+	}
+	for i, d := range testData {
+		changes := MaybeFixDescriptor(&d.desc)
+		if !reflect.DeepEqual(d.expected, changes) {
+			t.Errorf("%d: expected %q, but found %q", i, d.expected, changes)
+		}
+		if d.desc.NextColumnId != d.nextColumnID || d.desc.NextIndexId != d.nextIndexID {
+			t.Errorf("%d: expected next IDs %d/%d, but found %d/%d", i, d.nextColumnID,
+				d.nextIndexID, d.desc.NextColumnId, d.desc.NextIndexId)
+		}
+		if len(changes) > 0 {
+			if again := MaybeFixDescriptor(&d.desc); again != nil {
+				t.Errorf("%d: expected fixed descriptor to be stable, but found %q", i, again)
+			}
+		}
+	}
+}
+
+func TestMaybeUpgradeTableDescriptor(t *testing.T) {
+	columns := []ColumnDescriptor{
+		{Id: 1, Column: Column{Name: "a"}},
+		{Id: 2, Column: Column{Name: "b"}},
+	}
+	expected := TableDescriptor{
+		Id:            1,
+		ParentId:      1,
+		Table:         Table{Name: "foo"},
+		Columns:       columns,
+		NextColumnId:  3,
+		PrimaryIndex:  IndexDescriptor{Id: 1, Index: Index{Name: "primary"}, ColumnIds: []uint32{1}},
+		Indexes:       []IndexDescriptor{{Id: 2, Index: Index{Name: "b"}, ColumnIds: []uint32{2, 1}}},
+		NextIndexId:   3,
+		FormatVersion: CurrentFormatVersion,
+	}
+
+	testData := []TableDescriptor{
+		// BASE: index columns referenced by name, primary key first.
+		{
+			Id:           1,
+			ParentId:     1,
+			Table:        Table{Name: "foo"},
+			Columns:      columns,
+			NextColumnId: 3,
+			Indexes: []IndexDescriptor{
+				{Id: 1, Index: Index{Name: "primary"}, ColumnNames: []string{"a"}},
+				{Id: 2, Index: Index{Name: "b"}, ColumnNames: []string{"b", "a"}},
+			},
+			NextIndexId: 3,
+		},
+		// COLUMN_IDS: primary key first.
+		{
+			Id:           1,
+			ParentId:     1,
+			Table:        Table{Name: "foo"},
+			Columns:      columns,
+			NextColumnId: 3,
+			Indexes: []IndexDescriptor{
+				{Id: 1, Index: Index{Name: "primary"}, ColumnIds: []uint32{1}},
+				{Id: 2, Index: Index{Name: "b"}, ColumnIds: []uint32{2, 1}},
+			},
+			NextIndexId:   3,
+			FormatVersion: TableDescriptor_COLUMN_IDS,
+		},
+	}
+	for i, desc := range testData {
+		upgraded, err := MaybeUpgradeTableDescriptor(&desc)
+		if err != nil {
+			t.Fatalf("%d: unexpected error: %s", i, err)
+		}
+		if !upgraded {
+			t.Errorf("%d: expected descriptor to be upgraded", i)
+		}
+		if !reflect.DeepEqual(expected, desc) {
+			t.Errorf("%d: expected %+v, but found %+v", i, expected, desc)
+		}
+		if err := ValidateTableDesc(desc); err != nil {
+			t.Errorf("%d: upgraded descriptor failed validation: %s", i, err)
+		}
+		if upgraded, err := MaybeUpgradeTableDescriptor(&desc); upgraded || err != nil {
+			t.Errorf("%d: expected no-op upgrade, but found %t, %v", i, upgraded, err)
+		}
+	}
+
+	bad := TableDescriptor{
+		Table:   Table{Name: "foo"},
+		Columns: columns,
+		Indexes: []IndexDescriptor{{Index: Index{Name: "primary"}, ColumnNames: []string{"c"}}},
+	}
+	if _, err := MaybeUpgradeTableDescriptor(&bad); err == nil ||
+		err.Error() != `table "foo": index "primary" contains unknown column "c"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	future := TableDescriptor{Table: Table{Name: "foo"}, FormatVersion: CurrentFormatVersion + 1}
+	if _, err := MaybeUpgradeTableDescriptor(&future); err == nil {
+		t.Errorf("expected error upgrading descriptor from the future")
+	}
+}
+
+func TestValidateDatabaseDesc(t *testing.T) {
+	testData := []struct {
+		err  string
+		desc DatabaseDescriptor
+	}{
+		{"empty database name",
+			DatabaseDescriptor{}},
+		{"invalid database ID 0",
+			DatabaseDescriptor{Name: "foo"}},
+		{"",
+			DatabaseDescriptor{Id: 1, Name: "foo"}},
+	}
+	for i, d := range testData {
+		err := ValidateDatabaseDesc(d.desc)
+		if d.err == "" {
+			if err != nil {
+				t.Errorf("%d: expected success, but found %s", i, err)
+			}
+			continue
+		}
+		if err == nil || err.Error() != d.err {
+			t.Errorf("%d: expected \"%s\", but found \"%v\"", i, d.err, err)
+		}
+	}
+}
+
+func TestValidateSchemaSet(t *testing.T) {
+	parent := NewTableBuilder("parent").ID(2).ParentID(1).
+		Column("id", Column_INT).
+		Column("name", Column_STRING).
+		PrimaryKey("id").
+		Index("by_name", "name").
+		MustBuild()
+	child := NewTableBuilder("child").ID(3).ParentID(1).
+		Column("parent_id", Column_INT).
+		Column("id", Column_INT).
+		PrimaryKey("parent_id", "id").
+		MustBuild()
+	child.InterleaveParentId = parent.Id
+	child.PrimaryIndex.ForeignKey = &ForeignKeyReference{TableId: parent.Id, IndexId: parent.PrimaryIndex.Id}
+
+	if err := ValidateSchemaSet([]TableDescriptor{parent, child}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A table with the same name in another database is fine.
+	other := parent
+	other.Id, other.ParentId = 4, 5
+	if err := ValidateSchemaSet([]TableDescriptor{parent, child, other}); err != nil {
+		t.Fatal(err)
+	}
+
+	dup := parent
+	dup.Id = child.Id
+	badChild := child
+	badChild.Id = 6
+	badChild.Name = "bad_child"
+	badChild.InterleaveParentId = 7
+	badChild.PrimaryIndex.ForeignKey = &ForeignKeyReference{TableId: 7, IndexId: 1}
+	badChild.Indexes = []IndexDescriptor{
+		{Id: 2, Index: Index{Name: "fk_index"}, ColumnIds: []uint32{1},
+			ForeignKey: &ForeignKeyReference{TableId: parent.Id, IndexId: 9}},
+		{Id: 3, Index: Index{Name: "fk_non_unique"}, ColumnIds: []uint32{1},
+			ForeignKey: &ForeignKeyReference{TableId: parent.Id, IndexId: 2}},
+	}
+	badChild.NextIndexId = 4
+	self := child
+	self.Id = 8
+	self.Name = "self"
+	self.InterleaveParentId = 8
+	invalid := parent
+	invalid.Id = 9
+	invalid.Name = ""
+
+	err := ValidateSchemaSet([]TableDescriptor{parent, child, dup, badChild, self, invalid})
+	expected := `table "parent" duplicate ID of table "child": 3; ` +
+		`duplicate table name "parent" in database 1; ` +
+		`table "": empty table name; ` +
+		`table "bad_child": interleave parent 7 does not exist; ` +
+		`table "bad_child": index "primary" foreign key references unknown table 7; ` +
+		`table "bad_child": index "fk_index" foreign key references unknown index 9 of table "parent"; ` +
+		`table "bad_child": index "fk_non_unique" foreign key references non-unique index "by_name" of table "parent"; ` +
+		`table "self": cannot be interleaved with itself`
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected \"%s\", but found \"%v\"", expected, err)
+	}
+}
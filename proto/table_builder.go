@@ -0,0 +1,221 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"fmt"
+
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// PrimaryIndexName is the name given to the primary index of tables.
+const PrimaryIndexName = "primary"
+
+// A TableBuilder assembles a TableDescriptor column by column and index by
+// index, assigning column and index IDs as it goes:
+//
+//	desc, err := NewTableBuilder("users").ID(51).ParentID(50).
+//	  Column("id", Column_INT).
+//	  Column("name", Column_STRING).
+//	  PrimaryKey("id").
+//	  Index("by_name", "name").
+//	  Build()
+//
+// Errors, such as references to unknown columns, are accumulated and
+// returned by Build together with any descriptor validation errors.
+type TableBuilder struct {
+	desc TableDescriptor
+	errs util.Errors
+}
+
+// NewTableBuilder returns a builder for a table with the given name.
+func NewTableBuilder(name string) *TableBuilder {
+	return &TableBuilder{
+		desc: TableDescriptor{
+			Table:         Table{Name: name},
+			NextColumnId:  1,
+			NextIndexId:   1,
+			FormatVersion: CurrentFormatVersion,
+		},
+	}
+}
+
+// ID sets the ID of the table.
+func (b *TableBuilder) ID(id uint32) *TableBuilder {
+	b.desc.Id = id
+	return b
+}
+
+// ParentID sets the ID of the database containing the table.
+func (b *TableBuilder) ParentID(id uint32) *TableBuilder {
+	b.desc.ParentId = id
+	return b
+}
+
+// Column adds a column of the given type.
+func (b *TableBuilder) Column(name string, typ Column_ColumnType) *TableBuilder {
+	return b.addColumn(Column{Name: name, Type: typ})
+}
+
+// ComputedColumn adds a column of the given type whose value is computed
+// from the expression.
+func (b *TableBuilder) ComputedColumn(name string, typ Column_ColumnType, expr string) *TableBuilder {
+	return b.addColumn(Column{Name: name, Type: typ, ComputeExpr: expr})
+}
+
+func (b *TableBuilder) addColumn(column Column) *TableBuilder {
+	b.desc.Columns = append(b.desc.Columns, ColumnDescriptor{
+		Id:     b.desc.NextColumnId,
+		Column: column,
+	})
+	b.desc.NextColumnId++
+	return b
+}
+
+// PrimaryKey sets the primary key of the table to the named columns, which
+// must have been added previously.
+func (b *TableBuilder) PrimaryKey(columns ...string) *TableBuilder {
+	if b.desc.PrimaryIndex.Id != 0 {
+		b.errs = append(b.errs, fmt.Errorf("table %q: duplicate primary key", b.desc.Name))
+		return b
+	}
+	b.desc.PrimaryIndex = b.newIndex(Index{Name: PrimaryIndexName, Unique: true}, columns)
+	return b
+}
+
+// Index adds a secondary index over the named columns, which must have been
+// added previously.
+func (b *TableBuilder) Index(name string, columns ...string) *TableBuilder {
+	b.desc.Indexes = append(b.desc.Indexes, b.newIndex(Index{Name: name}, columns))
+	return b
+}
+
+// UniqueIndex adds a unique secondary index over the named columns, which
+// must have been added previously.
+func (b *TableBuilder) UniqueIndex(name string, columns ...string) *TableBuilder {
+	b.desc.Indexes = append(b.desc.Indexes, b.newIndex(Index{Name: name, Unique: true}, columns))
+	return b
+}
+
+// ExprIndex adds a secondary index over the given expressions.
+func (b *TableBuilder) ExprIndex(name string, exprs ...string) *TableBuilder {
+	index := b.newIndex(Index{Name: name}, nil)
+	index.KeyExprs = exprs
+	b.desc.Indexes = append(b.desc.Indexes, index)
+	return b
+}
+
+func (b *TableBuilder) newIndex(index Index, columns []string) IndexDescriptor {
+	desc := IndexDescriptor{
+		Id:    b.desc.NextIndexId,
+		Index: index,
+	}
+	b.desc.NextIndexId++
+	for _, name := range columns {
+		id, ok := b.columnID(name)
+		if !ok {
+			b.errs = append(b.errs, fmt.Errorf("table %q: index %q references unknown column %q",
+				b.desc.Name, index.Name, name))
+			continue
+		}
+		desc.ColumnIds = append(desc.ColumnIds, id)
+	}
+	return desc
+}
+
+func (b *TableBuilder) columnID(name string) (uint32, bool) {
+	for _, column := range b.desc.Columns {
+		if column.Name == name {
+			return column.Id, true
+		}
+	}
+	return 0, false
+}
+
+// Build returns the assembled descriptor after validating it.
+func (b *TableBuilder) Build() (TableDescriptor, error) {
+	errs := append(util.Errors(nil), b.errs...)
+	if err := ValidateTableDesc(b.desc); err != nil {
+		errs = append(errs, err.(util.Errors)...)
+	}
+	if len(errs) > 0 {
+		return TableDescriptor{}, errs
+	}
+	return b.desc, nil
+}
+
+// MustBuild is like Build but panics if the descriptor is invalid. It is
+// intended for tests and static schema definitions.
+func (b *TableBuilder) MustBuild() TableDescriptor {
+	desc, err := b.Build()
+	if err != nil {
+		panic(err)
+	}
+	return desc
+}
+
+// TableDescFromSchema converts a schema into a table descriptor, assigning
+// column and index IDs in the order the columns and indexes appear in the
+// schema. The first index of the schema becomes the primary index. The
+// table and parent IDs of the returned descriptor are left unset and the
+// descriptor has not been validated.
+func TableDescFromSchema(schema TableSchema) (TableDescriptor, error) {
+	b := NewTableBuilder(schema.Name)
+	b.desc.Table = schema.Table
+	for _, column := range schema.Columns {
+		b.addColumn(column)
+	}
+	for i, index := range schema.Indexes {
+		desc := b.newIndex(index.Index, index.ColumnNames)
+		desc.KeyExprs = index.KeyExprs
+		if i == 0 {
+			b.desc.PrimaryIndex = desc
+		} else {
+			b.desc.Indexes = append(b.desc.Indexes, desc)
+		}
+	}
+	if len(b.errs) > 0 {
+		return TableDescriptor{}, b.errs
+	}
+	return b.desc, nil
+}
+
+// TableSchemaFromDesc converts a table descriptor into a schema. The
+// primary index becomes the first index of the schema and column IDs are
+// replaced by column names. Other IDs are dropped.
+func TableSchemaFromDesc(desc TableDescriptor) TableSchema {
+	schema := TableSchema{
+		Table:   desc.Table,
+		Columns: make([]Column, 0, len(desc.Columns)),
+		Indexes: make([]TableSchema_IndexByName, 0, 1+len(desc.Indexes)),
+	}
+	columnNames := make(map[uint32]string, len(desc.Columns))
+	for _, column := range desc.Columns {
+		schema.Columns = append(schema.Columns, column.Column)
+		columnNames[column.Id] = column.Name
+	}
+	for _, index := range desc.AllIndexes() {
+		byName := TableSchema_IndexByName{
+			Index:    index.Index,
+			KeyExprs: index.KeyExprs,
+		}
+		for _, id := range index.ColumnIds {
+			byName.ColumnNames = append(byName.ColumnNames, columnNames[id])
+		}
+		schema.Indexes = append(schema.Indexes, byName)
+	}
+	return schema
+}
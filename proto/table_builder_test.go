@@ -0,0 +1,154 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package proto
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTableBuilder(t *testing.T) {
+	desc, err := NewTableBuilder("users").ID(2).ParentID(1).
+		Column("id", Column_INT).
+		Column("name", Column_STRING).
+		ComputedColumn("lower_name", Column_STRING, "lower(name)").
+		PrimaryKey("id").
+		Index("by_name", "name").
+		UniqueIndex("by_lower_name", "lower_name", "id").
+		ExprIndex("by_upper_name", "upper(name)").
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := TableDescriptor{
+		Id:       2,
+		ParentId: 1,
+		Table:    Table{Name: "users"},
+		Columns: []ColumnDescriptor{
+			{Id: 1, Column: Column{Name: "id", Type: Column_INT}},
+			{Id: 2, Column: Column{Name: "name", Type: Column_STRING}},
+			{Id: 3, Column: Column{Name: "lower_name", Type: Column_STRING, ComputeExpr: "lower(name)"}},
+		},
+		NextColumnId: 4,
+		PrimaryIndex: IndexDescriptor{Id: 1, Index: Index{Name: "primary", Unique: true}, ColumnIds: []uint32{1}},
+		Indexes: []IndexDescriptor{
+			{Id: 2, Index: Index{Name: "by_name"}, ColumnIds: []uint32{2}},
+			{Id: 3, Index: Index{Name: "by_lower_name", Unique: true}, ColumnIds: []uint32{3, 1}},
+			{Id: 4, Index: Index{Name: "by_upper_name"}, KeyExprs: []string{"upper(name)"}},
+		},
+		NextIndexId:   5,
+		FormatVersion: CurrentFormatVersion,
+	}
+	if !reflect.DeepEqual(expected, desc) {
+		t.Errorf("expected %+v, but found %+v", expected, desc)
+	}
+}
+
+func TestTableBuilderErrors(t *testing.T) {
+	_, err := NewTableBuilder("users").ID(2).ParentID(1).
+		Column("id", Column_INT).
+		Column("id", Column_STRING).
+		PrimaryKey("id").
+		PrimaryKey("id").
+		Index("by_name", "name").
+		Build()
+	expected := `table "users": duplicate primary key; ` +
+		`table "users": index "by_name" references unknown column "name"; ` +
+		`duplicate column name: "id"; ` +
+		`index "by_name" must contain at least 1 column`
+	if err == nil || err.Error() != expected {
+		t.Errorf("expected \"%s\", but found \"%v\"", expected, err)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("expected MustBuild to panic")
+		}
+	}()
+	NewTableBuilder("users").MustBuild()
+}
+
+func TestTableDescFromSchema(t *testing.T) {
+	schema := TableSchema{
+		Table: Table{Name: "users"},
+		Columns: []Column{
+			{Name: "id", Type: Column_INT},
+			{Name: "name", Type: Column_STRING},
+		},
+		Indexes: []TableSchema_IndexByName{
+			{Index: Index{Name: "pk", Unique: true}, ColumnNames: []string{"id"}},
+			{Index: Index{Name: "by_name"}, ColumnNames: []string{"name", "id"}},
+		},
+	}
+	desc, err := TableDescFromSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := NewTableBuilder("users").
+		Column("id", Column_INT).
+		Column("name", Column_STRING).
+		PrimaryKey("id").
+		Index("by_name", "name", "id").
+		desc
+	expected.PrimaryIndex.Name = "pk"
+	if !reflect.DeepEqual(expected, desc) {
+		t.Errorf("expected %+v, but found %+v", expected, desc)
+	}
+
+	schema.Indexes[1].ColumnNames = []string{"missing"}
+	if _, err := TableDescFromSchema(schema); err == nil ||
+		err.Error() != `table "users": index "by_name" references unknown column "missing"` {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestTableSchemaFromDesc(t *testing.T) {
+	desc := NewTableBuilder("users").ID(2).ParentID(1).
+		Column("id", Column_INT).
+		Column("name", Column_STRING).
+		PrimaryKey("id").
+		UniqueIndex("by_name", "name", "id").
+		ExprIndex("by_lower_name", "lower(name)").
+		MustBuild()
+	desc.Comment = "registered users"
+	desc.Columns[1].Comment = "display name"
+	expected := TableSchema{
+		Table: Table{Name: "users", Comment: "registered users"},
+		Columns: []Column{
+			{Name: "id", Type: Column_INT},
+			{Name: "name", Type: Column_STRING, Comment: "display name"},
+		},
+		Indexes: []TableSchema_IndexByName{
+			{Index: Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+			{Index: Index{Name: "by_name", Unique: true}, ColumnNames: []string{"name", "id"}},
+			{Index: Index{Name: "by_lower_name"}, KeyExprs: []string{"lower(name)"}},
+		},
+	}
+	schema := TableSchemaFromDesc(desc)
+	if !reflect.DeepEqual(expected, schema) {
+		t.Errorf("expected %+v, but found %+v", expected, schema)
+	}
+
+	// Converting the schema back yields the original descriptor, minus IDs.
+	roundTrip, err := TableDescFromSchema(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTrip.Id, roundTrip.ParentId = desc.Id, desc.ParentId
+	if !reflect.DeepEqual(desc, roundTrip) {
+		t.Errorf("expected %+v, but found %+v", desc, roundTrip)
+	}
+}
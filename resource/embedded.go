@@ -77,7 +77,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _uiJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xec\x7d\x7d\x77\xdb\x36\xb2\xf7\xdf\xdb\x4f\x81\xf2\x39\x69\xa8\x27\x36\x65\x27\xbb\x7b\xce\x95\xeb\xde\x75\x1c\x27\xeb\x6d\x62\xb7\xb1\xdb\xbd\xbd\x3e\x3e\x3e\x34\x09\x4b\x6c\x28\x52\xe1\x8b\x6d\x6d\xd7\xdf\xfd\xce\xe0\x85\x04\x48\x80\xa2\x64\x39\x4d\xb6\x66\x7b\x62\x09\x1c\x0c\x06\xc0\x00\x98\xf9\x01\x18\x0d\x87\xe4\x0d\x4d\x68\xe6\x17\x34\x24\x97\x73\x52\xe4\x81\xf7\x15\x24\xe6\x69\x99\x05\x74\x44\x82\x34\xf8\x90\xa5\x7e\x30\x19\x66\x94\xa7\x0d\xcb\x7c\x58\xe4\x43\xcf\x63\x74\xaf\x8e\xc9\xd1\xf1\x29\x39\x78\x75\x78\xfa\x35\x7c\xc7\xa4\xfd\x74\x36\xcf\xa2\xf1\xa4\x20\xcf\xb7\xb6\xff\x42\x4e\x27\x14\x92\x04\x17\xb2\x57\x16\x93\x34\xcb\x3d\x41\xfb\x36\x0a\x68\x92\x43\xd1\x65\x12\xd2\x8c\x14\x40\xbb\x37\x03\x3a\x2a\xdf\x6c\x90\x9f\x69\x96\x47\x69\x42\x9e\x7b\x5b\xc4\x45\x02\x47\xbc\x72\x06\x3b\xc8\x62\x9e\x96\x64\xea\xcf\x49\x92\x16\xa4\xcc\x29\xf0\x88\x72\x72\x15\xc5\x94\xd0\xdb\x80\xce\x0a\x12\x25\x50\x8b\xe9\x2c\x8e\xfc\x24\xa0\xe4\x26\x2a\x26\xac\x1c\xc1\x85\xd5\xe2\x17\xc1\x23\xbd\x2c\x7c\x20\xf7\x21\xc3\x0c\xbe\x5d\xa9\x84\xc4\x2f\x84\xd0\xf8\x4c\x8a\x62\x36\x1a\x0e\x6f\x6e\x6e\x3c\x9f\x09\xec\xa5\xd9\x78\x18\x73\xd2\x7c\xf8\xf6\x70\xff\xe0\xe8\xe4\x60\x13\x84\x16\x99\x7e\x4a\x62\x9a\xe7\x24\xa3\x1f\xcb\x28\xe3\x6d\xed\xcf\x40\xa8\xc0\xbf\x04\x51\x63\xff\x86\xa4\x19\xf1\xc7\x19\x85\x77\x45\x8a\x42\xdf\x64\x51\x11\x25\xe3\x0d\xe8\x8b\xab\xe2\xc6\xcf\x28\xb2\x09\xa3\xbc\xc8\xa2\xcb\xb2\xd0\xda\x4c\x8a\x08\x35\x57\x09\xa0\xd5\xfc\x84\x38\x7b\x27\xe4\xf0\xc4\x21\x2f\xf7\x4e\x0e\x4f\x36\x90\xc9\x3f\x0f\x4f\xff\x7e\xfc\xd3\x29\xf9\xe7\xde\xfb\xf7\x7b\x47\xa7\x87\x07\x27\xe4\xf8\x3d\xd9\x3f\x3e\x82\x5e\x3c\x3c\x3e\x82\x6f\xaf\xc9\xde\xd1\x2f\xe4\xfb\xc3\xa3\x57\x1b\x84\x42\x8b\x41\x39\xf4\x76\x96\x61\x0d\xd2\x0c\x59\x44\xd8\xa0\x34\xf4\xc8\x09\xa5\x9a\x08\x57\x29\x17\x29\x9f\xd1\x20\xba\x8a\x02\xa8\x5a\x32\x2e\xfd\x31\x25\xe3\xf4\x9a\x66\x09\xd4\x08\xf3\xcf\x68\x36\x8d\x72\xec\xd8\x1c\x64\x0c\x49\x1c\x4d\xa3\xc2\x2f\xd8\xf7\x56\xbd\xea\x52\xf6\x7e\x02\xc9\xdf\x9f\xb0\xfe\x45\x36\x58\x5a\xe2\x4f\x69\x8e\x9d\x15\xa4\x09\xaf\xba\xa2\x60\x42\xdf\x46\xe4\x65\xe6\x4f\xc9\x9b\xac\x4c\x68\x94\x11\xf7\x12\xbe\x3d\x0b\xd2\x90\xfe\xad\x52\xf0\xd8\xbf\xcc\x3d\x50\x94\x01\x64\xfb\xd3\x9f\xb0\x8f\xf7\x92\x30\xa3\x37\xe4\x65\x9a\x5c\x53\xe0\x4c\x89\x0b\x92\xce\x2f\x41\xc4\xbf\x8d\xa7\x7e\x14\xeb\xd4\xef\xfc\xa2\x20\xa7\x99\x1f\xcc\x89\x3b\x85\xcf\x66\xce\x5f\x5d\xfb\x19\x99\x50\x1f\x6a\xf8\x8a\xe6\x41\x16\xcd\xb0\xce\x64\x97\x3c\x3d\xad\xf4\x16\x7b\x91\xe6\xd1\x38\xe1\xaa\xe0\x87\x21\xab\x3b\xcf\x85\x29\xf8\xad\x48\x67\x52\x41\x81\xf5\x65\x84\xc4\xbf\x72\x06\xde\x53\x1c\x18\x43\xf2\x6d\x46\xaf\x68\x46\x51\xeb\x67\x7e\x31\xd9\x75\x3c\x6f\x58\xcc\x67\xd0\x03\xf9\x10\x5a\x7b\x92\x45\xf1\xaf\xd5\x27\x2f\xf4\x8a\xdc\x21\xc3\xef\x98\x84\x3f\x15\x51\x9c\xef\x7c\xe5\x5e\x95\x49\xc0\x04\x74\x59\xca\x80\xfc\xf6\x15\xd6\x15\x49\x7e\x2c\x69\x36\xdf\x67\x63\x75\x97\x28\x84\x92\x06\x9f\x2a\xb5\x26\x76\x2f\x3e\xe2\x67\x95\x0a\x1f\x1c\xb5\x1e\x7f\x05\xec\xf8\x87\x1d\x03\x05\xa8\x60\x19\x17\x40\x92\x94\x71\x6c\x22\xa0\x59\x06\x3a\xd1\xf1\x7e\x96\xc2\x34\xb4\x4b\xb6\x0c\x2f\xa1\xbd\x80\xff\xc4\x1d\xd4\xef\xee\xaa\x4f\x75\x15\xbc\x59\x96\x16\x29\xb4\x24\x95\x39\x80\x9f\xb9\x01\x2a\xd6\x90\x25\x80\xd1\x73\x5c\x16\x79\x01\x5a\x04\x7d\xa0\x96\x82\x4f\x74\x45\xdc\xaf\xb9\x8c\x69\x4d\xd5\x64\x56\x57\x44\x21\x82\xe2\xdb\x54\xf8\xf0\xd6\x1a\xa9\xad\xeb\x0e\x36\x8c\xa4\xac\xdd\x46\x64\x8a\x92\xce\x5c\x6c\x3d\x03\xe1\xdd\xce\x62\x61\x3c\x5e\xa8\x07\x9a\x99\x30\x3e\x1b\x06\x1a\x56\x5a\xa3\x01\xea\xa6\x56\x8a\x31\xb6\x7a\x4e\x8b\x1f\x85\xa6\xd4\xcd\xfe\xb1\x5b\xa5\x3e\xee\xf4\x66\x3f\xf1\xf3\x57\x7e\xe1\xaf\xa3\x53\x33\x5a\x94\x59\xa2\xa9\xde\x77\xaa\xea\x2d\x12\xa5\x52\xf7\xf5\x4a\xc2\xd9\xf6\x17\x43\x0e\xaa\x35\xb7\x07\x72\x5d\x42\x08\x31\x72\x1f\xa0\x53\xfa\x0b\xd1\xe6\xde\x29\x11\x0e\xea\x5e\x63\x1a\x67\x54\x66\xa5\x50\x5c\xb8\x77\x0d\xb9\x78\x27\x40\x09\x5f\xf3\xc9\x8d\xfc\xfb\xdf\xd6\xc1\x57\x53\x0d\xda\x23\x16\x65\xaa\x8a\x32\xc9\x52\x35\x65\x3d\xdb\xda\x0b\x6a\xf3\xaf\xb3\x4b\xb5\xb1\xd6\xa5\x2b\xb3\x3e\xbf\xb5\xa7\xf3\x46\x51\xd8\x8d\xcf\x9e\xb5\x49\xee\x16\xcf\x31\x42\x1f\xea\xfe\xe6\xaf\xee\x06\x52\x40\xb6\xf6\x79\xda\x82\xa7\x12\x03\x21\xa3\xc0\x1e\x11\x9f\x60\x42\xbe\x1b\x70\xfb\x54\x5a\xd2\x53\xb0\x36\xe2\x7c\xc8\x54\x09\x96\xda\xda\x38\x19\xf5\x34\x1d\x2a\xf2\x25\x4c\x19\x54\xab\x77\xac\x60\x6d\x31\xe7\x49\xea\x6a\xfe\x03\x8a\xc5\x6b\xab\xd0\xb1\x54\xe3\x82\x7e\x44\x6f\xde\xfd\xbc\xbf\x7f\x02\x36\x5b\xde\xd2\x7a\xd1\xa0\x6d\xcd\x8a\xa3\x6b\x7a\x71\x39\x2f\x28\x18\x64\x5b\xed\xe5\xe5\x03\x9d\x77\xbc\xbd\xf6\xe3\x8e\xb7\x51\x52\x80\x91\xd6\x41\xc0\xca\x0e\xd2\x32\x29\xac\x65\xdb\xdf\x62\xd9\xf6\xb7\xa2\xec\x85\x04\x60\x05\x1b\x5f\x8f\x03\x2e\xb7\x95\x20\x9f\xe7\x1d\x35\xc3\xb7\xf6\xb2\x63\x3f\x2f\x2e\xca\x59\x08\x1e\xde\x45\xe2\x27\x69\x9b\xc7\x9d\xc9\xdc\x61\x5d\xef\xa9\xdd\x0c\x4a\xad\x7e\xdd\x69\x2b\xc5\x5e\x10\x94\xd3\x32\x86\x92\x6a\xdd\x00\x4b\xb6\x00\x17\x26\x0b\x9a\x4a\x82\x2f\xbc\x5a\x21\xc8\xb3\x5d\xa4\x52\x52\x76\xda\xe4\x95\x82\x48\xea\x2a\xc1\x40\x5c\xe9\x8b\x24\xae\x12\x0c\xc4\xaa\xfa\x48\x7a\x35\xcd\x90\xa5\x56\x28\x4d\x76\x96\x62\x91\x5d\xa3\xae\x12\x2c\xb2\x6b\xc4\x55\x82\x5d\x76\x8d\x5e\x4d\xb3\x67\x41\xb7\x4c\xcf\x00\x29\x06\x72\x55\x41\x65\x06\x35\xcd\x90\xa5\x52\x59\x49\x5f\x25\x58\x88\x35\xf1\xab\x04\x53\xbb\x37\x15\x1a\x14\x13\x66\xd0\x89\x37\xf5\x6f\x5d\x33\xc5\x06\xef\x9e\x66\xb2\xd1\xce\xe7\x8a\x6f\x50\x65\x28\xc6\x90\xda\x39\x0c\x90\xa2\x5c\x34\x06\x32\x70\x90\x1b\x8a\xa4\x24\x99\x1a\x80\x39\x82\x17\x86\x7c\xed\x37\x86\xec\x19\x65\xb0\x03\x2c\xfe\x26\x16\xe6\xb7\x06\x36\xfe\x35\xb8\xc0\x08\x5e\x98\xb8\x18\x5f\x1a\x98\xf0\xde\x08\x2f\xfc\xa2\xd5\x89\xf5\x2b\xde\x7b\xf5\xf7\x86\xd9\x60\x9b\x75\xbc\x1c\x3f\xf2\xcc\xec\x63\xaf\xee\xe6\x5d\xa6\xf5\x35\x4f\xaa\x33\x2b\x0b\x24\x33\x03\xf6\xc6\xe3\x8c\x8e\xfd\x02\x3c\x9a\x46\xff\x36\x5e\x9f\x35\xbf\x3b\x7b\x3f\xbf\x71\xce\xa1\xb0\x6d\xfc\x87\x7d\xdb\x59\x9a\xc1\xc5\xfb\xbd\xd3\x03\xc6\xe5\xb9\xe4\xc2\x93\x94\xea\x0e\xf8\x52\xee\x35\xf2\x33\x8b\xc5\xfc\x46\x5a\x30\x92\x45\xe5\xf2\x6b\x34\xc6\xbc\x95\xf9\xc4\xde\x62\xc7\x32\x73\xc3\xe3\x5f\xb1\x48\x2d\x41\x96\x04\x19\x78\xba\x42\x62\x32\xa4\x4a\xb0\xb0\x86\xc1\xc4\x8f\x12\x74\x55\x57\x30\xa5\x7a\x00\x1c\x55\x32\x2b\xe7\x07\x74\x89\x2f\xd0\xd0\xdc\x40\x4b\x40\xed\x65\xe4\x95\x5e\xfe\x0a\x72\xc2\x8b\x96\x49\x59\x73\x87\xb7\x25\x35\x79\x07\xec\x05\xd9\xdd\xdd\x65\xb0\xd7\x15\xe2\x38\x26\x9b\x5c\x30\x84\xa2\x6c\x4e\x33\x3e\x95\x24\x25\x35\xba\x3c\xac\x0e\x2d\x67\xe7\x4e\x31\x72\xab\xfa\x02\x9f\xea\x73\x5f\x13\x97\xf7\x0c\xc2\x65\x59\xf1\xc0\xfd\x32\x8d\xe2\x38\x3a\x4d\x8f\x70\x1a\x77\xd9\x97\x5c\x6d\x36\x51\x5f\xfe\x82\xfc\x7f\xb2\xed\x6d\xd1\xbf\xb6\x6b\xab\x72\x81\x0a\xa9\x5f\x97\x34\xeb\x8b\x68\x4a\x73\x9a\x45\x34\x17\x15\x3f\x3d\x7e\x75\xec\x4e\xb3\x02\xab\x3c\x18\x81\x40\x08\x53\x22\x78\x37\xa5\x45\x16\x05\x48\xe6\x99\x51\x3a\xe9\x21\x30\x30\xee\x1e\x38\x9e\x2d\x6b\x6b\xfc\xf4\xa0\xae\xfa\x54\xd0\x2e\xdd\xaf\xbd\x9c\x90\x77\xbc\x69\x5a\x6e\x88\x48\x6f\x8e\xbb\x9c\xc6\x34\x28\x8c\xf3\x32\x7f\xd5\x1c\x48\x98\x69\xef\x7a\x7c\xc2\x5e\xb2\xf9\xcb\x82\x5b\xca\xa7\x5e\xd2\xeb\x5c\x2e\xef\xe6\x0b\xec\x4f\x9b\xf7\x8c\x05\xb1\xb1\x26\x9c\xdf\x0e\xcf\x55\xe1\x06\xc4\xca\xb7\x8e\x3c\x45\x54\xc4\x48\xdd\x18\xb2\x2e\x9f\xa1\x9a\x6c\xbb\xbc\x6c\xdc\x8e\x80\xa5\xb2\x13\xc3\x50\x1f\xab\x67\xa7\x3e\x58\xe8\x88\xd7\x5f\x95\xc3\x8c\x3b\xca\xc7\xaf\x96\x8f\x91\xb6\x5e\x34\x17\x17\x0f\x96\x37\x3b\x27\x03\x40\x69\x49\xbe\xb3\x4d\xb1\x4a\x57\x37\xa6\xda\x41\x13\xb0\x10\xfa\xf4\x1e\x6d\x84\x95\x74\x4a\xcd\xf9\xa8\x57\xbf\xbb\x5e\x31\xb3\xe9\xa1\x95\x4b\xed\xf3\x45\x0a\xa6\xea\x8a\xd0\x8f\x0e\xe3\x20\xa1\x37\x86\x89\xca\x8a\xb2\xe3\xc3\xe7\x49\x0f\x72\xa1\xc5\x7b\x3d\xb6\x17\x8f\x62\xf7\x16\xc1\xa0\xd7\x3d\xc5\xc0\x9c\x5c\x14\xfc\xa4\x59\xb0\x9c\x08\xcd\x49\xb1\x82\x8a\x04\x66\x2d\xea\x49\x26\xe3\x15\x57\x68\xe3\x5a\x81\x2f\x9a\x55\xaa\xde\xbe\xa7\x01\xb8\xc5\x6e\x58\x66\x6c\x73\xb2\xa3\xea\x16\x1c\x15\xed\x82\x99\x9f\x8c\x7a\x0e\x04\x94\x94\x26\xe1\x69\xc4\x86\x2d\x36\xe7\x2b\x6c\x78\xcb\x58\x93\x39\xc0\xbd\xc9\x8a\x66\x1e\xc1\xc6\x1b\x53\xf6\x0a\x8a\xdd\x24\x55\x3d\xec\xfc\x44\x75\xce\x2a\x9e\x35\x83\x0d\xd2\xe2\x79\x6e\x19\x13\xed\x21\xd1\xd5\xff\xd8\x4a\x1e\x6f\x6a\xa8\x01\xff\xa0\xf5\x7d\xc1\xeb\x26\xbb\x99\x7d\x55\xfb\x5d\xbc\xb7\xb8\x2c\xdd\x93\xb2\xbe\x49\xe9\x5e\xe4\x42\x71\x8d\x7a\xbe\x78\x06\xe6\x78\x74\xc5\x04\x77\x33\xab\x2f\x16\x6a\xa9\x24\xf6\xa9\xb7\x6e\x1f\x77\x7b\x0b\xec\xd9\xbf\xe2\x3f\xdb\x5b\x5b\x5b\x03\x43\x4f\xf6\x98\xcb\x1d\xde\x2e\xa7\x48\xe4\xd8\x58\xd0\x5b\x1a\x94\x6c\x38\x2e\xd6\x5d\xa6\x85\x58\x57\xad\x42\xee\x40\xf9\x68\xd6\x14\xcc\x08\xab\x85\x75\x0b\x13\x1f\xa6\x8a\x12\xbc\x6c\x5b\xee\x6e\x7e\xb6\x75\x6e\xd9\xd6\xc4\x07\x94\xb6\x33\xf3\x76\x57\x66\xdc\x3f\x8c\x10\x78\x3d\x3b\x37\x13\x59\x56\x05\x3c\x99\xe0\x62\xe5\x22\xb6\xd9\x0c\x7f\xbe\x15\x8d\x53\xab\x83\x17\xd3\x64\x5c\x4c\xe0\xe5\xb3\x67\xdd\xab\xe3\x47\x4f\xc8\xe1\xcd\xca\x7c\xe2\x36\x19\x9d\x45\xe7\x72\xc9\x75\x4d\x1a\xc1\xe4\x34\xa6\xaa\x7b\x1f\x5e\x18\x41\x4f\x15\xc1\x44\xec\x14\x03\x47\x03\xaf\xce\x81\x6c\xe2\xd3\xbd\x4b\x8b\x0f\x36\x53\x99\xc5\x08\x5e\xe0\xd1\x22\x96\xcb\x69\x97\x2c\x9d\xba\xaa\xaa\xbf\x61\xae\x11\xfe\xb3\x81\x6e\xd5\x24\x0d\x47\xc4\xf9\xe1\xf8\xe4\xd4\x81\x99\xea\x16\x5d\xaf\x62\x44\x92\x34\xf9\x47\x9e\x26\x07\xb8\xe3\x04\x9a\x1f\xfa\x85\x3f\x22\x1f\x61\x52\x31\xb6\x07\xdf\xb6\xae\xe5\xb5\x6e\x90\xb1\xed\xfa\x50\x6c\x83\x19\xa7\x0a\xf9\x54\x44\x50\xbf\xb3\xde\xf3\xa5\x96\xd3\x03\x6d\x3a\x00\x9f\x4a\x11\xac\x85\x35\xb5\x84\xcb\x3c\xac\xeb\x2c\x8d\x92\x6e\xf9\xf0\x51\x69\x3b\xc4\xb4\x8b\x7a\x67\xd1\x3a\xd1\x67\xa1\x41\x8f\x9a\x16\x81\x11\xb1\xf8\x51\x3f\x08\xa2\x9b\x48\x72\xf6\x97\x93\x7c\x83\x58\xdd\xac\xe2\x73\xbb\xc9\x21\x54\x67\xea\x66\xbd\xab\x41\x7c\x21\x46\xf1\x05\x0e\x63\x3f\x1b\x97\x53\x98\x8a\xeb\xf1\x7b\x61\x19\xc0\xf5\x00\x85\x8c\x9b\x64\x0b\xf1\xb9\x2a\x37\xa4\x9d\x77\x8d\x25\xc5\xa4\xe2\xd2\xd7\x0b\x93\x09\xc8\x94\x8d\x21\x2b\xcb\xb7\x6b\x6c\x4d\xa2\x8d\x0a\xf7\x76\x92\x6d\x90\x74\xd6\xd6\x13\x21\x03\xbc\x67\x10\x6a\x99\x93\xef\xc8\xf3\xad\x2d\xf2\xdf\xe4\x1f\x27\xc7\x47\x1e\x1e\x35\x4b\xc6\xd1\xd5\x1c\x39\xa0\xb2\xce\xd2\x24\xa7\xa7\x30\xf6\x06\x64\x44\x9a\x69\x4d\xa9\x11\xf0\xe3\x42\xd7\x18\xa1\x4c\x50\x50\xc2\x9a\x66\x39\x9c\x10\xf7\xb8\xd3\x04\x5b\x7a\x58\x63\x2e\x0f\x01\xa8\xc8\xac\xe1\x0b\xf8\xbf\x27\xfe\xc2\xe6\xb8\x80\x1d\x2e\x58\x40\x6e\x02\x98\xaa\x73\x5a\xfb\x55\x1d\x35\x68\xa5\x4e\xbe\x07\xbc\xf2\x36\x4a\xe8\x9b\xcc\x9f\x4d\x8c\x56\x73\xf5\xd6\x34\xa6\xf6\xf1\x24\x5e\x1a\xc7\x74\x19\x7f\xb8\xce\xe4\x5e\x4f\xd7\xe0\x01\x5f\x4f\x11\x0b\x9d\x76\x50\x80\x55\x94\xb1\xb3\x64\xd7\x1e\x6f\x65\x2f\x86\x5a\xed\x63\xaa\x6b\x5e\x1e\xf0\xf1\x6e\x1b\xeb\x83\x3a\x52\x99\xe5\x1d\x72\xab\xa7\xf0\xa7\x33\xb1\x43\x35\xe4\x08\xe4\x60\xc7\xb6\xee\x30\xc6\x73\x0b\xe3\xd0\xe3\x90\x6e\x67\xe6\x32\xa7\x87\x49\x41\x71\xd1\x8b\xae\xe9\x9b\x32\x82\x0a\x41\x6d\xdc\x22\x2b\x69\x47\xb6\x7c\x92\xde\xbc\xa5\x63\xb0\x91\xfa\x50\xfe\xb2\x77\x1b\xe5\x7d\x08\xff\xa7\x0f\xe1\xed\x49\xe0\xc7\xd0\x5c\x2f\x58\x7b\x79\x39\xfb\x66\xb3\x5f\x58\x8f\x85\x99\x7f\xc3\xb4\x4e\x33\x2a\x60\x5e\xc4\x19\x75\x83\x44\xf9\x61\x12\x15\x91\x1f\x47\xff\xa2\xe1\x06\x3b\x10\xca\xe6\xa2\x05\x2b\xa5\x96\x6d\xd1\x52\x09\xca\xe2\x87\x21\x13\x42\xd8\x61\x4c\x8d\x3a\x3c\x2a\xf3\x8a\x29\x4b\x17\x78\xc6\x24\x2d\xe3\xf0\x3d\xc5\xa3\xaf\x78\xf8\x0c\x9a\x61\x81\x1c\x38\x12\x60\x7d\x42\xd0\x95\x86\xe2\xbc\x5a\xd7\xba\xad\x97\x77\x3d\x65\x16\xe5\xbc\x3a\xc7\xb3\xa8\x38\x7c\x9a\xc5\x59\x58\x55\x56\xcb\xd4\x9f\xf5\x31\xa5\x9a\x4f\x2f\x30\x48\x6f\x0a\x18\x1d\x60\xa2\x87\x8a\x15\xd3\x8d\x0a\xa9\xcf\x07\x3a\xc7\xac\x8b\xa1\x24\xf5\x09\xd2\x18\x11\xa5\x7a\xce\xf2\x58\x4a\xee\x72\x46\x1d\x0e\x45\xf3\xf1\x33\x0a\x06\x29\x8e\x94\xfe\x79\xae\xc0\x81\x39\x9e\xf9\x41\x54\x80\xe8\xde\x76\xbf\x8c\x16\x47\x45\x23\xe9\xd0\x62\xf6\xbe\xf3\x2d\x8c\x63\x6e\xa2\xc8\x01\x69\x1f\xfa\xf2\xc1\x0e\x2b\xa7\xae\xa6\x58\x3d\x72\x81\x69\x9f\xe4\x11\xaa\x15\x68\x9b\xc4\x34\xdc\xbf\x80\x3b\xbc\x38\x2f\x4c\x32\xf1\xbd\x06\xae\xa5\x1d\x6b\x8e\xde\x2d\x4e\x7e\xf6\x69\xaf\x88\x82\x0f\xaf\x59\x8d\xab\xa9\x8f\x37\x80\xfb\xf4\xc9\xe1\xe8\xc9\xbb\xd1\x93\x93\xa7\x83\x05\xf3\xeb\x3b\xff\xf6\x5d\x04\x8e\x8a\x1f\xe7\x26\x20\xb6\x2d\xb9\xa2\xa9\xca\x41\xda\xc6\xac\xd3\xdb\xd5\x97\x67\x31\xf5\x09\x80\xa5\xda\x1c\x7d\x9c\x7b\xe4\x09\x58\x99\x0d\x4f\x64\x1c\x60\x5a\xd7\xd4\xd0\x22\x86\x72\x1b\xa7\x36\x9b\x8f\x3c\xe1\x99\x35\xf7\x41\xed\xed\xa3\x64\x63\x8d\xba\xd0\xf1\xc5\xc7\xd8\xa8\x7d\x8e\x0f\x37\x05\x35\x35\xa3\x7e\x52\xb8\x9f\x14\x7c\x12\x82\xa2\x71\x2c\xe2\x72\xea\xe1\x51\x8e\x71\x9a\xcd\xb7\xb7\x4c\x1d\x23\xca\xaf\x39\xf4\x46\xa4\x83\xda\x5a\x63\xb6\xd3\x02\x50\x78\xbf\x49\xde\xe5\xf9\x54\xb6\xa5\x17\xa8\x86\x64\x60\x91\xb2\x92\xe9\x3a\xa2\x37\x6e\x50\x64\x46\x61\xd8\xb1\x57\x78\x27\xfb\xc7\xbe\xe4\x49\x9c\xc1\x75\x98\x3d\x38\x46\x49\x9c\x0d\x30\xc7\xf2\x62\x1e\x83\x63\xe1\xdc\x44\x61\x31\x19\xc1\x74\x33\xbb\xdd\x99\x50\xbc\xea\x34\x7a\xc1\xbe\x38\xe4\x6e\x03\xf3\xe5\xd7\x63\xaf\xce\x06\x72\x5f\x45\x63\x70\x48\xb0\xf4\xda\x82\xb9\x33\x59\x3a\x6d\xc5\x04\xb3\x94\x2e\x14\x14\xca\x71\xe2\xd4\x67\x67\x6b\x3d\xcf\x84\xe8\xd9\xce\xc1\xea\xed\x8d\x2d\x88\x56\x33\xfc\xb1\xf5\x3b\x2c\x57\x60\xe2\x32\x4d\xdd\xc0\xb5\xd3\x06\xe8\x30\xf3\xfb\x37\x52\x8d\x5a\x3c\x76\xc8\xd0\x34\x58\xb2\x38\x2e\x64\x50\x65\xec\x24\x0b\x4f\xc6\x77\x8a\xa7\xe6\x80\x2f\xfc\xbb\xc4\xae\xcb\xd4\xab\x7c\xc1\xda\x6f\xd9\x00\x6e\x7d\xb5\x90\xd5\x19\x35\x90\x7d\xd0\xe0\x88\x8a\x4a\x81\xa7\xeb\x34\x15\xa3\x56\x29\x6b\xa0\x5a\x73\x82\x6b\xbf\x4d\x73\x84\x0d\xc9\x8a\x33\x5c\xbf\x6d\x10\x9b\x9c\xe2\x99\x3f\xa6\xf9\x90\xe9\xe6\x7f\x96\x37\x6c\xcb\x60\x44\x01\x2a\xff\x79\x2f\x9c\x46\xc9\xcf\xa0\xed\xba\xff\x5c\x27\xab\xfe\xb3\xe2\x0c\x2b\xb4\x2d\x27\x98\x1d\xa7\xd6\x0e\x45\xaa\x27\xaa\xe1\x85\xc9\x63\x9e\x5a\x80\x90\xf6\x86\xf3\x7d\x7d\xeb\x35\x78\xd6\xca\x7e\xc5\x54\xd9\x80\xb1\xec\x50\x74\x6d\x52\x97\x53\x09\x11\x4f\x1b\xf0\x95\x3b\xd5\xb6\xf4\x70\x6f\xd0\x75\x82\xcc\x4b\xa0\x79\x98\x0d\x87\xb9\x03\xbc\x8b\xe1\x6d\x3b\x03\xbe\xdd\xe1\x3a\x27\x3c\xe9\xaa\x8c\x09\xa3\x71\x06\x1b\x64\x31\x23\x76\x1b\x41\x65\xc3\x60\x31\xc9\xa1\xc3\x12\xab\x36\x37\xb4\x66\xe9\xdc\x18\x87\xf9\x63\x89\x2a\xb3\x5d\x57\x7b\xb5\xd7\x2a\x5a\x91\x8e\xc7\x31\x6d\x7b\xd7\x5d\xb6\x59\xe5\xbc\xde\xa0\xa4\xa8\x3a\x5f\x37\x92\xec\x46\x9a\xe6\xfc\x72\xe2\x45\x1e\x22\x27\x9f\xfa\x09\x8c\xa2\xac\xba\x06\x26\xb8\x54\x4d\x2b\x37\xae\x56\x72\xc9\x3b\x96\xdb\x5e\x52\x48\x95\xbe\x97\x10\x7a\x09\x86\x6b\x89\x1a\x9b\x8e\x3e\x15\x2c\xc4\xb6\x70\xf3\x1e\x8b\xbb\x8c\xc4\xcb\x88\xc4\x68\xf1\x44\x79\x06\xae\x39\x3b\x7e\x52\x1c\x8a\x6f\xfa\x8c\xa5\x1d\x4c\x34\x70\x47\x7b\x0a\x27\x11\xd3\x2c\xd2\xd3\xc7\x49\x93\x32\x41\xd3\xa8\x97\x4e\x07\x31\xf5\xb3\x4a\x54\xad\x1a\x8b\xb7\xc2\xf0\x59\x8b\x41\xdd\xdf\x96\xee\x34\x60\x70\xad\x59\xa7\x05\x8d\x2b\xc4\x65\x59\x14\x69\xa2\xa3\xf9\xf2\xa9\x2c\xec\x85\xe3\xb9\xe6\x82\x7b\x7e\x27\x40\x4e\x4e\xd3\x02\xdc\x2e\xc3\xae\xdf\x52\x16\xb1\x81\x31\x13\xa4\x17\x5f\xc5\xee\x67\x06\x12\xb6\x1f\x18\xd6\x67\xc6\x92\x80\xea\xef\x2f\xd0\xec\xe6\x13\xe6\x2b\x3a\x4d\x1d\x0b\xea\xd3\x36\xdf\xbc\xa6\x6d\xc9\xdb\x4d\xe8\xff\xc3\xb2\xe1\xde\x02\xfc\x1b\x25\xb3\xb2\x38\xc3\x31\xb2\xcb\x9b\xed\x1c\xbd\x15\xeb\x9c\xc4\x40\xb6\x91\xd2\xc2\x76\xd4\x29\x4d\x82\x38\x0a\x3e\x08\x8f\x47\x59\x57\x2c\x3b\xe8\x03\x83\xa8\xe7\x8b\x55\xdb\xe8\xac\xe0\xb1\x73\xbc\xa5\xb2\xcb\xcd\x35\x8f\x7d\x41\xbb\x58\xf9\xda\xb0\xbf\xe5\x92\x57\x5b\x7d\x5e\x6d\xbd\xb7\x12\x15\xcb\xbb\x7e\xd7\x20\xb5\x5b\xde\xd3\x34\x89\xf0\xb4\xd9\xfd\x6c\xef\xa5\x4d\xd7\x77\xbc\xd8\xf6\xd6\x0f\x4f\xbf\x97\xf9\x6a\x99\xc1\xd6\x34\x19\x75\xcc\x85\xa0\xc3\x13\x36\x04\x45\x2d\xc8\x0f\xb1\x1f\xd0\x49\x1a\x87\x34\x6b\xba\xc0\xcb\xab\x8f\x60\x5a\x2b\x90\x96\xd0\x74\xe1\x84\x04\x9a\x12\xc9\xc4\x86\x1a\xd5\xb4\xab\x29\x92\x70\x81\xd8\x95\x96\x87\xf0\xe1\x1a\xe7\xcf\x1f\xf4\xda\xa9\x72\x85\x4a\xa1\x63\xa9\x4d\x9d\xfc\x10\x5d\xe2\x26\xfc\xf6\xd6\xf3\x3f\xef\x68\x2f\x4a\x68\x4e\xb6\x79\xff\xf4\xfb\xe8\xe5\xd3\x0d\xf2\xf4\x1d\xff\xf3\x86\xff\x39\xe5\x7f\x7e\xe0\x7f\x0e\xf8\x9f\xff\xe5\x7f\x7e\x81\x3f\xe7\x86\xdd\x71\x8e\xce\xbe\xc4\xdb\x6a\x2e\xbb\xb3\x66\xba\xb9\xc1\xae\x2b\x41\x95\x25\xc5\xb7\x4c\xc4\x0e\x7d\x15\xd7\xe1\xc8\x53\xf2\xf2\x69\x97\x76\xb2\x4a\x41\x85\x36\xb7\x75\xaa\x30\x35\xf0\xe6\x4c\x87\xbb\xac\xf0\xf6\x2a\xf7\xec\x59\xd9\x28\x8a\xdc\x4c\x30\xc8\x48\x4b\xfc\xef\x38\x0b\xf2\xcd\x37\x50\xf8\xb7\xbc\x55\xc5\x01\x07\xb2\x49\xb6\xcd\x77\xe8\x59\x5e\x98\xe1\x5f\x47\xb7\x34\x74\xb7\x07\xac\x76\x4f\xe1\x5f\x96\xfd\xac\x3c\x37\x9d\x54\x60\xdd\xeb\x29\x6d\x0c\x75\x55\xbe\xe9\xbd\x5b\xe0\x2d\xb2\x13\x04\xde\x70\x45\xbf\x4c\x33\x18\xdc\x9b\x01\x4c\x1b\xfe\x2c\xa7\x23\xf9\x61\x87\xf0\x37\x20\x69\x8e\xfb\x22\xc9\x78\xb4\xa5\xa4\xf1\xdd\x9a\xff\x17\x04\x81\xd3\xe0\x3e\xa9\x58\x5f\xc1\x74\xb4\x79\xe5\x4f\xa3\x78\x3e\xda\xcb\x22\x3f\xde\x20\xb9\x9f\xe4\x9b\x08\x33\x5c\xed\xb0\xb7\x79\xf4\x2f\x3a\xda\xfe\xf3\xec\x96\x7f\xbd\xe1\xd0\x5f\x82\xa2\xc7\x3b\x33\x3f\x44\xf8\x6d\xb4\xbd\x35\xbb\x25\x7f\x01\x1a\x21\x2b\x07\x0d\xf3\x34\x8e\x42\x99\xc4\xf1\xc3\x6d\xa0\xc1\x90\x38\x57\x71\x7a\x33\x9a\x44\x61\x48\x93\x9d\x1b\x20\xd8\xbc\x84\xb5\xfc\x83\x64\x5b\xd7\x58\xd4\x60\x47\x7c\x7c\xf1\xe2\xc5\xce\xa5\x1f\x7c\x18\x67\x69\x99\x84\x92\x00\xc6\x32\xfc\xb7\x83\xdb\x9e\x9b\x7e\x1c\x8d\x93\x11\xba\xe4\x30\x1f\x36\xea\x1d\xb2\x7a\x1f\x87\xe1\xeb\x28\x63\xe7\xb4\x97\xab\xff\x17\x5a\xd9\x2f\xb4\x9e\x57\xff\x85\xff\xf5\xad\xe7\xc1\x35\x4d\xfe\x38\xbd\x8a\xb5\xfd\x42\x2b\x7a\x75\xd5\x5d\xcb\x1a\xbd\x63\xc6\x3d\x9b\x36\x4f\x71\x3a\x74\xf3\xe6\x02\xa9\x4c\xc9\x60\x15\x85\xd1\xb5\xd1\x6f\xa9\x0c\x26\x64\x15\xe5\x05\xb8\x10\x26\x9f\x05\xc8\xd8\xac\xab\xee\x79\x28\xd3\xf0\x5d\x87\x4b\x54\x64\x56\x87\x49\x52\x68\x5b\x29\x72\xfe\x05\x9e\x8e\xcd\x7d\x5a\x98\xf1\x7b\x3a\x5f\x39\xef\xcf\xe8\xd5\xac\x9c\xfb\x6d\x74\xbd\x7a\xe6\x43\x76\xe3\x7e\xe5\xec\x27\xf3\xbc\xa0\x53\xc7\x0c\x01\xda\x4e\x34\xf7\xeb\xa2\x50\x2b\xb3\xb1\x54\x60\xd9\xfb\x78\x9d\x7b\x91\xe4\x16\x2e\xc8\x80\xdb\xae\x55\x00\x84\xfb\x32\xaa\x82\x23\xdc\x97\x51\x1d\xc0\xe1\xbe\x9c\xd4\xf8\x0b\xf7\xe5\x55\x05\x43\xf8\x54\x7d\x5d\xaf\x20\x4c\xd1\x60\xda\x5c\xa5\xaf\xd9\xcc\x0c\x0c\x54\x63\xba\xee\x78\x6e\x75\xae\x95\x6d\x15\xdf\x63\xbd\x6c\xeb\x88\x24\xeb\xe5\xab\x86\x17\x59\x2f\xe7\x2a\xd4\x86\x65\x83\xe0\xbc\x9d\xdc\x48\x3a\x37\x1e\x31\xe6\x86\x7b\x73\x35\xc2\x7d\xcb\x46\x52\xe5\x17\xcb\xc0\x19\x62\x53\x8b\x7f\x55\xce\xf6\xca\xf7\xcb\x9d\xec\x55\x3c\xe0\xf2\x0b\x3c\xd4\x2b\x3d\xf7\x7b\x38\xd8\x0f\x75\x03\x5b\x0d\x75\xd1\x70\xc8\xcb\xdc\x18\x08\xea\x42\x84\xfa\x88\xe2\xa8\x98\xbb\x79\x8b\x10\x1f\xf4\x96\x45\x67\x19\x62\x97\xec\xee\x92\xad\x0e\xaf\xd9\xd9\xde\xda\x7a\xe2\x74\xf9\xcb\x82\x90\x39\xb4\x60\xa0\xe1\x95\x43\x5e\x98\x39\x44\xc9\x90\xd8\x65\x61\x5b\x96\x03\x70\x68\x4f\xd8\xc9\x76\x17\x1d\x5a\x47\x2d\xfd\xce\xd0\x02\x75\xc8\x94\xcf\xac\xfe\x96\x48\x2f\x6b\x6a\x00\xb6\x61\x8c\x31\x59\x70\x73\xf1\xb5\x38\xc1\x97\xf1\xb3\x47\xea\xa1\x36\xe7\xc9\x2f\x9b\x4f\xa6\x9b\x4f\x42\xf2\xe4\xef\xfc\x74\x9b\x0a\xce\xd5\xcd\xc8\xc9\xd9\x01\x6a\x1e\x9d\xc7\xb0\x4d\x2e\x8b\x53\xef\x39\x36\x4e\x58\x9b\x9a\xa6\x2d\xa6\x2b\x53\x8c\x13\x1d\x1f\x0a\x69\xc6\xa0\x89\x3e\x57\x07\x39\xb1\x11\xac\xe4\x57\xb5\x42\x7e\x22\xcc\xb8\x0b\xd7\xef\xc8\x9d\xbc\x20\x75\xc1\x3b\x0f\x66\x3f\x2c\x72\x68\xd8\xd9\x50\x2a\xbe\xe0\xae\xd4\x9b\x03\xfb\x55\xa9\xce\xc3\xe6\x8d\x5b\x52\x3d\x6e\x41\x89\xde\xf3\xd9\x7c\xde\x75\x72\x8f\x1f\x1d\x0e\x0d\x57\x9e\xcc\x83\xcb\x54\x10\x6b\x9b\x43\x74\xf7\x85\xa6\x87\x34\xc7\x20\x40\x90\x7a\x11\x19\xee\x22\xa9\x0f\x0a\x79\x26\x18\x9c\x57\x1c\x3a\x76\x71\x17\xdf\xa5\x45\x96\x96\xbd\x5b\xd3\xae\x62\x27\x74\xcd\x35\x4d\xd9\xdf\x7c\x43\x8b\x13\x2e\x6d\xbe\x70\x8b\x53\xc8\x73\x7c\xf9\x2b\x9e\x2f\x00\x33\x2c\x77\x6b\xf5\xac\x8f\x82\x7b\x79\x9a\xb5\x42\x3b\x36\x3a\xcd\x24\x07\x46\x34\xd6\x64\x10\xcd\xd8\x21\x8a\xa1\xf8\xaa\xf1\x59\xaf\x2d\x27\x44\x9f\x78\xbc\xf8\x68\xc5\x1a\xb7\xb2\x17\x95\xf4\x8a\x16\x3e\x0f\x09\xd3\xab\xba\x95\x56\xca\x43\xb3\x96\x3a\x9b\x77\x55\x45\x4e\x11\xd2\x73\xe1\x69\x40\xee\xff\x3b\x47\x29\x1f\x71\x18\xb7\x1b\x6f\x56\xcf\x69\xd1\xe3\x68\xa0\x89\x95\xdd\xdf\x17\x38\x41\xb7\x8f\xc1\xbc\x10\x69\xc6\x82\x58\x21\x25\x87\xe1\x88\x9d\xcd\x11\x89\xf0\xd7\x3f\x9b\x40\x57\xec\x0e\xf1\xa4\x0b\x4c\x6b\xb0\xee\xb0\x5a\xf3\xa1\xcb\x8e\xbf\xe0\x3f\x30\x7c\x71\x45\x3a\xd7\x0e\x56\xc2\x2c\x97\xe2\x45\x65\xe6\x31\x19\xf3\x0c\x06\x5d\x77\x7c\x2d\x32\x1e\xd1\xe2\x26\xcd\x3e\x68\x82\x36\xf9\xfb\x61\x88\x51\xd1\xbd\x84\xd3\xae\x52\xcc\x1e\x67\xd1\xab\x18\xf1\x77\xc9\x62\x4e\xf0\xfe\x34\x0d\x89\x5f\x68\x85\xa8\x2b\x2e\x2f\x30\xe7\x84\x18\x63\x6d\xc9\x22\x7e\xe2\xd1\xd9\x16\x17\xa1\x84\x71\x5b\xb2\x88\xf7\x68\xa6\x98\x9a\x49\xb1\x5f\x96\x64\xf9\x96\x87\x54\xb7\x72\x6e\x1b\x48\x4b\x16\xb0\x27\x2d\x50\x7b\x19\x46\x23\x75\xc5\x62\x98\x11\xae\x77\x40\xc3\x3c\x87\x12\x97\xee\x5a\xbc\x1d\xb0\xf9\xbe\xb2\x25\x97\x69\x2e\xdc\x8f\xe1\x7d\x64\x34\x45\x97\x94\xe4\x35\xcc\x7e\x73\x52\x4b\xa2\xd7\x54\x33\xc3\x45\x3d\x97\x02\x4c\x54\x9f\xb4\xe5\xe5\xd6\x23\x04\xec\x1c\x83\xfb\xbc\xdc\xea\xb1\x17\xc7\xa6\x05\xc4\xbe\x72\x88\xa0\x84\x96\x99\xbf\x6e\x51\x63\x08\x58\x7c\xea\x71\x67\x25\xa9\x47\xbf\x95\xa4\xdd\xc3\x56\x52\x73\x8f\x5b\xc9\x8d\xc3\xa0\x4b\xd4\x22\x6f\x04\xf4\xd1\x43\x12\xb7\x57\xb9\xf6\xc2\xa7\xd8\xa4\x86\x35\xb9\x4d\x5f\x5d\x07\x97\xf6\x65\xc4\x6d\xbb\xce\xa0\x18\x48\x5a\x05\x95\xd4\x8c\x4b\xb3\x49\xa8\xd5\xa9\x15\x4a\x94\x6b\xc2\x86\xca\xb6\xd7\x8a\x6e\x3d\xa9\xb6\x78\x8d\x9f\x3c\xc7\xb1\x27\xd4\xd5\x06\x04\xae\x68\x0b\xf4\x5a\x36\x98\x05\xbf\xe6\x75\x83\xfb\xc7\x0f\xb2\x70\x58\x5c\xeb\xf5\xae\x1c\x76\x7c\xe3\xc1\x96\x0e\xa6\x6b\x0f\xb2\x76\xd8\xc0\x88\x4d\xd2\x09\x64\x3c\xdc\xea\x21\xab\xba\xd6\xe5\x83\xd5\x64\xb5\xf5\x43\x8c\x55\xbe\x8c\x68\x87\x95\x94\x7c\x7c\x36\xf0\x2a\xdc\xa2\x49\x8d\xb3\x11\x1a\x9c\x7d\x31\x0d\x46\xfb\x49\x21\x0d\x61\xfa\x3f\x22\x1a\xed\x82\xb0\x69\x5a\x80\x86\x70\x6e\x7a\xe0\x19\x3c\xfb\x67\x08\x67\x30\x25\xd3\x51\x84\x23\x26\xeb\x27\x05\x33\x0c\x52\xb4\xb0\x0c\xde\x84\x4b\x42\x19\xa2\xdd\x17\x23\x19\x4d\x09\x1e\x0c\xc8\x68\x16\x64\x32\x43\xed\x55\x95\xaa\x68\x41\x31\x44\x75\xcd\x20\x06\xcf\xf7\x9f\x81\x61\x88\x49\xd6\x45\x98\x82\x39\xe8\x12\x5b\xac\x8e\xcc\x3d\x23\xce\x60\xd4\xb5\x6d\x2a\x19\x23\xbf\xb3\x06\x0f\x3d\x70\x42\x07\xac\xd4\xa7\x0d\xed\xf5\x32\x88\x23\x51\x18\x89\x2e\x4b\x18\xe6\xb0\x07\xec\x02\x22\xf6\x0f\x61\xe0\x00\xb7\x05\xd1\x06\xf0\xb9\xb3\xad\xc2\xf8\x2c\x09\xb6\x18\xe0\x1c\xd6\xee\x6c\x2e\xbd\x1f\x90\x63\xc2\x70\xda\xbc\x1f\x0c\xbd\x11\xea\xf3\x80\xe0\x0d\x2b\x61\xad\x36\x38\xe3\xf8\x10\x16\x38\x63\xfc\xb0\xf6\x37\xc7\xe4\x3e\xa1\xf5\x8d\x05\x3e\x84\xed\x6d\x69\x2b\xb0\xbc\x79\xf7\x7c\x52\xbb\x5b\x56\x72\x8d\x56\xb7\x1c\x18\x2b\xd8\xdc\xcd\x85\xf2\xb3\x84\x6c\xbe\x1c\x3c\xa6\xd7\x8f\x09\xae\x0c\xc8\x08\xf3\xb8\x07\x1e\x83\x94\x3a\x1c\x63\x35\x5a\xf0\xe9\x87\xc6\xd4\x4c\x1f\xc1\x98\xee\x32\x1e\xc1\x98\x3e\xe5\x3c\x82\x31\x9f\x3f\x18\xc3\xd6\x87\x05\x58\x8c\x84\x5b\x1a\xb4\x9f\x5b\x14\xce\x6a\x3e\x54\x5a\xad\x6c\x9d\xd3\x2b\x97\x3f\xa8\xc7\xef\x3c\x32\x58\xe7\x81\x8e\xe9\x35\x8f\x02\x7e\xb6\xc1\x43\x14\x15\x50\x68\x59\x6a\xf3\xa6\xdb\xe2\x58\x20\xfa\x42\xc6\x54\x0c\x61\x38\xad\xb3\x3c\x81\xdf\x99\x8e\x5a\x61\x5e\xce\x54\xf8\xfa\x32\x4a\x86\x45\xf3\xaa\x08\x14\xe8\x0f\x72\x4a\x74\x07\x3d\xe9\xfe\x1e\x86\xb6\x53\x54\x4c\x08\xeb\x75\xd2\xea\xed\x43\x04\x11\xb5\xa3\x18\xb2\x84\x9e\xe1\x4e\x2e\xfa\x44\x3b\xb8\x58\x3e\xdc\xc1\x85\x1e\xe7\x40\x89\x5e\xc0\x03\x67\x1f\x5c\xc3\x3f\xef\x4e\x56\x0f\x7d\x70\xd1\x17\x45\xc2\x47\xd5\xa7\xae\xf8\x0e\x7d\xc3\xa0\xdd\x37\xee\xc2\xc5\x72\x81\x17\x6c\xad\xc7\xae\x8c\xc2\x63\xb5\xb9\x3e\x8b\x50\x0d\xd5\x40\x58\x67\xbc\x86\x25\x8d\x4a\xbe\x58\xbd\x8d\x72\xeb\xcd\x0e\x20\x2d\xe3\x4e\xa3\x52\x53\xa2\x1a\xcb\x75\x07\x0d\x48\xab\x7b\x6c\xca\x87\xb9\x03\x1c\x87\x6d\x32\x46\x78\x56\x72\xe9\x46\x91\xea\x56\x88\x23\x06\x5f\xf1\x78\x9f\x0a\x7e\x2e\x82\xb6\xf5\x45\xcb\xa6\x5e\x91\x95\x79\xe1\x3a\xdf\x24\x97\xf9\x6c\xe7\x9b\x4b\xfc\x69\x62\xfe\x79\x11\xde\xc7\xb2\x9b\x8e\x3a\x69\xe2\x74\x01\x6d\xac\x97\x46\xcd\x2c\x3d\x8a\x75\xc8\x0d\xac\xa9\xf5\x61\x23\xc9\xa1\x81\x7b\x61\xe1\x9b\xad\x97\xe2\x6f\x67\x21\xe7\xb6\xc0\xbd\xf8\xdc\x59\x24\xb4\x99\x6f\x5a\x77\xd7\x9e\xb7\x61\x5b\xbf\x3b\x4e\x44\x3d\xae\x6c\xb7\xfd\x2b\x0a\x69\xa6\x79\x75\x0a\x1a\x37\xcd\x34\xd3\x8f\x8c\xe0\xdb\xce\x35\xee\xb3\x5f\xe2\x58\xdc\xd2\xae\xc8\xff\x7c\x4a\xae\x76\xa1\x9a\x8b\x7e\x9b\x14\x54\x86\x07\xd2\xee\x1b\xe5\xca\x64\x94\x38\x5a\xc4\x2b\x67\xd0\x1d\xf0\xaa\x19\x01\x6c\x1f\x33\xb3\xf8\x2f\x4e\x67\x50\xe9\xb5\xca\xca\x62\x89\xf5\x92\x94\x07\x19\xeb\x2d\xe4\xa3\x11\xd2\x78\x4c\xbf\xb0\xa2\x28\x73\xdf\x1f\x57\x51\xb2\xe0\xcf\xa8\xbc\x67\x60\xd3\xc2\x98\x5b\x2b\x9b\x45\x95\xb2\x91\x85\x3f\x8b\xd2\x90\x8e\xff\xf4\x8b\xbd\x22\x3f\x8a\xd8\x9a\xf6\xb5\x80\xd7\x6d\x64\xde\xb2\xff\x58\xc5\xff\xb2\x45\xe9\x79\xb4\x05\xeb\xa7\xaf\x2d\xa8\xed\xdd\x8b\x75\xdc\x9b\xf9\x99\x0f\x66\x80\x58\xbc\x4d\xdb\x99\x66\x13\xd2\x68\xef\xb4\x17\xbc\x87\x88\xfb\xb5\x7a\x1d\x96\x31\x3f\x05\x6e\xd2\x61\x7f\x2e\x32\xd2\xa6\xf2\x8a\x3b\xb3\x93\x48\xed\xa0\x76\x98\x48\xda\xa4\x23\x2d\x0d\x91\x6b\x29\xab\x05\x0a\x17\x63\x15\x24\x60\xb1\xaf\xc4\xc8\xd5\x8d\x5f\x1e\x76\x7c\xf1\xaf\x21\x4e\x59\xa4\xae\x3a\xec\xf0\x15\x0c\xa1\x62\x14\xd3\xab\xc2\xb1\x5f\xc2\x57\x1b\xe2\xcf\x28\x06\x8b\x48\xce\x7f\x97\x89\x2f\x3c\x5d\x77\x4d\xf1\xe9\x15\x67\x8c\x71\xe5\xf3\x49\xd7\xfe\xac\xed\x98\xc8\x2a\x76\xdc\x42\x33\xae\x65\xc5\x19\x8c\x38\x53\xf0\x26\x89\xd2\x29\x31\x9a\x78\x52\x23\x70\x93\xa4\xbb\x4f\xfc\x2f\xbe\xaf\xfe\x47\x07\xc3\xd4\xa3\x69\xda\x35\x53\x4c\x5e\x0d\x0e\x53\x0e\xc4\x5a\xf0\x30\x79\x47\xcf\x04\x88\xb1\xdc\xc2\xa0\x13\x67\x19\x7a\x40\x62\xfc\x48\xb8\x01\x13\x13\x2c\x6c\xa0\x18\x97\xc4\xea\x31\xd4\xaf\x3f\x6b\x9f\xe1\x8f\x61\x91\x6a\x7a\xf5\x88\x8b\x7d\x62\x5c\xac\x1e\x0a\x5f\x38\x30\xa6\xab\x91\x72\x67\xb3\x05\x8d\xf5\x3c\xed\xa5\x60\x63\x2d\xde\x0c\x1c\x93\x7c\x56\x46\xc7\xe4\x71\xb4\xdf\x0d\x1e\x5b\xf2\x0c\x1a\x3f\x94\x37\x52\xc8\x7b\xc1\x62\xd0\xe6\xce\x7d\x51\xbb\x55\xa1\xbb\x35\xe0\x77\xa6\x5b\x88\x3a\x88\x67\xba\x40\xf8\xe9\x90\x3c\x5d\x39\xef\x01\xe5\x29\x53\x81\xcd\x08\xac\x49\xaa\xe3\xef\x9e\x92\x86\x36\x5a\x3b\xd5\x04\xe8\xb1\xd7\xdd\xeb\xf3\x43\x2d\xcf\x0b\x86\xff\xfa\x31\x3d\xf5\xae\x7c\xc3\x6a\x69\x13\x2f\x83\x94\xb9\x66\xab\xca\x81\x19\x26\xe0\xf1\xa3\x7a\x80\x64\xdf\xd3\x39\x11\xd1\xa6\xd6\x06\xe1\xd9\x04\x83\x35\xb5\xbf\x60\x2c\x70\xd8\x27\x13\x0d\x63\x10\xf5\x97\x0d\xc3\x92\x91\x4f\x2b\x20\x0f\x66\xd4\x5f\x44\x1e\xfc\xec\x93\x89\xc7\x0e\xaa\xf4\x03\x90\xd9\xa1\x98\x5e\x82\x3d\xda\xbf\xcd\xe7\x11\x92\xd5\x9f\x47\x48\xd6\x20\xc3\xef\xed\x86\xe8\x01\x62\x1a\x78\xa6\x34\x7a\xfb\x83\xb2\x66\x3b\xdb\x60\xbb\x3c\x04\x2c\x7b\x9f\x7a\xf4\x75\x7f\x98\xf0\x6b\x44\x66\xb9\x99\x4e\xfa\xd9\xe9\xfa\xf4\x23\x6d\x47\x99\xef\x11\x9d\xfd\x2c\xd0\xd9\x5a\xbd\x3b\x2d\x73\x83\x61\x6e\xb4\xcb\x4d\x18\x6d\x75\xab\x55\xc1\x63\x45\x5a\x03\xa5\xad\x28\x57\x83\x69\xfd\xd9\xcc\x8a\xce\xae\x1c\x51\x5f\xfb\xd1\xb5\x45\x74\xf5\x4f\x44\x2c\xa2\x94\x07\x2b\x17\xd1\x55\xa0\x33\x23\x94\x33\xc5\x94\xdf\xe3\x73\x26\x7e\x3e\x71\x76\x64\xb2\x1b\xa6\x01\xfb\x7d\x7a\x6f\x4c\x8b\x03\xfe\x3b\xae\x2f\xe7\x87\xa1\xeb\x64\x69\x8a\x00\x08\x71\x78\xb9\x8e\xfc\xb1\x10\x87\xd7\xcc\x19\xb5\x7e\x40\x83\xfd\x54\xc2\x86\x20\x12\xd5\xd2\xc9\xd4\xdf\x54\x90\x84\xc8\x5d\xa7\x6a\x9c\xc1\x50\x09\xf3\xde\x94\xc3\x91\xdc\xab\xb2\xe4\x68\xb1\x5e\x2a\x07\x6b\x63\x9d\xb0\xa9\xd3\x1a\x69\x6e\xa7\xcd\x5b\xc4\xc3\x51\x35\x9f\x2f\x51\x42\xdf\x5c\x5f\xa1\x1d\xf1\x7f\x01\x00\x00\xff\xff\x77\x84\xa3\x53\x09\xa8\x00\x00")
+var _uiJsAppJs = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\xed\x3d\xfd\x73\xdb\xb6\x92\x3f\x5f\xfe\x0a\x96\x37\x69\xa8\x8b\x4d\xc5\x4d\xfb\x66\x4e\x6a\x7a\xcf\x71\xdc\xd4\xaf\x89\xdd\xc6\x6e\xde\xf5\x3c\x1e\x0d\x4d\xd2\x12\x1b\x8a\x54\xf8\x61\x5b\xaf\xcf\xff\xfb\xed\x02\x20\x09\x90\x00\x09\xea\xc3\x4d\x5a\xa7\x1d\x4b\x02\x16\x8b\x05\xb0\xd8\x5d\x2c\x16\xc0\x70\x68\xbc\xf6\x23\x3f\x71\x32\xdf\x33\x2e\x97\x46\x96\xba\xf6\xa3\xe1\xd0\x48\xe3\x3c\x71\xfd\x91\xe1\xc6\xee\x87\x24\x76\xdc\xd9\x30\xf1\x69\xda\x30\x4f\x87\x59\x3a\xb4\x6d\x02\xf7\xea\xc4\x38\x3e\x39\x33\x0e\x5f\x1d\x9d\x7d\x01\xbf\x31\xe9\x20\x5e\x2c\x93\x60\x3a\xcb\x8c\xaf\x9e\xed\x7d\x63\x9c\xcd\x7c\x48\x62\x58\x8c\xfd\x3c\x9b\xc5\x49\x6a\x33\xd8\x37\x81\xeb\x47\x29\x54\x9d\x47\x9e\x9f\x18\x19\xc0\xee\x2f\x00\xce\x2f\x72\x76\x8c\xf7\x7e\x92\x06\x71\x64\x7c\x65\x3f\x33\x2c\x04\x30\x59\x96\x39\x18\x23\x8a\x65\x9c\x1b\x73\x67\x69\x44\x71\x66\xe4\xa9\x0f\x38\x82\xd4\xb8\x0a\x42\xdf\xf0\x6f\x5d\x7f\x91\x19\x41\x04\xad\x98\x2f\xc2\xc0\x89\x5c\xdf\xb8\x09\xb2\x19\xa9\x87\x61\x21\xad\xf8\x95\xe1\x88\x2f\x33\x07\xc0\x1d\x28\xb0\x80\x5f\x57\x3c\xa0\xe1\x64\x8c\x68\xfc\x37\xcb\xb2\xc5\x68\x38\xbc\xb9\xb9\xb1\x1d\x42\xb0\x1d\x27\xd3\x61\x48\x41\xd3\xe1\x9b\xa3\x83\xc3\xe3\xd3\xc3\x5d\x20\x9a\x15\xfa\x25\x0a\xfd\x34\x35\x12\xff\x63\x1e\x24\xb4\xaf\x9d\x05\x10\xe5\x3a\x97\x40\x6a\xe8\xdc\x18\x71\x62\x38\xd3\xc4\x87\xbc\x2c\x46\xa2\x6f\x92\x20\x0b\xa2\xe9\x0e\x8c\xc5\x55\x76\xe3\x24\x3e\xa2\xf1\x82\x34\x4b\x82\xcb\x3c\x13\xfa\xac\x20\x11\x5a\xce\x03\x40\xaf\x39\x91\x61\xee\x9f\x1a\x47\xa7\xa6\xf1\x72\xff\xf4\xe8\x74\x07\x91\xfc\xf3\xe8\xec\x87\x93\x5f\xce\x8c\x7f\xee\xbf\x7b\xb7\x7f\x7c\x76\x74\x78\x6a\x9c\xbc\x33\x0e\x4e\x8e\x61\x14\x8f\x4e\x8e\xe1\xd7\xf7\xc6\xfe\xf1\xaf\xc6\x8f\x47\xc7\xaf\x76\x0c\x1f\x7a\x0c\xea\xf1\x6f\x17\x09\xb6\x20\x4e\x10\x45\x80\x1d\xea\x7b\xb6\x71\xea\xfb\x02\x09\x57\x31\x25\x29\x5d\xf8\x6e\x70\x15\xb8\xd0\xb4\x68\x9a\x3b\x53\xdf\x98\xc6\xd7\x7e\x12\x41\x8b\xb0\xfc\xc2\x4f\xe6\x41\x8a\x03\x9b\x02\x8d\x9e\x11\x06\xf3\x20\x73\x32\xf2\xbb\xd1\xae\xaa\x96\xfd\x5f\x80\xf2\x77\xa7\x64\x7c\x11\x0d\xd6\x16\x39\x73\x3f\xc5\xc1\x72\xe3\x88\x36\x9d\x63\x30\xc6\x6f\x23\xe3\x65\xe2\xcc\x8d\xd7\x49\x1e\xf9\x41\x62\x58\x97\xf0\xeb\xa9\x1b\x7b\xfe\xdf\x4b\x06\x0f\x9d\xcb\xd4\x06\x46\x19\x40\xb1\xff\xf8\x0f\x1c\xe3\xfd\xc8\x4b\xfc\x1b\xe3\x65\x1c\x5d\xfb\x80\xd9\x37\x2c\xa0\x74\x79\x09\x24\xfe\x7d\x3a\x77\x82\x50\x84\x7e\xeb\x64\x99\x71\x96\x38\xee\xd2\xb0\xe6\xf0\x5d\x8e\xf9\xd1\xb5\x93\x18\x33\xdf\x81\x16\xbe\xf2\x53\x37\x09\x16\xd8\x66\xe3\x85\xf1\xe4\xac\xe4\x5b\x1c\x45\x3f\x0d\xa6\x11\x65\x05\xc7\xf3\x48\xdb\x69\x29\x4c\xc1\x5f\x59\xbc\x28\x18\x14\x50\x5f\x06\x08\xfc\x1b\x45\x60\x3f\xc1\x89\x31\x34\xbe\x4d\xfc\x2b\x3f\xf1\x91\xeb\x17\x4e\x36\x7b\x61\xda\xf6\x30\x5b\x2e\x60\x04\xd2\x21\xf4\xf6\x2c\x09\xc2\xdf\xca\x6f\xb6\x67\x67\xa9\x69\x0c\xbf\x23\x14\xfe\x92\x05\x61\x3a\x7e\x64\x5d\xe5\x91\x4b\x08\xb4\x48\xca\xc0\xf8\xfd\x11\xb6\x15\x41\x7e\xce\xfd\x64\x79\x40\xe6\xea\x0b\x83\x03\x2c\x60\xf0\x5f\x99\x5a\x01\x5b\x93\x8f\xf8\x9d\x87\xc2\x7f\x38\x6b\x6d\x9a\x05\xe8\xe8\x97\xb1\x04\x02\x58\x30\x0f\x33\x00\x89\xf2\x30\x94\x01\xf8\x49\x02\x3c\xd1\x92\xbf\x88\x41\x0c\xbd\x30\x9e\x49\x32\xa1\xbf\x00\xff\xcc\x1a\x54\x79\x77\xe5\xb7\xaa\x09\xf6\x22\x89\xb3\x18\x7a\xd2\x2f\x4a\x00\x3e\x79\x07\x94\xa8\xa1\x88\x0b\xb3\xe7\x24\xcf\xd2\x0c\xb8\x08\xc6\x80\xaf\x05\xff\x05\x57\x86\xf5\x05\xa5\x31\xae\xa0\xea\xc8\xaa\x86\x70\x40\x50\x7d\x13\x0a\xff\xd1\xde\x1a\xf1\xbd\x6b\x0d\x76\xa4\xa0\xa4\xdf\x46\xc6\x1c\x29\x5d\x58\xd8\x7b\x12\xc0\xbb\x71\x37\x31\x36\xad\xd4\x06\xce\x8c\x08\x9e\x1d\x09\x0c\xa9\xad\xd6\x01\x55\x57\x73\xd5\x48\x7b\x3d\xf5\xb3\x9f\x19\xa7\x54\xdd\xfe\xb1\x9d\xa5\x3e\x8e\xb5\xd1\xcf\x9c\xf4\x95\x93\x39\x9b\x18\xd4\xc4\xcf\xf2\x24\x12\x58\xef\x3b\x9e\xf5\xba\x48\x29\xd9\x7d\xb3\x94\x50\xb4\xfa\x64\x14\x93\x6a\xc3\xfd\x81\x58\x7b\x10\xc1\x66\xee\x16\x06\x45\x9f\x88\x26\xf6\x56\x8a\x70\x52\x6b\xcd\x69\x94\xa8\xc4\x4a\xf1\x51\x71\xbf\x90\x94\xa2\x83\x00\x35\x7c\x41\x85\x9b\xf1\xef\x7f\x2b\x27\x5f\x05\x35\x68\xce\x58\xa4\xa9\xac\x6a\xa0\x90\x1c\x35\x69\xab\xae\x68\xdc\x52\xbc\x60\x1b\x65\x5b\xda\x0a\x8b\xf2\xad\x29\xce\x25\x62\xfd\xe9\xd3\x26\xc8\x5d\xb7\x8c\x61\xfc\x50\x8d\x37\xcd\xba\x1b\x14\x04\x12\xdd\x67\x0b\x0a\x8f\x07\x06\x40\x02\x81\x23\xc2\xbe\x81\x40\xbe\x1b\x50\xfb\xb4\xb0\xa4\xe7\x60\x6d\x84\xe9\x90\xb0\x12\xa8\xda\xca\x38\x19\x69\x9a\x0e\x25\x78\x0f\x53\x06\xd9\xea\x2d\xa9\x58\x50\xe6\x34\x89\xd7\xe6\x3f\x21\x59\xb4\xb5\x1c\x1c\x49\x95\x2a\xf4\x63\xff\xe6\xed\xfb\x83\x83\x53\xb0\xd9\xd2\x06\xd7\xb3\x0e\x6d\x72\x56\x18\x5c\xfb\x93\xcb\x65\xe6\x83\x41\xf6\xac\xa9\x5e\x3e\xf8\xcb\x96\xdc\x6b\x27\x6c\xc9\x0d\xa2\x0c\x8c\xb4\x16\x00\x52\xb7\x1b\xe7\x51\xa6\xac\x5b\x9d\x8b\x75\xab\x73\x59\xdd\x9d\x00\x60\x05\x4b\xb3\xa7\x2e\xa5\x5b\x09\x90\x2e\xd3\x96\x96\x61\xae\xba\xee\xd0\x49\xb3\x49\xbe\xf0\x60\x85\x37\x89\x9c\x28\x6e\xe2\xb8\x93\x99\x3b\x64\xe8\x6d\x7e\x98\x81\xa9\xf9\x9f\xe3\x26\x53\xec\xbb\x6e\x3e\xcf\x43\xa8\xa9\xe2\x0d\xb0\x64\x33\x58\xc2\x24\x6e\x9d\x49\x30\xc3\xae\x18\xc2\x78\xfa\x02\xa1\xb8\x94\x71\x13\xbc\x64\x90\x02\xba\x4c\x90\x00\x97\xfc\x52\x00\x97\x09\x12\x60\x9e\x7d\x0a\x78\x3e\x6d\xac\xa0\x9d\xf4\xbb\x40\x3b\x49\x51\xd0\x2e\x40\x97\x09\x0a\xda\x05\xe0\x32\x41\x4d\xbb\x00\xcf\xa7\xa9\x8b\xe0\xb2\x4c\x2c\x00\x29\x12\x70\x9e\x41\x8b\x02\x7c\x9a\xa4\x48\xc9\xb2\x05\x7c\x99\xa0\x00\x16\xc8\x2f\x13\x64\xfd\x5e\x67\x68\x60\x4c\x90\xa0\x33\x7b\xee\xdc\x5a\x72\x88\x1d\x3a\x3c\xf5\xe4\x81\x9a\xf1\x25\xac\x0c\xd5\x48\x52\x5b\xa7\x01\x42\xe4\x5d\x73\x20\x81\x05\x72\x8d\x91\xb8\x24\x59\x07\x90\x85\xe0\x44\x52\xae\x99\x23\x29\x9e\xf8\xc4\xed\x00\xca\x5f\x86\x42\x9e\x2b\x41\xe3\x5c\xc3\x12\x18\x9d\x17\x32\x2c\xd2\x4c\x09\x12\x3a\x1a\xde\xc4\xc9\x1a\x83\x58\x65\xd1\xd1\xab\x7e\xd7\xcc\x06\x95\xd4\xb1\x53\xfc\x4a\x0b\x93\xaf\x5a\xc3\x4d\x87\x4c\x18\x6b\x9a\x54\x15\xe6\x14\xe4\x41\x1c\xe6\xf3\xe8\x0c\x2c\xc3\xfa\xd0\x56\x39\xe7\xdc\x57\xf3\xe5\xaf\x67\x87\xa7\xe6\x05\x2e\x40\xf1\x0f\xfb\x3d\xd6\x2a\x7a\x72\xf2\x86\x94\xdc\xa3\x25\xf1\xa7\x56\xc1\xa3\xe3\x33\x52\xee\x2b\x52\x0e\x7f\x69\x15\xfb\xfe\xcd\xc9\x3e\x2d\xf8\x9c\x14\xa4\xbf\xb5\x8a\x9e\x9e\xbd\x3b\x3a\x7e\x4d\xca\x7e\x4d\xca\xb2\x04\xad\xc2\xff\x38\x3d\x39\x26\x45\xbf\x21\x45\xc9\x4f\x6e\xe4\x06\xd4\x2a\xb1\xab\x22\xc4\xee\x6a\x24\x16\x26\x18\x6f\x61\x0b\xd9\xf5\x12\xd2\x01\x26\x76\xde\xfe\x74\x9a\xf8\x53\x27\x83\x25\x6b\x6d\x94\x6b\xd9\xe7\xf5\xdf\xe6\xfe\xfb\xd7\xdc\x98\xe1\xaf\x71\x6f\x04\x93\x77\xfb\x67\x87\xdc\x08\x96\x49\x92\x5e\xa9\x95\xe7\xba\xa6\x9e\x23\xeb\x9f\x26\x8c\xb4\x6c\x69\x1f\x93\x5c\x9c\xb9\xc4\x9e\xb4\xe9\x4f\xac\x52\x48\x28\x6a\x82\x02\x34\x9d\x03\x69\xb1\x94\xc9\x9c\xa5\x96\xf2\xca\xbe\x2c\x69\xd1\xc2\x04\x67\x10\x5b\xb5\xab\x39\x1d\xc1\xc1\x91\x54\x9e\x97\x10\xf4\x43\x70\x19\x20\xa7\x3c\xfb\xea\x6b\x71\x54\xf2\x28\x20\xda\xe7\xfc\xc9\x8f\xc1\xcb\x27\x3b\xc6\x93\xb7\xf4\xe3\x35\xfd\x38\xa3\x1f\x3f\xd1\x8f\x43\xfa\xf1\x7f\xf4\xe3\x57\xf8\xb8\x90\xa8\xa8\xef\xe3\x04\x16\x1a\x2f\x51\x1d\x5b\x44\x29\xcb\x16\xae\x44\x1e\x43\x93\x0b\x88\x6f\x09\x89\xb2\x15\x23\x33\xf8\x99\xbe\x37\x9e\x18\x2f\x9f\xa8\x7c\x3b\x65\xa3\xa0\x41\xbb\x7b\x35\x95\x10\x4b\x70\x53\xa4\xc3\x17\xa4\xf2\xe6\xfa\xee\xe9\xd3\xbc\x56\x95\x71\x33\x43\x2f\x6a\x83\xfc\xef\x28\x0a\xe3\xcb\x2f\xa1\xf2\x6f\x69\xaf\x82\xc6\x8c\xa6\xd9\xcc\xd8\x35\xf6\xe4\x4e\x02\x52\xd6\xce\xe2\xef\x83\x5b\xdf\xb3\xf6\x06\xa4\x75\x4f\xe0\x2f\x29\x7e\x9e\x5f\xc8\x74\x0a\x19\x5e\x9b\xeb\x63\x68\x2b\xf7\x4b\x1c\xdd\x0c\xd5\xe4\x69\xb6\x0c\x51\x26\x99\x97\x71\x02\x1a\x7c\xd7\x8d\xc3\xd0\x59\xa4\xfe\xa8\xf8\x32\x36\x68\x0e\x50\x9a\x2e\x1c\x17\xf8\x7e\xf4\x8c\x4b\x03\x30\xe0\xe0\xff\x74\x5d\xd7\xac\x61\x9f\x95\xa8\xaf\xe2\x28\xdb\xbd\x72\xe6\x41\xb8\x1c\xed\x27\x81\x13\x82\x6a\x74\xa2\x74\x37\xf5\x93\xe0\x6a\x4c\x72\xd3\xe0\x5f\xfe\x68\xef\xeb\xc5\x2d\xfd\x79\xe3\xe3\x36\xce\x28\x42\xd2\xc3\xf1\xc2\xf1\x70\x31\x3e\xda\x7b\xb6\xb8\x35\xbe\x01\x18\x46\x6b\x8a\x15\x8c\xd2\x38\x0c\xbc\x22\xe9\x26\xf0\xb2\xd9\x68\x0f\x60\xd0\xe7\x7f\x15\xc6\x37\xa3\x59\xe0\x79\x7e\x34\xbe\x01\x80\xdd\xcb\xc4\x77\x3e\x14\x68\xab\x16\xb3\x16\x8c\xd9\xd7\xe7\xcf\x9f\x8f\x2f\x1d\xf7\xc3\x34\x01\xe3\xc1\x2b\x00\x60\x2e\xc3\x7f\xe3\xcc\xbf\xcd\x76\x9d\x30\x98\x46\x23\x17\xec\x56\x3f\xa9\xb7\xdb\x23\xed\x3e\xf1\xbc\xef\x83\x24\xcd\x7a\xb7\xff\x33\x6d\xec\x67\xda\xce\xab\xff\xc6\xff\x74\xdb\x79\x78\xed\x47\x7f\x9d\x51\xc5\xd6\x7e\xae\xc3\x7a\xd5\xde\xca\x52\x1d\x1d\x40\x25\xd4\xce\x4e\xcf\x50\x1c\x5a\x69\x5d\x41\x72\x22\x79\x6e\x99\x5e\x70\x6d\xee\x18\xe7\x0d\x65\x00\x59\xb3\xe7\x90\x63\x22\xaa\x20\xcd\x02\x37\x35\x25\xdb\x18\x00\x46\xa4\x2e\x40\xfe\x6e\xd0\x2e\xe1\xc5\xf0\x9d\x0c\x75\x51\x2e\x31\x55\xb9\x05\xc4\x4c\x40\x3b\x2b\x71\x9a\xa6\x62\xeb\xa5\xb3\xe0\x8f\xfe\x72\xe5\xb2\xef\x9d\x30\xf7\x57\x2e\xfd\x26\xb8\x5e\xbd\xf0\x11\x71\x29\xac\x5c\xfc\x74\x99\x66\xfe\xdc\x1c\x48\x4b\x5f\x28\xb0\xea\x0d\x91\x27\xd4\x59\x53\x15\x58\xf7\x01\xae\x57\xbb\x28\x57\x60\x41\x04\xd4\x76\x2d\x3d\x3c\xeb\x22\x2a\xbd\x3f\xeb\x22\xaa\x3c\x54\xeb\x62\xe2\x1d\x4c\xeb\xe2\x2a\xbd\x3d\xf7\x35\xd6\x95\x06\x21\x8c\x06\x62\x73\x95\xb1\x26\x92\x19\x10\xf0\xc6\x74\x35\xf0\xd4\xea\xdc\x28\xda\xd2\x81\xb9\x59\xb4\x95\xcb\x75\xb3\x78\x79\xff\xe9\x66\x31\x97\xbe\xc4\x81\x8a\x63\x1e\x75\x24\x5d\x0c\xd4\x86\x7b\x5d\x1b\x81\xf6\xad\x27\x95\x2b\xe0\xc2\x33\xc8\x16\xbc\xf4\x27\xb7\x02\x2e\xf2\x57\x5a\x01\x7b\x0e\x68\x25\x27\xf5\xb7\xb2\x0a\xe6\x8a\x7a\xcf\xe1\xff\x6e\xf0\x3c\x0b\xc2\x21\xd9\x4f\x77\xc9\xae\x68\x8f\x35\xb6\x04\xa2\x58\xde\xd7\x57\xe1\xce\x14\x98\xc6\xb0\xc8\xc7\xdf\xc3\xd8\x75\xc2\x1e\xcb\xed\x57\x45\x8f\x35\x96\xdc\x65\xce\x64\xaf\xbe\xf0\x9e\x2c\x80\xb6\xe0\x16\x8d\xac\xe1\xc4\xf1\xe6\x41\x54\xf5\xfc\xb0\x66\x94\x4d\xd0\x09\x99\x05\x73\x9f\x32\x25\xd8\x33\x50\x0c\x3a\x0f\x93\xec\x2b\x92\x66\x99\x8f\x7f\xdd\x7d\x3c\xdf\x7d\xec\x19\x8f\x7f\x18\x3d\x7e\x3b\x7a\x7c\x6a\x0e\x24\x46\xcf\x84\x82\x03\x61\xbe\x45\xdd\xd1\x35\x7b\x07\xeb\x2b\xaa\xc3\x2d\x50\xff\xc6\xa8\x80\x8d\xa1\xb1\x67\x3f\xf3\xff\x26\x5f\xb6\x36\xc9\xb4\x8a\x14\x29\xe3\x57\x44\x11\x3b\xe8\x07\xe8\x10\xab\xe8\x83\x1d\x6a\x1b\x29\xac\x31\x93\xef\x2b\x58\x17\xc3\x08\xc3\xa8\xfc\xf2\xee\xe8\x20\x9e\x2f\xe2\x08\x06\xb1\x44\x84\x8b\x67\x53\x05\x44\xeb\x90\xd1\x26\x8c\xab\x3a\xd6\x48\x68\x46\x09\x6f\xa9\x03\x68\x3c\x1a\xe3\x81\xdd\x5a\xdf\xe0\x6d\xa9\xa2\x6e\x8c\xda\x18\xd1\xe7\xa7\x99\xf5\xbb\x91\x27\xe1\xa8\x60\xa6\x1d\x63\xee\x03\x3f\x7b\x23\xc3\x7c\x7d\x78\x06\xc2\x0d\xcc\xe0\xc4\x71\xb3\x91\x11\xc5\xd1\x3f\xd2\x38\x3a\xc4\x1d\xf0\x14\x44\x88\x52\x2c\xd2\x08\x9a\x8a\x12\xba\xe3\x9e\xaa\x08\xe2\x88\x62\x90\xb6\x27\xce\x86\xc6\xd6\xb8\x64\xff\xfd\x6e\xd0\xe6\xb8\x29\xbb\x95\x8b\x88\x78\xed\x67\xc7\x24\x02\xaf\x2d\x0e\xa2\x11\x76\x81\xa4\x55\xc1\x0a\x20\x0d\xcf\x2f\xc6\x8f\x5a\x42\x8d\x64\x35\xeb\x04\x5f\x89\xc3\x2d\x8b\xf0\x92\x54\xc6\x28\x7d\xd5\xec\xbe\x2a\x26\x40\x20\x6a\xb2\x67\xf3\x1c\x2a\x29\xc8\x33\x71\x4f\x1e\xb6\x26\x18\xe1\xa8\x0a\x1a\x99\x90\x78\x57\x1a\x63\xa1\x8a\xce\xc2\xf2\x18\x55\x87\x9f\xe3\xad\xcc\x06\xe2\xd1\x4b\x42\xac\x84\x09\x53\xe9\x24\x9f\x54\xf4\x28\x62\x3f\x54\xd3\x0a\xfe\xe8\x4f\xa9\x55\xd9\xfa\x3e\x78\x4b\x52\xd7\x2b\x3f\x73\x68\xd0\x48\x7b\x5d\x44\x1f\x5c\x96\xf1\x34\xc2\x14\x92\x87\xf9\x20\x34\x8b\x03\xea\x10\x63\xc5\x9a\xda\x3c\x8e\x0d\xc2\x0a\x18\xec\x8b\x8a\x78\xe9\x67\xb6\x39\xe8\x8a\xab\xa9\x2a\xb4\x89\x20\x2f\x1d\xab\x50\xfd\xb3\x3e\x75\xb3\xd2\x7a\x35\x56\x08\x8a\xe5\xfc\xba\xeb\x76\x93\x58\x77\x3a\x4b\x56\x93\x6e\x1c\xa5\x5a\xb0\x47\x91\xe7\xdf\xfa\x7a\xb0\xef\x70\xc3\x54\x13\x34\xbe\xd1\x03\xd4\x59\xe1\xcc\x44\xbf\x09\xee\x95\x85\xbe\x8b\xd1\x68\x4e\xd6\x73\x11\x5e\x71\xc1\xdc\x59\x70\xd2\x43\x6a\x47\x28\x06\x34\x21\x0b\x02\x58\x48\x31\xd7\x8c\x4d\x84\xd8\x5d\xdb\x20\xf2\x6b\x09\xf8\x74\xce\x67\x30\x25\x5f\xa0\xbd\xc1\x5b\x35\x97\x04\xd3\x0e\x87\x95\xd8\x25\x17\xa4\x3e\x37\x8e\xae\x82\x29\x86\xc1\x26\x71\x9e\x91\xfa\x38\xb8\x96\x1e\xe4\xeb\xa6\x25\x52\x90\x9c\x73\xc7\x76\x29\x9b\xb0\xf9\xb0\x0a\x86\x80\x32\xcf\x2a\x18\xb8\xcd\xf7\x7e\x35\x93\x55\xcb\xff\xf0\xbf\xa0\x43\x6e\xd8\x1e\x3f\x08\xe0\x5d\x73\x25\x7c\xfc\xa2\x88\xdf\x1f\xb1\xf8\x8a\x50\xfc\x4c\xd0\x95\x39\x58\xa3\x26\xde\xb8\xe6\x91\xbb\x05\x57\x63\x1c\x01\xc3\xaf\x44\x7f\x31\x50\x19\x4e\x8f\xba\x40\xdb\x2d\x8a\x3e\x06\x05\x67\x4f\x88\xe6\x44\xb1\x36\xd5\xb1\x25\xa8\x57\x75\x52\x99\xf4\x6b\xdb\x14\x5e\x45\x5e\xf9\xfd\x73\xb1\x3f\xb4\x16\x24\x9f\xa8\xad\x42\x46\xf2\x3e\x0c\x95\x7a\x45\x7d\xac\x94\x8c\xb1\xa6\xb6\xa1\xc2\x0a\xdc\x9b\xad\x82\x44\x52\xf1\x8a\x54\x72\xd2\x76\xfc\xa8\xab\x56\xb5\x89\xd1\x6a\x83\x08\x76\x48\x21\xb3\xc0\x88\x98\xcf\x81\xf2\x11\x08\xb9\x52\x90\x31\xb1\x4f\xa9\x72\x29\xc0\xe0\xa2\x43\x7b\x8b\x68\xcf\xce\xde\x18\x56\xea\x83\x36\xf3\xd2\x81\x1a\x79\x96\x85\x13\x06\x85\x4b\x30\x94\x85\xfd\xea\xa1\xb6\x8a\x50\x41\x53\xf1\xf4\x44\x09\x36\x8d\x04\xa1\x8e\x46\xea\x57\x11\xda\x44\x2d\x15\xad\xa6\xaa\x7a\x92\x20\xb7\xb6\x5a\xa8\xea\xa3\xd6\x2e\x7a\x7b\xd3\xe9\xde\x59\x87\x61\xdb\x9f\xd3\x89\x65\x89\x3e\x82\xa2\x5d\xd4\xd2\x06\xa1\x22\x24\xa0\xe8\x25\xc7\x16\x9d\xb4\x9e\x3e\x27\xfb\x48\x6d\x9d\x5b\xb3\xb6\x44\xbb\x93\xa6\xb6\x19\x9e\x6a\xe3\x93\x96\xd5\xb4\x3e\x79\x7b\x84\x2b\xd8\x61\xc1\xf0\xa5\xf8\xc0\x29\x2e\x36\xed\x9c\x61\x43\x49\x7c\xd1\x03\x1d\x2b\xe6\xd2\xae\x9d\xe0\x19\xce\x95\x4a\x13\x19\xd4\x5a\x4e\x65\x2b\x29\xec\x25\x2d\x4e\xec\x58\x36\x6d\x8a\x13\x7f\x89\x82\x8f\x79\x9d\x17\xd9\x1c\xd0\xe0\xb9\xc2\x3e\x17\x79\x8e\xa4\xee\x18\xc1\x6a\x5c\x47\x4a\x53\xcc\x2b\xb0\x5e\xa3\xf4\x53\xa0\x87\x2c\xc5\x41\x84\x98\x86\xb5\x48\x82\xb9\x93\x2c\x07\x26\x4a\x0a\x73\xd0\x83\x23\x78\xcc\x39\xe9\x36\xc4\xb8\x84\x31\x42\x54\x51\x6c\xf6\xc0\x45\xbb\x88\x4d\xd9\x09\x3d\xc5\x0b\x5a\x88\xa6\xe2\x0e\x1a\x72\x6b\x4a\x7d\x83\x03\xfb\xb7\x38\x88\x2c\x1c\x9a\xc1\x16\xf8\x70\x45\x73\x9e\xdb\x0a\x6a\xb7\xe5\x0b\x53\xbd\x56\xa0\xe4\x16\xc1\x28\xb4\x6e\x67\xc9\x8e\x11\x2f\x94\x01\x10\x90\x4f\xc4\x7e\x9e\x1a\xdf\x19\x5f\x3d\xc3\x41\xc5\x20\x59\x1b\x0f\x7e\x47\xd3\xe0\x6a\x89\x18\xd0\xe0\x02\x4b\x36\xf5\xcf\xc0\xf4\x44\x95\x50\x4f\xab\xbb\xda\x81\x7a\xde\x87\xc9\x04\x51\x95\xc4\x6d\x6a\xf1\x70\xfd\x36\xb6\x16\x0e\xd8\x0b\x5b\xdf\xd7\x92\x6c\x9f\x95\x27\x9b\xf7\x71\x7f\xe7\x7d\xe0\xdf\x88\x5b\x49\x55\x72\xcf\xed\xa4\xfa\x66\x92\xc7\xf5\x0d\x2e\x66\xea\x5d\x66\x73\x5b\x13\x72\xf7\x70\xfa\x93\x70\xa2\x42\x56\x29\x42\xc8\x76\x8b\x0e\xf0\x04\x3c\x9a\x02\x49\xfb\xba\x50\x0c\xbe\x29\x0b\xb5\x2e\xaf\xda\x17\x85\xfc\x99\x41\xe9\xea\x42\x04\xc2\xad\xe1\xe4\xda\xc1\x05\x5b\xea\x67\x47\xec\x97\x48\x71\xb9\x97\x55\xc7\x8b\xc2\xb0\x22\x9a\x1d\xf1\x3d\xbc\x86\x3f\x6f\x4f\xb5\xac\x7f\xae\x70\xb5\xbe\x99\xe8\xae\xa4\x88\x87\xab\x1c\xce\x96\xe6\x4a\x4e\x4b\x4b\x6b\x8e\xa3\x3c\x0a\x63\xc7\xd3\xaa\xd9\x0d\x7d\x27\x29\x3b\x4c\xec\xcd\xde\x34\x08\x5d\x47\x62\x8f\xe1\x9f\x72\x09\x54\x15\xac\x09\xc7\x41\xbd\xf5\x65\x33\xdc\x76\xde\x62\x88\x71\x9e\xf0\x5c\xa8\xb5\x05\x85\x73\xc0\x76\x79\x86\x77\x15\xf4\x95\xd4\x5c\xc3\xfc\xb6\xdc\x2c\x09\x5b\x68\xe9\x5e\xe7\xcd\xbe\x42\x35\x54\xc9\xc0\x37\x60\xc2\xb7\x98\x28\x79\xd8\x6a\x9f\x54\x9c\x54\x6c\xa4\x59\x83\x9a\x29\xa1\x72\xd5\xc8\xa9\x0f\x83\xca\x8c\x28\x4c\x87\x8e\x46\x09\x14\xdb\x59\x92\xa7\x99\x65\x7e\x19\x5d\xa6\x8b\xf1\x97\x97\x78\xf0\x96\x7e\xd7\x54\xee\xcc\xef\xda\xbd\x2f\xdc\xed\x7b\x25\x10\x1d\xea\xbe\x55\xdf\xef\xac\xac\xf0\x5b\x98\x0e\xd9\x08\xd8\x0d\x3f\x04\xe5\x2f\x40\xf1\xdb\x80\xb6\x98\x83\x2a\x52\x95\x27\x3b\x95\x51\x40\x74\xea\x85\x6d\xa9\x85\x36\x06\xec\xa1\x1a\x38\x97\x61\xab\x66\xb4\x5a\x7c\x6e\x7f\x0e\xf5\x21\x74\xc7\x83\x0a\x59\x47\x85\x20\xff\x31\xff\x32\x13\x1c\xf6\xc2\x49\x1c\x94\x78\xac\x7f\x65\x1e\x41\xb9\xe2\x91\xf0\x9d\x5c\x0e\x6c\x5a\xf7\xac\xd5\x88\xbe\x1a\x8b\xae\x42\xda\x56\xd5\x5d\xba\xa2\x5c\xa0\x17\x28\x61\xed\x07\x72\xbc\xc3\xd5\x81\x8d\xaf\x98\x9e\xb9\x93\xad\xc1\x66\x25\xb4\x96\x80\x56\xca\xe7\x16\xf1\xac\x94\xce\xa4\x37\x95\xa2\xb9\xcc\xdd\x86\x5c\xae\xb6\x73\x36\x24\xa1\x0b\xbf\xbd\x54\x3c\xd3\x3d\xa4\x5a\x9d\x7f\x7a\x29\xcd\xfc\xd9\x0f\x22\x7a\x3d\x11\xcd\xe9\xfe\x1e\x12\x4e\x2d\x17\xa9\xe3\x4f\x5b\xb2\xb7\x72\xad\x64\x83\x6d\x1b\x02\xfe\xbe\xbb\x40\x57\x2f\x50\xe7\xd4\x29\x71\x66\x6e\x40\x29\xb4\x2f\x31\x68\x6d\xa0\x2f\x3a\x37\xf5\xb5\x97\x11\xc2\x6e\xae\x72\x29\x51\x42\xb5\x57\x6c\xda\x85\x26\x6b\x89\x0a\xe8\xd0\x71\x54\x64\x6c\x58\xc1\x55\x6c\xa9\xd2\x6e\x25\x84\xa0\xda\xaa\x54\x51\xaf\xf1\xd0\x95\x52\xab\x39\x01\x2b\xaf\x58\xcd\x11\x28\xcd\xe0\x9c\x81\x55\x7e\x0d\x5c\xe6\x14\x24\x71\xe5\xee\xcc\x09\x22\xbc\x7b\x6e\x85\xbb\x91\x34\x6e\x2c\xac\x64\x15\xd6\xf3\x13\xde\x71\x47\x54\xce\x0e\x5e\xed\x53\xf7\xe1\xc5\x97\xbf\x61\x07\x3b\x61\xe3\x8e\xa8\x0a\xfb\x35\x9e\xab\x92\x9d\x9a\x26\x19\xc6\x8b\x17\x2f\xc8\x3d\x96\x57\x78\x31\x63\x8b\xbb\x01\xaa\x6a\x1b\xf5\x92\x92\xdc\x97\xc7\x79\x8b\x0a\x9d\xe9\x81\x3b\xee\xd6\xaa\xb2\xbd\x28\xc3\x8a\xef\xba\x77\x56\xd1\x91\xc1\xfb\x2f\x93\x6c\xcb\xe3\x32\x0f\xc2\x30\x38\x8b\x8f\x31\xb6\xdd\x22\x3f\x04\xd7\x6a\x21\xd3\x48\x86\xf1\x5f\x34\xf6\xbd\xd9\x5a\x1e\x0b\x4a\x4c\xee\x67\xcf\x7b\xba\x30\x54\x1e\x8f\x56\x16\x4e\x6a\xe3\xec\xe4\xd5\x89\x35\x4f\x30\x8c\x63\x39\x18\x01\x41\x44\x2a\x67\x31\x46\x7a\x24\x81\x8b\x60\xf6\xaa\x67\x21\xd6\x73\x77\x37\xe6\x8f\x06\x74\x39\xa6\xf5\xe3\x17\xba\xe3\xaa\x75\x1c\xe3\x2d\xed\x9a\x86\xf7\x9c\xa5\xd7\xe7\x5d\xea\xe3\x26\xb6\xd4\x8e\xa6\x59\x32\x23\x7a\xff\x7a\x7a\x4a\x32\xe3\x3e\x56\x34\x57\xca\xa2\xc3\x3c\xd9\x90\x0d\xcd\x61\x23\x86\x6f\xf9\xab\xcd\xee\x0e\x32\x62\x77\xd7\xa6\xac\x45\x25\x54\x1d\x6d\x9b\xd1\xcd\x42\x91\xb4\x8c\x4c\x6e\x5e\xb5\x3b\x17\xb1\xd2\x11\x33\xd1\x39\x3a\xda\x35\xa9\x53\x5e\x17\x32\x12\x77\xb5\x6b\x97\x89\xd8\xfb\xef\x5f\xab\x31\xdd\x29\x22\xf0\x7a\x84\x07\x73\x43\xdd\x65\xd1\x32\x7e\x7a\x87\x67\xbd\x56\xe2\x29\xbe\xe4\x03\x5f\xfd\xe1\x7c\x45\xae\xc9\xd9\x36\x73\xf1\x63\xae\xbd\x64\x82\x72\x8c\x3f\x3a\xf6\x45\x9a\x82\xaa\xd5\x4c\xa4\x72\xd2\x86\x52\x68\xbe\x5d\x4f\xd5\xd5\x23\xd9\xda\x24\x48\xf8\x5a\x93\x0c\x2c\x49\x49\xc1\x6f\x82\xc1\x4a\x81\x70\xaf\x99\x69\x50\x96\x40\xb6\x90\xc5\x24\x99\xe3\x05\x35\xb4\x54\x57\x90\x53\x6e\xaa\x48\xd8\x77\xbe\x4b\x16\x0d\x79\x42\x6e\x1b\x6f\x69\xba\xc2\x29\x80\x76\xc1\xc2\x89\x46\x9a\x13\x01\x29\xf5\x23\xef\xac\x76\x86\xaf\x65\xd7\x82\x68\xc2\xcc\x49\xb2\x7a\x19\x86\xc6\x9e\xfa\x24\x0b\xaa\xdd\x35\xca\x76\x8c\xbb\xa6\xe2\x79\x89\xb3\x42\xb0\x63\x34\x70\x5e\x28\xe6\x44\x97\xcf\x41\x04\x20\xc7\x21\x69\x57\x43\x0b\xe8\x17\x61\xec\xd9\x99\xc6\x62\x98\xc9\x4f\x7e\xdc\x59\xbe\xe2\x8a\x2a\xcd\xa8\x67\x02\x6b\x4d\x52\xc6\xb8\xe9\x3a\xf1\xce\x25\x12\x8c\x31\x2e\x7f\x28\xa0\x0b\x26\x51\x8b\xde\xaa\x7f\xac\xbd\x67\x60\xcf\xfe\x0d\xff\xa0\x63\x46\xb6\x9f\xa5\x21\xcb\x4d\xda\x2f\x67\x08\x64\xaa\x50\xf8\xb7\xbe\x9b\x93\xe9\xa8\x17\x51\x4d\xda\x2a\x34\xc8\x1a\x70\x5f\xc7\xca\x82\xa0\x2d\x94\x77\x92\x13\xf1\x80\xac\x58\xdc\x46\xda\xb4\xdc\xad\xf4\xfc\x59\xdb\x2a\x1b\x98\xb6\xb5\xf0\x5e\x5b\x61\x74\x86\x05\x78\x93\xea\xf9\xc5\xce\xa3\x1e\x5a\x01\x9f\x1a\xb0\xb0\x71\x01\xb9\x3d\x1e\x3e\xbe\x2d\xbc\x96\x25\x3b\xb0\x33\x1a\x90\xf9\xf4\x69\xbb\x76\xfc\x68\x33\x3a\xec\x45\x9e\xce\xac\x3a\xa2\xf3\xe0\xa2\x8c\x2a\x57\xed\x70\xde\xb5\x05\x49\x13\x76\xb0\xbd\x00\x46\x2a\x73\x67\xec\xea\x77\xc0\xd8\xed\x3c\xbc\x6b\xde\x4f\x57\xc3\xd3\x7e\xed\xba\x18\x8e\x6f\xe2\x5b\x21\xa4\x94\xa9\x76\x54\xb5\x07\xd0\xff\x74\x72\xaa\x8e\xa0\xa7\x8e\x9d\x91\xf1\x51\x15\xdc\x55\x3f\x45\xab\xbc\xf1\x9a\xdc\xbf\xef\xd9\x1a\xa7\x6c\x4b\x20\xbc\x93\xed\xa2\xcf\xe0\x94\x25\xf1\xa8\xf8\x21\xac\xa9\xf8\xe3\xbd\x6d\x55\x12\xe2\x12\xb2\x7b\xb2\x88\x83\xa8\x9d\x3e\xd2\xb5\x1c\x6c\x0b\x99\x6a\x52\xef\xda\x0f\x3d\x78\x1a\xe7\x16\xa4\x1e\x8b\x9f\xc5\x97\x1d\x44\x13\xa9\x90\xfe\x85\x90\xaf\x01\xf3\xb7\x4f\x53\xd9\x2e\x5b\x10\xf2\x92\xba\xde\xee\x72\x12\x4f\xd8\x2c\x9e\xe0\x34\x76\x92\x69\x8e\x11\xae\xd5\xfc\x9d\x28\x26\x70\x35\x41\xa1\xe0\x2e\xbd\xc3\xb3\x2c\x0d\x69\x17\x6d\x73\x89\x33\xa9\x28\xf5\x95\x62\x92\x9d\x7b\x2f\x3a\xa3\x68\x2c\xbd\x7f\x59\xd5\x25\x9f\x48\x08\x21\x23\xba\x0a\x20\x2c\x12\xb8\xf0\xc1\x0a\xa6\x5f\xf0\xa0\x5b\xb8\x7c\xd3\x61\xe5\x73\xf9\xcc\xee\xc5\xa8\xa2\x13\x05\x07\x53\x19\x9e\x58\xba\xb5\x45\xd7\x4a\x95\xbc\x86\x7b\xe5\x4d\x10\xf9\xaf\x13\x67\x31\x93\x5a\xcd\x65\xee\x36\x76\x2a\xaf\xe7\x1b\x58\x01\x5f\xcf\xd1\x17\x3a\x6f\x81\x00\xab\x28\x21\x8f\xc3\x5c\xdb\xb4\x97\xed\x10\x5a\x75\x80\xa9\x56\xcb\x45\x0b\xb7\x35\xfd\xc0\xcf\x54\x62\x79\x7b\xd4\xea\xc9\x9c\xf9\x62\x52\xbb\x7d\xa3\xf5\x06\x87\xa5\x02\xb1\x67\x53\x97\x6e\x6b\xe1\x3c\xf5\xc9\x0e\x20\x28\xbd\xe0\xda\x7f\x9d\x07\xd0\x20\x68\x8d\x95\x25\x79\x4b\x50\x94\x9d\xce\xe2\x9b\x37\xfe\x14\x6c\x24\x1d\xc8\x5f\xf7\x6f\x83\x54\x07\xf0\x7f\x75\x00\x6f\x4f\x5d\x07\xf7\x88\xd9\x7d\x28\x29\xf9\x35\x68\xf3\x2b\x78\x89\x73\x43\xb8\x4e\x30\x2a\x40\x2e\xa2\x44\xdd\x31\x82\xf4\x28\x0a\xb2\xc0\x09\x83\x7f\xf9\xde\x0e\xd9\x84\x23\xb2\xa8\x43\x53\x0a\xc5\xba\x54\x25\x30\x8b\xe3\x79\x84\x08\x66\x87\x11\x36\x1a\xf4\xd5\x98\x45\xed\xcc\x9f\x31\x8b\xf3\xd0\x7b\xe7\xe3\x5b\x56\xb8\x45\x02\xdd\xd0\x41\x07\xce\x84\x2b\x76\x5d\x8b\xc7\x1e\xa0\x69\xd3\xdb\x62\x7d\xd7\x73\x62\x51\x2e\xcb\x83\x76\x5d\xd5\x31\x75\x28\x54\xa7\x40\x55\x5a\x2d\x62\x5c\xa2\xa7\x53\x85\xb6\x33\x48\xec\x0a\x98\x1d\x60\xa2\x7b\x9c\x15\xb3\xa3\x5d\x9a\x84\x3e\x7a\x76\xb7\x2b\x49\xd8\xb9\x23\x57\x16\xf2\xdb\xe6\x24\x25\xb5\x3c\xdd\x23\x3c\xa5\x83\x2a\xf1\xc1\x20\xc5\x99\xa2\x5f\xe6\x0a\x16\x30\x27\x78\xaf\x6b\x06\xa4\xdb\x7b\x7a\x05\xef\xc6\x9d\x60\x77\x83\x76\x98\xbb\xd6\x5c\x98\xc7\xd4\x44\x29\x26\xe4\xa0\xb3\x42\x1c\xb0\x7c\x6e\x09\x8c\xa5\x51\x0a\x4c\xfb\x28\x0d\x90\xad\x80\xdb\x0a\x9f\x86\xf5\x0d\x2c\x87\xbb\xcb\x82\x90\x09\xd7\x9a\xb8\x77\x5d\x1a\xc5\xbe\x45\xe1\xd7\x72\x51\x4f\xe0\x7e\xa0\x47\x04\xad\xda\x55\x50\x4f\x1e\x1f\xd1\x1b\xa0\x9e\x0c\x3a\xe4\xeb\x5b\xe7\xf6\x6d\x00\x0b\x15\x27\x4c\xfd\xd5\xe3\x5b\xea\x52\x47\x7b\xa9\x5f\x3c\xae\x24\x0a\x00\x92\xaa\x5a\xe8\xa3\xec\x29\x9e\xb4\x2a\x8a\xe1\x13\x0b\x87\x98\xd6\x26\x1a\x1a\xc0\x50\x6f\xed\x19\x26\x85\x04\xc1\x49\xb5\xc2\x62\x98\x74\xea\xea\x91\x3b\x3a\xef\x81\xd5\x09\x95\x75\xa3\xf8\xf4\x97\x1e\x15\x54\x08\xd1\x3b\xc6\x88\x3a\xb5\xf1\x6d\x86\x69\x9c\x2c\xf7\x9e\x59\x83\x2d\x05\xf1\x10\xdb\x49\x3f\x5e\x9f\x82\xb7\xad\x7c\x4a\xdb\x72\x93\x31\x35\xe4\x1d\x2b\x8c\xb7\x60\xe3\xa3\x56\x79\x55\x40\x0c\xb1\x07\xa7\x48\x09\x7f\xdf\xa0\x49\xef\xc0\x05\x71\xb3\xb8\x1d\xcf\xe8\xa5\xd7\xcf\xc9\x0f\x93\x45\xcf\xa7\xd7\x53\xbb\x2a\x56\x84\x97\xd0\x88\xc6\xd2\x82\xb9\x1b\x68\x4d\x5c\x30\x4b\xfd\x4e\x42\x31\x30\x07\xa3\xc5\xf0\xb1\x2c\x5b\xe3\x50\xbb\xaa\xbf\xa5\xc1\x22\xe2\xb8\x93\xab\x0d\x2d\xc2\xa9\x3b\xa8\x3b\x55\x0e\x1d\x62\x7e\xff\x6e\x94\xb3\x16\xdf\x11\x22\xde\x34\x50\x59\xd4\x2f\x74\x27\x3f\xdc\xaf\xc0\x49\xf0\xce\xf1\xa4\x1f\xe0\x85\xbf\x7d\x6e\xfc\xb1\xcb\xb5\x60\xb5\x6e\xd9\x01\x6c\xba\x5c\x48\xda\x8c\x1c\x48\xbe\x08\xee\x88\x12\x8a\x73\x4f\x57\x69\xbc\x8f\x9a\x87\x14\x42\x68\xaa\x05\x6e\xb5\x6e\x13\x16\xc2\x92\x64\x6e\x31\x5c\xe5\xd6\x80\xd5\x27\xea\x08\x6f\xfe\xb9\x56\xc3\xaa\x02\x52\x2f\xc0\x6a\xc7\xfb\xb8\xc5\x30\x07\xdb\x58\x04\x93\xf7\xd1\x54\x01\xbe\xaa\xd8\xde\xb9\xc2\x11\x32\xfe\x14\x0f\xed\x71\xfb\x15\x73\x6e\x03\x46\xb1\x43\xd1\xb6\x49\x9d\xcf\x0b\x17\xf1\xbc\xe6\xbe\xb2\xe6\xc2\x96\x1e\xee\x0d\x5a\xa6\x9b\xd8\x11\x74\x0f\xb1\xe1\xb0\xb4\x8b\x8f\x2b\xda\x7b\xe6\x80\x6e\x77\x58\xe6\x29\x4d\xba\xca\x43\x83\xc0\x90\x53\xda\x9d\x88\xc8\xf3\x82\x3c\x1a\xe2\x16\x2b\x30\xb4\x5d\xb9\x58\x6c\x6e\x08\xdd\xd2\xba\x31\x0e\xf2\xa3\x47\x93\xc9\xae\xab\xba\xd9\x1b\x25\x2d\x8b\xa7\xd3\xd0\x6f\xae\xae\xdb\x6c\xb3\x72\xf1\x7a\x83\x94\x22\xeb\x7c\x51\x4b\x1a\xeb\x2d\x7e\x29\x70\xd7\x0a\x91\x82\xcf\x9d\x08\x66\x51\x52\xbe\xeb\xca\xb0\x94\x5d\x5b\x6c\x5c\xad\xb4\x24\x6f\x51\xb7\x5a\x54\x14\x2c\xbd\x16\x11\x62\x0d\x1d\x47\x64\xdb\xd6\x23\x0c\x85\xea\xa6\xa4\x3e\x14\xf7\x21\x89\xc0\xf6\x8f\xe7\x6f\x62\x47\x7b\x8a\xc4\x9f\xaf\xbe\xc6\x59\x37\x90\xbe\x5f\x1c\xfd\xa7\x74\xf6\x75\x1b\x51\xe9\x97\x79\x96\xc5\x91\xe8\xcd\x6f\x58\xd8\x9d\xf3\xb9\xc2\x42\x5e\xfb\x02\x70\xe3\x2c\xce\x60\xd9\x65\xae\x69\x11\x4b\x10\x13\x42\xcc\x7e\xf7\x43\x52\xe3\x1d\xfb\xaf\x2d\x1e\xfe\x07\x72\xa8\x89\x0a\xcc\x57\xfe\x5c\x79\x9b\x45\xd3\x7c\xb3\xeb\xb6\x25\xed\x37\xc6\xff\xdb\x45\x43\x57\x0b\xf0\x37\x88\x16\x79\x76\x8e\x73\xe4\x05\xed\x36\x12\x0c\xff\xa8\xd5\xc9\x36\xe2\x7a\x58\xed\x75\x8a\x23\x37\x0c\xdc\x0f\x6c\xc5\xc3\xe9\x15\xc5\x0e\xba\xec\x4a\x91\x8b\x6e\xd6\x56\x45\xb6\xb3\x30\x75\xda\x31\x65\x2c\x3b\xf7\xb3\x66\x7f\x17\x2a\x8f\x8b\x52\xaf\xac\xf7\x46\xe2\xaa\x61\xeb\xd4\xf2\x9e\xc7\x51\x80\xd1\x66\xeb\xd9\xde\xbd\x4d\xd7\xb7\xb4\xda\xe6\xd6\x0f\x4d\x5f\xcb\x7c\x55\x48\xb0\x0d\x09\xa3\xf6\xb3\xf7\xf4\x5c\x21\x6b\x85\xf1\x53\xe8\xb8\xfe\x2c\x0e\x3d\x3f\x31\xd7\x66\x1f\x86\xb4\x62\x20\x21\xa1\xbe\x84\x63\x14\x08\x4c\x54\x24\xd6\xd8\xa8\x82\x5d\x8d\x91\xb8\xf7\xee\xf2\xcf\x70\x4b\x53\x75\x91\x7f\x8f\xe7\xf4\xb6\x15\x7f\xce\xbf\xdc\x59\x7b\x7e\x2f\x4f\xa5\xef\x5a\x4f\xd8\xcb\xa5\x41\x18\x64\x4b\x2b\x6d\x00\x16\x6a\x91\x0d\x96\xe4\x29\x56\xd5\x8d\xc7\xc5\x7d\xf9\x60\xfd\x3c\x36\x35\xc2\x04\xc8\xf3\x75\x57\x61\x8c\x01\x97\xb4\x32\xf9\x8b\xab\x43\x43\x4d\x0b\x59\xb0\xc1\x22\x28\x3e\x25\xfb\xfa\x16\x39\x22\xc5\xd7\x2e\x7b\x01\xa0\x7a\x01\xf6\x13\x6b\xbf\xe2\xe1\xda\x0d\x75\xc0\x9f\xf8\x75\x07\x3a\x15\xe2\x44\xfb\xf9\x04\x0a\xbc\xb5\xb7\x13\xf8\xf0\xb0\x09\x1d\x3c\x90\x7e\x58\xe5\xd0\xdc\xe2\x55\xab\x9b\x7c\x69\xa1\x38\xd0\x49\xe4\x79\xdb\xbe\x05\x7b\x89\x41\x12\xf0\x25\x9f\x5c\xf2\x68\x60\xe8\x9b\x23\x5c\xf0\x30\x4e\xf7\xfc\x14\xdf\x34\x86\xd4\x49\xe0\xb5\xef\xf0\x21\x91\xe7\x0c\xc1\x45\x89\xa1\xed\xce\x94\xce\x5d\x18\x44\xb9\xa9\x17\x25\x28\xa7\x89\xcf\x49\x9c\x52\x6a\xb5\x5f\x94\x38\xb9\xfc\x0d\xbd\x2b\x1f\xfc\x65\x6a\x49\x6e\x9c\x1d\xd8\x69\x9c\x64\x1d\xb7\xdd\xca\xe8\x78\x05\xbd\x2c\xd0\xc0\xba\xb1\xdf\xe3\x16\x65\xe7\x93\x51\xeb\x47\xc4\xd6\x2e\xf7\x6d\xd4\x24\xbb\xdd\xb7\xa5\xb9\x25\x57\xca\x2f\xf9\x2d\xdb\x2c\x5f\x53\xb2\x92\xf7\x76\xdb\xef\x56\xaf\xf0\x3d\x06\xab\xc3\x38\xf2\x84\x4b\x5b\xb9\xe3\xcb\xe8\xe7\xa3\x47\x97\x49\xab\xe9\xd4\x25\xce\x3f\xfc\x03\xd3\xb7\xf5\xd4\xb2\xa2\xcc\xa0\xe7\x45\xb3\x84\xc6\x63\x3f\xbb\x89\x93\x0f\xe2\x75\xc0\x35\xfc\x8e\xe7\x25\xe8\x8a\x8c\x28\xec\x2a\xd5\xec\x53\x14\x5a\xd5\xb0\xcf\xfe\xd7\xe6\x26\x92\x9b\x72\x79\x8d\x4b\x2b\x4c\x29\x20\xde\x89\xdb\xb3\x8a\x5f\xe8\x63\xf3\xdd\x55\x70\xaf\xd2\xaf\x7f\x77\x32\x45\xb9\xfa\xdd\xc9\x6f\x88\x01\x64\x28\x31\x37\x0d\xa4\x9e\x15\xec\x17\x16\xa8\xba\x0e\xa9\x91\xba\x62\x35\xc4\x08\x17\x07\xa0\x66\x9e\x43\x8d\xbd\x87\x16\x63\x23\x76\xdf\x95\xb6\x64\x9f\xee\xc2\xd7\x97\xe9\x18\x49\x4d\xd1\x9e\x94\x7c\x0f\xd2\x6f\x69\x54\x94\x88\x2d\x15\xcc\x70\xd6\xce\x5e\xd7\xe8\x0a\x37\x58\x4b\x5e\x58\x65\x33\x04\xec\x9c\xbe\xd7\x9f\x36\xb4\xc7\x7e\x18\xf6\xb9\x1e\x9e\x45\x1e\xab\x4e\x88\x70\x3d\x8a\x7b\xcc\x52\x98\x6a\xde\x29\x41\xaa\xd9\xaf\x04\x69\x8e\xb0\x12\x54\x3e\xe2\x4a\x70\xe9\x34\x68\x23\x35\x4b\x6b\xc7\x19\x8f\xfd\x9b\xb7\xef\x0f\x0e\xc8\x90\x49\x82\x57\xef\xc6\x8f\x5a\x6c\x52\xad\x8b\xf7\xcb\x60\xf8\xc2\xbe\x0c\xa8\x6d\xd7\x7a\x24\x08\x41\x4f\x8b\xf1\x13\x8c\xcb\x71\x1b\x17\xd2\x36\xed\xbb\x6e\x3e\xcf\x43\xc6\x89\x79\xca\x6c\xe0\x1d\x1e\xad\x96\x46\x5f\xff\x32\x2c\xca\xae\x9b\xbb\x5a\x5a\x5f\x6d\x10\x0b\x7e\xc3\x7a\x83\xae\x8f\xb7\xa2\x38\x14\x4b\xeb\xcd\x6a\x0e\xb5\x7f\x63\x6b\xaa\x83\xf0\xda\x56\x74\x87\xca\x19\xb1\x6b\xb4\x3a\x32\xb6\xa7\x3d\x8a\xa6\x6e\x54\x7d\x90\x96\xac\xa6\x3f\xd8\x5c\xa5\x6a\x44\x75\x16\x88\x4a\x03\xbb\xf4\x5b\xd4\xa1\x51\x1a\xa1\xc1\xa9\xeb\xd3\x20\xb0\xf7\xea\xd2\x60\xa6\xff\x83\x47\xa3\x59\x11\x76\x4d\xc3\xa1\xc1\x16\x37\x1a\xfe\x0c\x5a\xfc\x13\x74\x67\x10\x26\xab\x3d\x8e\x49\x68\xbd\x57\x67\x86\x84\x8a\x86\x2f\x83\x76\x61\x4f\x57\x06\xeb\xf7\x6e\x4f\x46\x9d\x82\xad\x39\x32\xea\x15\xc9\xcc\x50\x75\x53\x0b\x56\x54\x78\x31\x58\x73\xe5\x4e\x0c\x5a\xee\xcf\xe1\xc3\x60\x42\xd6\x22\x77\x9f\xe1\x02\xbd\xf0\x2d\x96\xef\x38\x3e\x35\x4c\xf2\x90\x90\xd6\x53\x6c\xe7\x35\x1c\xe2\xb1\x91\x16\xb7\x92\x4e\x1f\x9e\x6b\x1f\xaa\xe0\xbc\x30\x85\x77\xb9\x70\xc3\x1c\x69\xb8\x5d\x80\x44\xfd\x03\x1c\x26\x60\x1b\xe8\x9c\xc7\xb8\x18\xac\x74\xb1\x9c\xc4\xd9\x22\x71\xe7\x90\x7e\x27\xb2\x74\x3d\x47\x8e\xcc\x87\xd3\xc4\xbd\x35\xef\x0d\x63\x9f\x2d\x3a\x6f\x48\x0d\x1b\xb5\xc1\x09\xc6\x6d\x58\xe0\x04\xf1\x76\xed\x6f\xea\x93\xbb\x47\xeb\x1b\x2b\xdc\x86\xed\xad\xe8\x2b\xb0\xbc\xe9\xf0\xdc\xab\xdd\x5d\x34\x72\x83\x56\x77\x31\x31\x56\xb0\xb9\xeb\x8a\xf2\x93\x74\xd9\x7c\x3e\xfe\x98\x9d\xad\x3a\x64\x98\x79\xac\xe1\x8f\x41\x48\xd1\x1d\xa3\x34\x5a\xf4\xbd\x31\x15\xd2\x07\x67\xcc\x83\x33\xe6\xc1\x19\xf3\x57\x70\xc6\x10\xfd\xd0\xe1\x8b\x29\xdc\x2d\x35\xd8\x4f\xed\x0e\x92\x52\x1e\x72\xbd\x96\x0b\x37\x90\x94\x10\xab\xbc\x5e\x46\xdc\x3a\xdb\x7d\xb9\xac\x0c\x05\xfc\x64\x8f\x4e\x71\x2c\xc0\xc1\x92\xd4\x7a\xec\x69\xf7\x49\x28\x51\x91\xd5\x9f\x4e\xe3\x99\xcf\x92\x86\x5a\x61\x59\x8a\x94\xad\xf5\x8b\x33\x42\x0a\xce\x2b\xcf\xdf\xe0\x7a\x90\x42\xe2\x72\xd0\x2e\x96\xbf\x47\x9e\x2a\x8a\x8a\x10\xa1\x0c\xa6\x2d\x73\xb7\xf2\x08\x8f\xd2\x8b\xd1\xf3\xb0\xd7\x5f\xe3\x85\x36\x9e\x9f\x1e\x9e\x6f\xb8\xe7\x83\x2a\xe5\x44\xf8\x03\x1f\x68\xa3\xca\x6a\xbd\xc7\xd9\x04\x26\xaa\x7c\xb9\xcd\x17\xda\x5a\xe7\xa6\xb0\x1c\xa0\x7e\xd8\x3a\x62\x74\xcf\x16\x58\xc6\x2b\x3c\xf4\xc6\xfb\xcf\xff\xb0\x07\xdf\xaa\x50\x27\x81\x9c\x36\x47\x1b\x19\xa5\x51\xbd\x88\x46\xb5\xa6\x71\x03\x3a\xb5\x0a\x36\x2a\x30\xd4\xfc\x5e\x58\xf9\x6e\x23\x93\x7d\x6e\xe3\x61\xb9\x9d\x6e\x61\x54\xad\xbc\xad\xbe\x8f\x40\x54\xf3\x4a\x75\xd6\xa1\x84\x28\xcc\x34\xbb\x4a\x41\xe3\xa6\x9e\x26\xbb\x62\x15\x73\x5b\x75\xdc\x27\xaf\xe2\xc8\xad\x2d\x6d\xf7\x1e\x52\x91\x5c\xee\x42\xd5\x95\x7e\x13\x14\x58\x86\x5e\x23\xa6\x7b\xc6\x57\x66\x94\x98\xc2\x79\x5f\x73\xd0\x7e\xdc\xb7\x7e\xfe\xf9\x00\x0b\x93\xd3\x6f\x66\xeb\x95\x5a\x1b\xa5\x95\x9c\xa4\xd6\xa2\x94\x1e\xb1\xd6\x26\xf2\xc1\x08\x51\x39\x7f\xb8\xfb\x65\x39\x66\xd6\xbd\x5a\x96\x2b\x82\x97\xc8\xbe\x23\xce\xa6\xce\x13\xc7\x2b\x9b\x45\x25\xb3\x75\x5f\x0a\x5b\xa3\x8e\x5e\x7c\xab\x6e\xc8\xcf\xec\x66\x11\xb5\x2e\xa0\x6d\x1b\xc9\xb7\xec\x3f\x96\xa7\x9f\x77\xf4\xf7\x76\x1f\x6c\x41\x9d\xd7\x16\x0b\xa9\x59\x7b\x8f\x8a\x29\xef\x1e\xcf\x2d\xca\xec\x9d\xa6\xc2\xdb\xca\x63\x8b\x2b\xb7\xa1\x8f\xf9\xc9\xfc\x26\x9b\x78\x69\x91\xd8\x49\x46\xb5\x40\x6d\x31\x91\x04\xa1\x53\x58\x1a\xac\x54\x2f\xab\x05\xcf\x4d\xd3\xb9\x0a\x14\x90\x93\xbf\x6c\xe6\x8a\xc6\x2f\xbd\x74\xad\xfb\x2d\x08\x7a\x4e\xb9\xba\x74\xe9\x0a\xa6\x50\x36\x0a\xfd\xab\x8c\xdc\xb2\x74\xde\xb5\xb5\x3c\xfb\x1a\xc9\x20\xf7\xb1\xd1\x5b\xa9\xa9\xe2\x19\x74\x58\x8b\x5a\xa7\xac\x09\x56\x2a\x4f\xda\xf6\x67\x55\x61\x22\xab\xd8\x71\x9d\x66\x5c\xc3\x8a\x93\x18\x71\xb2\xa3\xab\x85\x97\x8e\x3b\xa1\x4a\x93\x6a\xc7\x56\x0b\xb8\x75\x4e\x3f\xd3\x7d\xf5\xbf\xba\x33\x8c\x0f\x4d\x13\x8e\x99\x62\xf2\x6a\xee\x30\x2e\x20\x56\xe1\x0f\x2b\xce\xe8\xc9\x1c\x62\xa4\x34\x33\xe8\x58\x2c\x83\x86\x4b\x8c\x86\x84\x4b\x7c\x62\x0c\x45\xfb\xd1\x42\xe5\x8a\xa1\xca\x7e\x70\x8b\xfd\xe1\x16\xa9\xc0\x57\x0f\x7e\xb1\x7b\xf6\x8b\x55\x53\xe1\x33\x77\x8c\x89\x6c\xc4\x9d\xd9\x6c\xb8\xc6\x34\xa3\xbd\x38\xdf\x58\x03\x37\x71\x8e\x15\x78\x56\xf6\x8e\x15\xe1\x68\x7f\x98\x7b\xac\x67\x0c\x1a\x0d\xca\x1b\x71\xe0\x5a\x6e\x31\xe8\x73\x73\x5d\xaf\xdd\xaa\xae\xbb\x0d\xf8\xef\x64\xa7\x10\x45\x27\x9e\xec\x00\xe1\xfd\x79\xf2\x44\xe6\x5c\xc3\x95\xc7\x89\x02\x95\x11\x58\x81\x94\xe1\xef\x36\x97\x86\x36\x5a\x33\x55\xe6\xd0\x23\xd9\xed\xfa\x79\x5b\xea\xb9\x63\xfa\x6f\xde\xa7\xc7\x9f\x95\xaf\x59\x2d\xeb\x79\xca\x2c\xb9\x55\x65\x82\x84\x21\x11\x03\x5a\x4e\xb2\x1f\xfd\x25\xf4\x0e\x85\xde\x36\x61\xa0\x53\xf5\x09\x7b\x4f\x5e\xad\xbd\x2f\xd2\xc2\xe0\xda\xd7\xa7\xed\x0d\x40\x1b\xf7\x4b\x20\x1a\x25\x51\xa6\x4f\xe2\x11\x81\xbf\x37\xf2\x48\xa0\x8a\x9e\x03\x99\x04\xc5\x68\x11\xf6\x60\xff\x3e\xb8\x64\x1f\x5c\xb2\x9f\xa5\x4b\xb6\x52\x7a\x35\x7f\x66\x61\xf4\xea\x3b\x65\xe5\x76\xb6\xc4\x76\xd9\x86\x5b\x76\x9d\x76\xe8\x2e\x7f\x08\xf1\x1b\xf4\xcc\x52\x33\xdd\xd0\xb3\xd3\x45\xf1\x53\xd8\x8e\x45\xb9\x07\xef\xec\x27\xe1\x9d\xad\xd8\xbb\xd5\x32\x97\x18\xe6\x52\xbb\x5c\xe6\xa3\x2d\x4f\xb5\x72\xfe\x58\x96\x56\xf3\xd2\x96\x90\xab\xb9\x69\x9d\xc5\x42\xe9\x9d\x5d\xd5\x35\x4b\x5d\xbf\x18\x6c\x7e\xe9\xa4\x1d\x8e\x56\xf1\x7a\xfa\x2e\xb8\xea\x32\xcd\x2e\xc8\x22\x08\xb3\x0b\xae\x74\x50\x13\xc0\x42\xaa\xcc\xe9\x99\x3f\x73\xe6\xa4\x33\x73\x5c\x24\x5b\x5e\xec\x92\x97\xfc\xf0\x35\xdc\x43\xfa\xe2\xcd\xcb\xe5\x91\x67\x99\x49\x1c\xa3\xb3\xc4\x30\x69\xbd\xe5\xb5\xaa\x66\xd5\x0b\xe6\x88\x1f\xcb\x57\x65\xe7\x94\xdf\x90\x0d\x76\xea\xa5\x86\xa3\xe2\x6b\x57\xf9\x8e\xe2\xc3\x11\x0d\x91\x57\x60\x21\x71\xc2\x3c\x0a\xfa\x98\xc5\xa8\x71\x45\xaa\xcd\x03\xb1\xe1\x10\xc1\xf8\x5b\x33\x0b\x40\xec\x15\x11\xaa\x16\x67\xc2\x03\xa6\xda\x90\xc3\x51\xb1\x1f\xa7\x28\xd1\x40\xdd\xab\x04\xe1\x0d\x11\xb0\x3e\x6f\x05\xd0\x54\x0d\x9b\x36\x80\x87\xa3\x52\x67\xf5\xa8\x41\xb7\xd4\x23\xb4\x95\xfe\x1f\x72\x2b\x08\x60\xbc\xd9\x00\x00")
 
 func uiJsAppJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -92,12 +92,12 @@ func uiJsAppJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ui/js/app.js", size: 43017, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	info := bindataFileInfo{name: "ui/js/app.js", size: 55740, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _uiIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x55\x51\x73\xdb\x36\x0c\x7e\xd7\xaf\x40\xb5\x07\xa7\x97\x58\x72\x9a\xee\xb6\x65\x76\x56\xc5\xcd\x56\xdf\x7a\xca\x2e\x72\xd6\xeb\x23\x45\x41\x12\x5b\x8a\xd4\x48\xca\x8e\xee\xf6\xe3\x07\x4a\x4a\xea\x6c\xc9\xae\xd5\x8b\x05\xe2\x03\xf0\x01\xf8\x28\x2f\x5f\xcc\xe7\xc1\x5a\xb7\xbd\x11\x55\xed\xe0\xd5\xe2\xf4\x35\x6c\x6b\x84\xb5\xe6\x9f\x8d\x66\xbc\x86\xa4\x73\xb5\x36\x36\x0a\x82\xf7\x82\xa3\xb2\x58\x40\xa7\x0a\x34\xe0\x08\x96\xb4\x04\x41\x98\x3c\x27\xf0\x27\x1a\x2b\xb4\x82\x57\xd1\x02\x8e\x3c\x20\x9c\x5c\xe1\xcb\x9f\x83\x5e\x77\xd0\xb0\x1e\x94\x76\xd0\x59\xa4\x04\xc2\x42\x29\x24\x02\xde\x71\x6c\x1d\x08\x05\x5c\x37\xad\x14\x4c\x71\x84\xbd\x70\xf5\x50\x64\x4a\x11\x05\x1f\xa7\x04\x3a\x77\x8c\xb0\x8c\xd0\x2d\x59\xe5\x21\x0a\x98\x0b\x02\xa0\xa7\x76\xae\x3d\x8f\xe3\xfd\x7e\x1f\xb1\x81\x65\xa4\x4d\x15\xcb\x11\x65\xe3\xf7\x9b\xf5\x55\x9a\x5d\xcd\x89\x69\x10\xdc\x2a\x89\xd6\x82\xc1\xbf\x3a\x61\xa8\xc1\xbc\x07\xd6\x12\x0f\xce\x72\x62\x27\xd9\x1e\xb4\x01\x56\x19\x24\x9f\xd3\x9e\xe7\xde\x08\x27\x54\x75\x02\x56\x97\x6e\xcf\x0c\x06\x85\xb0\xce\x88\xbc\x73\x8f\x06\x74\xcf\x8a\x3a\x3d\x04\xd0\x88\x98\x82\x30\xc9\x60\x93\x85\x70\x99\x64\x9b\xec\x24\xf8\xb0\xd9\xbe\xbb\xbe\xdd\xc2\x87\xe4\xe6\x26\x49\xb7\x9b\xab\x0c\xae\x6f\x60\x7d\x9d\xbe\xdd\x6c\x37\xd7\x29\x59\xbf\x42\x92\x7e\x84\xdf\x37\xe9\xdb\x13\x40\x1a\x0f\x15\xc1\xbb\xd6\x78\xee\xda\x04\xc2\x8f\x0e\x8b\x08\x32\xc4\x47\xc5\x4b\x3d\x92\xb1\x2d\x72\x51\x0a\x4e\x1d\xa9\xaa\x63\x15\x42\xa5\x77\x68\x14\x35\x12\xb4\x68\x1a\x61\xfd\xf2\x2c\x51\x2b\x40\x8a\x46\x38\xe6\x06\xfb\x3f\xed\x7c\x29\x91\xdc\x12\xe7\x9b\x6c\x58\x63\xe0\xeb\x28\xd6\xa0\xf5\x3b\xe1\x5a\x8d\xed\x8e\xe2\x19\x65\x74\x0e\x89\x2a\x0c\xee\xe1\x52\xab\x1d\x12\x00\xe1\x88\xaa\xf5\x39\x95\x79\x53\x35\x4c\xc8\x88\x14\xf0\x72\x58\xa0\x7f\x2e\x0d\x6b\xe0\x37\xd3\x29\x14\x06\x8e\x72\xb2\x8e\xb9\x2e\xf0\x0d\xbf\x97\xa7\x64\xb9\x1d\x43\xe6\xf3\x8b\x60\xf9\xa2\xd0\xdc\xf5\x2d\xd2\xf6\x1b\x49\xf6\xf8\x03\xb0\xac\x91\x15\x17\x43\xda\x65\x83\x8e\x94\x53\x33\x63\xd1\xad\xc2\xce\x95\xf3\x1f\xc3\xc9\x25\x85\xfa\x0c\xb5\xc1\x72\x35\xf3\xf2\xb1\xa4\x9f\x92\xda\xb0\x51\xa5\x75\x25\x91\xb5\x62\x28\x16\x73\x6b\x7f\x29\x59\x23\x64\xbf\xca\x74\x67\x38\x1e\x67\x4c\xd9\xe3\x3f\x8c\x3e\x7f\xbd\x58\x9c\xfc\xb4\x58\xfc\x3d\x9d\xaf\x89\xad\x3f\x9f\x91\xb8\xe4\x6a\x66\x5d\x4f\x4a\xab\x11\xdd\x0c\x3c\xcf\xd5\xcc\xe1\x9d\xf3\xf9\x66\x87\x14\x3c\x36\xfc\x82\x0d\x47\x4e\xa1\xc7\x91\x82\x73\x1b\xab\x5d\x71\x16\x9f\x46\x3f\x44\xa7\xf4\x1a\x15\x67\x51\x23\x54\x44\xde\xf0\x6b\xb3\x90\xbc\xbf\x09\x5f\x19\xd6\xd6\x87\x11\x96\x1b\x41\x57\xd6\x1a\x4e\x88\x4f\x13\x2d\x22\x75\x16\x9d\x45\xdf\xc7\x13\xa3\x4f\x04\x5f\xc6\x23\xf4\xff\xe2\x9e\x6c\xe7\x6b\x83\x49\xa7\xb5\x11\x92\xcc\x45\x44\xb7\xf9\xde\x7e\x2e\x87\x13\x4e\xe2\xc5\xc3\xf7\x6d\x19\x8f\x07\x5e\x24\xf1\xbd\x4a\x96\xb9\x2e\xfa\x09\xef\xcf\x48\xfd\x5c\x32\x6b\x57\x21\xcd\x2d\x65\xbb\x69\x08\xe4\x65\xd3\x90\xbe\x8b\xc3\xc7\x90\xf9\x30\xd2\xe9\xbd\xd6\x0d\xa6\x74\x31\xc2\xc3\xba\xec\x89\x24\x8a\xe4\x62\x9f\xca\x14\x5e\xa4\xde\xf5\x74\x94\xa5\x3b\xf6\x5c\x58\x36\xf8\x1e\xe2\xc6\x1e\xd1\x4c\x56\x21\x76\x20\x8a\x55\x68\xb4\x76\x0f\xf1\x65\x27\xe5\x3b\xf4\xff\x07\x6b\x12\x3f\x7d\x69\xd1\xf8\x29\x12\xf6\x99\x35\x78\x31\xfd\x7b\xd2\xcb\x78\x9c\x21\x15\x1c\xee\xe0\x3f\x01\x00\x00\xff\xff\xab\x1e\x5d\x59\x69\x06\x00\x00")
+var _uiIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x09\x6e\x88\x00\xff\x94\x55\xdd\x6e\xe3\x36\x13\xbd\xd7\x53\xcc\xea\xbb\x70\x16\x89\x45\x27\xd9\x0f\x6d\x53\xd9\x5d\xc7\x49\x1b\xa3\x0b\xbb\x88\x9c\x2e\xf6\x72\x4c\x8e\x25\x66\x29\x52\x25\x47\x76\x04\xf4\xe1\x0b\x59\xca\x5f\xeb\x14\x5b\xc0\x80\xc5\x99\x73\x66\x8e\xe6\x0c\xa8\xf4\xdd\x70\x18\xcd\x5c\xd5\x78\x9d\x17\x0c\x67\xa3\xd3\x0f\xb0\x2a\x08\x66\x4e\x7e\xf5\x0e\x65\x01\xd3\x9a\x0b\xe7\x43\x12\x45\x9f\xb4\x24\x1b\x48\x41\x6d\x15\x79\xe0\x82\x60\x5a\xa1\x2c\x08\xfa\xcc\x09\xfc\x4e\x3e\x68\x67\xe1\x2c\x19\xc1\x51\x0b\x88\xfb\x54\xfc\xfe\xc7\xa8\x71\x35\x94\xd8\x80\x75\x0c\x75\x20\xe0\x42\x07\xd8\x68\x43\x40\x0f\x92\x2a\x06\x6d\x41\xba\xb2\x32\x1a\xad\x24\xd8\x69\x2e\x80\x9f\xab\x27\xd1\x97\xbe\x80\x5b\x33\x6a\x0b\x08\xd2\x55\x0d\xb8\xcd\x4b\x14\x20\x47\x11\x00\x40\xc1\x5c\x5d\x08\xb1\xdb\xed\x12\xdc\xab\x4c\x9c\xcf\x85\xe9\x50\x41\x7c\x9a\xcf\xae\x17\xd9\xf5\xf0\x2c\x19\x45\xd1\x9d\x35\x14\x02\x78\xfa\xa3\xd6\x9e\x14\xac\x1b\xc0\xaa\x32\x5a\xe2\xda\x10\x18\xdc\x81\xf3\x80\xb9\x27\x52\xc0\xae\xd5\xb9\xf3\x9a\xb5\xcd\x4f\x20\xb8\x0d\xef\xd0\x53\xa4\x74\x60\xaf\xd7\x35\xbf\x1a\xd0\xa3\x2a\x1d\xe0\x25\xc0\x59\x40\x0b\xf1\x34\x83\x79\x16\xc3\xe5\x34\x9b\x67\x27\xd1\xe7\xf9\xea\x66\x79\xb7\x82\xcf\xd3\xdb\xdb\xe9\x62\x35\xbf\xce\x60\x79\x0b\xb3\xe5\xe2\x6a\xbe\x9a\x2f\x17\x19\x2c\x7f\x86\xe9\xe2\x0b\xfc\x3a\x5f\x5c\x9d\x00\x69\x2e\xc8\x03\x3d\x54\xbe\xd5\xee\x7c\xa4\xdb\xd1\x91\x4a\x20\x23\x7a\xd5\x7c\xe3\x3a\xb7\x42\x45\x52\x6f\xb4\x04\x83\x36\xaf\x31\x27\xc8\xdd\x96\xbc\xd5\x36\x8f\x2a\xf2\xa5\x0e\xad\x79\x01\xd0\x2a\x30\xba\xd4\x8c\xbc\x3f\xff\xe3\x75\x9e\x5b\x4c\xef\x56\x37\xcb\xdb\x6c\x6f\x63\xd4\xf6\xb1\x58\x52\x68\x3d\x91\xce\x76\xf3\xe8\x96\xa7\x5b\xa3\x0b\x98\x5a\xe5\x69\x07\x97\xce\x6e\xc9\xb2\x27\x38\x42\xab\x9a\xb5\xb3\xe1\x63\x5e\xa2\x36\x89\x74\xe5\xfb\xbd\x81\xed\xef\xd2\x63\x09\xbf\xf8\xda\x92\xf6\x70\xb4\xf6\x58\x1e\x4b\xa7\xe8\xa3\x7c\x5c\x4f\x83\xeb\xd0\x51\x86\xc3\x49\x94\xbe\x53\x4e\x72\x53\x11\x14\x5c\x9a\x49\x94\x76\x7f\x00\x69\x41\xa8\xda\x07\x80\xb4\x24\x46\x90\x05\xfa\x40\x3c\x8e\x6b\xde\x0c\xbf\x8f\xfb\x94\xd1\xf6\x2b\x14\x9e\x36\xe3\x41\xbb\x3e\xe1\x42\x88\x8d\xb3\x1c\x92\xdc\xb9\xdc\x10\x56\x7a\xdf\x4c\xc8\x10\x7e\xda\x60\xa9\x4d\x33\xce\x5c\xed\x25\x1d\x67\x68\xc3\xf1\x6f\xde\x5d\x7c\x18\x8d\x4e\x7e\x18\x8d\xfe\xec\xe3\x33\xa7\xa8\x8d\x0f\xc0\x93\x19\x0f\x02\x37\x86\x42\x41\xc4\x03\xe0\xa6\xa2\xf1\x80\xe9\x81\xdb\x7a\x83\x97\x12\x5a\x6c\xfc\x8c\x8d\x3b\x4d\x71\x8b\x13\x46\xaf\x83\xb0\x5b\x75\x2e\x4e\x93\xef\x92\x53\x61\xb7\x89\x3a\x4f\x4a\x6d\x13\x19\x42\xfc\xad\x55\xb0\xaa\xfe\x13\x3e\xf7\x58\x15\x2f\x19\x41\x7a\x5d\x31\x04\x2f\xc7\xb1\xb8\xef\x65\xa9\x73\x71\x9e\x9c\x27\xff\x17\xbd\xa2\xfb\x10\x4f\x52\xd1\x41\xff\x8d\x77\xf0\x75\xbe\x95\x5c\x6a\x2e\xbc\x36\xf7\x41\x8c\x92\xb3\x64\xf4\x78\x7e\xab\x06\x6b\x36\x34\x79\xba\xdf\x52\xd1\x05\x22\x80\x54\x3c\x6e\x49\xba\x76\xaa\xe9\xf1\x6d\x8c\x3c\x48\x83\x21\x8c\x63\xac\xaa\x05\x6e\xfb\x21\x00\xa4\xd8\x0f\xe9\x7f\x22\x7e\x0d\x19\xee\x47\xda\x3f\x17\xae\xa4\x05\x96\x14\xbf\xec\x8b\x07\x8a\x58\xa7\x28\x1c\xaa\x14\x4f\x16\x6d\xea\x30\x2b\xb0\xf3\x6f\xd1\xb2\x7d\xee\x30\x4f\x21\xe3\x1a\xc3\x5b\xd4\xab\xc7\xf4\x13\xbb\x9b\x10\xf9\xfe\xa4\xf4\x16\xb4\x1a\xc7\xde\x39\x7e\x2a\xb1\xa9\x8d\xb9\xa1\xf6\x6b\x32\x73\xb6\xbd\xa7\xc9\xb7\x3e\x2a\xbd\x7d\xc3\xc4\x76\x15\xff\xee\x53\x2a\x3a\x07\x52\x51\x70\x69\x26\xd1\x5f\x03\x00\xbd\xba\x62\xae\xa7\x06\x00\x00")
 
 func uiIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -112,7 +112,7 @@ func uiIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "ui/index.html", size: 1641, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
+	info := bindataFileInfo{name: "ui/index.html", size: 1703, mode: os.FileMode(420), modTime: time.Unix(1400000000, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -127,6 +127,15 @@ var Models;
             AccumulateMVCCStats(dest.stats, src.stats);
         }
         Proto.AccumulateStatus = AccumulateStatus;
+        (function (ColumnType) {
+            ColumnType[ColumnType["BYTES"] = 0] = "BYTES";
+            ColumnType[ColumnType["BOOL"] = 1] = "BOOL";
+            ColumnType[ColumnType["INT"] = 2] = "INT";
+            ColumnType[ColumnType["FLOAT"] = 3] = "FLOAT";
+            ColumnType[ColumnType["STRING"] = 4] = "STRING";
+            ColumnType[ColumnType["JSON"] = 5] = "JSON";
+        })(Proto.ColumnType || (Proto.ColumnType = {}));
+        var ColumnType = Proto.ColumnType;
         (function (QueryAggregator) {
             QueryAggregator[QueryAggregator["AVG"] = 1] = "AVG";
             QueryAggregator[QueryAggregator["AVG_RATE"] = 2] = "AVG_RATE";
@@ -134,6 +143,332 @@ var Models;
         var QueryAggregator = Proto.QueryAggregator;
     })(Proto = Models.Proto || (Models.Proto = {}));
 })(Models || (Models = {}));
+// source: models/stats.ts
+/// <reference path="../typings/mithriljs/mithril.d.ts" />
+/// <reference path="proto.ts" />
+// Author: Bram Gruneir (bram+code@cockroachlabs.com)
+var Models;
+(function (Models) {
+    var Stats;
+    (function (Stats) {
+        var kibi = 1024;
+        var units = ['KiB', 'MiB', 'GiB', 'TiB', 'PiB', 'EiB', 'ZiB', 'YiB'];
+        function FormatBytes(bytes) {
+            if (Math.abs(bytes) < kibi) {
+                return bytes + ' B';
+            }
+            var u = -1;
+            do {
+                bytes /= kibi;
+                ++u;
+            } while (Math.abs(bytes) >= kibi && u < units.length - 1);
+            return bytes.toFixed(1) + ' ' + units[u];
+        }
+        Stats.FormatBytes = FormatBytes;
+        var tableStyle = "border-collapse:collapse; border - spacing:0; border - color:#ccc";
+        var thStyle = "font-family:Arial, sans-serif;font-size:14px;font-weight:normal;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#efefef;text-align:center";
+        var tdStyleOddFirst = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#efefef;text-align:center";
+        var tdStyleOdd = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#f9f9f9;text-align:center";
+        var tdStyleEvenFirst = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#efefef;text-align:center";
+        var tdStyleEven = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#fff;text-align:center";
+        function CreateStatsTable(stats) {
+            return m("div", [
+                m("h3", "Statistics"),
+                m("table", { style: tableStyle }, [
+                    m("tr", [
+                        m("th", { style: thStyle }, ""),
+                        m("th", { style: thStyle }, "Key"),
+                        m("th", { style: thStyle }, "Value"),
+                        m("th", { style: thStyle }, "Live"),
+                        m("th", { style: thStyle }, "Intent"),
+                        m("th", { style: thStyle }, "System")
+                    ]),
+                    m("tr", [
+                        m("td", { style: tdStyleOddFirst }, "Count"),
+                        m("td", { style: tdStyleOdd }, stats.key_count),
+                        m("td", { style: tdStyleOdd }, stats.val_count),
+                        m("td", { style: tdStyleOdd }, stats.live_count),
+                        m("td", { style: tdStyleOdd }, stats.intent_count),
+                        m("td", { style: tdStyleOdd }, stats.sys_count)
+                    ]),
+                    m("tr", [
+                        m("td", { style: tdStyleEvenFirst }, "Size"),
+                        m("td", { style: tdStyleEven }, FormatBytes(stats.key_bytes)),
+                        m("td", { style: tdStyleEven }, FormatBytes(stats.val_bytes)),
+                        m("td", { style: tdStyleEven }, FormatBytes(stats.live_bytes)),
+                        m("td", { style: tdStyleEven }, FormatBytes(stats.intent_bytes)),
+                        m("td", { style: tdStyleEven }, FormatBytes(stats.sys_bytes))
+                    ])
+                ])
+            ]);
+        }
+        Stats.CreateStatsTable = CreateStatsTable;
+    })(Stats = Models.Stats || (Models.Stats = {}));
+})(Models || (Models = {}));
+// source: models/databases.ts
+/// <reference path="../typings/mithriljs/mithril.d.ts" />
+/// <reference path="../typings/d3/d3.d.ts" />
+/// <reference path="../util/querycache.ts" />
+/// <reference path="proto.ts" />
+/// <reference path="stats.ts" />
+var Models;
+(function (Models) {
+    var Databases;
+    (function (Databases_1) {
+        var _prefix = "/_admin/databases/";
+        var _datetimeFormatter = d3.time.format("%Y-%m-%d %H:%M:%S");
+        function _formatDate(nanos) {
+            var datetime = new Date(nanos / 1.0e6);
+            return _datetimeFormatter(datetime);
+        }
+        function _tableHref(database, table) {
+            return "/databases/" + encodeURIComponent(database) + "/" + encodeURIComponent(table);
+        }
+        var Databases = (function () {
+            function Databases() {
+                this._data = new Utils.QueryCache(function () {
+                    return m.request({ url: _prefix, method: "GET", extract: nonJsonErrors })
+                        .then(function (results) {
+                        return results.databases;
+                    });
+                });
+            }
+            Databases.prototype.GetNames = function () {
+                return this._data.result() || [];
+            };
+            Databases.prototype.refresh = function () {
+                this._data.refresh();
+            };
+            return Databases;
+        })();
+        Databases_1.Databases = Databases;
+        var Database = (function () {
+            function Database(_name) {
+                var _this = this;
+                this._name = _name;
+                this._data = new Utils.QueryCache(function () {
+                    var url = _prefix + encodeURIComponent(_this._name);
+                    return m.request({ url: url, method: "GET", extract: nonJsonErrors });
+                });
+            }
+            Database.prototype.refresh = function () {
+                this._data.refresh();
+            };
+            Database.prototype.Details = function () {
+                var db = this._data.result();
+                if (db == null) {
+                    return m("div", "No data present yet.");
+                }
+                if (db.tables.length == 0) {
+                    return m("div", "No tables.");
+                }
+                return m("table", [
+                    m("tr", [
+                        m("th", "Table"),
+                        m("th", "Columns"),
+                        m("th", "Indexes"),
+                        m("th", "Ranges"),
+                        m("th", "Rows"),
+                        m("th", "Size"),
+                        m("th", "Statistics Collected at")
+                    ]),
+                    db.tables.map(function (table) {
+                        return m("tr", { key: table.name }, [
+                            m("td", m("a[href=" + _tableHref(db.name, table.name) + "]", { config: m.route }, table.name)),
+                            m("td", table.schema.columns.length),
+                            m("td", table.schema.indexes.length),
+                            m("td", table.range_count),
+                            m("td", table.stats ? table.stats.row_count : "-"),
+                            m("td", table.stats ? Models.Stats.FormatBytes(table.stats.data_size) : "-"),
+                            m("td", table.stats ? _formatDate(table.stats.collected_at) : "-")
+                        ]);
+                    })
+                ]);
+            };
+            return Database;
+        })();
+        Databases_1.Database = Database;
+        var Table = (function () {
+            function Table(_database, _name) {
+                var _this = this;
+                this._database = _database;
+                this._name = _name;
+                this._data = new Utils.QueryCache(function () {
+                    var url = _prefix + encodeURIComponent(_this._database) + "/" + encodeURIComponent(_this._name);
+                    return m.request({ url: url, method: "GET", extract: nonJsonErrors });
+                });
+            }
+            Table.prototype.refresh = function () {
+                this._data.refresh();
+            };
+            Table.prototype.Details = function () {
+                var table = this._data.result();
+                if (table == null) {
+                    return m("div", "No data present yet.");
+                }
+                var schema = table.schema;
+                return m("div", [
+                    m("table", [
+                        m("tr", [m("td", "Comment:"), m("td", schema.table.comment)]),
+                        m("tr", [m("td", "TTL (seconds):"), m("td", schema.table.ttl_seconds || "-")]),
+                        m("tr", [m("td", "Ranges:"), m("td", table.range_count)]),
+                        m("tr", [m("td", "Rows:"), m("td", table.stats ? table.stats.row_count : "-")]),
+                        m("tr", [m("td", "Size:"), m("td", table.stats ? Models.Stats.FormatBytes(table.stats.data_size) : "-")]),
+                        m("tr", [m("td", "Statistics Collected at:"), m("td", table.stats ? _formatDate(table.stats.collected_at) : "-")])
+                    ]),
+                    m("h3", "Columns"),
+                    m("table", [
+                        m("tr", [m("th", "Name"), m("th", "Type"), m("th", "Computed as"), m("th", "Comment")]),
+                        schema.columns.map(function (column) {
+                            return m("tr", { key: column.name }, [
+                                m("td", column.name),
+                                m("td", Models.Proto.ColumnType[column.type]),
+                                m("td", column.compute_expr),
+                                m("td", column.comment)
+                            ]);
+                        })
+                    ]),
+                    m("h3", "Indexes"),
+                    m("table", [
+                        m("tr", [m("th", "Name"), m("th", "Unique"), m("th", "Columns")]),
+                        schema.indexes.map(function (index, i) {
+                            return m("tr", { key: index.index.name }, [
+                                m("td", index.index.name + (i == 0 ? " (primary)" : "")),
+                                m("td", index.index.unique ? "yes" : "no"),
+                                m("td", (index.column_names || index.key_exprs || []).join(", "))
+                            ]);
+                        })
+                    ])
+                ]);
+            };
+            return Table;
+        })();
+        Databases_1.Table = Table;
+        function nonJsonErrors(xhr, opts) {
+            return xhr.status > 200 ? JSON.stringify(xhr.responseText) : xhr.responseText;
+        }
+    })(Databases = Models.Databases || (Models.Databases = {}));
+})(Models || (Models = {}));
+// source: pages/databases.ts
+/// <reference path="../typings/mithriljs/mithril.d.ts" />
+/// <reference path="../models/databases.ts" />
+var AdminViews;
+(function (AdminViews) {
+    var Databases;
+    (function (Databases) {
+        var databases = new Models.Databases.Databases();
+        var DatabasesPage;
+        (function (DatabasesPage) {
+            var Controller = (function () {
+                function Controller() {
+                    var _this = this;
+                    this._refresh();
+                    this._interval = setInterval(function () { return _this._refresh(); }, Controller._queryEveryMS);
+                }
+                Controller.prototype._refresh = function () {
+                    databases.refresh();
+                };
+                Controller.prototype.onunload = function () {
+                    clearInterval(this._interval);
+                };
+                Controller._queryEveryMS = 10000;
+                return Controller;
+            })();
+            function controller() {
+                return new Controller();
+            }
+            DatabasesPage.controller = controller;
+            function view(ctrl) {
+                return m("div", [
+                    m("h2", "Databases List"),
+                    m("ul", [
+                        databases.GetNames().map(function (name) {
+                            return m("li", { key: name }, m("div", [
+                                m.trust("&nbsp;&bull;&nbsp;"),
+                                m("a[href=/databases/" + encodeURIComponent(name) + "]", { config: m.route }, name)
+                            ]));
+                        }),
+                    ])
+                ]);
+            }
+            DatabasesPage.view = view;
+        })(DatabasesPage = Databases.DatabasesPage || (Databases.DatabasesPage = {}));
+        var DatabasePage;
+        (function (DatabasePage) {
+            var Controller = (function () {
+                function Controller(name) {
+                    var _this = this;
+                    this.database = new Models.Databases.Database(name);
+                    this._interval = setInterval(function () { return _this._refresh(); }, Controller._queryEveryMS);
+                }
+                Controller.prototype._refresh = function () {
+                    this.database.refresh();
+                };
+                Controller.prototype.onunload = function () {
+                    clearInterval(this._interval);
+                };
+                Controller._queryEveryMS = 10000;
+                return Controller;
+            })();
+            function controller() {
+                var name = m.route.param("database");
+                return new Controller(name);
+            }
+            DatabasePage.controller = controller;
+            function view(ctrl) {
+                var name = m.route.param("database");
+                return m("div", [
+                    m("h2", "Database Tables"),
+                    m("div", [
+                        m("h3", "Database: " + name),
+                        ctrl.database.Details()
+                    ])
+                ]);
+            }
+            DatabasePage.view = view;
+        })(DatabasePage = Databases.DatabasePage || (Databases.DatabasePage = {}));
+        var TablePage;
+        (function (TablePage) {
+            var Controller = (function () {
+                function Controller(database, name) {
+                    var _this = this;
+                    this.table = new Models.Databases.Table(database, name);
+                    this._interval = setInterval(function () { return _this._refresh(); }, Controller._queryEveryMS);
+                }
+                Controller.prototype._refresh = function () {
+                    this.table.refresh();
+                };
+                Controller.prototype.onunload = function () {
+                    clearInterval(this._interval);
+                };
+                Controller._queryEveryMS = 10000;
+                return Controller;
+            })();
+            function controller() {
+                var database = m.route.param("database");
+                var name = m.route.param("table");
+                return new Controller(database, name);
+            }
+            TablePage.controller = controller;
+            function view(ctrl) {
+                var database = m.route.param("database");
+                var name = m.route.param("table");
+                return m("div", [
+                    m("h2", "Table Schema"),
+                    m("div", [
+                        m("h3", [
+                            "Table: ",
+                            m("a[href=/databases/" + encodeURIComponent(database) + "]", { config: m.route }, database),
+                            "." + name
+                        ]),
+                        ctrl.table.Details()
+                    ])
+                ]);
+            }
+            TablePage.view = view;
+        })(TablePage = Databases.TablePage || (Databases.TablePage = {}));
+    })(Databases = AdminViews.Databases || (AdminViews.Databases = {}));
+})(AdminViews || (AdminViews = {}));
 // source: util/chainprop.ts
 // Author: Matt Tracy (matt@cockroachlabs.com)
 var Utils;
@@ -443,68 +778,6 @@ var AdminViews;
         })(Page = Monitor.Page || (Monitor.Page = {}));
     })(Monitor = AdminViews.Monitor || (AdminViews.Monitor = {}));
 })(AdminViews || (AdminViews = {}));
-// source: models/stats.ts
-/// <reference path="../typings/mithriljs/mithril.d.ts" />
-/// <reference path="proto.ts" />
-// Author: Bram Gruneir (bram+code@cockroachlabs.com)
-var Models;
-(function (Models) {
-    var Stats;
-    (function (Stats) {
-        var kibi = 1024;
-        var units = ['KiB', 'MiB', 'GiB', 'TiB', 'PiB', 'EiB', 'ZiB', 'YiB'];
-        function FormatBytes(bytes) {
-            if (Math.abs(bytes) < kibi) {
-                return bytes + ' B';
-            }
-            var u = -1;
-            do {
-                bytes /= kibi;
-                ++u;
-            } while (Math.abs(bytes) >= kibi && u < units.length - 1);
-            return bytes.toFixed(1) + ' ' + units[u];
-        }
-        Stats.FormatBytes = FormatBytes;
-        var tableStyle = "border-collapse:collapse; border - spacing:0; border - color:#ccc";
-        var thStyle = "font-family:Arial, sans-serif;font-size:14px;font-weight:normal;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#efefef;text-align:center";
-        var tdStyleOddFirst = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#efefef;text-align:center";
-        var tdStyleOdd = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#f9f9f9;text-align:center";
-        var tdStyleEvenFirst = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#efefef;text-align:center";
-        var tdStyleEven = "font-family:Arial, sans-serif;font-size:14px;padding:10px 5px;border-style:solid;border-width:1px;overflow:hidden;word-break:normal;border-color:#ccc;color:#333;background-color:#fff;text-align:center";
-        function CreateStatsTable(stats) {
-            return m("div", [
-                m("h3", "Statistics"),
-                m("table", { style: tableStyle }, [
-                    m("tr", [
-                        m("th", { style: thStyle }, ""),
-                        m("th", { style: thStyle }, "Key"),
-                        m("th", { style: thStyle }, "Value"),
-                        m("th", { style: thStyle }, "Live"),
-                        m("th", { style: thStyle }, "Intent"),
-                        m("th", { style: thStyle }, "System")
-                    ]),
-                    m("tr", [
-                        m("td", { style: tdStyleOddFirst }, "Count"),
-                        m("td", { style: tdStyleOdd }, stats.key_count),
-                        m("td", { style: tdStyleOdd }, stats.val_count),
-                        m("td", { style: tdStyleOdd }, stats.live_count),
-                        m("td", { style: tdStyleOdd }, stats.intent_count),
-                        m("td", { style: tdStyleOdd }, stats.sys_count)
-                    ]),
-                    m("tr", [
-                        m("td", { style: tdStyleEvenFirst }, "Size"),
-                        m("td", { style: tdStyleEven }, FormatBytes(stats.key_bytes)),
-                        m("td", { style: tdStyleEven }, FormatBytes(stats.val_bytes)),
-                        m("td", { style: tdStyleEven }, FormatBytes(stats.live_bytes)),
-                        m("td", { style: tdStyleEven }, FormatBytes(stats.intent_bytes)),
-                        m("td", { style: tdStyleEven }, FormatBytes(stats.sys_bytes))
-                    ])
-                ])
-            ]);
-        }
-        Stats.CreateStatsTable = CreateStatsTable;
-    })(Stats = Models.Stats || (Models.Stats = {}));
-})(Models || (Models = {}));
 // source: models/status.ts
 /// <reference path="../typings/mithriljs/mithril.d.ts" />
 /// <reference path="../typings/d3/d3.d.ts" />
@@ -931,12 +1204,16 @@ var AdminViews;
 })(AdminViews || (AdminViews = {}));
 // source: app.ts
 /// <reference path="typings/mithriljs/mithril.d.ts" />
+/// <reference path="pages/databases.ts" />
 /// <reference path="pages/graph.ts" />
 /// <reference path="pages/monitor.ts" />
 /// <reference path="pages/nodes.ts" />
 /// <reference path="pages/stores.ts" />
 m.route.mode = "hash";
 m.route(document.getElementById("root"), "/nodes", {
+    "/databases": AdminViews.Databases.DatabasesPage,
+    "/databases/:database": AdminViews.Databases.DatabasePage,
+    "/databases/:database/:table": AdminViews.Databases.TablePage,
     "/graph": AdminViews.Graph.Page,
     "/monitor": AdminViews.Monitor.Page,
     "/node": AdminViews.Nodes.NodesPage,
@@ -1,6 +1,7 @@
 // source: app.ts
 /// <reference path="typings/mithriljs/mithril.d.ts" />
 
+/// <reference path="pages/databases.ts" />
 /// <reference path="pages/graph.ts" />
 /// <reference path="pages/monitor.ts" />
 /// <reference path="pages/nodes.ts" />
@@ -11,6 +12,9 @@
 
 m.route.mode = "hash";
 m.route(document.getElementById("root"), "/nodes", {
+    "/databases": AdminViews.Databases.DatabasesPage,
+    "/databases/:database": AdminViews.Databases.DatabasePage,
+    "/databases/:database/:table": AdminViews.Databases.TablePage,
     "/graph": AdminViews.Graph.Page,
     "/monitor": AdminViews.Monitor.Page,
     "/node": AdminViews.Nodes.NodesPage,
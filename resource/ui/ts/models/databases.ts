@@ -0,0 +1,200 @@
+// source: models/databases.ts
+/// <reference path="../typings/mithriljs/mithril.d.ts" />
+/// <reference path="../typings/d3/d3.d.ts" />
+/// <reference path="../util/querycache.ts" />
+/// <reference path="proto.ts" />
+/// <reference path="stats.ts" />
+
+module Models {
+    export module Databases {
+        import promise = _mithril.MithrilPromise;
+
+        var _prefix = "/_admin/databases/";
+
+        /**
+         * DatabaseList matches the output of the /_admin/databases
+         * endpoint.
+         */
+        export interface DatabaseList {
+            databases: string[];
+        }
+
+        /**
+         * TableStats holds the most recently collected statistics of a table.
+         */
+        export interface TableStats {
+            row_count: number;
+            data_size: number;
+            collected_at: number;
+        }
+
+        /**
+         * TableInfo matches the output of the
+         * /_admin/databases/<database>/<table> endpoint.
+         */
+        export interface TableInfo {
+            name: string;
+            schema: Proto.TableSchema;
+            range_count: number;
+            stats?: TableStats;
+        }
+
+        /**
+         * DatabaseInfo matches the output of the /_admin/databases/<database>
+         * endpoint.
+         */
+        export interface DatabaseInfo {
+            name: string;
+            tables: TableInfo[];
+        }
+
+        var _datetimeFormatter = d3.time.format("%Y-%m-%d %H:%M:%S");
+        function _formatDate(nanos: number): string {
+            var datetime = new Date(nanos / 1.0e6);
+            return _datetimeFormatter(datetime);
+        }
+
+        function _tableHref(database: string, table: string): string {
+            return "/databases/" + encodeURIComponent(database) + "/" + encodeURIComponent(table);
+        }
+
+        /**
+         * Databases caches the names of the databases.
+         */
+        export class Databases {
+            private _data = new Utils.QueryCache(():promise<string[]> => {
+                return m.request({ url: _prefix, method: "GET", extract: nonJsonErrors })
+                    .then((results: DatabaseList) => {
+                        return results.databases;
+                    });
+            });
+
+            public GetNames(): string[] {
+                return this._data.result() || [];
+            }
+
+            public refresh() {
+                this._data.refresh();
+            }
+        }
+
+        /**
+         * Database caches the description of the tables of a database.
+         */
+        export class Database {
+            private _data: Utils.QueryCache<DatabaseInfo>;
+
+            public constructor(private _name: string) {
+                this._data = new Utils.QueryCache(():promise<DatabaseInfo> => {
+                    var url = _prefix + encodeURIComponent(this._name);
+                    return m.request({ url: url, method: "GET", extract: nonJsonErrors });
+                });
+            }
+
+            public refresh() {
+                this._data.refresh();
+            }
+
+            public Details(): _mithril.MithrilVirtualElement {
+                var db = this._data.result();
+                if (db == null) {
+                    return m("div", "No data present yet.")
+                }
+                if (db.tables.length == 0) {
+                    return m("div", "No tables.")
+                }
+
+                return m("table", [
+                    m("tr", [
+                        m("th", "Table"),
+                        m("th", "Columns"),
+                        m("th", "Indexes"),
+                        m("th", "Ranges"),
+                        m("th", "Rows"),
+                        m("th", "Size"),
+                        m("th", "Statistics Collected at")
+                    ]),
+                    db.tables.map((table) => {
+                        return m("tr", { key: table.name }, [
+                            m("td", m("a[href=" + _tableHref(db.name, table.name) + "]", { config: m.route }, table.name)),
+                            m("td", table.schema.columns.length),
+                            m("td", table.schema.indexes.length),
+                            m("td", table.range_count),
+                            m("td", table.stats ? table.stats.row_count : "-"),
+                            m("td", table.stats ? Stats.FormatBytes(table.stats.data_size) : "-"),
+                            m("td", table.stats ? _formatDate(table.stats.collected_at) : "-")
+                        ]);
+                    })
+                ]);
+            }
+        }
+
+        /**
+         * Table caches the description of a single table.
+         */
+        export class Table {
+            private _data: Utils.QueryCache<TableInfo>;
+
+            public constructor(private _database: string, private _name: string) {
+                this._data = new Utils.QueryCache(():promise<TableInfo> => {
+                    var url = _prefix + encodeURIComponent(this._database) + "/" + encodeURIComponent(this._name);
+                    return m.request({ url: url, method: "GET", extract: nonJsonErrors });
+                });
+            }
+
+            public refresh() {
+                this._data.refresh();
+            }
+
+            public Details(): _mithril.MithrilVirtualElement {
+                var table = this._data.result();
+                if (table == null) {
+                    return m("div", "No data present yet.")
+                }
+
+                var schema = table.schema;
+                return m("div", [
+                    m("table", [
+                        m("tr", [m("td", "Comment:"), m("td", schema.table.comment)]),
+                        m("tr", [m("td", "TTL (seconds):"), m("td", schema.table.ttl_seconds || "-")]),
+                        m("tr", [m("td", "Ranges:"), m("td", table.range_count)]),
+                        m("tr", [m("td", "Rows:"), m("td", table.stats ? table.stats.row_count : "-")]),
+                        m("tr", [m("td", "Size:"), m("td", table.stats ? Stats.FormatBytes(table.stats.data_size) : "-")]),
+                        m("tr", [m("td", "Statistics Collected at:"), m("td", table.stats ? _formatDate(table.stats.collected_at) : "-")])
+                    ]),
+                    m("h3", "Columns"),
+                    m("table", [
+                        m("tr", [m("th", "Name"), m("th", "Type"), m("th", "Computed as"), m("th", "Comment")]),
+                        schema.columns.map((column) => {
+                            return m("tr", { key: column.name }, [
+                                m("td", column.name),
+                                m("td", Proto.ColumnType[column.type]),
+                                m("td", column.compute_expr),
+                                m("td", column.comment)
+                            ]);
+                        })
+                    ]),
+                    m("h3", "Indexes"),
+                    m("table", [
+                        m("tr", [m("th", "Name"), m("th", "Unique"), m("th", "Columns")]),
+                        schema.indexes.map((index, i) => {
+                            return m("tr", { key: index.index.name }, [
+                                m("td", index.index.name + (i == 0 ? " (primary)" : "")),
+                                m("td", index.index.unique ? "yes" : "no"),
+                                m("td", (index.column_names || index.key_exprs || []).join(", "))
+                            ]);
+                        })
+                    ])
+                ]);
+            }
+        }
+
+        /**
+         * nonJsonErrors ensures that error messages returned from the server
+         * are parseable as JSON strings.
+         */
+        function nonJsonErrors(xhr: XMLHttpRequest, opts: _mithril.MithrilXHROptions):string {
+            return xhr.status > 200 ? JSON.stringify(xhr.responseText) : xhr.responseText;
+        }
+    }
+}
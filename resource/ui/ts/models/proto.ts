@@ -181,6 +181,74 @@ module Models {
             AccumulateMVCCStats(dest.stats, src.stats);
         }
 
+        /*****************************
+         * /proto/structured.proto
+         ****************************/
+
+        /**
+         * ColumnType is an enumeration of the types of the columns of a
+         * table.
+         *
+         * Source message = "Column.ColumnType"
+         */
+        export enum ColumnType {
+            BYTES = 0,
+            BOOL = 1,
+            INT = 2,
+            FLOAT = 3,
+            STRING = 4,
+            JSON = 5,
+        }
+
+        /**
+         * Table holds the properties of a table.
+         */
+        export interface Table {
+            name: string;
+            comment: string;
+            ttl_seconds: number;
+        }
+
+        /**
+         * Column is a column of a table.
+         */
+        export interface Column {
+            name: string;
+            type: ColumnType;
+            compute_expr: string;
+            comment: string;
+        }
+
+        /**
+         * Index is an index of a table.
+         */
+        export interface Index {
+            name: string;
+            unique: boolean;
+        }
+
+        /**
+         * IndexByName is an index of a table along with the names of the
+         * columns it indexes.
+         *
+         * Source message = "TableSchema.IndexByName"
+         */
+        export interface IndexByName {
+            index: Index;
+            column_names: string[];
+            key_exprs: string[];
+        }
+
+        /**
+         * TableSchema is the schema of a table. The first index is the
+         * primary key.
+         */
+        export interface TableSchema {
+            table: Table;
+            columns: Column[];
+            indexes: IndexByName[];
+        }
+
         /*****************************
          * /proto/timeseries.proto
          ****************************/
@@ -0,0 +1,145 @@
+// source: pages/databases.ts
+/// <reference path="../typings/mithriljs/mithril.d.ts" />
+/// <reference path="../models/databases.ts" />
+
+/**
+ * AdminViews is the primary module for Cockroaches administrative web
+ * interface.
+ */
+module AdminViews {
+    /**
+     * Databases is the view for browsing the databases and tables of the
+     * cluster.
+     */
+    export module Databases {
+        var databases = new Models.Databases.Databases();
+
+        /**
+         * DatabasesPage shows a list of all the databases.
+         */
+        export module DatabasesPage {
+            class Controller {
+                private static _queryEveryMS = 10000;
+                private _interval: number;
+
+                private _refresh():void {
+                    databases.refresh();
+                }
+
+                public constructor() {
+                    this._refresh();
+                    this._interval = setInterval(() => this._refresh(), Controller._queryEveryMS);
+                }
+
+                public onunload() {
+                    clearInterval(this._interval);
+                }
+            }
+
+            export function controller():Controller {
+                return new Controller();
+            }
+
+            export function view(ctrl:Controller) {
+                return m("div", [
+                    m("h2", "Databases List"),
+                    m("ul", [
+                        databases.GetNames().map(function(name) {
+                            return m("li", { key: name },
+                                m("div", [
+                                    m.trust("&nbsp;&bull;&nbsp;"),
+                                    m("a[href=/databases/" + encodeURIComponent(name) + "]", { config: m.route }, name)
+                                ]));
+                        }),
+                    ])
+                ]);
+            }
+        }
+
+        /**
+         * DatabasePage shows the tables of a single database.
+         */
+        export module DatabasePage {
+            class Controller {
+                private static _queryEveryMS = 10000;
+                private _interval: number;
+                database: Models.Databases.Database;
+
+                private _refresh():void {
+                    this.database.refresh();
+                }
+
+                public constructor(name:string) {
+                    this.database = new Models.Databases.Database(name);
+                    this._interval = setInterval(() => this._refresh(), Controller._queryEveryMS);
+                }
+
+                public onunload() {
+                    clearInterval(this._interval);
+                }
+            }
+
+            export function controller():Controller {
+                var name = m.route.param("database");
+                return new Controller(name);
+            }
+
+            export function view(ctrl:Controller) {
+                var name = m.route.param("database");
+                return m("div", [
+                    m("h2", "Database Tables"),
+                    m("div", [
+                        m("h3", "Database: " + name),
+                        ctrl.database.Details()
+                    ])
+                ]);
+            }
+        }
+
+        /**
+         * TablePage shows the schema and statistics of a single table.
+         */
+        export module TablePage {
+            class Controller {
+                private static _queryEveryMS = 10000;
+                private _interval: number;
+                table: Models.Databases.Table;
+
+                private _refresh():void {
+                    this.table.refresh();
+                }
+
+                public constructor(database:string, name:string) {
+                    this.table = new Models.Databases.Table(database, name);
+                    this._interval = setInterval(() => this._refresh(), Controller._queryEveryMS);
+                }
+
+                public onunload() {
+                    clearInterval(this._interval);
+                }
+            }
+
+            export function controller():Controller {
+                var database = m.route.param("database");
+                var name = m.route.param("table");
+                return new Controller(database, name);
+            }
+
+            export function view(ctrl:Controller) {
+                var database = m.route.param("database");
+                var name = m.route.param("table");
+                return m("div", [
+                    m("h2", "Table Schema"),
+                    m("div", [
+                        m("h3", [
+                            "Table: ",
+                            m("a[href=/databases/" + encodeURIComponent(database) + "]", { config: m.route }, database),
+                            "." + name
+                        ]),
+                        ctrl.table.Details()
+                    ])
+                ]);
+            }
+        }
+    }
+}
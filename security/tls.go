@@ -170,6 +170,22 @@ func LoadInsecureClientTLSConfig() *tls.Config {
 	}
 }
 
+// GetCertificateUser returns the user named by the client certificate of
+// a verified TLS connection, which is the common name of its subject.
+// Node certificates name NodeCommonName.
+func GetCertificateUser(tlsState *tls.ConnectionState) (string, error) {
+	if tlsState == nil {
+		return "", util.Errorf("request is not using TLS")
+	}
+	if len(tlsState.PeerCertificates) == 0 {
+		return "", util.Errorf("no client certificates in request")
+	}
+	if len(tlsState.VerifiedChains) != len(tlsState.PeerCertificates) {
+		return "", util.Errorf("client certificates not verified")
+	}
+	return tlsState.PeerCertificates[0].Subject.CommonName, nil
+}
+
 // LogRequestCertificates examines a http request and logs a summary of the TLS config.
 func LogRequestCertificates(r *http.Request) {
 	if r.TLS == nil {
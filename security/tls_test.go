@@ -18,7 +18,9 @@
 package security_test
 
 import (
+	"crypto/tls"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"testing"
 
 	"github.com/cockroachdb/cockroach/security"
@@ -66,3 +68,26 @@ func verifyX509Cert(cert *x509.Certificate, dnsName string, roots *x509.CertPool
 	_, err := cert.Verify(verifyOptions)
 	return err
 }
+
+func TestGetCertificateUser(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "alice"}}
+	testCases := []struct {
+		state *tls.ConnectionState
+		user  string
+		ok    bool
+	}{
+		{nil, "", false},
+		{&tls.ConnectionState{}, "", false},
+		{&tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}, "", false},
+		{&tls.ConnectionState{
+			PeerCertificates: []*x509.Certificate{cert},
+			VerifiedChains:   [][]*x509.Certificate{{cert}},
+		}, "alice", true},
+	}
+	for i, test := range testCases {
+		user, err := security.GetCertificateUser(test.state)
+		if (err == nil) != test.ok || user != test.user {
+			t.Errorf("%d: expected user %q (ok=%t), but found %q (err=%v)", i, test.user, test.ok, user, err)
+		}
+	}
+}
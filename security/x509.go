@@ -39,6 +39,9 @@ import (
 const (
 	validFor      = time.Hour * 24 * 365
 	maxPathLength = 2
+
+	// NodeCommonName is the common name of node certificates.
+	NodeCommonName = "Cockroach Node"
 )
 
 // generateKeyPair returns a random 'keySize' bit RSA key pair.
@@ -139,7 +142,7 @@ func GenerateNodeCert(caCert *x509.Certificate, caKey crypto.PrivateKey, keySize
 		return nil, nil, err
 	}
 
-	template, err := newTemplate("Cockroach Node")
+	template, err := newTemplate(NodeCommonName)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -54,6 +54,9 @@ const (
 	permPathPrefix = adminEndpoint + "perms"
 	// zonePathPrefix is the prefix for zone configuration changes.
 	zonePathPrefix = adminEndpoint + "zones"
+	// databasesPathPrefix is the prefix for descriptions of databases and
+	// tables.
+	databasesPathPrefix = adminEndpoint + "databases"
 )
 
 // An actionHandler is an interface which provides Get, Put & Delete
@@ -70,25 +73,32 @@ type adminServer struct {
 	db      *client.DB    // Key-value database client
 	stopper *util.Stopper // Used to shutdown the server
 	acct    *acctHandler
+	dbs     *databaseHandler
 	perm    *permHandler
 	zone    *zoneHandler
+	table   *tableHandler
 	mux     *http.ServeMux
 }
 
 // newAdminServer allocates and returns a new REST server for
-// administrative APIs.
-func newAdminServer(db *client.DB, stopper *util.Stopper) *adminServer {
+// administrative APIs. The rows of tables are read and written on behalf
+// of the user of each request, as returned by user.
+func newAdminServer(db *client.DB, stopper *util.Stopper, user func(r *http.Request) (string, error)) *adminServer {
 	server := &adminServer{
 		db:      db,
 		stopper: stopper,
 		acct:    &acctHandler{db: db},
+		dbs:     &databaseHandler{db: db},
 		perm:    &permHandler{db: db},
 		zone:    &zoneHandler{db: db},
+		table:   &tableHandler{db: db, user: user},
 		mux:     http.NewServeMux(),
 	}
 
 	server.mux.HandleFunc(acctPathPrefix, server.handleAcctAction)
 	server.mux.HandleFunc(acctPathPrefix+"/", server.handleAcctAction)
+	server.mux.HandleFunc(databasesPathPrefix, server.handleDatabaseAction)
+	server.mux.HandleFunc(databasesPathPrefix+"/", server.handleDatabaseAction)
 	server.mux.HandleFunc(debugEndpoint, server.handleDebug)
 	server.mux.HandleFunc(healthPath, server.handleHealth)
 	server.mux.HandleFunc(quitPath, server.handleQuit)
@@ -131,6 +141,12 @@ func (s *adminServer) handleAcctAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.acct, w, r, acctPathPrefix)
 }
 
+// handleDatabaseAction handles descriptions of databases and tables by
+// method.
+func (s *adminServer) handleDatabaseAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.dbs, w, r, databasesPathPrefix)
+}
+
 // handlePermAction handles actions for perm configuration by method.
 func (s *adminServer) handlePermAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.perm, w, r, permPathPrefix)
@@ -141,6 +157,13 @@ func (s *adminServer) handleZoneAction(w http.ResponseWriter, r *http.Request) {
 	s.handleRESTAction(s.zone, w, r, zonePathPrefix)
 }
 
+// handleTableAction handles reads and writes of the rows of tables by
+// method. Unlike the other actions, it is served under tablePathPrefix,
+// which requires authentication.
+func (s *adminServer) handleTableAction(w http.ResponseWriter, r *http.Request) {
+	s.handleRESTAction(s.table, w, r, tablePathPrefix)
+}
+
 // handleRESTAction handles RESTful admin actions.
 func (s *adminServer) handleRESTAction(handler actionHandler, w http.ResponseWriter, r *http.Request, prefix string) {
 	switch r.Method {
@@ -27,6 +27,7 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/util"
@@ -43,7 +44,9 @@ func startAdminServer() (string, *util.Stopper) {
 	if err != nil {
 		log.Fatal(err)
 	}
-	admin := newAdminServer(db, stopper)
+	admin := newAdminServer(db, stopper, func(*http.Request) (string, error) {
+		return storage.UserRoot, nil
+	})
 	mux := http.NewServeMux()
 	mux.Handle(adminEndpoint, admin)
 	mux.Handle(debugEndpoint, admin)
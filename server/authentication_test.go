@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/kv"
 	"github.com/cockroachdb/cockroach/testutils"
 	"github.com/cockroachdb/cockroach/ts"
@@ -50,6 +51,7 @@ func TestSSLEnforcement(t *testing.T) {
 
 		// /_admin/: server.adminServer: no auth.
 		{"GET", healthPath, http.StatusOK, http.StatusOK},
+		{"GET", databasesPathPrefix, http.StatusOK, http.StatusOK},
 
 		// /debug/: server.adminServer: no auth.
 		{"GET", debugEndpoint + "vars", http.StatusOK, http.StatusOK},
@@ -62,6 +64,12 @@ func TestSSLEnforcement(t *testing.T) {
 
 		// /ts/: ts.Server.
 		{"GET", ts.URLPrefix, http.StatusNotFound, http.StatusUnauthorized},
+
+		// /sql/: server.sqlServer. Statements must be posted.
+		{"GET", client.SQLEndpoint, http.StatusMethodNotAllowed, http.StatusUnauthorized},
+
+		// /table/: server.adminServer. The path must name a table's rows.
+		{"GET", tablePathPrefix, http.StatusInternalServerError, http.StatusUnauthorized},
 	}
 
 	// HTTPS with client certs.
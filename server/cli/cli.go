@@ -68,7 +68,11 @@ func init() {
 		acctCmd,
 		permCmd,
 		rangeCmd,
+		tableCmd,
+		schemaCmd,
 		zoneCmd,
+		dumpCmd,
+		debugCmd,
 
 		// Miscellaneous commands.
 		// TODO(pmattis): stats
@@ -206,6 +206,91 @@ func ExampleSplitMergeRanges() {
 	// node drained and shutdown: ok
 }
 
+func ExampleTables() {
+	c := newCLITest()
+
+	c.Run("table create testdata/users.json")
+	c.Run("table create testdata/users.json")
+	c.Run("table ls")
+	c.Run("table describe users")
+	c.Run("dump users")
+	c.Run("table rename users accounts")
+	c.Run("table ls default acc*")
+	c.Run("table drop accounts")
+	c.Run("table ls")
+	c.Run("table describe accounts")
+	c.Run("quit")
+
+	// Output:
+	// table create testdata/users.json
+	// table create testdata/users.json
+	// create table failed: table "users" already exists
+	// table ls
+	// users
+	// table describe users
+	// users
+	// 	id	INT
+	// 	name	STRING
+	// 	primary UNIQUE (id)
+	// 	by_name (name)
+	// dump users
+	// CREATE TABLE `users` (
+	//   `id` INT,
+	//   `name` STRING,
+	//   CONSTRAINT `primary` PRIMARY KEY (`id`),
+	//   INDEX `by_name` (`name`)
+	// );
+	// table rename users accounts
+	// table ls default acc*
+	// accounts
+	// table drop accounts
+	// table ls
+	// table describe accounts
+	// describe table failed: table "accounts" does not exist
+	// quit
+	// node drained and shutdown: ok
+}
+
+func ExampleApplySchema() {
+	c := newCLITest()
+
+	c.Run("schema apply testdata")
+	c.Run("schema apply testdata")
+	c.Run("table ls")
+	c.Run("quit")
+
+	// Output:
+	// schema apply testdata
+	// create table "users"
+	// schema apply testdata
+	// no changes
+	// table ls
+	// users
+	// quit
+	// node drained and shutdown: ok
+}
+
+func ExampleDecodeKey() {
+	c := newCLITest()
+
+	c.Run("kv put a 1")
+	c.Run("kv scan --pretty")
+	c.Run("debug decode-key 61")
+	c.Run("debug decode-key zz")
+	c.Run("quit")
+
+	// Output:
+	// kv put a 1
+	// kv scan --pretty
+	// "a"	"1"
+	// debug decode-key 61
+	// decode failed: key "a" is not in the structured keyspace
+	// debug decode-key zz
+	// invalid key "zz": encoding/hex: invalid byte: U+007A 'z'
+	// quit
+	// node drained and shutdown: ok
+}
+
 func ExampleGlogFlags() {
 	c := newCLITest()
 
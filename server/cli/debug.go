@@ -0,0 +1,100 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+
+	"github.com/spf13/cobra"
+)
+
+// prettyKeys, set by the --pretty flag, causes the keys listed by the kv
+// scan and range ls commands to be decoded as by decode-key.
+var prettyKeys bool
+
+// prettyKey returns the decoded form of a key of the data of a table if
+// --pretty was specified, or the quoted key otherwise or if it cannot be
+// decoded.
+func prettyKey(kvDB *client.DB, key proto.Key) string {
+	if prettyKeys {
+		if k, err := kvDB.DecodeKey(key); err == nil {
+			return k.String()
+		}
+	}
+	return key.String()
+}
+
+// A decodeKeyCmd command decodes a key of the data of a table.
+var decodeKeyCmd = &cobra.Command{
+	Use:   "decode-key [options] <hex-key>",
+	Short: "decodes a key of the data of a table\n",
+	Long: `
+Decodes <hex-key>, a hex-encoded key of the data of a table, and
+displays the table, index, key column values and column it addresses.
+For example, the key of the name column of the row of the users table
+whose id is 1 is displayed as:
+
+  /users(51)/primary(1)/id=1/name
+
+The numbers in parentheses are the IDs of the table and index.
+`,
+	Run: runDecodeKey,
+}
+
+func runDecodeKey(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	key, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+	if err != nil {
+		fmt.Fprintf(osStderr, "invalid key %q: %s\n", args[0], err)
+		osExit(1)
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	k, err := kvDB.DecodeKey(key)
+	if err != nil {
+		fmt.Fprintf(osStderr, "decode failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("%s\n", k)
+}
+
+var debugCmds = []*cobra.Command{
+	decodeKeyCmd,
+}
+
+var debugCmd = &cobra.Command{
+	Use:   "debug",
+	Short: "debugging commands",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+	},
+}
+
+func init() {
+	debugCmd.AddCommand(debugCmds...)
+}
@@ -0,0 +1,53 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// A dumpCmd command dumps the schema and rows of a table.
+var dumpCmd = &cobra.Command{
+	Use:   "dump [options] <table>",
+	Short: "dumps the schema and rows of a table\n",
+	Long: `
+Writes the schema of <table> as a CREATE TABLE statement to standard
+output, followed by an INSERT statement for each of its rows in
+primary key order. The INSERT statements can be executed to re-import
+the rows into a table with the same schema. The table is read in
+chunks, so large tables can be dumped.
+`,
+	Run: runDump,
+}
+
+func runDump(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	if err := kvDB.DumpTable(args[0], os.Stdout); err != nil {
+		fmt.Fprintf(osStderr, "dump failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
@@ -102,6 +102,10 @@ var flagUsage = map[string]string{
 `,
 	"metrics-frequency": `
         Adjust the frequency at which the server records its own internal metrics.
+`,
+	"pretty": `
+        Decode the keys of the data of tables, displaying their table,
+        index, key column values and column.
 `,
 	"scan-interval": `
         Adjusts the target for the duration of a single scan through a store's
@@ -111,6 +115,14 @@ var flagUsage = map[string]string{
 	"scan-max-idle-time": `
         Adjusts the max idle time of the scanner. This speeds up the scanner on small
         clusters to be more responsive.
+`,
+	"table-gc-grace-period": `
+        Adjusts the time after which the data of dropped tables is deleted by
+        the servers. A value of 0 leaves the deletion to clients.
+`,
+	"table-stats-interval": `
+        Adjusts the interval at which the servers collect the statistics of
+        tables. A value of 0 leaves the collection to clients.
 `,
 	"stores": `
         A comma-separated list of stores, specified by a colon-separated list
@@ -171,6 +183,10 @@ func initFlags(ctx *server.Context) {
 		f.DurationVar(&ctx.ScanInterval, "scan-interval", ctx.ScanInterval, flagUsage["scan-interval"])
 		f.DurationVar(&ctx.ScanMaxIdleTime, "scan-max-idle-time", ctx.ScanMaxIdleTime,
 			flagUsage["scan-max-idle-time"])
+		f.DurationVar(&ctx.TableGCGracePeriod, "table-gc-grace-period", ctx.TableGCGracePeriod,
+			flagUsage["table-gc-grace-period"])
+		f.DurationVar(&ctx.TableStatsInterval, "table-stats-interval", ctx.TableStatsInterval,
+			flagUsage["table-stats-interval"])
 
 		startCmd.MarkFlagRequired("gossip")
 		startCmd.MarkFlagRequired("stores")
@@ -189,7 +205,13 @@ func initFlags(ctx *server.Context) {
 		cmd.MarkFlagRequired("key-size")
 	}
 
-	clientCmds := []*cobra.Command{kvCmd, rangeCmd, acctCmd, permCmd, zoneCmd, quitCmd}
+	for _, cmd := range []*cobra.Command{scanCmd, lsRangesCmd} {
+		f := cmd.Flags()
+		f.BoolVar(&prettyKeys, "pretty", false, flagUsage["pretty"])
+	}
+
+	clientCmds := []*cobra.Command{kvCmd, rangeCmd, acctCmd, permCmd, zoneCmd,
+		tableCmd, schemaCmd, dumpCmd, debugCmd, quitCmd}
 	for _, cmd := range clientCmds {
 		f := cmd.PersistentFlags()
 		f.StringVar(&ctx.Addr, "addr", ctx.Addr, flagUsage["addr"])
@@ -273,7 +273,7 @@ func runScan(cmd *cobra.Command, args []string) {
 			continue
 		}
 
-		key := proto.Key(row.Key)
+		key := prettyKey(kvDB, row.Key)
 		if i, ok := row.Value.(*int64); ok {
 			fmt.Printf("%s\t%d\n", key, *i)
 		} else {
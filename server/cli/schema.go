@@ -0,0 +1,112 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/cockroachdb/cockroach/proto"
+
+	"github.com/spf13/cobra"
+)
+
+// An applySchemaCmd command applies a directory of table schema files.
+var applySchemaCmd = &cobra.Command{
+	Use:   "apply [options] <dir>",
+	Short: "applies a directory of table schema files\n",
+	Long: `
+Reads the table schema files in <dir>, all files with a .json suffix
+in the format accepted by "table create", and compares them with the
+tables of the cluster. The planned changes are displayed and then
+applied in dependency order: missing databases are created first, then
+missing tables, then the missing columns of existing tables and finally
+their missing indexes.
+
+Columns and indexes of existing tables which are absent from the schema
+files are left in place. Existing columns, indexes and primary keys
+cannot be changed: no change is applied if the schema files would
+change any of them.
+`,
+	Run: runApplySchema,
+}
+
+func runApplySchema(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	paths, err := filepath.Glob(filepath.Join(args[0], "*.json"))
+	if err != nil {
+		fmt.Fprintf(osStderr, "unable to list schema files: %s\n", err)
+		osExit(1)
+		return
+	}
+	if len(paths) == 0 {
+		fmt.Fprintf(osStderr, "no schema files found in %q\n", args[0])
+		osExit(1)
+		return
+	}
+	var schemas []proto.TableSchema
+	for _, path := range paths {
+		schema, err := readSchemaFile(path)
+		if err != nil {
+			fmt.Fprintf(osStderr, "%s\n", err)
+			osExit(1)
+			return
+		}
+		schemas = append(schemas, schema)
+	}
+
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	changes, err := kvDB.PlanSchemaChanges(schemas)
+	if err != nil {
+		fmt.Fprintf(osStderr, "schema apply failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	if len(changes) == 0 {
+		fmt.Printf("no changes\n")
+		return
+	}
+	for _, c := range changes {
+		fmt.Printf("%s\n", c)
+	}
+	if err := kvDB.ApplySchemaChanges(changes); err != nil {
+		fmt.Fprintf(osStderr, "schema apply failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+var schemaCmds = []*cobra.Command{
+	applySchemaCmd,
+}
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "apply table schemas",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+	},
+}
+
+func init() {
+	schemaCmd.AddCommand(schemaCmds...)
+}
@@ -0,0 +1,245 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/proto"
+
+	"github.com/spf13/cobra"
+)
+
+// A lsTablesCmd command lists the tables of a database.
+var lsTablesCmd = &cobra.Command{
+	Use:   "ls [options] [<database> [<pattern>]]",
+	Short: "lists the tables of a database",
+	Long: `
+Lists the names of the tables in <database>, or in the default database
+if none is specified. If a pattern such as "user*" is given, only the
+tables whose names match it are listed.
+`,
+	Run: runLsTables,
+}
+
+func runLsTables(cmd *cobra.Command, args []string) {
+	if len(args) > 2 {
+		cmd.Usage()
+		return
+	}
+	var database, pattern string
+	if len(args) >= 1 {
+		database = args[0]
+	}
+	if len(args) >= 2 {
+		pattern = args[1]
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	names, err := kvDB.ListTables(database, pattern)
+	if err != nil {
+		fmt.Fprintf(osStderr, "list tables failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	for _, name := range names {
+		fmt.Printf("%s\n", name)
+	}
+}
+
+// A describeTableCmd command displays the schema of a table.
+var describeTableCmd = &cobra.Command{
+	Use:   "describe [options] <table>",
+	Short: "displays the schema of a table",
+	Long: `
+Displays the columns and indexes of <table>. The first index listed is
+the primary key. The table name may be qualified with a database name
+("<database>.<table>").
+`,
+	Run: runDescribeTable,
+}
+
+func runDescribeTable(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	schema, err := kvDB.DescribeTable(args[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "describe table failed: %s\n", err)
+		osExit(1)
+		return
+	}
+	fmt.Printf("%s\n", schema.Name)
+	for _, column := range schema.Columns {
+		fmt.Printf("\t%s\t%s\n", column.Name, column.Type)
+	}
+	for _, index := range schema.Indexes {
+		var kind string
+		if index.Unique {
+			kind = " UNIQUE"
+		}
+		parts := index.ColumnNames
+		if len(index.KeyExprs) > 0 {
+			parts = index.KeyExprs
+		}
+		fmt.Printf("\t%s%s (%s)\n", index.Name, kind, strings.Join(parts, ", "))
+	}
+}
+
+// A createTableCmd command creates a table from a schema file.
+var createTableCmd = &cobra.Command{
+	Use:   "create [options] <schema-file>",
+	Short: "creates a table from a schema file",
+	Long: `
+Creates a table from the JSON-formatted schema in <schema-file>. The
+first index is the primary key and is required. For example:
+
+  {
+    "table": {"name": "users"},
+    "columns": [
+      {"name": "id", "type": "INT"},
+      {"name": "name", "type": "STRING"}
+    ],
+    "indexes": [
+      {"index": {"name": "primary", "unique": true}, "column_names": ["id"]},
+      {"index": {"name": "by_name"}, "column_names": ["name"]}
+    ]
+  }
+
+Column types are BYTES, BOOL, INT, FLOAT, STRING and JSON. The table
+name may be qualified with a database name ("<database>.<table>").
+`,
+	Run: runCreateTable,
+}
+
+func runCreateTable(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	schema, err := readSchemaFile(args[0])
+	if err != nil {
+		fmt.Fprintf(osStderr, "%s\n", err)
+		osExit(1)
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	if err := kvDB.CreateTable(schema); err != nil {
+		fmt.Fprintf(osStderr, "create table failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+// readSchemaFile reads a JSON-formatted table schema from a file.
+func readSchemaFile(path string) (proto.TableSchema, error) {
+	var schema proto.TableSchema
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return schema, fmt.Errorf("unable to read schema file %q: %s", path, err)
+	}
+	if err := json.Unmarshal(b, &schema); err != nil {
+		return schema, fmt.Errorf("unable to parse schema file %q: %s", path, err)
+	}
+	return schema, nil
+}
+
+// A dropTableCmd command drops a table.
+var dropTableCmd = &cobra.Command{
+	Use:   "drop [options] <table>",
+	Short: "drops a table",
+	Long: `
+Drops <table>, making it inaccessible by name. The data of the table
+is reclaimed once a grace period has passed.
+`,
+	Run: runDropTable,
+}
+
+func runDropTable(cmd *cobra.Command, args []string) {
+	if len(args) != 1 {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	if err := kvDB.DropTable(args[0]); err != nil {
+		fmt.Fprintf(osStderr, "drop table failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+// A renameTableCmd command renames a table.
+var renameTableCmd = &cobra.Command{
+	Use:   "rename [options] <table> <new-name>",
+	Short: "renames a table\n",
+	Long: `
+Renames <table> to <new-name>. The table's data and indexes are left
+in place.
+`,
+	Run: runRenameTable,
+}
+
+func runRenameTable(cmd *cobra.Command, args []string) {
+	if len(args) != 2 {
+		cmd.Usage()
+		return
+	}
+	kvDB := makeDBClient()
+	if kvDB == nil {
+		return
+	}
+	if err := kvDB.RenameTable(args[0], args[1]); err != nil {
+		fmt.Fprintf(osStderr, "rename table failed: %s\n", err)
+		osExit(1)
+		return
+	}
+}
+
+var tableCmds = []*cobra.Command{
+	lsTablesCmd,
+	describeTableCmd,
+	createTableCmd,
+	dropTableCmd,
+	renameTableCmd,
+}
+
+var tableCmd = &cobra.Command{
+	Use:   "table",
+	Short: "list, describe, create, drop and rename tables",
+	Run: func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+	},
+}
+
+func init() {
+	tableCmd.AddCommand(tableCmds...)
+}
@@ -35,13 +35,15 @@ import (
 
 // Context defaults.
 const (
-	defaultAddr             = ":8080"
-	defaultMaxOffset        = 250 * time.Millisecond
-	defaultGossipInterval   = 2 * time.Second
-	defaultCacheSize        = 1 << 30 // GB
-	defaultScanInterval     = 10 * time.Minute
-	defaultScanMaxIdleTime  = 5 * time.Second
-	defaultMetricsFrequency = 10 * time.Second
+	defaultAddr               = ":8080"
+	defaultMaxOffset          = 250 * time.Millisecond
+	defaultGossipInterval     = 2 * time.Second
+	defaultCacheSize          = 1 << 30 // GB
+	defaultScanInterval       = 10 * time.Minute
+	defaultScanMaxIdleTime    = 5 * time.Second
+	defaultMetricsFrequency   = 10 * time.Second
+	defaultTableGCGracePeriod = 24 * time.Hour
+	defaultTableStatsInterval = 1 * time.Hour
 )
 
 // Context holds parameters needed to setup a server.
@@ -117,6 +119,15 @@ type Context struct {
 	// stores.
 	ScanMaxIdleTime time.Duration
 
+	// TableGCGracePeriod is the time after which the data of dropped tables
+	// is deleted by the range leaders. Zero disables the deletion, leaving
+	// it to clients.
+	TableGCGracePeriod time.Duration
+
+	// TableStatsInterval is the interval at which the statistics of tables
+	// are collected. Zero disables the collection, leaving it to clients.
+	TableStatsInterval time.Duration
+
 	// MetricsFrequency determines the frequency at which the server should
 	// record internal metrics.
 	MetricsFrequency time.Duration
@@ -125,13 +136,15 @@ type Context struct {
 // NewContext returns a Context with default values.
 func NewContext() *Context {
 	ctx := &Context{
-		Addr:             defaultAddr,
-		MaxOffset:        defaultMaxOffset,
-		GossipInterval:   defaultGossipInterval,
-		CacheSize:        defaultCacheSize,
-		ScanInterval:     defaultScanInterval,
-		ScanMaxIdleTime:  defaultScanMaxIdleTime,
-		MetricsFrequency: defaultMetricsFrequency,
+		Addr:               defaultAddr,
+		MaxOffset:          defaultMaxOffset,
+		GossipInterval:     defaultGossipInterval,
+		CacheSize:          defaultCacheSize,
+		ScanInterval:       defaultScanInterval,
+		ScanMaxIdleTime:    defaultScanMaxIdleTime,
+		MetricsFrequency:   defaultMetricsFrequency,
+		TableGCGracePeriod: defaultTableGCGracePeriod,
+		TableStatsInterval: defaultTableStatsInterval,
 	}
 	// Initializes base context defaults.
 	ctx.InitDefaults()
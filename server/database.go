@@ -0,0 +1,152 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// databaseList holds the names of the databases, as returned by Get.
+type databaseList struct {
+	Databases []string `json:"databases"`
+}
+
+// databaseInfo describes a database and its tables.
+type databaseInfo struct {
+	Name   string      `json:"name"`
+	Tables []tableInfo `json:"tables"`
+}
+
+// tableInfo describes a table: its schema, the number of ranges holding
+// its data and its most recently collected statistics.
+type tableInfo struct {
+	Name       string            `json:"name"`
+	Schema     proto.TableSchema `json:"schema"`
+	RangeCount int               `json:"range_count"`
+	// Stats is nil if the statistics of the table have not been collected.
+	Stats *tableStatsInfo `json:"stats,omitempty"`
+}
+
+// tableStatsInfo holds the statistics of a table. CollectedAt is in
+// nanoseconds since the epoch.
+type tableStatsInfo struct {
+	RowCount    int64 `json:"row_count"`
+	DataSize    int64 `json:"data_size"`
+	CollectedAt int64 `json:"collected_at"`
+}
+
+// A databaseHandler implements the actionHandler interface, describing
+// the databases and tables of the cluster for the admin UI. The sizes of
+// tables are those recorded by the most recent collection of their
+// statistics. Databases and tables cannot be modified through it.
+//
+//   GET /_admin/databases
+//   GET /_admin/databases/<database>
+//   GET /_admin/databases/<database>/<table>
+type databaseHandler struct {
+	db *client.DB // Key-value database client
+}
+
+// Put is not supported.
+func (dh *databaseHandler) Put(path string, body []byte, r *http.Request) error {
+	return util.Errorf("databases cannot be modified through %s", databasesPathPrefix)
+}
+
+// Get returns the names of the databases if the path is empty, the
+// description of the tables of the named database if the path names a
+// database, or the description of a single table if the path is of the
+// form /<database>/<table>.
+func (dh *databaseHandler) Get(path string, r *http.Request) (body []byte, contentType string, err error) {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		names, err := dh.db.ListDatabases()
+		if err != nil {
+			return nil, "", err
+		}
+		return util.MarshalResponse(r, &databaseList{Databases: names}, tableEncodings)
+	}
+	parts := strings.Split(path, "/")
+	switch len(parts) {
+	case 1:
+		info, err := dh.describeDatabase(parts[0])
+		if err != nil {
+			return nil, "", err
+		}
+		return util.MarshalResponse(r, info, tableEncodings)
+	case 2:
+		schema, err := dh.db.DescribeTable(parts[0] + "." + parts[1])
+		if err != nil {
+			return nil, "", err
+		}
+		info, err := dh.describeTable(parts[0], schema)
+		if err != nil {
+			return nil, "", err
+		}
+		return util.MarshalResponse(r, info, tableEncodings)
+	}
+	return nil, "", util.Errorf("expected a path of the form %s[/<database>[/<table>]]: %q",
+		databasesPathPrefix, databasesPathPrefix+"/"+path)
+}
+
+// Delete is not supported.
+func (dh *databaseHandler) Delete(path string, r *http.Request) error {
+	return util.Errorf("databases cannot be modified through %s", databasesPathPrefix)
+}
+
+// describeDatabase describes the tables of the named database.
+func (dh *databaseHandler) describeDatabase(database string) (*databaseInfo, error) {
+	schemas, err := dh.db.ListTableDescriptors(database, "")
+	if err != nil {
+		return nil, err
+	}
+	info := &databaseInfo{Name: database, Tables: []tableInfo{}}
+	for _, schema := range schemas {
+		table, err := dh.describeTable(database, schema)
+		if err != nil {
+			return nil, err
+		}
+		info.Tables = append(info.Tables, *table)
+	}
+	return info, nil
+}
+
+// describeTable describes the table of the database with the given
+// schema, looking up its range count and statistics.
+func (dh *databaseHandler) describeTable(database string, schema proto.TableSchema) (*tableInfo, error) {
+	name := database + "." + schema.Table.Name
+	info := &tableInfo{Name: schema.Table.Name, Schema: schema}
+	var err error
+	if info.RangeCount, err = dh.db.TableRangeCount(name); err != nil {
+		return nil, err
+	}
+	stats, err := dh.db.TableStats(name)
+	if err != nil {
+		return nil, err
+	}
+	if stats != nil {
+		info.Stats = &tableStatsInfo{
+			RowCount:    stats.RowCount,
+			DataSize:    stats.DataSize,
+			CollectedAt: stats.CollectedAt.UnixNano(),
+		}
+	}
+	return info, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// TestDatabasesEndpoint verifies that the databases and tables of the
+// cluster are described over HTTP.
+func TestDatabasesEndpoint(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+	schema := proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := s.db.CreateDatabase("app"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.db.InsertTableRows("users",
+		map[string]interface{}{"id": int64(1), "name": "alice"},
+		map[string]interface{}{"id": int64(2), "name": "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.db.CollectTableStats("users"); err != nil {
+		t.Fatal(err)
+	}
+	httpClient, err := testContext.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	get := func(path string, expectedStatus int, v interface{}) {
+		url := testContext.RequestScheme() + "://" + s.ServingAddr() + databasesPathPrefix + path
+		req, err := http.NewRequest("GET", url, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(util.AcceptHeader, util.JSONContentType)
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != expectedStatus {
+			t.Fatalf("GET %s: expected status %d, but found %d: %s", path, expectedStatus, resp.StatusCode, b)
+		}
+		if v != nil {
+			if err := json.Unmarshal(b, v); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	var dbs databaseList
+	get("", http.StatusOK, &dbs)
+	if expected := []string{"app", "default"}; !reflect.DeepEqual(expected, dbs.Databases) {
+		t.Errorf("expected databases %q, but found %q", expected, dbs.Databases)
+	}
+
+	var db databaseInfo
+	get("/default", http.StatusOK, &db)
+	if len(db.Tables) != 1 {
+		t.Fatalf("expected a single table, but found %+v", db.Tables)
+	}
+	table := db.Tables[0]
+	if table.Name != "users" || len(table.Schema.Columns) != 2 || table.RangeCount < 1 {
+		t.Errorf("unexpected table %+v", table)
+	}
+	if table.Stats == nil || table.Stats.RowCount != 2 || table.Stats.DataSize == 0 {
+		t.Errorf("unexpected statistics %+v", table.Stats)
+	}
+	get("/app", http.StatusOK, &db)
+	if db.Name != "app" || len(db.Tables) != 0 {
+		t.Errorf("expected no tables, but found %+v", db)
+	}
+
+	var info tableInfo
+	get("/default/users", http.StatusOK, &info)
+	if !reflect.DeepEqual(table, info) {
+		t.Errorf("expected %+v, but found %+v", table, info)
+	}
+	get("/missing", http.StatusInternalServerError, nil)
+	get("/default/missing", http.StatusInternalServerError, nil)
+	get("/a/b/c", http.StatusInternalServerError, nil)
+}
@@ -592,6 +592,34 @@ func (n *nodeServer) EndTransaction(args *proto.EndTransactionRequest, reply *pr
 	return n.executeCmd(args, reply)
 }
 
+func (n *nodeServer) GetRow(args *proto.GetRowRequest, reply *proto.GetRowResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+func (n *nodeServer) PutRow(args *proto.PutRowRequest, reply *proto.PutRowResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+func (n *nodeServer) DeleteRow(args *proto.DeleteRowRequest, reply *proto.DeleteRowResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+func (n *nodeServer) ScanRows(args *proto.ScanRowsRequest, reply *proto.ScanRowsResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+func (n *nodeServer) LockRow(args *proto.LockRowRequest, reply *proto.LockRowResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+func (n *nodeServer) PutUnique(args *proto.PutUniqueRequest, reply *proto.PutUniqueResponse) error {
+	return n.executeCmd(args, reply)
+}
+
+func (n *nodeServer) ScanChanges(args *proto.ScanChangesRequest, reply *proto.ScanChangesResponse) error {
+	return n.executeCmd(args, reply)
+}
+
 func (n *nodeServer) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSplitResponse) error {
 	return n.executeCmd(args, reply)
 }
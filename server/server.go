@@ -64,6 +64,7 @@ type Server struct {
 	node          *Node
 	admin         *adminServer
 	status        *statusServer
+	sql           *sqlServer
 	tsDB          *ts.DB
 	tsServer      *ts.Server
 	raftTransport multiraft.Transport
@@ -125,17 +126,19 @@ func NewServer(ctx *Context, stopper *util.Stopper) (*Server, error) {
 	}
 	// TODO(bdarnell): make StoreConfig configurable.
 	nCtx := storage.StoreContext{
-		Clock:           s.clock,
-		DB:              s.db,
-		Gossip:          s.gossip,
-		Transport:       s.raftTransport,
-		ScanInterval:    s.ctx.ScanInterval,
-		ScanMaxIdleTime: s.ctx.ScanMaxIdleTime,
-		EventFeed:       &util.Feed{},
+		Clock:              s.clock,
+		DB:                 s.db,
+		Gossip:             s.gossip,
+		Transport:          s.raftTransport,
+		ScanInterval:       s.ctx.ScanInterval,
+		ScanMaxIdleTime:    s.ctx.ScanMaxIdleTime,
+		TableGCGracePeriod: s.ctx.TableGCGracePeriod,
+		EventFeed:          &util.Feed{},
 	}
 	s.node = NewNode(nCtx)
-	s.admin = newAdminServer(s.db, s.stopper)
+	s.admin = newAdminServer(s.db, s.stopper, s.requestUser)
 	s.status = newStatusServer(s.db, s.gossip)
+	s.sql = newSQLServer(s.db, s.requestUser)
 	s.tsDB = ts.NewDB(s.db)
 	s.tsServer = ts.NewServer(s.tsDB)
 	s.stopper.AddCloser(nCtx.EventFeed)
@@ -173,6 +176,12 @@ func (s *Server) Start(selfBootstrap bool) error {
 	runtime := status.NewRuntimeStatRecorder(s.node.Descriptor.NodeID, s.clock)
 	s.tsDB.PollSource(runtime, s.ctx.MetricsFrequency, ts.Resolution10s, s.stopper)
 
+	// Begin collecting the statistics of tables. Only the node holding the
+	// table statistics lease collects them.
+	if s.ctx.TableStatsInterval > 0 {
+		s.db.RunTableStats(s.stopper, s.ctx.TableStatsInterval)
+	}
+
 	log.Infof("starting %s server at %s", s.ctx.RequestScheme(), s.rpc.Addr())
 	// TODO(spencer): go1.5 is supposed to allow shutdown of running http server.
 	s.initHTTP()
@@ -193,6 +202,8 @@ func (s *Server) initHTTP() {
 
 	s.mux.HandleFunc(kv.DBPrefix, s.authenticateRequest(s.kvDB))
 	s.mux.HandleFunc(ts.URLPrefix, s.authenticateRequest(s.tsServer))
+	s.mux.HandleFunc(client.SQLEndpoint, s.authenticateRequest(s.sql))
+	s.mux.HandleFunc(tablePathPrefix, s.authenticateRequest(http.HandlerFunc(s.admin.handleTableAction)))
 }
 
 // authenticateRequest is a simple wrapper around a http handler.
@@ -215,7 +226,7 @@ func (s *Server) authenticateRequest(handler http.Handler) func(http.ResponseWri
 		// TODO(marc): we should verify that the chain ends in our CA. Is it really needed though? It should be the only
 		// one in the pool.
 		// We should probably check for exactly one cert.
-		if r.TLS == nil || len(r.TLS.PeerCertificates) == 0 || len(r.TLS.VerifiedChains) != len(r.TLS.PeerCertificates) {
+		if _, err := security.GetCertificateUser(r.TLS); err != nil {
 			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
 			return
 		}
@@ -223,6 +234,23 @@ func (s *Server) authenticateRequest(handler http.Handler) func(http.ResponseWri
 	}
 }
 
+// requestUser returns the user on whose behalf an authenticated request
+// is made: the user named by its client certificate, or root if the
+// server is insecure. Node certificates act as root.
+func (s *Server) requestUser(r *http.Request) (string, error) {
+	if s.ctx.Insecure {
+		return storage.UserRoot, nil
+	}
+	user, err := security.GetCertificateUser(r.TLS)
+	if err != nil {
+		return "", err
+	}
+	if user == security.NodeCommonName {
+		return storage.UserRoot, nil
+	}
+	return user, nil
+}
+
 // Stop stops the server.
 func (s *Server) Stop() {
 	s.stopper.Stop()
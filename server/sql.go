@@ -0,0 +1,77 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+// sqlEncodings are the encodings of the requests and responses of the
+// SQL endpoint, whose types are not protocol messages.
+var sqlEncodings = []util.EncodingType{util.JSONEncoding, util.YAMLEncoding}
+
+// An sqlServer executes the statements of the client.SQLRequests posted
+// to client.SQLEndpoint on behalf of the users making the requests.
+type sqlServer struct {
+	db   *client.DB
+	user func(r *http.Request) (string, error) // Returns the user of a request
+}
+
+// newSQLServer allocates and returns an sqlServer.
+func newSQLServer(db *client.DB, user func(r *http.Request) (string, error)) *sqlServer {
+	return &sqlServer{db: db, user: user}
+}
+
+// ServeHTTP implements the http.Handler interface. Statements which fail
+// are answered with the error and http.StatusBadRequest.
+func (s *sqlServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	reqBody, err := ioutil.ReadAll(r.Body)
+	defer r.Body.Close()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	user, err := s.user(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	request := &client.SQLRequest{}
+	if err := util.UnmarshalRequest(r, reqBody, request, sqlEncodings); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	result, err := s.db.WithUser(user).ExecSQL(request.Statement)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	b, contentType, err := util.MarshalResponse(r, &result, sqlEncodings)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set(util.ContentTypeHeader, contentType)
+	w.Write(b)
+}
@@ -0,0 +1,75 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+)
+
+// TestSQLEndpoint verifies that statements posted to the SQL endpoint
+// are executed on the tables of the cluster.
+func TestSQLEndpoint(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+	schema := proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := s.db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+
+	result, err := client.PostSQL(testBaseContext, s.ServingAddr(),
+		"INSERT INTO users (id, name) VALUES (1, 'alice'), (2, 'bob')")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.RowsAffected != 2 {
+		t.Errorf("expected 2 rows to be inserted, but found %d", result.RowsAffected)
+	}
+	if name, err := s.db.GetTableRow("users", map[string]interface{}{"id": 2}, "name"); err != nil {
+		t.Fatal(err)
+	} else if name["name"] != "bob" {
+		t.Errorf("expected bob, but found %v", name)
+	}
+
+	result, err = client.PostSQL(testBaseContext, s.ServingAddr(), "SELECT * FROM users WHERE id > 1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := client.SQLResult{
+		Columns: []string{"id", "name"},
+		Rows:    [][]interface{}{{json.Number("2"), "bob"}},
+	}
+	if !reflect.DeepEqual(expected, result) {
+		t.Errorf("expected %+v, but found %+v", expected, result)
+	}
+
+	if _, err := client.PostSQL(testBaseContext, s.ServingAddr(), "SELECT * FROM missing"); err == nil {
+		t.Errorf("expected a statement on a missing table to fail")
+	}
+}
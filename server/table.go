@@ -0,0 +1,263 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+const (
+	// tablePathPrefix is the prefix for reads and writes of the rows of
+	// tables: /table/<name>/rows.
+	tablePathPrefix = "/table/"
+	// tableRowsSuffix is the suffix of the paths of the rows of a table.
+	tableRowsSuffix = "/rows"
+
+	// paramLimit limits the number of rows read.
+	paramLimit = "limit"
+	// paramColumns lists the comma-separated columns of the rows read.
+	paramColumns = "columns"
+)
+
+// tableEncodings are the encodings of the rows returned by Get.
+var tableEncodings = []util.EncodingType{util.JSONEncoding, util.YAMLEncoding}
+
+// tableRows holds the rows of a table, as returned by Get and accepted by
+// Put.
+type tableRows struct {
+	Rows []map[string]interface{} `json:"rows"`
+}
+
+// A tableHandler implements the actionHandler interface, reading and
+// writing the rows of tables as JSON. The query parameters of reads and
+// deletes other than limit and columns select the rows whose columns
+// equal their values. Values of BYTES columns are base64-encoded.
+//
+//   GET /table/users/rows?name=alice&columns=id,email&limit=10
+//   POST /table/users/rows {"rows": [{"id": 1, "name": "alice"}]}
+//   DELETE /table/users/rows?id=1
+type tableHandler struct {
+	db   *client.DB                            // Key-value database client
+	user func(r *http.Request) (string, error) // Returns the user of a request
+}
+
+// userDB returns the DB handle through which the rows of tables are read
+// and written on behalf of the user of the request.
+func (th *tableHandler) userDB(r *http.Request) (*client.DB, error) {
+	user, err := th.user(r)
+	if err != nil {
+		return nil, err
+	}
+	return th.db.WithUser(user), nil
+}
+
+// tableName returns the name of the table whose rows are addressed by the
+// path.
+func tableName(path string) (string, error) {
+	name := strings.TrimSuffix(path, tableRowsSuffix)
+	if name == path || name == "" || strings.Contains(name, "/") {
+		return "", util.Errorf("expected a path of the form %s<table>%s: %q",
+			tablePathPrefix, tableRowsSuffix, tablePathPrefix+path)
+	}
+	return name, nil
+}
+
+// Put inserts the rows of the JSON-formatted body into the table. It
+// fails if any of the rows exist.
+func (th *tableHandler) Put(path string, body []byte, r *http.Request) error {
+	name, err := tableName(path)
+	if err != nil {
+		return err
+	}
+	db, err := th.userDB(r)
+	if err != nil {
+		return err
+	}
+	desc, err := db.DescribeTableDesc(name)
+	if err != nil {
+		return err
+	}
+	var rows tableRows
+	d := json.NewDecoder(bytes.NewReader(body))
+	d.UseNumber()
+	if err := d.Decode(&rows); err != nil {
+		return util.Errorf("unable to parse rows: %s", err)
+	}
+	for _, row := range rows.Rows {
+		for _, column := range desc.Columns {
+			if v, ok := row[column.Name]; ok {
+				if row[column.Name], err = decodeJSONValue(column, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return db.InsertTableRows(name, rows.Rows...)
+}
+
+// Get returns the rows of the table selected by the query parameters in
+// primary key order.
+func (th *tableHandler) Get(path string, r *http.Request) (body []byte, contentType string, err error) {
+	name, err := tableName(path)
+	if err != nil {
+		return nil, "", err
+	}
+	db, err := th.userDB(r)
+	if err != nil {
+		return nil, "", err
+	}
+	desc, err := db.DescribeTableDesc(name)
+	if err != nil {
+		return nil, "", err
+	}
+	opts, err := tableScanOptions(&desc, r.URL.Query())
+	if err != nil {
+		return nil, "", err
+	}
+	rows, err := db.ScanTable(name, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return util.MarshalResponse(r, &tableRows{Rows: rows}, tableEncodings)
+}
+
+// Delete deletes the rows of the table selected by the query parameters,
+// of which there must be at least one filter.
+func (th *tableHandler) Delete(path string, r *http.Request) error {
+	name, err := tableName(path)
+	if err != nil {
+		return err
+	}
+	db, err := th.userDB(r)
+	if err != nil {
+		return err
+	}
+	desc, err := db.DescribeTableDesc(name)
+	if err != nil {
+		return err
+	}
+	query := r.URL.Query()
+	if len(query) == len(query[paramLimit]) {
+		return util.Errorf("deleting the rows of table %q requires a filter", name)
+	}
+	if _, ok := query[paramColumns]; ok {
+		return util.Errorf("columns cannot be specified when deleting rows")
+	}
+	opts, err := tableScanOptions(&desc, query)
+	if err != nil {
+		return err
+	}
+	_, err = db.DeleteTableRows(name, opts...)
+	return err
+}
+
+// tableScanOptions returns the options of the scan of the table selected
+// by the query parameters.
+func tableScanOptions(desc *proto.TableDescriptor, query url.Values) ([]client.ScanOption, error) {
+	var opts []client.ScanOption
+	for param, values := range query {
+		switch param {
+		case paramLimit:
+			limit, err := strconv.ParseInt(values[0], 10, 64)
+			if err != nil || limit <= 0 {
+				return nil, util.Errorf("invalid limit %q", values[0])
+			}
+			opts = append(opts, client.ScanLimitOpt(limit))
+		case paramColumns:
+			opts = append(opts, client.ScanColumnsOpt(strings.Split(values[0], ",")...))
+		default:
+			column, ok := findTableColumn(desc, param)
+			if !ok {
+				return nil, &client.UnknownColumnError{Table: desc.Name, Column: param}
+			}
+			for _, s := range values {
+				v, err := parseQueryValue(column, s)
+				if err != nil {
+					return nil, err
+				}
+				opts = append(opts, client.ScanFilterOpt(param, "=", v))
+			}
+		}
+	}
+	return opts, nil
+}
+
+// findTableColumn returns the column of the table with the given name.
+func findTableColumn(desc *proto.TableDescriptor, name string) (proto.ColumnDescriptor, bool) {
+	for _, column := range desc.Columns {
+		if column.Name == name {
+			return column, true
+		}
+	}
+	return proto.ColumnDescriptor{}, false
+}
+
+// parseQueryValue parses the value of a column given as a query parameter.
+func parseQueryValue(column proto.ColumnDescriptor, s string) (interface{}, error) {
+	var v interface{}
+	var err error
+	switch column.Type {
+	case proto.Column_INT:
+		v, err = strconv.ParseInt(s, 10, 64)
+	case proto.Column_FLOAT:
+		v, err = strconv.ParseFloat(s, 64)
+	case proto.Column_BOOL:
+		v, err = strconv.ParseBool(s)
+	case proto.Column_BYTES:
+		v, err = base64.StdEncoding.DecodeString(s)
+	case proto.Column_JSON:
+		v = json.RawMessage(s)
+	default:
+		v = s
+	}
+	if err != nil {
+		return nil, util.Errorf("column %q: invalid %s value %q", column.Name, column.Type, s)
+	}
+	return v, nil
+}
+
+// decodeJSONValue converts the value of a column decoded from JSON, with
+// numbers decoded as json.Number, into a value accepted by the table API.
+func decodeJSONValue(column proto.ColumnDescriptor, v interface{}) (interface{}, error) {
+	var err error
+	switch t := v.(type) {
+	case json.Number:
+		switch column.Type {
+		case proto.Column_INT:
+			v, err = t.Int64()
+		case proto.Column_FLOAT:
+			v, err = t.Float64()
+		}
+	case string:
+		if column.Type == proto.Column_BYTES {
+			v, err = base64.StdEncoding.DecodeString(t)
+		}
+	}
+	if err != nil {
+		return nil, util.Errorf("column %q: invalid %s value %v", column.Name, column.Type, v)
+	}
+	return v, nil
+}
@@ -0,0 +1,153 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package server
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+)
+
+func TestTableName(t *testing.T) {
+	testCases := []struct {
+		path, expected string
+	}{
+		{"users/rows", "users"},
+		{"db.users/rows", "db.users"},
+		{"users", ""},
+		{"/rows", ""},
+		{"a/b/rows", ""},
+	}
+	for i, c := range testCases {
+		name, err := tableName(c.path)
+		if c.expected == "" {
+			if err == nil {
+				t.Errorf("%d: expected %q to fail", i, c.path)
+			}
+		} else if err != nil {
+			t.Errorf("%d: %s", i, err)
+		} else if name != c.expected {
+			t.Errorf("%d: expected %q, but found %q", i, c.expected, name)
+		}
+	}
+}
+
+func TestDecodeTableValues(t *testing.T) {
+	testCases := []struct {
+		typ      proto.Column_ColumnType
+		query    string
+		json     interface{}
+		expected interface{}
+	}{
+		{proto.Column_INT, "9007199254740993", json.Number("9007199254740993"), int64(9007199254740993)},
+		{proto.Column_FLOAT, "1.5", json.Number("1.5"), 1.5},
+		{proto.Column_BOOL, "true", true, true},
+		{proto.Column_STRING, "abc", "abc", "abc"},
+		{proto.Column_BYTES, "AAE=", "AAE=", []byte{0, 1}},
+	}
+	for i, c := range testCases {
+		column := proto.ColumnDescriptor{Name: "c", Type: c.typ}
+		if v, err := parseQueryValue(column, c.query); err != nil {
+			t.Errorf("%d: %s", i, err)
+		} else if !reflect.DeepEqual(c.expected, v) {
+			t.Errorf("%d: expected %#v, but found %#v", i, c.expected, v)
+		}
+		if v, err := decodeJSONValue(column, c.json); err != nil {
+			t.Errorf("%d: %s", i, err)
+		} else if !reflect.DeepEqual(c.expected, v) {
+			t.Errorf("%d: expected %#v, but found %#v", i, c.expected, v)
+		}
+	}
+	if _, err := parseQueryValue(proto.ColumnDescriptor{Name: "c", Type: proto.Column_INT}, "x"); err == nil {
+		t.Errorf("expected parsing an invalid INT to fail")
+	}
+	if _, err := decodeJSONValue(proto.ColumnDescriptor{Name: "c", Type: proto.Column_INT}, json.Number("1.5")); err == nil {
+		t.Errorf("expected decoding a non-integer INT to fail")
+	}
+}
+
+// TestTableRowsEndpoint verifies that the rows of a table can be written,
+// read and deleted over HTTP.
+func TestTableRowsEndpoint(t *testing.T) {
+	s := StartTestServer(t)
+	defer s.Stop()
+	schema := proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}
+	if err := s.db.CreateTable(schema); err != nil {
+		t.Fatal(err)
+	}
+	httpClient, err := testContext.GetHTTPClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := testContext.RequestScheme() + "://" + s.ServingAddr() + tablePathPrefix + "users" + tableRowsSuffix
+	do := func(method, query, body string, expectedStatus int) string {
+		req, err := http.NewRequest(method, url+query, strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(util.ContentTypeHeader, util.JSONContentType)
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		b, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != expectedStatus {
+			t.Fatalf("%s %s: expected status %d, but found %d: %s", method, query, expectedStatus, resp.StatusCode, b)
+		}
+		return string(b)
+	}
+
+	do("POST", "", `{"rows": [{"id": 1, "name": "alice"}, {"id": 2, "name": "bob"}]}`, http.StatusOK)
+	do("POST", "", `{"rows": [{"id": 1}]}`, http.StatusInternalServerError)
+	get := func(query string) []map[string]interface{} {
+		var rows tableRows
+		if err := json.Unmarshal([]byte(do("GET", query, "", http.StatusOK)), &rows); err != nil {
+			t.Fatal(err)
+		}
+		return rows.Rows
+	}
+	bob := map[string]interface{}{"id": float64(2), "name": "bob"}
+	if rows := get("?name=bob"); !reflect.DeepEqual([]map[string]interface{}{bob}, rows) {
+		t.Errorf("expected bob, but found %v", rows)
+	}
+	if rows := get("?columns=id&limit=1"); !reflect.DeepEqual([]map[string]interface{}{{"id": float64(1)}}, rows) {
+		t.Errorf("expected the first id, but found %v", rows)
+	}
+	do("DELETE", "", "", http.StatusInternalServerError)
+	do("DELETE", "?id=1", "", http.StatusOK)
+	if rows := get(""); !reflect.DeepEqual([]map[string]interface{}{bob}, rows) {
+		t.Errorf("expected bob, but found %v", rows)
+	}
+}
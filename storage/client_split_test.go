@@ -114,6 +114,54 @@ func TestStoreRangeSplitAtRangeBounds(t *testing.T) {
 	}
 }
 
+// TestStoreRangeSplitAtRowBoundary verifies that a split within a row is
+// moved to the start of the row, or to its end if the row starts the
+// range.
+func TestStoreRangeSplitAtRowBoundary(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	const tableID, indexID = 100, 1
+	rowKey := keys.MakeRowKey(tableID, indexID, []byte("pk"))
+	pArgs := &proto.PutRowRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:     rowKey,
+			RaftID:  1,
+			Replica: proto.Replica{StoreID: store.StoreID()},
+		},
+		TableId:    tableID,
+		IndexId:    indexID,
+		PrimaryKey: []byte("pk"),
+		Cells: []proto.RowCell{
+			{ColumnId: 1, Value: []byte("a")},
+			{ColumnId: 2, Value: []byte("b")},
+		},
+	}
+	if err := store.ExecuteCmd(context.Background(), client.Call{Args: pArgs, Reply: &proto.PutRowResponse{}}); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		splitKey, expStartKey proto.Key
+	}{
+		// A split at a cell moves to the sentinel of the row.
+		{keys.MakeCellKey(rowKey, 2), rowKey},
+		// The row now starts the range, so the split moves to its end.
+		{keys.MakeCellKey(rowKey, 1), rowKey.PrefixEnd()},
+	}
+	for i, test := range testCases {
+		rng := store.LookupRange(test.splitKey, nil)
+		args, reply := adminSplitArgs(rng.Desc().StartKey, test.splitKey, rng.Desc().RaftID, store.StoreID())
+		if err := store.ExecuteCmd(context.Background(), client.Call{Args: args, Reply: reply}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if startKey := store.LookupRange(test.expStartKey, nil).Desc().StartKey; !startKey.Equal(test.expStartKey) {
+			t.Errorf("%d: expected range to start at %q, but found %q", i, test.expStartKey, startKey)
+		}
+	}
+}
+
 // TestStoreRangeSplitConcurrent verifies that concurrent range splits
 // of the same range are executed serially, and all but the first fail
 // because the split key is invalid after the first split succeeds.
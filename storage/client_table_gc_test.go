@@ -0,0 +1,162 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+)
+
+// TestTableGCQueueReclaimDroppedTable verifies that the table GC queue
+// deletes the data and the descriptor of a dropped table once its grace
+// period has passed.
+func TestTableGCQueueReclaimDroppedTable(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	ctx := storage.TestStoreContext
+	ctx.TableGCGracePeriod = time.Nanosecond
+	store, stopper := createTestStoreWithEngine(t,
+		engine.NewInMem(proto.Attributes{}, 10<<20),
+		hlc.NewClock(hlc.NewManualClock(0).UnixNano),
+		true, &ctx)
+	defer stopper.Stop()
+
+	db := store.DB()
+	if err := db.CreateTable(proto.TableSchema{
+		Table: proto.Table{Name: "users"},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := db.ImportCSV("users", strings.NewReader("id,name\n1,alice\n2,bob\n")); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DropTable("users"); err != nil {
+		t.Fatal(err)
+	}
+
+	prefix := keys.MakeTablePrefix(desc.Id)
+	util.SucceedsWithin(t, time.Second, func() error {
+		store.ForceTableGCScan(t)
+		if kvs, err := db.Scan(prefix, prefix.PrefixEnd(), 0); err != nil {
+			return err
+		} else if len(kvs) > 0 {
+			return util.Errorf("expected the data of the table to be deleted, but found %d keys", len(kvs))
+		}
+		if descs, err := db.ListDroppedTables(); err != nil {
+			return err
+		} else if len(descs) > 0 {
+			return util.Errorf("expected the table descriptor to be removed")
+		}
+		return nil
+	})
+}
+
+// TestGCQueueExpireRows verifies that the GC queue deletes the expired
+// rows of tables whose rows have a time to live, along with their
+// secondary index entries.
+func TestGCQueueExpireRows(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	// The GC queue processes a range at most once a second.
+	manual := hlc.NewManualClock(0)
+	ctx := storage.TestStoreContext
+	store, stopper := createTestStoreWithEngine(t,
+		engine.NewInMem(proto.Attributes{}, 10<<20), hlc.NewClock(manual.UnixNano), true, &ctx)
+	defer stopper.Stop()
+
+	db := store.DB()
+	if err := db.CreateTable(proto.TableSchema{
+		Table: proto.Table{Name: "users", TTLSeconds: 10},
+		Columns: []proto.Column{
+			{Name: "id", Type: proto.Column_INT},
+			{Name: "name", Type: proto.Column_STRING},
+		},
+		Indexes: []proto.TableSchema_IndexByName{
+			{Index: proto.Index{Name: "primary", Unique: true}, ColumnNames: []string{"id"}},
+			{Index: proto.Index{Name: "by_name"}, ColumnNames: []string{"name"}},
+		},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	desc, err := db.DescribeTableDesc("users")
+	if err != nil {
+		t.Fatal(err)
+	}
+	manual.Set(1E9)
+	if err := db.InsertTableRows("users", map[string]interface{}{"id": 1, "name": "alice"}); err != nil {
+		t.Fatal(err)
+	}
+	manual.Increment(5E9)
+	if err := db.InsertTableRows("users", map[string]interface{}{"id": 2, "name": "bob"}); err != nil {
+		t.Fatal(err)
+	}
+	manual.Increment(5E9)
+
+	// Only the second row and its index entry remain.
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		store.ForceGCScan(t)
+		if rows, err := db.ScanTable("users"); err != nil {
+			return err
+		} else if len(rows) != 1 {
+			return util.Errorf("expected 1 row, but found %+v", rows)
+		}
+		primary := keys.MakeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+		if kvs, err := db.Scan(primary, primary.PrefixEnd(), 0); err != nil {
+			return err
+		} else if len(kvs) != 2 {
+			return util.Errorf("expected the sentinel and cell of 1 row, but found %d keys", len(kvs))
+		}
+		return nil
+	})
+	index := keys.MakeIndexPrefix(desc.Id, desc.Indexes[0].Id)
+	kvs, err := db.Scan(index, index.PrefixEnd(), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 1 {
+		t.Errorf("expected the index entry of 1 row, but found %d", len(kvs))
+	}
+
+	// The index span is empty once every row has expired.
+	manual.Increment(10E9)
+	util.SucceedsWithin(t, 5*time.Second, func() error {
+		store.ForceGCScan(t)
+		if kvs, err := db.Scan(index, index.PrefixEnd(), 0); err != nil {
+			return err
+		} else if len(kvs) != 0 {
+			return util.Errorf("expected no index entries, but found %d", len(kvs))
+		}
+		return nil
+	})
+}
@@ -341,6 +341,78 @@ func TestBatchScan(t *testing.T) {
 	}
 }
 
+// TestBatchIterReverse verifies that iterating backwards over a batch
+// visits the keys of a forward scan in reverse order, skipping keys
+// deleted by the batch.
+func TestBatchIterReverse(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	e := NewInMem(proto.Attributes{}, 1<<20)
+	defer e.Close()
+
+	b := e.NewBatch()
+	defer b.Close()
+
+	for _, k := range []string{"a", "c", "e", "g"} {
+		if err := e.Put(proto.EncodedKey(k), []byte(k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, k := range []string{"b", "c", "f", "h"} {
+		if err := b.Put(proto.EncodedKey(k), []byte("b"+k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, k := range []string{"e", "f", "g"} {
+		if err := b.Clear(proto.EncodedKey(k)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	kvs, err := Scan(b, proto.EncodedKey(proto.KeyMin), proto.EncodedKey(proto.KeyMax), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var expected []string
+	for i := len(kvs) - 1; i >= 0; i-- {
+		expected = append(expected, string(kvs[i].Key)+"="+string(kvs[i].Value))
+	}
+
+	testCases := []struct {
+		key      string
+		expected []string
+	}{
+		{"z", expected},
+		{"h", expected[1:]},
+		// The keys deleted by the batch are skipped.
+		{"g", expected[1:]},
+		{"d", expected[1:]},
+		{"c", expected[2:]},
+		{"a", nil},
+	}
+	for i, c := range testCases {
+		iter := b.NewIterator()
+		var actual []string
+		for iter.SeekReverse([]byte(c.key)); iter.Valid(); iter.Prev() {
+			actual = append(actual, string(iter.Key())+"="+string(iter.Value()))
+		}
+		if err := iter.Error(); err != nil {
+			t.Fatal(err)
+		}
+		iter.Close()
+		if !reflect.DeepEqual(c.expected, actual) {
+			t.Errorf("%d: expected %v; got %v", i, c.expected, actual)
+		}
+	}
+
+	// Iterating forward after moving backwards works as usual.
+	iter := b.NewIterator()
+	defer iter.Close()
+	iter.SeekReverse([]byte("d"))
+	iter.Next()
+	if !iter.Valid() || string(iter.Key()) != "h" {
+		t.Errorf("expected to move forward to \"h\"; got valid=%t", iter.Valid())
+	}
+}
+
 // TestBatchScanWithDelete verifies that a scan containing
 // a single deleted value returns nothing.
 func TestBatchScanWithDelete(t *testing.T) {
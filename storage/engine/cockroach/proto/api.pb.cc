@@ -78,6 +78,69 @@ const ::google::protobuf::internal::GeneratedMessageReflection*
 const ::google::protobuf::Descriptor* EndTransactionResponse_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   EndTransactionResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RowCell_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RowCell_reflection_ = NULL;
+const ::google::protobuf::Descriptor* Row_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  Row_reflection_ = NULL;
+const ::google::protobuf::Descriptor* GetRowRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  GetRowRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* GetRowResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  GetRowResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* PutRowRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  PutRowRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* PutRowResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  PutRowResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* DeleteRowRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  DeleteRowRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* DeleteRowResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  DeleteRowResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* RowFilter_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RowFilter_reflection_ = NULL;
+const ::google::protobuf::EnumDescriptor* RowFilter_Op_descriptor_ = NULL;
+const ::google::protobuf::EnumDescriptor* RowFilter_Type_descriptor_ = NULL;
+const ::google::protobuf::Descriptor* RowAggregate_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RowAggregate_reflection_ = NULL;
+const ::google::protobuf::EnumDescriptor* RowAggregate_Func_descriptor_ = NULL;
+const ::google::protobuf::Descriptor* RowAggregateResult_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  RowAggregateResult_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ScanRowsRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ScanRowsRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ScanRowsResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ScanRowsResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* LockRowRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  LockRowRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* LockRowResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  LockRowResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* PutUniqueRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  PutUniqueRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* PutUniqueResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  PutUniqueResponse_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ScanChangesRequest_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ScanChangesRequest_reflection_ = NULL;
+const ::google::protobuf::Descriptor* KeyValueChange_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  KeyValueChange_reflection_ = NULL;
+const ::google::protobuf::Descriptor* ScanChangesResponse_descriptor_ = NULL;
+const ::google::protobuf::internal::GeneratedMessageReflection*
+  ScanChangesResponse_reflection_ = NULL;
 const ::google::protobuf::Descriptor* RequestUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
   RequestUnion_reflection_ = NULL;
@@ -90,6 +153,13 @@ struct RequestUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::GetRowRequest* get_row_;
+  const ::cockroach::proto::PutRowRequest* put_row_;
+  const ::cockroach::proto::DeleteRowRequest* delete_row_;
+  const ::cockroach::proto::ScanRowsRequest* scan_rows_;
+  const ::cockroach::proto::LockRowRequest* lock_row_;
+  const ::cockroach::proto::PutUniqueRequest* put_unique_;
+  const ::cockroach::proto::ScanChangesRequest* scan_changes_;
 }* RequestUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* ResponseUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -103,6 +173,13 @@ struct ResponseUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::ScanResponse* scan_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::GetRowResponse* get_row_;
+  const ::cockroach::proto::PutRowResponse* put_row_;
+  const ::cockroach::proto::DeleteRowResponse* delete_row_;
+  const ::cockroach::proto::ScanRowsResponse* scan_rows_;
+  const ::cockroach::proto::LockRowResponse* lock_row_;
+  const ::cockroach::proto::PutUniqueResponse* put_unique_;
+  const ::cockroach::proto::ScanChangesResponse* scan_changes_;
 }* ResponseUnion_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* BatchRequest_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -379,9 +456,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRangeResponse, _internal_metadata_),
       -1);
   ScanRequest_descriptor_ = file->message_type(15);
-  static const int ScanRequest_offsets_[2] = {
+  static const int ScanRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, max_results_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, keys_only_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRequest, reverse_),
   };
   ScanRequest_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -444,8 +523,357 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(EndTransactionResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(EndTransactionResponse, _internal_metadata_),
       -1);
-  RequestUnion_descriptor_ = file->message_type(19);
-  static const int RequestUnion_offsets_[9] = {
+  RowCell_descriptor_ = file->message_type(19);
+  static const int RowCell_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowCell, column_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowCell, value_),
+  };
+  RowCell_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RowCell_descriptor_,
+      RowCell::default_instance_,
+      RowCell_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowCell, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RowCell),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowCell, _internal_metadata_),
+      -1);
+  Row_descriptor_ = file->message_type(20);
+  static const int Row_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Row, primary_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Row, cells_),
+  };
+  Row_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      Row_descriptor_,
+      Row::default_instance_,
+      Row_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Row, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(Row),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(Row, _internal_metadata_),
+      -1);
+  GetRowRequest_descriptor_ = file->message_type(21);
+  static const int GetRowRequest_offsets_[6] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, table_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, index_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, primary_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, column_ids_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, ttl_seconds_),
+  };
+  GetRowRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      GetRowRequest_descriptor_,
+      GetRowRequest::default_instance_,
+      GetRowRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(GetRowRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowRequest, _internal_metadata_),
+      -1);
+  GetRowResponse_descriptor_ = file->message_type(22);
+  static const int GetRowResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowResponse, row_),
+  };
+  GetRowResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      GetRowResponse_descriptor_,
+      GetRowResponse::default_instance_,
+      GetRowResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(GetRowResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(GetRowResponse, _internal_metadata_),
+      -1);
+  PutRowRequest_descriptor_ = file->message_type(23);
+  static const int PutRowRequest_offsets_[6] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, table_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, index_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, primary_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, cells_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, ttl_seconds_),
+  };
+  PutRowRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      PutRowRequest_descriptor_,
+      PutRowRequest::default_instance_,
+      PutRowRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(PutRowRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowRequest, _internal_metadata_),
+      -1);
+  PutRowResponse_descriptor_ = file->message_type(24);
+  static const int PutRowResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowResponse, header_),
+  };
+  PutRowResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      PutRowResponse_descriptor_,
+      PutRowResponse::default_instance_,
+      PutRowResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(PutRowResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutRowResponse, _internal_metadata_),
+      -1);
+  DeleteRowRequest_descriptor_ = file->message_type(25);
+  static const int DeleteRowRequest_offsets_[5] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, table_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, index_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, primary_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, ttl_seconds_),
+  };
+  DeleteRowRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      DeleteRowRequest_descriptor_,
+      DeleteRowRequest::default_instance_,
+      DeleteRowRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(DeleteRowRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowRequest, _internal_metadata_),
+      -1);
+  DeleteRowResponse_descriptor_ = file->message_type(26);
+  static const int DeleteRowResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowResponse, deleted_),
+  };
+  DeleteRowResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      DeleteRowResponse_descriptor_,
+      DeleteRowResponse::default_instance_,
+      DeleteRowResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(DeleteRowResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(DeleteRowResponse, _internal_metadata_),
+      -1);
+  RowFilter_descriptor_ = file->message_type(27);
+  static const int RowFilter_offsets_[4] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowFilter, column_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowFilter, op_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowFilter, type_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowFilter, value_),
+  };
+  RowFilter_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RowFilter_descriptor_,
+      RowFilter::default_instance_,
+      RowFilter_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowFilter, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RowFilter),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowFilter, _internal_metadata_),
+      -1);
+  RowFilter_Op_descriptor_ = RowFilter_descriptor_->enum_type(0);
+  RowFilter_Type_descriptor_ = RowFilter_descriptor_->enum_type(1);
+  RowAggregate_descriptor_ = file->message_type(28);
+  static const int RowAggregate_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregate, func_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregate, column_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregate, type_),
+  };
+  RowAggregate_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RowAggregate_descriptor_,
+      RowAggregate::default_instance_,
+      RowAggregate_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregate, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RowAggregate),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregate, _internal_metadata_),
+      -1);
+  RowAggregate_Func_descriptor_ = RowAggregate_descriptor_->enum_type(0);
+  RowAggregateResult_descriptor_ = file->message_type(29);
+  static const int RowAggregateResult_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregateResult, aggregate_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregateResult, count_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregateResult, value_),
+  };
+  RowAggregateResult_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      RowAggregateResult_descriptor_,
+      RowAggregateResult::default_instance_,
+      RowAggregateResult_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregateResult, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(RowAggregateResult),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RowAggregateResult, _internal_metadata_),
+      -1);
+  ScanRowsRequest_descriptor_ = file->message_type(30);
+  static const int ScanRowsRequest_offsets_[8] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, table_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, index_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, column_ids_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, max_rows_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, filters_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, aggregates_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, ttl_seconds_),
+  };
+  ScanRowsRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ScanRowsRequest_descriptor_,
+      ScanRowsRequest::default_instance_,
+      ScanRowsRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ScanRowsRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsRequest, _internal_metadata_),
+      -1);
+  ScanRowsResponse_descriptor_ = file->message_type(31);
+  static const int ScanRowsResponse_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsResponse, rows_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsResponse, aggregates_),
+  };
+  ScanRowsResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ScanRowsResponse_descriptor_,
+      ScanRowsResponse::default_instance_,
+      ScanRowsResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ScanRowsResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanRowsResponse, _internal_metadata_),
+      -1);
+  LockRowRequest_descriptor_ = file->message_type(32);
+  static const int LockRowRequest_offsets_[6] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, table_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, index_id_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, primary_key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, column_ids_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, ttl_seconds_),
+  };
+  LockRowRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      LockRowRequest_descriptor_,
+      LockRowRequest::default_instance_,
+      LockRowRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(LockRowRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowRequest, _internal_metadata_),
+      -1);
+  LockRowResponse_descriptor_ = file->message_type(33);
+  static const int LockRowResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowResponse, row_),
+  };
+  LockRowResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      LockRowResponse_descriptor_,
+      LockRowResponse::default_instance_,
+      LockRowResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(LockRowResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(LockRowResponse, _internal_metadata_),
+      -1);
+  PutUniqueRequest_descriptor_ = file->message_type(34);
+  static const int PutUniqueRequest_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueRequest, value_),
+  };
+  PutUniqueRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      PutUniqueRequest_descriptor_,
+      PutUniqueRequest::default_instance_,
+      PutUniqueRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(PutUniqueRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueRequest, _internal_metadata_),
+      -1);
+  PutUniqueResponse_descriptor_ = file->message_type(35);
+  static const int PutUniqueResponse_offsets_[1] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueResponse, header_),
+  };
+  PutUniqueResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      PutUniqueResponse_descriptor_,
+      PutUniqueResponse::default_instance_,
+      PutUniqueResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(PutUniqueResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(PutUniqueResponse, _internal_metadata_),
+      -1);
+  ScanChangesRequest_descriptor_ = file->message_type(36);
+  static const int ScanChangesRequest_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesRequest, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesRequest, start_timestamp_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesRequest, max_results_),
+  };
+  ScanChangesRequest_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ScanChangesRequest_descriptor_,
+      ScanChangesRequest::default_instance_,
+      ScanChangesRequest_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesRequest, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ScanChangesRequest),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesRequest, _internal_metadata_),
+      -1);
+  KeyValueChange_descriptor_ = file->message_type(37);
+  static const int KeyValueChange_offsets_[3] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeyValueChange, key_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeyValueChange, value_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeyValueChange, deleted_),
+  };
+  KeyValueChange_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      KeyValueChange_descriptor_,
+      KeyValueChange::default_instance_,
+      KeyValueChange_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeyValueChange, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(KeyValueChange),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(KeyValueChange, _internal_metadata_),
+      -1);
+  ScanChangesResponse_descriptor_ = file->message_type(38);
+  static const int ScanChangesResponse_offsets_[2] = {
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesResponse, header_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesResponse, changes_),
+  };
+  ScanChangesResponse_reflection_ =
+    ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
+      ScanChangesResponse_descriptor_,
+      ScanChangesResponse::default_instance_,
+      ScanChangesResponse_offsets_,
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesResponse, _has_bits_[0]),
+      -1,
+      -1,
+      sizeof(ScanChangesResponse),
+      GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ScanChangesResponse, _internal_metadata_),
+      -1);
+  RequestUnion_descriptor_ = file->message_type(39);
+  static const int RequestUnion_offsets_[16] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, conditional_put_),
@@ -454,6 +882,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, get_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, put_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, delete_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, scan_rows_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, lock_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, put_unique_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(RequestUnion_default_oneof_instance_, scan_changes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, value_),
   };
   RequestUnion_reflection_ =
@@ -469,8 +904,8 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(RequestUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(RequestUnion, _internal_metadata_),
       -1);
-  ResponseUnion_descriptor_ = file->message_type(20);
-  static const int ResponseUnion_offsets_[9] = {
+  ResponseUnion_descriptor_ = file->message_type(40);
+  static const int ResponseUnion_offsets_[16] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, conditional_put_),
@@ -479,6 +914,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, get_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, put_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, delete_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, scan_rows_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, lock_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, put_unique_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ResponseUnion_default_oneof_instance_, scan_changes_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, value_),
   };
   ResponseUnion_reflection_ =
@@ -494,7 +936,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(ResponseUnion),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ResponseUnion, _internal_metadata_),
       -1);
-  BatchRequest_descriptor_ = file->message_type(21);
+  BatchRequest_descriptor_ = file->message_type(41);
   static const int BatchRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, requests_),
@@ -510,7 +952,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(BatchRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchRequest, _internal_metadata_),
       -1);
-  BatchResponse_descriptor_ = file->message_type(22);
+  BatchResponse_descriptor_ = file->message_type(42);
   static const int BatchResponse_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, responses_),
@@ -526,7 +968,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(BatchResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(BatchResponse, _internal_metadata_),
       -1);
-  AdminSplitRequest_descriptor_ = file->message_type(23);
+  AdminSplitRequest_descriptor_ = file->message_type(43);
   static const int AdminSplitRequest_offsets_[2] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, split_key_),
@@ -542,7 +984,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(AdminSplitRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitRequest, _internal_metadata_),
       -1);
-  AdminSplitResponse_descriptor_ = file->message_type(24);
+  AdminSplitResponse_descriptor_ = file->message_type(44);
   static const int AdminSplitResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, header_),
   };
@@ -557,7 +999,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(AdminSplitResponse),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminSplitResponse, _internal_metadata_),
       -1);
-  AdminMergeRequest_descriptor_ = file->message_type(25);
+  AdminMergeRequest_descriptor_ = file->message_type(45);
   static const int AdminMergeRequest_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, header_),
   };
@@ -572,7 +1014,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto() {
       sizeof(AdminMergeRequest),
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeRequest, _internal_metadata_),
       -1);
-  AdminMergeResponse_descriptor_ = file->message_type(26);
+  AdminMergeResponse_descriptor_ = file->message_type(46);
   static const int AdminMergeResponse_offsets_[1] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(AdminMergeResponse, header_),
   };
@@ -638,6 +1080,46 @@ void protobuf_RegisterTypes(const ::std::string&) {
       EndTransactionRequest_descriptor_, &EndTransactionRequest::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       EndTransactionResponse_descriptor_, &EndTransactionResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RowCell_descriptor_, &RowCell::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      Row_descriptor_, &Row::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      GetRowRequest_descriptor_, &GetRowRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      GetRowResponse_descriptor_, &GetRowResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      PutRowRequest_descriptor_, &PutRowRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      PutRowResponse_descriptor_, &PutRowResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      DeleteRowRequest_descriptor_, &DeleteRowRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      DeleteRowResponse_descriptor_, &DeleteRowResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RowFilter_descriptor_, &RowFilter::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RowAggregate_descriptor_, &RowAggregate::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      RowAggregateResult_descriptor_, &RowAggregateResult::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ScanRowsRequest_descriptor_, &ScanRowsRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ScanRowsResponse_descriptor_, &ScanRowsResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      LockRowRequest_descriptor_, &LockRowRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      LockRowResponse_descriptor_, &LockRowResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      PutUniqueRequest_descriptor_, &PutUniqueRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      PutUniqueResponse_descriptor_, &PutUniqueResponse::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ScanChangesRequest_descriptor_, &ScanChangesRequest::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      KeyValueChange_descriptor_, &KeyValueChange::default_instance());
+  ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
+      ScanChangesResponse_descriptor_, &ScanChangesResponse::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
       RequestUnion_descriptor_, &RequestUnion::default_instance());
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedMessage(
@@ -697,6 +1179,46 @@ void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto() {
   delete EndTransactionRequest_reflection_;
   delete EndTransactionResponse::default_instance_;
   delete EndTransactionResponse_reflection_;
+  delete RowCell::default_instance_;
+  delete RowCell_reflection_;
+  delete Row::default_instance_;
+  delete Row_reflection_;
+  delete GetRowRequest::default_instance_;
+  delete GetRowRequest_reflection_;
+  delete GetRowResponse::default_instance_;
+  delete GetRowResponse_reflection_;
+  delete PutRowRequest::default_instance_;
+  delete PutRowRequest_reflection_;
+  delete PutRowResponse::default_instance_;
+  delete PutRowResponse_reflection_;
+  delete DeleteRowRequest::default_instance_;
+  delete DeleteRowRequest_reflection_;
+  delete DeleteRowResponse::default_instance_;
+  delete DeleteRowResponse_reflection_;
+  delete RowFilter::default_instance_;
+  delete RowFilter_reflection_;
+  delete RowAggregate::default_instance_;
+  delete RowAggregate_reflection_;
+  delete RowAggregateResult::default_instance_;
+  delete RowAggregateResult_reflection_;
+  delete ScanRowsRequest::default_instance_;
+  delete ScanRowsRequest_reflection_;
+  delete ScanRowsResponse::default_instance_;
+  delete ScanRowsResponse_reflection_;
+  delete LockRowRequest::default_instance_;
+  delete LockRowRequest_reflection_;
+  delete LockRowResponse::default_instance_;
+  delete LockRowResponse_reflection_;
+  delete PutUniqueRequest::default_instance_;
+  delete PutUniqueRequest_reflection_;
+  delete PutUniqueResponse::default_instance_;
+  delete PutUniqueResponse_reflection_;
+  delete ScanChangesRequest::default_instance_;
+  delete ScanChangesRequest_reflection_;
+  delete KeyValueChange::default_instance_;
+  delete KeyValueChange_reflection_;
+  delete ScanChangesResponse::default_instance_;
+  delete ScanChangesResponse_reflection_;
   delete RequestUnion::default_instance_;
   delete RequestUnion_default_oneof_instance_;
   delete RequestUnion_reflection_;
@@ -778,62 +1300,159 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
     "x_entries_to_delete\030\002 \001(\003B\004\310\336\037\000\"k\n\023Delet"
     "eRangeResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
     "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013num"
-    "_deleted\030\002 \001(\003B\004\310\336\037\000\"b\n\013ScanRequest\0228\n\006h"
-    "eader\030\001 \001(\0132\036.cockroach.proto.RequestHea"
-    "derB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037\000"
-    "\"x\n\014ScanResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022-\n\004r"
-    "ows\030\002 \003(\0132\031.cockroach.proto.KeyValueB\004\310\336"
-    "\037\000\"\260\001\n\025EndTransactionRequest\0228\n\006header\030\001"
-    " \001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336"
-    "\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022G\n\027internal"
-    "_commit_trigger\030\003 \001(\0132&.cockroach.proto."
-    "InternalCommitTrigger\"\211\001\n\026EndTransaction"
-    "Response\0229\n\006header\030\001 \001(\0132\037.cockroach.pro"
-    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013commit_wa"
-    "it\030\002 \001(\003B\004\310\336\037\000\022\031\n\010resolved\030\003 \003(\014B\007\372\336\037\003Ke"
-    "y\"\320\003\n\014RequestUnion\022*\n\003get\030\002 \001(\0132\033.cockro"
-    "ach.proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033.co"
-    "ckroach.proto.PutRequestH\000\022A\n\017conditiona"
-    "l_put\030\004 \001(\0132&.cockroach.proto.Conditiona"
-    "lPutRequestH\000\0226\n\tincrement\030\005 \001(\0132!.cockr"
-    "oach.proto.IncrementRequestH\000\0220\n\006delete\030"
-    "\006 \001(\0132\036.cockroach.proto.DeleteRequestH\000\022"
-    ";\n\014delete_range\030\007 \001(\0132#.cockroach.proto."
-    "DeleteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034.coc"
-    "kroach.proto.ScanRequestH\000\022A\n\017end_transa"
-    "ction\030\t \001(\0132&.cockroach.proto.EndTransac"
-    "tionRequestH\000:\004\310\240\037\001B\007\n\005value\"\331\003\n\rRespons"
-    "eUnion\022+\n\003get\030\002 \001(\0132\034.cockroach.proto.Ge"
-    "tResponseH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.pro"
-    "to.PutResponseH\000\022B\n\017conditional_put\030\004 \001("
-    "\0132\'.cockroach.proto.ConditionalPutRespon"
-    "seH\000\0227\n\tincrement\030\005 \001(\0132\".cockroach.prot"
-    "o.IncrementResponseH\000\0221\n\006delete\030\006 \001(\0132\037."
-    "cockroach.proto.DeleteResponseH\000\022<\n\014dele"
-    "te_range\030\007 \001(\0132$.cockroach.proto.DeleteR"
-    "angeResponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroach"
-    ".proto.ScanResponseH\000\022B\n\017end_transaction"
-    "\030\t \001(\0132\'.cockroach.proto.EndTransactionR"
-    "esponseH\000:\004\310\240\037\001B\007\n\005value\"\177\n\014BatchRequest"
-    "\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Reque"
-    "stHeaderB\010\310\336\037\000\320\336\037\001\0225\n\010requests\030\002 \003(\0132\035.c"
-    "ockroach.proto.RequestUnionB\004\310\336\037\000\"\203\001\n\rBa"
-    "tchResponse\0229\n\006header\030\001 \001(\0132\037.cockroach."
-    "proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0227\n\trespon"
-    "ses\030\002 \003(\0132\036.cockroach.proto.ResponseUnio"
-    "nB\004\310\336\037\000\"i\n\021AdminSplitRequest\0228\n\006header\030\001"
-    " \001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336"
-    "\037\000\320\336\037\001\022\032\n\tsplit_key\030\002 \001(\014B\007\372\336\037\003Key\"O\n\022Ad"
-    "minSplitResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"M\n\021A"
-    "dminMergeRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
-    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"O\n\022Ad"
-    "minMergeResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001*L\n\023R"
-    "eadConsistencyType\022\016\n\nCONSISTENT\020\000\022\r\n\tCO"
-    "NSENSUS\020\001\022\020\n\014INCONSISTENT\020\002\032\004\210\243\036\000B\023Z\005pro"
-    "to\340\342\036\001\310\342\036\001\320\342\036\001", 4214);
+    "_deleted\030\002 \001(\003B\004\310\336\037\000\"\222\001\n\013ScanRequest\0228\n\006"
+    "header\030\001 \001(\0132\036.cockroach.proto.RequestHe"
+    "aderB\010\310\336\037\000\320\336\037\001\022\031\n\013max_results\030\002 \001(\003B\004\310\336\037"
+    "\000\022\027\n\tkeys_only\030\003 \001(\010B\004\310\336\037\000\022\025\n\007reverse\030\004 "
+    "\001(\010B\004\310\336\037\000\"x\n\014ScanResponse\0229\n\006header\030\001 \001("
+    "\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\022-\n\004rows\030\002 \003(\0132\031.cockroach.proto.Key"
+    "ValueB\004\310\336\037\000\"\260\001\n\025EndTransactionRequest\0228\n"
+    "\006header\030\001 \001(\0132\036.cockroach.proto.RequestH"
+    "eaderB\010\310\336\037\000\320\336\037\001\022\024\n\006commit\030\002 \001(\010B\004\310\336\037\000\022G\n"
+    "\027internal_commit_trigger\030\003 \001(\0132&.cockroa"
+    "ch.proto.InternalCommitTrigger\"\211\001\n\026EndTr"
+    "ansactionResponse\0229\n\006header\030\001 \001(\0132\037.cock"
+    "roach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\031\n\013"
+    "commit_wait\030\002 \001(\003B\004\310\336\037\000\022\031\n\010resolved\030\003 \003("
+    "\014B\007\372\336\037\003Key\"1\n\007RowCell\022\027\n\tcolumn_id\030\001 \001(\r"
+    "B\004\310\336\037\000\022\r\n\005value\030\002 \001(\014\"I\n\003Row\022\023\n\013primary_"
+    "key\030\001 \001(\014\022-\n\005cells\030\002 \003(\0132\030.cockroach.pro"
+    "to.RowCellB\004\310\336\037\000\"\313\001\n\rGetRowRequest\0228\n\006he"
+    "ader\030\001 \001(\0132\036.cockroach.proto.RequestHead"
+    "erB\010\310\336\037\000\320\336\037\001\022\026\n\010table_id\030\002 \001(\rB\004\310\336\037\000\022\026\n\010"
+    "index_id\030\003 \001(\rB\004\310\336\037\000\022\023\n\013primary_key\030\004 \001("
+    "\014\022\022\n\ncolumn_ids\030\005 \003(\r\022\'\n\013ttl_seconds\030\006 \001"
+    "(\005B\022\310\336\037\000\342\336\037\nTTLSeconds\"n\n\016GetRowResponse"
+    "\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Respo"
+    "nseHeaderB\010\310\336\037\000\320\336\037\001\022!\n\003row\030\002 \001(\0132\024.cockr"
+    "oach.proto.Row\"\346\001\n\rPutRowRequest\0228\n\006head"
+    "er\030\001 \001(\0132\036.cockroach.proto.RequestHeader"
+    "B\010\310\336\037\000\320\336\037\001\022\026\n\010table_id\030\002 \001(\rB\004\310\336\037\000\022\026\n\010in"
+    "dex_id\030\003 \001(\rB\004\310\336\037\000\022\023\n\013primary_key\030\004 \001(\014\022"
+    "-\n\005cells\030\005 \003(\0132\030.cockroach.proto.RowCell"
+    "B\004\310\336\037\000\022\'\n\013ttl_seconds\030\006 \001(\005B\022\310\336\037\000\342\336\037\nTTL"
+    "Seconds\"K\n\016PutRowResponse\0229\n\006header\030\001 \001("
+    "\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000"
+    "\320\336\037\001\"\272\001\n\020DeleteRowRequest\0228\n\006header\030\001 \001("
+    "\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320"
+    "\336\037\001\022\026\n\010table_id\030\002 \001(\rB\004\310\336\037\000\022\026\n\010index_id\030"
+    "\003 \001(\rB\004\310\336\037\000\022\023\n\013primary_key\030\004 \001(\014\022\'\n\013ttl_"
+    "seconds\030\005 \001(\005B\022\310\336\037\000\342\336\037\nTTLSeconds\"e\n\021Del"
+    "eteRowResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
+    "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022\025\n\007del"
+    "eted\030\002 \001(\010B\004\310\336\037\000\"\224\002\n\tRowFilter\022\027\n\tcolumn"
+    "_id\030\001 \001(\rB\004\310\336\037\000\022/\n\002op\030\002 \001(\0162\035.cockroach."
+    "proto.RowFilter.OpB\004\310\336\037\000\0223\n\004type\030\003 \001(\0162\037"
+    ".cockroach.proto.RowFilter.TypeB\004\310\336\037\000\022\r\n"
+    "\005value\030\004 \001(\014\"R\n\002Op\022\006\n\002EQ\020\000\022\006\n\002NE\020\001\022\006\n\002LT"
+    "\020\002\022\006\n\002LE\020\003\022\006\n\002GT\020\004\022\006\n\002GE\020\005\022\013\n\007IS_NULL\020\006\022"
+    "\017\n\013IS_NOT_NULL\020\007\"%\n\004Type\022\t\n\005BYTES\020\000\022\t\n\005F"
+    "LOAT\020\001\022\007\n\003INT\020\002\"\302\001\n\014RowAggregate\0226\n\004func"
+    "\030\001 \001(\0162\".cockroach.proto.RowAggregate.Fu"
+    "ncB\004\310\336\037\000\022\027\n\tcolumn_id\030\002 \001(\rB\004\310\336\037\000\0223\n\004typ"
+    "e\030\003 \001(\0162\037.cockroach.proto.RowFilter.Type"
+    "B\004\310\336\037\000\",\n\004Func\022\t\n\005COUNT\020\000\022\007\n\003SUM\020\001\022\007\n\003MI"
+    "N\020\002\022\007\n\003MAX\020\003\"p\n\022RowAggregateResult\0226\n\tag"
+    "gregate\030\001 \001(\0132\035.cockroach.proto.RowAggre"
+    "gateB\004\310\336\037\000\022\023\n\005count\030\002 \001(\003B\004\310\336\037\000\022\r\n\005value"
+    "\030\003 \001(\014\"\274\002\n\017ScanRowsRequest\0228\n\006header\030\001 \001"
+    "(\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037\000"
+    "\320\336\037\001\022\026\n\010table_id\030\002 \001(\rB\004\310\336\037\000\022\026\n\010index_id"
+    "\030\003 \001(\rB\004\310\336\037\000\022\022\n\ncolumn_ids\030\004 \003(\r\022\026\n\010max_"
+    "rows\030\005 \001(\003B\004\310\336\037\000\0221\n\007filters\030\006 \003(\0132\032.cock"
+    "roach.proto.RowFilterB\004\310\336\037\000\0227\n\naggregate"
+    "s\030\007 \003(\0132\035.cockroach.proto.RowAggregateB\004"
+    "\310\336\037\000\022\'\n\013ttl_seconds\030\010 \001(\005B\022\310\336\037\000\342\336\037\nTTLSe"
+    "conds\"\266\001\n\020ScanRowsResponse\0229\n\006header\030\001 \001"
+    "(\0132\037.cockroach.proto.ResponseHeaderB\010\310\336\037"
+    "\000\320\336\037\001\022(\n\004rows\030\002 \003(\0132\024.cockroach.proto.Ro"
+    "wB\004\310\336\037\000\022=\n\naggregates\030\003 \003(\0132#.cockroach."
+    "proto.RowAggregateResultB\004\310\336\037\000\"\314\001\n\016LockR"
+    "owRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.pr"
+    "oto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\026\n\010table_id\030"
+    "\002 \001(\rB\004\310\336\037\000\022\026\n\010index_id\030\003 \001(\rB\004\310\336\037\000\022\023\n\013p"
+    "rimary_key\030\004 \001(\014\022\022\n\ncolumn_ids\030\005 \003(\r\022\'\n\013"
+    "ttl_seconds\030\006 \001(\005B\022\310\336\037\000\342\336\037\nTTLSeconds\"o\n"
+    "\017LockRowResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
+    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\022!\n\003r"
+    "ow\030\002 \001(\0132\024.cockroach.proto.Row\"y\n\020PutUni"
+    "queRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.p"
+    "roto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 "
+    "\001(\0132\026.cockroach.proto.ValueB\004\310\336\037\000\"N\n\021Put"
+    "UniqueResponse\0229\n\006header\030\001 \001(\0132\037.cockroa"
+    "ch.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\244\001\n\022Sc"
+    "anChangesRequest\0228\n\006header\030\001 \001(\0132\036.cockr"
+    "oach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\0229\n\017st"
+    "art_timestamp\030\002 \001(\0132\032.cockroach.proto.Ti"
+    "mestampB\004\310\336\037\000\022\031\n\013max_results\030\003 \001(\003B\004\310\336\037\000"
+    "\"j\n\016KeyValueChange\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Key"
+    "\022+\n\005value\030\002 \001(\0132\026.cockroach.proto.ValueB"
+    "\004\310\336\037\000\022\025\n\007deleted\030\003 \001(\010B\004\310\336\037\000\"\210\001\n\023ScanCha"
+    "ngesResponse\0229\n\006header\030\001 \001(\0132\037.cockroach"
+    ".proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0226\n\007chang"
+    "es\030\002 \003(\0132\037.cockroach.proto.KeyValueChang"
+    "eB\004\310\336\037\000\"\321\006\n\014RequestUnion\022*\n\003get\030\002 \001(\0132\033."
+    "cockroach.proto.GetRequestH\000\022*\n\003put\030\003 \001("
+    "\0132\033.cockroach.proto.PutRequestH\000\022A\n\017cond"
+    "itional_put\030\004 \001(\0132&.cockroach.proto.Cond"
+    "itionalPutRequestH\000\0226\n\tincrement\030\005 \001(\0132!"
+    ".cockroach.proto.IncrementRequestH\000\0220\n\006d"
+    "elete\030\006 \001(\0132\036.cockroach.proto.DeleteRequ"
+    "estH\000\022;\n\014delete_range\030\007 \001(\0132#.cockroach."
+    "proto.DeleteRangeRequestH\000\022,\n\004scan\030\010 \001(\013"
+    "2\034.cockroach.proto.ScanRequestH\000\022A\n\017end_"
+    "transaction\030\t \001(\0132&.cockroach.proto.EndT"
+    "ransactionRequestH\000\0221\n\007get_row\030\n \001(\0132\036.c"
+    "ockroach.proto.GetRowRequestH\000\0221\n\007put_ro"
+    "w\030\013 \001(\0132\036.cockroach.proto.PutRowRequestH"
+    "\000\0227\n\ndelete_row\030\014 \001(\0132!.cockroach.proto."
+    "DeleteRowRequestH\000\0225\n\tscan_rows\030\r \001(\0132 ."
+    "cockroach.proto.ScanRowsRequestH\000\0223\n\010loc"
+    "k_row\030\016 \001(\0132\037.cockroach.proto.LockRowReq"
+    "uestH\000\0227\n\nput_unique\030\017 \001(\0132!.cockroach.p"
+    "roto.PutUniqueRequestH\000\022;\n\014scan_changes\030"
+    "\020 \001(\0132#.cockroach.proto.ScanChangesReque"
+    "stH\000:\004\310\240\037\001B\007\n\005value\"\341\006\n\rResponseUnion\022+\n"
+    "\003get\030\002 \001(\0132\034.cockroach.proto.GetResponse"
+    "H\000\022+\n\003put\030\003 \001(\0132\034.cockroach.proto.PutRes"
+    "ponseH\000\022B\n\017conditional_put\030\004 \001(\0132\'.cockr"
+    "oach.proto.ConditionalPutResponseH\000\0227\n\ti"
+    "ncrement\030\005 \001(\0132\".cockroach.proto.Increme"
+    "ntResponseH\000\0221\n\006delete\030\006 \001(\0132\037.cockroach"
+    ".proto.DeleteResponseH\000\022<\n\014delete_range\030"
+    "\007 \001(\0132$.cockroach.proto.DeleteRangeRespo"
+    "nseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroach.proto.Sc"
+    "anResponseH\000\022B\n\017end_transaction\030\t \001(\0132\'."
+    "cockroach.proto.EndTransactionResponseH\000"
+    "\0222\n\007get_row\030\n \001(\0132\037.cockroach.proto.GetR"
+    "owResponseH\000\0222\n\007put_row\030\013 \001(\0132\037.cockroac"
+    "h.proto.PutRowResponseH\000\0228\n\ndelete_row\030\014"
+    " \001(\0132\".cockroach.proto.DeleteRowResponse"
+    "H\000\0226\n\tscan_rows\030\r \001(\0132!.cockroach.proto."
+    "ScanRowsResponseH\000\0224\n\010lock_row\030\016 \001(\0132 .c"
+    "ockroach.proto.LockRowResponseH\000\0228\n\nput_"
+    "unique\030\017 \001(\0132\".cockroach.proto.PutUnique"
+    "ResponseH\000\022<\n\014scan_changes\030\020 \001(\0132$.cockr"
+    "oach.proto.ScanChangesResponseH\000:\004\310\240\037\001B\007"
+    "\n\005value\"\177\n\014BatchRequest\0228\n\006header\030\001 \001(\0132"
+    "\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037"
+    "\001\0225\n\010requests\030\002 \003(\0132\035.cockroach.proto.Re"
+    "questUnionB\004\310\336\037\000\"\203\001\n\rBatchResponse\0229\n\006he"
+    "ader\030\001 \001(\0132\037.cockroach.proto.ResponseHea"
+    "derB\010\310\336\037\000\320\336\037\001\0227\n\tresponses\030\002 \003(\0132\036.cockr"
+    "oach.proto.ResponseUnionB\004\310\336\037\000\"i\n\021AdminS"
+    "plitRequest\0228\n\006header\030\001 \001(\0132\036.cockroach."
+    "proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\032\n\tsplit_k"
+    "ey\030\002 \001(\014B\007\372\336\037\003Key\"O\n\022AdminSplitResponse\022"
+    "9\n\006header\030\001 \001(\0132\037.cockroach.proto.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\"M\n\021AdminMergeRequest\022"
+    "8\n\006header\030\001 \001(\0132\036.cockroach.proto.Reques"
+    "tHeaderB\010\310\336\037\000\320\336\037\001\"O\n\022AdminMergeResponse\022"
+    "9\n\006header\030\001 \001(\0132\037.cockroach.proto.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001*L\n\023ReadConsistencyTyp"
+    "e\022\016\n\nCONSISTENT\020\000\022\r\n\tCONSENSUS\020\001\022\020\n\014INCO"
+    "NSISTENT\020\002\032\004\210\243\036\000B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 8117);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/api.proto", &protobuf_RegisterTypes);
   ClientCmdID::default_instance_ = new ClientCmdID();
@@ -855,6 +1474,26 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   ScanResponse::default_instance_ = new ScanResponse();
   EndTransactionRequest::default_instance_ = new EndTransactionRequest();
   EndTransactionResponse::default_instance_ = new EndTransactionResponse();
+  RowCell::default_instance_ = new RowCell();
+  Row::default_instance_ = new Row();
+  GetRowRequest::default_instance_ = new GetRowRequest();
+  GetRowResponse::default_instance_ = new GetRowResponse();
+  PutRowRequest::default_instance_ = new PutRowRequest();
+  PutRowResponse::default_instance_ = new PutRowResponse();
+  DeleteRowRequest::default_instance_ = new DeleteRowRequest();
+  DeleteRowResponse::default_instance_ = new DeleteRowResponse();
+  RowFilter::default_instance_ = new RowFilter();
+  RowAggregate::default_instance_ = new RowAggregate();
+  RowAggregateResult::default_instance_ = new RowAggregateResult();
+  ScanRowsRequest::default_instance_ = new ScanRowsRequest();
+  ScanRowsResponse::default_instance_ = new ScanRowsResponse();
+  LockRowRequest::default_instance_ = new LockRowRequest();
+  LockRowResponse::default_instance_ = new LockRowResponse();
+  PutUniqueRequest::default_instance_ = new PutUniqueRequest();
+  PutUniqueResponse::default_instance_ = new PutUniqueResponse();
+  ScanChangesRequest::default_instance_ = new ScanChangesRequest();
+  KeyValueChange::default_instance_ = new KeyValueChange();
+  ScanChangesResponse::default_instance_ = new ScanChangesResponse();
   RequestUnion::default_instance_ = new RequestUnion();
   RequestUnion_default_oneof_instance_ = new RequestUnionOneofInstance();
   ResponseUnion::default_instance_ = new ResponseUnion();
@@ -884,6 +1523,26 @@ void protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto() {
   ScanResponse::default_instance_->InitAsDefaultInstance();
   EndTransactionRequest::default_instance_->InitAsDefaultInstance();
   EndTransactionResponse::default_instance_->InitAsDefaultInstance();
+  RowCell::default_instance_->InitAsDefaultInstance();
+  Row::default_instance_->InitAsDefaultInstance();
+  GetRowRequest::default_instance_->InitAsDefaultInstance();
+  GetRowResponse::default_instance_->InitAsDefaultInstance();
+  PutRowRequest::default_instance_->InitAsDefaultInstance();
+  PutRowResponse::default_instance_->InitAsDefaultInstance();
+  DeleteRowRequest::default_instance_->InitAsDefaultInstance();
+  DeleteRowResponse::default_instance_->InitAsDefaultInstance();
+  RowFilter::default_instance_->InitAsDefaultInstance();
+  RowAggregate::default_instance_->InitAsDefaultInstance();
+  RowAggregateResult::default_instance_->InitAsDefaultInstance();
+  ScanRowsRequest::default_instance_->InitAsDefaultInstance();
+  ScanRowsResponse::default_instance_->InitAsDefaultInstance();
+  LockRowRequest::default_instance_->InitAsDefaultInstance();
+  LockRowResponse::default_instance_->InitAsDefaultInstance();
+  PutUniqueRequest::default_instance_->InitAsDefaultInstance();
+  PutUniqueResponse::default_instance_->InitAsDefaultInstance();
+  ScanChangesRequest::default_instance_->InitAsDefaultInstance();
+  KeyValueChange::default_instance_->InitAsDefaultInstance();
+  ScanChangesResponse::default_instance_->InitAsDefaultInstance();
   RequestUnion::default_instance_->InitAsDefaultInstance();
   ResponseUnion::default_instance_->InitAsDefaultInstance();
   BatchRequest::default_instance_->InitAsDefaultInstance();
@@ -6806,6 +7465,8 @@ void DeleteRangeResponse::clear_num_deleted() {
 #ifndef _MSC_VER
 const int ScanRequest::kHeaderFieldNumber;
 const int ScanRequest::kMaxResultsFieldNumber;
+const int ScanRequest::kKeysOnlyFieldNumber;
+const int ScanRequest::kReverseFieldNumber;
 #endif  // !_MSC_VER
 
 ScanRequest::ScanRequest()
@@ -6830,6 +7491,8 @@ void ScanRequest::SharedCtor() {
   _cached_size_ = 0;
   header_ = NULL;
   max_results_ = GOOGLE_LONGLONG(0);
+  keys_only_ = false;
+  reverse_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -6870,12 +7533,24 @@ ScanRequest* ScanRequest::New(::google::protobuf::Arena* arena) const {
 }
 
 void ScanRequest::Clear() {
-  if (_has_bits_[0 / 32] & 3u) {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<ScanRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 15u) {
+    ZR_(max_results_, reverse_);
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
-    max_results_ = GOOGLE_LONGLONG(0);
   }
+
+#undef ZR_HELPER_
+#undef ZR_
+
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
   if (_internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->Clear();
@@ -6915,6 +7590,36 @@ bool ScanRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(24)) goto parse_keys_only;
+        break;
+      }
+
+      // optional bool keys_only = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_keys_only:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &keys_only_)));
+          set_has_keys_only();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_reverse;
+        break;
+      }
+
+      // optional bool reverse = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_reverse:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &reverse_)));
+          set_has_reverse();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -6955,6 +7660,16 @@ void ScanRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->max_results(), output);
   }
 
+  // optional bool keys_only = 3;
+  if (has_keys_only()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->keys_only(), output);
+  }
+
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->reverse(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -6977,6 +7692,16 @@ void ScanRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->max_results(), target);
   }
 
+  // optional bool keys_only = 3;
+  if (has_keys_only()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->keys_only(), target);
+  }
+
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->reverse(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -6988,7 +7713,7 @@ void ScanRequest::SerializeWithCachedSizes(
 int ScanRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 3) {
+  if (_has_bits_[0 / 32] & 15) {
     // optional .cockroach.proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
@@ -7003,6 +7728,16 @@ int ScanRequest::ByteSize() const {
           this->max_results());
     }
 
+    // optional bool keys_only = 3;
+    if (has_keys_only()) {
+      total_size += 1 + 1;
+    }
+
+    // optional bool reverse = 4;
+    if (has_reverse()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -7036,6 +7771,12 @@ void ScanRequest::MergeFrom(const ScanRequest& from) {
     if (from.has_max_results()) {
       set_max_results(from.max_results());
     }
+    if (from.has_keys_only()) {
+      set_keys_only(from.keys_only());
+    }
+    if (from.has_reverse()) {
+      set_reverse(from.reverse());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -7066,6 +7807,8 @@ void ScanRequest::Swap(ScanRequest* other) {
 void ScanRequest::InternalSwap(ScanRequest* other) {
   std::swap(header_, other->header_);
   std::swap(max_results_, other->max_results_);
+  std::swap(keys_only_, other->keys_only_);
+  std::swap(reverse_, other->reverse_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -7149,6 +7892,54 @@ void ScanRequest::clear_max_results() {
   // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
 }
 
+// optional bool keys_only = 3;
+bool ScanRequest::has_keys_only() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ScanRequest::set_has_keys_only() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ScanRequest::clear_has_keys_only() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ScanRequest::clear_keys_only() {
+  keys_only_ = false;
+  clear_has_keys_only();
+}
+ bool ScanRequest::keys_only() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.keys_only)
+  return keys_only_;
+}
+ void ScanRequest::set_keys_only(bool value) {
+  set_has_keys_only();
+  keys_only_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.keys_only)
+}
+
+// optional bool reverse = 4;
+bool ScanRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void ScanRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void ScanRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void ScanRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+ bool ScanRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.reverse)
+  return reverse_;
+}
+ void ScanRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.reverse)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -8386,93 +9177,9316 @@ EndTransactionResponse::mutable_resolved() {
 // ===================================================================
 
 #ifndef _MSC_VER
-const int RequestUnion::kGetFieldNumber;
-const int RequestUnion::kPutFieldNumber;
-const int RequestUnion::kConditionalPutFieldNumber;
-const int RequestUnion::kIncrementFieldNumber;
-const int RequestUnion::kDeleteFieldNumber;
-const int RequestUnion::kDeleteRangeFieldNumber;
-const int RequestUnion::kScanFieldNumber;
-const int RequestUnion::kEndTransactionFieldNumber;
+const int RowCell::kColumnIdFieldNumber;
+const int RowCell::kValueFieldNumber;
 #endif  // !_MSC_VER
 
-RequestUnion::RequestUnion()
+RowCell::RowCell()
   : ::google::protobuf::Message(), _internal_metadata_(NULL) {
   SharedCtor();
-  // @@protoc_insertion_point(constructor:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(constructor:cockroach.proto.RowCell)
 }
 
-void RequestUnion::InitAsDefaultInstance() {
-  RequestUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
-  RequestUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
-  RequestUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
-  RequestUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
-  RequestUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
-  RequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
-  RequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
-  RequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+void RowCell::InitAsDefaultInstance() {
 }
 
-RequestUnion::RequestUnion(const RequestUnion& from)
+RowCell::RowCell(const RowCell& from)
   : ::google::protobuf::Message(),
     _internal_metadata_(NULL) {
   SharedCtor();
   MergeFrom(from);
-  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RequestUnion)
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RowCell)
 }
 
-void RequestUnion::SharedCtor() {
+void RowCell::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
   _cached_size_ = 0;
+  column_id_ = 0u;
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
-  clear_has_value();
 }
 
-RequestUnion::~RequestUnion() {
-  // @@protoc_insertion_point(destructor:cockroach.proto.RequestUnion)
+RowCell::~RowCell() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RowCell)
   SharedDtor();
 }
 
-void RequestUnion::SharedDtor() {
-  if (has_value()) {
-    clear_value();
-  }
+void RowCell::SharedDtor() {
+  value_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   if (this != default_instance_) {
   }
 }
 
-void RequestUnion::SetCachedSize(int size) const {
+void RowCell::SetCachedSize(int size) const {
   GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
   _cached_size_ = size;
   GOOGLE_SAFE_CONCURRENT_WRITES_END();
 }
-const ::google::protobuf::Descriptor* RequestUnion::descriptor() {
+const ::google::protobuf::Descriptor* RowCell::descriptor() {
   protobuf_AssignDescriptorsOnce();
-  return RequestUnion_descriptor_;
+  return RowCell_descriptor_;
 }
 
-const RequestUnion& RequestUnion::default_instance() {
+const RowCell& RowCell::default_instance() {
   if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   return *default_instance_;
 }
 
-RequestUnion* RequestUnion::default_instance_ = NULL;
+RowCell* RowCell::default_instance_ = NULL;
 
-RequestUnion* RequestUnion::New(::google::protobuf::Arena* arena) const {
-  RequestUnion* n = new RequestUnion;
+RowCell* RowCell::New(::google::protobuf::Arena* arena) const {
+  RowCell* n = new RowCell;
   if (arena != NULL) {
     arena->Own(n);
   }
   return n;
 }
 
-void RequestUnion::clear_value() {
-  switch(value_case()) {
-    case kGet: {
-      delete value_.get_;
-      break;
+void RowCell::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    column_id_ = 0u;
+    if (has_value()) {
+      value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
     }
-    case kPut: {
-      delete value_.put_;
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool RowCell::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RowCell)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional uint32 column_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &column_id_)));
+          set_has_column_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional bytes value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RowCell)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RowCell)
+  return false;
+#undef DO_
+}
+
+void RowCell::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RowCell)
+  // optional uint32 column_id = 1;
+  if (has_column_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(1, this->column_id(), output);
+  }
+
+  // optional bytes value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      2, this->value(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RowCell)
+}
+
+::google::protobuf::uint8* RowCell::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RowCell)
+  // optional uint32 column_id = 1;
+  if (has_column_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(1, this->column_id(), target);
+  }
+
+  // optional bytes value = 2;
+  if (has_value()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        2, this->value(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RowCell)
+  return target;
+}
+
+int RowCell::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional uint32 column_id = 1;
+    if (has_column_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->column_id());
+    }
+
+    // optional bytes value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->value());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RowCell::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const RowCell* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RowCell>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RowCell::MergeFrom(const RowCell& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_column_id()) {
+      set_column_id(from.column_id());
+    }
+    if (from.has_value()) {
+      set_has_value();
+      value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void RowCell::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RowCell::CopyFrom(const RowCell& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RowCell::IsInitialized() const {
+
+  return true;
+}
+
+void RowCell::Swap(RowCell* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void RowCell::InternalSwap(RowCell* other) {
+  std::swap(column_id_, other->column_id_);
+  value_.Swap(&other->value_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata RowCell::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RowCell_descriptor_;
+  metadata.reflection = RowCell_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// RowCell
+
+// optional uint32 column_id = 1;
+bool RowCell::has_column_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void RowCell::set_has_column_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void RowCell::clear_has_column_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void RowCell::clear_column_id() {
+  column_id_ = 0u;
+  clear_has_column_id();
+}
+ ::google::protobuf::uint32 RowCell::column_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowCell.column_id)
+  return column_id_;
+}
+ void RowCell::set_column_id(::google::protobuf::uint32 value) {
+  set_has_column_id();
+  column_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowCell.column_id)
+}
+
+// optional bytes value = 2;
+bool RowCell::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void RowCell::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void RowCell::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void RowCell::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_value();
+}
+ const ::std::string& RowCell::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowCell.value)
+  return value_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RowCell::set_value(const ::std::string& value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowCell.value)
+}
+ void RowCell::set_value(const char* value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RowCell.value)
+}
+ void RowCell::set_value(const void* value, size_t size) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RowCell.value)
+}
+ ::std::string* RowCell::mutable_value() {
+  set_has_value();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowCell.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* RowCell::release_value() {
+  clear_has_value();
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RowCell::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowCell.value)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int Row::kPrimaryKeyFieldNumber;
+const int Row::kCellsFieldNumber;
+#endif  // !_MSC_VER
+
+Row::Row()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.Row)
+}
+
+void Row::InitAsDefaultInstance() {
+}
+
+Row::Row(const Row& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.Row)
+}
+
+void Row::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  primary_key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+Row::~Row() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.Row)
+  SharedDtor();
+}
+
+void Row::SharedDtor() {
+  primary_key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+  }
+}
+
+void Row::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* Row::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return Row_descriptor_;
+}
+
+const Row& Row::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+Row* Row::default_instance_ = NULL;
+
+Row* Row::New(::google::protobuf::Arena* arena) const {
+  Row* n = new Row;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void Row::Clear() {
+  if (has_primary_key()) {
+    primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  }
+  cells_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool Row::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.Row)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes primary_key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_primary_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_cells;
+        break;
+      }
+
+      // repeated .cockroach.proto.RowCell cells = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_cells:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_cells:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_cells()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_cells;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.Row)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.Row)
+  return false;
+#undef DO_
+}
+
+void Row::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.Row)
+  // optional bytes primary_key = 1;
+  if (has_primary_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->primary_key(), output);
+  }
+
+  // repeated .cockroach.proto.RowCell cells = 2;
+  for (unsigned int i = 0, n = this->cells_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->cells(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.Row)
+}
+
+::google::protobuf::uint8* Row::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.Row)
+  // optional bytes primary_key = 1;
+  if (has_primary_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->primary_key(), target);
+  }
+
+  // repeated .cockroach.proto.RowCell cells = 2;
+  for (unsigned int i = 0, n = this->cells_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->cells(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.Row)
+  return target;
+}
+
+int Row::ByteSize() const {
+  int total_size = 0;
+
+  // optional bytes primary_key = 1;
+  if (has_primary_key()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::BytesSize(
+        this->primary_key());
+  }
+
+  // repeated .cockroach.proto.RowCell cells = 2;
+  total_size += 1 * this->cells_size();
+  for (int i = 0; i < this->cells_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->cells(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void Row::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const Row* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const Row>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void Row::MergeFrom(const Row& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  cells_.MergeFrom(from.cells_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_primary_key()) {
+      set_has_primary_key();
+      primary_key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.primary_key_);
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void Row::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void Row::CopyFrom(const Row& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool Row::IsInitialized() const {
+
+  return true;
+}
+
+void Row::Swap(Row* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void Row::InternalSwap(Row* other) {
+  primary_key_.Swap(&other->primary_key_);
+  cells_.UnsafeArenaSwap(&other->cells_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata Row::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = Row_descriptor_;
+  metadata.reflection = Row_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// Row
+
+// optional bytes primary_key = 1;
+bool Row::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void Row::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void Row::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void Row::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+ const ::std::string& Row::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Row.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void Row::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Row.primary_key)
+}
+ void Row::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Row.primary_key)
+}
+ void Row::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Row.primary_key)
+}
+ ::std::string* Row::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Row.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* Row::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void Row::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Row.primary_key)
+}
+
+// repeated .cockroach.proto.RowCell cells = 2;
+int Row::cells_size() const {
+  return cells_.size();
+}
+void Row::clear_cells() {
+  cells_.Clear();
+}
+ const ::cockroach::proto::RowCell& Row::cells(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Row.cells)
+  return cells_.Get(index);
+}
+ ::cockroach::proto::RowCell* Row::mutable_cells(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Row.cells)
+  return cells_.Mutable(index);
+}
+ ::cockroach::proto::RowCell* Row::add_cells() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.Row.cells)
+  return cells_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >&
+Row::cells() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.Row.cells)
+  return cells_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >*
+Row::mutable_cells() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.Row.cells)
+  return &cells_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int GetRowRequest::kHeaderFieldNumber;
+const int GetRowRequest::kTableIdFieldNumber;
+const int GetRowRequest::kIndexIdFieldNumber;
+const int GetRowRequest::kPrimaryKeyFieldNumber;
+const int GetRowRequest::kColumnIdsFieldNumber;
+const int GetRowRequest::kTtlSecondsFieldNumber;
+#endif  // !_MSC_VER
+
+GetRowRequest::GetRowRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.GetRowRequest)
+}
+
+void GetRowRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+GetRowRequest::GetRowRequest(const GetRowRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.GetRowRequest)
+}
+
+void GetRowRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  table_id_ = 0u;
+  index_id_ = 0u;
+  primary_key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ttl_seconds_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+GetRowRequest::~GetRowRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.GetRowRequest)
+  SharedDtor();
+}
+
+void GetRowRequest::SharedDtor() {
+  primary_key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void GetRowRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* GetRowRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return GetRowRequest_descriptor_;
+}
+
+const GetRowRequest& GetRowRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+GetRowRequest* GetRowRequest::default_instance_ = NULL;
+
+GetRowRequest* GetRowRequest::New(::google::protobuf::Arena* arena) const {
+  GetRowRequest* n = new GetRowRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void GetRowRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<GetRowRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 47u) {
+    ZR_(table_id_, index_id_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_primary_key()) {
+      primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    ttl_seconds_ = 0;
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  column_ids_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool GetRowRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.GetRowRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_table_id;
+        break;
+      }
+
+      // optional uint32 table_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_table_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &table_id_)));
+          set_has_table_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_index_id;
+        break;
+      }
+
+      // optional uint32 index_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_index_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &index_id_)));
+          set_has_index_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_primary_key;
+        break;
+      }
+
+      // optional bytes primary_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_primary_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_primary_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_column_ids;
+        break;
+      }
+
+      // repeated uint32 column_ids = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_column_ids:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 1, 40, input, this->mutable_column_ids())));
+        } else if (tag == 42) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitiveNoInline<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, this->mutable_column_ids())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_column_ids;
+        if (input->ExpectTag(48)) goto parse_ttl_seconds;
+        break;
+      }
+
+      // optional int32 ttl_seconds = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_ttl_seconds:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &ttl_seconds_)));
+          set_has_ttl_seconds();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.GetRowRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.GetRowRequest)
+  return false;
+#undef DO_
+}
+
+void GetRowRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.GetRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(2, this->table_id(), output);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(3, this->index_id(), output);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->primary_key(), output);
+  }
+
+  // repeated uint32 column_ids = 5;
+  for (int i = 0; i < this->column_ids_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(
+      5, this->column_ids(i), output);
+  }
+
+  // optional int32 ttl_seconds = 6;
+  if (has_ttl_seconds()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(6, this->ttl_seconds(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.GetRowRequest)
+}
+
+::google::protobuf::uint8* GetRowRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.GetRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(2, this->table_id(), target);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(3, this->index_id(), target);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->primary_key(), target);
+  }
+
+  // repeated uint32 column_ids = 5;
+  for (int i = 0; i < this->column_ids_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteUInt32ToArray(5, this->column_ids(i), target);
+  }
+
+  // optional int32 ttl_seconds = 6;
+  if (has_ttl_seconds()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(6, this->ttl_seconds(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.GetRowRequest)
+  return target;
+}
+
+int GetRowRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 47) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional uint32 table_id = 2;
+    if (has_table_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->table_id());
+    }
+
+    // optional uint32 index_id = 3;
+    if (has_index_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->index_id());
+    }
+
+    // optional bytes primary_key = 4;
+    if (has_primary_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->primary_key());
+    }
+
+    // optional int32 ttl_seconds = 6;
+    if (has_ttl_seconds()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->ttl_seconds());
+    }
+
+  }
+  // repeated uint32 column_ids = 5;
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->column_ids_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        UInt32Size(this->column_ids(i));
+    }
+    total_size += 1 * this->column_ids_size() + data_size;
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void GetRowRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const GetRowRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const GetRowRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void GetRowRequest::MergeFrom(const GetRowRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  column_ids_.MergeFrom(from.column_ids_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_table_id()) {
+      set_table_id(from.table_id());
+    }
+    if (from.has_index_id()) {
+      set_index_id(from.index_id());
+    }
+    if (from.has_primary_key()) {
+      set_has_primary_key();
+      primary_key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.primary_key_);
+    }
+    if (from.has_ttl_seconds()) {
+      set_ttl_seconds(from.ttl_seconds());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void GetRowRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void GetRowRequest::CopyFrom(const GetRowRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetRowRequest::IsInitialized() const {
+
+  return true;
+}
+
+void GetRowRequest::Swap(GetRowRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GetRowRequest::InternalSwap(GetRowRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(table_id_, other->table_id_);
+  std::swap(index_id_, other->index_id_);
+  primary_key_.Swap(&other->primary_key_);
+  column_ids_.UnsafeArenaSwap(&other->column_ids_);
+  std::swap(ttl_seconds_, other->ttl_seconds_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata GetRowRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = GetRowRequest_descriptor_;
+  metadata.reflection = GetRowRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// GetRowRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool GetRowRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void GetRowRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void GetRowRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void GetRowRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& GetRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* GetRowRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* GetRowRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void GetRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowRequest.header)
+}
+
+// optional uint32 table_id = 2;
+bool GetRowRequest::has_table_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void GetRowRequest::set_has_table_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void GetRowRequest::clear_has_table_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void GetRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
+}
+ ::google::protobuf::uint32 GetRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.table_id)
+  return table_id_;
+}
+ void GetRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+bool GetRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void GetRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void GetRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void GetRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+ ::google::protobuf::uint32 GetRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.index_id)
+  return index_id_;
+}
+ void GetRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+bool GetRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void GetRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void GetRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void GetRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+ const ::std::string& GetRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void GetRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.primary_key)
+}
+ void GetRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.GetRowRequest.primary_key)
+}
+ void GetRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.GetRowRequest.primary_key)
+}
+ ::std::string* GetRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* GetRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void GetRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowRequest.primary_key)
+}
+
+// repeated uint32 column_ids = 5;
+int GetRowRequest::column_ids_size() const {
+  return column_ids_.size();
+}
+void GetRowRequest::clear_column_ids() {
+  column_ids_.Clear();
+}
+ ::google::protobuf::uint32 GetRowRequest::column_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.column_ids)
+  return column_ids_.Get(index);
+}
+ void GetRowRequest::set_column_ids(int index, ::google::protobuf::uint32 value) {
+  column_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.column_ids)
+}
+ void GetRowRequest::add_column_ids(::google::protobuf::uint32 value) {
+  column_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.GetRowRequest.column_ids)
+}
+ const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+GetRowRequest::column_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.GetRowRequest.column_ids)
+  return column_ids_;
+}
+ ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+GetRowRequest::mutable_column_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.GetRowRequest.column_ids)
+  return &column_ids_;
+}
+
+// optional int32 ttl_seconds = 6;
+bool GetRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+void GetRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000020u;
+}
+void GetRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+void GetRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+ ::google::protobuf::int32 GetRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+ void GetRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.ttl_seconds)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int GetRowResponse::kHeaderFieldNumber;
+const int GetRowResponse::kRowFieldNumber;
+#endif  // !_MSC_VER
+
+GetRowResponse::GetRowResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.GetRowResponse)
+}
+
+void GetRowResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  row_ = const_cast< ::cockroach::proto::Row*>(&::cockroach::proto::Row::default_instance());
+}
+
+GetRowResponse::GetRowResponse(const GetRowResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.GetRowResponse)
+}
+
+void GetRowResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  row_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+GetRowResponse::~GetRowResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.GetRowResponse)
+  SharedDtor();
+}
+
+void GetRowResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete row_;
+  }
+}
+
+void GetRowResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* GetRowResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return GetRowResponse_descriptor_;
+}
+
+const GetRowResponse& GetRowResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+GetRowResponse* GetRowResponse::default_instance_ = NULL;
+
+GetRowResponse* GetRowResponse::New(::google::protobuf::Arena* arena) const {
+  GetRowResponse* n = new GetRowResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void GetRowResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_row()) {
+      if (row_ != NULL) row_->::cockroach::proto::Row::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool GetRowResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.GetRowResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_row;
+        break;
+      }
+
+      // optional .cockroach.proto.Row row = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.GetRowResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.GetRowResponse)
+  return false;
+#undef DO_
+}
+
+void GetRowResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.GetRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Row row = 2;
+  if (has_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->row_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.GetRowResponse)
+}
+
+::google::protobuf::uint8* GetRowResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.GetRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Row row = 2;
+  if (has_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->row_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.GetRowResponse)
+  return target;
+}
+
+int GetRowResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Row row = 2;
+    if (has_row()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->row_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void GetRowResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const GetRowResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const GetRowResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void GetRowResponse::MergeFrom(const GetRowResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_row()) {
+      mutable_row()->::cockroach::proto::Row::MergeFrom(from.row());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void GetRowResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void GetRowResponse::CopyFrom(const GetRowResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool GetRowResponse::IsInitialized() const {
+
+  return true;
+}
+
+void GetRowResponse::Swap(GetRowResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void GetRowResponse::InternalSwap(GetRowResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(row_, other->row_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata GetRowResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = GetRowResponse_descriptor_;
+  metadata.reflection = GetRowResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// GetRowResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool GetRowResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void GetRowResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void GetRowResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void GetRowResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& GetRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* GetRowResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* GetRowResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void GetRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowResponse.header)
+}
+
+// optional .cockroach.proto.Row row = 2;
+bool GetRowResponse::has_row() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void GetRowResponse::set_has_row() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void GetRowResponse::clear_has_row() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void GetRowResponse::clear_row() {
+  if (row_ != NULL) row_->::cockroach::proto::Row::Clear();
+  clear_has_row();
+}
+ const ::cockroach::proto::Row& GetRowResponse::row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowResponse.row)
+  return row_ != NULL ? *row_ : *default_instance_->row_;
+}
+ ::cockroach::proto::Row* GetRowResponse::mutable_row() {
+  set_has_row();
+  if (row_ == NULL) {
+    row_ = new ::cockroach::proto::Row;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowResponse.row)
+  return row_;
+}
+ ::cockroach::proto::Row* GetRowResponse::release_row() {
+  clear_has_row();
+  ::cockroach::proto::Row* temp = row_;
+  row_ = NULL;
+  return temp;
+}
+ void GetRowResponse::set_allocated_row(::cockroach::proto::Row* row) {
+  delete row_;
+  row_ = row;
+  if (row) {
+    set_has_row();
+  } else {
+    clear_has_row();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowResponse.row)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int PutRowRequest::kHeaderFieldNumber;
+const int PutRowRequest::kTableIdFieldNumber;
+const int PutRowRequest::kIndexIdFieldNumber;
+const int PutRowRequest::kPrimaryKeyFieldNumber;
+const int PutRowRequest::kCellsFieldNumber;
+const int PutRowRequest::kTtlSecondsFieldNumber;
+#endif  // !_MSC_VER
+
+PutRowRequest::PutRowRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.PutRowRequest)
+}
+
+void PutRowRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+PutRowRequest::PutRowRequest(const PutRowRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.PutRowRequest)
+}
+
+void PutRowRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  table_id_ = 0u;
+  index_id_ = 0u;
+  primary_key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ttl_seconds_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+PutRowRequest::~PutRowRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.PutRowRequest)
+  SharedDtor();
+}
+
+void PutRowRequest::SharedDtor() {
+  primary_key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void PutRowRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PutRowRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PutRowRequest_descriptor_;
+}
+
+const PutRowRequest& PutRowRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+PutRowRequest* PutRowRequest::default_instance_ = NULL;
+
+PutRowRequest* PutRowRequest::New(::google::protobuf::Arena* arena) const {
+  PutRowRequest* n = new PutRowRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void PutRowRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<PutRowRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 47u) {
+    ZR_(table_id_, index_id_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_primary_key()) {
+      primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    ttl_seconds_ = 0;
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  cells_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool PutRowRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.PutRowRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_table_id;
+        break;
+      }
+
+      // optional uint32 table_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_table_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &table_id_)));
+          set_has_table_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_index_id;
+        break;
+      }
+
+      // optional uint32 index_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_index_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &index_id_)));
+          set_has_index_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_primary_key;
+        break;
+      }
+
+      // optional bytes primary_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_primary_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_primary_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_cells;
+        break;
+      }
+
+      // repeated .cockroach.proto.RowCell cells = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_cells:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_cells:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_cells()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_loop_cells;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectTag(48)) goto parse_ttl_seconds;
+        break;
+      }
+
+      // optional int32 ttl_seconds = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_ttl_seconds:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &ttl_seconds_)));
+          set_has_ttl_seconds();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.PutRowRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.PutRowRequest)
+  return false;
+#undef DO_
+}
+
+void PutRowRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.PutRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(2, this->table_id(), output);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(3, this->index_id(), output);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->primary_key(), output);
+  }
+
+  // repeated .cockroach.proto.RowCell cells = 5;
+  for (unsigned int i = 0, n = this->cells_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      5, this->cells(i), output);
+  }
+
+  // optional int32 ttl_seconds = 6;
+  if (has_ttl_seconds()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(6, this->ttl_seconds(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.PutRowRequest)
+}
+
+::google::protobuf::uint8* PutRowRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.PutRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(2, this->table_id(), target);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(3, this->index_id(), target);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->primary_key(), target);
+  }
+
+  // repeated .cockroach.proto.RowCell cells = 5;
+  for (unsigned int i = 0, n = this->cells_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        5, this->cells(i), target);
+  }
+
+  // optional int32 ttl_seconds = 6;
+  if (has_ttl_seconds()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(6, this->ttl_seconds(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.PutRowRequest)
+  return target;
+}
+
+int PutRowRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 47) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional uint32 table_id = 2;
+    if (has_table_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->table_id());
+    }
+
+    // optional uint32 index_id = 3;
+    if (has_index_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->index_id());
+    }
+
+    // optional bytes primary_key = 4;
+    if (has_primary_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->primary_key());
+    }
+
+    // optional int32 ttl_seconds = 6;
+    if (has_ttl_seconds()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->ttl_seconds());
+    }
+
+  }
+  // repeated .cockroach.proto.RowCell cells = 5;
+  total_size += 1 * this->cells_size();
+  for (int i = 0; i < this->cells_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->cells(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PutRowRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const PutRowRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const PutRowRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PutRowRequest::MergeFrom(const PutRowRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  cells_.MergeFrom(from.cells_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_table_id()) {
+      set_table_id(from.table_id());
+    }
+    if (from.has_index_id()) {
+      set_index_id(from.index_id());
+    }
+    if (from.has_primary_key()) {
+      set_has_primary_key();
+      primary_key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.primary_key_);
+    }
+    if (from.has_ttl_seconds()) {
+      set_ttl_seconds(from.ttl_seconds());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void PutRowRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PutRowRequest::CopyFrom(const PutRowRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PutRowRequest::IsInitialized() const {
+
+  return true;
+}
+
+void PutRowRequest::Swap(PutRowRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void PutRowRequest::InternalSwap(PutRowRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(table_id_, other->table_id_);
+  std::swap(index_id_, other->index_id_);
+  primary_key_.Swap(&other->primary_key_);
+  cells_.UnsafeArenaSwap(&other->cells_);
+  std::swap(ttl_seconds_, other->ttl_seconds_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata PutRowRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PutRowRequest_descriptor_;
+  metadata.reflection = PutRowRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// PutRowRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool PutRowRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void PutRowRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void PutRowRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void PutRowRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& PutRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* PutRowRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* PutRowRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void PutRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRowRequest.header)
+}
+
+// optional uint32 table_id = 2;
+bool PutRowRequest::has_table_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void PutRowRequest::set_has_table_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void PutRowRequest::clear_has_table_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void PutRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
+}
+ ::google::protobuf::uint32 PutRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.table_id)
+  return table_id_;
+}
+ void PutRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+bool PutRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void PutRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void PutRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void PutRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+ ::google::protobuf::uint32 PutRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.index_id)
+  return index_id_;
+}
+ void PutRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+bool PutRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void PutRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void PutRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void PutRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+ const ::std::string& PutRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void PutRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.primary_key)
+}
+ void PutRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.PutRowRequest.primary_key)
+}
+ void PutRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.PutRowRequest.primary_key)
+}
+ ::std::string* PutRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* PutRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void PutRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRowRequest.primary_key)
+}
+
+// repeated .cockroach.proto.RowCell cells = 5;
+int PutRowRequest::cells_size() const {
+  return cells_.size();
+}
+void PutRowRequest::clear_cells() {
+  cells_.Clear();
+}
+ const ::cockroach::proto::RowCell& PutRowRequest::cells(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.cells)
+  return cells_.Get(index);
+}
+ ::cockroach::proto::RowCell* PutRowRequest::mutable_cells(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowRequest.cells)
+  return cells_.Mutable(index);
+}
+ ::cockroach::proto::RowCell* PutRowRequest::add_cells() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.PutRowRequest.cells)
+  return cells_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >&
+PutRowRequest::cells() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.PutRowRequest.cells)
+  return cells_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >*
+PutRowRequest::mutable_cells() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.PutRowRequest.cells)
+  return &cells_;
+}
+
+// optional int32 ttl_seconds = 6;
+bool PutRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+void PutRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000020u;
+}
+void PutRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+void PutRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+ ::google::protobuf::int32 PutRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+ void PutRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.ttl_seconds)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int PutRowResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+PutRowResponse::PutRowResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.PutRowResponse)
+}
+
+void PutRowResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+PutRowResponse::PutRowResponse(const PutRowResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.PutRowResponse)
+}
+
+void PutRowResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+PutRowResponse::~PutRowResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.PutRowResponse)
+  SharedDtor();
+}
+
+void PutRowResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void PutRowResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PutRowResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PutRowResponse_descriptor_;
+}
+
+const PutRowResponse& PutRowResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+PutRowResponse* PutRowResponse::default_instance_ = NULL;
+
+PutRowResponse* PutRowResponse::New(::google::protobuf::Arena* arena) const {
+  PutRowResponse* n = new PutRowResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void PutRowResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool PutRowResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.PutRowResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.PutRowResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.PutRowResponse)
+  return false;
+#undef DO_
+}
+
+void PutRowResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.PutRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.PutRowResponse)
+}
+
+::google::protobuf::uint8* PutRowResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.PutRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.PutRowResponse)
+  return target;
+}
+
+int PutRowResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PutRowResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const PutRowResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const PutRowResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PutRowResponse::MergeFrom(const PutRowResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void PutRowResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PutRowResponse::CopyFrom(const PutRowResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PutRowResponse::IsInitialized() const {
+
+  return true;
+}
+
+void PutRowResponse::Swap(PutRowResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void PutRowResponse::InternalSwap(PutRowResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata PutRowResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PutRowResponse_descriptor_;
+  metadata.reflection = PutRowResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// PutRowResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool PutRowResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void PutRowResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void PutRowResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void PutRowResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& PutRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* PutRowResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* PutRowResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void PutRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRowResponse.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int DeleteRowRequest::kHeaderFieldNumber;
+const int DeleteRowRequest::kTableIdFieldNumber;
+const int DeleteRowRequest::kIndexIdFieldNumber;
+const int DeleteRowRequest::kPrimaryKeyFieldNumber;
+const int DeleteRowRequest::kTtlSecondsFieldNumber;
+#endif  // !_MSC_VER
+
+DeleteRowRequest::DeleteRowRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRowRequest)
+}
+
+void DeleteRowRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+DeleteRowRequest::DeleteRowRequest(const DeleteRowRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRowRequest)
+}
+
+void DeleteRowRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  table_id_ = 0u;
+  index_id_ = 0u;
+  primary_key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ttl_seconds_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+DeleteRowRequest::~DeleteRowRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRowRequest)
+  SharedDtor();
+}
+
+void DeleteRowRequest::SharedDtor() {
+  primary_key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void DeleteRowRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* DeleteRowRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return DeleteRowRequest_descriptor_;
+}
+
+const DeleteRowRequest& DeleteRowRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+DeleteRowRequest* DeleteRowRequest::default_instance_ = NULL;
+
+DeleteRowRequest* DeleteRowRequest::New(::google::protobuf::Arena* arena) const {
+  DeleteRowRequest* n = new DeleteRowRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void DeleteRowRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<DeleteRowRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 31u) {
+    ZR_(table_id_, index_id_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_primary_key()) {
+      primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    ttl_seconds_ = 0;
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool DeleteRowRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRowRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_table_id;
+        break;
+      }
+
+      // optional uint32 table_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_table_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &table_id_)));
+          set_has_table_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_index_id;
+        break;
+      }
+
+      // optional uint32 index_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_index_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &index_id_)));
+          set_has_index_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_primary_key;
+        break;
+      }
+
+      // optional bytes primary_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_primary_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_primary_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_ttl_seconds;
+        break;
+      }
+
+      // optional int32 ttl_seconds = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_ttl_seconds:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &ttl_seconds_)));
+          set_has_ttl_seconds();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRowRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRowRequest)
+  return false;
+#undef DO_
+}
+
+void DeleteRowRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(2, this->table_id(), output);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(3, this->index_id(), output);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->primary_key(), output);
+  }
+
+  // optional int32 ttl_seconds = 5;
+  if (has_ttl_seconds()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(5, this->ttl_seconds(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRowRequest)
+}
+
+::google::protobuf::uint8* DeleteRowRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(2, this->table_id(), target);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(3, this->index_id(), target);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->primary_key(), target);
+  }
+
+  // optional int32 ttl_seconds = 5;
+  if (has_ttl_seconds()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(5, this->ttl_seconds(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRowRequest)
+  return target;
+}
+
+int DeleteRowRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 31) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional uint32 table_id = 2;
+    if (has_table_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->table_id());
+    }
+
+    // optional uint32 index_id = 3;
+    if (has_index_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->index_id());
+    }
+
+    // optional bytes primary_key = 4;
+    if (has_primary_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->primary_key());
+    }
+
+    // optional int32 ttl_seconds = 5;
+    if (has_ttl_seconds()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->ttl_seconds());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void DeleteRowRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const DeleteRowRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const DeleteRowRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void DeleteRowRequest::MergeFrom(const DeleteRowRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_table_id()) {
+      set_table_id(from.table_id());
+    }
+    if (from.has_index_id()) {
+      set_index_id(from.index_id());
+    }
+    if (from.has_primary_key()) {
+      set_has_primary_key();
+      primary_key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.primary_key_);
+    }
+    if (from.has_ttl_seconds()) {
+      set_ttl_seconds(from.ttl_seconds());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void DeleteRowRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void DeleteRowRequest::CopyFrom(const DeleteRowRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool DeleteRowRequest::IsInitialized() const {
+
+  return true;
+}
+
+void DeleteRowRequest::Swap(DeleteRowRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void DeleteRowRequest::InternalSwap(DeleteRowRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(table_id_, other->table_id_);
+  std::swap(index_id_, other->index_id_);
+  primary_key_.Swap(&other->primary_key_);
+  std::swap(ttl_seconds_, other->ttl_seconds_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata DeleteRowRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = DeleteRowRequest_descriptor_;
+  metadata.reflection = DeleteRowRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// DeleteRowRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool DeleteRowRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void DeleteRowRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void DeleteRowRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void DeleteRowRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& DeleteRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* DeleteRowRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRowRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* DeleteRowRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void DeleteRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRowRequest.header)
+}
+
+// optional uint32 table_id = 2;
+bool DeleteRowRequest::has_table_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void DeleteRowRequest::set_has_table_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void DeleteRowRequest::clear_has_table_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void DeleteRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
+}
+ ::google::protobuf::uint32 DeleteRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.table_id)
+  return table_id_;
+}
+ void DeleteRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+bool DeleteRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void DeleteRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void DeleteRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void DeleteRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+ ::google::protobuf::uint32 DeleteRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.index_id)
+  return index_id_;
+}
+ void DeleteRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+bool DeleteRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void DeleteRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void DeleteRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void DeleteRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+ const ::std::string& DeleteRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void DeleteRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.primary_key)
+}
+ void DeleteRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.DeleteRowRequest.primary_key)
+}
+ void DeleteRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.DeleteRowRequest.primary_key)
+}
+ ::std::string* DeleteRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* DeleteRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void DeleteRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRowRequest.primary_key)
+}
+
+// optional int32 ttl_seconds = 5;
+bool DeleteRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+void DeleteRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000010u;
+}
+void DeleteRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+void DeleteRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+ ::google::protobuf::int32 DeleteRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+ void DeleteRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.ttl_seconds)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int DeleteRowResponse::kHeaderFieldNumber;
+const int DeleteRowResponse::kDeletedFieldNumber;
+#endif  // !_MSC_VER
+
+DeleteRowResponse::DeleteRowResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.DeleteRowResponse)
+}
+
+void DeleteRowResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+DeleteRowResponse::DeleteRowResponse(const DeleteRowResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.DeleteRowResponse)
+}
+
+void DeleteRowResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  deleted_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+DeleteRowResponse::~DeleteRowResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.DeleteRowResponse)
+  SharedDtor();
+}
+
+void DeleteRowResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void DeleteRowResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* DeleteRowResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return DeleteRowResponse_descriptor_;
+}
+
+const DeleteRowResponse& DeleteRowResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+DeleteRowResponse* DeleteRowResponse::default_instance_ = NULL;
+
+DeleteRowResponse* DeleteRowResponse::New(::google::protobuf::Arena* arena) const {
+  DeleteRowResponse* n = new DeleteRowResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void DeleteRowResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    deleted_ = false;
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool DeleteRowResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.DeleteRowResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_deleted;
+        break;
+      }
+
+      // optional bool deleted = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &deleted_)));
+          set_has_deleted();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.DeleteRowResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.DeleteRowResponse)
+  return false;
+#undef DO_
+}
+
+void DeleteRowResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.DeleteRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional bool deleted = 2;
+  if (has_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(2, this->deleted(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.DeleteRowResponse)
+}
+
+::google::protobuf::uint8* DeleteRowResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.DeleteRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional bool deleted = 2;
+  if (has_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(2, this->deleted(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.DeleteRowResponse)
+  return target;
+}
+
+int DeleteRowResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional bool deleted = 2;
+    if (has_deleted()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void DeleteRowResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const DeleteRowResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const DeleteRowResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void DeleteRowResponse::MergeFrom(const DeleteRowResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_deleted()) {
+      set_deleted(from.deleted());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void DeleteRowResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void DeleteRowResponse::CopyFrom(const DeleteRowResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool DeleteRowResponse::IsInitialized() const {
+
+  return true;
+}
+
+void DeleteRowResponse::Swap(DeleteRowResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void DeleteRowResponse::InternalSwap(DeleteRowResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(deleted_, other->deleted_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata DeleteRowResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = DeleteRowResponse_descriptor_;
+  metadata.reflection = DeleteRowResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// DeleteRowResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool DeleteRowResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void DeleteRowResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void DeleteRowResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void DeleteRowResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& DeleteRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* DeleteRowResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRowResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* DeleteRowResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void DeleteRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRowResponse.header)
+}
+
+// optional bool deleted = 2;
+bool DeleteRowResponse::has_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void DeleteRowResponse::set_has_deleted() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void DeleteRowResponse::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void DeleteRowResponse::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+ bool DeleteRowResponse::deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowResponse.deleted)
+  return deleted_;
+}
+ void DeleteRowResponse::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowResponse.deleted)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+const ::google::protobuf::EnumDescriptor* RowFilter_Op_descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RowFilter_Op_descriptor_;
+}
+bool RowFilter_Op_IsValid(int value) {
+  switch(value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+    case 4:
+    case 5:
+    case 6:
+    case 7:
+      return true;
+    default:
+      return false;
+  }
+}
+
+#ifndef _MSC_VER
+const RowFilter_Op RowFilter::EQ;
+const RowFilter_Op RowFilter::NE;
+const RowFilter_Op RowFilter::LT;
+const RowFilter_Op RowFilter::LE;
+const RowFilter_Op RowFilter::GT;
+const RowFilter_Op RowFilter::GE;
+const RowFilter_Op RowFilter::IS_NULL;
+const RowFilter_Op RowFilter::IS_NOT_NULL;
+const RowFilter_Op RowFilter::Op_MIN;
+const RowFilter_Op RowFilter::Op_MAX;
+const int RowFilter::Op_ARRAYSIZE;
+#endif  // _MSC_VER
+const ::google::protobuf::EnumDescriptor* RowFilter_Type_descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RowFilter_Type_descriptor_;
+}
+bool RowFilter_Type_IsValid(int value) {
+  switch(value) {
+    case 0:
+    case 1:
+    case 2:
+      return true;
+    default:
+      return false;
+  }
+}
+
+#ifndef _MSC_VER
+const RowFilter_Type RowFilter::BYTES;
+const RowFilter_Type RowFilter::FLOAT;
+const RowFilter_Type RowFilter::INT;
+const RowFilter_Type RowFilter::Type_MIN;
+const RowFilter_Type RowFilter::Type_MAX;
+const int RowFilter::Type_ARRAYSIZE;
+#endif  // _MSC_VER
+#ifndef _MSC_VER
+const int RowFilter::kColumnIdFieldNumber;
+const int RowFilter::kOpFieldNumber;
+const int RowFilter::kTypeFieldNumber;
+const int RowFilter::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+RowFilter::RowFilter()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RowFilter)
+}
+
+void RowFilter::InitAsDefaultInstance() {
+}
+
+RowFilter::RowFilter(const RowFilter& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RowFilter)
+}
+
+void RowFilter::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  column_id_ = 0u;
+  op_ = 0;
+  type_ = 0;
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RowFilter::~RowFilter() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RowFilter)
+  SharedDtor();
+}
+
+void RowFilter::SharedDtor() {
+  value_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+  }
+}
+
+void RowFilter::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RowFilter::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RowFilter_descriptor_;
+}
+
+const RowFilter& RowFilter::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+RowFilter* RowFilter::default_instance_ = NULL;
+
+RowFilter* RowFilter::New(::google::protobuf::Arena* arena) const {
+  RowFilter* n = new RowFilter;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void RowFilter::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<RowFilter*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 15u) {
+    ZR_(column_id_, op_);
+    type_ = 0;
+    if (has_value()) {
+      value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool RowFilter::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RowFilter)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional uint32 column_id = 1;
+      case 1: {
+        if (tag == 8) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &column_id_)));
+          set_has_column_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_op;
+        break;
+      }
+
+      // optional .cockroach.proto.RowFilter.Op op = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_op:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::RowFilter_Op_IsValid(value)) {
+            set_op(static_cast< ::cockroach::proto::RowFilter_Op >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(2, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_type;
+        break;
+      }
+
+      // optional .cockroach.proto.RowFilter.Type type = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_type:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::RowFilter_Type_IsValid(value)) {
+            set_type(static_cast< ::cockroach::proto::RowFilter_Type >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(3, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_value;
+        break;
+      }
+
+      // optional bytes value = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RowFilter)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RowFilter)
+  return false;
+#undef DO_
+}
+
+void RowFilter::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RowFilter)
+  // optional uint32 column_id = 1;
+  if (has_column_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(1, this->column_id(), output);
+  }
+
+  // optional .cockroach.proto.RowFilter.Op op = 2;
+  if (has_op()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      2, this->op(), output);
+  }
+
+  // optional .cockroach.proto.RowFilter.Type type = 3;
+  if (has_type()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      3, this->type(), output);
+  }
+
+  // optional bytes value = 4;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->value(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RowFilter)
+}
+
+::google::protobuf::uint8* RowFilter::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RowFilter)
+  // optional uint32 column_id = 1;
+  if (has_column_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(1, this->column_id(), target);
+  }
+
+  // optional .cockroach.proto.RowFilter.Op op = 2;
+  if (has_op()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      2, this->op(), target);
+  }
+
+  // optional .cockroach.proto.RowFilter.Type type = 3;
+  if (has_type()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      3, this->type(), target);
+  }
+
+  // optional bytes value = 4;
+  if (has_value()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->value(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RowFilter)
+  return target;
+}
+
+int RowFilter::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 15) {
+    // optional uint32 column_id = 1;
+    if (has_column_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->column_id());
+    }
+
+    // optional .cockroach.proto.RowFilter.Op op = 2;
+    if (has_op()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->op());
+    }
+
+    // optional .cockroach.proto.RowFilter.Type type = 3;
+    if (has_type()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->type());
+    }
+
+    // optional bytes value = 4;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->value());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RowFilter::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const RowFilter* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RowFilter>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RowFilter::MergeFrom(const RowFilter& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_column_id()) {
+      set_column_id(from.column_id());
+    }
+    if (from.has_op()) {
+      set_op(from.op());
+    }
+    if (from.has_type()) {
+      set_type(from.type());
+    }
+    if (from.has_value()) {
+      set_has_value();
+      value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void RowFilter::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RowFilter::CopyFrom(const RowFilter& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RowFilter::IsInitialized() const {
+
+  return true;
+}
+
+void RowFilter::Swap(RowFilter* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void RowFilter::InternalSwap(RowFilter* other) {
+  std::swap(column_id_, other->column_id_);
+  std::swap(op_, other->op_);
+  std::swap(type_, other->type_);
+  value_.Swap(&other->value_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata RowFilter::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RowFilter_descriptor_;
+  metadata.reflection = RowFilter_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// RowFilter
+
+// optional uint32 column_id = 1;
+bool RowFilter::has_column_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void RowFilter::set_has_column_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void RowFilter::clear_has_column_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void RowFilter::clear_column_id() {
+  column_id_ = 0u;
+  clear_has_column_id();
+}
+ ::google::protobuf::uint32 RowFilter::column_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.column_id)
+  return column_id_;
+}
+ void RowFilter::set_column_id(::google::protobuf::uint32 value) {
+  set_has_column_id();
+  column_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.column_id)
+}
+
+// optional .cockroach.proto.RowFilter.Op op = 2;
+bool RowFilter::has_op() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void RowFilter::set_has_op() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void RowFilter::clear_has_op() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void RowFilter::clear_op() {
+  op_ = 0;
+  clear_has_op();
+}
+ ::cockroach::proto::RowFilter_Op RowFilter::op() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.op)
+  return static_cast< ::cockroach::proto::RowFilter_Op >(op_);
+}
+ void RowFilter::set_op(::cockroach::proto::RowFilter_Op value) {
+  assert(::cockroach::proto::RowFilter_Op_IsValid(value));
+  set_has_op();
+  op_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.op)
+}
+
+// optional .cockroach.proto.RowFilter.Type type = 3;
+bool RowFilter::has_type() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void RowFilter::set_has_type() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void RowFilter::clear_has_type() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void RowFilter::clear_type() {
+  type_ = 0;
+  clear_has_type();
+}
+ ::cockroach::proto::RowFilter_Type RowFilter::type() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.type)
+  return static_cast< ::cockroach::proto::RowFilter_Type >(type_);
+}
+ void RowFilter::set_type(::cockroach::proto::RowFilter_Type value) {
+  assert(::cockroach::proto::RowFilter_Type_IsValid(value));
+  set_has_type();
+  type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.type)
+}
+
+// optional bytes value = 4;
+bool RowFilter::has_value() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void RowFilter::set_has_value() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void RowFilter::clear_has_value() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void RowFilter::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_value();
+}
+ const ::std::string& RowFilter::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.value)
+  return value_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RowFilter::set_value(const ::std::string& value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.value)
+}
+ void RowFilter::set_value(const char* value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RowFilter.value)
+}
+ void RowFilter::set_value(const void* value, size_t size) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RowFilter.value)
+}
+ ::std::string* RowFilter::mutable_value() {
+  set_has_value();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowFilter.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* RowFilter::release_value() {
+  clear_has_value();
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RowFilter::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowFilter.value)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+const ::google::protobuf::EnumDescriptor* RowAggregate_Func_descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RowAggregate_Func_descriptor_;
+}
+bool RowAggregate_Func_IsValid(int value) {
+  switch(value) {
+    case 0:
+    case 1:
+    case 2:
+    case 3:
+      return true;
+    default:
+      return false;
+  }
+}
+
+#ifndef _MSC_VER
+const RowAggregate_Func RowAggregate::COUNT;
+const RowAggregate_Func RowAggregate::SUM;
+const RowAggregate_Func RowAggregate::MIN;
+const RowAggregate_Func RowAggregate::MAX;
+const RowAggregate_Func RowAggregate::Func_MIN;
+const RowAggregate_Func RowAggregate::Func_MAX;
+const int RowAggregate::Func_ARRAYSIZE;
+#endif  // _MSC_VER
+#ifndef _MSC_VER
+const int RowAggregate::kFuncFieldNumber;
+const int RowAggregate::kColumnIdFieldNumber;
+const int RowAggregate::kTypeFieldNumber;
+#endif  // !_MSC_VER
+
+RowAggregate::RowAggregate()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RowAggregate)
+}
+
+void RowAggregate::InitAsDefaultInstance() {
+}
+
+RowAggregate::RowAggregate(const RowAggregate& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RowAggregate)
+}
+
+void RowAggregate::SharedCtor() {
+  _cached_size_ = 0;
+  func_ = 0;
+  column_id_ = 0u;
+  type_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RowAggregate::~RowAggregate() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RowAggregate)
+  SharedDtor();
+}
+
+void RowAggregate::SharedDtor() {
+  if (this != default_instance_) {
+  }
+}
+
+void RowAggregate::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RowAggregate::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RowAggregate_descriptor_;
+}
+
+const RowAggregate& RowAggregate::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+RowAggregate* RowAggregate::default_instance_ = NULL;
+
+RowAggregate* RowAggregate::New(::google::protobuf::Arena* arena) const {
+  RowAggregate* n = new RowAggregate;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void RowAggregate::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<RowAggregate*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  ZR_(func_, type_);
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool RowAggregate::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RowAggregate)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RowAggregate.Func func = 1;
+      case 1: {
+        if (tag == 8) {
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::RowAggregate_Func_IsValid(value)) {
+            set_func(static_cast< ::cockroach::proto::RowAggregate_Func >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(1, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_column_id;
+        break;
+      }
+
+      // optional uint32 column_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_column_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &column_id_)));
+          set_has_column_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_type;
+        break;
+      }
+
+      // optional .cockroach.proto.RowFilter.Type type = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_type:
+          int value;
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   int, ::google::protobuf::internal::WireFormatLite::TYPE_ENUM>(
+                 input, &value)));
+          if (::cockroach::proto::RowFilter_Type_IsValid(value)) {
+            set_type(static_cast< ::cockroach::proto::RowFilter_Type >(value));
+          } else {
+            mutable_unknown_fields()->AddVarint(3, value);
+          }
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RowAggregate)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RowAggregate)
+  return false;
+#undef DO_
+}
+
+void RowAggregate::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RowAggregate)
+  // optional .cockroach.proto.RowAggregate.Func func = 1;
+  if (has_func()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      1, this->func(), output);
+  }
+
+  // optional uint32 column_id = 2;
+  if (has_column_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(2, this->column_id(), output);
+  }
+
+  // optional .cockroach.proto.RowFilter.Type type = 3;
+  if (has_type()) {
+    ::google::protobuf::internal::WireFormatLite::WriteEnum(
+      3, this->type(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RowAggregate)
+}
+
+::google::protobuf::uint8* RowAggregate::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RowAggregate)
+  // optional .cockroach.proto.RowAggregate.Func func = 1;
+  if (has_func()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      1, this->func(), target);
+  }
+
+  // optional uint32 column_id = 2;
+  if (has_column_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(2, this->column_id(), target);
+  }
+
+  // optional .cockroach.proto.RowFilter.Type type = 3;
+  if (has_type()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteEnumToArray(
+      3, this->type(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RowAggregate)
+  return target;
+}
+
+int RowAggregate::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional .cockroach.proto.RowAggregate.Func func = 1;
+    if (has_func()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->func());
+    }
+
+    // optional uint32 column_id = 2;
+    if (has_column_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->column_id());
+    }
+
+    // optional .cockroach.proto.RowFilter.Type type = 3;
+    if (has_type()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::EnumSize(this->type());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RowAggregate::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const RowAggregate* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RowAggregate>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RowAggregate::MergeFrom(const RowAggregate& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_func()) {
+      set_func(from.func());
+    }
+    if (from.has_column_id()) {
+      set_column_id(from.column_id());
+    }
+    if (from.has_type()) {
+      set_type(from.type());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void RowAggregate::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RowAggregate::CopyFrom(const RowAggregate& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RowAggregate::IsInitialized() const {
+
+  return true;
+}
+
+void RowAggregate::Swap(RowAggregate* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void RowAggregate::InternalSwap(RowAggregate* other) {
+  std::swap(func_, other->func_);
+  std::swap(column_id_, other->column_id_);
+  std::swap(type_, other->type_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata RowAggregate::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RowAggregate_descriptor_;
+  metadata.reflection = RowAggregate_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// RowAggregate
+
+// optional .cockroach.proto.RowAggregate.Func func = 1;
+bool RowAggregate::has_func() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void RowAggregate::set_has_func() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void RowAggregate::clear_has_func() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void RowAggregate::clear_func() {
+  func_ = 0;
+  clear_has_func();
+}
+ ::cockroach::proto::RowAggregate_Func RowAggregate::func() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregate.func)
+  return static_cast< ::cockroach::proto::RowAggregate_Func >(func_);
+}
+ void RowAggregate::set_func(::cockroach::proto::RowAggregate_Func value) {
+  assert(::cockroach::proto::RowAggregate_Func_IsValid(value));
+  set_has_func();
+  func_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregate.func)
+}
+
+// optional uint32 column_id = 2;
+bool RowAggregate::has_column_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void RowAggregate::set_has_column_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void RowAggregate::clear_has_column_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void RowAggregate::clear_column_id() {
+  column_id_ = 0u;
+  clear_has_column_id();
+}
+ ::google::protobuf::uint32 RowAggregate::column_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregate.column_id)
+  return column_id_;
+}
+ void RowAggregate::set_column_id(::google::protobuf::uint32 value) {
+  set_has_column_id();
+  column_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregate.column_id)
+}
+
+// optional .cockroach.proto.RowFilter.Type type = 3;
+bool RowAggregate::has_type() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void RowAggregate::set_has_type() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void RowAggregate::clear_has_type() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void RowAggregate::clear_type() {
+  type_ = 0;
+  clear_has_type();
+}
+ ::cockroach::proto::RowFilter_Type RowAggregate::type() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregate.type)
+  return static_cast< ::cockroach::proto::RowFilter_Type >(type_);
+}
+ void RowAggregate::set_type(::cockroach::proto::RowFilter_Type value) {
+  assert(::cockroach::proto::RowFilter_Type_IsValid(value));
+  set_has_type();
+  type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregate.type)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RowAggregateResult::kAggregateFieldNumber;
+const int RowAggregateResult::kCountFieldNumber;
+const int RowAggregateResult::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+RowAggregateResult::RowAggregateResult()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RowAggregateResult)
+}
+
+void RowAggregateResult::InitAsDefaultInstance() {
+  aggregate_ = const_cast< ::cockroach::proto::RowAggregate*>(&::cockroach::proto::RowAggregate::default_instance());
+}
+
+RowAggregateResult::RowAggregateResult(const RowAggregateResult& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RowAggregateResult)
+}
+
+void RowAggregateResult::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  aggregate_ = NULL;
+  count_ = GOOGLE_LONGLONG(0);
+  value_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+RowAggregateResult::~RowAggregateResult() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RowAggregateResult)
+  SharedDtor();
+}
+
+void RowAggregateResult::SharedDtor() {
+  value_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete aggregate_;
+  }
+}
+
+void RowAggregateResult::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RowAggregateResult::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RowAggregateResult_descriptor_;
+}
+
+const RowAggregateResult& RowAggregateResult::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+RowAggregateResult* RowAggregateResult::default_instance_ = NULL;
+
+RowAggregateResult* RowAggregateResult::New(::google::protobuf::Arena* arena) const {
+  RowAggregateResult* n = new RowAggregateResult;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void RowAggregateResult::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_aggregate()) {
+      if (aggregate_ != NULL) aggregate_->::cockroach::proto::RowAggregate::Clear();
+    }
+    count_ = GOOGLE_LONGLONG(0);
+    if (has_value()) {
+      value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool RowAggregateResult::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.RowAggregateResult)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RowAggregate aggregate = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_aggregate()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_count;
+        break;
+      }
+
+      // optional int64 count = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_count:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &count_)));
+          set_has_count();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_value;
+        break;
+      }
+
+      // optional bytes value = 3;
+      case 3: {
+        if (tag == 26) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.RowAggregateResult)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.RowAggregateResult)
+  return false;
+#undef DO_
+}
+
+void RowAggregateResult::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.RowAggregateResult)
+  // optional .cockroach.proto.RowAggregate aggregate = 1;
+  if (has_aggregate()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->aggregate_, output);
+  }
+
+  // optional int64 count = 2;
+  if (has_count()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(2, this->count(), output);
+  }
+
+  // optional bytes value = 3;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      3, this->value(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.RowAggregateResult)
+}
+
+::google::protobuf::uint8* RowAggregateResult::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.RowAggregateResult)
+  // optional .cockroach.proto.RowAggregate aggregate = 1;
+  if (has_aggregate()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->aggregate_, target);
+  }
+
+  // optional int64 count = 2;
+  if (has_count()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(2, this->count(), target);
+  }
+
+  // optional bytes value = 3;
+  if (has_value()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        3, this->value(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.RowAggregateResult)
+  return target;
+}
+
+int RowAggregateResult::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional .cockroach.proto.RowAggregate aggregate = 1;
+    if (has_aggregate()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->aggregate_);
+    }
+
+    // optional int64 count = 2;
+    if (has_count()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->count());
+    }
+
+    // optional bytes value = 3;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->value());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void RowAggregateResult::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const RowAggregateResult* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const RowAggregateResult>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void RowAggregateResult::MergeFrom(const RowAggregateResult& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_aggregate()) {
+      mutable_aggregate()->::cockroach::proto::RowAggregate::MergeFrom(from.aggregate());
+    }
+    if (from.has_count()) {
+      set_count(from.count());
+    }
+    if (from.has_value()) {
+      set_has_value();
+      value_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.value_);
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void RowAggregateResult::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void RowAggregateResult::CopyFrom(const RowAggregateResult& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool RowAggregateResult::IsInitialized() const {
+
+  return true;
+}
+
+void RowAggregateResult::Swap(RowAggregateResult* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void RowAggregateResult::InternalSwap(RowAggregateResult* other) {
+  std::swap(aggregate_, other->aggregate_);
+  std::swap(count_, other->count_);
+  value_.Swap(&other->value_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata RowAggregateResult::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = RowAggregateResult_descriptor_;
+  metadata.reflection = RowAggregateResult_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// RowAggregateResult
+
+// optional .cockroach.proto.RowAggregate aggregate = 1;
+bool RowAggregateResult::has_aggregate() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void RowAggregateResult::set_has_aggregate() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void RowAggregateResult::clear_has_aggregate() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void RowAggregateResult::clear_aggregate() {
+  if (aggregate_ != NULL) aggregate_->::cockroach::proto::RowAggregate::Clear();
+  clear_has_aggregate();
+}
+ const ::cockroach::proto::RowAggregate& RowAggregateResult::aggregate() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregateResult.aggregate)
+  return aggregate_ != NULL ? *aggregate_ : *default_instance_->aggregate_;
+}
+ ::cockroach::proto::RowAggregate* RowAggregateResult::mutable_aggregate() {
+  set_has_aggregate();
+  if (aggregate_ == NULL) {
+    aggregate_ = new ::cockroach::proto::RowAggregate;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowAggregateResult.aggregate)
+  return aggregate_;
+}
+ ::cockroach::proto::RowAggregate* RowAggregateResult::release_aggregate() {
+  clear_has_aggregate();
+  ::cockroach::proto::RowAggregate* temp = aggregate_;
+  aggregate_ = NULL;
+  return temp;
+}
+ void RowAggregateResult::set_allocated_aggregate(::cockroach::proto::RowAggregate* aggregate) {
+  delete aggregate_;
+  aggregate_ = aggregate;
+  if (aggregate) {
+    set_has_aggregate();
+  } else {
+    clear_has_aggregate();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowAggregateResult.aggregate)
+}
+
+// optional int64 count = 2;
+bool RowAggregateResult::has_count() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void RowAggregateResult::set_has_count() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void RowAggregateResult::clear_has_count() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void RowAggregateResult::clear_count() {
+  count_ = GOOGLE_LONGLONG(0);
+  clear_has_count();
+}
+ ::google::protobuf::int64 RowAggregateResult::count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregateResult.count)
+  return count_;
+}
+ void RowAggregateResult::set_count(::google::protobuf::int64 value) {
+  set_has_count();
+  count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregateResult.count)
+}
+
+// optional bytes value = 3;
+bool RowAggregateResult::has_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void RowAggregateResult::set_has_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void RowAggregateResult::clear_has_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void RowAggregateResult::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_value();
+}
+ const ::std::string& RowAggregateResult::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregateResult.value)
+  return value_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RowAggregateResult::set_value(const ::std::string& value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregateResult.value)
+}
+ void RowAggregateResult::set_value(const char* value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RowAggregateResult.value)
+}
+ void RowAggregateResult::set_value(const void* value, size_t size) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RowAggregateResult.value)
+}
+ ::std::string* RowAggregateResult::mutable_value() {
+  set_has_value();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowAggregateResult.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* RowAggregateResult::release_value() {
+  clear_has_value();
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void RowAggregateResult::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowAggregateResult.value)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanRowsRequest::kHeaderFieldNumber;
+const int ScanRowsRequest::kTableIdFieldNumber;
+const int ScanRowsRequest::kIndexIdFieldNumber;
+const int ScanRowsRequest::kColumnIdsFieldNumber;
+const int ScanRowsRequest::kMaxRowsFieldNumber;
+const int ScanRowsRequest::kFiltersFieldNumber;
+const int ScanRowsRequest::kAggregatesFieldNumber;
+const int ScanRowsRequest::kTtlSecondsFieldNumber;
+#endif  // !_MSC_VER
+
+ScanRowsRequest::ScanRowsRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ScanRowsRequest)
+}
+
+void ScanRowsRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+ScanRowsRequest::ScanRowsRequest(const ScanRowsRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanRowsRequest)
+}
+
+void ScanRowsRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  table_id_ = 0u;
+  index_id_ = 0u;
+  max_rows_ = GOOGLE_LONGLONG(0);
+  ttl_seconds_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanRowsRequest::~ScanRowsRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ScanRowsRequest)
+  SharedDtor();
+}
+
+void ScanRowsRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanRowsRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanRowsRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanRowsRequest_descriptor_;
+}
+
+const ScanRowsRequest& ScanRowsRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ScanRowsRequest* ScanRowsRequest::default_instance_ = NULL;
+
+ScanRowsRequest* ScanRowsRequest::New(::google::protobuf::Arena* arena) const {
+  ScanRowsRequest* n = new ScanRowsRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ScanRowsRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<ScanRowsRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 151u) {
+    ZR_(table_id_, index_id_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    max_rows_ = GOOGLE_LONGLONG(0);
+    ttl_seconds_ = 0;
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  column_ids_.Clear();
+  filters_.Clear();
+  aggregates_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ScanRowsRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanRowsRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_table_id;
+        break;
+      }
+
+      // optional uint32 table_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_table_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &table_id_)));
+          set_has_table_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_index_id;
+        break;
+      }
+
+      // optional uint32 index_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_index_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &index_id_)));
+          set_has_index_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_column_ids;
+        break;
+      }
+
+      // repeated uint32 column_ids = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_column_ids:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 1, 32, input, this->mutable_column_ids())));
+        } else if (tag == 34) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitiveNoInline<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, this->mutable_column_ids())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(32)) goto parse_column_ids;
+        if (input->ExpectTag(40)) goto parse_max_rows;
+        break;
+      }
+
+      // optional int64 max_rows = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_max_rows:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_rows_)));
+          set_has_max_rows();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_filters;
+        break;
+      }
+
+      // repeated .cockroach.proto.RowFilter filters = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_filters:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_filters:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_filters()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_loop_filters;
+        if (input->ExpectTag(58)) goto parse_loop_aggregates;
+        input->UnsafeDecrementRecursionDepth();
+        break;
+      }
+
+      // repeated .cockroach.proto.RowAggregate aggregates = 7;
+      case 7: {
+        if (tag == 58) {
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_aggregates:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_aggregates()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_loop_aggregates;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectTag(64)) goto parse_ttl_seconds;
+        break;
+      }
+
+      // optional int32 ttl_seconds = 8;
+      case 8: {
+        if (tag == 64) {
+         parse_ttl_seconds:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &ttl_seconds_)));
+          set_has_ttl_seconds();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanRowsRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanRowsRequest)
+  return false;
+#undef DO_
+}
+
+void ScanRowsRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanRowsRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(2, this->table_id(), output);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(3, this->index_id(), output);
+  }
+
+  // repeated uint32 column_ids = 4;
+  for (int i = 0; i < this->column_ids_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(
+      4, this->column_ids(i), output);
+  }
+
+  // optional int64 max_rows = 5;
+  if (has_max_rows()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(5, this->max_rows(), output);
+  }
+
+  // repeated .cockroach.proto.RowFilter filters = 6;
+  for (unsigned int i = 0, n = this->filters_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      6, this->filters(i), output);
+  }
+
+  // repeated .cockroach.proto.RowAggregate aggregates = 7;
+  for (unsigned int i = 0, n = this->aggregates_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, this->aggregates(i), output);
+  }
+
+  // optional int32 ttl_seconds = 8;
+  if (has_ttl_seconds()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(8, this->ttl_seconds(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanRowsRequest)
+}
+
+::google::protobuf::uint8* ScanRowsRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanRowsRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(2, this->table_id(), target);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(3, this->index_id(), target);
+  }
+
+  // repeated uint32 column_ids = 4;
+  for (int i = 0; i < this->column_ids_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteUInt32ToArray(4, this->column_ids(i), target);
+  }
+
+  // optional int64 max_rows = 5;
+  if (has_max_rows()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(5, this->max_rows(), target);
+  }
+
+  // repeated .cockroach.proto.RowFilter filters = 6;
+  for (unsigned int i = 0, n = this->filters_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        6, this->filters(i), target);
+  }
+
+  // repeated .cockroach.proto.RowAggregate aggregates = 7;
+  for (unsigned int i = 0, n = this->aggregates_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, this->aggregates(i), target);
+  }
+
+  // optional int32 ttl_seconds = 8;
+  if (has_ttl_seconds()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(8, this->ttl_seconds(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanRowsRequest)
+  return target;
+}
+
+int ScanRowsRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 151) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional uint32 table_id = 2;
+    if (has_table_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->table_id());
+    }
+
+    // optional uint32 index_id = 3;
+    if (has_index_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->index_id());
+    }
+
+    // optional int64 max_rows = 5;
+    if (has_max_rows()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_rows());
+    }
+
+    // optional int32 ttl_seconds = 8;
+    if (has_ttl_seconds()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->ttl_seconds());
+    }
+
+  }
+  // repeated uint32 column_ids = 4;
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->column_ids_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        UInt32Size(this->column_ids(i));
+    }
+    total_size += 1 * this->column_ids_size() + data_size;
+  }
+
+  // repeated .cockroach.proto.RowFilter filters = 6;
+  total_size += 1 * this->filters_size();
+  for (int i = 0; i < this->filters_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->filters(i));
+  }
+
+  // repeated .cockroach.proto.RowAggregate aggregates = 7;
+  total_size += 1 * this->aggregates_size();
+  for (int i = 0; i < this->aggregates_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->aggregates(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanRowsRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ScanRowsRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ScanRowsRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanRowsRequest::MergeFrom(const ScanRowsRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  column_ids_.MergeFrom(from.column_ids_);
+  filters_.MergeFrom(from.filters_);
+  aggregates_.MergeFrom(from.aggregates_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_table_id()) {
+      set_table_id(from.table_id());
+    }
+    if (from.has_index_id()) {
+      set_index_id(from.index_id());
+    }
+    if (from.has_max_rows()) {
+      set_max_rows(from.max_rows());
+    }
+    if (from.has_ttl_seconds()) {
+      set_ttl_seconds(from.ttl_seconds());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ScanRowsRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanRowsRequest::CopyFrom(const ScanRowsRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanRowsRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ScanRowsRequest::Swap(ScanRowsRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ScanRowsRequest::InternalSwap(ScanRowsRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(table_id_, other->table_id_);
+  std::swap(index_id_, other->index_id_);
+  column_ids_.UnsafeArenaSwap(&other->column_ids_);
+  std::swap(max_rows_, other->max_rows_);
+  filters_.UnsafeArenaSwap(&other->filters_);
+  aggregates_.UnsafeArenaSwap(&other->aggregates_);
+  std::swap(ttl_seconds_, other->ttl_seconds_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ScanRowsRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanRowsRequest_descriptor_;
+  metadata.reflection = ScanRowsRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ScanRowsRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool ScanRowsRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ScanRowsRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ScanRowsRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ScanRowsRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& ScanRowsRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* ScanRowsRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* ScanRowsRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ScanRowsRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRowsRequest.header)
+}
+
+// optional uint32 table_id = 2;
+bool ScanRowsRequest::has_table_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ScanRowsRequest::set_has_table_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ScanRowsRequest::clear_has_table_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ScanRowsRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
+}
+ ::google::protobuf::uint32 ScanRowsRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.table_id)
+  return table_id_;
+}
+ void ScanRowsRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+bool ScanRowsRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ScanRowsRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ScanRowsRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ScanRowsRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+ ::google::protobuf::uint32 ScanRowsRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.index_id)
+  return index_id_;
+}
+ void ScanRowsRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.index_id)
+}
+
+// repeated uint32 column_ids = 4;
+int ScanRowsRequest::column_ids_size() const {
+  return column_ids_.size();
+}
+void ScanRowsRequest::clear_column_ids() {
+  column_ids_.Clear();
+}
+ ::google::protobuf::uint32 ScanRowsRequest::column_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.column_ids)
+  return column_ids_.Get(index);
+}
+ void ScanRowsRequest::set_column_ids(int index, ::google::protobuf::uint32 value) {
+  column_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.column_ids)
+}
+ void ScanRowsRequest::add_column_ids(::google::protobuf::uint32 value) {
+  column_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsRequest.column_ids)
+}
+ const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+ScanRowsRequest::column_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsRequest.column_ids)
+  return column_ids_;
+}
+ ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+ScanRowsRequest::mutable_column_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsRequest.column_ids)
+  return &column_ids_;
+}
+
+// optional int64 max_rows = 5;
+bool ScanRowsRequest::has_max_rows() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+void ScanRowsRequest::set_has_max_rows() {
+  _has_bits_[0] |= 0x00000010u;
+}
+void ScanRowsRequest::clear_has_max_rows() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+void ScanRowsRequest::clear_max_rows() {
+  max_rows_ = GOOGLE_LONGLONG(0);
+  clear_has_max_rows();
+}
+ ::google::protobuf::int64 ScanRowsRequest::max_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.max_rows)
+  return max_rows_;
+}
+ void ScanRowsRequest::set_max_rows(::google::protobuf::int64 value) {
+  set_has_max_rows();
+  max_rows_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.max_rows)
+}
+
+// repeated .cockroach.proto.RowFilter filters = 6;
+int ScanRowsRequest::filters_size() const {
+  return filters_.size();
+}
+void ScanRowsRequest::clear_filters() {
+  filters_.Clear();
+}
+ const ::cockroach::proto::RowFilter& ScanRowsRequest::filters(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.filters)
+  return filters_.Get(index);
+}
+ ::cockroach::proto::RowFilter* ScanRowsRequest::mutable_filters(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsRequest.filters)
+  return filters_.Mutable(index);
+}
+ ::cockroach::proto::RowFilter* ScanRowsRequest::add_filters() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsRequest.filters)
+  return filters_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter >&
+ScanRowsRequest::filters() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsRequest.filters)
+  return filters_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter >*
+ScanRowsRequest::mutable_filters() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsRequest.filters)
+  return &filters_;
+}
+
+// repeated .cockroach.proto.RowAggregate aggregates = 7;
+int ScanRowsRequest::aggregates_size() const {
+  return aggregates_.size();
+}
+void ScanRowsRequest::clear_aggregates() {
+  aggregates_.Clear();
+}
+ const ::cockroach::proto::RowAggregate& ScanRowsRequest::aggregates(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_.Get(index);
+}
+ ::cockroach::proto::RowAggregate* ScanRowsRequest::mutable_aggregates(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_.Mutable(index);
+}
+ ::cockroach::proto::RowAggregate* ScanRowsRequest::add_aggregates() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate >&
+ScanRowsRequest::aggregates() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate >*
+ScanRowsRequest::mutable_aggregates() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsRequest.aggregates)
+  return &aggregates_;
+}
+
+// optional int32 ttl_seconds = 8;
+bool ScanRowsRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+void ScanRowsRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000080u;
+}
+void ScanRowsRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+void ScanRowsRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+ ::google::protobuf::int32 ScanRowsRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+ void ScanRowsRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.ttl_seconds)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanRowsResponse::kHeaderFieldNumber;
+const int ScanRowsResponse::kRowsFieldNumber;
+const int ScanRowsResponse::kAggregatesFieldNumber;
+#endif  // !_MSC_VER
+
+ScanRowsResponse::ScanRowsResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ScanRowsResponse)
+}
+
+void ScanRowsResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+ScanRowsResponse::ScanRowsResponse(const ScanRowsResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanRowsResponse)
+}
+
+void ScanRowsResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanRowsResponse::~ScanRowsResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ScanRowsResponse)
+  SharedDtor();
+}
+
+void ScanRowsResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanRowsResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanRowsResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanRowsResponse_descriptor_;
+}
+
+const ScanRowsResponse& ScanRowsResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ScanRowsResponse* ScanRowsResponse::default_instance_ = NULL;
+
+ScanRowsResponse* ScanRowsResponse::New(::google::protobuf::Arena* arena) const {
+  ScanRowsResponse* n = new ScanRowsResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ScanRowsResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  rows_.Clear();
+  aggregates_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ScanRowsResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanRowsResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_rows;
+        break;
+      }
+
+      // repeated .cockroach.proto.Row rows = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_rows:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_rows;
+        if (input->ExpectTag(26)) goto parse_loop_aggregates;
+        input->UnsafeDecrementRecursionDepth();
+        break;
+      }
+
+      // repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+      case 3: {
+        if (tag == 26) {
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_aggregates:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_aggregates()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(26)) goto parse_loop_aggregates;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanRowsResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanRowsResponse)
+  return false;
+#undef DO_
+}
+
+void ScanRowsResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanRowsResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // repeated .cockroach.proto.Row rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->rows(i), output);
+  }
+
+  // repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+  for (unsigned int i = 0, n = this->aggregates_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      3, this->aggregates(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanRowsResponse)
+}
+
+::google::protobuf::uint8* ScanRowsResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanRowsResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // repeated .cockroach.proto.Row rows = 2;
+  for (unsigned int i = 0, n = this->rows_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->rows(i), target);
+  }
+
+  // repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+  for (unsigned int i = 0, n = this->aggregates_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        3, this->aggregates(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanRowsResponse)
+  return target;
+}
+
+int ScanRowsResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  // repeated .cockroach.proto.Row rows = 2;
+  total_size += 1 * this->rows_size();
+  for (int i = 0; i < this->rows_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->rows(i));
+  }
+
+  // repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+  total_size += 1 * this->aggregates_size();
+  for (int i = 0; i < this->aggregates_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->aggregates(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanRowsResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ScanRowsResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ScanRowsResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanRowsResponse::MergeFrom(const ScanRowsResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  rows_.MergeFrom(from.rows_);
+  aggregates_.MergeFrom(from.aggregates_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ScanRowsResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanRowsResponse::CopyFrom(const ScanRowsResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanRowsResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ScanRowsResponse::Swap(ScanRowsResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ScanRowsResponse::InternalSwap(ScanRowsResponse* other) {
+  std::swap(header_, other->header_);
+  rows_.UnsafeArenaSwap(&other->rows_);
+  aggregates_.UnsafeArenaSwap(&other->aggregates_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ScanRowsResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanRowsResponse_descriptor_;
+  metadata.reflection = ScanRowsResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ScanRowsResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool ScanRowsResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ScanRowsResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ScanRowsResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ScanRowsResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& ScanRowsResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* ScanRowsResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* ScanRowsResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ScanRowsResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRowsResponse.header)
+}
+
+// repeated .cockroach.proto.Row rows = 2;
+int ScanRowsResponse::rows_size() const {
+  return rows_.size();
+}
+void ScanRowsResponse::clear_rows() {
+  rows_.Clear();
+}
+ const ::cockroach::proto::Row& ScanRowsResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsResponse.rows)
+  return rows_.Get(index);
+}
+ ::cockroach::proto::Row* ScanRowsResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsResponse.rows)
+  return rows_.Mutable(index);
+}
+ ::cockroach::proto::Row* ScanRowsResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsResponse.rows)
+  return rows_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row >&
+ScanRowsResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsResponse.rows)
+  return rows_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row >*
+ScanRowsResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsResponse.rows)
+  return &rows_;
+}
+
+// repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+int ScanRowsResponse::aggregates_size() const {
+  return aggregates_.size();
+}
+void ScanRowsResponse::clear_aggregates() {
+  aggregates_.Clear();
+}
+ const ::cockroach::proto::RowAggregateResult& ScanRowsResponse::aggregates(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_.Get(index);
+}
+ ::cockroach::proto::RowAggregateResult* ScanRowsResponse::mutable_aggregates(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_.Mutable(index);
+}
+ ::cockroach::proto::RowAggregateResult* ScanRowsResponse::add_aggregates() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult >&
+ScanRowsResponse::aggregates() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult >*
+ScanRowsResponse::mutable_aggregates() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsResponse.aggregates)
+  return &aggregates_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int LockRowRequest::kHeaderFieldNumber;
+const int LockRowRequest::kTableIdFieldNumber;
+const int LockRowRequest::kIndexIdFieldNumber;
+const int LockRowRequest::kPrimaryKeyFieldNumber;
+const int LockRowRequest::kColumnIdsFieldNumber;
+const int LockRowRequest::kTtlSecondsFieldNumber;
+#endif  // !_MSC_VER
+
+LockRowRequest::LockRowRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.LockRowRequest)
+}
+
+void LockRowRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+}
+
+LockRowRequest::LockRowRequest(const LockRowRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.LockRowRequest)
+}
+
+void LockRowRequest::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  header_ = NULL;
+  table_id_ = 0u;
+  index_id_ = 0u;
+  primary_key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  ttl_seconds_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+LockRowRequest::~LockRowRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.LockRowRequest)
+  SharedDtor();
+}
+
+void LockRowRequest::SharedDtor() {
+  primary_key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void LockRowRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* LockRowRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return LockRowRequest_descriptor_;
+}
+
+const LockRowRequest& LockRowRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+LockRowRequest* LockRowRequest::default_instance_ = NULL;
+
+LockRowRequest* LockRowRequest::New(::google::protobuf::Arena* arena) const {
+  LockRowRequest* n = new LockRowRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void LockRowRequest::Clear() {
+#define ZR_HELPER_(f) reinterpret_cast<char*>(\
+  &reinterpret_cast<LockRowRequest*>(16)->f)
+
+#define ZR_(first, last) do {\
+  ::memset(&first, 0,\
+           ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
+} while (0)
+
+  if (_has_bits_[0 / 32] & 47u) {
+    ZR_(table_id_, index_id_);
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_primary_key()) {
+      primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    ttl_seconds_ = 0;
+  }
+
+#undef ZR_HELPER_
+#undef ZR_
+
+  column_ids_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool LockRowRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.LockRowRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(16)) goto parse_table_id;
+        break;
+      }
+
+      // optional uint32 table_id = 2;
+      case 2: {
+        if (tag == 16) {
+         parse_table_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &table_id_)));
+          set_has_table_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_index_id;
+        break;
+      }
+
+      // optional uint32 index_id = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_index_id:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, &index_id_)));
+          set_has_index_id();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(34)) goto parse_primary_key;
+        break;
+      }
+
+      // optional bytes primary_key = 4;
+      case 4: {
+        if (tag == 34) {
+         parse_primary_key:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_primary_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_column_ids;
+        break;
+      }
+
+      // repeated uint32 column_ids = 5;
+      case 5: {
+        if (tag == 40) {
+         parse_column_ids:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadRepeatedPrimitive<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 1, 40, input, this->mutable_column_ids())));
+        } else if (tag == 42) {
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPackedPrimitiveNoInline<
+                   ::google::protobuf::uint32, ::google::protobuf::internal::WireFormatLite::TYPE_UINT32>(
+                 input, this->mutable_column_ids())));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(40)) goto parse_column_ids;
+        if (input->ExpectTag(48)) goto parse_ttl_seconds;
+        break;
+      }
+
+      // optional int32 ttl_seconds = 6;
+      case 6: {
+        if (tag == 48) {
+         parse_ttl_seconds:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int32, ::google::protobuf::internal::WireFormatLite::TYPE_INT32>(
+                 input, &ttl_seconds_)));
+          set_has_ttl_seconds();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.LockRowRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.LockRowRequest)
+  return false;
+#undef DO_
+}
+
+void LockRowRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.LockRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(2, this->table_id(), output);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(3, this->index_id(), output);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      4, this->primary_key(), output);
+  }
+
+  // repeated uint32 column_ids = 5;
+  for (int i = 0; i < this->column_ids_size(); i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteUInt32(
+      5, this->column_ids(i), output);
+  }
+
+  // optional int32 ttl_seconds = 6;
+  if (has_ttl_seconds()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt32(6, this->ttl_seconds(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.LockRowRequest)
+}
+
+::google::protobuf::uint8* LockRowRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.LockRowRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional uint32 table_id = 2;
+  if (has_table_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(2, this->table_id(), target);
+  }
+
+  // optional uint32 index_id = 3;
+  if (has_index_id()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteUInt32ToArray(3, this->index_id(), target);
+  }
+
+  // optional bytes primary_key = 4;
+  if (has_primary_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        4, this->primary_key(), target);
+  }
+
+  // repeated uint32 column_ids = 5;
+  for (int i = 0; i < this->column_ids_size(); i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteUInt32ToArray(5, this->column_ids(i), target);
+  }
+
+  // optional int32 ttl_seconds = 6;
+  if (has_ttl_seconds()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt32ToArray(6, this->ttl_seconds(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.LockRowRequest)
+  return target;
+}
+
+int LockRowRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 47) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional uint32 table_id = 2;
+    if (has_table_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->table_id());
+    }
+
+    // optional uint32 index_id = 3;
+    if (has_index_id()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::UInt32Size(
+          this->index_id());
+    }
+
+    // optional bytes primary_key = 4;
+    if (has_primary_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->primary_key());
+    }
+
+    // optional int32 ttl_seconds = 6;
+    if (has_ttl_seconds()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int32Size(
+          this->ttl_seconds());
+    }
+
+  }
+  // repeated uint32 column_ids = 5;
+  {
+    int data_size = 0;
+    for (int i = 0; i < this->column_ids_size(); i++) {
+      data_size += ::google::protobuf::internal::WireFormatLite::
+        UInt32Size(this->column_ids(i));
+    }
+    total_size += 1 * this->column_ids_size() + data_size;
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void LockRowRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const LockRowRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const LockRowRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void LockRowRequest::MergeFrom(const LockRowRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  column_ids_.MergeFrom(from.column_ids_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_table_id()) {
+      set_table_id(from.table_id());
+    }
+    if (from.has_index_id()) {
+      set_index_id(from.index_id());
+    }
+    if (from.has_primary_key()) {
+      set_has_primary_key();
+      primary_key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.primary_key_);
+    }
+    if (from.has_ttl_seconds()) {
+      set_ttl_seconds(from.ttl_seconds());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void LockRowRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void LockRowRequest::CopyFrom(const LockRowRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool LockRowRequest::IsInitialized() const {
+
+  return true;
+}
+
+void LockRowRequest::Swap(LockRowRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void LockRowRequest::InternalSwap(LockRowRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(table_id_, other->table_id_);
+  std::swap(index_id_, other->index_id_);
+  primary_key_.Swap(&other->primary_key_);
+  column_ids_.UnsafeArenaSwap(&other->column_ids_);
+  std::swap(ttl_seconds_, other->ttl_seconds_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata LockRowRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = LockRowRequest_descriptor_;
+  metadata.reflection = LockRowRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// LockRowRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool LockRowRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void LockRowRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void LockRowRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void LockRowRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& LockRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* LockRowRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* LockRowRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void LockRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowRequest.header)
+}
+
+// optional uint32 table_id = 2;
+bool LockRowRequest::has_table_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void LockRowRequest::set_has_table_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void LockRowRequest::clear_has_table_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void LockRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
+}
+ ::google::protobuf::uint32 LockRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.table_id)
+  return table_id_;
+}
+ void LockRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+bool LockRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void LockRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void LockRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void LockRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+ ::google::protobuf::uint32 LockRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.index_id)
+  return index_id_;
+}
+ void LockRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+bool LockRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void LockRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void LockRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void LockRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+ const ::std::string& LockRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void LockRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.primary_key)
+}
+ void LockRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.LockRowRequest.primary_key)
+}
+ void LockRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.LockRowRequest.primary_key)
+}
+ ::std::string* LockRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* LockRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void LockRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowRequest.primary_key)
+}
+
+// repeated uint32 column_ids = 5;
+int LockRowRequest::column_ids_size() const {
+  return column_ids_.size();
+}
+void LockRowRequest::clear_column_ids() {
+  column_ids_.Clear();
+}
+ ::google::protobuf::uint32 LockRowRequest::column_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.column_ids)
+  return column_ids_.Get(index);
+}
+ void LockRowRequest::set_column_ids(int index, ::google::protobuf::uint32 value) {
+  column_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.column_ids)
+}
+ void LockRowRequest::add_column_ids(::google::protobuf::uint32 value) {
+  column_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.LockRowRequest.column_ids)
+}
+ const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+LockRowRequest::column_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.LockRowRequest.column_ids)
+  return column_ids_;
+}
+ ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+LockRowRequest::mutable_column_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.LockRowRequest.column_ids)
+  return &column_ids_;
+}
+
+// optional int32 ttl_seconds = 6;
+bool LockRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+void LockRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000020u;
+}
+void LockRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+void LockRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+ ::google::protobuf::int32 LockRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+ void LockRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.ttl_seconds)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int LockRowResponse::kHeaderFieldNumber;
+const int LockRowResponse::kRowFieldNumber;
+#endif  // !_MSC_VER
+
+LockRowResponse::LockRowResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.LockRowResponse)
+}
+
+void LockRowResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+  row_ = const_cast< ::cockroach::proto::Row*>(&::cockroach::proto::Row::default_instance());
+}
+
+LockRowResponse::LockRowResponse(const LockRowResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.LockRowResponse)
+}
+
+void LockRowResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  row_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+LockRowResponse::~LockRowResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.LockRowResponse)
+  SharedDtor();
+}
+
+void LockRowResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete row_;
+  }
+}
+
+void LockRowResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* LockRowResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return LockRowResponse_descriptor_;
+}
+
+const LockRowResponse& LockRowResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+LockRowResponse* LockRowResponse::default_instance_ = NULL;
+
+LockRowResponse* LockRowResponse::New(::google::protobuf::Arena* arena) const {
+  LockRowResponse* n = new LockRowResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void LockRowResponse::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+    }
+    if (has_row()) {
+      if (row_ != NULL) row_->::cockroach::proto::Row::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool LockRowResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.LockRowResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_row;
+        break;
+      }
+
+      // optional .cockroach.proto.Row row = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.LockRowResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.LockRowResponse)
+  return false;
+#undef DO_
+}
+
+void LockRowResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.LockRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Row row = 2;
+  if (has_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->row_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.LockRowResponse)
+}
+
+::google::protobuf::uint8* LockRowResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.LockRowResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Row row = 2;
+  if (has_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->row_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.LockRowResponse)
+  return target;
+}
+
+int LockRowResponse::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.ResponseHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Row row = 2;
+    if (has_row()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->row_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void LockRowResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const LockRowResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const LockRowResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void LockRowResponse::MergeFrom(const LockRowResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+    if (from.has_row()) {
+      mutable_row()->::cockroach::proto::Row::MergeFrom(from.row());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void LockRowResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void LockRowResponse::CopyFrom(const LockRowResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool LockRowResponse::IsInitialized() const {
+
+  return true;
+}
+
+void LockRowResponse::Swap(LockRowResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void LockRowResponse::InternalSwap(LockRowResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(row_, other->row_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata LockRowResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = LockRowResponse_descriptor_;
+  metadata.reflection = LockRowResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// LockRowResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool LockRowResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void LockRowResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void LockRowResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void LockRowResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& LockRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* LockRowResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* LockRowResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void LockRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowResponse.header)
+}
+
+// optional .cockroach.proto.Row row = 2;
+bool LockRowResponse::has_row() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void LockRowResponse::set_has_row() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void LockRowResponse::clear_has_row() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void LockRowResponse::clear_row() {
+  if (row_ != NULL) row_->::cockroach::proto::Row::Clear();
+  clear_has_row();
+}
+ const ::cockroach::proto::Row& LockRowResponse::row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowResponse.row)
+  return row_ != NULL ? *row_ : *default_instance_->row_;
+}
+ ::cockroach::proto::Row* LockRowResponse::mutable_row() {
+  set_has_row();
+  if (row_ == NULL) {
+    row_ = new ::cockroach::proto::Row;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowResponse.row)
+  return row_;
+}
+ ::cockroach::proto::Row* LockRowResponse::release_row() {
+  clear_has_row();
+  ::cockroach::proto::Row* temp = row_;
+  row_ = NULL;
+  return temp;
+}
+ void LockRowResponse::set_allocated_row(::cockroach::proto::Row* row) {
+  delete row_;
+  row_ = row;
+  if (row) {
+    set_has_row();
+  } else {
+    clear_has_row();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowResponse.row)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int PutUniqueRequest::kHeaderFieldNumber;
+const int PutUniqueRequest::kValueFieldNumber;
+#endif  // !_MSC_VER
+
+PutUniqueRequest::PutUniqueRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.PutUniqueRequest)
+}
+
+void PutUniqueRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
+}
+
+PutUniqueRequest::PutUniqueRequest(const PutUniqueRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.PutUniqueRequest)
+}
+
+void PutUniqueRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  value_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+PutUniqueRequest::~PutUniqueRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.PutUniqueRequest)
+  SharedDtor();
+}
+
+void PutUniqueRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete value_;
+  }
+}
+
+void PutUniqueRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PutUniqueRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PutUniqueRequest_descriptor_;
+}
+
+const PutUniqueRequest& PutUniqueRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+PutUniqueRequest* PutUniqueRequest::default_instance_ = NULL;
+
+PutUniqueRequest* PutUniqueRequest::New(::google::protobuf::Arena* arena) const {
+  PutUniqueRequest* n = new PutUniqueRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void PutUniqueRequest::Clear() {
+  if (_has_bits_[0 / 32] & 3u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+    }
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool PutUniqueRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.PutUniqueRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.proto.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.PutUniqueRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.PutUniqueRequest)
+  return false;
+#undef DO_
+}
+
+void PutUniqueRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.PutUniqueRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->value_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.PutUniqueRequest)
+}
+
+::google::protobuf::uint8* PutUniqueRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.PutUniqueRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->value_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.PutUniqueRequest)
+  return target;
+}
+
+int PutUniqueRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 3) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->value_);
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PutUniqueRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const PutUniqueRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const PutUniqueRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PutUniqueRequest::MergeFrom(const PutUniqueRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void PutUniqueRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PutUniqueRequest::CopyFrom(const PutUniqueRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PutUniqueRequest::IsInitialized() const {
+
+  return true;
+}
+
+void PutUniqueRequest::Swap(PutUniqueRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void PutUniqueRequest::InternalSwap(PutUniqueRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(value_, other->value_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata PutUniqueRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PutUniqueRequest_descriptor_;
+  metadata.reflection = PutUniqueRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// PutUniqueRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool PutUniqueRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void PutUniqueRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void PutUniqueRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void PutUniqueRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& PutUniqueRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutUniqueRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* PutUniqueRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutUniqueRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* PutUniqueRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void PutUniqueRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutUniqueRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+bool PutUniqueRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void PutUniqueRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void PutUniqueRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void PutUniqueRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+ const ::cockroach::proto::Value& PutUniqueRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutUniqueRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+ ::cockroach::proto::Value* PutUniqueRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutUniqueRequest.value)
+  return value_;
+}
+ ::cockroach::proto::Value* PutUniqueRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+ void PutUniqueRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutUniqueRequest.value)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int PutUniqueResponse::kHeaderFieldNumber;
+#endif  // !_MSC_VER
+
+PutUniqueResponse::PutUniqueResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.PutUniqueResponse)
+}
+
+void PutUniqueResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+PutUniqueResponse::PutUniqueResponse(const PutUniqueResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.PutUniqueResponse)
+}
+
+void PutUniqueResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+PutUniqueResponse::~PutUniqueResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.PutUniqueResponse)
+  SharedDtor();
+}
+
+void PutUniqueResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void PutUniqueResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* PutUniqueResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return PutUniqueResponse_descriptor_;
+}
+
+const PutUniqueResponse& PutUniqueResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+PutUniqueResponse* PutUniqueResponse::default_instance_ = NULL;
+
+PutUniqueResponse* PutUniqueResponse::New(::google::protobuf::Arena* arena) const {
+  PutUniqueResponse* n = new PutUniqueResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void PutUniqueResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool PutUniqueResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.PutUniqueResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.PutUniqueResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.PutUniqueResponse)
+  return false;
+#undef DO_
+}
+
+void PutUniqueResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.PutUniqueResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.PutUniqueResponse)
+}
+
+::google::protobuf::uint8* PutUniqueResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.PutUniqueResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.PutUniqueResponse)
+  return target;
+}
+
+int PutUniqueResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void PutUniqueResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const PutUniqueResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const PutUniqueResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void PutUniqueResponse::MergeFrom(const PutUniqueResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void PutUniqueResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void PutUniqueResponse::CopyFrom(const PutUniqueResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool PutUniqueResponse::IsInitialized() const {
+
+  return true;
+}
+
+void PutUniqueResponse::Swap(PutUniqueResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void PutUniqueResponse::InternalSwap(PutUniqueResponse* other) {
+  std::swap(header_, other->header_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata PutUniqueResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = PutUniqueResponse_descriptor_;
+  metadata.reflection = PutUniqueResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// PutUniqueResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool PutUniqueResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void PutUniqueResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void PutUniqueResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void PutUniqueResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& PutUniqueResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutUniqueResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* PutUniqueResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutUniqueResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* PutUniqueResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void PutUniqueResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutUniqueResponse.header)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanChangesRequest::kHeaderFieldNumber;
+const int ScanChangesRequest::kStartTimestampFieldNumber;
+const int ScanChangesRequest::kMaxResultsFieldNumber;
+#endif  // !_MSC_VER
+
+ScanChangesRequest::ScanChangesRequest()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ScanChangesRequest)
+}
+
+void ScanChangesRequest::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::RequestHeader*>(&::cockroach::proto::RequestHeader::default_instance());
+  start_timestamp_ = const_cast< ::cockroach::proto::Timestamp*>(&::cockroach::proto::Timestamp::default_instance());
+}
+
+ScanChangesRequest::ScanChangesRequest(const ScanChangesRequest& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanChangesRequest)
+}
+
+void ScanChangesRequest::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  start_timestamp_ = NULL;
+  max_results_ = GOOGLE_LONGLONG(0);
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanChangesRequest::~ScanChangesRequest() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ScanChangesRequest)
+  SharedDtor();
+}
+
+void ScanChangesRequest::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+    delete start_timestamp_;
+  }
+}
+
+void ScanChangesRequest::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanChangesRequest::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanChangesRequest_descriptor_;
+}
+
+const ScanChangesRequest& ScanChangesRequest::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ScanChangesRequest* ScanChangesRequest::default_instance_ = NULL;
+
+ScanChangesRequest* ScanChangesRequest::New(::google::protobuf::Arena* arena) const {
+  ScanChangesRequest* n = new ScanChangesRequest;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ScanChangesRequest::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_header()) {
+      if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+    }
+    if (has_start_timestamp()) {
+      if (start_timestamp_ != NULL) start_timestamp_->::cockroach::proto::Timestamp::Clear();
+    }
+    max_results_ = GOOGLE_LONGLONG(0);
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ScanChangesRequest::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanChangesRequest)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.RequestHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_start_timestamp;
+        break;
+      }
+
+      // optional .cockroach.proto.Timestamp start_timestamp = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_start_timestamp:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_start_timestamp()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_max_results;
+        break;
+      }
+
+      // optional int64 max_results = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_max_results:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   ::google::protobuf::int64, ::google::protobuf::internal::WireFormatLite::TYPE_INT64>(
+                 input, &max_results_)));
+          set_has_max_results();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanChangesRequest)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanChangesRequest)
+  return false;
+#undef DO_
+}
+
+void ScanChangesRequest::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanChangesRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // optional .cockroach.proto.Timestamp start_timestamp = 2;
+  if (has_start_timestamp()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->start_timestamp_, output);
+  }
+
+  // optional int64 max_results = 3;
+  if (has_max_results()) {
+    ::google::protobuf::internal::WireFormatLite::WriteInt64(3, this->max_results(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanChangesRequest)
+}
+
+::google::protobuf::uint8* ScanChangesRequest::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanChangesRequest)
+  // optional .cockroach.proto.RequestHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // optional .cockroach.proto.Timestamp start_timestamp = 2;
+  if (has_start_timestamp()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->start_timestamp_, target);
+  }
+
+  // optional int64 max_results = 3;
+  if (has_max_results()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteInt64ToArray(3, this->max_results(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanChangesRequest)
+  return target;
+}
+
+int ScanChangesRequest::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional .cockroach.proto.RequestHeader header = 1;
+    if (has_header()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->header_);
+    }
+
+    // optional .cockroach.proto.Timestamp start_timestamp = 2;
+    if (has_start_timestamp()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->start_timestamp_);
+    }
+
+    // optional int64 max_results = 3;
+    if (has_max_results()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::Int64Size(
+          this->max_results());
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanChangesRequest::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ScanChangesRequest* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ScanChangesRequest>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanChangesRequest::MergeFrom(const ScanChangesRequest& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::RequestHeader::MergeFrom(from.header());
+    }
+    if (from.has_start_timestamp()) {
+      mutable_start_timestamp()->::cockroach::proto::Timestamp::MergeFrom(from.start_timestamp());
+    }
+    if (from.has_max_results()) {
+      set_max_results(from.max_results());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ScanChangesRequest::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanChangesRequest::CopyFrom(const ScanChangesRequest& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanChangesRequest::IsInitialized() const {
+
+  return true;
+}
+
+void ScanChangesRequest::Swap(ScanChangesRequest* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ScanChangesRequest::InternalSwap(ScanChangesRequest* other) {
+  std::swap(header_, other->header_);
+  std::swap(start_timestamp_, other->start_timestamp_);
+  std::swap(max_results_, other->max_results_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ScanChangesRequest::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanChangesRequest_descriptor_;
+  metadata.reflection = ScanChangesRequest_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ScanChangesRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+bool ScanChangesRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ScanChangesRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ScanChangesRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ScanChangesRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::RequestHeader& ScanChangesRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::RequestHeader* ScanChangesRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesRequest.header)
+  return header_;
+}
+ ::cockroach::proto::RequestHeader* ScanChangesRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ScanChangesRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanChangesRequest.header)
+}
+
+// optional .cockroach.proto.Timestamp start_timestamp = 2;
+bool ScanChangesRequest::has_start_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void ScanChangesRequest::set_has_start_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void ScanChangesRequest::clear_has_start_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void ScanChangesRequest::clear_start_timestamp() {
+  if (start_timestamp_ != NULL) start_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_start_timestamp();
+}
+ const ::cockroach::proto::Timestamp& ScanChangesRequest::start_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesRequest.start_timestamp)
+  return start_timestamp_ != NULL ? *start_timestamp_ : *default_instance_->start_timestamp_;
+}
+ ::cockroach::proto::Timestamp* ScanChangesRequest::mutable_start_timestamp() {
+  set_has_start_timestamp();
+  if (start_timestamp_ == NULL) {
+    start_timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesRequest.start_timestamp)
+  return start_timestamp_;
+}
+ ::cockroach::proto::Timestamp* ScanChangesRequest::release_start_timestamp() {
+  clear_has_start_timestamp();
+  ::cockroach::proto::Timestamp* temp = start_timestamp_;
+  start_timestamp_ = NULL;
+  return temp;
+}
+ void ScanChangesRequest::set_allocated_start_timestamp(::cockroach::proto::Timestamp* start_timestamp) {
+  delete start_timestamp_;
+  start_timestamp_ = start_timestamp;
+  if (start_timestamp) {
+    set_has_start_timestamp();
+  } else {
+    clear_has_start_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanChangesRequest.start_timestamp)
+}
+
+// optional int64 max_results = 3;
+bool ScanChangesRequest::has_max_results() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void ScanChangesRequest::set_has_max_results() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void ScanChangesRequest::clear_has_max_results() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void ScanChangesRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
+}
+ ::google::protobuf::int64 ScanChangesRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesRequest.max_results)
+  return max_results_;
+}
+ void ScanChangesRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanChangesRequest.max_results)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int KeyValueChange::kKeyFieldNumber;
+const int KeyValueChange::kValueFieldNumber;
+const int KeyValueChange::kDeletedFieldNumber;
+#endif  // !_MSC_VER
+
+KeyValueChange::KeyValueChange()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.KeyValueChange)
+}
+
+void KeyValueChange::InitAsDefaultInstance() {
+  value_ = const_cast< ::cockroach::proto::Value*>(&::cockroach::proto::Value::default_instance());
+}
+
+KeyValueChange::KeyValueChange(const KeyValueChange& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.KeyValueChange)
+}
+
+void KeyValueChange::SharedCtor() {
+  ::google::protobuf::internal::GetEmptyString();
+  _cached_size_ = 0;
+  key_.UnsafeSetDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  value_ = NULL;
+  deleted_ = false;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+KeyValueChange::~KeyValueChange() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.KeyValueChange)
+  SharedDtor();
+}
+
+void KeyValueChange::SharedDtor() {
+  key_.DestroyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  if (this != default_instance_) {
+    delete value_;
+  }
+}
+
+void KeyValueChange::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* KeyValueChange::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return KeyValueChange_descriptor_;
+}
+
+const KeyValueChange& KeyValueChange::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+KeyValueChange* KeyValueChange::default_instance_ = NULL;
+
+KeyValueChange* KeyValueChange::New(::google::protobuf::Arena* arena) const {
+  KeyValueChange* n = new KeyValueChange;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void KeyValueChange::Clear() {
+  if (_has_bits_[0 / 32] & 7u) {
+    if (has_key()) {
+      key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+    }
+    if (has_value()) {
+      if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+    }
+    deleted_ = false;
+  }
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool KeyValueChange::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.KeyValueChange)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional bytes key = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadBytes(
+                input, this->mutable_key()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_value;
+        break;
+      }
+
+      // optional .cockroach.proto.Value value = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_value:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_value()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(24)) goto parse_deleted;
+        break;
+      }
+
+      // optional bool deleted = 3;
+      case 3: {
+        if (tag == 24) {
+         parse_deleted:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &deleted_)));
+          set_has_deleted();
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.KeyValueChange)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.KeyValueChange)
+  return false;
+#undef DO_
+}
+
+void KeyValueChange::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.KeyValueChange)
+  // optional bytes key = 1;
+  if (has_key()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBytesMaybeAliased(
+      1, this->key(), output);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, *this->value_, output);
+  }
+
+  // optional bool deleted = 3;
+  if (has_deleted()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->deleted(), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.KeyValueChange)
+}
+
+::google::protobuf::uint8* KeyValueChange::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.KeyValueChange)
+  // optional bytes key = 1;
+  if (has_key()) {
+    target =
+      ::google::protobuf::internal::WireFormatLite::WriteBytesToArray(
+        1, this->key(), target);
+  }
+
+  // optional .cockroach.proto.Value value = 2;
+  if (has_value()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, *this->value_, target);
+  }
+
+  // optional bool deleted = 3;
+  if (has_deleted()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->deleted(), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.KeyValueChange)
+  return target;
+}
+
+int KeyValueChange::ByteSize() const {
+  int total_size = 0;
+
+  if (_has_bits_[0 / 32] & 7) {
+    // optional bytes key = 1;
+    if (has_key()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::BytesSize(
+          this->key());
+    }
+
+    // optional .cockroach.proto.Value value = 2;
+    if (has_value()) {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *this->value_);
+    }
+
+    // optional bool deleted = 3;
+    if (has_deleted()) {
+      total_size += 1 + 1;
+    }
+
+  }
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void KeyValueChange::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const KeyValueChange* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const KeyValueChange>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void KeyValueChange::MergeFrom(const KeyValueChange& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_key()) {
+      set_has_key();
+      key_.AssignWithDefault(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), from.key_);
+    }
+    if (from.has_value()) {
+      mutable_value()->::cockroach::proto::Value::MergeFrom(from.value());
+    }
+    if (from.has_deleted()) {
+      set_deleted(from.deleted());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void KeyValueChange::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void KeyValueChange::CopyFrom(const KeyValueChange& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool KeyValueChange::IsInitialized() const {
+
+  return true;
+}
+
+void KeyValueChange::Swap(KeyValueChange* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void KeyValueChange::InternalSwap(KeyValueChange* other) {
+  key_.Swap(&other->key_);
+  std::swap(value_, other->value_);
+  std::swap(deleted_, other->deleted_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata KeyValueChange::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = KeyValueChange_descriptor_;
+  metadata.reflection = KeyValueChange_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// KeyValueChange
+
+// optional bytes key = 1;
+bool KeyValueChange::has_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void KeyValueChange::set_has_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void KeyValueChange::clear_has_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void KeyValueChange::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
+}
+ const ::std::string& KeyValueChange::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValueChange.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void KeyValueChange::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.KeyValueChange.key)
+}
+ void KeyValueChange::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.KeyValueChange.key)
+}
+ void KeyValueChange::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.KeyValueChange.key)
+}
+ ::std::string* KeyValueChange::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValueChange.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ ::std::string* KeyValueChange::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+ void KeyValueChange::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
+  } else {
+    clear_has_key();
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValueChange.key)
+}
+
+// optional .cockroach.proto.Value value = 2;
+bool KeyValueChange::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+void KeyValueChange::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+void KeyValueChange::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+void KeyValueChange::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+ const ::cockroach::proto::Value& KeyValueChange::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValueChange.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+ ::cockroach::proto::Value* KeyValueChange::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValueChange.value)
+  return value_;
+}
+ ::cockroach::proto::Value* KeyValueChange::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+ void KeyValueChange::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValueChange.value)
+}
+
+// optional bool deleted = 3;
+bool KeyValueChange::has_deleted() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+void KeyValueChange::set_has_deleted() {
+  _has_bits_[0] |= 0x00000004u;
+}
+void KeyValueChange::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+void KeyValueChange::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+ bool KeyValueChange::deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValueChange.deleted)
+  return deleted_;
+}
+ void KeyValueChange::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.KeyValueChange.deleted)
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int ScanChangesResponse::kHeaderFieldNumber;
+const int ScanChangesResponse::kChangesFieldNumber;
+#endif  // !_MSC_VER
+
+ScanChangesResponse::ScanChangesResponse()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.ScanChangesResponse)
+}
+
+void ScanChangesResponse::InitAsDefaultInstance() {
+  header_ = const_cast< ::cockroach::proto::ResponseHeader*>(&::cockroach::proto::ResponseHeader::default_instance());
+}
+
+ScanChangesResponse::ScanChangesResponse(const ScanChangesResponse& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.ScanChangesResponse)
+}
+
+void ScanChangesResponse::SharedCtor() {
+  _cached_size_ = 0;
+  header_ = NULL;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+}
+
+ScanChangesResponse::~ScanChangesResponse() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.ScanChangesResponse)
+  SharedDtor();
+}
+
+void ScanChangesResponse::SharedDtor() {
+  if (this != default_instance_) {
+    delete header_;
+  }
+}
+
+void ScanChangesResponse::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* ScanChangesResponse::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return ScanChangesResponse_descriptor_;
+}
+
+const ScanChangesResponse& ScanChangesResponse::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+ScanChangesResponse* ScanChangesResponse::default_instance_ = NULL;
+
+ScanChangesResponse* ScanChangesResponse::New(::google::protobuf::Arena* arena) const {
+  ScanChangesResponse* n = new ScanChangesResponse;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void ScanChangesResponse::Clear() {
+  if (has_header()) {
+    if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  }
+  changes_.Clear();
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  if (_internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->Clear();
+  }
+}
+
+bool ScanChangesResponse::MergePartialFromCodedStream(
+    ::google::protobuf::io::CodedInputStream* input) {
+#define DO_(EXPRESSION) if (!(EXPRESSION)) goto failure
+  ::google::protobuf::uint32 tag;
+  // @@protoc_insertion_point(parse_start:cockroach.proto.ScanChangesResponse)
+  for (;;) {
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    tag = p.first;
+    if (!p.second) goto handle_unusual;
+    switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
+      // optional .cockroach.proto.ResponseHeader header = 1;
+      case 1: {
+        if (tag == 10) {
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_header()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_changes;
+        break;
+      }
+
+      // repeated .cockroach.proto.KeyValueChange changes = 2;
+      case 2: {
+        if (tag == 18) {
+         parse_changes:
+          DO_(input->IncrementRecursionDepth());
+         parse_loop_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtualNoRecursionDepth(
+                input, add_changes()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(18)) goto parse_loop_changes;
+        input->UnsafeDecrementRecursionDepth();
+        if (input->ExpectAtEnd()) goto success;
+        break;
+      }
+
+      default: {
+      handle_unusual:
+        if (tag == 0 ||
+            ::google::protobuf::internal::WireFormatLite::GetTagWireType(tag) ==
+            ::google::protobuf::internal::WireFormatLite::WIRETYPE_END_GROUP) {
+          goto success;
+        }
+        DO_(::google::protobuf::internal::WireFormat::SkipField(
+              input, tag, mutable_unknown_fields()));
+        break;
+      }
+    }
+  }
+success:
+  // @@protoc_insertion_point(parse_success:cockroach.proto.ScanChangesResponse)
+  return true;
+failure:
+  // @@protoc_insertion_point(parse_failure:cockroach.proto.ScanChangesResponse)
+  return false;
+#undef DO_
+}
+
+void ScanChangesResponse::SerializeWithCachedSizes(
+    ::google::protobuf::io::CodedOutputStream* output) const {
+  // @@protoc_insertion_point(serialize_start:cockroach.proto.ScanChangesResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      1, *this->header_, output);
+  }
+
+  // repeated .cockroach.proto.KeyValueChange changes = 2;
+  for (unsigned int i = 0, n = this->changes_size(); i < n; i++) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      2, this->changes(i), output);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
+        unknown_fields(), output);
+  }
+  // @@protoc_insertion_point(serialize_end:cockroach.proto.ScanChangesResponse)
+}
+
+::google::protobuf::uint8* ScanChangesResponse::SerializeWithCachedSizesToArray(
+    ::google::protobuf::uint8* target) const {
+  // @@protoc_insertion_point(serialize_to_array_start:cockroach.proto.ScanChangesResponse)
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        1, *this->header_, target);
+  }
+
+  // repeated .cockroach.proto.KeyValueChange changes = 2;
+  for (unsigned int i = 0, n = this->changes_size(); i < n; i++) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        2, this->changes(i), target);
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
+        unknown_fields(), target);
+  }
+  // @@protoc_insertion_point(serialize_to_array_end:cockroach.proto.ScanChangesResponse)
+  return target;
+}
+
+int ScanChangesResponse::ByteSize() const {
+  int total_size = 0;
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  if (has_header()) {
+    total_size += 1 +
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        *this->header_);
+  }
+
+  // repeated .cockroach.proto.KeyValueChange changes = 2;
+  total_size += 1 * this->changes_size();
+  for (int i = 0; i < this->changes_size(); i++) {
+    total_size +=
+      ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+        this->changes(i));
+  }
+
+  if (_internal_metadata_.have_unknown_fields()) {
+    total_size +=
+      ::google::protobuf::internal::WireFormat::ComputeUnknownFieldsSize(
+        unknown_fields());
+  }
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = total_size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+  return total_size;
+}
+
+void ScanChangesResponse::MergeFrom(const ::google::protobuf::Message& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  const ScanChangesResponse* source = 
+      ::google::protobuf::internal::DynamicCastToGenerated<const ScanChangesResponse>(
+          &from);
+  if (source == NULL) {
+    ::google::protobuf::internal::ReflectionOps::Merge(from, this);
+  } else {
+    MergeFrom(*source);
+  }
+}
+
+void ScanChangesResponse::MergeFrom(const ScanChangesResponse& from) {
+  if (GOOGLE_PREDICT_FALSE(&from == this)) MergeFromFail(__LINE__);
+  changes_.MergeFrom(from.changes_);
+  if (from._has_bits_[0 / 32] & (0xffu << (0 % 32))) {
+    if (from.has_header()) {
+      mutable_header()->::cockroach::proto::ResponseHeader::MergeFrom(from.header());
+    }
+  }
+  if (from._internal_metadata_.have_unknown_fields()) {
+    mutable_unknown_fields()->MergeFrom(from.unknown_fields());
+  }
+}
+
+void ScanChangesResponse::CopyFrom(const ::google::protobuf::Message& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+void ScanChangesResponse::CopyFrom(const ScanChangesResponse& from) {
+  if (&from == this) return;
+  Clear();
+  MergeFrom(from);
+}
+
+bool ScanChangesResponse::IsInitialized() const {
+
+  return true;
+}
+
+void ScanChangesResponse::Swap(ScanChangesResponse* other) {
+  if (other == this) return;
+  InternalSwap(other);
+}
+void ScanChangesResponse::InternalSwap(ScanChangesResponse* other) {
+  std::swap(header_, other->header_);
+  changes_.UnsafeArenaSwap(&other->changes_);
+  std::swap(_has_bits_[0], other->_has_bits_[0]);
+  _internal_metadata_.Swap(&other->_internal_metadata_);
+  std::swap(_cached_size_, other->_cached_size_);
+}
+
+::google::protobuf::Metadata ScanChangesResponse::GetMetadata() const {
+  protobuf_AssignDescriptorsOnce();
+  ::google::protobuf::Metadata metadata;
+  metadata.descriptor = ScanChangesResponse_descriptor_;
+  metadata.reflection = ScanChangesResponse_reflection_;
+  return metadata;
+}
+
+#if PROTOBUF_INLINE_NOT_IN_HEADERS
+// ScanChangesResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+bool ScanChangesResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+void ScanChangesResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+void ScanChangesResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+void ScanChangesResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+ const ::cockroach::proto::ResponseHeader& ScanChangesResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+ ::cockroach::proto::ResponseHeader* ScanChangesResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesResponse.header)
+  return header_;
+}
+ ::cockroach::proto::ResponseHeader* ScanChangesResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+ void ScanChangesResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanChangesResponse.header)
+}
+
+// repeated .cockroach.proto.KeyValueChange changes = 2;
+int ScanChangesResponse::changes_size() const {
+  return changes_.size();
+}
+void ScanChangesResponse::clear_changes() {
+  changes_.Clear();
+}
+ const ::cockroach::proto::KeyValueChange& ScanChangesResponse::changes(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesResponse.changes)
+  return changes_.Get(index);
+}
+ ::cockroach::proto::KeyValueChange* ScanChangesResponse::mutable_changes(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesResponse.changes)
+  return changes_.Mutable(index);
+}
+ ::cockroach::proto::KeyValueChange* ScanChangesResponse::add_changes() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanChangesResponse.changes)
+  return changes_.Add();
+}
+ const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange >&
+ScanChangesResponse::changes() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanChangesResponse.changes)
+  return changes_;
+}
+ ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange >*
+ScanChangesResponse::mutable_changes() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanChangesResponse.changes)
+  return &changes_;
+}
+
+#endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
+
+// ===================================================================
+
+#ifndef _MSC_VER
+const int RequestUnion::kGetFieldNumber;
+const int RequestUnion::kPutFieldNumber;
+const int RequestUnion::kConditionalPutFieldNumber;
+const int RequestUnion::kIncrementFieldNumber;
+const int RequestUnion::kDeleteFieldNumber;
+const int RequestUnion::kDeleteRangeFieldNumber;
+const int RequestUnion::kScanFieldNumber;
+const int RequestUnion::kEndTransactionFieldNumber;
+const int RequestUnion::kGetRowFieldNumber;
+const int RequestUnion::kPutRowFieldNumber;
+const int RequestUnion::kDeleteRowFieldNumber;
+const int RequestUnion::kScanRowsFieldNumber;
+const int RequestUnion::kLockRowFieldNumber;
+const int RequestUnion::kPutUniqueFieldNumber;
+const int RequestUnion::kScanChangesFieldNumber;
+#endif  // !_MSC_VER
+
+RequestUnion::RequestUnion()
+  : ::google::protobuf::Message(), _internal_metadata_(NULL) {
+  SharedCtor();
+  // @@protoc_insertion_point(constructor:cockroach.proto.RequestUnion)
+}
+
+void RequestUnion::InitAsDefaultInstance() {
+  RequestUnion_default_oneof_instance_->get_ = const_cast< ::cockroach::proto::GetRequest*>(&::cockroach::proto::GetRequest::default_instance());
+  RequestUnion_default_oneof_instance_->put_ = const_cast< ::cockroach::proto::PutRequest*>(&::cockroach::proto::PutRequest::default_instance());
+  RequestUnion_default_oneof_instance_->conditional_put_ = const_cast< ::cockroach::proto::ConditionalPutRequest*>(&::cockroach::proto::ConditionalPutRequest::default_instance());
+  RequestUnion_default_oneof_instance_->increment_ = const_cast< ::cockroach::proto::IncrementRequest*>(&::cockroach::proto::IncrementRequest::default_instance());
+  RequestUnion_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteRequest*>(&::cockroach::proto::DeleteRequest::default_instance());
+  RequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
+  RequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
+  RequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  RequestUnion_default_oneof_instance_->get_row_ = const_cast< ::cockroach::proto::GetRowRequest*>(&::cockroach::proto::GetRowRequest::default_instance());
+  RequestUnion_default_oneof_instance_->put_row_ = const_cast< ::cockroach::proto::PutRowRequest*>(&::cockroach::proto::PutRowRequest::default_instance());
+  RequestUnion_default_oneof_instance_->delete_row_ = const_cast< ::cockroach::proto::DeleteRowRequest*>(&::cockroach::proto::DeleteRowRequest::default_instance());
+  RequestUnion_default_oneof_instance_->scan_rows_ = const_cast< ::cockroach::proto::ScanRowsRequest*>(&::cockroach::proto::ScanRowsRequest::default_instance());
+  RequestUnion_default_oneof_instance_->lock_row_ = const_cast< ::cockroach::proto::LockRowRequest*>(&::cockroach::proto::LockRowRequest::default_instance());
+  RequestUnion_default_oneof_instance_->put_unique_ = const_cast< ::cockroach::proto::PutUniqueRequest*>(&::cockroach::proto::PutUniqueRequest::default_instance());
+  RequestUnion_default_oneof_instance_->scan_changes_ = const_cast< ::cockroach::proto::ScanChangesRequest*>(&::cockroach::proto::ScanChangesRequest::default_instance());
+}
+
+RequestUnion::RequestUnion(const RequestUnion& from)
+  : ::google::protobuf::Message(),
+    _internal_metadata_(NULL) {
+  SharedCtor();
+  MergeFrom(from);
+  // @@protoc_insertion_point(copy_constructor:cockroach.proto.RequestUnion)
+}
+
+void RequestUnion::SharedCtor() {
+  _cached_size_ = 0;
+  ::memset(_has_bits_, 0, sizeof(_has_bits_));
+  clear_has_value();
+}
+
+RequestUnion::~RequestUnion() {
+  // @@protoc_insertion_point(destructor:cockroach.proto.RequestUnion)
+  SharedDtor();
+}
+
+void RequestUnion::SharedDtor() {
+  if (has_value()) {
+    clear_value();
+  }
+  if (this != default_instance_) {
+  }
+}
+
+void RequestUnion::SetCachedSize(int size) const {
+  GOOGLE_SAFE_CONCURRENT_WRITES_BEGIN();
+  _cached_size_ = size;
+  GOOGLE_SAFE_CONCURRENT_WRITES_END();
+}
+const ::google::protobuf::Descriptor* RequestUnion::descriptor() {
+  protobuf_AssignDescriptorsOnce();
+  return RequestUnion_descriptor_;
+}
+
+const RequestUnion& RequestUnion::default_instance() {
+  if (default_instance_ == NULL) protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  return *default_instance_;
+}
+
+RequestUnion* RequestUnion::default_instance_ = NULL;
+
+RequestUnion* RequestUnion::New(::google::protobuf::Arena* arena) const {
+  RequestUnion* n = new RequestUnion;
+  if (arena != NULL) {
+    arena->Own(n);
+  }
+  return n;
+}
+
+void RequestUnion::clear_value() {
+  switch(value_case()) {
+    case kGet: {
+      delete value_.get_;
+      break;
+    }
+    case kPut: {
+      delete value_.put_;
       break;
     }
     case kConditionalPut: {
@@ -8499,6 +18513,34 @@ void RequestUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kGetRow: {
+      delete value_.get_row_;
+      break;
+    }
+    case kPutRow: {
+      delete value_.put_row_;
+      break;
+    }
+    case kDeleteRow: {
+      delete value_.delete_row_;
+      break;
+    }
+    case kScanRows: {
+      delete value_.scan_rows_;
+      break;
+    }
+    case kLockRow: {
+      delete value_.lock_row_;
+      break;
+    }
+    case kPutUnique: {
+      delete value_.put_unique_;
+      break;
+    }
+    case kScanChanges: {
+      delete value_.scan_changes_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -8521,7 +18563,7 @@ bool RequestUnion::MergePartialFromCodedStream(
   ::google::protobuf::uint32 tag;
   // @@protoc_insertion_point(parse_start:cockroach.proto.RequestUnion)
   for (;;) {
-    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
@@ -8624,6 +18666,97 @@ bool RequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(82)) goto parse_get_row;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRowRequest get_row = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_get_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_put_row;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRowRequest put_row = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_put_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_delete_row;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_delete_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_scan_rows;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_scan_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_lock_row;
+        break;
+      }
+
+      // optional .cockroach.proto.LockRowRequest lock_row = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_lock_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_lock_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_put_unique;
+        break;
+      }
+
+      // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_put_unique:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_unique()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(130)) goto parse_scan_changes;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_scan_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan_changes()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -8701,6 +18834,48 @@ void RequestUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  if (has_get_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.get_row_, output);
+  }
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  if (has_put_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.put_row_, output);
+  }
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  if (has_delete_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.delete_row_, output);
+  }
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  if (has_scan_rows()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.scan_rows_, output);
+  }
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  if (has_lock_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.lock_row_, output);
+  }
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  if (has_put_unique()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, *value_.put_unique_, output);
+  }
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  if (has_scan_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, *value_.scan_changes_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -8767,6 +18942,55 @@ void RequestUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  if (has_get_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.get_row_, target);
+  }
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  if (has_put_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.put_row_, target);
+  }
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  if (has_delete_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.delete_row_, target);
+  }
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  if (has_scan_rows()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.scan_rows_, target);
+  }
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  if (has_lock_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.lock_row_, target);
+  }
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  if (has_put_unique()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, *value_.put_unique_, target);
+  }
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  if (has_scan_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, *value_.scan_changes_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -8804,35 +19028,84 @@ int RequestUnion::ByteSize() const {
     case kIncrement: {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *value_.increment_);
+          *value_.increment_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRequest delete = 6;
+    case kDelete: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete__);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+    case kDeleteRange: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_range_);
+      break;
+    }
+    // optional .cockroach.proto.ScanRequest scan = 8;
+    case kScan: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_);
+      break;
+    }
+    // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+    case kEndTransaction: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.end_transaction_);
+      break;
+    }
+    // optional .cockroach.proto.GetRowRequest get_row = 10;
+    case kGetRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.get_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutRowRequest put_row = 11;
+    case kPutRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_row_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+    case kDeleteRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_row_);
       break;
     }
-    // optional .cockroach.proto.DeleteRequest delete = 6;
-    case kDelete: {
+    // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+    case kScanRows: {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *value_.delete__);
+          *value_.scan_rows_);
       break;
     }
-    // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-    case kDeleteRange: {
+    // optional .cockroach.proto.LockRowRequest lock_row = 14;
+    case kLockRow: {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *value_.delete_range_);
+          *value_.lock_row_);
       break;
     }
-    // optional .cockroach.proto.ScanRequest scan = 8;
-    case kScan: {
+    // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+    case kPutUnique: {
       total_size += 1 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *value_.scan_);
+          *value_.put_unique_);
       break;
     }
-    // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-    case kEndTransaction: {
-      total_size += 1 +
+    // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+    case kScanChanges: {
+      total_size += 2 +
         ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
-          *value_.end_transaction_);
+          *value_.scan_changes_);
       break;
     }
     case VALUE_NOT_SET: {
@@ -8897,6 +19170,34 @@ void RequestUnion::MergeFrom(const RequestUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
       break;
     }
+    case kGetRow: {
+      mutable_get_row()->::cockroach::proto::GetRowRequest::MergeFrom(from.get_row());
+      break;
+    }
+    case kPutRow: {
+      mutable_put_row()->::cockroach::proto::PutRowRequest::MergeFrom(from.put_row());
+      break;
+    }
+    case kDeleteRow: {
+      mutable_delete_row()->::cockroach::proto::DeleteRowRequest::MergeFrom(from.delete_row());
+      break;
+    }
+    case kScanRows: {
+      mutable_scan_rows()->::cockroach::proto::ScanRowsRequest::MergeFrom(from.scan_rows());
+      break;
+    }
+    case kLockRow: {
+      mutable_lock_row()->::cockroach::proto::LockRowRequest::MergeFrom(from.lock_row());
+      break;
+    }
+    case kPutUnique: {
+      mutable_put_unique()->::cockroach::proto::PutUniqueRequest::MergeFrom(from.put_unique());
+      break;
+    }
+    case kScanChanges: {
+      mutable_scan_changes()->::cockroach::proto::ScanChangesRequest::MergeFrom(from.scan_changes());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9314,6 +19615,328 @@ void RequestUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowRequest get_row = 10;
+bool RequestUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+void RequestUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+void RequestUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::GetRowRequest& RequestUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowRequest::default_instance();
+}
+ ::cockroach::proto::GetRowRequest* RequestUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.get_row)
+  return value_.get_row_;
+}
+ ::cockroach::proto::GetRowRequest* RequestUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowRequest* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowRequest put_row = 11;
+bool RequestUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+void RequestUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+void RequestUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutRowRequest& RequestUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowRequest::default_instance();
+}
+ ::cockroach::proto::PutRowRequest* RequestUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.put_row)
+  return value_.put_row_;
+}
+ ::cockroach::proto::PutRowRequest* RequestUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowRequest* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+bool RequestUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+void RequestUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+void RequestUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::DeleteRowRequest& RequestUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowRequest::default_instance();
+}
+ ::cockroach::proto::DeleteRowRequest* RequestUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.delete_row)
+  return value_.delete_row_;
+}
+ ::cockroach::proto::DeleteRowRequest* RequestUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowRequest* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+bool RequestUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+void RequestUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+void RequestUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanRowsRequest& RequestUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsRequest::default_instance();
+}
+ ::cockroach::proto::ScanRowsRequest* RequestUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan_rows)
+  return value_.scan_rows_;
+}
+ ::cockroach::proto::ScanRowsRequest* RequestUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsRequest* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowRequest lock_row = 14;
+bool RequestUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+void RequestUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+void RequestUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::LockRowRequest& RequestUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowRequest::default_instance();
+}
+ ::cockroach::proto::LockRowRequest* RequestUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.lock_row)
+  return value_.lock_row_;
+}
+ ::cockroach::proto::LockRowRequest* RequestUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowRequest* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+bool RequestUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+void RequestUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+void RequestUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutUniqueRequest& RequestUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueRequest::default_instance();
+}
+ ::cockroach::proto::PutUniqueRequest* RequestUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.put_unique)
+  return value_.put_unique_;
+}
+ ::cockroach::proto::PutUniqueRequest* RequestUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueRequest* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+bool RequestUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+void RequestUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+void RequestUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanChangesRequest& RequestUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesRequest::default_instance();
+}
+ ::cockroach::proto::ScanChangesRequest* RequestUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan_changes)
+  return value_.scan_changes_;
+}
+ ::cockroach::proto::ScanChangesRequest* RequestUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesRequest* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void RequestUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan_changes)
+}
+
 bool RequestUnion::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -9336,6 +19959,13 @@ const int ResponseUnion::kDeleteFieldNumber;
 const int ResponseUnion::kDeleteRangeFieldNumber;
 const int ResponseUnion::kScanFieldNumber;
 const int ResponseUnion::kEndTransactionFieldNumber;
+const int ResponseUnion::kGetRowFieldNumber;
+const int ResponseUnion::kPutRowFieldNumber;
+const int ResponseUnion::kDeleteRowFieldNumber;
+const int ResponseUnion::kScanRowsFieldNumber;
+const int ResponseUnion::kLockRowFieldNumber;
+const int ResponseUnion::kPutUniqueFieldNumber;
+const int ResponseUnion::kScanChangesFieldNumber;
 #endif  // !_MSC_VER
 
 ResponseUnion::ResponseUnion()
@@ -9353,6 +19983,13 @@ void ResponseUnion::InitAsDefaultInstance() {
   ResponseUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
   ResponseUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanResponse*>(&::cockroach::proto::ScanResponse::default_instance());
   ResponseUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->get_row_ = const_cast< ::cockroach::proto::GetRowResponse*>(&::cockroach::proto::GetRowResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->put_row_ = const_cast< ::cockroach::proto::PutRowResponse*>(&::cockroach::proto::PutRowResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->delete_row_ = const_cast< ::cockroach::proto::DeleteRowResponse*>(&::cockroach::proto::DeleteRowResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->scan_rows_ = const_cast< ::cockroach::proto::ScanRowsResponse*>(&::cockroach::proto::ScanRowsResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->lock_row_ = const_cast< ::cockroach::proto::LockRowResponse*>(&::cockroach::proto::LockRowResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->put_unique_ = const_cast< ::cockroach::proto::PutUniqueResponse*>(&::cockroach::proto::PutUniqueResponse::default_instance());
+  ResponseUnion_default_oneof_instance_->scan_changes_ = const_cast< ::cockroach::proto::ScanChangesResponse*>(&::cockroach::proto::ScanChangesResponse::default_instance());
 }
 
 ResponseUnion::ResponseUnion(const ResponseUnion& from)
@@ -9441,6 +20078,34 @@ void ResponseUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kGetRow: {
+      delete value_.get_row_;
+      break;
+    }
+    case kPutRow: {
+      delete value_.put_row_;
+      break;
+    }
+    case kDeleteRow: {
+      delete value_.delete_row_;
+      break;
+    }
+    case kScanRows: {
+      delete value_.scan_rows_;
+      break;
+    }
+    case kLockRow: {
+      delete value_.lock_row_;
+      break;
+    }
+    case kPutUnique: {
+      delete value_.put_unique_;
+      break;
+    }
+    case kScanChanges: {
+      delete value_.scan_changes_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9463,7 +20128,7 @@ bool ResponseUnion::MergePartialFromCodedStream(
   ::google::protobuf::uint32 tag;
   // @@protoc_insertion_point(parse_start:cockroach.proto.ResponseUnion)
   for (;;) {
-    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(127);
+    ::std::pair< ::google::protobuf::uint32, bool> p = input->ReadTagWithCutoff(16383);
     tag = p.first;
     if (!p.second) goto handle_unusual;
     switch (::google::protobuf::internal::WireFormatLite::GetTagFieldNumber(tag)) {
@@ -9497,72 +20162,163 @@ bool ResponseUnion::MergePartialFromCodedStream(
         if (tag == 34) {
          parse_conditional_put:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_conditional_put()));
+               input, mutable_conditional_put()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(42)) goto parse_increment;
+        break;
+      }
+
+      // optional .cockroach.proto.IncrementResponse increment = 5;
+      case 5: {
+        if (tag == 42) {
+         parse_increment:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_increment()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteResponse delete = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanResponse scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_get_row;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRowResponse get_row = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_get_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_put_row;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRowResponse put_row = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_put_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_row()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(42)) goto parse_increment;
+        if (input->ExpectTag(98)) goto parse_delete_row;
         break;
       }
 
-      // optional .cockroach.proto.IncrementResponse increment = 5;
-      case 5: {
-        if (tag == 42) {
-         parse_increment:
+      // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_delete_row:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_increment()));
+               input, mutable_delete_row()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(50)) goto parse_delete;
+        if (input->ExpectTag(106)) goto parse_scan_rows;
         break;
       }
 
-      // optional .cockroach.proto.DeleteResponse delete = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_delete:
+      // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_scan_rows:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
+               input, mutable_scan_rows()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(58)) goto parse_delete_range;
+        if (input->ExpectTag(114)) goto parse_lock_row;
         break;
       }
 
-      // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
+      // optional .cockroach.proto.LockRowResponse lock_row = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_lock_row:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
+               input, mutable_lock_row()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_scan;
+        if (input->ExpectTag(122)) goto parse_put_unique;
         break;
       }
 
-      // optional .cockroach.proto.ScanResponse scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
+      // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_put_unique:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
+               input, mutable_put_unique()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
+        if (input->ExpectTag(130)) goto parse_scan_changes;
         break;
       }
 
-      // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
+      // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_scan_changes:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
+               input, mutable_scan_changes()));
         } else {
           goto handle_unusual;
         }
@@ -9643,6 +20399,48 @@ void ResponseUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.GetRowResponse get_row = 10;
+  if (has_get_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.get_row_, output);
+  }
+
+  // optional .cockroach.proto.PutRowResponse put_row = 11;
+  if (has_put_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.put_row_, output);
+  }
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+  if (has_delete_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.delete_row_, output);
+  }
+
+  // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+  if (has_scan_rows()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.scan_rows_, output);
+  }
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 14;
+  if (has_lock_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.lock_row_, output);
+  }
+
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+  if (has_put_unique()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, *value_.put_unique_, output);
+  }
+
+  // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+  if (has_scan_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, *value_.scan_changes_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -9709,6 +20507,55 @@ void ResponseUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.GetRowResponse get_row = 10;
+  if (has_get_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.get_row_, target);
+  }
+
+  // optional .cockroach.proto.PutRowResponse put_row = 11;
+  if (has_put_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.put_row_, target);
+  }
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+  if (has_delete_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.delete_row_, target);
+  }
+
+  // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+  if (has_scan_rows()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.scan_rows_, target);
+  }
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 14;
+  if (has_lock_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.lock_row_, target);
+  }
+
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+  if (has_put_unique()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, *value_.put_unique_, target);
+  }
+
+  // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+  if (has_scan_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, *value_.scan_changes_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -9777,6 +20624,55 @@ int ResponseUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.GetRowResponse get_row = 10;
+    case kGetRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.get_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutRowResponse put_row = 11;
+    case kPutRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_row_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+    case kDeleteRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_row_);
+      break;
+    }
+    // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+    case kScanRows: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_rows_);
+      break;
+    }
+    // optional .cockroach.proto.LockRowResponse lock_row = 14;
+    case kLockRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.lock_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+    case kPutUnique: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_unique_);
+      break;
+    }
+    // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+    case kScanChanges: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_changes_);
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -9839,6 +20735,34 @@ void ResponseUnion::MergeFrom(const ResponseUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
       break;
     }
+    case kGetRow: {
+      mutable_get_row()->::cockroach::proto::GetRowResponse::MergeFrom(from.get_row());
+      break;
+    }
+    case kPutRow: {
+      mutable_put_row()->::cockroach::proto::PutRowResponse::MergeFrom(from.put_row());
+      break;
+    }
+    case kDeleteRow: {
+      mutable_delete_row()->::cockroach::proto::DeleteRowResponse::MergeFrom(from.delete_row());
+      break;
+    }
+    case kScanRows: {
+      mutable_scan_rows()->::cockroach::proto::ScanRowsResponse::MergeFrom(from.scan_rows());
+      break;
+    }
+    case kLockRow: {
+      mutable_lock_row()->::cockroach::proto::LockRowResponse::MergeFrom(from.lock_row());
+      break;
+    }
+    case kPutUnique: {
+      mutable_put_unique()->::cockroach::proto::PutUniqueResponse::MergeFrom(from.put_unique());
+      break;
+    }
+    case kScanChanges: {
+      mutable_scan_changes()->::cockroach::proto::ScanChangesResponse::MergeFrom(from.scan_changes());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -10256,6 +21180,328 @@ void ResponseUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowResponse get_row = 10;
+bool ResponseUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+void ResponseUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+void ResponseUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::GetRowResponse& ResponseUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowResponse::default_instance();
+}
+ ::cockroach::proto::GetRowResponse* ResponseUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.get_row)
+  return value_.get_row_;
+}
+ ::cockroach::proto::GetRowResponse* ResponseUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowResponse* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_get_row(::cockroach::proto::GetRowResponse* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowResponse put_row = 11;
+bool ResponseUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+void ResponseUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+void ResponseUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutRowResponse& ResponseUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowResponse::default_instance();
+}
+ ::cockroach::proto::PutRowResponse* ResponseUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.put_row)
+  return value_.put_row_;
+}
+ ::cockroach::proto::PutRowResponse* ResponseUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowResponse* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+bool ResponseUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+void ResponseUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+void ResponseUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::DeleteRowResponse& ResponseUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowResponse::default_instance();
+}
+ ::cockroach::proto::DeleteRowResponse* ResponseUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.delete_row)
+  return value_.delete_row_;
+}
+ ::cockroach::proto::DeleteRowResponse* ResponseUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowResponse* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+bool ResponseUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+void ResponseUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+void ResponseUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanRowsResponse& ResponseUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsResponse::default_instance();
+}
+ ::cockroach::proto::ScanRowsResponse* ResponseUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.scan_rows)
+  return value_.scan_rows_;
+}
+ ::cockroach::proto::ScanRowsResponse* ResponseUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsResponse* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsResponse* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowResponse lock_row = 14;
+bool ResponseUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+void ResponseUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+void ResponseUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::LockRowResponse& ResponseUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowResponse::default_instance();
+}
+ ::cockroach::proto::LockRowResponse* ResponseUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.lock_row)
+  return value_.lock_row_;
+}
+ ::cockroach::proto::LockRowResponse* ResponseUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowResponse* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+bool ResponseUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+void ResponseUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+void ResponseUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutUniqueResponse& ResponseUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueResponse::default_instance();
+}
+ ::cockroach::proto::PutUniqueResponse* ResponseUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.put_unique)
+  return value_.put_unique_;
+}
+ ::cockroach::proto::PutUniqueResponse* ResponseUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueResponse* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+bool ResponseUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+void ResponseUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+void ResponseUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanChangesResponse& ResponseUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesResponse::default_instance();
+}
+ ::cockroach::proto::ScanChangesResponse* ResponseUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.scan_changes)
+  return value_.scan_changes_;
+}
+ ::cockroach::proto::ScanChangesResponse* ResponseUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesResponse* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ResponseUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesResponse* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.scan_changes)
+}
+
 bool ResponseUnion::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -61,6 +61,26 @@ class ScanRequest;
 class ScanResponse;
 class EndTransactionRequest;
 class EndTransactionResponse;
+class RowCell;
+class Row;
+class GetRowRequest;
+class GetRowResponse;
+class PutRowRequest;
+class PutRowResponse;
+class DeleteRowRequest;
+class DeleteRowResponse;
+class RowFilter;
+class RowAggregate;
+class RowAggregateResult;
+class ScanRowsRequest;
+class ScanRowsResponse;
+class LockRowRequest;
+class LockRowResponse;
+class PutUniqueRequest;
+class PutUniqueResponse;
+class ScanChangesRequest;
+class KeyValueChange;
+class ScanChangesResponse;
 class RequestUnion;
 class ResponseUnion;
 class BatchRequest;
@@ -70,6 +90,72 @@ class AdminSplitResponse;
 class AdminMergeRequest;
 class AdminMergeResponse;
 
+enum RowFilter_Op {
+  RowFilter_Op_EQ = 0,
+  RowFilter_Op_NE = 1,
+  RowFilter_Op_LT = 2,
+  RowFilter_Op_LE = 3,
+  RowFilter_Op_GT = 4,
+  RowFilter_Op_GE = 5,
+  RowFilter_Op_IS_NULL = 6,
+  RowFilter_Op_IS_NOT_NULL = 7
+};
+bool RowFilter_Op_IsValid(int value);
+const RowFilter_Op RowFilter_Op_Op_MIN = RowFilter_Op_EQ;
+const RowFilter_Op RowFilter_Op_Op_MAX = RowFilter_Op_IS_NOT_NULL;
+const int RowFilter_Op_Op_ARRAYSIZE = RowFilter_Op_Op_MAX + 1;
+
+const ::google::protobuf::EnumDescriptor* RowFilter_Op_descriptor();
+inline const ::std::string& RowFilter_Op_Name(RowFilter_Op value) {
+  return ::google::protobuf::internal::NameOfEnum(
+    RowFilter_Op_descriptor(), value);
+}
+inline bool RowFilter_Op_Parse(
+    const ::std::string& name, RowFilter_Op* value) {
+  return ::google::protobuf::internal::ParseNamedEnum<RowFilter_Op>(
+    RowFilter_Op_descriptor(), name, value);
+}
+enum RowFilter_Type {
+  RowFilter_Type_BYTES = 0,
+  RowFilter_Type_FLOAT = 1,
+  RowFilter_Type_INT = 2
+};
+bool RowFilter_Type_IsValid(int value);
+const RowFilter_Type RowFilter_Type_Type_MIN = RowFilter_Type_BYTES;
+const RowFilter_Type RowFilter_Type_Type_MAX = RowFilter_Type_INT;
+const int RowFilter_Type_Type_ARRAYSIZE = RowFilter_Type_Type_MAX + 1;
+
+const ::google::protobuf::EnumDescriptor* RowFilter_Type_descriptor();
+inline const ::std::string& RowFilter_Type_Name(RowFilter_Type value) {
+  return ::google::protobuf::internal::NameOfEnum(
+    RowFilter_Type_descriptor(), value);
+}
+inline bool RowFilter_Type_Parse(
+    const ::std::string& name, RowFilter_Type* value) {
+  return ::google::protobuf::internal::ParseNamedEnum<RowFilter_Type>(
+    RowFilter_Type_descriptor(), name, value);
+}
+enum RowAggregate_Func {
+  RowAggregate_Func_COUNT = 0,
+  RowAggregate_Func_SUM = 1,
+  RowAggregate_Func_MIN = 2,
+  RowAggregate_Func_MAX = 3
+};
+bool RowAggregate_Func_IsValid(int value);
+const RowAggregate_Func RowAggregate_Func_Func_MIN = RowAggregate_Func_COUNT;
+const RowAggregate_Func RowAggregate_Func_Func_MAX = RowAggregate_Func_MAX;
+const int RowAggregate_Func_Func_ARRAYSIZE = RowAggregate_Func_Func_MAX + 1;
+
+const ::google::protobuf::EnumDescriptor* RowAggregate_Func_descriptor();
+inline const ::std::string& RowAggregate_Func_Name(RowAggregate_Func value) {
+  return ::google::protobuf::internal::NameOfEnum(
+    RowAggregate_Func_descriptor(), value);
+}
+inline bool RowAggregate_Func_Parse(
+    const ::std::string& name, RowAggregate_Func* value) {
+  return ::google::protobuf::internal::ParseNamedEnum<RowAggregate_Func>(
+    RowAggregate_Func_descriptor(), name, value);
+}
 enum ReadConsistencyType {
   CONSISTENT = 0,
   CONSENSUS = 1,
@@ -1768,18 +1854,38 @@ class ScanRequest : public ::google::protobuf::Message {
   ::google::protobuf::int64 max_results() const;
   void set_max_results(::google::protobuf::int64 value);
 
+  // optional bool keys_only = 3;
+  bool has_keys_only() const;
+  void clear_keys_only();
+  static const int kKeysOnlyFieldNumber = 3;
+  bool keys_only() const;
+  void set_keys_only(bool value);
+
+  // optional bool reverse = 4;
+  bool has_reverse() const;
+  void clear_reverse();
+  static const int kReverseFieldNumber = 4;
+  bool reverse() const;
+  void set_reverse(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.ScanRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
   inline void set_has_max_results();
   inline void clear_has_max_results();
+  inline void set_has_keys_only();
+  inline void clear_has_keys_only();
+  inline void set_has_reverse();
+  inline void clear_has_reverse();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
   ::google::protobuf::int64 max_results_;
+  bool keys_only_;
+  bool reverse_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
@@ -2124,14 +2230,14 @@ class EndTransactionResponse : public ::google::protobuf::Message {
 };
 // -------------------------------------------------------------------
 
-class RequestUnion : public ::google::protobuf::Message {
+class RowCell : public ::google::protobuf::Message {
  public:
-  RequestUnion();
-  virtual ~RequestUnion();
+  RowCell();
+  virtual ~RowCell();
 
-  RequestUnion(const RequestUnion& from);
+  RowCell(const RowCell& from);
 
-  inline RequestUnion& operator=(const RequestUnion& from) {
+  inline RowCell& operator=(const RowCell& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2145,31 +2251,19 @@ class RequestUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const RequestUnion& default_instance();
-
-  enum ValueCase {
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    VALUE_NOT_SET = 0,
-  };
+  static const RowCell& default_instance();
 
-  void Swap(RequestUnion* other);
+  void Swap(RowCell* other);
 
   // implements Message ----------------------------------------------
 
-  inline RequestUnion* New() const { return New(NULL); }
+  inline RowCell* New() const { return New(NULL); }
 
-  RequestUnion* New(::google::protobuf::Arena* arena) const;
+  RowCell* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const RequestUnion& from);
-  void MergeFrom(const RequestUnion& from);
+  void CopyFrom(const RowCell& from);
+  void MergeFrom(const RowCell& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2184,7 +2278,7 @@ class RequestUnion : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(RequestUnion* other);
+  void InternalSwap(RowCell* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2200,127 +2294,54 @@ class RequestUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.GetRequest get = 2;
-  bool has_get() const;
-  void clear_get();
-  static const int kGetFieldNumber = 2;
-  const ::cockroach::proto::GetRequest& get() const;
-  ::cockroach::proto::GetRequest* mutable_get();
-  ::cockroach::proto::GetRequest* release_get();
-  void set_allocated_get(::cockroach::proto::GetRequest* get);
-
-  // optional .cockroach.proto.PutRequest put = 3;
-  bool has_put() const;
-  void clear_put();
-  static const int kPutFieldNumber = 3;
-  const ::cockroach::proto::PutRequest& put() const;
-  ::cockroach::proto::PutRequest* mutable_put();
-  ::cockroach::proto::PutRequest* release_put();
-  void set_allocated_put(::cockroach::proto::PutRequest* put);
-
-  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
-  bool has_conditional_put() const;
-  void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
-  ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
-  ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
-  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
-
-  // optional .cockroach.proto.IncrementRequest increment = 5;
-  bool has_increment() const;
-  void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  const ::cockroach::proto::IncrementRequest& increment() const;
-  ::cockroach::proto::IncrementRequest* mutable_increment();
-  ::cockroach::proto::IncrementRequest* release_increment();
-  void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
-
-  // optional .cockroach.proto.DeleteRequest delete = 6;
-  bool has_delete_() const;
-  void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  const ::cockroach::proto::DeleteRequest& delete_() const;
-  ::cockroach::proto::DeleteRequest* mutable_delete_();
-  ::cockroach::proto::DeleteRequest* release_delete_();
-  void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
-
-  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-  bool has_delete_range() const;
-  void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
-  ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
-  ::cockroach::proto::DeleteRangeRequest* release_delete_range();
-  void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
-
-  // optional .cockroach.proto.ScanRequest scan = 8;
-  bool has_scan() const;
-  void clear_scan();
-  static const int kScanFieldNumber = 8;
-  const ::cockroach::proto::ScanRequest& scan() const;
-  ::cockroach::proto::ScanRequest* mutable_scan();
-  ::cockroach::proto::ScanRequest* release_scan();
-  void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
-
-  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-  bool has_end_transaction() const;
-  void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
-  ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
-  ::cockroach::proto::EndTransactionRequest* release_end_transaction();
-  void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
-
-  ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestUnion)
- private:
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
+  // optional uint32 column_id = 1;
+  bool has_column_id() const;
+  void clear_column_id();
+  static const int kColumnIdFieldNumber = 1;
+  ::google::protobuf::uint32 column_id() const;
+  void set_column_id(::google::protobuf::uint32 value);
 
-  inline bool has_value() const;
+  // optional bytes value = 2;
+  bool has_value() const;
   void clear_value();
+  static const int kValueFieldNumber = 2;
+  const ::std::string& value() const;
+  void set_value(const ::std::string& value);
+  void set_value(const char* value);
+  void set_value(const void* value, size_t size);
+  ::std::string* mutable_value();
+  ::std::string* release_value();
+  void set_allocated_value(::std::string* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RowCell)
+ private:
+  inline void set_has_column_id();
+  inline void clear_has_column_id();
+  inline void set_has_value();
   inline void clear_has_value();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
-  union ValueUnion {
-    ValueUnion() {}
-    ::cockroach::proto::GetRequest* get_;
-    ::cockroach::proto::PutRequest* put_;
-    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
-    ::cockroach::proto::IncrementRequest* increment_;
-    ::cockroach::proto::DeleteRequest* delete__;
-    ::cockroach::proto::DeleteRangeRequest* delete_range_;
-    ::cockroach::proto::ScanRequest* scan_;
-    ::cockroach::proto::EndTransactionRequest* end_transaction_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
+  ::google::protobuf::internal::ArenaStringPtr value_;
+  ::google::protobuf::uint32 column_id_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static RequestUnion* default_instance_;
+  static RowCell* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class ResponseUnion : public ::google::protobuf::Message {
+class Row : public ::google::protobuf::Message {
  public:
-  ResponseUnion();
-  virtual ~ResponseUnion();
+  Row();
+  virtual ~Row();
 
-  ResponseUnion(const ResponseUnion& from);
+  Row(const Row& from);
 
-  inline ResponseUnion& operator=(const ResponseUnion& from) {
+  inline Row& operator=(const Row& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2334,31 +2355,19 @@ class ResponseUnion : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const ResponseUnion& default_instance();
-
-  enum ValueCase {
-    kGet = 2,
-    kPut = 3,
-    kConditionalPut = 4,
-    kIncrement = 5,
-    kDelete = 6,
-    kDeleteRange = 7,
-    kScan = 8,
-    kEndTransaction = 9,
-    VALUE_NOT_SET = 0,
-  };
+  static const Row& default_instance();
 
-  void Swap(ResponseUnion* other);
+  void Swap(Row* other);
 
   // implements Message ----------------------------------------------
 
-  inline ResponseUnion* New() const { return New(NULL); }
+  inline Row* New() const { return New(NULL); }
 
-  ResponseUnion* New(::google::protobuf::Arena* arena) const;
+  Row* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const ResponseUnion& from);
-  void MergeFrom(const ResponseUnion& from);
+  void CopyFrom(const Row& from);
+  void MergeFrom(const Row& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2373,7 +2382,7 @@ class ResponseUnion : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(ResponseUnion* other);
+  void InternalSwap(Row* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2389,153 +2398,83 @@ class ResponseUnion : public ::google::protobuf::Message {
 
   // accessors -------------------------------------------------------
 
-  // optional .cockroach.proto.GetResponse get = 2;
-  bool has_get() const;
-  void clear_get();
-  static const int kGetFieldNumber = 2;
-  const ::cockroach::proto::GetResponse& get() const;
-  ::cockroach::proto::GetResponse* mutable_get();
-  ::cockroach::proto::GetResponse* release_get();
-  void set_allocated_get(::cockroach::proto::GetResponse* get);
+  // optional bytes primary_key = 1;
+  bool has_primary_key() const;
+  void clear_primary_key();
+  static const int kPrimaryKeyFieldNumber = 1;
+  const ::std::string& primary_key() const;
+  void set_primary_key(const ::std::string& value);
+  void set_primary_key(const char* value);
+  void set_primary_key(const void* value, size_t size);
+  ::std::string* mutable_primary_key();
+  ::std::string* release_primary_key();
+  void set_allocated_primary_key(::std::string* primary_key);
+
+  // repeated .cockroach.proto.RowCell cells = 2;
+  int cells_size() const;
+  void clear_cells();
+  static const int kCellsFieldNumber = 2;
+  const ::cockroach::proto::RowCell& cells(int index) const;
+  ::cockroach::proto::RowCell* mutable_cells(int index);
+  ::cockroach::proto::RowCell* add_cells();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >&
+      cells() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >*
+      mutable_cells();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.Row)
+ private:
+  inline void set_has_primary_key();
+  inline void clear_has_primary_key();
 
-  // optional .cockroach.proto.PutResponse put = 3;
-  bool has_put() const;
-  void clear_put();
-  static const int kPutFieldNumber = 3;
-  const ::cockroach::proto::PutResponse& put() const;
-  ::cockroach::proto::PutResponse* mutable_put();
-  ::cockroach::proto::PutResponse* release_put();
-  void set_allocated_put(::cockroach::proto::PutResponse* put);
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::internal::ArenaStringPtr primary_key_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell > cells_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
-  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
-  bool has_conditional_put() const;
-  void clear_conditional_put();
-  static const int kConditionalPutFieldNumber = 4;
-  const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
-  ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
-  ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
-  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
+  void InitAsDefaultInstance();
+  static Row* default_instance_;
+};
+// -------------------------------------------------------------------
 
-  // optional .cockroach.proto.IncrementResponse increment = 5;
-  bool has_increment() const;
-  void clear_increment();
-  static const int kIncrementFieldNumber = 5;
-  const ::cockroach::proto::IncrementResponse& increment() const;
-  ::cockroach::proto::IncrementResponse* mutable_increment();
-  ::cockroach::proto::IncrementResponse* release_increment();
-  void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
+class GetRowRequest : public ::google::protobuf::Message {
+ public:
+  GetRowRequest();
+  virtual ~GetRowRequest();
 
-  // optional .cockroach.proto.DeleteResponse delete = 6;
-  bool has_delete_() const;
-  void clear_delete_();
-  static const int kDeleteFieldNumber = 6;
-  const ::cockroach::proto::DeleteResponse& delete_() const;
-  ::cockroach::proto::DeleteResponse* mutable_delete_();
-  ::cockroach::proto::DeleteResponse* release_delete_();
-  void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
+  GetRowRequest(const GetRowRequest& from);
 
-  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
-  bool has_delete_range() const;
-  void clear_delete_range();
-  static const int kDeleteRangeFieldNumber = 7;
-  const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
-  ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
-  ::cockroach::proto::DeleteRangeResponse* release_delete_range();
-  void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
+  inline GetRowRequest& operator=(const GetRowRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-  // optional .cockroach.proto.ScanResponse scan = 8;
-  bool has_scan() const;
-  void clear_scan();
-  static const int kScanFieldNumber = 8;
-  const ::cockroach::proto::ScanResponse& scan() const;
-  ::cockroach::proto::ScanResponse* mutable_scan();
-  ::cockroach::proto::ScanResponse* release_scan();
-  void set_allocated_scan(::cockroach::proto::ScanResponse* scan);
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
 
-  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
-  bool has_end_transaction() const;
-  void clear_end_transaction();
-  static const int kEndTransactionFieldNumber = 9;
-  const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
-  ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
-  ::cockroach::proto::EndTransactionResponse* release_end_transaction();
-  void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
-
-  ValueCase value_case() const;
-  // @@protoc_insertion_point(class_scope:cockroach.proto.ResponseUnion)
- private:
-  inline void set_has_get();
-  inline void set_has_put();
-  inline void set_has_conditional_put();
-  inline void set_has_increment();
-  inline void set_has_delete_();
-  inline void set_has_delete_range();
-  inline void set_has_scan();
-  inline void set_has_end_transaction();
-
-  inline bool has_value() const;
-  void clear_value();
-  inline void clear_has_value();
-
-  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
-  ::google::protobuf::uint32 _has_bits_[1];
-  mutable int _cached_size_;
-  union ValueUnion {
-    ValueUnion() {}
-    ::cockroach::proto::GetResponse* get_;
-    ::cockroach::proto::PutResponse* put_;
-    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
-    ::cockroach::proto::IncrementResponse* increment_;
-    ::cockroach::proto::DeleteResponse* delete__;
-    ::cockroach::proto::DeleteRangeResponse* delete_range_;
-    ::cockroach::proto::ScanResponse* scan_;
-    ::cockroach::proto::EndTransactionResponse* end_transaction_;
-  } value_;
-  ::google::protobuf::uint32 _oneof_case_[1];
-
-  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
-  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
-  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
-
-  void InitAsDefaultInstance();
-  static ResponseUnion* default_instance_;
-};
-// -------------------------------------------------------------------
-
-class BatchRequest : public ::google::protobuf::Message {
- public:
-  BatchRequest();
-  virtual ~BatchRequest();
-
-  BatchRequest(const BatchRequest& from);
-
-  inline BatchRequest& operator=(const BatchRequest& from) {
-    CopyFrom(from);
-    return *this;
-  }
-
-  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
-    return _internal_metadata_.unknown_fields();
-  }
-
-  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
-    return _internal_metadata_.mutable_unknown_fields();
-  }
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchRequest& default_instance();
+  static const GetRowRequest& default_instance();
 
-  void Swap(BatchRequest* other);
+  void Swap(GetRowRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline BatchRequest* New() const { return New(NULL); }
+  inline GetRowRequest* New() const { return New(NULL); }
 
-  BatchRequest* New(::google::protobuf::Arena* arena) const;
+  GetRowRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchRequest& from);
-  void MergeFrom(const BatchRequest& from);
+  void CopyFrom(const GetRowRequest& from);
+  void MergeFrom(const GetRowRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2550,7 +2489,7 @@ class BatchRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(BatchRequest* other);
+  void InternalSwap(GetRowRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2575,45 +2514,90 @@ class BatchRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // repeated .cockroach.proto.RequestUnion requests = 2;
-  int requests_size() const;
-  void clear_requests();
-  static const int kRequestsFieldNumber = 2;
-  const ::cockroach::proto::RequestUnion& requests(int index) const;
-  ::cockroach::proto::RequestUnion* mutable_requests(int index);
-  ::cockroach::proto::RequestUnion* add_requests();
-  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
-      requests() const;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
-      mutable_requests();
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchRequest)
+  // optional uint32 table_id = 2;
+  bool has_table_id() const;
+  void clear_table_id();
+  static const int kTableIdFieldNumber = 2;
+  ::google::protobuf::uint32 table_id() const;
+  void set_table_id(::google::protobuf::uint32 value);
+
+  // optional uint32 index_id = 3;
+  bool has_index_id() const;
+  void clear_index_id();
+  static const int kIndexIdFieldNumber = 3;
+  ::google::protobuf::uint32 index_id() const;
+  void set_index_id(::google::protobuf::uint32 value);
+
+  // optional bytes primary_key = 4;
+  bool has_primary_key() const;
+  void clear_primary_key();
+  static const int kPrimaryKeyFieldNumber = 4;
+  const ::std::string& primary_key() const;
+  void set_primary_key(const ::std::string& value);
+  void set_primary_key(const char* value);
+  void set_primary_key(const void* value, size_t size);
+  ::std::string* mutable_primary_key();
+  ::std::string* release_primary_key();
+  void set_allocated_primary_key(::std::string* primary_key);
+
+  // repeated uint32 column_ids = 5;
+  int column_ids_size() const;
+  void clear_column_ids();
+  static const int kColumnIdsFieldNumber = 5;
+  ::google::protobuf::uint32 column_ids(int index) const;
+  void set_column_ids(int index, ::google::protobuf::uint32 value);
+  void add_column_ids(::google::protobuf::uint32 value);
+  const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+      column_ids() const;
+  ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+      mutable_column_ids();
+
+  // optional int32 ttl_seconds = 6;
+  bool has_ttl_seconds() const;
+  void clear_ttl_seconds();
+  static const int kTtlSecondsFieldNumber = 6;
+  ::google::protobuf::int32 ttl_seconds() const;
+  void set_ttl_seconds(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.GetRowRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_table_id();
+  inline void clear_has_table_id();
+  inline void set_has_index_id();
+  inline void clear_has_index_id();
+  inline void set_has_primary_key();
+  inline void clear_has_primary_key();
+  inline void set_has_ttl_seconds();
+  inline void clear_has_ttl_seconds();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion > requests_;
+  ::google::protobuf::uint32 table_id_;
+  ::google::protobuf::uint32 index_id_;
+  ::google::protobuf::internal::ArenaStringPtr primary_key_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::uint32 > column_ids_;
+  ::google::protobuf::int32 ttl_seconds_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static BatchRequest* default_instance_;
+  static GetRowRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class BatchResponse : public ::google::protobuf::Message {
+class GetRowResponse : public ::google::protobuf::Message {
  public:
-  BatchResponse();
-  virtual ~BatchResponse();
+  GetRowResponse();
+  virtual ~GetRowResponse();
 
-  BatchResponse(const BatchResponse& from);
+  GetRowResponse(const GetRowResponse& from);
 
-  inline BatchResponse& operator=(const BatchResponse& from) {
+  inline GetRowResponse& operator=(const GetRowResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2627,19 +2611,19 @@ class BatchResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const BatchResponse& default_instance();
+  static const GetRowResponse& default_instance();
 
-  void Swap(BatchResponse* other);
+  void Swap(GetRowResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline BatchResponse* New() const { return New(NULL); }
+  inline GetRowResponse* New() const { return New(NULL); }
 
-  BatchResponse* New(::google::protobuf::Arena* arena) const;
+  GetRowResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const BatchResponse& from);
-  void MergeFrom(const BatchResponse& from);
+  void CopyFrom(const GetRowResponse& from);
+  void MergeFrom(const GetRowResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2654,7 +2638,7 @@ class BatchResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(BatchResponse* other);
+  void InternalSwap(GetRowResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2679,45 +2663,44 @@ class BatchResponse : public ::google::protobuf::Message {
   ::cockroach::proto::ResponseHeader* release_header();
   void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // repeated .cockroach.proto.ResponseUnion responses = 2;
-  int responses_size() const;
-  void clear_responses();
-  static const int kResponsesFieldNumber = 2;
-  const ::cockroach::proto::ResponseUnion& responses(int index) const;
-  ::cockroach::proto::ResponseUnion* mutable_responses(int index);
-  ::cockroach::proto::ResponseUnion* add_responses();
-  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
-      responses() const;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
-      mutable_responses();
+  // optional .cockroach.proto.Row row = 2;
+  bool has_row() const;
+  void clear_row();
+  static const int kRowFieldNumber = 2;
+  const ::cockroach::proto::Row& row() const;
+  ::cockroach::proto::Row* mutable_row();
+  ::cockroach::proto::Row* release_row();
+  void set_allocated_row(::cockroach::proto::Row* row);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchResponse)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.GetRowResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_row();
+  inline void clear_has_row();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
-  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion > responses_;
+  ::cockroach::proto::Row* row_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static BatchResponse* default_instance_;
+  static GetRowResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminSplitRequest : public ::google::protobuf::Message {
+class PutRowRequest : public ::google::protobuf::Message {
  public:
-  AdminSplitRequest();
-  virtual ~AdminSplitRequest();
+  PutRowRequest();
+  virtual ~PutRowRequest();
 
-  AdminSplitRequest(const AdminSplitRequest& from);
+  PutRowRequest(const PutRowRequest& from);
 
-  inline AdminSplitRequest& operator=(const AdminSplitRequest& from) {
+  inline PutRowRequest& operator=(const PutRowRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2731,19 +2714,19 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminSplitRequest& default_instance();
+  static const PutRowRequest& default_instance();
 
-  void Swap(AdminSplitRequest* other);
+  void Swap(PutRowRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminSplitRequest* New() const { return New(NULL); }
+  inline PutRowRequest* New() const { return New(NULL); }
 
-  AdminSplitRequest* New(::google::protobuf::Arena* arena) const;
+  PutRowRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminSplitRequest& from);
-  void MergeFrom(const AdminSplitRequest& from);
+  void CopyFrom(const PutRowRequest& from);
+  void MergeFrom(const PutRowRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2758,7 +2741,7 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminSplitRequest* other);
+  void InternalSwap(PutRowRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2783,47 +2766,90 @@ class AdminSplitRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // optional bytes split_key = 2;
-  bool has_split_key() const;
-  void clear_split_key();
-  static const int kSplitKeyFieldNumber = 2;
-  const ::std::string& split_key() const;
-  void set_split_key(const ::std::string& value);
-  void set_split_key(const char* value);
-  void set_split_key(const void* value, size_t size);
-  ::std::string* mutable_split_key();
-  ::std::string* release_split_key();
-  void set_allocated_split_key(::std::string* split_key);
-
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitRequest)
+  // optional uint32 table_id = 2;
+  bool has_table_id() const;
+  void clear_table_id();
+  static const int kTableIdFieldNumber = 2;
+  ::google::protobuf::uint32 table_id() const;
+  void set_table_id(::google::protobuf::uint32 value);
+
+  // optional uint32 index_id = 3;
+  bool has_index_id() const;
+  void clear_index_id();
+  static const int kIndexIdFieldNumber = 3;
+  ::google::protobuf::uint32 index_id() const;
+  void set_index_id(::google::protobuf::uint32 value);
+
+  // optional bytes primary_key = 4;
+  bool has_primary_key() const;
+  void clear_primary_key();
+  static const int kPrimaryKeyFieldNumber = 4;
+  const ::std::string& primary_key() const;
+  void set_primary_key(const ::std::string& value);
+  void set_primary_key(const char* value);
+  void set_primary_key(const void* value, size_t size);
+  ::std::string* mutable_primary_key();
+  ::std::string* release_primary_key();
+  void set_allocated_primary_key(::std::string* primary_key);
+
+  // repeated .cockroach.proto.RowCell cells = 5;
+  int cells_size() const;
+  void clear_cells();
+  static const int kCellsFieldNumber = 5;
+  const ::cockroach::proto::RowCell& cells(int index) const;
+  ::cockroach::proto::RowCell* mutable_cells(int index);
+  ::cockroach::proto::RowCell* add_cells();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >&
+      cells() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >*
+      mutable_cells();
+
+  // optional int32 ttl_seconds = 6;
+  bool has_ttl_seconds() const;
+  void clear_ttl_seconds();
+  static const int kTtlSecondsFieldNumber = 6;
+  ::google::protobuf::int32 ttl_seconds() const;
+  void set_ttl_seconds(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.PutRowRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
-  inline void set_has_split_key();
-  inline void clear_has_split_key();
+  inline void set_has_table_id();
+  inline void clear_has_table_id();
+  inline void set_has_index_id();
+  inline void clear_has_index_id();
+  inline void set_has_primary_key();
+  inline void clear_has_primary_key();
+  inline void set_has_ttl_seconds();
+  inline void clear_has_ttl_seconds();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
-  ::google::protobuf::internal::ArenaStringPtr split_key_;
+  ::google::protobuf::uint32 table_id_;
+  ::google::protobuf::uint32 index_id_;
+  ::google::protobuf::internal::ArenaStringPtr primary_key_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell > cells_;
+  ::google::protobuf::int32 ttl_seconds_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminSplitRequest* default_instance_;
+  static PutRowRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminSplitResponse : public ::google::protobuf::Message {
+class PutRowResponse : public ::google::protobuf::Message {
  public:
-  AdminSplitResponse();
-  virtual ~AdminSplitResponse();
+  PutRowResponse();
+  virtual ~PutRowResponse();
 
-  AdminSplitResponse(const AdminSplitResponse& from);
+  PutRowResponse(const PutRowResponse& from);
 
-  inline AdminSplitResponse& operator=(const AdminSplitResponse& from) {
+  inline PutRowResponse& operator=(const PutRowResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2837,19 +2863,19 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminSplitResponse& default_instance();
+  static const PutRowResponse& default_instance();
 
-  void Swap(AdminSplitResponse* other);
+  void Swap(PutRowResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminSplitResponse* New() const { return New(NULL); }
+  inline PutRowResponse* New() const { return New(NULL); }
 
-  AdminSplitResponse* New(::google::protobuf::Arena* arena) const;
+  PutRowResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminSplitResponse& from);
-  void MergeFrom(const AdminSplitResponse& from);
+  void CopyFrom(const PutRowResponse& from);
+  void MergeFrom(const PutRowResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2864,7 +2890,7 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminSplitResponse* other);
+  void InternalSwap(PutRowResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2889,7 +2915,7 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   ::cockroach::proto::ResponseHeader* release_header();
   void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitResponse)
+  // @@protoc_insertion_point(class_scope:cockroach.proto.PutRowResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
@@ -2903,18 +2929,18 @@ class AdminSplitResponse : public ::google::protobuf::Message {
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminSplitResponse* default_instance_;
+  static PutRowResponse* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminMergeRequest : public ::google::protobuf::Message {
+class DeleteRowRequest : public ::google::protobuf::Message {
  public:
-  AdminMergeRequest();
-  virtual ~AdminMergeRequest();
+  DeleteRowRequest();
+  virtual ~DeleteRowRequest();
 
-  AdminMergeRequest(const AdminMergeRequest& from);
+  DeleteRowRequest(const DeleteRowRequest& from);
 
-  inline AdminMergeRequest& operator=(const AdminMergeRequest& from) {
+  inline DeleteRowRequest& operator=(const DeleteRowRequest& from) {
     CopyFrom(from);
     return *this;
   }
@@ -2928,19 +2954,19 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminMergeRequest& default_instance();
+  static const DeleteRowRequest& default_instance();
 
-  void Swap(AdminMergeRequest* other);
+  void Swap(DeleteRowRequest* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminMergeRequest* New() const { return New(NULL); }
+  inline DeleteRowRequest* New() const { return New(NULL); }
 
-  AdminMergeRequest* New(::google::protobuf::Arena* arena) const;
+  DeleteRowRequest* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminMergeRequest& from);
-  void MergeFrom(const AdminMergeRequest& from);
+  void CopyFrom(const DeleteRowRequest& from);
+  void MergeFrom(const DeleteRowRequest& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -2955,7 +2981,7 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminMergeRequest* other);
+  void InternalSwap(DeleteRowRequest* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -2980,32 +3006,77 @@ class AdminMergeRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* release_header();
   void set_allocated_header(::cockroach::proto::RequestHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeRequest)
+  // optional uint32 table_id = 2;
+  bool has_table_id() const;
+  void clear_table_id();
+  static const int kTableIdFieldNumber = 2;
+  ::google::protobuf::uint32 table_id() const;
+  void set_table_id(::google::protobuf::uint32 value);
+
+  // optional uint32 index_id = 3;
+  bool has_index_id() const;
+  void clear_index_id();
+  static const int kIndexIdFieldNumber = 3;
+  ::google::protobuf::uint32 index_id() const;
+  void set_index_id(::google::protobuf::uint32 value);
+
+  // optional bytes primary_key = 4;
+  bool has_primary_key() const;
+  void clear_primary_key();
+  static const int kPrimaryKeyFieldNumber = 4;
+  const ::std::string& primary_key() const;
+  void set_primary_key(const ::std::string& value);
+  void set_primary_key(const char* value);
+  void set_primary_key(const void* value, size_t size);
+  ::std::string* mutable_primary_key();
+  ::std::string* release_primary_key();
+  void set_allocated_primary_key(::std::string* primary_key);
+
+  // optional int32 ttl_seconds = 5;
+  bool has_ttl_seconds() const;
+  void clear_ttl_seconds();
+  static const int kTtlSecondsFieldNumber = 5;
+  ::google::protobuf::int32 ttl_seconds() const;
+  void set_ttl_seconds(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.DeleteRowRequest)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_table_id();
+  inline void clear_has_table_id();
+  inline void set_has_index_id();
+  inline void clear_has_index_id();
+  inline void set_has_primary_key();
+  inline void clear_has_primary_key();
+  inline void set_has_ttl_seconds();
+  inline void clear_has_ttl_seconds();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::uint32 table_id_;
+  ::google::protobuf::uint32 index_id_;
+  ::google::protobuf::internal::ArenaStringPtr primary_key_;
+  ::google::protobuf::int32 ttl_seconds_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminMergeRequest* default_instance_;
+  static DeleteRowRequest* default_instance_;
 };
 // -------------------------------------------------------------------
 
-class AdminMergeResponse : public ::google::protobuf::Message {
+class DeleteRowResponse : public ::google::protobuf::Message {
  public:
-  AdminMergeResponse();
-  virtual ~AdminMergeResponse();
+  DeleteRowResponse();
+  virtual ~DeleteRowResponse();
 
-  AdminMergeResponse(const AdminMergeResponse& from);
+  DeleteRowResponse(const DeleteRowResponse& from);
 
-  inline AdminMergeResponse& operator=(const AdminMergeResponse& from) {
+  inline DeleteRowResponse& operator=(const DeleteRowResponse& from) {
     CopyFrom(from);
     return *this;
   }
@@ -3019,19 +3090,19 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   }
 
   static const ::google::protobuf::Descriptor* descriptor();
-  static const AdminMergeResponse& default_instance();
+  static const DeleteRowResponse& default_instance();
 
-  void Swap(AdminMergeResponse* other);
+  void Swap(DeleteRowResponse* other);
 
   // implements Message ----------------------------------------------
 
-  inline AdminMergeResponse* New() const { return New(NULL); }
+  inline DeleteRowResponse* New() const { return New(NULL); }
 
-  AdminMergeResponse* New(::google::protobuf::Arena* arena) const;
+  DeleteRowResponse* New(::google::protobuf::Arena* arena) const;
   void CopyFrom(const ::google::protobuf::Message& from);
   void MergeFrom(const ::google::protobuf::Message& from);
-  void CopyFrom(const AdminMergeResponse& from);
-  void MergeFrom(const AdminMergeResponse& from);
+  void CopyFrom(const DeleteRowResponse& from);
+  void MergeFrom(const DeleteRowResponse& from);
   void Clear();
   bool IsInitialized() const;
 
@@ -3046,7 +3117,7 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   void SharedCtor();
   void SharedDtor();
   void SetCachedSize(int size) const;
-  void InternalSwap(AdminMergeResponse* other);
+  void InternalSwap(DeleteRowResponse* other);
   private:
   inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
     return _internal_metadata_.arena();
@@ -3071,656 +3142,4323 @@ class AdminMergeResponse : public ::google::protobuf::Message {
   ::cockroach::proto::ResponseHeader* release_header();
   void set_allocated_header(::cockroach::proto::ResponseHeader* header);
 
-  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeResponse)
+  // optional bool deleted = 2;
+  bool has_deleted() const;
+  void clear_deleted();
+  static const int kDeletedFieldNumber = 2;
+  bool deleted() const;
+  void set_deleted(bool value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.DeleteRowResponse)
  private:
   inline void set_has_header();
   inline void clear_has_header();
+  inline void set_has_deleted();
+  inline void clear_has_deleted();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
   mutable int _cached_size_;
   ::cockroach::proto::ResponseHeader* header_;
+  bool deleted_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
 
   void InitAsDefaultInstance();
-  static AdminMergeResponse* default_instance_;
+  static DeleteRowResponse* default_instance_;
 };
-// ===================================================================
+// -------------------------------------------------------------------
 
+class RowFilter : public ::google::protobuf::Message {
+ public:
+  RowFilter();
+  virtual ~RowFilter();
 
-// ===================================================================
+  RowFilter(const RowFilter& from);
 
-#if !PROTOBUF_INLINE_NOT_IN_HEADERS
-// ClientCmdID
+  inline RowFilter& operator=(const RowFilter& from) {
+    CopyFrom(from);
+    return *this;
+  }
 
-// optional int64 wall_time = 1;
-inline bool ClientCmdID::has_wall_time() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void ClientCmdID::set_has_wall_time() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void ClientCmdID::clear_has_wall_time() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-inline void ClientCmdID::clear_wall_time() {
-  wall_time_ = GOOGLE_LONGLONG(0);
-  clear_has_wall_time();
-}
-inline ::google::protobuf::int64 ClientCmdID::wall_time() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.wall_time)
-  return wall_time_;
-}
-inline void ClientCmdID::set_wall_time(::google::protobuf::int64 value) {
-  set_has_wall_time();
-  wall_time_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.wall_time)
-}
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
 
-// optional int64 random = 2;
-inline bool ClientCmdID::has_random() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
-}
-inline void ClientCmdID::set_has_random() {
-  _has_bits_[0] |= 0x00000002u;
-}
-inline void ClientCmdID::clear_has_random() {
-  _has_bits_[0] &= ~0x00000002u;
-}
-inline void ClientCmdID::clear_random() {
-  random_ = GOOGLE_LONGLONG(0);
-  clear_has_random();
-}
-inline ::google::protobuf::int64 ClientCmdID::random() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.random)
-  return random_;
-}
-inline void ClientCmdID::set_random(::google::protobuf::int64 value) {
-  set_has_random();
-  random_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.random)
-}
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
 
-// -------------------------------------------------------------------
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RowFilter& default_instance();
 
-// RequestHeader
+  void Swap(RowFilter* other);
 
-// optional .cockroach.proto.Timestamp timestamp = 1;
-inline bool RequestHeader::has_timestamp() const {
-  return (_has_bits_[0] & 0x00000001u) != 0;
-}
-inline void RequestHeader::set_has_timestamp() {
-  _has_bits_[0] |= 0x00000001u;
-}
-inline void RequestHeader::clear_has_timestamp() {
-  _has_bits_[0] &= ~0x00000001u;
-}
-inline void RequestHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
-}
-inline const ::cockroach::proto::Timestamp& RequestHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
-}
+  // implements Message ----------------------------------------------
+
+  inline RowFilter* New() const { return New(NULL); }
+
+  RowFilter* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RowFilter& from);
+  void MergeFrom(const RowFilter& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RowFilter* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  typedef RowFilter_Op Op;
+  static const Op EQ = RowFilter_Op_EQ;
+  static const Op NE = RowFilter_Op_NE;
+  static const Op LT = RowFilter_Op_LT;
+  static const Op LE = RowFilter_Op_LE;
+  static const Op GT = RowFilter_Op_GT;
+  static const Op GE = RowFilter_Op_GE;
+  static const Op IS_NULL = RowFilter_Op_IS_NULL;
+  static const Op IS_NOT_NULL = RowFilter_Op_IS_NOT_NULL;
+  static inline bool Op_IsValid(int value) {
+    return RowFilter_Op_IsValid(value);
+  }
+  static const Op Op_MIN =
+    RowFilter_Op_Op_MIN;
+  static const Op Op_MAX =
+    RowFilter_Op_Op_MAX;
+  static const int Op_ARRAYSIZE =
+    RowFilter_Op_Op_ARRAYSIZE;
+  static inline const ::google::protobuf::EnumDescriptor*
+  Op_descriptor() {
+    return RowFilter_Op_descriptor();
+  }
+  static inline const ::std::string& Op_Name(Op value) {
+    return RowFilter_Op_Name(value);
+  }
+  static inline bool Op_Parse(const ::std::string& name,
+      Op* value) {
+    return RowFilter_Op_Parse(name, value);
+  }
+
+  typedef RowFilter_Type Type;
+  static const Type BYTES = RowFilter_Type_BYTES;
+  static const Type FLOAT = RowFilter_Type_FLOAT;
+  static const Type INT = RowFilter_Type_INT;
+  static inline bool Type_IsValid(int value) {
+    return RowFilter_Type_IsValid(value);
+  }
+  static const Type Type_MIN =
+    RowFilter_Type_Type_MIN;
+  static const Type Type_MAX =
+    RowFilter_Type_Type_MAX;
+  static const int Type_ARRAYSIZE =
+    RowFilter_Type_Type_ARRAYSIZE;
+  static inline const ::google::protobuf::EnumDescriptor*
+  Type_descriptor() {
+    return RowFilter_Type_descriptor();
+  }
+  static inline const ::std::string& Type_Name(Type value) {
+    return RowFilter_Type_Name(value);
+  }
+  static inline bool Type_Parse(const ::std::string& name,
+      Type* value) {
+    return RowFilter_Type_Parse(name, value);
+  }
+
+  // accessors -------------------------------------------------------
+
+  // optional uint32 column_id = 1;
+  bool has_column_id() const;
+  void clear_column_id();
+  static const int kColumnIdFieldNumber = 1;
+  ::google::protobuf::uint32 column_id() const;
+  void set_column_id(::google::protobuf::uint32 value);
+
+  // optional .cockroach.proto.RowFilter.Op op = 2;
+  bool has_op() const;
+  void clear_op();
+  static const int kOpFieldNumber = 2;
+  ::cockroach::proto::RowFilter_Op op() const;
+  void set_op(::cockroach::proto::RowFilter_Op value);
+
+  // optional .cockroach.proto.RowFilter.Type type = 3;
+  bool has_type() const;
+  void clear_type();
+  static const int kTypeFieldNumber = 3;
+  ::cockroach::proto::RowFilter_Type type() const;
+  void set_type(::cockroach::proto::RowFilter_Type value);
+
+  // optional bytes value = 4;
+  bool has_value() const;
+  void clear_value();
+  static const int kValueFieldNumber = 4;
+  const ::std::string& value() const;
+  void set_value(const ::std::string& value);
+  void set_value(const char* value);
+  void set_value(const void* value, size_t size);
+  ::std::string* mutable_value();
+  ::std::string* release_value();
+  void set_allocated_value(::std::string* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RowFilter)
+ private:
+  inline void set_has_column_id();
+  inline void clear_has_column_id();
+  inline void set_has_op();
+  inline void clear_has_op();
+  inline void set_has_type();
+  inline void clear_has_type();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::uint32 column_id_;
+  int op_;
+  ::google::protobuf::internal::ArenaStringPtr value_;
+  int type_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static RowFilter* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RowAggregate : public ::google::protobuf::Message {
+ public:
+  RowAggregate();
+  virtual ~RowAggregate();
+
+  RowAggregate(const RowAggregate& from);
+
+  inline RowAggregate& operator=(const RowAggregate& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RowAggregate& default_instance();
+
+  void Swap(RowAggregate* other);
+
+  // implements Message ----------------------------------------------
+
+  inline RowAggregate* New() const { return New(NULL); }
+
+  RowAggregate* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RowAggregate& from);
+  void MergeFrom(const RowAggregate& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RowAggregate* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  typedef RowAggregate_Func Func;
+  static const Func COUNT = RowAggregate_Func_COUNT;
+  static const Func SUM = RowAggregate_Func_SUM;
+  static const Func MIN = RowAggregate_Func_MIN;
+  static const Func MAX = RowAggregate_Func_MAX;
+  static inline bool Func_IsValid(int value) {
+    return RowAggregate_Func_IsValid(value);
+  }
+  static const Func Func_MIN =
+    RowAggregate_Func_Func_MIN;
+  static const Func Func_MAX =
+    RowAggregate_Func_Func_MAX;
+  static const int Func_ARRAYSIZE =
+    RowAggregate_Func_Func_ARRAYSIZE;
+  static inline const ::google::protobuf::EnumDescriptor*
+  Func_descriptor() {
+    return RowAggregate_Func_descriptor();
+  }
+  static inline const ::std::string& Func_Name(Func value) {
+    return RowAggregate_Func_Name(value);
+  }
+  static inline bool Func_Parse(const ::std::string& name,
+      Func* value) {
+    return RowAggregate_Func_Parse(name, value);
+  }
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RowAggregate.Func func = 1;
+  bool has_func() const;
+  void clear_func();
+  static const int kFuncFieldNumber = 1;
+  ::cockroach::proto::RowAggregate_Func func() const;
+  void set_func(::cockroach::proto::RowAggregate_Func value);
+
+  // optional uint32 column_id = 2;
+  bool has_column_id() const;
+  void clear_column_id();
+  static const int kColumnIdFieldNumber = 2;
+  ::google::protobuf::uint32 column_id() const;
+  void set_column_id(::google::protobuf::uint32 value);
+
+  // optional .cockroach.proto.RowFilter.Type type = 3;
+  bool has_type() const;
+  void clear_type();
+  static const int kTypeFieldNumber = 3;
+  ::cockroach::proto::RowFilter_Type type() const;
+  void set_type(::cockroach::proto::RowFilter_Type value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RowAggregate)
+ private:
+  inline void set_has_func();
+  inline void clear_has_func();
+  inline void set_has_column_id();
+  inline void clear_has_column_id();
+  inline void set_has_type();
+  inline void clear_has_type();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  int func_;
+  ::google::protobuf::uint32 column_id_;
+  int type_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static RowAggregate* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RowAggregateResult : public ::google::protobuf::Message {
+ public:
+  RowAggregateResult();
+  virtual ~RowAggregateResult();
+
+  RowAggregateResult(const RowAggregateResult& from);
+
+  inline RowAggregateResult& operator=(const RowAggregateResult& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RowAggregateResult& default_instance();
+
+  void Swap(RowAggregateResult* other);
+
+  // implements Message ----------------------------------------------
+
+  inline RowAggregateResult* New() const { return New(NULL); }
+
+  RowAggregateResult* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RowAggregateResult& from);
+  void MergeFrom(const RowAggregateResult& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RowAggregateResult* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RowAggregate aggregate = 1;
+  bool has_aggregate() const;
+  void clear_aggregate();
+  static const int kAggregateFieldNumber = 1;
+  const ::cockroach::proto::RowAggregate& aggregate() const;
+  ::cockroach::proto::RowAggregate* mutable_aggregate();
+  ::cockroach::proto::RowAggregate* release_aggregate();
+  void set_allocated_aggregate(::cockroach::proto::RowAggregate* aggregate);
+
+  // optional int64 count = 2;
+  bool has_count() const;
+  void clear_count();
+  static const int kCountFieldNumber = 2;
+  ::google::protobuf::int64 count() const;
+  void set_count(::google::protobuf::int64 value);
+
+  // optional bytes value = 3;
+  bool has_value() const;
+  void clear_value();
+  static const int kValueFieldNumber = 3;
+  const ::std::string& value() const;
+  void set_value(const ::std::string& value);
+  void set_value(const char* value);
+  void set_value(const void* value, size_t size);
+  ::std::string* mutable_value();
+  ::std::string* release_value();
+  void set_allocated_value(::std::string* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RowAggregateResult)
+ private:
+  inline void set_has_aggregate();
+  inline void clear_has_aggregate();
+  inline void set_has_count();
+  inline void clear_has_count();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RowAggregate* aggregate_;
+  ::google::protobuf::int64 count_;
+  ::google::protobuf::internal::ArenaStringPtr value_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static RowAggregateResult* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ScanRowsRequest : public ::google::protobuf::Message {
+ public:
+  ScanRowsRequest();
+  virtual ~ScanRowsRequest();
+
+  ScanRowsRequest(const ScanRowsRequest& from);
+
+  inline ScanRowsRequest& operator=(const ScanRowsRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ScanRowsRequest& default_instance();
+
+  void Swap(ScanRowsRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ScanRowsRequest* New() const { return New(NULL); }
+
+  ScanRowsRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ScanRowsRequest& from);
+  void MergeFrom(const ScanRowsRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ScanRowsRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional uint32 table_id = 2;
+  bool has_table_id() const;
+  void clear_table_id();
+  static const int kTableIdFieldNumber = 2;
+  ::google::protobuf::uint32 table_id() const;
+  void set_table_id(::google::protobuf::uint32 value);
+
+  // optional uint32 index_id = 3;
+  bool has_index_id() const;
+  void clear_index_id();
+  static const int kIndexIdFieldNumber = 3;
+  ::google::protobuf::uint32 index_id() const;
+  void set_index_id(::google::protobuf::uint32 value);
+
+  // repeated uint32 column_ids = 4;
+  int column_ids_size() const;
+  void clear_column_ids();
+  static const int kColumnIdsFieldNumber = 4;
+  ::google::protobuf::uint32 column_ids(int index) const;
+  void set_column_ids(int index, ::google::protobuf::uint32 value);
+  void add_column_ids(::google::protobuf::uint32 value);
+  const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+      column_ids() const;
+  ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+      mutable_column_ids();
+
+  // optional int64 max_rows = 5;
+  bool has_max_rows() const;
+  void clear_max_rows();
+  static const int kMaxRowsFieldNumber = 5;
+  ::google::protobuf::int64 max_rows() const;
+  void set_max_rows(::google::protobuf::int64 value);
+
+  // repeated .cockroach.proto.RowFilter filters = 6;
+  int filters_size() const;
+  void clear_filters();
+  static const int kFiltersFieldNumber = 6;
+  const ::cockroach::proto::RowFilter& filters(int index) const;
+  ::cockroach::proto::RowFilter* mutable_filters(int index);
+  ::cockroach::proto::RowFilter* add_filters();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter >&
+      filters() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter >*
+      mutable_filters();
+
+  // repeated .cockroach.proto.RowAggregate aggregates = 7;
+  int aggregates_size() const;
+  void clear_aggregates();
+  static const int kAggregatesFieldNumber = 7;
+  const ::cockroach::proto::RowAggregate& aggregates(int index) const;
+  ::cockroach::proto::RowAggregate* mutable_aggregates(int index);
+  ::cockroach::proto::RowAggregate* add_aggregates();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate >&
+      aggregates() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate >*
+      mutable_aggregates();
+
+  // optional int32 ttl_seconds = 8;
+  bool has_ttl_seconds() const;
+  void clear_ttl_seconds();
+  static const int kTtlSecondsFieldNumber = 8;
+  ::google::protobuf::int32 ttl_seconds() const;
+  void set_ttl_seconds(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanRowsRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_table_id();
+  inline void clear_has_table_id();
+  inline void set_has_index_id();
+  inline void clear_has_index_id();
+  inline void set_has_max_rows();
+  inline void clear_has_max_rows();
+  inline void set_has_ttl_seconds();
+  inline void clear_has_ttl_seconds();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::uint32 table_id_;
+  ::google::protobuf::uint32 index_id_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::uint32 > column_ids_;
+  ::google::protobuf::int64 max_rows_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter > filters_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate > aggregates_;
+  ::google::protobuf::int32 ttl_seconds_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ScanRowsRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ScanRowsResponse : public ::google::protobuf::Message {
+ public:
+  ScanRowsResponse();
+  virtual ~ScanRowsResponse();
+
+  ScanRowsResponse(const ScanRowsResponse& from);
+
+  inline ScanRowsResponse& operator=(const ScanRowsResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ScanRowsResponse& default_instance();
+
+  void Swap(ScanRowsResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ScanRowsResponse* New() const { return New(NULL); }
+
+  ScanRowsResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ScanRowsResponse& from);
+  void MergeFrom(const ScanRowsResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ScanRowsResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // repeated .cockroach.proto.Row rows = 2;
+  int rows_size() const;
+  void clear_rows();
+  static const int kRowsFieldNumber = 2;
+  const ::cockroach::proto::Row& rows(int index) const;
+  ::cockroach::proto::Row* mutable_rows(int index);
+  ::cockroach::proto::Row* add_rows();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row >&
+      rows() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row >*
+      mutable_rows();
+
+  // repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+  int aggregates_size() const;
+  void clear_aggregates();
+  static const int kAggregatesFieldNumber = 3;
+  const ::cockroach::proto::RowAggregateResult& aggregates(int index) const;
+  ::cockroach::proto::RowAggregateResult* mutable_aggregates(int index);
+  ::cockroach::proto::RowAggregateResult* add_aggregates();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult >&
+      aggregates() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult >*
+      mutable_aggregates();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanRowsResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row > rows_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult > aggregates_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ScanRowsResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class LockRowRequest : public ::google::protobuf::Message {
+ public:
+  LockRowRequest();
+  virtual ~LockRowRequest();
+
+  LockRowRequest(const LockRowRequest& from);
+
+  inline LockRowRequest& operator=(const LockRowRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const LockRowRequest& default_instance();
+
+  void Swap(LockRowRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline LockRowRequest* New() const { return New(NULL); }
+
+  LockRowRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const LockRowRequest& from);
+  void MergeFrom(const LockRowRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(LockRowRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional uint32 table_id = 2;
+  bool has_table_id() const;
+  void clear_table_id();
+  static const int kTableIdFieldNumber = 2;
+  ::google::protobuf::uint32 table_id() const;
+  void set_table_id(::google::protobuf::uint32 value);
+
+  // optional uint32 index_id = 3;
+  bool has_index_id() const;
+  void clear_index_id();
+  static const int kIndexIdFieldNumber = 3;
+  ::google::protobuf::uint32 index_id() const;
+  void set_index_id(::google::protobuf::uint32 value);
+
+  // optional bytes primary_key = 4;
+  bool has_primary_key() const;
+  void clear_primary_key();
+  static const int kPrimaryKeyFieldNumber = 4;
+  const ::std::string& primary_key() const;
+  void set_primary_key(const ::std::string& value);
+  void set_primary_key(const char* value);
+  void set_primary_key(const void* value, size_t size);
+  ::std::string* mutable_primary_key();
+  ::std::string* release_primary_key();
+  void set_allocated_primary_key(::std::string* primary_key);
+
+  // repeated uint32 column_ids = 5;
+  int column_ids_size() const;
+  void clear_column_ids();
+  static const int kColumnIdsFieldNumber = 5;
+  ::google::protobuf::uint32 column_ids(int index) const;
+  void set_column_ids(int index, ::google::protobuf::uint32 value);
+  void add_column_ids(::google::protobuf::uint32 value);
+  const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+      column_ids() const;
+  ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+      mutable_column_ids();
+
+  // optional int32 ttl_seconds = 6;
+  bool has_ttl_seconds() const;
+  void clear_ttl_seconds();
+  static const int kTtlSecondsFieldNumber = 6;
+  ::google::protobuf::int32 ttl_seconds() const;
+  void set_ttl_seconds(::google::protobuf::int32 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.LockRowRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_table_id();
+  inline void clear_has_table_id();
+  inline void set_has_index_id();
+  inline void clear_has_index_id();
+  inline void set_has_primary_key();
+  inline void clear_has_primary_key();
+  inline void set_has_ttl_seconds();
+  inline void clear_has_ttl_seconds();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::uint32 table_id_;
+  ::google::protobuf::uint32 index_id_;
+  ::google::protobuf::internal::ArenaStringPtr primary_key_;
+  ::google::protobuf::RepeatedField< ::google::protobuf::uint32 > column_ids_;
+  ::google::protobuf::int32 ttl_seconds_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static LockRowRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class LockRowResponse : public ::google::protobuf::Message {
+ public:
+  LockRowResponse();
+  virtual ~LockRowResponse();
+
+  LockRowResponse(const LockRowResponse& from);
+
+  inline LockRowResponse& operator=(const LockRowResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const LockRowResponse& default_instance();
+
+  void Swap(LockRowResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline LockRowResponse* New() const { return New(NULL); }
+
+  LockRowResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const LockRowResponse& from);
+  void MergeFrom(const LockRowResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(LockRowResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // optional .cockroach.proto.Row row = 2;
+  bool has_row() const;
+  void clear_row();
+  static const int kRowFieldNumber = 2;
+  const ::cockroach::proto::Row& row() const;
+  ::cockroach::proto::Row* mutable_row();
+  ::cockroach::proto::Row* release_row();
+  void set_allocated_row(::cockroach::proto::Row* row);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.LockRowResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_row();
+  inline void clear_has_row();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::cockroach::proto::Row* row_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static LockRowResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class PutUniqueRequest : public ::google::protobuf::Message {
+ public:
+  PutUniqueRequest();
+  virtual ~PutUniqueRequest();
+
+  PutUniqueRequest(const PutUniqueRequest& from);
+
+  inline PutUniqueRequest& operator=(const PutUniqueRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const PutUniqueRequest& default_instance();
+
+  void Swap(PutUniqueRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline PutUniqueRequest* New() const { return New(NULL); }
+
+  PutUniqueRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const PutUniqueRequest& from);
+  void MergeFrom(const PutUniqueRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(PutUniqueRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Value value = 2;
+  bool has_value() const;
+  void clear_value();
+  static const int kValueFieldNumber = 2;
+  const ::cockroach::proto::Value& value() const;
+  ::cockroach::proto::Value* mutable_value();
+  ::cockroach::proto::Value* release_value();
+  void set_allocated_value(::cockroach::proto::Value* value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.PutUniqueRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Value* value_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static PutUniqueRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class PutUniqueResponse : public ::google::protobuf::Message {
+ public:
+  PutUniqueResponse();
+  virtual ~PutUniqueResponse();
+
+  PutUniqueResponse(const PutUniqueResponse& from);
+
+  inline PutUniqueResponse& operator=(const PutUniqueResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const PutUniqueResponse& default_instance();
+
+  void Swap(PutUniqueResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline PutUniqueResponse* New() const { return New(NULL); }
+
+  PutUniqueResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const PutUniqueResponse& from);
+  void MergeFrom(const PutUniqueResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(PutUniqueResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.PutUniqueResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static PutUniqueResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ScanChangesRequest : public ::google::protobuf::Message {
+ public:
+  ScanChangesRequest();
+  virtual ~ScanChangesRequest();
+
+  ScanChangesRequest(const ScanChangesRequest& from);
+
+  inline ScanChangesRequest& operator=(const ScanChangesRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ScanChangesRequest& default_instance();
+
+  void Swap(ScanChangesRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ScanChangesRequest* New() const { return New(NULL); }
+
+  ScanChangesRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ScanChangesRequest& from);
+  void MergeFrom(const ScanChangesRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ScanChangesRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional .cockroach.proto.Timestamp start_timestamp = 2;
+  bool has_start_timestamp() const;
+  void clear_start_timestamp();
+  static const int kStartTimestampFieldNumber = 2;
+  const ::cockroach::proto::Timestamp& start_timestamp() const;
+  ::cockroach::proto::Timestamp* mutable_start_timestamp();
+  ::cockroach::proto::Timestamp* release_start_timestamp();
+  void set_allocated_start_timestamp(::cockroach::proto::Timestamp* start_timestamp);
+
+  // optional int64 max_results = 3;
+  bool has_max_results() const;
+  void clear_max_results();
+  static const int kMaxResultsFieldNumber = 3;
+  ::google::protobuf::int64 max_results() const;
+  void set_max_results(::google::protobuf::int64 value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanChangesRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_start_timestamp();
+  inline void clear_has_start_timestamp();
+  inline void set_has_max_results();
+  inline void clear_has_max_results();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::cockroach::proto::Timestamp* start_timestamp_;
+  ::google::protobuf::int64 max_results_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ScanChangesRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class KeyValueChange : public ::google::protobuf::Message {
+ public:
+  KeyValueChange();
+  virtual ~KeyValueChange();
+
+  KeyValueChange(const KeyValueChange& from);
+
+  inline KeyValueChange& operator=(const KeyValueChange& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const KeyValueChange& default_instance();
+
+  void Swap(KeyValueChange* other);
+
+  // implements Message ----------------------------------------------
+
+  inline KeyValueChange* New() const { return New(NULL); }
+
+  KeyValueChange* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const KeyValueChange& from);
+  void MergeFrom(const KeyValueChange& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(KeyValueChange* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional bytes key = 1;
+  bool has_key() const;
+  void clear_key();
+  static const int kKeyFieldNumber = 1;
+  const ::std::string& key() const;
+  void set_key(const ::std::string& value);
+  void set_key(const char* value);
+  void set_key(const void* value, size_t size);
+  ::std::string* mutable_key();
+  ::std::string* release_key();
+  void set_allocated_key(::std::string* key);
+
+  // optional .cockroach.proto.Value value = 2;
+  bool has_value() const;
+  void clear_value();
+  static const int kValueFieldNumber = 2;
+  const ::cockroach::proto::Value& value() const;
+  ::cockroach::proto::Value* mutable_value();
+  ::cockroach::proto::Value* release_value();
+  void set_allocated_value(::cockroach::proto::Value* value);
+
+  // optional bool deleted = 3;
+  bool has_deleted() const;
+  void clear_deleted();
+  static const int kDeletedFieldNumber = 3;
+  bool deleted() const;
+  void set_deleted(bool value);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.KeyValueChange)
+ private:
+  inline void set_has_key();
+  inline void clear_has_key();
+  inline void set_has_value();
+  inline void clear_has_value();
+  inline void set_has_deleted();
+  inline void clear_has_deleted();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::google::protobuf::internal::ArenaStringPtr key_;
+  ::cockroach::proto::Value* value_;
+  bool deleted_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static KeyValueChange* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ScanChangesResponse : public ::google::protobuf::Message {
+ public:
+  ScanChangesResponse();
+  virtual ~ScanChangesResponse();
+
+  ScanChangesResponse(const ScanChangesResponse& from);
+
+  inline ScanChangesResponse& operator=(const ScanChangesResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ScanChangesResponse& default_instance();
+
+  void Swap(ScanChangesResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ScanChangesResponse* New() const { return New(NULL); }
+
+  ScanChangesResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ScanChangesResponse& from);
+  void MergeFrom(const ScanChangesResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ScanChangesResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // repeated .cockroach.proto.KeyValueChange changes = 2;
+  int changes_size() const;
+  void clear_changes();
+  static const int kChangesFieldNumber = 2;
+  const ::cockroach::proto::KeyValueChange& changes(int index) const;
+  ::cockroach::proto::KeyValueChange* mutable_changes(int index);
+  ::cockroach::proto::KeyValueChange* add_changes();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange >&
+      changes() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange >*
+      mutable_changes();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ScanChangesResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange > changes_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ScanChangesResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class RequestUnion : public ::google::protobuf::Message {
+ public:
+  RequestUnion();
+  virtual ~RequestUnion();
+
+  RequestUnion(const RequestUnion& from);
+
+  inline RequestUnion& operator=(const RequestUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const RequestUnion& default_instance();
+
+  enum ValueCase {
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kGetRow = 10,
+    kPutRow = 11,
+    kDeleteRow = 12,
+    kScanRows = 13,
+    kLockRow = 14,
+    kPutUnique = 15,
+    kScanChanges = 16,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(RequestUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  inline RequestUnion* New() const { return New(NULL); }
+
+  RequestUnion* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const RequestUnion& from);
+  void MergeFrom(const RequestUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(RequestUnion* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.GetRequest get = 2;
+  bool has_get() const;
+  void clear_get();
+  static const int kGetFieldNumber = 2;
+  const ::cockroach::proto::GetRequest& get() const;
+  ::cockroach::proto::GetRequest* mutable_get();
+  ::cockroach::proto::GetRequest* release_get();
+  void set_allocated_get(::cockroach::proto::GetRequest* get);
+
+  // optional .cockroach.proto.PutRequest put = 3;
+  bool has_put() const;
+  void clear_put();
+  static const int kPutFieldNumber = 3;
+  const ::cockroach::proto::PutRequest& put() const;
+  ::cockroach::proto::PutRequest* mutable_put();
+  ::cockroach::proto::PutRequest* release_put();
+  void set_allocated_put(::cockroach::proto::PutRequest* put);
+
+  // optional .cockroach.proto.ConditionalPutRequest conditional_put = 4;
+  bool has_conditional_put() const;
+  void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  const ::cockroach::proto::ConditionalPutRequest& conditional_put() const;
+  ::cockroach::proto::ConditionalPutRequest* mutable_conditional_put();
+  ::cockroach::proto::ConditionalPutRequest* release_conditional_put();
+  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutRequest* conditional_put);
+
+  // optional .cockroach.proto.IncrementRequest increment = 5;
+  bool has_increment() const;
+  void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  const ::cockroach::proto::IncrementRequest& increment() const;
+  ::cockroach::proto::IncrementRequest* mutable_increment();
+  ::cockroach::proto::IncrementRequest* release_increment();
+  void set_allocated_increment(::cockroach::proto::IncrementRequest* increment);
+
+  // optional .cockroach.proto.DeleteRequest delete = 6;
+  bool has_delete_() const;
+  void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  const ::cockroach::proto::DeleteRequest& delete_() const;
+  ::cockroach::proto::DeleteRequest* mutable_delete_();
+  ::cockroach::proto::DeleteRequest* release_delete_();
+  void set_allocated_delete_(::cockroach::proto::DeleteRequest* delete_);
+
+  // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+  bool has_delete_range() const;
+  void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  const ::cockroach::proto::DeleteRangeRequest& delete_range() const;
+  ::cockroach::proto::DeleteRangeRequest* mutable_delete_range();
+  ::cockroach::proto::DeleteRangeRequest* release_delete_range();
+  void set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range);
+
+  // optional .cockroach.proto.ScanRequest scan = 8;
+  bool has_scan() const;
+  void clear_scan();
+  static const int kScanFieldNumber = 8;
+  const ::cockroach::proto::ScanRequest& scan() const;
+  ::cockroach::proto::ScanRequest* mutable_scan();
+  ::cockroach::proto::ScanRequest* release_scan();
+  void set_allocated_scan(::cockroach::proto::ScanRequest* scan);
+
+  // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+  bool has_end_transaction() const;
+  void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  const ::cockroach::proto::EndTransactionRequest& end_transaction() const;
+  ::cockroach::proto::EndTransactionRequest* mutable_end_transaction();
+  ::cockroach::proto::EndTransactionRequest* release_end_transaction();
+  void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
+
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  bool has_get_row() const;
+  void clear_get_row();
+  static const int kGetRowFieldNumber = 10;
+  const ::cockroach::proto::GetRowRequest& get_row() const;
+  ::cockroach::proto::GetRowRequest* mutable_get_row();
+  ::cockroach::proto::GetRowRequest* release_get_row();
+  void set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row);
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  bool has_put_row() const;
+  void clear_put_row();
+  static const int kPutRowFieldNumber = 11;
+  const ::cockroach::proto::PutRowRequest& put_row() const;
+  ::cockroach::proto::PutRowRequest* mutable_put_row();
+  ::cockroach::proto::PutRowRequest* release_put_row();
+  void set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row);
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  bool has_delete_row() const;
+  void clear_delete_row();
+  static const int kDeleteRowFieldNumber = 12;
+  const ::cockroach::proto::DeleteRowRequest& delete_row() const;
+  ::cockroach::proto::DeleteRowRequest* mutable_delete_row();
+  ::cockroach::proto::DeleteRowRequest* release_delete_row();
+  void set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row);
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  bool has_scan_rows() const;
+  void clear_scan_rows();
+  static const int kScanRowsFieldNumber = 13;
+  const ::cockroach::proto::ScanRowsRequest& scan_rows() const;
+  ::cockroach::proto::ScanRowsRequest* mutable_scan_rows();
+  ::cockroach::proto::ScanRowsRequest* release_scan_rows();
+  void set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows);
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  bool has_lock_row() const;
+  void clear_lock_row();
+  static const int kLockRowFieldNumber = 14;
+  const ::cockroach::proto::LockRowRequest& lock_row() const;
+  ::cockroach::proto::LockRowRequest* mutable_lock_row();
+  ::cockroach::proto::LockRowRequest* release_lock_row();
+  void set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row);
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  bool has_put_unique() const;
+  void clear_put_unique();
+  static const int kPutUniqueFieldNumber = 15;
+  const ::cockroach::proto::PutUniqueRequest& put_unique() const;
+  ::cockroach::proto::PutUniqueRequest* mutable_put_unique();
+  ::cockroach::proto::PutUniqueRequest* release_put_unique();
+  void set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique);
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  bool has_scan_changes() const;
+  void clear_scan_changes();
+  static const int kScanChangesFieldNumber = 16;
+  const ::cockroach::proto::ScanChangesRequest& scan_changes() const;
+  ::cockroach::proto::ScanChangesRequest* mutable_scan_changes();
+  ::cockroach::proto::ScanChangesRequest* release_scan_changes();
+  void set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes);
+
+  ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.RequestUnion)
+ private:
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_get_row();
+  inline void set_has_put_row();
+  inline void set_has_delete_row();
+  inline void set_has_scan_rows();
+  inline void set_has_lock_row();
+  inline void set_has_put_unique();
+  inline void set_has_scan_changes();
+
+  inline bool has_value() const;
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ValueUnion() {}
+    ::cockroach::proto::GetRequest* get_;
+    ::cockroach::proto::PutRequest* put_;
+    ::cockroach::proto::ConditionalPutRequest* conditional_put_;
+    ::cockroach::proto::IncrementRequest* increment_;
+    ::cockroach::proto::DeleteRequest* delete__;
+    ::cockroach::proto::DeleteRangeRequest* delete_range_;
+    ::cockroach::proto::ScanRequest* scan_;
+    ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::GetRowRequest* get_row_;
+    ::cockroach::proto::PutRowRequest* put_row_;
+    ::cockroach::proto::DeleteRowRequest* delete_row_;
+    ::cockroach::proto::ScanRowsRequest* scan_rows_;
+    ::cockroach::proto::LockRowRequest* lock_row_;
+    ::cockroach::proto::PutUniqueRequest* put_unique_;
+    ::cockroach::proto::ScanChangesRequest* scan_changes_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static RequestUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class ResponseUnion : public ::google::protobuf::Message {
+ public:
+  ResponseUnion();
+  virtual ~ResponseUnion();
+
+  ResponseUnion(const ResponseUnion& from);
+
+  inline ResponseUnion& operator=(const ResponseUnion& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const ResponseUnion& default_instance();
+
+  enum ValueCase {
+    kGet = 2,
+    kPut = 3,
+    kConditionalPut = 4,
+    kIncrement = 5,
+    kDelete = 6,
+    kDeleteRange = 7,
+    kScan = 8,
+    kEndTransaction = 9,
+    kGetRow = 10,
+    kPutRow = 11,
+    kDeleteRow = 12,
+    kScanRows = 13,
+    kLockRow = 14,
+    kPutUnique = 15,
+    kScanChanges = 16,
+    VALUE_NOT_SET = 0,
+  };
+
+  void Swap(ResponseUnion* other);
+
+  // implements Message ----------------------------------------------
+
+  inline ResponseUnion* New() const { return New(NULL); }
+
+  ResponseUnion* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const ResponseUnion& from);
+  void MergeFrom(const ResponseUnion& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(ResponseUnion* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.GetResponse get = 2;
+  bool has_get() const;
+  void clear_get();
+  static const int kGetFieldNumber = 2;
+  const ::cockroach::proto::GetResponse& get() const;
+  ::cockroach::proto::GetResponse* mutable_get();
+  ::cockroach::proto::GetResponse* release_get();
+  void set_allocated_get(::cockroach::proto::GetResponse* get);
+
+  // optional .cockroach.proto.PutResponse put = 3;
+  bool has_put() const;
+  void clear_put();
+  static const int kPutFieldNumber = 3;
+  const ::cockroach::proto::PutResponse& put() const;
+  ::cockroach::proto::PutResponse* mutable_put();
+  ::cockroach::proto::PutResponse* release_put();
+  void set_allocated_put(::cockroach::proto::PutResponse* put);
+
+  // optional .cockroach.proto.ConditionalPutResponse conditional_put = 4;
+  bool has_conditional_put() const;
+  void clear_conditional_put();
+  static const int kConditionalPutFieldNumber = 4;
+  const ::cockroach::proto::ConditionalPutResponse& conditional_put() const;
+  ::cockroach::proto::ConditionalPutResponse* mutable_conditional_put();
+  ::cockroach::proto::ConditionalPutResponse* release_conditional_put();
+  void set_allocated_conditional_put(::cockroach::proto::ConditionalPutResponse* conditional_put);
+
+  // optional .cockroach.proto.IncrementResponse increment = 5;
+  bool has_increment() const;
+  void clear_increment();
+  static const int kIncrementFieldNumber = 5;
+  const ::cockroach::proto::IncrementResponse& increment() const;
+  ::cockroach::proto::IncrementResponse* mutable_increment();
+  ::cockroach::proto::IncrementResponse* release_increment();
+  void set_allocated_increment(::cockroach::proto::IncrementResponse* increment);
+
+  // optional .cockroach.proto.DeleteResponse delete = 6;
+  bool has_delete_() const;
+  void clear_delete_();
+  static const int kDeleteFieldNumber = 6;
+  const ::cockroach::proto::DeleteResponse& delete_() const;
+  ::cockroach::proto::DeleteResponse* mutable_delete_();
+  ::cockroach::proto::DeleteResponse* release_delete_();
+  void set_allocated_delete_(::cockroach::proto::DeleteResponse* delete_);
+
+  // optional .cockroach.proto.DeleteRangeResponse delete_range = 7;
+  bool has_delete_range() const;
+  void clear_delete_range();
+  static const int kDeleteRangeFieldNumber = 7;
+  const ::cockroach::proto::DeleteRangeResponse& delete_range() const;
+  ::cockroach::proto::DeleteRangeResponse* mutable_delete_range();
+  ::cockroach::proto::DeleteRangeResponse* release_delete_range();
+  void set_allocated_delete_range(::cockroach::proto::DeleteRangeResponse* delete_range);
+
+  // optional .cockroach.proto.ScanResponse scan = 8;
+  bool has_scan() const;
+  void clear_scan();
+  static const int kScanFieldNumber = 8;
+  const ::cockroach::proto::ScanResponse& scan() const;
+  ::cockroach::proto::ScanResponse* mutable_scan();
+  ::cockroach::proto::ScanResponse* release_scan();
+  void set_allocated_scan(::cockroach::proto::ScanResponse* scan);
+
+  // optional .cockroach.proto.EndTransactionResponse end_transaction = 9;
+  bool has_end_transaction() const;
+  void clear_end_transaction();
+  static const int kEndTransactionFieldNumber = 9;
+  const ::cockroach::proto::EndTransactionResponse& end_transaction() const;
+  ::cockroach::proto::EndTransactionResponse* mutable_end_transaction();
+  ::cockroach::proto::EndTransactionResponse* release_end_transaction();
+  void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
+
+  // optional .cockroach.proto.GetRowResponse get_row = 10;
+  bool has_get_row() const;
+  void clear_get_row();
+  static const int kGetRowFieldNumber = 10;
+  const ::cockroach::proto::GetRowResponse& get_row() const;
+  ::cockroach::proto::GetRowResponse* mutable_get_row();
+  ::cockroach::proto::GetRowResponse* release_get_row();
+  void set_allocated_get_row(::cockroach::proto::GetRowResponse* get_row);
+
+  // optional .cockroach.proto.PutRowResponse put_row = 11;
+  bool has_put_row() const;
+  void clear_put_row();
+  static const int kPutRowFieldNumber = 11;
+  const ::cockroach::proto::PutRowResponse& put_row() const;
+  ::cockroach::proto::PutRowResponse* mutable_put_row();
+  ::cockroach::proto::PutRowResponse* release_put_row();
+  void set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row);
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+  bool has_delete_row() const;
+  void clear_delete_row();
+  static const int kDeleteRowFieldNumber = 12;
+  const ::cockroach::proto::DeleteRowResponse& delete_row() const;
+  ::cockroach::proto::DeleteRowResponse* mutable_delete_row();
+  ::cockroach::proto::DeleteRowResponse* release_delete_row();
+  void set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row);
+
+  // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+  bool has_scan_rows() const;
+  void clear_scan_rows();
+  static const int kScanRowsFieldNumber = 13;
+  const ::cockroach::proto::ScanRowsResponse& scan_rows() const;
+  ::cockroach::proto::ScanRowsResponse* mutable_scan_rows();
+  ::cockroach::proto::ScanRowsResponse* release_scan_rows();
+  void set_allocated_scan_rows(::cockroach::proto::ScanRowsResponse* scan_rows);
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 14;
+  bool has_lock_row() const;
+  void clear_lock_row();
+  static const int kLockRowFieldNumber = 14;
+  const ::cockroach::proto::LockRowResponse& lock_row() const;
+  ::cockroach::proto::LockRowResponse* mutable_lock_row();
+  ::cockroach::proto::LockRowResponse* release_lock_row();
+  void set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row);
+
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+  bool has_put_unique() const;
+  void clear_put_unique();
+  static const int kPutUniqueFieldNumber = 15;
+  const ::cockroach::proto::PutUniqueResponse& put_unique() const;
+  ::cockroach::proto::PutUniqueResponse* mutable_put_unique();
+  ::cockroach::proto::PutUniqueResponse* release_put_unique();
+  void set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique);
+
+  // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+  bool has_scan_changes() const;
+  void clear_scan_changes();
+  static const int kScanChangesFieldNumber = 16;
+  const ::cockroach::proto::ScanChangesResponse& scan_changes() const;
+  ::cockroach::proto::ScanChangesResponse* mutable_scan_changes();
+  ::cockroach::proto::ScanChangesResponse* release_scan_changes();
+  void set_allocated_scan_changes(::cockroach::proto::ScanChangesResponse* scan_changes);
+
+  ValueCase value_case() const;
+  // @@protoc_insertion_point(class_scope:cockroach.proto.ResponseUnion)
+ private:
+  inline void set_has_get();
+  inline void set_has_put();
+  inline void set_has_conditional_put();
+  inline void set_has_increment();
+  inline void set_has_delete_();
+  inline void set_has_delete_range();
+  inline void set_has_scan();
+  inline void set_has_end_transaction();
+  inline void set_has_get_row();
+  inline void set_has_put_row();
+  inline void set_has_delete_row();
+  inline void set_has_scan_rows();
+  inline void set_has_lock_row();
+  inline void set_has_put_unique();
+  inline void set_has_scan_changes();
+
+  inline bool has_value() const;
+  void clear_value();
+  inline void clear_has_value();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  union ValueUnion {
+    ValueUnion() {}
+    ::cockroach::proto::GetResponse* get_;
+    ::cockroach::proto::PutResponse* put_;
+    ::cockroach::proto::ConditionalPutResponse* conditional_put_;
+    ::cockroach::proto::IncrementResponse* increment_;
+    ::cockroach::proto::DeleteResponse* delete__;
+    ::cockroach::proto::DeleteRangeResponse* delete_range_;
+    ::cockroach::proto::ScanResponse* scan_;
+    ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::GetRowResponse* get_row_;
+    ::cockroach::proto::PutRowResponse* put_row_;
+    ::cockroach::proto::DeleteRowResponse* delete_row_;
+    ::cockroach::proto::ScanRowsResponse* scan_rows_;
+    ::cockroach::proto::LockRowResponse* lock_row_;
+    ::cockroach::proto::PutUniqueResponse* put_unique_;
+    ::cockroach::proto::ScanChangesResponse* scan_changes_;
+  } value_;
+  ::google::protobuf::uint32 _oneof_case_[1];
+
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static ResponseUnion* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchRequest : public ::google::protobuf::Message {
+ public:
+  BatchRequest();
+  virtual ~BatchRequest();
+
+  BatchRequest(const BatchRequest& from);
+
+  inline BatchRequest& operator=(const BatchRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchRequest& default_instance();
+
+  void Swap(BatchRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchRequest* New() const { return New(NULL); }
+
+  BatchRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchRequest& from);
+  void MergeFrom(const BatchRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // repeated .cockroach.proto.RequestUnion requests = 2;
+  int requests_size() const;
+  void clear_requests();
+  static const int kRequestsFieldNumber = 2;
+  const ::cockroach::proto::RequestUnion& requests(int index) const;
+  ::cockroach::proto::RequestUnion* mutable_requests(int index);
+  ::cockroach::proto::RequestUnion* add_requests();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >&
+      requests() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion >*
+      mutable_requests();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RequestUnion > requests_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class BatchResponse : public ::google::protobuf::Message {
+ public:
+  BatchResponse();
+  virtual ~BatchResponse();
+
+  BatchResponse(const BatchResponse& from);
+
+  inline BatchResponse& operator=(const BatchResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const BatchResponse& default_instance();
+
+  void Swap(BatchResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline BatchResponse* New() const { return New(NULL); }
+
+  BatchResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const BatchResponse& from);
+  void MergeFrom(const BatchResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(BatchResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // repeated .cockroach.proto.ResponseUnion responses = 2;
+  int responses_size() const;
+  void clear_responses();
+  static const int kResponsesFieldNumber = 2;
+  const ::cockroach::proto::ResponseUnion& responses(int index) const;
+  ::cockroach::proto::ResponseUnion* mutable_responses(int index);
+  ::cockroach::proto::ResponseUnion* add_responses();
+  const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >&
+      responses() const;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion >*
+      mutable_responses();
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.BatchResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  ::google::protobuf::RepeatedPtrField< ::cockroach::proto::ResponseUnion > responses_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static BatchResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminSplitRequest : public ::google::protobuf::Message {
+ public:
+  AdminSplitRequest();
+  virtual ~AdminSplitRequest();
+
+  AdminSplitRequest(const AdminSplitRequest& from);
+
+  inline AdminSplitRequest& operator=(const AdminSplitRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminSplitRequest& default_instance();
+
+  void Swap(AdminSplitRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminSplitRequest* New() const { return New(NULL); }
+
+  AdminSplitRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminSplitRequest& from);
+  void MergeFrom(const AdminSplitRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminSplitRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // optional bytes split_key = 2;
+  bool has_split_key() const;
+  void clear_split_key();
+  static const int kSplitKeyFieldNumber = 2;
+  const ::std::string& split_key() const;
+  void set_split_key(const ::std::string& value);
+  void set_split_key(const char* value);
+  void set_split_key(const void* value, size_t size);
+  ::std::string* mutable_split_key();
+  ::std::string* release_split_key();
+  void set_allocated_split_key(::std::string* split_key);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+  inline void set_has_split_key();
+  inline void clear_has_split_key();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  ::google::protobuf::internal::ArenaStringPtr split_key_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminSplitRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminSplitResponse : public ::google::protobuf::Message {
+ public:
+  AdminSplitResponse();
+  virtual ~AdminSplitResponse();
+
+  AdminSplitResponse(const AdminSplitResponse& from);
+
+  inline AdminSplitResponse& operator=(const AdminSplitResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminSplitResponse& default_instance();
+
+  void Swap(AdminSplitResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminSplitResponse* New() const { return New(NULL); }
+
+  AdminSplitResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminSplitResponse& from);
+  void MergeFrom(const AdminSplitResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminSplitResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminSplitResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminSplitResponse* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminMergeRequest : public ::google::protobuf::Message {
+ public:
+  AdminMergeRequest();
+  virtual ~AdminMergeRequest();
+
+  AdminMergeRequest(const AdminMergeRequest& from);
+
+  inline AdminMergeRequest& operator=(const AdminMergeRequest& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminMergeRequest& default_instance();
+
+  void Swap(AdminMergeRequest* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminMergeRequest* New() const { return New(NULL); }
+
+  AdminMergeRequest* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminMergeRequest& from);
+  void MergeFrom(const AdminMergeRequest& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminMergeRequest* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.RequestHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::RequestHeader& header() const;
+  ::cockroach::proto::RequestHeader* mutable_header();
+  ::cockroach::proto::RequestHeader* release_header();
+  void set_allocated_header(::cockroach::proto::RequestHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeRequest)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::RequestHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminMergeRequest* default_instance_;
+};
+// -------------------------------------------------------------------
+
+class AdminMergeResponse : public ::google::protobuf::Message {
+ public:
+  AdminMergeResponse();
+  virtual ~AdminMergeResponse();
+
+  AdminMergeResponse(const AdminMergeResponse& from);
+
+  inline AdminMergeResponse& operator=(const AdminMergeResponse& from) {
+    CopyFrom(from);
+    return *this;
+  }
+
+  inline const ::google::protobuf::UnknownFieldSet& unknown_fields() const {
+    return _internal_metadata_.unknown_fields();
+  }
+
+  inline ::google::protobuf::UnknownFieldSet* mutable_unknown_fields() {
+    return _internal_metadata_.mutable_unknown_fields();
+  }
+
+  static const ::google::protobuf::Descriptor* descriptor();
+  static const AdminMergeResponse& default_instance();
+
+  void Swap(AdminMergeResponse* other);
+
+  // implements Message ----------------------------------------------
+
+  inline AdminMergeResponse* New() const { return New(NULL); }
+
+  AdminMergeResponse* New(::google::protobuf::Arena* arena) const;
+  void CopyFrom(const ::google::protobuf::Message& from);
+  void MergeFrom(const ::google::protobuf::Message& from);
+  void CopyFrom(const AdminMergeResponse& from);
+  void MergeFrom(const AdminMergeResponse& from);
+  void Clear();
+  bool IsInitialized() const;
+
+  int ByteSize() const;
+  bool MergePartialFromCodedStream(
+      ::google::protobuf::io::CodedInputStream* input);
+  void SerializeWithCachedSizes(
+      ::google::protobuf::io::CodedOutputStream* output) const;
+  ::google::protobuf::uint8* SerializeWithCachedSizesToArray(::google::protobuf::uint8* output) const;
+  int GetCachedSize() const { return _cached_size_; }
+  private:
+  void SharedCtor();
+  void SharedDtor();
+  void SetCachedSize(int size) const;
+  void InternalSwap(AdminMergeResponse* other);
+  private:
+  inline ::google::protobuf::Arena* GetArenaNoVirtual() const {
+    return _internal_metadata_.arena();
+  }
+  inline void* MaybeArenaPtr() const {
+    return _internal_metadata_.raw_arena_ptr();
+  }
+  public:
+
+  ::google::protobuf::Metadata GetMetadata() const;
+
+  // nested types ----------------------------------------------------
+
+  // accessors -------------------------------------------------------
+
+  // optional .cockroach.proto.ResponseHeader header = 1;
+  bool has_header() const;
+  void clear_header();
+  static const int kHeaderFieldNumber = 1;
+  const ::cockroach::proto::ResponseHeader& header() const;
+  ::cockroach::proto::ResponseHeader* mutable_header();
+  ::cockroach::proto::ResponseHeader* release_header();
+  void set_allocated_header(::cockroach::proto::ResponseHeader* header);
+
+  // @@protoc_insertion_point(class_scope:cockroach.proto.AdminMergeResponse)
+ private:
+  inline void set_has_header();
+  inline void clear_has_header();
+
+  ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
+  ::google::protobuf::uint32 _has_bits_[1];
+  mutable int _cached_size_;
+  ::cockroach::proto::ResponseHeader* header_;
+  friend void  protobuf_AddDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_AssignDesc_cockroach_2fproto_2fapi_2eproto();
+  friend void protobuf_ShutdownFile_cockroach_2fproto_2fapi_2eproto();
+
+  void InitAsDefaultInstance();
+  static AdminMergeResponse* default_instance_;
+};
+// ===================================================================
+
+
+// ===================================================================
+
+#if !PROTOBUF_INLINE_NOT_IN_HEADERS
+// ClientCmdID
+
+// optional int64 wall_time = 1;
+inline bool ClientCmdID::has_wall_time() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ClientCmdID::set_has_wall_time() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ClientCmdID::clear_has_wall_time() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ClientCmdID::clear_wall_time() {
+  wall_time_ = GOOGLE_LONGLONG(0);
+  clear_has_wall_time();
+}
+inline ::google::protobuf::int64 ClientCmdID::wall_time() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.wall_time)
+  return wall_time_;
+}
+inline void ClientCmdID::set_wall_time(::google::protobuf::int64 value) {
+  set_has_wall_time();
+  wall_time_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.wall_time)
+}
+
+// optional int64 random = 2;
+inline bool ClientCmdID::has_random() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ClientCmdID::set_has_random() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ClientCmdID::clear_has_random() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ClientCmdID::clear_random() {
+  random_ = GOOGLE_LONGLONG(0);
+  clear_has_random();
+}
+inline ::google::protobuf::int64 ClientCmdID::random() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ClientCmdID.random)
+  return random_;
+}
+inline void ClientCmdID::set_random(::google::protobuf::int64 value) {
+  set_has_random();
+  random_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ClientCmdID.random)
+}
+
+// -------------------------------------------------------------------
+
+// RequestHeader
+
+// optional .cockroach.proto.Timestamp timestamp = 1;
+inline bool RequestHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RequestHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RequestHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RequestHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& RequestHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
 inline ::cockroach::proto::Timestamp* RequestHeader::mutable_timestamp() {
   set_has_timestamp();
   if (timestamp_ == NULL) {
     timestamp_ = new ::cockroach::proto::Timestamp;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.timestamp)
-  return timestamp_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* RequestHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.timestamp)
+}
+
+// optional .cockroach.proto.ClientCmdID cmd_id = 2;
+inline bool RequestHeader::has_cmd_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RequestHeader::set_has_cmd_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RequestHeader::clear_has_cmd_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RequestHeader::clear_cmd_id() {
+  if (cmd_id_ != NULL) cmd_id_->::cockroach::proto::ClientCmdID::Clear();
+  clear_has_cmd_id();
+}
+inline const ::cockroach::proto::ClientCmdID& RequestHeader::cmd_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.cmd_id)
+  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
+}
+inline ::cockroach::proto::ClientCmdID* RequestHeader::mutable_cmd_id() {
+  set_has_cmd_id();
+  if (cmd_id_ == NULL) {
+    cmd_id_ = new ::cockroach::proto::ClientCmdID;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.cmd_id)
+  return cmd_id_;
+}
+inline ::cockroach::proto::ClientCmdID* RequestHeader::release_cmd_id() {
+  clear_has_cmd_id();
+  ::cockroach::proto::ClientCmdID* temp = cmd_id_;
+  cmd_id_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_cmd_id(::cockroach::proto::ClientCmdID* cmd_id) {
+  delete cmd_id_;
+  cmd_id_ = cmd_id;
+  if (cmd_id) {
+    set_has_cmd_id();
+  } else {
+    clear_has_cmd_id();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.cmd_id)
+}
+
+// optional bytes key = 3;
+inline bool RequestHeader::has_key() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RequestHeader::set_has_key() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RequestHeader::clear_has_key() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RequestHeader::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
+}
+inline const ::std::string& RequestHeader::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.key)
+}
+inline void RequestHeader::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.key)
+}
+inline void RequestHeader::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.key)
+}
+inline ::std::string* RequestHeader::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RequestHeader::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
+  } else {
+    clear_has_key();
+  }
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.key)
+}
+
+// optional bytes end_key = 4;
+inline bool RequestHeader::has_end_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RequestHeader::set_has_end_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RequestHeader::clear_has_end_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RequestHeader::clear_end_key() {
+  end_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_end_key();
+}
+inline const ::std::string& RequestHeader::end_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.end_key)
+  return end_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_end_key(const ::std::string& value) {
+  set_has_end_key();
+  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.end_key)
+}
+inline void RequestHeader::set_end_key(const char* value) {
+  set_has_end_key();
+  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.end_key)
+}
+inline void RequestHeader::set_end_key(const void* value, size_t size) {
+  set_has_end_key();
+  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.end_key)
+}
+inline ::std::string* RequestHeader::mutable_end_key() {
+  set_has_end_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.end_key)
+  return end_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RequestHeader::release_end_key() {
+  clear_has_end_key();
+  return end_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_allocated_end_key(::std::string* end_key) {
+  if (end_key != NULL) {
+    set_has_end_key();
+  } else {
+    clear_has_end_key();
+  }
+  end_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), end_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.end_key)
+}
+
+// optional string user = 5;
+inline bool RequestHeader::has_user() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void RequestHeader::set_has_user() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void RequestHeader::clear_has_user() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void RequestHeader::clear_user() {
+  user_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_user();
+}
+inline const ::std::string& RequestHeader::user() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user)
+  return user_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_user(const ::std::string& value) {
+  set_has_user();
+  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user)
+}
+inline void RequestHeader::set_user(const char* value) {
+  set_has_user();
+  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.user)
+}
+inline void RequestHeader::set_user(const char* value, size_t size) {
+  set_has_user();
+  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.user)
+}
+inline ::std::string* RequestHeader::mutable_user() {
+  set_has_user();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.user)
+  return user_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RequestHeader::release_user() {
+  clear_has_user();
+  return user_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RequestHeader::set_allocated_user(::std::string* user) {
+  if (user != NULL) {
+    set_has_user();
+  } else {
+    clear_has_user();
+  }
+  user_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), user);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.user)
+}
+
+// optional .cockroach.proto.Replica replica = 6;
+inline bool RequestHeader::has_replica() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void RequestHeader::set_has_replica() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void RequestHeader::clear_has_replica() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void RequestHeader::clear_replica() {
+  if (replica_ != NULL) replica_->::cockroach::proto::Replica::Clear();
+  clear_has_replica();
+}
+inline const ::cockroach::proto::Replica& RequestHeader::replica() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.replica)
+  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+}
+inline ::cockroach::proto::Replica* RequestHeader::mutable_replica() {
+  set_has_replica();
+  if (replica_ == NULL) {
+    replica_ = new ::cockroach::proto::Replica;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.replica)
+  return replica_;
+}
+inline ::cockroach::proto::Replica* RequestHeader::release_replica() {
+  clear_has_replica();
+  ::cockroach::proto::Replica* temp = replica_;
+  replica_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_replica(::cockroach::proto::Replica* replica) {
+  delete replica_;
+  replica_ = replica;
+  if (replica) {
+    set_has_replica();
+  } else {
+    clear_has_replica();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.replica)
+}
+
+// optional int64 raft_id = 7;
+inline bool RequestHeader::has_raft_id() const {
+  return (_has_bits_[0] & 0x00000040u) != 0;
+}
+inline void RequestHeader::set_has_raft_id() {
+  _has_bits_[0] |= 0x00000040u;
+}
+inline void RequestHeader::clear_has_raft_id() {
+  _has_bits_[0] &= ~0x00000040u;
+}
+inline void RequestHeader::clear_raft_id() {
+  raft_id_ = GOOGLE_LONGLONG(0);
+  clear_has_raft_id();
+}
+inline ::google::protobuf::int64 RequestHeader::raft_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.raft_id)
+  return raft_id_;
+}
+inline void RequestHeader::set_raft_id(::google::protobuf::int64 value) {
+  set_has_raft_id();
+  raft_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.raft_id)
+}
+
+// optional int32 user_priority = 8 [default = 1];
+inline bool RequestHeader::has_user_priority() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void RequestHeader::set_has_user_priority() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void RequestHeader::clear_has_user_priority() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void RequestHeader::clear_user_priority() {
+  user_priority_ = 1;
+  clear_has_user_priority();
+}
+inline ::google::protobuf::int32 RequestHeader::user_priority() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user_priority)
+  return user_priority_;
+}
+inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
+  set_has_user_priority();
+  user_priority_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user_priority)
+}
+
+// optional .cockroach.proto.Transaction txn = 9;
+inline bool RequestHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000100u) != 0;
+}
+inline void RequestHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000100u;
+}
+inline void RequestHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000100u;
+}
+inline void RequestHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::proto::Transaction& RequestHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::proto::Transaction* RequestHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) {
+    txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.txn)
+  return txn_;
+}
+inline ::cockroach::proto::Transaction* RequestHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void RequestHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.txn)
+}
+
+// optional .cockroach.proto.ReadConsistencyType read_consistency = 10;
+inline bool RequestHeader::has_read_consistency() const {
+  return (_has_bits_[0] & 0x00000200u) != 0;
+}
+inline void RequestHeader::set_has_read_consistency() {
+  _has_bits_[0] |= 0x00000200u;
+}
+inline void RequestHeader::clear_has_read_consistency() {
+  _has_bits_[0] &= ~0x00000200u;
+}
+inline void RequestHeader::clear_read_consistency() {
+  read_consistency_ = 0;
+  clear_has_read_consistency();
+}
+inline ::cockroach::proto::ReadConsistencyType RequestHeader::read_consistency() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.read_consistency)
+  return static_cast< ::cockroach::proto::ReadConsistencyType >(read_consistency_);
+}
+inline void RequestHeader::set_read_consistency(::cockroach::proto::ReadConsistencyType value) {
+  assert(::cockroach::proto::ReadConsistencyType_IsValid(value));
+  set_has_read_consistency();
+  read_consistency_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
+}
+
+// -------------------------------------------------------------------
+
+// ResponseHeader
+
+// optional .cockroach.proto.Error error = 1;
+inline bool ResponseHeader::has_error() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ResponseHeader::set_has_error() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ResponseHeader::clear_has_error() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ResponseHeader::clear_error() {
+  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
+  clear_has_error();
+}
+inline const ::cockroach::proto::Error& ResponseHeader::error() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.error)
+  return error_ != NULL ? *error_ : *default_instance_->error_;
+}
+inline ::cockroach::proto::Error* ResponseHeader::mutable_error() {
+  set_has_error();
+  if (error_ == NULL) {
+    error_ = new ::cockroach::proto::Error;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.error)
+  return error_;
+}
+inline ::cockroach::proto::Error* ResponseHeader::release_error() {
+  clear_has_error();
+  ::cockroach::proto::Error* temp = error_;
+  error_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_error(::cockroach::proto::Error* error) {
+  delete error_;
+  error_ = error;
+  if (error) {
+    set_has_error();
+  } else {
+    clear_has_error();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.error)
+}
+
+// optional .cockroach.proto.Timestamp timestamp = 2;
+inline bool ResponseHeader::has_timestamp() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ResponseHeader::set_has_timestamp() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ResponseHeader::clear_has_timestamp() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ResponseHeader::clear_timestamp() {
+  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_timestamp();
+}
+inline const ::cockroach::proto::Timestamp& ResponseHeader::timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.timestamp)
+  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ResponseHeader::mutable_timestamp() {
+  set_has_timestamp();
+  if (timestamp_ == NULL) {
+    timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.timestamp)
+  return timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ResponseHeader::release_timestamp() {
+  clear_has_timestamp();
+  ::cockroach::proto::Timestamp* temp = timestamp_;
+  timestamp_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
+  delete timestamp_;
+  timestamp_ = timestamp;
+  if (timestamp) {
+    set_has_timestamp();
+  } else {
+    clear_has_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.timestamp)
+}
+
+// optional .cockroach.proto.Transaction txn = 3;
+inline bool ResponseHeader::has_txn() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ResponseHeader::set_has_txn() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ResponseHeader::clear_has_txn() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ResponseHeader::clear_txn() {
+  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
+  clear_has_txn();
+}
+inline const ::cockroach::proto::Transaction& ResponseHeader::txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.txn)
+  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+}
+inline ::cockroach::proto::Transaction* ResponseHeader::mutable_txn() {
+  set_has_txn();
+  if (txn_ == NULL) {
+    txn_ = new ::cockroach::proto::Transaction;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.txn)
+  return txn_;
+}
+inline ::cockroach::proto::Transaction* ResponseHeader::release_txn() {
+  clear_has_txn();
+  ::cockroach::proto::Transaction* temp = txn_;
+  txn_ = NULL;
+  return temp;
+}
+inline void ResponseHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
+  delete txn_;
+  txn_ = txn;
+  if (txn) {
+    set_has_txn();
+  } else {
+    clear_has_txn();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.txn)
+}
+
+// -------------------------------------------------------------------
+
+// GetRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool GetRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& GetRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* GetRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* GetRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRequest.header)
+}
+
+// -------------------------------------------------------------------
+
+// GetResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool GetResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void GetResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void GetResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void GetResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& GetResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* GetResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* GetResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool GetResponse::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GetResponse::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GetResponse::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GetResponse::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& GetResponse::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* GetResponse::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* GetResponse::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void GetResponse::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool PutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& PutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* PutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::Timestamp* RequestHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::proto::RequestHeader* PutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool PutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void PutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void PutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void PutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& PutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* PutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* PutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void PutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.value)
+}
+
+// -------------------------------------------------------------------
+
+// PutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool PutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void PutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void PutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void PutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& PutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* PutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* PutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutResponse.header)
+}
+
+// -------------------------------------------------------------------
+
+// ConditionalPutRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ConditionalPutRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ConditionalPutRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ConditionalPutRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ConditionalPutRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::RequestHeader& ConditionalPutRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.header)
+}
+
+// optional .cockroach.proto.Value value = 2;
+inline bool ConditionalPutRequest::has_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ConditionalPutRequest::set_has_value() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ConditionalPutRequest::clear_has_value() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ConditionalPutRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& ConditionalPutRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.value)
+}
+
+// optional .cockroach.proto.Value exp_value = 3;
+inline bool ConditionalPutRequest::has_exp_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ConditionalPutRequest::set_has_exp_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ConditionalPutRequest::clear_has_exp_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ConditionalPutRequest::clear_exp_value() {
+  if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
+  clear_has_exp_value();
+}
+inline const ::cockroach::proto::Value& ConditionalPutRequest::exp_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.exp_value)
+  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_exp_value() {
+  set_has_exp_value();
+  if (exp_value_ == NULL) {
+    exp_value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.exp_value)
+  return exp_value_;
+}
+inline ::cockroach::proto::Value* ConditionalPutRequest::release_exp_value() {
+  clear_has_exp_value();
+  ::cockroach::proto::Value* temp = exp_value_;
+  exp_value_ = NULL;
+  return temp;
+}
+inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::proto::Value* exp_value) {
+  delete exp_value_;
+  exp_value_ = exp_value;
+  if (exp_value) {
+    set_has_exp_value();
   } else {
-    clear_has_timestamp();
+    clear_has_exp_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.timestamp)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.exp_value)
 }
 
-// optional .cockroach.proto.ClientCmdID cmd_id = 2;
-inline bool RequestHeader::has_cmd_id() const {
-  return (_has_bits_[0] & 0x00000002u) != 0;
+// -------------------------------------------------------------------
+
+// ConditionalPutResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ConditionalPutResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_cmd_id() {
-  _has_bits_[0] |= 0x00000002u;
+inline void ConditionalPutResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_cmd_id() {
-  _has_bits_[0] &= ~0x00000002u;
+inline void ConditionalPutResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_cmd_id() {
-  if (cmd_id_ != NULL) cmd_id_->::cockroach::proto::ClientCmdID::Clear();
-  clear_has_cmd_id();
+inline void ConditionalPutResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::ClientCmdID& RequestHeader::cmd_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.cmd_id)
-  return cmd_id_ != NULL ? *cmd_id_ : *default_instance_->cmd_id_;
+inline const ::cockroach::proto::ResponseHeader& ConditionalPutResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ClientCmdID* RequestHeader::mutable_cmd_id() {
-  set_has_cmd_id();
-  if (cmd_id_ == NULL) {
-    cmd_id_ = new ::cockroach::proto::ClientCmdID;
+inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.cmd_id)
-  return cmd_id_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::ClientCmdID* RequestHeader::release_cmd_id() {
-  clear_has_cmd_id();
-  ::cockroach::proto::ClientCmdID* temp = cmd_id_;
-  cmd_id_ = NULL;
+inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_cmd_id(::cockroach::proto::ClientCmdID* cmd_id) {
-  delete cmd_id_;
-  cmd_id_ = cmd_id;
-  if (cmd_id) {
-    set_has_cmd_id();
+inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_cmd_id();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.cmd_id)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutResponse.header)
 }
 
-// optional bytes key = 3;
-inline bool RequestHeader::has_key() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void RequestHeader::set_has_key() {
-  _has_bits_[0] |= 0x00000004u;
-}
-inline void RequestHeader::clear_has_key() {
-  _has_bits_[0] &= ~0x00000004u;
-}
-inline void RequestHeader::clear_key() {
-  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_key();
+// -------------------------------------------------------------------
+
+// IncrementRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool IncrementRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline const ::std::string& RequestHeader::key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.key)
-  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void IncrementRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::set_key(const ::std::string& value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.key)
+inline void IncrementRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::set_key(const char* value) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.key)
+inline void IncrementRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline void RequestHeader::set_key(const void* value, size_t size) {
-  set_has_key();
-  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.key)
+inline const ::cockroach::proto::RequestHeader& IncrementRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::std::string* RequestHeader::mutable_key() {
-  set_has_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.key)
-  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::RequestHeader* IncrementRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementRequest.header)
+  return header_;
 }
-inline ::std::string* RequestHeader::release_key() {
-  clear_has_key();
-  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::RequestHeader* IncrementRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline void RequestHeader::set_allocated_key(::std::string* key) {
-  if (key != NULL) {
-    set_has_key();
+inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_key();
+    clear_has_header();
   }
-  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementRequest.header)
 }
 
-// optional bytes end_key = 4;
-inline bool RequestHeader::has_end_key() const {
-  return (_has_bits_[0] & 0x00000008u) != 0;
+// optional int64 increment = 2;
+inline bool IncrementRequest::has_increment() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestHeader::set_has_end_key() {
-  _has_bits_[0] |= 0x00000008u;
+inline void IncrementRequest::set_has_increment() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::clear_has_end_key() {
-  _has_bits_[0] &= ~0x00000008u;
+inline void IncrementRequest::clear_has_increment() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::clear_end_key() {
-  end_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_end_key();
+inline void IncrementRequest::clear_increment() {
+  increment_ = GOOGLE_LONGLONG(0);
+  clear_has_increment();
 }
-inline const ::std::string& RequestHeader::end_key() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.end_key)
-  return end_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::google::protobuf::int64 IncrementRequest::increment() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.increment)
+  return increment_;
 }
-inline void RequestHeader::set_end_key(const ::std::string& value) {
-  set_has_end_key();
-  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.end_key)
+inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
+  set_has_increment();
+  increment_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.increment)
 }
-inline void RequestHeader::set_end_key(const char* value) {
-  set_has_end_key();
-  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.end_key)
+
+// -------------------------------------------------------------------
+
+// IncrementResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool IncrementResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_end_key(const void* value, size_t size) {
-  set_has_end_key();
-  end_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.end_key)
+inline void IncrementResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline ::std::string* RequestHeader::mutable_end_key() {
-  set_has_end_key();
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.end_key)
-  return end_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void IncrementResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline ::std::string* RequestHeader::release_end_key() {
-  clear_has_end_key();
-  return end_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline void IncrementResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline void RequestHeader::set_allocated_end_key(::std::string* end_key) {
-  if (end_key != NULL) {
-    set_has_end_key();
+inline const ::cockroach::proto::ResponseHeader& IncrementResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* IncrementResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementResponse.header)
+  return header_;
+}
+inline ::cockroach::proto::ResponseHeader* IncrementResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void IncrementResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_end_key();
+    clear_has_header();
   }
-  end_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), end_key);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.end_key)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementResponse.header)
+}
+
+// optional int64 new_value = 2;
+inline bool IncrementResponse::has_new_value() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void IncrementResponse::set_has_new_value() {
+  _has_bits_[0] |= 0x00000002u;
 }
-
-// optional string user = 5;
-inline bool RequestHeader::has_user() const {
-  return (_has_bits_[0] & 0x00000010u) != 0;
+inline void IncrementResponse::clear_has_new_value() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::set_has_user() {
-  _has_bits_[0] |= 0x00000010u;
+inline void IncrementResponse::clear_new_value() {
+  new_value_ = GOOGLE_LONGLONG(0);
+  clear_has_new_value();
 }
-inline void RequestHeader::clear_has_user() {
-  _has_bits_[0] &= ~0x00000010u;
+inline ::google::protobuf::int64 IncrementResponse::new_value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.new_value)
+  return new_value_;
 }
-inline void RequestHeader::clear_user() {
-  user_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
-  clear_has_user();
+inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
+  set_has_new_value();
+  new_value_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.new_value)
 }
-inline const ::std::string& RequestHeader::user() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user)
-  return user_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+
+// -------------------------------------------------------------------
+
+// DeleteRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool DeleteRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_user(const ::std::string& value) {
-  set_has_user();
-  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user)
+inline void DeleteRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::set_user(const char* value) {
-  set_has_user();
-  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.RequestHeader.user)
+inline void DeleteRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::set_user(const char* value, size_t size) {
-  set_has_user();
-  user_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
-      ::std::string(reinterpret_cast<const char*>(value), size));
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RequestHeader.user)
+inline void DeleteRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::std::string* RequestHeader::mutable_user() {
-  set_has_user();
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.user)
-  return user_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline const ::cockroach::proto::RequestHeader& DeleteRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::std::string* RequestHeader::release_user() {
-  clear_has_user();
-  return user_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+inline ::cockroach::proto::RequestHeader* DeleteRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRequest.header)
+  return header_;
 }
-inline void RequestHeader::set_allocated_user(::std::string* user) {
-  if (user != NULL) {
-    set_has_user();
+inline ::cockroach::proto::RequestHeader* DeleteRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_user();
+    clear_has_header();
   }
-  user_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), user);
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.user)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRequest.header)
 }
 
-// optional .cockroach.proto.Replica replica = 6;
-inline bool RequestHeader::has_replica() const {
-  return (_has_bits_[0] & 0x00000020u) != 0;
+// -------------------------------------------------------------------
+
+// DeleteResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool DeleteResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_replica() {
-  _has_bits_[0] |= 0x00000020u;
+inline void DeleteResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_replica() {
-  _has_bits_[0] &= ~0x00000020u;
+inline void DeleteResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_replica() {
-  if (replica_ != NULL) replica_->::cockroach::proto::Replica::Clear();
-  clear_has_replica();
+inline void DeleteResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Replica& RequestHeader::replica() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.replica)
-  return replica_ != NULL ? *replica_ : *default_instance_->replica_;
+inline const ::cockroach::proto::ResponseHeader& DeleteResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Replica* RequestHeader::mutable_replica() {
-  set_has_replica();
-  if (replica_ == NULL) {
-    replica_ = new ::cockroach::proto::Replica;
+inline ::cockroach::proto::ResponseHeader* DeleteResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.replica)
-  return replica_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Replica* RequestHeader::release_replica() {
-  clear_has_replica();
-  ::cockroach::proto::Replica* temp = replica_;
-  replica_ = NULL;
+inline ::cockroach::proto::ResponseHeader* DeleteResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_replica(::cockroach::proto::Replica* replica) {
-  delete replica_;
-  replica_ = replica;
-  if (replica) {
-    set_has_replica();
+inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_replica();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.replica)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteResponse.header)
 }
 
-// optional int64 raft_id = 7;
-inline bool RequestHeader::has_raft_id() const {
-  return (_has_bits_[0] & 0x00000040u) != 0;
+// -------------------------------------------------------------------
+
+// DeleteRangeRequest
+
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool DeleteRangeRequest::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_raft_id() {
-  _has_bits_[0] |= 0x00000040u;
+inline void DeleteRangeRequest::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_raft_id() {
-  _has_bits_[0] &= ~0x00000040u;
+inline void DeleteRangeRequest::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_raft_id() {
-  raft_id_ = GOOGLE_LONGLONG(0);
-  clear_has_raft_id();
+inline void DeleteRangeRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline ::google::protobuf::int64 RequestHeader::raft_id() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.raft_id)
-  return raft_id_;
+inline const ::cockroach::proto::RequestHeader& DeleteRangeRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline void RequestHeader::set_raft_id(::google::protobuf::int64 value) {
-  set_has_raft_id();
-  raft_id_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.raft_id)
+inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeRequest.header)
+  return header_;
+}
+inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
+  return temp;
+}
+inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeRequest.header)
 }
 
-// optional int32 user_priority = 8 [default = 1];
-inline bool RequestHeader::has_user_priority() const {
-  return (_has_bits_[0] & 0x00000080u) != 0;
+// optional int64 max_entries_to_delete = 2;
+inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestHeader::set_has_user_priority() {
-  _has_bits_[0] |= 0x00000080u;
+inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::clear_has_user_priority() {
-  _has_bits_[0] &= ~0x00000080u;
+inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::clear_user_priority() {
-  user_priority_ = 1;
-  clear_has_user_priority();
+inline void DeleteRangeRequest::clear_max_entries_to_delete() {
+  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
+  clear_has_max_entries_to_delete();
 }
-inline ::google::protobuf::int32 RequestHeader::user_priority() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.user_priority)
-  return user_priority_;
+inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+  return max_entries_to_delete_;
 }
-inline void RequestHeader::set_user_priority(::google::protobuf::int32 value) {
-  set_has_user_priority();
-  user_priority_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.user_priority)
+inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
+  set_has_max_entries_to_delete();
+  max_entries_to_delete_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
 }
 
-// optional .cockroach.proto.Transaction txn = 9;
-inline bool RequestHeader::has_txn() const {
-  return (_has_bits_[0] & 0x00000100u) != 0;
+// -------------------------------------------------------------------
+
+// DeleteRangeResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool DeleteRangeResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void RequestHeader::set_has_txn() {
-  _has_bits_[0] |= 0x00000100u;
+inline void DeleteRangeResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
 }
-inline void RequestHeader::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000100u;
+inline void DeleteRangeResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
 }
-inline void RequestHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_txn();
+inline void DeleteRangeResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Transaction& RequestHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline const ::cockroach::proto::ResponseHeader& DeleteRangeResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Transaction* RequestHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) {
-    txn_ = new ::cockroach::proto::Transaction;
+inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestHeader.txn)
-  return txn_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Transaction* RequestHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::proto::Transaction* temp = txn_;
-  txn_ = NULL;
+inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void RequestHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
+inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_txn();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestHeader.txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeResponse.header)
 }
 
-// optional .cockroach.proto.ReadConsistencyType read_consistency = 10;
-inline bool RequestHeader::has_read_consistency() const {
-  return (_has_bits_[0] & 0x00000200u) != 0;
+// optional int64 num_deleted = 2;
+inline bool DeleteRangeResponse::has_num_deleted() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void RequestHeader::set_has_read_consistency() {
-  _has_bits_[0] |= 0x00000200u;
+inline void DeleteRangeResponse::set_has_num_deleted() {
+  _has_bits_[0] |= 0x00000002u;
 }
-inline void RequestHeader::clear_has_read_consistency() {
-  _has_bits_[0] &= ~0x00000200u;
+inline void DeleteRangeResponse::clear_has_num_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
 }
-inline void RequestHeader::clear_read_consistency() {
-  read_consistency_ = 0;
-  clear_has_read_consistency();
+inline void DeleteRangeResponse::clear_num_deleted() {
+  num_deleted_ = GOOGLE_LONGLONG(0);
+  clear_has_num_deleted();
 }
-inline ::cockroach::proto::ReadConsistencyType RequestHeader::read_consistency() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestHeader.read_consistency)
-  return static_cast< ::cockroach::proto::ReadConsistencyType >(read_consistency_);
+inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.num_deleted)
+  return num_deleted_;
 }
-inline void RequestHeader::set_read_consistency(::cockroach::proto::ReadConsistencyType value) {
-  assert(::cockroach::proto::ReadConsistencyType_IsValid(value));
-  set_has_read_consistency();
-  read_consistency_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.RequestHeader.read_consistency)
+inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
+  set_has_num_deleted();
+  num_deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeResponse.num_deleted)
 }
 
 // -------------------------------------------------------------------
 
-// ResponseHeader
+// ScanRequest
 
-// optional .cockroach.proto.Error error = 1;
-inline bool ResponseHeader::has_error() const {
+// optional .cockroach.proto.RequestHeader header = 1;
+inline bool ScanRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ResponseHeader::set_has_error() {
+inline void ScanRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ResponseHeader::clear_has_error() {
+inline void ScanRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ResponseHeader::clear_error() {
-  if (error_ != NULL) error_->::cockroach::proto::Error::Clear();
-  clear_has_error();
+inline void ScanRequest::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
+  clear_has_header();
 }
-inline const ::cockroach::proto::Error& ResponseHeader::error() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.error)
-  return error_ != NULL ? *error_ : *default_instance_->error_;
+inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::Error* ResponseHeader::mutable_error() {
-  set_has_error();
-  if (error_ == NULL) {
-    error_ = new ::cockroach::proto::Error;
+inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.error)
-  return error_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
+  return header_;
 }
-inline ::cockroach::proto::Error* ResponseHeader::release_error() {
-  clear_has_error();
-  ::cockroach::proto::Error* temp = error_;
-  error_ = NULL;
+inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
+  clear_has_header();
+  ::cockroach::proto::RequestHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_error(::cockroach::proto::Error* error) {
-  delete error_;
-  error_ = error;
-  if (error) {
-    set_has_error();
+inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_error();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.error)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
 }
 
-// optional .cockroach.proto.Timestamp timestamp = 2;
-inline bool ResponseHeader::has_timestamp() const {
+// optional int64 max_results = 2;
+inline bool ScanRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ResponseHeader::set_has_timestamp() {
+inline void ScanRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ResponseHeader::clear_has_timestamp() {
+inline void ScanRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ResponseHeader::clear_timestamp() {
-  if (timestamp_ != NULL) timestamp_->::cockroach::proto::Timestamp::Clear();
-  clear_has_timestamp();
+inline void ScanRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
-inline const ::cockroach::proto::Timestamp& ResponseHeader::timestamp() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.timestamp)
-  return timestamp_ != NULL ? *timestamp_ : *default_instance_->timestamp_;
+inline ::google::protobuf::int64 ScanRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
+  return max_results_;
 }
-inline ::cockroach::proto::Timestamp* ResponseHeader::mutable_timestamp() {
-  set_has_timestamp();
-  if (timestamp_ == NULL) {
-    timestamp_ = new ::cockroach::proto::Timestamp;
+inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+}
+
+// optional bool keys_only = 3;
+inline bool ScanRequest::has_keys_only() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanRequest::set_has_keys_only() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanRequest::clear_has_keys_only() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanRequest::clear_keys_only() {
+  keys_only_ = false;
+  clear_has_keys_only();
+}
+inline bool ScanRequest::keys_only() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.keys_only)
+  return keys_only_;
+}
+inline void ScanRequest::set_keys_only(bool value) {
+  set_has_keys_only();
+  keys_only_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.keys_only)
+}
+
+// optional bool reverse = 4;
+inline bool ScanRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void ScanRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void ScanRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void ScanRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+inline bool ScanRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.reverse)
+  return reverse_;
+}
+inline void ScanRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.reverse)
+}
+
+// -------------------------------------------------------------------
+
+// ScanResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ScanResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
+}
+inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.timestamp)
-  return timestamp_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
+  return header_;
 }
-inline ::cockroach::proto::Timestamp* ResponseHeader::release_timestamp() {
-  clear_has_timestamp();
-  ::cockroach::proto::Timestamp* temp = timestamp_;
-  timestamp_ = NULL;
+inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
   return temp;
 }
-inline void ResponseHeader::set_allocated_timestamp(::cockroach::proto::Timestamp* timestamp) {
-  delete timestamp_;
-  timestamp_ = timestamp;
-  if (timestamp) {
-    set_has_timestamp();
+inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
   } else {
-    clear_has_timestamp();
+    clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.timestamp)
-}
-
-// optional .cockroach.proto.Transaction txn = 3;
-inline bool ResponseHeader::has_txn() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
-}
-inline void ResponseHeader::set_has_txn() {
-  _has_bits_[0] |= 0x00000004u;
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
 }
-inline void ResponseHeader::clear_has_txn() {
-  _has_bits_[0] &= ~0x00000004u;
+
+// repeated .cockroach.proto.KeyValue rows = 2;
+inline int ScanResponse::rows_size() const {
+  return rows_.size();
 }
-inline void ResponseHeader::clear_txn() {
-  if (txn_ != NULL) txn_->::cockroach::proto::Transaction::Clear();
-  clear_has_txn();
+inline void ScanResponse::clear_rows() {
+  rows_.Clear();
 }
-inline const ::cockroach::proto::Transaction& ResponseHeader::txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseHeader.txn)
-  return txn_ != NULL ? *txn_ : *default_instance_->txn_;
+inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
+  return rows_.Get(index);
 }
-inline ::cockroach::proto::Transaction* ResponseHeader::mutable_txn() {
-  set_has_txn();
-  if (txn_ == NULL) {
-    txn_ = new ::cockroach::proto::Transaction;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseHeader.txn)
-  return txn_;
+inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
+  return rows_.Mutable(index);
 }
-inline ::cockroach::proto::Transaction* ResponseHeader::release_txn() {
-  clear_has_txn();
-  ::cockroach::proto::Transaction* temp = txn_;
-  txn_ = NULL;
-  return temp;
+inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
+  return rows_.Add();
 }
-inline void ResponseHeader::set_allocated_txn(::cockroach::proto::Transaction* txn) {
-  delete txn_;
-  txn_ = txn;
-  if (txn) {
-    set_has_txn();
-  } else {
-    clear_has_txn();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseHeader.txn)
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
+ScanResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
+ScanResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
+  return &rows_;
 }
 
 // -------------------------------------------------------------------
 
-// GetRequest
+// EndTransactionRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool GetRequest::has_header() const {
+inline bool EndTransactionRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GetRequest::set_has_header() {
+inline void EndTransactionRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GetRequest::clear_has_header() {
+inline void EndTransactionRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GetRequest::clear_header() {
+inline void EndTransactionRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& GetRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetRequest.header)
+inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* GetRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* GetRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3728,46 +7466,113 @@ inline void GetRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
+}
+
+// optional bool commit = 2;
+inline bool EndTransactionRequest::has_commit() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void EndTransactionRequest::set_has_commit() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void EndTransactionRequest::clear_has_commit() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void EndTransactionRequest::clear_commit() {
+  commit_ = false;
+  clear_has_commit();
+}
+inline bool EndTransactionRequest::commit() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
+  return commit_;
+}
+inline void EndTransactionRequest::set_commit(bool value) {
+  set_has_commit();
+  commit_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+}
+
+// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
+inline bool EndTransactionRequest::has_internal_commit_trigger() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void EndTransactionRequest::set_has_internal_commit_trigger() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void EndTransactionRequest::clear_internal_commit_trigger() {
+  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
+  clear_has_internal_commit_trigger();
+}
+inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
+}
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
+  set_has_internal_commit_trigger();
+  if (internal_commit_trigger_ == NULL) {
+    internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+  return internal_commit_trigger_;
+}
+inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
+  clear_has_internal_commit_trigger();
+  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
+  internal_commit_trigger_ = NULL;
+  return temp;
+}
+inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
+  delete internal_commit_trigger_;
+  internal_commit_trigger_ = internal_commit_trigger;
+  if (internal_commit_trigger) {
+    set_has_internal_commit_trigger();
+  } else {
+    clear_has_internal_commit_trigger();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
 }
 
 // -------------------------------------------------------------------
 
-// GetResponse
+// EndTransactionResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool GetResponse::has_header() const {
+inline bool EndTransactionResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void GetResponse::set_has_header() {
+inline void EndTransactionResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void GetResponse::clear_has_header() {
+inline void EndTransactionResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void GetResponse::clear_header() {
+inline void EndTransactionResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& GetResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.header)
+inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* GetResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* GetResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3775,89 +7580,292 @@ inline void GetResponse::set_allocated_header(::cockroach::proto::ResponseHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+}
+
+// optional int64 commit_wait = 2;
+inline bool EndTransactionResponse::has_commit_wait() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void EndTransactionResponse::set_has_commit_wait() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void EndTransactionResponse::clear_has_commit_wait() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void EndTransactionResponse::clear_commit_wait() {
+  commit_wait_ = GOOGLE_LONGLONG(0);
+  clear_has_commit_wait();
+}
+inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
+  return commit_wait_;
+}
+inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
+  set_has_commit_wait();
+  commit_wait_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
+}
+
+// repeated bytes resolved = 3;
+inline int EndTransactionResponse::resolved_size() const {
+  return resolved_.size();
+}
+inline void EndTransactionResponse::clear_resolved() {
+  resolved_.Clear();
+}
+inline const ::std::string& EndTransactionResponse::resolved(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Get(index);
+}
+inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_.Mutable(index);
+}
+inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
+  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
+  resolved_.Mutable(index)->assign(value);
+}
+inline void EndTransactionResponse::set_resolved(int index, const char* value) {
+  resolved_.Mutable(index)->assign(value);
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
+  resolved_.Mutable(index)->assign(
+    reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline ::std::string* EndTransactionResponse::add_resolved() {
+  return resolved_.Add();
+}
+inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const char* value) {
+  resolved_.Add()->assign(value);
+  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
+  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
+  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+}
+inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
+EndTransactionResponse::resolved() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
+  return resolved_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::std::string>*
+EndTransactionResponse::mutable_resolved() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
+  return &resolved_;
+}
+
+// -------------------------------------------------------------------
+
+// RowCell
+
+// optional uint32 column_id = 1;
+inline bool RowCell::has_column_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RowCell::set_has_column_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RowCell::clear_has_column_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RowCell::clear_column_id() {
+  column_id_ = 0u;
+  clear_has_column_id();
+}
+inline ::google::protobuf::uint32 RowCell::column_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowCell.column_id)
+  return column_id_;
+}
+inline void RowCell::set_column_id(::google::protobuf::uint32 value) {
+  set_has_column_id();
+  column_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowCell.column_id)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool GetResponse::has_value() const {
+// optional bytes value = 2;
+inline bool RowCell::has_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void GetResponse::set_has_value() {
+inline void RowCell::set_has_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void GetResponse::clear_has_value() {
+inline void RowCell::clear_has_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void GetResponse::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+inline void RowCell::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
   clear_has_value();
 }
-inline const ::cockroach::proto::Value& GetResponse::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.GetResponse.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline const ::std::string& RowCell::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowCell.value)
+  return value_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::cockroach::proto::Value* GetResponse::mutable_value() {
+inline void RowCell::set_value(const ::std::string& value) {
   set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetResponse.value)
-  return value_;
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowCell.value)
 }
-inline ::cockroach::proto::Value* GetResponse::release_value() {
+inline void RowCell::set_value(const char* value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RowCell.value)
+}
+inline void RowCell::set_value(const void* value, size_t size) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RowCell.value)
+}
+inline ::std::string* RowCell::mutable_value() {
+  set_has_value();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowCell.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RowCell::release_value() {
   clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void GetResponse::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
+inline void RowCell::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
     set_has_value();
   } else {
     clear_has_value();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetResponse.value)
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowCell.value)
 }
 
 // -------------------------------------------------------------------
 
-// PutRequest
+// Row
+
+// optional bytes primary_key = 1;
+inline bool Row::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void Row::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void Row::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void Row::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+inline const ::std::string& Row::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Row.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void Row::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.Row.primary_key)
+}
+inline void Row::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.Row.primary_key)
+}
+inline void Row::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.Row.primary_key)
+}
+inline ::std::string* Row::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Row.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* Row::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void Row::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.Row.primary_key)
+}
+
+// repeated .cockroach.proto.RowCell cells = 2;
+inline int Row::cells_size() const {
+  return cells_.size();
+}
+inline void Row::clear_cells() {
+  cells_.Clear();
+}
+inline const ::cockroach::proto::RowCell& Row::cells(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.Row.cells)
+  return cells_.Get(index);
+}
+inline ::cockroach::proto::RowCell* Row::mutable_cells(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.Row.cells)
+  return cells_.Mutable(index);
+}
+inline ::cockroach::proto::RowCell* Row::add_cells() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.Row.cells)
+  return cells_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >&
+Row::cells() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.Row.cells)
+  return cells_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >*
+Row::mutable_cells() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.Row.cells)
+  return &cells_;
+}
+
+// -------------------------------------------------------------------
+
+// GetRowRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool PutRequest::has_header() const {
+inline bool GetRowRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutRequest::set_has_header() {
+inline void GetRowRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PutRequest::clear_has_header() {
+inline void GetRowRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PutRequest::clear_header() {
+inline void GetRowRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& PutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.header)
+inline const ::cockroach::proto::RequestHeader& GetRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* PutRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* GetRowRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* PutRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* GetRowRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void GetRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3865,89 +7873,201 @@ inline void PutRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowRequest.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool PutRequest::has_value() const {
+// optional uint32 table_id = 2;
+inline bool GetRowRequest::has_table_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void PutRequest::set_has_value() {
+inline void GetRowRequest::set_has_table_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void PutRequest::clear_has_value() {
+inline void GetRowRequest::clear_has_table_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void PutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void GetRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
 }
-inline const ::cockroach::proto::Value& PutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline ::google::protobuf::uint32 GetRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.table_id)
+  return table_id_;
 }
-inline ::cockroach::proto::Value* PutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRequest.value)
-  return value_;
+inline void GetRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.table_id)
 }
-inline ::cockroach::proto::Value* PutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+
+// optional uint32 index_id = 3;
+inline bool GetRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void PutRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void GetRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void GetRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void GetRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+inline ::google::protobuf::uint32 GetRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.index_id)
+  return index_id_;
+}
+inline void GetRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+inline bool GetRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void GetRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void GetRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void GetRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+inline const ::std::string& GetRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void GetRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.primary_key)
+}
+inline void GetRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.GetRowRequest.primary_key)
+}
+inline void GetRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.GetRowRequest.primary_key)
+}
+inline ::std::string* GetRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* GetRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void GetRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
   } else {
-    clear_has_value();
+    clear_has_primary_key();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRequest.value)
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowRequest.primary_key)
+}
+
+// repeated uint32 column_ids = 5;
+inline int GetRowRequest::column_ids_size() const {
+  return column_ids_.size();
+}
+inline void GetRowRequest::clear_column_ids() {
+  column_ids_.Clear();
+}
+inline ::google::protobuf::uint32 GetRowRequest::column_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.column_ids)
+  return column_ids_.Get(index);
+}
+inline void GetRowRequest::set_column_ids(int index, ::google::protobuf::uint32 value) {
+  column_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.column_ids)
+}
+inline void GetRowRequest::add_column_ids(::google::protobuf::uint32 value) {
+  column_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.GetRowRequest.column_ids)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+GetRowRequest::column_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.GetRowRequest.column_ids)
+  return column_ids_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+GetRowRequest::mutable_column_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.GetRowRequest.column_ids)
+  return &column_ids_;
+}
+
+// optional int32 ttl_seconds = 6;
+inline bool GetRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void GetRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void GetRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void GetRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+inline ::google::protobuf::int32 GetRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+inline void GetRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.GetRowRequest.ttl_seconds)
 }
 
 // -------------------------------------------------------------------
 
-// PutResponse
+// GetRowResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool PutResponse::has_header() const {
+inline bool GetRowResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void PutResponse::set_has_header() {
+inline void GetRowResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void PutResponse::clear_has_header() {
+inline void GetRowResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void PutResponse::clear_header() {
+inline void GetRowResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& PutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.PutResponse.header)
+inline const ::cockroach::proto::ResponseHeader& GetRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* PutResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* GetRowResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* PutResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* GetRowResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void GetRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -3955,46 +8075,89 @@ inline void PutResponse::set_allocated_header(::cockroach::proto::ResponseHeader
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowResponse.header)
+}
+
+// optional .cockroach.proto.Row row = 2;
+inline bool GetRowResponse::has_row() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void GetRowResponse::set_has_row() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void GetRowResponse::clear_has_row() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void GetRowResponse::clear_row() {
+  if (row_ != NULL) row_->::cockroach::proto::Row::Clear();
+  clear_has_row();
+}
+inline const ::cockroach::proto::Row& GetRowResponse::row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.GetRowResponse.row)
+  return row_ != NULL ? *row_ : *default_instance_->row_;
+}
+inline ::cockroach::proto::Row* GetRowResponse::mutable_row() {
+  set_has_row();
+  if (row_ == NULL) {
+    row_ = new ::cockroach::proto::Row;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.GetRowResponse.row)
+  return row_;
+}
+inline ::cockroach::proto::Row* GetRowResponse::release_row() {
+  clear_has_row();
+  ::cockroach::proto::Row* temp = row_;
+  row_ = NULL;
+  return temp;
+}
+inline void GetRowResponse::set_allocated_row(::cockroach::proto::Row* row) {
+  delete row_;
+  row_ = row;
+  if (row) {
+    set_has_row();
+  } else {
+    clear_has_row();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.GetRowResponse.row)
 }
 
 // -------------------------------------------------------------------
 
-// ConditionalPutRequest
+// PutRowRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool ConditionalPutRequest::has_header() const {
+inline bool PutRowRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutRequest::set_has_header() {
+inline void PutRowRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ConditionalPutRequest::clear_has_header() {
+inline void PutRowRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ConditionalPutRequest::clear_header() {
+inline void PutRowRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& ConditionalPutRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.header)
+inline const ::cockroach::proto::RequestHeader& PutRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* PutRowRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* ConditionalPutRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* PutRowRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void PutRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4002,132 +8165,201 @@ inline void ConditionalPutRequest::set_allocated_header(::cockroach::proto::Requ
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRowRequest.header)
 }
 
-// optional .cockroach.proto.Value value = 2;
-inline bool ConditionalPutRequest::has_value() const {
+// optional uint32 table_id = 2;
+inline bool PutRowRequest::has_table_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ConditionalPutRequest::set_has_value() {
+inline void PutRowRequest::set_has_table_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ConditionalPutRequest::clear_has_value() {
+inline void PutRowRequest::clear_has_table_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ConditionalPutRequest::clear_value() {
-  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
-  clear_has_value();
+inline void PutRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
 }
-inline const ::cockroach::proto::Value& ConditionalPutRequest::value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.value)
-  return value_ != NULL ? *value_ : *default_instance_->value_;
+inline ::google::protobuf::uint32 PutRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.table_id)
+  return table_id_;
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_value() {
-  set_has_value();
-  if (value_ == NULL) {
-    value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.value)
-  return value_;
+inline void PutRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.table_id)
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::release_value() {
-  clear_has_value();
-  ::cockroach::proto::Value* temp = value_;
-  value_ = NULL;
-  return temp;
+
+// optional uint32 index_id = 3;
+inline bool PutRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void ConditionalPutRequest::set_allocated_value(::cockroach::proto::Value* value) {
-  delete value_;
-  value_ = value;
-  if (value) {
-    set_has_value();
+inline void PutRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void PutRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void PutRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+inline ::google::protobuf::uint32 PutRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.index_id)
+  return index_id_;
+}
+inline void PutRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+inline bool PutRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void PutRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void PutRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void PutRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+inline const ::std::string& PutRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void PutRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.primary_key)
+}
+inline void PutRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.PutRowRequest.primary_key)
+}
+inline void PutRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.PutRowRequest.primary_key)
+}
+inline ::std::string* PutRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* PutRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void PutRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
   } else {
-    clear_has_value();
+    clear_has_primary_key();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.value)
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRowRequest.primary_key)
 }
 
-// optional .cockroach.proto.Value exp_value = 3;
-inline bool ConditionalPutRequest::has_exp_value() const {
-  return (_has_bits_[0] & 0x00000004u) != 0;
+// repeated .cockroach.proto.RowCell cells = 5;
+inline int PutRowRequest::cells_size() const {
+  return cells_.size();
 }
-inline void ConditionalPutRequest::set_has_exp_value() {
-  _has_bits_[0] |= 0x00000004u;
+inline void PutRowRequest::clear_cells() {
+  cells_.Clear();
 }
-inline void ConditionalPutRequest::clear_has_exp_value() {
-  _has_bits_[0] &= ~0x00000004u;
+inline const ::cockroach::proto::RowCell& PutRowRequest::cells(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.cells)
+  return cells_.Get(index);
 }
-inline void ConditionalPutRequest::clear_exp_value() {
-  if (exp_value_ != NULL) exp_value_->::cockroach::proto::Value::Clear();
-  clear_has_exp_value();
+inline ::cockroach::proto::RowCell* PutRowRequest::mutable_cells(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowRequest.cells)
+  return cells_.Mutable(index);
+}
+inline ::cockroach::proto::RowCell* PutRowRequest::add_cells() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.PutRowRequest.cells)
+  return cells_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >&
+PutRowRequest::cells() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.PutRowRequest.cells)
+  return cells_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowCell >*
+PutRowRequest::mutable_cells() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.PutRowRequest.cells)
+  return &cells_;
+}
+
+// optional int32 ttl_seconds = 6;
+inline bool PutRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void PutRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000020u;
 }
-inline const ::cockroach::proto::Value& ConditionalPutRequest::exp_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutRequest.exp_value)
-  return exp_value_ != NULL ? *exp_value_ : *default_instance_->exp_value_;
+inline void PutRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000020u;
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::mutable_exp_value() {
-  set_has_exp_value();
-  if (exp_value_ == NULL) {
-    exp_value_ = new ::cockroach::proto::Value;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutRequest.exp_value)
-  return exp_value_;
+inline void PutRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
 }
-inline ::cockroach::proto::Value* ConditionalPutRequest::release_exp_value() {
-  clear_has_exp_value();
-  ::cockroach::proto::Value* temp = exp_value_;
-  exp_value_ = NULL;
-  return temp;
+inline ::google::protobuf::int32 PutRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowRequest.ttl_seconds)
+  return ttl_seconds_;
 }
-inline void ConditionalPutRequest::set_allocated_exp_value(::cockroach::proto::Value* exp_value) {
-  delete exp_value_;
-  exp_value_ = exp_value;
-  if (exp_value) {
-    set_has_exp_value();
-  } else {
-    clear_has_exp_value();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutRequest.exp_value)
+inline void PutRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.PutRowRequest.ttl_seconds)
 }
 
 // -------------------------------------------------------------------
 
-// ConditionalPutResponse
+// PutRowResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ConditionalPutResponse::has_header() const {
+inline bool PutRowResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ConditionalPutResponse::set_has_header() {
+inline void PutRowResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ConditionalPutResponse::clear_has_header() {
+inline void PutRowResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ConditionalPutResponse::clear_header() {
+inline void PutRowResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& ConditionalPutResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ConditionalPutResponse.header)
+inline const ::cockroach::proto::ResponseHeader& PutRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutRowResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* PutRowResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ConditionalPutResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutRowResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* ConditionalPutResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* PutRowResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void PutRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4135,46 +8367,46 @@ inline void ConditionalPutResponse::set_allocated_header(::cockroach::proto::Res
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ConditionalPutResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutRowResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// IncrementRequest
+// DeleteRowRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool IncrementRequest::has_header() const {
+inline bool DeleteRowRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void IncrementRequest::set_has_header() {
+inline void DeleteRowRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void IncrementRequest::clear_has_header() {
+inline void DeleteRowRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void IncrementRequest::clear_header() {
+inline void DeleteRowRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& IncrementRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.header)
+inline const ::cockroach::proto::RequestHeader& DeleteRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* IncrementRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* DeleteRowRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRowRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* IncrementRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* DeleteRowRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void DeleteRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4182,70 +8414,171 @@ inline void IncrementRequest::set_allocated_header(::cockroach::proto::RequestHe
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRowRequest.header)
 }
 
-// optional int64 increment = 2;
-inline bool IncrementRequest::has_increment() const {
+// optional uint32 table_id = 2;
+inline bool DeleteRowRequest::has_table_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void IncrementRequest::set_has_increment() {
+inline void DeleteRowRequest::set_has_table_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void IncrementRequest::clear_has_increment() {
+inline void DeleteRowRequest::clear_has_table_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void IncrementRequest::clear_increment() {
-  increment_ = GOOGLE_LONGLONG(0);
-  clear_has_increment();
+inline void DeleteRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
 }
-inline ::google::protobuf::int64 IncrementRequest::increment() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementRequest.increment)
-  return increment_;
+inline ::google::protobuf::uint32 DeleteRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.table_id)
+  return table_id_;
 }
-inline void IncrementRequest::set_increment(::google::protobuf::int64 value) {
-  set_has_increment();
-  increment_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementRequest.increment)
+inline void DeleteRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+inline bool DeleteRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void DeleteRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void DeleteRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void DeleteRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+inline ::google::protobuf::uint32 DeleteRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.index_id)
+  return index_id_;
+}
+inline void DeleteRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+inline bool DeleteRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void DeleteRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void DeleteRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void DeleteRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+inline const ::std::string& DeleteRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void DeleteRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.primary_key)
+}
+inline void DeleteRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.DeleteRowRequest.primary_key)
+}
+inline void DeleteRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.DeleteRowRequest.primary_key)
+}
+inline ::std::string* DeleteRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* DeleteRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void DeleteRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRowRequest.primary_key)
+}
+
+// optional int32 ttl_seconds = 5;
+inline bool DeleteRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void DeleteRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void DeleteRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void DeleteRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+inline ::google::protobuf::int32 DeleteRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+inline void DeleteRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowRequest.ttl_seconds)
 }
 
 // -------------------------------------------------------------------
 
-// IncrementResponse
+// DeleteRowResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool IncrementResponse::has_header() const {
+inline bool DeleteRowResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void IncrementResponse::set_has_header() {
+inline void DeleteRowResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void IncrementResponse::clear_has_header() {
+inline void DeleteRowResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void IncrementResponse::clear_header() {
+inline void DeleteRowResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& IncrementResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.header)
+inline const ::cockroach::proto::ResponseHeader& DeleteRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* IncrementResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* DeleteRowResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.IncrementResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRowResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* IncrementResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* DeleteRowResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void IncrementResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void DeleteRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4253,70 +8586,403 @@ inline void IncrementResponse::set_allocated_header(::cockroach::proto::Response
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.IncrementResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRowResponse.header)
 }
 
-// optional int64 new_value = 2;
-inline bool IncrementResponse::has_new_value() const {
+// optional bool deleted = 2;
+inline bool DeleteRowResponse::has_deleted() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void IncrementResponse::set_has_new_value() {
+inline void DeleteRowResponse::set_has_deleted() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void IncrementResponse::clear_has_new_value() {
+inline void DeleteRowResponse::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void DeleteRowResponse::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+inline bool DeleteRowResponse::deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRowResponse.deleted)
+  return deleted_;
+}
+inline void DeleteRowResponse::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRowResponse.deleted)
+}
+
+// -------------------------------------------------------------------
+
+// RowFilter
+
+// optional uint32 column_id = 1;
+inline bool RowFilter::has_column_id() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RowFilter::set_has_column_id() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RowFilter::clear_has_column_id() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RowFilter::clear_column_id() {
+  column_id_ = 0u;
+  clear_has_column_id();
+}
+inline ::google::protobuf::uint32 RowFilter::column_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.column_id)
+  return column_id_;
+}
+inline void RowFilter::set_column_id(::google::protobuf::uint32 value) {
+  set_has_column_id();
+  column_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.column_id)
+}
+
+// optional .cockroach.proto.RowFilter.Op op = 2;
+inline bool RowFilter::has_op() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RowFilter::set_has_op() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RowFilter::clear_has_op() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RowFilter::clear_op() {
+  op_ = 0;
+  clear_has_op();
+}
+inline ::cockroach::proto::RowFilter_Op RowFilter::op() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.op)
+  return static_cast< ::cockroach::proto::RowFilter_Op >(op_);
+}
+inline void RowFilter::set_op(::cockroach::proto::RowFilter_Op value) {
+  assert(::cockroach::proto::RowFilter_Op_IsValid(value));
+  set_has_op();
+  op_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.op)
+}
+
+// optional .cockroach.proto.RowFilter.Type type = 3;
+inline bool RowFilter::has_type() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RowFilter::set_has_type() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RowFilter::clear_has_type() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RowFilter::clear_type() {
+  type_ = 0;
+  clear_has_type();
+}
+inline ::cockroach::proto::RowFilter_Type RowFilter::type() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.type)
+  return static_cast< ::cockroach::proto::RowFilter_Type >(type_);
+}
+inline void RowFilter::set_type(::cockroach::proto::RowFilter_Type value) {
+  assert(::cockroach::proto::RowFilter_Type_IsValid(value));
+  set_has_type();
+  type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.type)
+}
+
+// optional bytes value = 4;
+inline bool RowFilter::has_value() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void RowFilter::set_has_value() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void RowFilter::clear_has_value() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void RowFilter::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_value();
+}
+inline const ::std::string& RowFilter::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowFilter.value)
+  return value_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RowFilter::set_value(const ::std::string& value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowFilter.value)
+}
+inline void RowFilter::set_value(const char* value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RowFilter.value)
+}
+inline void RowFilter::set_value(const void* value, size_t size) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RowFilter.value)
+}
+inline ::std::string* RowFilter::mutable_value() {
+  set_has_value();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowFilter.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* RowFilter::release_value() {
+  clear_has_value();
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RowFilter::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowFilter.value)
+}
+
+// -------------------------------------------------------------------
+
+// RowAggregate
+
+// optional .cockroach.proto.RowAggregate.Func func = 1;
+inline bool RowAggregate::has_func() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RowAggregate::set_has_func() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RowAggregate::clear_has_func() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RowAggregate::clear_func() {
+  func_ = 0;
+  clear_has_func();
+}
+inline ::cockroach::proto::RowAggregate_Func RowAggregate::func() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregate.func)
+  return static_cast< ::cockroach::proto::RowAggregate_Func >(func_);
+}
+inline void RowAggregate::set_func(::cockroach::proto::RowAggregate_Func value) {
+  assert(::cockroach::proto::RowAggregate_Func_IsValid(value));
+  set_has_func();
+  func_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregate.func)
+}
+
+// optional uint32 column_id = 2;
+inline bool RowAggregate::has_column_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RowAggregate::set_has_column_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RowAggregate::clear_has_column_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void RowAggregate::clear_column_id() {
+  column_id_ = 0u;
+  clear_has_column_id();
+}
+inline ::google::protobuf::uint32 RowAggregate::column_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregate.column_id)
+  return column_id_;
+}
+inline void RowAggregate::set_column_id(::google::protobuf::uint32 value) {
+  set_has_column_id();
+  column_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregate.column_id)
+}
+
+// optional .cockroach.proto.RowFilter.Type type = 3;
+inline bool RowAggregate::has_type() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RowAggregate::set_has_type() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RowAggregate::clear_has_type() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RowAggregate::clear_type() {
+  type_ = 0;
+  clear_has_type();
+}
+inline ::cockroach::proto::RowFilter_Type RowAggregate::type() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregate.type)
+  return static_cast< ::cockroach::proto::RowFilter_Type >(type_);
+}
+inline void RowAggregate::set_type(::cockroach::proto::RowFilter_Type value) {
+  assert(::cockroach::proto::RowFilter_Type_IsValid(value));
+  set_has_type();
+  type_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregate.type)
+}
+
+// -------------------------------------------------------------------
+
+// RowAggregateResult
+
+// optional .cockroach.proto.RowAggregate aggregate = 1;
+inline bool RowAggregateResult::has_aggregate() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void RowAggregateResult::set_has_aggregate() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void RowAggregateResult::clear_has_aggregate() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void RowAggregateResult::clear_aggregate() {
+  if (aggregate_ != NULL) aggregate_->::cockroach::proto::RowAggregate::Clear();
+  clear_has_aggregate();
+}
+inline const ::cockroach::proto::RowAggregate& RowAggregateResult::aggregate() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregateResult.aggregate)
+  return aggregate_ != NULL ? *aggregate_ : *default_instance_->aggregate_;
+}
+inline ::cockroach::proto::RowAggregate* RowAggregateResult::mutable_aggregate() {
+  set_has_aggregate();
+  if (aggregate_ == NULL) {
+    aggregate_ = new ::cockroach::proto::RowAggregate;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowAggregateResult.aggregate)
+  return aggregate_;
+}
+inline ::cockroach::proto::RowAggregate* RowAggregateResult::release_aggregate() {
+  clear_has_aggregate();
+  ::cockroach::proto::RowAggregate* temp = aggregate_;
+  aggregate_ = NULL;
+  return temp;
+}
+inline void RowAggregateResult::set_allocated_aggregate(::cockroach::proto::RowAggregate* aggregate) {
+  delete aggregate_;
+  aggregate_ = aggregate;
+  if (aggregate) {
+    set_has_aggregate();
+  } else {
+    clear_has_aggregate();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowAggregateResult.aggregate)
+}
+
+// optional int64 count = 2;
+inline bool RowAggregateResult::has_count() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void RowAggregateResult::set_has_count() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void RowAggregateResult::clear_has_count() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void IncrementResponse::clear_new_value() {
-  new_value_ = GOOGLE_LONGLONG(0);
-  clear_has_new_value();
+inline void RowAggregateResult::clear_count() {
+  count_ = GOOGLE_LONGLONG(0);
+  clear_has_count();
+}
+inline ::google::protobuf::int64 RowAggregateResult::count() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregateResult.count)
+  return count_;
+}
+inline void RowAggregateResult::set_count(::google::protobuf::int64 value) {
+  set_has_count();
+  count_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregateResult.count)
+}
+
+// optional bytes value = 3;
+inline bool RowAggregateResult::has_value() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void RowAggregateResult::set_has_value() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void RowAggregateResult::clear_has_value() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void RowAggregateResult::clear_value() {
+  value_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_value();
+}
+inline const ::std::string& RowAggregateResult::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RowAggregateResult.value)
+  return value_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RowAggregateResult::set_value(const ::std::string& value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.RowAggregateResult.value)
+}
+inline void RowAggregateResult::set_value(const char* value) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.RowAggregateResult.value)
+}
+inline void RowAggregateResult::set_value(const void* value, size_t size) {
+  set_has_value();
+  value_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.RowAggregateResult.value)
 }
-inline ::google::protobuf::int64 IncrementResponse::new_value() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.IncrementResponse.new_value)
-  return new_value_;
+inline ::std::string* RowAggregateResult::mutable_value() {
+  set_has_value();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RowAggregateResult.value)
+  return value_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline void IncrementResponse::set_new_value(::google::protobuf::int64 value) {
-  set_has_new_value();
-  new_value_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.IncrementResponse.new_value)
+inline ::std::string* RowAggregateResult::release_value() {
+  clear_has_value();
+  return value_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void RowAggregateResult::set_allocated_value(::std::string* value) {
+  if (value != NULL) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  value_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RowAggregateResult.value)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRequest
+// ScanRowsRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool DeleteRequest::has_header() const {
+inline bool ScanRowsRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRequest::set_has_header() {
+inline void ScanRowsRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRequest::clear_has_header() {
+inline void ScanRowsRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRequest::clear_header() {
+inline void ScanRowsRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& DeleteRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRequest.header)
+inline const ::cockroach::proto::RequestHeader& ScanRowsRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ScanRowsRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* ScanRowsRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ScanRowsRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4324,46 +8990,232 @@ inline void DeleteRequest::set_allocated_header(::cockroach::proto::RequestHeade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRowsRequest.header)
+}
+
+// optional uint32 table_id = 2;
+inline bool ScanRowsRequest::has_table_id() const {
+  return (_has_bits_[0] & 0x00000002u) != 0;
+}
+inline void ScanRowsRequest::set_has_table_id() {
+  _has_bits_[0] |= 0x00000002u;
+}
+inline void ScanRowsRequest::clear_has_table_id() {
+  _has_bits_[0] &= ~0x00000002u;
+}
+inline void ScanRowsRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
+}
+inline ::google::protobuf::uint32 ScanRowsRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.table_id)
+  return table_id_;
+}
+inline void ScanRowsRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+inline bool ScanRowsRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void ScanRowsRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void ScanRowsRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void ScanRowsRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+inline ::google::protobuf::uint32 ScanRowsRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.index_id)
+  return index_id_;
+}
+inline void ScanRowsRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.index_id)
+}
+
+// repeated uint32 column_ids = 4;
+inline int ScanRowsRequest::column_ids_size() const {
+  return column_ids_.size();
+}
+inline void ScanRowsRequest::clear_column_ids() {
+  column_ids_.Clear();
+}
+inline ::google::protobuf::uint32 ScanRowsRequest::column_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.column_ids)
+  return column_ids_.Get(index);
+}
+inline void ScanRowsRequest::set_column_ids(int index, ::google::protobuf::uint32 value) {
+  column_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.column_ids)
+}
+inline void ScanRowsRequest::add_column_ids(::google::protobuf::uint32 value) {
+  column_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsRequest.column_ids)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+ScanRowsRequest::column_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsRequest.column_ids)
+  return column_ids_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+ScanRowsRequest::mutable_column_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsRequest.column_ids)
+  return &column_ids_;
+}
+
+// optional int64 max_rows = 5;
+inline bool ScanRowsRequest::has_max_rows() const {
+  return (_has_bits_[0] & 0x00000010u) != 0;
+}
+inline void ScanRowsRequest::set_has_max_rows() {
+  _has_bits_[0] |= 0x00000010u;
+}
+inline void ScanRowsRequest::clear_has_max_rows() {
+  _has_bits_[0] &= ~0x00000010u;
+}
+inline void ScanRowsRequest::clear_max_rows() {
+  max_rows_ = GOOGLE_LONGLONG(0);
+  clear_has_max_rows();
+}
+inline ::google::protobuf::int64 ScanRowsRequest::max_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.max_rows)
+  return max_rows_;
+}
+inline void ScanRowsRequest::set_max_rows(::google::protobuf::int64 value) {
+  set_has_max_rows();
+  max_rows_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.max_rows)
+}
+
+// repeated .cockroach.proto.RowFilter filters = 6;
+inline int ScanRowsRequest::filters_size() const {
+  return filters_.size();
+}
+inline void ScanRowsRequest::clear_filters() {
+  filters_.Clear();
+}
+inline const ::cockroach::proto::RowFilter& ScanRowsRequest::filters(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.filters)
+  return filters_.Get(index);
+}
+inline ::cockroach::proto::RowFilter* ScanRowsRequest::mutable_filters(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsRequest.filters)
+  return filters_.Mutable(index);
+}
+inline ::cockroach::proto::RowFilter* ScanRowsRequest::add_filters() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsRequest.filters)
+  return filters_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter >&
+ScanRowsRequest::filters() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsRequest.filters)
+  return filters_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowFilter >*
+ScanRowsRequest::mutable_filters() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsRequest.filters)
+  return &filters_;
+}
+
+// repeated .cockroach.proto.RowAggregate aggregates = 7;
+inline int ScanRowsRequest::aggregates_size() const {
+  return aggregates_.size();
+}
+inline void ScanRowsRequest::clear_aggregates() {
+  aggregates_.Clear();
+}
+inline const ::cockroach::proto::RowAggregate& ScanRowsRequest::aggregates(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_.Get(index);
+}
+inline ::cockroach::proto::RowAggregate* ScanRowsRequest::mutable_aggregates(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_.Mutable(index);
+}
+inline ::cockroach::proto::RowAggregate* ScanRowsRequest::add_aggregates() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate >&
+ScanRowsRequest::aggregates() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsRequest.aggregates)
+  return aggregates_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregate >*
+ScanRowsRequest::mutable_aggregates() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsRequest.aggregates)
+  return &aggregates_;
+}
+
+// optional int32 ttl_seconds = 8;
+inline bool ScanRowsRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000080u) != 0;
+}
+inline void ScanRowsRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000080u;
+}
+inline void ScanRowsRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000080u;
+}
+inline void ScanRowsRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+inline ::google::protobuf::int32 ScanRowsRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+inline void ScanRowsRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRowsRequest.ttl_seconds)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteResponse
+// ScanRowsResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool DeleteResponse::has_header() const {
+inline bool ScanRowsResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteResponse::set_has_header() {
+inline void ScanRowsResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteResponse::clear_has_header() {
+inline void ScanRowsResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteResponse::clear_header() {
+inline void ScanRowsResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& DeleteResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteResponse.header)
+inline const ::cockroach::proto::ResponseHeader& ScanRowsResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* DeleteResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* ScanRowsResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* DeleteResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* ScanRowsResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void ScanRowsResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4371,46 +9223,106 @@ inline void DeleteResponse::set_allocated_header(::cockroach::proto::ResponseHea
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRowsResponse.header)
+}
+
+// repeated .cockroach.proto.Row rows = 2;
+inline int ScanRowsResponse::rows_size() const {
+  return rows_.size();
+}
+inline void ScanRowsResponse::clear_rows() {
+  rows_.Clear();
+}
+inline const ::cockroach::proto::Row& ScanRowsResponse::rows(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsResponse.rows)
+  return rows_.Get(index);
+}
+inline ::cockroach::proto::Row* ScanRowsResponse::mutable_rows(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsResponse.rows)
+  return rows_.Mutable(index);
+}
+inline ::cockroach::proto::Row* ScanRowsResponse::add_rows() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsResponse.rows)
+  return rows_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row >&
+ScanRowsResponse::rows() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsResponse.rows)
+  return rows_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::Row >*
+ScanRowsResponse::mutable_rows() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsResponse.rows)
+  return &rows_;
+}
+
+// repeated .cockroach.proto.RowAggregateResult aggregates = 3;
+inline int ScanRowsResponse::aggregates_size() const {
+  return aggregates_.size();
+}
+inline void ScanRowsResponse::clear_aggregates() {
+  aggregates_.Clear();
+}
+inline const ::cockroach::proto::RowAggregateResult& ScanRowsResponse::aggregates(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_.Get(index);
+}
+inline ::cockroach::proto::RowAggregateResult* ScanRowsResponse::mutable_aggregates(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_.Mutable(index);
+}
+inline ::cockroach::proto::RowAggregateResult* ScanRowsResponse::add_aggregates() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_.Add();
+}
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult >&
+ScanRowsResponse::aggregates() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanRowsResponse.aggregates)
+  return aggregates_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::RowAggregateResult >*
+ScanRowsResponse::mutable_aggregates() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanRowsResponse.aggregates)
+  return &aggregates_;
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeRequest
+// LockRowRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool DeleteRangeRequest::has_header() const {
+inline bool LockRowRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeRequest::set_has_header() {
+inline void LockRowRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeRequest::clear_has_header() {
+inline void LockRowRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeRequest::clear_header() {
+inline void LockRowRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& DeleteRangeRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.header)
+inline const ::cockroach::proto::RequestHeader& LockRowRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* LockRowRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* DeleteRangeRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* LockRowRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void LockRowRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4418,70 +9330,201 @@ inline void DeleteRangeRequest::set_allocated_header(::cockroach::proto::Request
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowRequest.header)
 }
 
-// optional int64 max_entries_to_delete = 2;
-inline bool DeleteRangeRequest::has_max_entries_to_delete() const {
+// optional uint32 table_id = 2;
+inline bool LockRowRequest::has_table_id() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void DeleteRangeRequest::set_has_max_entries_to_delete() {
+inline void LockRowRequest::set_has_table_id() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void DeleteRangeRequest::clear_has_max_entries_to_delete() {
+inline void LockRowRequest::clear_has_table_id() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void DeleteRangeRequest::clear_max_entries_to_delete() {
-  max_entries_to_delete_ = GOOGLE_LONGLONG(0);
-  clear_has_max_entries_to_delete();
+inline void LockRowRequest::clear_table_id() {
+  table_id_ = 0u;
+  clear_has_table_id();
 }
-inline ::google::protobuf::int64 DeleteRangeRequest::max_entries_to_delete() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
-  return max_entries_to_delete_;
+inline ::google::protobuf::uint32 LockRowRequest::table_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.table_id)
+  return table_id_;
 }
-inline void DeleteRangeRequest::set_max_entries_to_delete(::google::protobuf::int64 value) {
-  set_has_max_entries_to_delete();
-  max_entries_to_delete_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeRequest.max_entries_to_delete)
+inline void LockRowRequest::set_table_id(::google::protobuf::uint32 value) {
+  set_has_table_id();
+  table_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.table_id)
+}
+
+// optional uint32 index_id = 3;
+inline bool LockRowRequest::has_index_id() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
+}
+inline void LockRowRequest::set_has_index_id() {
+  _has_bits_[0] |= 0x00000004u;
+}
+inline void LockRowRequest::clear_has_index_id() {
+  _has_bits_[0] &= ~0x00000004u;
+}
+inline void LockRowRequest::clear_index_id() {
+  index_id_ = 0u;
+  clear_has_index_id();
+}
+inline ::google::protobuf::uint32 LockRowRequest::index_id() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.index_id)
+  return index_id_;
+}
+inline void LockRowRequest::set_index_id(::google::protobuf::uint32 value) {
+  set_has_index_id();
+  index_id_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.index_id)
+}
+
+// optional bytes primary_key = 4;
+inline bool LockRowRequest::has_primary_key() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void LockRowRequest::set_has_primary_key() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void LockRowRequest::clear_has_primary_key() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void LockRowRequest::clear_primary_key() {
+  primary_key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_primary_key();
+}
+inline const ::std::string& LockRowRequest::primary_key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.primary_key)
+  return primary_key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void LockRowRequest::set_primary_key(const ::std::string& value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.primary_key)
+}
+inline void LockRowRequest::set_primary_key(const char* value) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.LockRowRequest.primary_key)
+}
+inline void LockRowRequest::set_primary_key(const void* value, size_t size) {
+  set_has_primary_key();
+  primary_key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.LockRowRequest.primary_key)
+}
+inline ::std::string* LockRowRequest::mutable_primary_key() {
+  set_has_primary_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowRequest.primary_key)
+  return primary_key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* LockRowRequest::release_primary_key() {
+  clear_has_primary_key();
+  return primary_key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void LockRowRequest::set_allocated_primary_key(::std::string* primary_key) {
+  if (primary_key != NULL) {
+    set_has_primary_key();
+  } else {
+    clear_has_primary_key();
+  }
+  primary_key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), primary_key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowRequest.primary_key)
+}
+
+// repeated uint32 column_ids = 5;
+inline int LockRowRequest::column_ids_size() const {
+  return column_ids_.size();
+}
+inline void LockRowRequest::clear_column_ids() {
+  column_ids_.Clear();
+}
+inline ::google::protobuf::uint32 LockRowRequest::column_ids(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.column_ids)
+  return column_ids_.Get(index);
+}
+inline void LockRowRequest::set_column_ids(int index, ::google::protobuf::uint32 value) {
+  column_ids_.Set(index, value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.column_ids)
+}
+inline void LockRowRequest::add_column_ids(::google::protobuf::uint32 value) {
+  column_ids_.Add(value);
+  // @@protoc_insertion_point(field_add:cockroach.proto.LockRowRequest.column_ids)
+}
+inline const ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >&
+LockRowRequest::column_ids() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.LockRowRequest.column_ids)
+  return column_ids_;
+}
+inline ::google::protobuf::RepeatedField< ::google::protobuf::uint32 >*
+LockRowRequest::mutable_column_ids() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.LockRowRequest.column_ids)
+  return &column_ids_;
+}
+
+// optional int32 ttl_seconds = 6;
+inline bool LockRowRequest::has_ttl_seconds() const {
+  return (_has_bits_[0] & 0x00000020u) != 0;
+}
+inline void LockRowRequest::set_has_ttl_seconds() {
+  _has_bits_[0] |= 0x00000020u;
+}
+inline void LockRowRequest::clear_has_ttl_seconds() {
+  _has_bits_[0] &= ~0x00000020u;
+}
+inline void LockRowRequest::clear_ttl_seconds() {
+  ttl_seconds_ = 0;
+  clear_has_ttl_seconds();
+}
+inline ::google::protobuf::int32 LockRowRequest::ttl_seconds() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowRequest.ttl_seconds)
+  return ttl_seconds_;
+}
+inline void LockRowRequest::set_ttl_seconds(::google::protobuf::int32 value) {
+  set_has_ttl_seconds();
+  ttl_seconds_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.LockRowRequest.ttl_seconds)
 }
 
 // -------------------------------------------------------------------
 
-// DeleteRangeResponse
+// LockRowResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool DeleteRangeResponse::has_header() const {
+inline bool LockRowResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void DeleteRangeResponse::set_has_header() {
+inline void LockRowResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void DeleteRangeResponse::clear_has_header() {
+inline void LockRowResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void DeleteRangeResponse::clear_header() {
+inline void LockRowResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& DeleteRangeResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.header)
+inline const ::cockroach::proto::ResponseHeader& LockRowResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* LockRowResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.DeleteRangeResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* DeleteRangeResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* LockRowResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void LockRowResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4489,70 +9532,89 @@ inline void DeleteRangeResponse::set_allocated_header(::cockroach::proto::Respon
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.DeleteRangeResponse.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowResponse.header)
 }
 
-// optional int64 num_deleted = 2;
-inline bool DeleteRangeResponse::has_num_deleted() const {
+// optional .cockroach.proto.Row row = 2;
+inline bool LockRowResponse::has_row() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void DeleteRangeResponse::set_has_num_deleted() {
+inline void LockRowResponse::set_has_row() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void DeleteRangeResponse::clear_has_num_deleted() {
+inline void LockRowResponse::clear_has_row() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void DeleteRangeResponse::clear_num_deleted() {
-  num_deleted_ = GOOGLE_LONGLONG(0);
-  clear_has_num_deleted();
+inline void LockRowResponse::clear_row() {
+  if (row_ != NULL) row_->::cockroach::proto::Row::Clear();
+  clear_has_row();
 }
-inline ::google::protobuf::int64 DeleteRangeResponse::num_deleted() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.DeleteRangeResponse.num_deleted)
-  return num_deleted_;
+inline const ::cockroach::proto::Row& LockRowResponse::row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.LockRowResponse.row)
+  return row_ != NULL ? *row_ : *default_instance_->row_;
 }
-inline void DeleteRangeResponse::set_num_deleted(::google::protobuf::int64 value) {
-  set_has_num_deleted();
-  num_deleted_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.DeleteRangeResponse.num_deleted)
+inline ::cockroach::proto::Row* LockRowResponse::mutable_row() {
+  set_has_row();
+  if (row_ == NULL) {
+    row_ = new ::cockroach::proto::Row;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.LockRowResponse.row)
+  return row_;
+}
+inline ::cockroach::proto::Row* LockRowResponse::release_row() {
+  clear_has_row();
+  ::cockroach::proto::Row* temp = row_;
+  row_ = NULL;
+  return temp;
+}
+inline void LockRowResponse::set_allocated_row(::cockroach::proto::Row* row) {
+  delete row_;
+  row_ = row;
+  if (row) {
+    set_has_row();
+  } else {
+    clear_has_row();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.LockRowResponse.row)
 }
 
 // -------------------------------------------------------------------
 
-// ScanRequest
+// PutUniqueRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool ScanRequest::has_header() const {
+inline bool PutUniqueRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanRequest::set_has_header() {
+inline void PutUniqueRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanRequest::clear_has_header() {
+inline void PutUniqueRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanRequest::clear_header() {
+inline void PutUniqueRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& ScanRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.header)
+inline const ::cockroach::proto::RequestHeader& PutUniqueRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutUniqueRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* PutUniqueRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutUniqueRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* ScanRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* PutUniqueRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void PutUniqueRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4560,70 +9622,89 @@ inline void ScanRequest::set_allocated_header(::cockroach::proto::RequestHeader*
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutUniqueRequest.header)
 }
 
-// optional int64 max_results = 2;
-inline bool ScanRequest::has_max_results() const {
+// optional .cockroach.proto.Value value = 2;
+inline bool PutUniqueRequest::has_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void ScanRequest::set_has_max_results() {
+inline void PutUniqueRequest::set_has_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void ScanRequest::clear_has_max_results() {
+inline void PutUniqueRequest::clear_has_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void ScanRequest::clear_max_results() {
-  max_results_ = GOOGLE_LONGLONG(0);
-  clear_has_max_results();
+inline void PutUniqueRequest::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
+}
+inline const ::cockroach::proto::Value& PutUniqueRequest::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutUniqueRequest.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
+}
+inline ::cockroach::proto::Value* PutUniqueRequest::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutUniqueRequest.value)
+  return value_;
 }
-inline ::google::protobuf::int64 ScanRequest::max_results() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanRequest.max_results)
-  return max_results_;
+inline ::cockroach::proto::Value* PutUniqueRequest::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
 }
-inline void ScanRequest::set_max_results(::google::protobuf::int64 value) {
-  set_has_max_results();
-  max_results_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.ScanRequest.max_results)
+inline void PutUniqueRequest::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutUniqueRequest.value)
 }
 
 // -------------------------------------------------------------------
 
-// ScanResponse
+// PutUniqueResponse
 
 // optional .cockroach.proto.ResponseHeader header = 1;
-inline bool ScanResponse::has_header() const {
+inline bool PutUniqueResponse::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void ScanResponse::set_has_header() {
+inline void PutUniqueResponse::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void ScanResponse::clear_has_header() {
+inline void PutUniqueResponse::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void ScanResponse::clear_header() {
+inline void PutUniqueResponse::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::ResponseHeader& ScanResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.header)
+inline const ::cockroach::proto::ResponseHeader& PutUniqueResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.PutUniqueResponse.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::mutable_header() {
+inline ::cockroach::proto::ResponseHeader* PutUniqueResponse::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::ResponseHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.PutUniqueResponse.header)
   return header_;
 }
-inline ::cockroach::proto::ResponseHeader* ScanResponse::release_header() {
+inline ::cockroach::proto::ResponseHeader* PutUniqueResponse::release_header() {
   clear_has_header();
   ::cockroach::proto::ResponseHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+inline void PutUniqueResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4631,76 +9712,46 @@ inline void ScanResponse::set_allocated_header(::cockroach::proto::ResponseHeade
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanResponse.header)
-}
-
-// repeated .cockroach.proto.KeyValue rows = 2;
-inline int ScanResponse::rows_size() const {
-  return rows_.size();
-}
-inline void ScanResponse::clear_rows() {
-  rows_.Clear();
-}
-inline const ::cockroach::proto::KeyValue& ScanResponse::rows(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.ScanResponse.rows)
-  return rows_.Get(index);
-}
-inline ::cockroach::proto::KeyValue* ScanResponse::mutable_rows(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanResponse.rows)
-  return rows_.Mutable(index);
-}
-inline ::cockroach::proto::KeyValue* ScanResponse::add_rows() {
-  // @@protoc_insertion_point(field_add:cockroach.proto.ScanResponse.rows)
-  return rows_.Add();
-}
-inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >&
-ScanResponse::rows() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.ScanResponse.rows)
-  return rows_;
-}
-inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValue >*
-ScanResponse::mutable_rows() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanResponse.rows)
-  return &rows_;
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.PutUniqueResponse.header)
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionRequest
+// ScanChangesRequest
 
 // optional .cockroach.proto.RequestHeader header = 1;
-inline bool EndTransactionRequest::has_header() const {
+inline bool ScanChangesRequest::has_header() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionRequest::set_has_header() {
+inline void ScanChangesRequest::set_has_header() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionRequest::clear_has_header() {
+inline void ScanChangesRequest::clear_has_header() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionRequest::clear_header() {
+inline void ScanChangesRequest::clear_header() {
   if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
   clear_has_header();
 }
-inline const ::cockroach::proto::RequestHeader& EndTransactionRequest::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.header)
+inline const ::cockroach::proto::RequestHeader& ScanChangesRequest::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesRequest.header)
   return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::mutable_header() {
+inline ::cockroach::proto::RequestHeader* ScanChangesRequest::mutable_header() {
   set_has_header();
   if (header_ == NULL) {
     header_ = new ::cockroach::proto::RequestHeader;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesRequest.header)
   return header_;
 }
-inline ::cockroach::proto::RequestHeader* EndTransactionRequest::release_header() {
+inline ::cockroach::proto::RequestHeader* ScanChangesRequest::release_header() {
   clear_has_header();
   ::cockroach::proto::RequestHeader* temp = header_;
   header_ = NULL;
   return temp;
 }
-inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
+inline void ScanChangesRequest::set_allocated_header(::cockroach::proto::RequestHeader* header) {
   delete header_;
   header_ = header;
   if (header) {
@@ -4708,199 +9759,275 @@ inline void EndTransactionRequest::set_allocated_header(::cockroach::proto::Requ
   } else {
     clear_has_header();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.header)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanChangesRequest.header)
 }
 
-// optional bool commit = 2;
-inline bool EndTransactionRequest::has_commit() const {
+// optional .cockroach.proto.Timestamp start_timestamp = 2;
+inline bool ScanChangesRequest::has_start_timestamp() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EndTransactionRequest::set_has_commit() {
+inline void ScanChangesRequest::set_has_start_timestamp() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EndTransactionRequest::clear_has_commit() {
+inline void ScanChangesRequest::clear_has_start_timestamp() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void EndTransactionRequest::clear_commit() {
-  commit_ = false;
-  clear_has_commit();
+inline void ScanChangesRequest::clear_start_timestamp() {
+  if (start_timestamp_ != NULL) start_timestamp_->::cockroach::proto::Timestamp::Clear();
+  clear_has_start_timestamp();
 }
-inline bool EndTransactionRequest::commit() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.commit)
-  return commit_;
+inline const ::cockroach::proto::Timestamp& ScanChangesRequest::start_timestamp() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesRequest.start_timestamp)
+  return start_timestamp_ != NULL ? *start_timestamp_ : *default_instance_->start_timestamp_;
 }
-inline void EndTransactionRequest::set_commit(bool value) {
-  set_has_commit();
-  commit_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionRequest.commit)
+inline ::cockroach::proto::Timestamp* ScanChangesRequest::mutable_start_timestamp() {
+  set_has_start_timestamp();
+  if (start_timestamp_ == NULL) {
+    start_timestamp_ = new ::cockroach::proto::Timestamp;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesRequest.start_timestamp)
+  return start_timestamp_;
+}
+inline ::cockroach::proto::Timestamp* ScanChangesRequest::release_start_timestamp() {
+  clear_has_start_timestamp();
+  ::cockroach::proto::Timestamp* temp = start_timestamp_;
+  start_timestamp_ = NULL;
+  return temp;
+}
+inline void ScanChangesRequest::set_allocated_start_timestamp(::cockroach::proto::Timestamp* start_timestamp) {
+  delete start_timestamp_;
+  start_timestamp_ = start_timestamp;
+  if (start_timestamp) {
+    set_has_start_timestamp();
+  } else {
+    clear_has_start_timestamp();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanChangesRequest.start_timestamp)
 }
 
-// optional .cockroach.proto.InternalCommitTrigger internal_commit_trigger = 3;
-inline bool EndTransactionRequest::has_internal_commit_trigger() const {
+// optional int64 max_results = 3;
+inline bool ScanChangesRequest::has_max_results() const {
   return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void EndTransactionRequest::set_has_internal_commit_trigger() {
+inline void ScanChangesRequest::set_has_max_results() {
   _has_bits_[0] |= 0x00000004u;
 }
-inline void EndTransactionRequest::clear_has_internal_commit_trigger() {
+inline void ScanChangesRequest::clear_has_max_results() {
   _has_bits_[0] &= ~0x00000004u;
 }
-inline void EndTransactionRequest::clear_internal_commit_trigger() {
-  if (internal_commit_trigger_ != NULL) internal_commit_trigger_->::cockroach::proto::InternalCommitTrigger::Clear();
-  clear_has_internal_commit_trigger();
-}
-inline const ::cockroach::proto::InternalCommitTrigger& EndTransactionRequest::internal_commit_trigger() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_ != NULL ? *internal_commit_trigger_ : *default_instance_->internal_commit_trigger_;
-}
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::mutable_internal_commit_trigger() {
-  set_has_internal_commit_trigger();
-  if (internal_commit_trigger_ == NULL) {
-    internal_commit_trigger_ = new ::cockroach::proto::InternalCommitTrigger;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
-  return internal_commit_trigger_;
+inline void ScanChangesRequest::clear_max_results() {
+  max_results_ = GOOGLE_LONGLONG(0);
+  clear_has_max_results();
 }
-inline ::cockroach::proto::InternalCommitTrigger* EndTransactionRequest::release_internal_commit_trigger() {
-  clear_has_internal_commit_trigger();
-  ::cockroach::proto::InternalCommitTrigger* temp = internal_commit_trigger_;
-  internal_commit_trigger_ = NULL;
-  return temp;
+inline ::google::protobuf::int64 ScanChangesRequest::max_results() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesRequest.max_results)
+  return max_results_;
 }
-inline void EndTransactionRequest::set_allocated_internal_commit_trigger(::cockroach::proto::InternalCommitTrigger* internal_commit_trigger) {
-  delete internal_commit_trigger_;
-  internal_commit_trigger_ = internal_commit_trigger;
-  if (internal_commit_trigger) {
-    set_has_internal_commit_trigger();
-  } else {
-    clear_has_internal_commit_trigger();
-  }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionRequest.internal_commit_trigger)
+inline void ScanChangesRequest::set_max_results(::google::protobuf::int64 value) {
+  set_has_max_results();
+  max_results_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.ScanChangesRequest.max_results)
 }
 
 // -------------------------------------------------------------------
 
-// EndTransactionResponse
+// KeyValueChange
 
-// optional .cockroach.proto.ResponseHeader header = 1;
-inline bool EndTransactionResponse::has_header() const {
+// optional bytes key = 1;
+inline bool KeyValueChange::has_key() const {
   return (_has_bits_[0] & 0x00000001u) != 0;
 }
-inline void EndTransactionResponse::set_has_header() {
+inline void KeyValueChange::set_has_key() {
   _has_bits_[0] |= 0x00000001u;
 }
-inline void EndTransactionResponse::clear_has_header() {
+inline void KeyValueChange::clear_has_key() {
   _has_bits_[0] &= ~0x00000001u;
 }
-inline void EndTransactionResponse::clear_header() {
-  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
-  clear_has_header();
+inline void KeyValueChange::clear_key() {
+  key_.ClearToEmptyNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+  clear_has_key();
 }
-inline const ::cockroach::proto::ResponseHeader& EndTransactionResponse::header() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.header)
-  return header_ != NULL ? *header_ : *default_instance_->header_;
+inline const ::std::string& KeyValueChange::key() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValueChange.key)
+  return key_.GetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::mutable_header() {
-  set_has_header();
-  if (header_ == NULL) {
-    header_ = new ::cockroach::proto::ResponseHeader;
-  }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.header)
-  return header_;
+inline void KeyValueChange::set_key(const ::std::string& value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), value);
+  // @@protoc_insertion_point(field_set:cockroach.proto.KeyValueChange.key)
 }
-inline ::cockroach::proto::ResponseHeader* EndTransactionResponse::release_header() {
-  clear_has_header();
-  ::cockroach::proto::ResponseHeader* temp = header_;
-  header_ = NULL;
-  return temp;
+inline void KeyValueChange::set_key(const char* value) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), ::std::string(value));
+  // @@protoc_insertion_point(field_set_char:cockroach.proto.KeyValueChange.key)
 }
-inline void EndTransactionResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
-  delete header_;
-  header_ = header;
-  if (header) {
-    set_has_header();
+inline void KeyValueChange::set_key(const void* value, size_t size) {
+  set_has_key();
+  key_.SetNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(),
+      ::std::string(reinterpret_cast<const char*>(value), size));
+  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.KeyValueChange.key)
+}
+inline ::std::string* KeyValueChange::mutable_key() {
+  set_has_key();
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValueChange.key)
+  return key_.MutableNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline ::std::string* KeyValueChange::release_key() {
+  clear_has_key();
+  return key_.ReleaseNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited());
+}
+inline void KeyValueChange::set_allocated_key(::std::string* key) {
+  if (key != NULL) {
+    set_has_key();
   } else {
-    clear_has_header();
+    clear_has_key();
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.EndTransactionResponse.header)
+  key_.SetAllocatedNoArena(&::google::protobuf::internal::GetEmptyStringAlreadyInited(), key);
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValueChange.key)
 }
 
-// optional int64 commit_wait = 2;
-inline bool EndTransactionResponse::has_commit_wait() const {
+// optional .cockroach.proto.Value value = 2;
+inline bool KeyValueChange::has_value() const {
   return (_has_bits_[0] & 0x00000002u) != 0;
 }
-inline void EndTransactionResponse::set_has_commit_wait() {
+inline void KeyValueChange::set_has_value() {
   _has_bits_[0] |= 0x00000002u;
 }
-inline void EndTransactionResponse::clear_has_commit_wait() {
+inline void KeyValueChange::clear_has_value() {
   _has_bits_[0] &= ~0x00000002u;
 }
-inline void EndTransactionResponse::clear_commit_wait() {
-  commit_wait_ = GOOGLE_LONGLONG(0);
-  clear_has_commit_wait();
+inline void KeyValueChange::clear_value() {
+  if (value_ != NULL) value_->::cockroach::proto::Value::Clear();
+  clear_has_value();
 }
-inline ::google::protobuf::int64 EndTransactionResponse::commit_wait() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.commit_wait)
-  return commit_wait_;
+inline const ::cockroach::proto::Value& KeyValueChange::value() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValueChange.value)
+  return value_ != NULL ? *value_ : *default_instance_->value_;
 }
-inline void EndTransactionResponse::set_commit_wait(::google::protobuf::int64 value) {
-  set_has_commit_wait();
-  commit_wait_ = value;
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.commit_wait)
+inline ::cockroach::proto::Value* KeyValueChange::mutable_value() {
+  set_has_value();
+  if (value_ == NULL) {
+    value_ = new ::cockroach::proto::Value;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.KeyValueChange.value)
+  return value_;
+}
+inline ::cockroach::proto::Value* KeyValueChange::release_value() {
+  clear_has_value();
+  ::cockroach::proto::Value* temp = value_;
+  value_ = NULL;
+  return temp;
+}
+inline void KeyValueChange::set_allocated_value(::cockroach::proto::Value* value) {
+  delete value_;
+  value_ = value;
+  if (value) {
+    set_has_value();
+  } else {
+    clear_has_value();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.KeyValueChange.value)
 }
 
-// repeated bytes resolved = 3;
-inline int EndTransactionResponse::resolved_size() const {
-  return resolved_.size();
+// optional bool deleted = 3;
+inline bool KeyValueChange::has_deleted() const {
+  return (_has_bits_[0] & 0x00000004u) != 0;
 }
-inline void EndTransactionResponse::clear_resolved() {
-  resolved_.Clear();
+inline void KeyValueChange::set_has_deleted() {
+  _has_bits_[0] |= 0x00000004u;
 }
-inline const ::std::string& EndTransactionResponse::resolved(int index) const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Get(index);
+inline void KeyValueChange::clear_has_deleted() {
+  _has_bits_[0] &= ~0x00000004u;
 }
-inline ::std::string* EndTransactionResponse::mutable_resolved(int index) {
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_.Mutable(index);
+inline void KeyValueChange::clear_deleted() {
+  deleted_ = false;
+  clear_has_deleted();
+}
+inline bool KeyValueChange::deleted() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.KeyValueChange.deleted)
+  return deleted_;
+}
+inline void KeyValueChange::set_deleted(bool value) {
+  set_has_deleted();
+  deleted_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.KeyValueChange.deleted)
+}
+
+// -------------------------------------------------------------------
+
+// ScanChangesResponse
+
+// optional .cockroach.proto.ResponseHeader header = 1;
+inline bool ScanChangesResponse::has_header() const {
+  return (_has_bits_[0] & 0x00000001u) != 0;
+}
+inline void ScanChangesResponse::set_has_header() {
+  _has_bits_[0] |= 0x00000001u;
+}
+inline void ScanChangesResponse::clear_has_header() {
+  _has_bits_[0] &= ~0x00000001u;
+}
+inline void ScanChangesResponse::clear_header() {
+  if (header_ != NULL) header_->::cockroach::proto::ResponseHeader::Clear();
+  clear_has_header();
+}
+inline const ::cockroach::proto::ResponseHeader& ScanChangesResponse::header() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesResponse.header)
+  return header_ != NULL ? *header_ : *default_instance_->header_;
 }
-inline void EndTransactionResponse::set_resolved(int index, const ::std::string& value) {
-  // @@protoc_insertion_point(field_set:cockroach.proto.EndTransactionResponse.resolved)
-  resolved_.Mutable(index)->assign(value);
+inline ::cockroach::proto::ResponseHeader* ScanChangesResponse::mutable_header() {
+  set_has_header();
+  if (header_ == NULL) {
+    header_ = new ::cockroach::proto::ResponseHeader;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesResponse.header)
+  return header_;
 }
-inline void EndTransactionResponse::set_resolved(int index, const char* value) {
-  resolved_.Mutable(index)->assign(value);
-  // @@protoc_insertion_point(field_set_char:cockroach.proto.EndTransactionResponse.resolved)
+inline ::cockroach::proto::ResponseHeader* ScanChangesResponse::release_header() {
+  clear_has_header();
+  ::cockroach::proto::ResponseHeader* temp = header_;
+  header_ = NULL;
+  return temp;
 }
-inline void EndTransactionResponse::set_resolved(int index, const void* value, size_t size) {
-  resolved_.Mutable(index)->assign(
-    reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_set_pointer:cockroach.proto.EndTransactionResponse.resolved)
+inline void ScanChangesResponse::set_allocated_header(::cockroach::proto::ResponseHeader* header) {
+  delete header_;
+  header_ = header;
+  if (header) {
+    set_has_header();
+  } else {
+    clear_has_header();
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ScanChangesResponse.header)
 }
-inline ::std::string* EndTransactionResponse::add_resolved() {
-  return resolved_.Add();
+
+// repeated .cockroach.proto.KeyValueChange changes = 2;
+inline int ScanChangesResponse::changes_size() const {
+  return changes_.size();
 }
-inline void EndTransactionResponse::add_resolved(const ::std::string& value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add:cockroach.proto.EndTransactionResponse.resolved)
+inline void ScanChangesResponse::clear_changes() {
+  changes_.Clear();
 }
-inline void EndTransactionResponse::add_resolved(const char* value) {
-  resolved_.Add()->assign(value);
-  // @@protoc_insertion_point(field_add_char:cockroach.proto.EndTransactionResponse.resolved)
+inline const ::cockroach::proto::KeyValueChange& ScanChangesResponse::changes(int index) const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ScanChangesResponse.changes)
+  return changes_.Get(index);
 }
-inline void EndTransactionResponse::add_resolved(const void* value, size_t size) {
-  resolved_.Add()->assign(reinterpret_cast<const char*>(value), size);
-  // @@protoc_insertion_point(field_add_pointer:cockroach.proto.EndTransactionResponse.resolved)
+inline ::cockroach::proto::KeyValueChange* ScanChangesResponse::mutable_changes(int index) {
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ScanChangesResponse.changes)
+  return changes_.Mutable(index);
 }
-inline const ::google::protobuf::RepeatedPtrField< ::std::string>&
-EndTransactionResponse::resolved() const {
-  // @@protoc_insertion_point(field_list:cockroach.proto.EndTransactionResponse.resolved)
-  return resolved_;
+inline ::cockroach::proto::KeyValueChange* ScanChangesResponse::add_changes() {
+  // @@protoc_insertion_point(field_add:cockroach.proto.ScanChangesResponse.changes)
+  return changes_.Add();
 }
-inline ::google::protobuf::RepeatedPtrField< ::std::string>*
-EndTransactionResponse::mutable_resolved() {
-  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.EndTransactionResponse.resolved)
-  return &resolved_;
+inline const ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange >&
+ScanChangesResponse::changes() const {
+  // @@protoc_insertion_point(field_list:cockroach.proto.ScanChangesResponse.changes)
+  return changes_;
+}
+inline ::google::protobuf::RepeatedPtrField< ::cockroach::proto::KeyValueChange >*
+ScanChangesResponse::mutable_changes() {
+  // @@protoc_insertion_point(field_mutable_list:cockroach.proto.ScanChangesResponse.changes)
+  return &changes_;
 }
 
 // -------------------------------------------------------------------
@@ -5144,135 +10271,457 @@ inline bool RequestUnion::has_delete_range() const {
 inline void RequestUnion::set_has_delete_range() {
   _oneof_case_[0] = kDeleteRange;
 }
-inline void RequestUnion::clear_delete_range() {
-  if (has_delete_range()) {
-    delete value_.delete_range_;
+inline void RequestUnion::clear_delete_range() {
+  if (has_delete_range()) {
+    delete value_.delete_range_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRangeRequest& RequestUnion::delete_range() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.delete_range)
+  return has_delete_range() ? *value_.delete_range_
+                      : ::cockroach::proto::DeleteRangeRequest::default_instance();
+}
+inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::mutable_delete_range() {
+  if (!has_delete_range()) {
+    clear_value();
+    set_has_delete_range();
+    value_.delete_range_ = new ::cockroach::proto::DeleteRangeRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.delete_range)
+  return value_.delete_range_;
+}
+inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::release_delete_range() {
+  if (has_delete_range()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRangeRequest* temp = value_.delete_range_;
+    value_.delete_range_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range) {
+  clear_value();
+  if (delete_range) {
+    set_has_delete_range();
+    value_.delete_range_ = delete_range;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.delete_range)
+}
+
+// optional .cockroach.proto.ScanRequest scan = 8;
+inline bool RequestUnion::has_scan() const {
+  return value_case() == kScan;
+}
+inline void RequestUnion::set_has_scan() {
+  _oneof_case_[0] = kScan;
+}
+inline void RequestUnion::clear_scan() {
+  if (has_scan()) {
+    delete value_.scan_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan)
+  return has_scan() ? *value_.scan_
+                      : ::cockroach::proto::ScanRequest::default_instance();
+}
+inline ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
+  if (!has_scan()) {
+    clear_value();
+    set_has_scan();
+    value_.scan_ = new ::cockroach::proto::ScanRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan)
+  return value_.scan_;
+}
+inline ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
+  if (has_scan()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRequest* temp = value_.scan_;
+    value_.scan_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
+  clear_value();
+  if (scan) {
+    set_has_scan();
+    value_.scan_ = scan;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan)
+}
+
+// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+inline bool RequestUnion::has_end_transaction() const {
+  return value_case() == kEndTransaction;
+}
+inline void RequestUnion::set_has_end_transaction() {
+  _oneof_case_[0] = kEndTransaction;
+}
+inline void RequestUnion::clear_end_transaction() {
+  if (has_end_transaction()) {
+    delete value_.end_transaction_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.end_transaction)
+  return has_end_transaction() ? *value_.end_transaction_
+                      : ::cockroach::proto::EndTransactionRequest::default_instance();
+}
+inline ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
+  if (!has_end_transaction()) {
+    clear_value();
+    set_has_end_transaction();
+    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.end_transaction)
+  return value_.end_transaction_;
+}
+inline ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
+  if (has_end_transaction()) {
+    clear_has_value();
+    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
+    value_.end_transaction_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
+  clear_value();
+  if (end_transaction) {
+    set_has_end_transaction();
+    value_.end_transaction_ = end_transaction;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.end_transaction)
+}
+
+// optional .cockroach.proto.GetRowRequest get_row = 10;
+inline bool RequestUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+inline void RequestUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+inline void RequestUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::GetRowRequest& RequestUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowRequest::default_instance();
+}
+inline ::cockroach::proto::GetRowRequest* RequestUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.get_row)
+  return value_.get_row_;
+}
+inline ::cockroach::proto::GetRowRequest* RequestUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowRequest* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowRequest put_row = 11;
+inline bool RequestUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+inline void RequestUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+inline void RequestUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRowRequest& RequestUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowRequest::default_instance();
+}
+inline ::cockroach::proto::PutRowRequest* RequestUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.put_row)
+  return value_.put_row_;
+}
+inline ::cockroach::proto::PutRowRequest* RequestUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowRequest* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+inline bool RequestUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+inline void RequestUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+inline void RequestUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRowRequest& RequestUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowRequest::default_instance();
+}
+inline ::cockroach::proto::DeleteRowRequest* RequestUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.delete_row)
+  return value_.delete_row_;
+}
+inline ::cockroach::proto::DeleteRowRequest* RequestUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowRequest* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+inline bool RequestUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+inline void RequestUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+inline void RequestUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanRowsRequest& RequestUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsRequest::default_instance();
+}
+inline ::cockroach::proto::ScanRowsRequest* RequestUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan_rows)
+  return value_.scan_rows_;
+}
+inline ::cockroach::proto::ScanRowsRequest* RequestUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsRequest* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void RequestUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowRequest lock_row = 14;
+inline bool RequestUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+inline void RequestUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+inline void RequestUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::DeleteRangeRequest& RequestUnion::delete_range() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.delete_range)
-  return has_delete_range() ? *value_.delete_range_
-                      : ::cockroach::proto::DeleteRangeRequest::default_instance();
+inline const ::cockroach::proto::LockRowRequest& RequestUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowRequest::default_instance();
 }
-inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::mutable_delete_range() {
-  if (!has_delete_range()) {
+inline ::cockroach::proto::LockRowRequest* RequestUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
     clear_value();
-    set_has_delete_range();
-    value_.delete_range_ = new ::cockroach::proto::DeleteRangeRequest;
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.delete_range)
-  return value_.delete_range_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.lock_row)
+  return value_.lock_row_;
 }
-inline ::cockroach::proto::DeleteRangeRequest* RequestUnion::release_delete_range() {
-  if (has_delete_range()) {
+inline ::cockroach::proto::LockRowRequest* RequestUnion::release_lock_row() {
+  if (has_lock_row()) {
     clear_has_value();
-    ::cockroach::proto::DeleteRangeRequest* temp = value_.delete_range_;
-    value_.delete_range_ = NULL;
+    ::cockroach::proto::LockRowRequest* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_delete_range(::cockroach::proto::DeleteRangeRequest* delete_range) {
+inline void RequestUnion::set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row) {
   clear_value();
-  if (delete_range) {
-    set_has_delete_range();
-    value_.delete_range_ = delete_range;
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.delete_range)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.lock_row)
 }
 
-// optional .cockroach.proto.ScanRequest scan = 8;
-inline bool RequestUnion::has_scan() const {
-  return value_case() == kScan;
+// optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+inline bool RequestUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
 }
-inline void RequestUnion::set_has_scan() {
-  _oneof_case_[0] = kScan;
+inline void RequestUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
 }
-inline void RequestUnion::clear_scan() {
-  if (has_scan()) {
-    delete value_.scan_;
+inline void RequestUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::ScanRequest& RequestUnion::scan() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan)
-  return has_scan() ? *value_.scan_
-                      : ::cockroach::proto::ScanRequest::default_instance();
+inline const ::cockroach::proto::PutUniqueRequest& RequestUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueRequest::default_instance();
 }
-inline ::cockroach::proto::ScanRequest* RequestUnion::mutable_scan() {
-  if (!has_scan()) {
+inline ::cockroach::proto::PutUniqueRequest* RequestUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
     clear_value();
-    set_has_scan();
-    value_.scan_ = new ::cockroach::proto::ScanRequest;
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan)
-  return value_.scan_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.put_unique)
+  return value_.put_unique_;
 }
-inline ::cockroach::proto::ScanRequest* RequestUnion::release_scan() {
-  if (has_scan()) {
+inline ::cockroach::proto::PutUniqueRequest* RequestUnion::release_put_unique() {
+  if (has_put_unique()) {
     clear_has_value();
-    ::cockroach::proto::ScanRequest* temp = value_.scan_;
-    value_.scan_ = NULL;
+    ::cockroach::proto::PutUniqueRequest* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_scan(::cockroach::proto::ScanRequest* scan) {
+inline void RequestUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique) {
   clear_value();
-  if (scan) {
-    set_has_scan();
-    value_.scan_ = scan;
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.put_unique)
 }
 
-// optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-inline bool RequestUnion::has_end_transaction() const {
-  return value_case() == kEndTransaction;
+// optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+inline bool RequestUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
 }
-inline void RequestUnion::set_has_end_transaction() {
-  _oneof_case_[0] = kEndTransaction;
+inline void RequestUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
 }
-inline void RequestUnion::clear_end_transaction() {
-  if (has_end_transaction()) {
-    delete value_.end_transaction_;
+inline void RequestUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
     clear_has_value();
   }
 }
-inline const ::cockroach::proto::EndTransactionRequest& RequestUnion::end_transaction() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.end_transaction)
-  return has_end_transaction() ? *value_.end_transaction_
-                      : ::cockroach::proto::EndTransactionRequest::default_instance();
+inline const ::cockroach::proto::ScanChangesRequest& RequestUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.RequestUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesRequest::default_instance();
 }
-inline ::cockroach::proto::EndTransactionRequest* RequestUnion::mutable_end_transaction() {
-  if (!has_end_transaction()) {
+inline ::cockroach::proto::ScanChangesRequest* RequestUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
     clear_value();
-    set_has_end_transaction();
-    value_.end_transaction_ = new ::cockroach::proto::EndTransactionRequest;
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.end_transaction)
-  return value_.end_transaction_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.RequestUnion.scan_changes)
+  return value_.scan_changes_;
 }
-inline ::cockroach::proto::EndTransactionRequest* RequestUnion::release_end_transaction() {
-  if (has_end_transaction()) {
+inline ::cockroach::proto::ScanChangesRequest* RequestUnion::release_scan_changes() {
+  if (has_scan_changes()) {
     clear_has_value();
-    ::cockroach::proto::EndTransactionRequest* temp = value_.end_transaction_;
-    value_.end_transaction_ = NULL;
+    ::cockroach::proto::ScanChangesRequest* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
-inline void RequestUnion::set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction) {
+inline void RequestUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes) {
   clear_value();
-  if (end_transaction) {
-    set_has_end_transaction();
-    value_.end_transaction_ = end_transaction;
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.end_transaction)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.RequestUnion.scan_changes)
 }
 
 inline bool RequestUnion::has_value() const {
@@ -5656,6 +11105,328 @@ inline void ResponseUnion::set_allocated_end_transaction(::cockroach::proto::End
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowResponse get_row = 10;
+inline bool ResponseUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+inline void ResponseUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+inline void ResponseUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::GetRowResponse& ResponseUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowResponse::default_instance();
+}
+inline ::cockroach::proto::GetRowResponse* ResponseUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.get_row)
+  return value_.get_row_;
+}
+inline ::cockroach::proto::GetRowResponse* ResponseUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowResponse* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_get_row(::cockroach::proto::GetRowResponse* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowResponse put_row = 11;
+inline bool ResponseUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+inline void ResponseUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+inline void ResponseUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRowResponse& ResponseUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowResponse::default_instance();
+}
+inline ::cockroach::proto::PutRowResponse* ResponseUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.put_row)
+  return value_.put_row_;
+}
+inline ::cockroach::proto::PutRowResponse* ResponseUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowResponse* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+inline bool ResponseUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+inline void ResponseUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+inline void ResponseUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRowResponse& ResponseUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowResponse::default_instance();
+}
+inline ::cockroach::proto::DeleteRowResponse* ResponseUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.delete_row)
+  return value_.delete_row_;
+}
+inline ::cockroach::proto::DeleteRowResponse* ResponseUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowResponse* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+inline bool ResponseUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+inline void ResponseUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+inline void ResponseUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanRowsResponse& ResponseUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsResponse::default_instance();
+}
+inline ::cockroach::proto::ScanRowsResponse* ResponseUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.scan_rows)
+  return value_.scan_rows_;
+}
+inline ::cockroach::proto::ScanRowsResponse* ResponseUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsResponse* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsResponse* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowResponse lock_row = 14;
+inline bool ResponseUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+inline void ResponseUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+inline void ResponseUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::LockRowResponse& ResponseUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowResponse::default_instance();
+}
+inline ::cockroach::proto::LockRowResponse* ResponseUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.lock_row)
+  return value_.lock_row_;
+}
+inline ::cockroach::proto::LockRowResponse* ResponseUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowResponse* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+inline bool ResponseUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+inline void ResponseUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+inline void ResponseUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutUniqueResponse& ResponseUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueResponse::default_instance();
+}
+inline ::cockroach::proto::PutUniqueResponse* ResponseUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.put_unique)
+  return value_.put_unique_;
+}
+inline ::cockroach::proto::PutUniqueResponse* ResponseUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueResponse* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+inline bool ResponseUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+inline void ResponseUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+inline void ResponseUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanChangesResponse& ResponseUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ResponseUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesResponse::default_instance();
+}
+inline ::cockroach::proto::ScanChangesResponse* ResponseUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ResponseUnion.scan_changes)
+  return value_.scan_changes_;
+}
+inline ::cockroach::proto::ScanChangesResponse* ResponseUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesResponse* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ResponseUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesResponse* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ResponseUnion.scan_changes)
+}
+
 inline bool ResponseUnion::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -6113,6 +11884,46 @@ inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::Respons
 
 // -------------------------------------------------------------------
 
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
+// -------------------------------------------------------------------
+
 
 // @@protoc_insertion_point(namespace_scope)
 
@@ -6123,6 +11934,21 @@ inline void AdminMergeResponse::set_allocated_header(::cockroach::proto::Respons
 namespace google {
 namespace protobuf {
 
+template <> struct is_proto_enum< ::cockroach::proto::RowFilter_Op> : ::google::protobuf::internal::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::RowFilter_Op>() {
+  return ::cockroach::proto::RowFilter_Op_descriptor();
+}
+template <> struct is_proto_enum< ::cockroach::proto::RowFilter_Type> : ::google::protobuf::internal::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::RowFilter_Type>() {
+  return ::cockroach::proto::RowFilter_Type_descriptor();
+}
+template <> struct is_proto_enum< ::cockroach::proto::RowAggregate_Func> : ::google::protobuf::internal::true_type {};
+template <>
+inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::RowAggregate_Func>() {
+  return ::cockroach::proto::RowAggregate_Func_descriptor();
+}
 template <> struct is_proto_enum< ::cockroach::proto::ReadConsistencyType> : ::google::protobuf::internal::true_type {};
 template <>
 inline const EnumDescriptor* GetEnumDescriptor< ::cockroach::proto::ReadConsistencyType>() {
@@ -90,6 +90,13 @@ struct InternalRequestUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::GetRowRequest* get_row_;
+  const ::cockroach::proto::PutRowRequest* put_row_;
+  const ::cockroach::proto::DeleteRowRequest* delete_row_;
+  const ::cockroach::proto::ScanRowsRequest* scan_rows_;
+  const ::cockroach::proto::LockRowRequest* lock_row_;
+  const ::cockroach::proto::PutUniqueRequest* put_unique_;
+  const ::cockroach::proto::ScanChangesRequest* scan_changes_;
   const ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
   const ::cockroach::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range_;
@@ -106,6 +113,13 @@ struct InternalResponseUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::ScanResponse* scan_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::GetRowResponse* get_row_;
+  const ::cockroach::proto::PutRowResponse* put_row_;
+  const ::cockroach::proto::DeleteRowResponse* delete_row_;
+  const ::cockroach::proto::ScanRowsResponse* scan_rows_;
+  const ::cockroach::proto::LockRowResponse* lock_row_;
+  const ::cockroach::proto::PutUniqueResponse* put_unique_;
+  const ::cockroach::proto::ScanChangesResponse* scan_changes_;
   const ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
   const ::cockroach::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range_;
@@ -126,6 +140,9 @@ struct ReadWriteCmdResponseOneofInstance {
   const ::cockroach::proto::DeleteResponse* delete__;
   const ::cockroach::proto::DeleteRangeResponse* delete_range_;
   const ::cockroach::proto::EndTransactionResponse* end_transaction_;
+  const ::cockroach::proto::PutRowResponse* put_row_;
+  const ::cockroach::proto::DeleteRowResponse* delete_row_;
+  const ::cockroach::proto::LockRowResponse* lock_row_;
   const ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
   const ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
   const ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
@@ -134,6 +151,7 @@ struct ReadWriteCmdResponseOneofInstance {
   const ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
   const ::cockroach::proto::InternalGCResponse* internal_gc_;
   const ::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+  const ::cockroach::proto::PutUniqueResponse* put_unique_;
 }* ReadWriteCmdResponse_default_oneof_instance_ = NULL;
 const ::google::protobuf::Descriptor* InternalRaftCommandUnion_descriptor_ = NULL;
 const ::google::protobuf::internal::GeneratedMessageReflection*
@@ -147,6 +165,13 @@ struct InternalRaftCommandUnionOneofInstance {
   const ::cockroach::proto::DeleteRangeRequest* delete_range_;
   const ::cockroach::proto::ScanRequest* scan_;
   const ::cockroach::proto::EndTransactionRequest* end_transaction_;
+  const ::cockroach::proto::GetRowRequest* get_row_;
+  const ::cockroach::proto::PutRowRequest* put_row_;
+  const ::cockroach::proto::DeleteRowRequest* delete_row_;
+  const ::cockroach::proto::ScanRowsRequest* scan_rows_;
+  const ::cockroach::proto::LockRowRequest* lock_row_;
+  const ::cockroach::proto::PutUniqueRequest* put_unique_;
+  const ::cockroach::proto::ScanChangesRequest* scan_changes_;
   const ::cockroach::proto::BatchRequest* batch_;
   const ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
   const ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
@@ -196,10 +221,11 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       "cockroach/proto/internal.proto");
   GOOGLE_CHECK(file != NULL);
   InternalRangeLookupRequest_descriptor_ = file->message_type(0);
-  static const int InternalRangeLookupRequest_offsets_[3] = {
+  static const int InternalRangeLookupRequest_offsets_[4] = {
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, header_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, max_ranges_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, ignore_intents_),
+    GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRangeLookupRequest, reverse_),
   };
   InternalRangeLookupRequest_reflection_ =
     ::google::protobuf::internal::GeneratedMessageReflection::NewGeneratedMessageReflection(
@@ -495,7 +521,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalLeaderLeaseResponse, _internal_metadata_),
       -1);
   InternalRequestUnion_descriptor_ = file->message_type(18);
-  static const int InternalRequestUnion_offsets_[12] = {
+  static const int InternalRequestUnion_offsets_[19] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, conditional_put_),
@@ -504,6 +530,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, get_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, put_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, delete_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, scan_rows_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, lock_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, put_unique_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, scan_changes_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, internal_resolve_intent_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRequestUnion_default_oneof_instance_, internal_resolve_intent_range_),
@@ -523,7 +556,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalRequestUnion, _internal_metadata_),
       -1);
   InternalResponseUnion_descriptor_ = file->message_type(19);
-  static const int InternalResponseUnion_offsets_[12] = {
+  static const int InternalResponseUnion_offsets_[19] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, conditional_put_),
@@ -532,6 +565,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, get_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, put_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, delete_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, scan_rows_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, lock_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, put_unique_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, scan_changes_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, internal_resolve_intent_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalResponseUnion_default_oneof_instance_, internal_resolve_intent_range_),
@@ -583,13 +623,16 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(InternalBatchResponse, _internal_metadata_),
       -1);
   ReadWriteCmdResponse_descriptor_ = file->message_type(22);
-  static const int ReadWriteCmdResponse_offsets_[15] = {
+  static const int ReadWriteCmdResponse_offsets_[19] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, conditional_put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, increment_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete__),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, put_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, delete_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, lock_row_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_heartbeat_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_push_txn_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_resolve_intent_),
@@ -598,6 +641,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_truncate_log_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_gc_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, internal_leader_lease_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(ReadWriteCmdResponse_default_oneof_instance_, put_unique_),
     GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, value_),
   };
   ReadWriteCmdResponse_reflection_ =
@@ -614,7 +658,7 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
       GOOGLE_PROTOBUF_GENERATED_MESSAGE_FIELD_OFFSET(ReadWriteCmdResponse, _internal_metadata_),
       -1);
   InternalRaftCommandUnion_descriptor_ = file->message_type(23);
-  static const int InternalRaftCommandUnion_offsets_[20] = {
+  static const int InternalRaftCommandUnion_offsets_[27] = {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, get_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, put_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, conditional_put_),
@@ -623,6 +667,13 @@ void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto() {
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, delete_range_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, scan_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, end_transaction_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, get_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, put_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, delete_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, scan_rows_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, lock_row_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, put_unique_),
+    PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, scan_changes_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, batch_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_range_lookup_),
     PROTO2_GENERATED_DEFAULT_ONEOF_FIELD_OFFSET(InternalRaftCommandUnion_default_oneof_instance_, internal_heartbeat_txn_),
@@ -951,93 +1002,113 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "\n\036cockroach/proto/internal.proto\022\017cockro"
     "ach.proto\032\031cockroach/proto/api.proto\032\034co"
     "ckroach/proto/config.proto\032\032cockroach/pr"
-    "oto/data.proto\032\024gogoproto/gogo.proto\"\216\001\n"
+    "oto/data.proto\032\024gogoproto/gogo.proto\"\245\001\n"
     "\032InternalRangeLookupRequest\0228\n\006header\030\001 "
     "\001(\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037"
     "\000\320\336\037\001\022\030\n\nmax_ranges\030\002 \001(\005B\004\310\336\037\000\022\034\n\016ignor"
-    "e_intents\030\003 \001(\010B\004\310\336\037\000\"\220\001\n\033InternalRangeL"
-    "ookupResponse\0229\n\006header\030\001 \001(\0132\037.cockroac"
-    "h.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0226\n\006rang"
-    "es\030\002 \003(\0132 .cockroach.proto.RangeDescript"
-    "orB\004\310\336\037\000\"W\n\033InternalHeartbeatTxnRequest\022"
-    "8\n\006header\030\001 \001(\0132\036.cockroach.proto.Reques"
-    "tHeaderB\010\310\336\037\000\320\336\037\001\"Y\n\034InternalHeartbeatTx"
-    "nResponse\0229\n\006header\030\001 \001(\0132\037.cockroach.pr"
-    "oto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\235\002\n\021Interna"
-    "lGCRequest\0228\n\006header\030\001 \001(\0132\036.cockroach.p"
-    "roto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022<\n\007gc_meta\030"
-    "\002 \001(\0132\033.cockroach.proto.GCMetadataB\016\310\336\037\000"
-    "\342\336\037\006GCMeta\022<\n\004keys\030\003 \003(\0132(.cockroach.pro"
-    "to.InternalGCRequest.GCKeyB\004\310\336\037\000\032R\n\005GCKe"
-    "y\022\024\n\003key\030\001 \001(\014B\007\372\336\037\003Key\0223\n\ttimestamp\030\002 \001"
-    "(\0132\032.cockroach.proto.TimestampB\004\310\336\037\000\"O\n\022"
-    "InternalGCResponse\0229\n\006header\030\001 \001(\0132\037.coc"
-    "kroach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\214\002"
-    "\n\026InternalPushTxnRequest\0228\n\006header\030\001 \001(\013"
-    "2\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336"
-    "\037\001\0226\n\npushee_txn\030\002 \001(\0132\034.cockroach.proto"
-    ".TransactionB\004\310\336\037\000\022-\n\003now\030\003 \001(\0132\032.cockro"
-    "ach.proto.TimestampB\004\310\336\037\000\0225\n\tpush_type\030\004"
-    " \001(\0162\034.cockroach.proto.PushTxnTypeB\004\310\336\037\000"
-    "\022\032\n\014range_lookup\030\005 \001(\010B\004\310\336\037\000\"\206\001\n\027Interna"
-    "lPushTxnResponse\0229\n\006header\030\001 \001(\0132\037.cockr"
-    "oach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\0220\n\np"
-    "ushee_txn\030\002 \001(\0132\034.cockroach.proto.Transa"
-    "ction\"X\n\034InternalResolveIntentRequest\0228\n"
-    "\006header\030\001 \001(\0132\036.cockroach.proto.RequestH"
-    "eaderB\010\310\336\037\000\320\336\037\001\"Z\n\035InternalResolveIntent"
-    "Response\0229\n\006header\030\001 \001(\0132\037.cockroach.pro"
-    "to.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"]\n!InternalR"
-    "esolveIntentRangeRequest\0228\n\006header\030\001 \001(\013"
-    "2\036.cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336"
-    "\037\001\"_\n\"InternalResolveIntentRangeResponse"
-    "\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Respo"
-    "nseHeaderB\010\310\336\037\000\320\336\037\001\"}\n\024InternalMergeRequ"
-    "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
-    "questHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.c"
-    "ockroach.proto.ValueB\004\310\336\037\000\"R\n\025InternalMe"
-    "rgeResponse\0229\n\006header\030\001 \001(\0132\037.cockroach."
-    "proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"k\n\032Intern"
-    "alTruncateLogRequest\0228\n\006header\030\001 \001(\0132\036.c"
-    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022\023"
-    "\n\005index\030\002 \001(\004B\004\310\336\037\000\"X\n\033InternalTruncateL"
-    "ogResponse\0229\n\006header\030\001 \001(\0132\037.cockroach.p"
-    "roto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\203\001\n\032Intern"
-    "alLeaderLeaseRequest\0228\n\006header\030\001 \001(\0132\036.c"
-    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\022+"
-    "\n\005lease\030\002 \001(\0132\026.cockroach.proto.LeaseB\004\310"
-    "\336\037\000\"X\n\033InternalLeaderLeaseResponse\0229\n\006he"
+    "e_intents\030\003 \001(\010B\004\310\336\037\000\022\025\n\007reverse\030\004 \001(\010B\004"
+    "\310\336\037\000\"\220\001\n\033InternalRangeLookupResponse\0229\n\006"
+    "header\030\001 \001(\0132\037.cockroach.proto.ResponseH"
+    "eaderB\010\310\336\037\000\320\336\037\001\0226\n\006ranges\030\002 \003(\0132 .cockro"
+    "ach.proto.RangeDescriptorB\004\310\336\037\000\"W\n\033Inter"
+    "nalHeartbeatTxnRequest\0228\n\006header\030\001 \001(\0132\036"
+    ".cockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001"
+    "\"Y\n\034InternalHeartbeatTxnResponse\0229\n\006head"
+    "er\030\001 \001(\0132\037.cockroach.proto.ResponseHeade"
+    "rB\010\310\336\037\000\320\336\037\001\"\235\002\n\021InternalGCRequest\0228\n\006hea"
+    "der\030\001 \001(\0132\036.cockroach.proto.RequestHeade"
+    "rB\010\310\336\037\000\320\336\037\001\022<\n\007gc_meta\030\002 \001(\0132\033.cockroach"
+    ".proto.GCMetadataB\016\310\336\037\000\342\336\037\006GCMeta\022<\n\004key"
+    "s\030\003 \003(\0132(.cockroach.proto.InternalGCRequ"
+    "est.GCKeyB\004\310\336\037\000\032R\n\005GCKey\022\024\n\003key\030\001 \001(\014B\007\372"
+    "\336\037\003Key\0223\n\ttimestamp\030\002 \001(\0132\032.cockroach.pr"
+    "oto.TimestampB\004\310\336\037\000\"O\n\022InternalGCRespons"
+    "e\0229\n\006header\030\001 \001(\0132\037.cockroach.proto.Resp"
+    "onseHeaderB\010\310\336\037\000\320\336\037\001\"\214\002\n\026InternalPushTxn"
+    "Request\0228\n\006header\030\001 \001(\0132\036.cockroach.prot"
+    "o.RequestHeaderB\010\310\336\037\000\320\336\037\001\0226\n\npushee_txn\030"
+    "\002 \001(\0132\034.cockroach.proto.TransactionB\004\310\336\037"
+    "\000\022-\n\003now\030\003 \001(\0132\032.cockroach.proto.Timesta"
+    "mpB\004\310\336\037\000\0225\n\tpush_type\030\004 \001(\0162\034.cockroach."
+    "proto.PushTxnTypeB\004\310\336\037\000\022\032\n\014range_lookup\030"
+    "\005 \001(\010B\004\310\336\037\000\"\206\001\n\027InternalPushTxnResponse\022"
+    "9\n\006header\030\001 \001(\0132\037.cockroach.proto.Respon"
+    "seHeaderB\010\310\336\037\000\320\336\037\001\0220\n\npushee_txn\030\002 \001(\0132\034"
+    ".cockroach.proto.Transaction\"X\n\034Internal"
+    "ResolveIntentRequest\0228\n\006header\030\001 \001(\0132\036.c"
+    "ockroach.proto.RequestHeaderB\010\310\336\037\000\320\336\037\001\"Z"
+    "\n\035InternalResolveIntentResponse\0229\n\006heade"
+    "r\030\001 \001(\0132\037.cockroach.proto.ResponseHeader"
+    "B\010\310\336\037\000\320\336\037\001\"]\n!InternalResolveIntentRange"
+    "Request\0228\n\006header\030\001 \001(\0132\036.cockroach.prot"
+    "o.RequestHeaderB\010\310\336\037\000\320\336\037\001\"_\n\"InternalRes"
+    "olveIntentRangeResponse\0229\n\006header\030\001 \001(\0132"
+    "\037.cockroach.proto.ResponseHeaderB\010\310\336\037\000\320\336"
+    "\037\001\"}\n\024InternalMergeRequest\0228\n\006header\030\001 \001"
+    "(\0132\036.cockroach.proto.RequestHeaderB\010\310\336\037\000"
+    "\320\336\037\001\022+\n\005value\030\002 \001(\0132\026.cockroach.proto.Va"
+    "lueB\004\310\336\037\000\"R\n\025InternalMergeResponse\0229\n\006he"
     "ader\030\001 \001(\0132\037.cockroach.proto.ResponseHea"
-    "derB\010\310\336\037\000\320\336\037\001\"\315\005\n\024InternalRequestUnion\022*"
-    "\n\003get\030\002 \001(\0132\033.cockroach.proto.GetRequest"
-    "H\000\022*\n\003put\030\003 \001(\0132\033.cockroach.proto.PutReq"
-    "uestH\000\022A\n\017conditional_put\030\004 \001(\0132&.cockro"
-    "ach.proto.ConditionalPutRequestH\000\0226\n\tinc"
-    "rement\030\005 \001(\0132!.cockroach.proto.Increment"
-    "RequestH\000\0220\n\006delete\030\006 \001(\0132\036.cockroach.pr"
-    "oto.DeleteRequestH\000\022;\n\014delete_range\030\007 \001("
-    "\0132#.cockroach.proto.DeleteRangeRequestH\000"
-    "\022,\n\004scan\030\010 \001(\0132\034.cockroach.proto.ScanReq"
-    "uestH\000\022A\n\017end_transaction\030\t \001(\0132&.cockro"
-    "ach.proto.EndTransactionRequestH\000\022D\n\021int"
-    "ernal_push_txn\030\036 \001(\0132\'.cockroach.proto.I"
-    "nternalPushTxnRequestH\000\022P\n\027internal_reso"
-    "lve_intent\030\037 \001(\0132-.cockroach.proto.Inter"
-    "nalResolveIntentRequestH\000\022[\n\035internal_re"
-    "solve_intent_range\030  \001(\01322.cockroach.pro"
-    "to.InternalResolveIntentRangeRequestH\000:\004"
-    "\310\240\037\001B\007\n\005value\"\331\005\n\025InternalResponseUnion\022"
-    "+\n\003get\030\002 \001(\0132\034.cockroach.proto.GetRespon"
-    "seH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.proto.PutR"
-    "esponseH\000\022B\n\017conditional_put\030\004 \001(\0132\'.coc"
-    "kroach.proto.ConditionalPutResponseH\000\0227\n"
-    "\tincrement\030\005 \001(\0132\".cockroach.proto.Incre"
-    "mentResponseH\000\0221\n\006delete\030\006 \001(\0132\037.cockroa"
-    "ch.proto.DeleteResponseH\000\022<\n\014delete_rang"
-    "e\030\007 \001(\0132$.cockroach.proto.DeleteRangeRes"
-    "ponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroach.proto."
-    "ScanResponseH\000\022B\n\017end_transaction\030\t \001(\0132"
-    "\'.cockroach.proto.EndTransactionResponse"
+    "derB\010\310\336\037\000\320\336\037\001\"k\n\032InternalTruncateLogRequ"
+    "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
+    "questHeaderB\010\310\336\037\000\320\336\037\001\022\023\n\005index\030\002 \001(\004B\004\310\336"
+    "\037\000\"X\n\033InternalTruncateLogResponse\0229\n\006hea"
+    "der\030\001 \001(\0132\037.cockroach.proto.ResponseHead"
+    "erB\010\310\336\037\000\320\336\037\001\"\203\001\n\032InternalLeaderLeaseRequ"
+    "est\0228\n\006header\030\001 \001(\0132\036.cockroach.proto.Re"
+    "questHeaderB\010\310\336\037\000\320\336\037\001\022+\n\005lease\030\002 \001(\0132\026.c"
+    "ockroach.proto.LeaseB\004\310\336\037\000\"X\n\033InternalLe"
+    "aderLeaseResponse\0229\n\006header\030\001 \001(\0132\037.cock"
+    "roach.proto.ResponseHeaderB\010\310\336\037\000\320\336\037\001\"\316\010\n"
+    "\024InternalRequestUnion\022*\n\003get\030\002 \001(\0132\033.coc"
+    "kroach.proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033"
+    ".cockroach.proto.PutRequestH\000\022A\n\017conditi"
+    "onal_put\030\004 \001(\0132&.cockroach.proto.Conditi"
+    "onalPutRequestH\000\0226\n\tincrement\030\005 \001(\0132!.co"
+    "ckroach.proto.IncrementRequestH\000\0220\n\006dele"
+    "te\030\006 \001(\0132\036.cockroach.proto.DeleteRequest"
+    "H\000\022;\n\014delete_range\030\007 \001(\0132#.cockroach.pro"
+    "to.DeleteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034."
+    "cockroach.proto.ScanRequestH\000\022A\n\017end_tra"
+    "nsaction\030\t \001(\0132&.cockroach.proto.EndTran"
+    "sactionRequestH\000\0221\n\007get_row\030\n \001(\0132\036.cock"
+    "roach.proto.GetRowRequestH\000\0221\n\007put_row\030\013"
+    " \001(\0132\036.cockroach.proto.PutRowRequestH\000\0227"
+    "\n\ndelete_row\030\014 \001(\0132!.cockroach.proto.Del"
+    "eteRowRequestH\000\0225\n\tscan_rows\030\r \001(\0132 .coc"
+    "kroach.proto.ScanRowsRequestH\000\0223\n\010lock_r"
+    "ow\030\016 \001(\0132\037.cockroach.proto.LockRowReques"
+    "tH\000\0227\n\nput_unique\030\017 \001(\0132!.cockroach.prot"
+    "o.PutUniqueRequestH\000\022;\n\014scan_changes\030\020 \001"
+    "(\0132#.cockroach.proto.ScanChangesRequestH"
+    "\000\022D\n\021internal_push_txn\030\036 \001(\0132\'.cockroach"
+    ".proto.InternalPushTxnRequestH\000\022P\n\027inter"
+    "nal_resolve_intent\030\037 \001(\0132-.cockroach.pro"
+    "to.InternalResolveIntentRequestH\000\022[\n\035int"
+    "ernal_resolve_intent_range\030  \001(\01322.cockr"
+    "oach.proto.InternalResolveIntentRangeReq"
+    "uestH\000:\004\310\240\037\001B\007\n\005value\"\341\010\n\025InternalRespon"
+    "seUnion\022+\n\003get\030\002 \001(\0132\034.cockroach.proto.G"
+    "etResponseH\000\022+\n\003put\030\003 \001(\0132\034.cockroach.pr"
+    "oto.PutResponseH\000\022B\n\017conditional_put\030\004 \001"
+    "(\0132\'.cockroach.proto.ConditionalPutRespo"
+    "nseH\000\0227\n\tincrement\030\005 \001(\0132\".cockroach.pro"
+    "to.IncrementResponseH\000\0221\n\006delete\030\006 \001(\0132\037"
+    ".cockroach.proto.DeleteResponseH\000\022<\n\014del"
+    "ete_range\030\007 \001(\0132$.cockroach.proto.Delete"
+    "RangeResponseH\000\022-\n\004scan\030\010 \001(\0132\035.cockroac"
+    "h.proto.ScanResponseH\000\022B\n\017end_transactio"
+    "n\030\t \001(\0132\'.cockroach.proto.EndTransaction"
+    "ResponseH\000\0222\n\007get_row\030\n \001(\0132\037.cockroach."
+    "proto.GetRowResponseH\000\0222\n\007put_row\030\013 \001(\0132"
+    "\037.cockroach.proto.PutRowResponseH\000\0228\n\nde"
+    "lete_row\030\014 \001(\0132\".cockroach.proto.DeleteR"
+    "owResponseH\000\0226\n\tscan_rows\030\r \001(\0132!.cockro"
+    "ach.proto.ScanRowsResponseH\000\0224\n\010lock_row"
+    "\030\016 \001(\0132 .cockroach.proto.LockRowResponse"
+    "H\000\0228\n\nput_unique\030\017 \001(\0132\".cockroach.proto"
+    ".PutUniqueResponseH\000\022<\n\014scan_changes\030\020 \001"
+    "(\0132$.cockroach.proto.ScanChangesResponse"
     "H\000\022E\n\021internal_push_txn\030\036 \001(\0132(.cockroac"
     "h.proto.InternalPushTxnResponseH\000\022Q\n\027int"
     "ernal_resolve_intent\030\037 \001(\0132..cockroach.p"
@@ -1052,7 +1123,7 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "\006header\030\001 \001(\0132\037.cockroach.proto.Response"
     "HeaderB\010\310\336\037\000\320\336\037\001\022\?\n\tresponses\030\002 \003(\0132&.co"
     "ckroach.proto.InternalResponseUnionB\004\310\336\037"
-    "\000\"\351\007\n\024ReadWriteCmdResponse\022+\n\003put\030\001 \001(\0132"
+    "\000\"\307\t\n\024ReadWriteCmdResponse\022+\n\003put\030\001 \001(\0132"
     "\034.cockroach.proto.PutResponseH\000\022B\n\017condi"
     "tional_put\030\002 \001(\0132\'.cockroach.proto.Condi"
     "tionalPutResponseH\000\0227\n\tincrement\030\003 \001(\0132\""
@@ -1061,79 +1132,94 @@ void protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto() {
     "ponseH\000\022<\n\014delete_range\030\005 \001(\0132$.cockroac"
     "h.proto.DeleteRangeResponseH\000\022B\n\017end_tra"
     "nsaction\030\006 \001(\0132\'.cockroach.proto.EndTran"
-    "sactionResponseH\000\022O\n\026internal_heartbeat_"
-    "txn\030\n \001(\0132-.cockroach.proto.InternalHear"
-    "tbeatTxnResponseH\000\022E\n\021internal_push_txn\030"
-    "\013 \001(\0132(.cockroach.proto.InternalPushTxnR"
-    "esponseH\000\022Q\n\027internal_resolve_intent\030\014 \001"
-    "(\0132..cockroach.proto.InternalResolveInte"
-    "ntResponseH\000\022\\\n\035internal_resolve_intent_"
-    "range\030\r \001(\01323.cockroach.proto.InternalRe"
-    "solveIntentRangeResponseH\000\022@\n\016internal_m"
-    "erge\030\016 \001(\0132&.cockroach.proto.InternalMer"
-    "geResponseH\000\022M\n\025internal_truncate_log\030\017 "
-    "\001(\0132,.cockroach.proto.InternalTruncateLo"
-    "gResponseH\000\022:\n\013internal_gc\030\020 \001(\0132#.cockr"
-    "oach.proto.InternalGCResponseH\000\022M\n\025inter"
-    "nal_leader_lease\030\021 \001(\0132,.cockroach.proto"
-    ".InternalLeaderLeaseResponseH\000:\004\310\240\037\001B\007\n\005"
-    "value\"\212\n\n\030InternalRaftCommandUnion\022*\n\003ge"
-    "t\030\002 \001(\0132\033.cockroach.proto.GetRequestH\000\022*"
-    "\n\003put\030\003 \001(\0132\033.cockroach.proto.PutRequest"
-    "H\000\022A\n\017conditional_put\030\004 \001(\0132&.cockroach."
-    "proto.ConditionalPutRequestH\000\0226\n\tincreme"
-    "nt\030\005 \001(\0132!.cockroach.proto.IncrementRequ"
-    "estH\000\0220\n\006delete\030\006 \001(\0132\036.cockroach.proto."
-    "DeleteRequestH\000\022;\n\014delete_range\030\007 \001(\0132#."
-    "cockroach.proto.DeleteRangeRequestH\000\022,\n\004"
-    "scan\030\010 \001(\0132\034.cockroach.proto.ScanRequest"
-    "H\000\022A\n\017end_transaction\030\t \001(\0132&.cockroach."
-    "proto.EndTransactionRequestH\000\022.\n\005batch\030\036"
-    " \001(\0132\035.cockroach.proto.BatchRequestH\000\022L\n"
-    "\025internal_range_lookup\030\037 \001(\0132+.cockroach"
-    ".proto.InternalRangeLookupRequestH\000\022N\n\026i"
-    "nternal_heartbeat_txn\030  \001(\0132,.cockroach."
-    "proto.InternalHeartbeatTxnRequestH\000\022D\n\021i"
-    "nternal_push_txn\030! \001(\0132\'.cockroach.proto"
-    ".InternalPushTxnRequestH\000\022P\n\027internal_re"
-    "solve_intent\030\" \001(\0132-.cockroach.proto.Int"
-    "ernalResolveIntentRequestH\000\022[\n\035internal_"
-    "resolve_intent_range\030# \001(\01322.cockroach.p"
-    "roto.InternalResolveIntentRangeRequestH\000"
-    "\022H\n\027internal_merge_response\030$ \001(\0132%.cock"
-    "roach.proto.InternalMergeRequestH\000\022L\n\025in"
-    "ternal_truncate_log\030% \001(\0132+.cockroach.pr"
-    "oto.InternalTruncateLogRequestH\000\022I\n\013inte"
-    "rnal_gc\030& \001(\0132\".cockroach.proto.Internal"
-    "GCRequestB\016\342\336\037\nInternalGCH\000\022E\n\016internal_"
-    "lease\030\' \001(\0132+.cockroach.proto.InternalLe"
-    "aderLeaseRequestH\000\022\?\n\016internal_batch\030( \001"
-    "(\0132%.cockroach.proto.InternalBatchReques"
-    "tH\000:\004\310\240\037\001B\007\n\005value\"\272\001\n\023InternalRaftComma"
-    "nd\022)\n\007raft_id\030\001 \001(\003B\030\310\336\037\000\342\336\037\006RaftID\372\336\037\006R"
-    "aftID\022:\n\016origin_node_id\030\002 \001(\004B\"\310\336\037\000\342\336\037\014O"
-    "riginNodeID\372\336\037\nRaftNodeID\022<\n\003cmd\030\003 \001(\0132)"
-    ".cockroach.proto.InternalRaftCommandUnio"
-    "nB\004\310\336\037\000\"N\n\022RaftMessageRequest\022+\n\010group_i"
-    "d\030\001 \001(\004B\031\310\336\037\000\342\336\037\007GroupID\372\336\037\006RaftID\022\013\n\003ms"
-    "g\030\002 \001(\014\"\025\n\023RaftMessageResponse\"\236\001\n\026Inter"
-    "nalTimeSeriesData\022#\n\025start_timestamp_nan"
-    "os\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample_duration_nanos\030"
-    "\002 \001(\003B\004\310\336\037\000\022:\n\007samples\030\003 \003(\0132).cockroach"
-    ".proto.InternalTimeSeriesSample\"r\n\030Inter"
-    "nalTimeSeriesSample\022\024\n\006offset\030\001 \001(\005B\004\310\336\037"
-    "\000\022\023\n\005count\030\006 \001(\rB\004\310\336\037\000\022\021\n\003sum\030\007 \001(\001B\004\310\336\037"
-    "\000\022\013\n\003max\030\010 \001(\001\022\013\n\003min\030\t \001(\001\"=\n\022RaftTrunc"
-    "atedState\022\023\n\005index\030\001 \001(\004B\004\310\336\037\000\022\022\n\004term\030\002"
-    " \001(\004B\004\310\336\037\000\"\274\001\n\020RaftSnapshotData\022@\n\020range"
-    "_descriptor\030\001 \001(\0132 .cockroach.proto.Rang"
-    "eDescriptorB\004\310\336\037\000\022>\n\002KV\030\002 \003(\0132*.cockroac"
-    "h.proto.RaftSnapshotData.KeyValueB\006\342\336\037\002K"
-    "V\032&\n\010KeyValue\022\013\n\003key\030\001 \001(\014\022\r\n\005value\030\002 \001("
-    "\014*G\n\013PushTxnType\022\022\n\016PUSH_TIMESTAMP\020\000\022\r\n\t"
-    "ABORT_TXN\020\001\022\017\n\013CLEANUP_TXN\020\002\032\004\210\243\036\000*%\n\021In"
-    "ternalValueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000B\023Z\005pro"
-    "to\340\342\036\001\310\342\036\001\320\342\036\001", 7414);
+    "sactionResponseH\000\0222\n\007put_row\030\007 \001(\0132\037.coc"
+    "kroach.proto.PutRowResponseH\000\0228\n\ndelete_"
+    "row\030\010 \001(\0132\".cockroach.proto.DeleteRowRes"
+    "ponseH\000\0224\n\010lock_row\030\t \001(\0132 .cockroach.pr"
+    "oto.LockRowResponseH\000\022O\n\026internal_heartb"
+    "eat_txn\030\n \001(\0132-.cockroach.proto.Internal"
+    "HeartbeatTxnResponseH\000\022E\n\021internal_push_"
+    "txn\030\013 \001(\0132(.cockroach.proto.InternalPush"
+    "TxnResponseH\000\022Q\n\027internal_resolve_intent"
+    "\030\014 \001(\0132..cockroach.proto.InternalResolve"
+    "IntentResponseH\000\022\\\n\035internal_resolve_int"
+    "ent_range\030\r \001(\01323.cockroach.proto.Intern"
+    "alResolveIntentRangeResponseH\000\022@\n\016intern"
+    "al_merge\030\016 \001(\0132&.cockroach.proto.Interna"
+    "lMergeResponseH\000\022M\n\025internal_truncate_lo"
+    "g\030\017 \001(\0132,.cockroach.proto.InternalTrunca"
+    "teLogResponseH\000\022:\n\013internal_gc\030\020 \001(\0132#.c"
+    "ockroach.proto.InternalGCResponseH\000\022M\n\025i"
+    "nternal_leader_lease\030\021 \001(\0132,.cockroach.p"
+    "roto.InternalLeaderLeaseResponseH\000\0228\n\npu"
+    "t_unique\030\022 \001(\0132\".cockroach.proto.PutUniq"
+    "ueResponseH\000:\004\310\240\037\001B\007\n\005value\"\213\r\n\030Internal"
+    "RaftCommandUnion\022*\n\003get\030\002 \001(\0132\033.cockroac"
+    "h.proto.GetRequestH\000\022*\n\003put\030\003 \001(\0132\033.cock"
+    "roach.proto.PutRequestH\000\022A\n\017conditional_"
+    "put\030\004 \001(\0132&.cockroach.proto.ConditionalP"
+    "utRequestH\000\0226\n\tincrement\030\005 \001(\0132!.cockroa"
+    "ch.proto.IncrementRequestH\000\0220\n\006delete\030\006 "
+    "\001(\0132\036.cockroach.proto.DeleteRequestH\000\022;\n"
+    "\014delete_range\030\007 \001(\0132#.cockroach.proto.De"
+    "leteRangeRequestH\000\022,\n\004scan\030\010 \001(\0132\034.cockr"
+    "oach.proto.ScanRequestH\000\022A\n\017end_transact"
+    "ion\030\t \001(\0132&.cockroach.proto.EndTransacti"
+    "onRequestH\000\0221\n\007get_row\030\n \001(\0132\036.cockroach"
+    ".proto.GetRowRequestH\000\0221\n\007put_row\030\013 \001(\0132"
+    "\036.cockroach.proto.PutRowRequestH\000\0227\n\ndel"
+    "ete_row\030\014 \001(\0132!.cockroach.proto.DeleteRo"
+    "wRequestH\000\0225\n\tscan_rows\030\r \001(\0132 .cockroac"
+    "h.proto.ScanRowsRequestH\000\0223\n\010lock_row\030\016 "
+    "\001(\0132\037.cockroach.proto.LockRowRequestH\000\0227"
+    "\n\nput_unique\030\017 \001(\0132!.cockroach.proto.Put"
+    "UniqueRequestH\000\022;\n\014scan_changes\030\020 \001(\0132#."
+    "cockroach.proto.ScanChangesRequestH\000\022.\n\005"
+    "batch\030\036 \001(\0132\035.cockroach.proto.BatchReque"
+    "stH\000\022L\n\025internal_range_lookup\030\037 \001(\0132+.co"
+    "ckroach.proto.InternalRangeLookupRequest"
+    "H\000\022N\n\026internal_heartbeat_txn\030  \001(\0132,.coc"
+    "kroach.proto.InternalHeartbeatTxnRequest"
+    "H\000\022D\n\021internal_push_txn\030! \001(\0132\'.cockroac"
+    "h.proto.InternalPushTxnRequestH\000\022P\n\027inte"
+    "rnal_resolve_intent\030\" \001(\0132-.cockroach.pr"
+    "oto.InternalResolveIntentRequestH\000\022[\n\035in"
+    "ternal_resolve_intent_range\030# \001(\01322.cock"
+    "roach.proto.InternalResolveIntentRangeRe"
+    "questH\000\022H\n\027internal_merge_response\030$ \001(\013"
+    "2%.cockroach.proto.InternalMergeRequestH"
+    "\000\022L\n\025internal_truncate_log\030% \001(\0132+.cockr"
+    "oach.proto.InternalTruncateLogRequestH\000\022"
+    "I\n\013internal_gc\030& \001(\0132\".cockroach.proto.I"
+    "nternalGCRequestB\016\342\336\037\nInternalGCH\000\022E\n\016in"
+    "ternal_lease\030\' \001(\0132+.cockroach.proto.Int"
+    "ernalLeaderLeaseRequestH\000\022\?\n\016internal_ba"
+    "tch\030( \001(\0132%.cockroach.proto.InternalBatc"
+    "hRequestH\000:\004\310\240\037\001B\007\n\005value\"\272\001\n\023InternalRa"
+    "ftCommand\022)\n\007raft_id\030\001 \001(\003B\030\310\336\037\000\342\336\037\006Raft"
+    "ID\372\336\037\006RaftID\022:\n\016origin_node_id\030\002 \001(\004B\"\310\336"
+    "\037\000\342\336\037\014OriginNodeID\372\336\037\nRaftNodeID\022<\n\003cmd\030"
+    "\003 \001(\0132).cockroach.proto.InternalRaftComm"
+    "andUnionB\004\310\336\037\000\"N\n\022RaftMessageRequest\022+\n\010"
+    "group_id\030\001 \001(\004B\031\310\336\037\000\342\336\037\007GroupID\372\336\037\006RaftI"
+    "D\022\013\n\003msg\030\002 \001(\014\"\025\n\023RaftMessageResponse\"\236\001"
+    "\n\026InternalTimeSeriesData\022#\n\025start_timest"
+    "amp_nanos\030\001 \001(\003B\004\310\336\037\000\022#\n\025sample_duration"
+    "_nanos\030\002 \001(\003B\004\310\336\037\000\022:\n\007samples\030\003 \003(\0132).co"
+    "ckroach.proto.InternalTimeSeriesSample\"r"
+    "\n\030InternalTimeSeriesSample\022\024\n\006offset\030\001 \001"
+    "(\005B\004\310\336\037\000\022\023\n\005count\030\006 \001(\rB\004\310\336\037\000\022\021\n\003sum\030\007 \001"
+    "(\001B\004\310\336\037\000\022\013\n\003max\030\010 \001(\001\022\013\n\003min\030\t \001(\001\"=\n\022Ra"
+    "ftTruncatedState\022\023\n\005index\030\001 \001(\004B\004\310\336\037\000\022\022\n"
+    "\004term\030\002 \001(\004B\004\310\336\037\000\"\274\001\n\020RaftSnapshotData\022@"
+    "\n\020range_descriptor\030\001 \001(\0132 .cockroach.pro"
+    "to.RangeDescriptorB\004\310\336\037\000\022>\n\002KV\030\002 \003(\0132*.c"
+    "ockroach.proto.RaftSnapshotData.KeyValue"
+    "B\006\342\336\037\002KV\032&\n\010KeyValue\022\013\n\003key\030\001 \001(\014\022\r\n\005val"
+    "ue\030\002 \001(\014*G\n\013PushTxnType\022\022\n\016PUSH_TIMESTAM"
+    "P\020\000\022\r\n\tABORT_TXN\020\001\022\017\n\013CLEANUP_TXN\020\002\032\004\210\243\036"
+    "\000*%\n\021InternalValueType\022\n\n\006_CR_TS\020\001\032\004\210\243\036\000"
+    "B\023Z\005proto\340\342\036\001\310\342\036\001\320\342\036\001", 8821);
   ::google::protobuf::MessageFactory::InternalRegisterGeneratedFile(
     "cockroach/proto/internal.proto", &protobuf_RegisterTypes);
   InternalRangeLookupRequest::default_instance_ = new InternalRangeLookupRequest();
@@ -1260,6 +1346,7 @@ static void MergeFromFail(int line) {
 const int InternalRangeLookupRequest::kHeaderFieldNumber;
 const int InternalRangeLookupRequest::kMaxRangesFieldNumber;
 const int InternalRangeLookupRequest::kIgnoreIntentsFieldNumber;
+const int InternalRangeLookupRequest::kReverseFieldNumber;
 #endif  // !_MSC_VER
 
 InternalRangeLookupRequest::InternalRangeLookupRequest()
@@ -1285,6 +1372,7 @@ void InternalRangeLookupRequest::SharedCtor() {
   header_ = NULL;
   max_ranges_ = 0;
   ignore_intents_ = false;
+  reverse_ = false;
   ::memset(_has_bits_, 0, sizeof(_has_bits_));
 }
 
@@ -1333,8 +1421,8 @@ void InternalRangeLookupRequest::Clear() {
            ZR_HELPER_(last) - ZR_HELPER_(first) + sizeof(last));\
 } while (0)
 
-  if (_has_bits_[0 / 32] & 7u) {
-    ZR_(max_ranges_, ignore_intents_);
+  if (_has_bits_[0 / 32] & 15u) {
+    ZR_(max_ranges_, reverse_);
     if (has_header()) {
       if (header_ != NULL) header_->::cockroach::proto::RequestHeader::Clear();
     }
@@ -1397,6 +1485,21 @@ bool InternalRangeLookupRequest::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(32)) goto parse_reverse;
+        break;
+      }
+
+      // optional bool reverse = 4;
+      case 4: {
+        if (tag == 32) {
+         parse_reverse:
+          DO_((::google::protobuf::internal::WireFormatLite::ReadPrimitive<
+                   bool, ::google::protobuf::internal::WireFormatLite::TYPE_BOOL>(
+                 input, &reverse_)));
+          set_has_reverse();
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -1442,6 +1545,11 @@ void InternalRangeLookupRequest::SerializeWithCachedSizes(
     ::google::protobuf::internal::WireFormatLite::WriteBool(3, this->ignore_intents(), output);
   }
 
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    ::google::protobuf::internal::WireFormatLite::WriteBool(4, this->reverse(), output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -1469,6 +1577,11 @@ void InternalRangeLookupRequest::SerializeWithCachedSizes(
     target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(3, this->ignore_intents(), target);
   }
 
+  // optional bool reverse = 4;
+  if (has_reverse()) {
+    target = ::google::protobuf::internal::WireFormatLite::WriteBoolToArray(4, this->reverse(), target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -1480,7 +1593,7 @@ void InternalRangeLookupRequest::SerializeWithCachedSizes(
 int InternalRangeLookupRequest::ByteSize() const {
   int total_size = 0;
 
-  if (_has_bits_[0 / 32] & 7) {
+  if (_has_bits_[0 / 32] & 15) {
     // optional .cockroach.proto.RequestHeader header = 1;
     if (has_header()) {
       total_size += 1 +
@@ -1500,6 +1613,11 @@ int InternalRangeLookupRequest::ByteSize() const {
       total_size += 1 + 1;
     }
 
+    // optional bool reverse = 4;
+    if (has_reverse()) {
+      total_size += 1 + 1;
+    }
+
   }
   if (_internal_metadata_.have_unknown_fields()) {
     total_size +=
@@ -1536,6 +1654,9 @@ void InternalRangeLookupRequest::MergeFrom(const InternalRangeLookupRequest& fro
     if (from.has_ignore_intents()) {
       set_ignore_intents(from.ignore_intents());
     }
+    if (from.has_reverse()) {
+      set_reverse(from.reverse());
+    }
   }
   if (from._internal_metadata_.have_unknown_fields()) {
     mutable_unknown_fields()->MergeFrom(from.unknown_fields());
@@ -1567,6 +1688,7 @@ void InternalRangeLookupRequest::InternalSwap(InternalRangeLookupRequest* other)
   std::swap(header_, other->header_);
   std::swap(max_ranges_, other->max_ranges_);
   std::swap(ignore_intents_, other->ignore_intents_);
+  std::swap(reverse_, other->reverse_);
   std::swap(_has_bits_[0], other->_has_bits_[0]);
   _internal_metadata_.Swap(&other->_internal_metadata_);
   std::swap(_cached_size_, other->_cached_size_);
@@ -1674,6 +1796,30 @@ void InternalRangeLookupRequest::clear_ignore_intents() {
   // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.ignore_intents)
 }
 
+// optional bool reverse = 4;
+bool InternalRangeLookupRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+void InternalRangeLookupRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+void InternalRangeLookupRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+void InternalRangeLookupRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+ bool InternalRangeLookupRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.reverse)
+  return reverse_;
+}
+ void InternalRangeLookupRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.reverse)
+}
+
 #endif  // PROTOBUF_INLINE_NOT_IN_HEADERS
 
 // ===================================================================
@@ -7778,6 +7924,13 @@ const int InternalRequestUnion::kDeleteFieldNumber;
 const int InternalRequestUnion::kDeleteRangeFieldNumber;
 const int InternalRequestUnion::kScanFieldNumber;
 const int InternalRequestUnion::kEndTransactionFieldNumber;
+const int InternalRequestUnion::kGetRowFieldNumber;
+const int InternalRequestUnion::kPutRowFieldNumber;
+const int InternalRequestUnion::kDeleteRowFieldNumber;
+const int InternalRequestUnion::kScanRowsFieldNumber;
+const int InternalRequestUnion::kLockRowFieldNumber;
+const int InternalRequestUnion::kPutUniqueFieldNumber;
+const int InternalRequestUnion::kScanChangesFieldNumber;
 const int InternalRequestUnion::kInternalPushTxnFieldNumber;
 const int InternalRequestUnion::kInternalResolveIntentFieldNumber;
 const int InternalRequestUnion::kInternalResolveIntentRangeFieldNumber;
@@ -7798,6 +7951,13 @@ void InternalRequestUnion::InitAsDefaultInstance() {
   InternalRequestUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->get_row_ = const_cast< ::cockroach::proto::GetRowRequest*>(&::cockroach::proto::GetRowRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->put_row_ = const_cast< ::cockroach::proto::PutRowRequest*>(&::cockroach::proto::PutRowRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->delete_row_ = const_cast< ::cockroach::proto::DeleteRowRequest*>(&::cockroach::proto::DeleteRowRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->scan_rows_ = const_cast< ::cockroach::proto::ScanRowsRequest*>(&::cockroach::proto::ScanRowsRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->lock_row_ = const_cast< ::cockroach::proto::LockRowRequest*>(&::cockroach::proto::LockRowRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->put_unique_ = const_cast< ::cockroach::proto::PutUniqueRequest*>(&::cockroach::proto::PutUniqueRequest::default_instance());
+  InternalRequestUnion_default_oneof_instance_->scan_changes_ = const_cast< ::cockroach::proto::ScanChangesRequest*>(&::cockroach::proto::ScanChangesRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnRequest*>(&::cockroach::proto::InternalPushTxnRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentRequest*>(&::cockroach::proto::InternalResolveIntentRequest::default_instance());
   InternalRequestUnion_default_oneof_instance_->internal_resolve_intent_range_ = const_cast< ::cockroach::proto::InternalResolveIntentRangeRequest*>(&::cockroach::proto::InternalResolveIntentRangeRequest::default_instance());
@@ -7889,6 +8049,34 @@ void InternalRequestUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kGetRow: {
+      delete value_.get_row_;
+      break;
+    }
+    case kPutRow: {
+      delete value_.put_row_;
+      break;
+    }
+    case kDeleteRow: {
+      delete value_.delete_row_;
+      break;
+    }
+    case kScanRows: {
+      delete value_.scan_rows_;
+      break;
+    }
+    case kLockRow: {
+      delete value_.lock_row_;
+      break;
+    }
+    case kPutUnique: {
+      delete value_.put_unique_;
+      break;
+    }
+    case kScanChanges: {
+      delete value_.scan_changes_;
+      break;
+    }
     case kInternalPushTxn: {
       delete value_.internal_push_txn_;
       break;
@@ -8026,6 +8214,97 @@ bool InternalRequestUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(82)) goto parse_get_row;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRowRequest get_row = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_get_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_put_row;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRowRequest put_row = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_put_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_delete_row;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_delete_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_scan_rows;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_scan_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_lock_row;
+        break;
+      }
+
+      // optional .cockroach.proto.LockRowRequest lock_row = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_lock_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_lock_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_put_unique;
+        break;
+      }
+
+      // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_put_unique:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_unique()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(130)) goto parse_scan_changes;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_scan_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan_changes()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectTag(242)) goto parse_internal_push_txn;
         break;
       }
@@ -8142,6 +8421,48 @@ void InternalRequestUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  if (has_get_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.get_row_, output);
+  }
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  if (has_put_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.put_row_, output);
+  }
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  if (has_delete_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.delete_row_, output);
+  }
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  if (has_scan_rows()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.scan_rows_, output);
+  }
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  if (has_lock_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.lock_row_, output);
+  }
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  if (has_put_unique()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, *value_.put_unique_, output);
+  }
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  if (has_scan_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, *value_.scan_changes_, output);
+  }
+
   // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
   if (has_internal_push_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -8226,6 +8547,55 @@ void InternalRequestUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  if (has_get_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.get_row_, target);
+  }
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  if (has_put_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.put_row_, target);
+  }
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  if (has_delete_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.delete_row_, target);
+  }
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  if (has_scan_rows()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.scan_rows_, target);
+  }
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  if (has_lock_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.lock_row_, target);
+  }
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  if (has_put_unique()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, *value_.put_unique_, target);
+  }
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  if (has_scan_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, *value_.scan_changes_, target);
+  }
+
   // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
   if (has_internal_push_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -8315,6 +8685,55 @@ int InternalRequestUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.GetRowRequest get_row = 10;
+    case kGetRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.get_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutRowRequest put_row = 11;
+    case kPutRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_row_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+    case kDeleteRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_row_);
+      break;
+    }
+    // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+    case kScanRows: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_rows_);
+      break;
+    }
+    // optional .cockroach.proto.LockRowRequest lock_row = 14;
+    case kLockRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.lock_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+    case kPutUnique: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_unique_);
+      break;
+    }
+    // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+    case kScanChanges: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_changes_);
+      break;
+    }
     // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
     case kInternalPushTxn: {
       total_size += 2 +
@@ -8398,6 +8817,34 @@ void InternalRequestUnion::MergeFrom(const InternalRequestUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
       break;
     }
+    case kGetRow: {
+      mutable_get_row()->::cockroach::proto::GetRowRequest::MergeFrom(from.get_row());
+      break;
+    }
+    case kPutRow: {
+      mutable_put_row()->::cockroach::proto::PutRowRequest::MergeFrom(from.put_row());
+      break;
+    }
+    case kDeleteRow: {
+      mutable_delete_row()->::cockroach::proto::DeleteRowRequest::MergeFrom(from.delete_row());
+      break;
+    }
+    case kScanRows: {
+      mutable_scan_rows()->::cockroach::proto::ScanRowsRequest::MergeFrom(from.scan_rows());
+      break;
+    }
+    case kLockRow: {
+      mutable_lock_row()->::cockroach::proto::LockRowRequest::MergeFrom(from.lock_row());
+      break;
+    }
+    case kPutUnique: {
+      mutable_put_unique()->::cockroach::proto::PutUniqueRequest::MergeFrom(from.put_unique());
+      break;
+    }
+    case kScanChanges: {
+      mutable_scan_changes()->::cockroach::proto::ScanChangesRequest::MergeFrom(from.scan_changes());
+      break;
+    }
     case kInternalPushTxn: {
       mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnRequest::MergeFrom(from.internal_push_txn());
       break;
@@ -8827,99 +9274,421 @@ void InternalRequestUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.end_transaction)
 }
 
-// optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
-bool InternalRequestUnion::has_internal_push_txn() const {
-  return value_case() == kInternalPushTxn;
+// optional .cockroach.proto.GetRowRequest get_row = 10;
+bool InternalRequestUnion::has_get_row() const {
+  return value_case() == kGetRow;
 }
-void InternalRequestUnion::set_has_internal_push_txn() {
-  _oneof_case_[0] = kInternalPushTxn;
+void InternalRequestUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
 }
-void InternalRequestUnion::clear_internal_push_txn() {
-  if (has_internal_push_txn()) {
-    delete value_.internal_push_txn_;
+void InternalRequestUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
     clear_has_value();
   }
 }
- const ::cockroach::proto::InternalPushTxnRequest& InternalRequestUnion::internal_push_txn() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.internal_push_txn)
-  return has_internal_push_txn() ? *value_.internal_push_txn_
-                      : ::cockroach::proto::InternalPushTxnRequest::default_instance();
+ const ::cockroach::proto::GetRowRequest& InternalRequestUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowRequest::default_instance();
 }
- ::cockroach::proto::InternalPushTxnRequest* InternalRequestUnion::mutable_internal_push_txn() {
-  if (!has_internal_push_txn()) {
+ ::cockroach::proto::GetRowRequest* InternalRequestUnion::mutable_get_row() {
+  if (!has_get_row()) {
     clear_value();
-    set_has_internal_push_txn();
-    value_.internal_push_txn_ = new ::cockroach::proto::InternalPushTxnRequest;
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.internal_push_txn)
-  return value_.internal_push_txn_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.get_row)
+  return value_.get_row_;
 }
- ::cockroach::proto::InternalPushTxnRequest* InternalRequestUnion::release_internal_push_txn() {
-  if (has_internal_push_txn()) {
+ ::cockroach::proto::GetRowRequest* InternalRequestUnion::release_get_row() {
+  if (has_get_row()) {
     clear_has_value();
-    ::cockroach::proto::InternalPushTxnRequest* temp = value_.internal_push_txn_;
-    value_.internal_push_txn_ = NULL;
+    ::cockroach::proto::GetRowRequest* temp = value_.get_row_;
+    value_.get_row_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void InternalRequestUnion::set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnRequest* internal_push_txn) {
+ void InternalRequestUnion::set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row) {
   clear_value();
-  if (internal_push_txn) {
-    set_has_internal_push_txn();
-    value_.internal_push_txn_ = internal_push_txn;
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.internal_push_txn)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.get_row)
 }
 
-// optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 31;
-bool InternalRequestUnion::has_internal_resolve_intent() const {
-  return value_case() == kInternalResolveIntent;
+// optional .cockroach.proto.PutRowRequest put_row = 11;
+bool InternalRequestUnion::has_put_row() const {
+  return value_case() == kPutRow;
 }
-void InternalRequestUnion::set_has_internal_resolve_intent() {
-  _oneof_case_[0] = kInternalResolveIntent;
+void InternalRequestUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
 }
-void InternalRequestUnion::clear_internal_resolve_intent() {
-  if (has_internal_resolve_intent()) {
-    delete value_.internal_resolve_intent_;
+void InternalRequestUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
     clear_has_value();
   }
 }
- const ::cockroach::proto::InternalResolveIntentRequest& InternalRequestUnion::internal_resolve_intent() const {
-  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.internal_resolve_intent)
-  return has_internal_resolve_intent() ? *value_.internal_resolve_intent_
-                      : ::cockroach::proto::InternalResolveIntentRequest::default_instance();
+ const ::cockroach::proto::PutRowRequest& InternalRequestUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowRequest::default_instance();
 }
- ::cockroach::proto::InternalResolveIntentRequest* InternalRequestUnion::mutable_internal_resolve_intent() {
-  if (!has_internal_resolve_intent()) {
+ ::cockroach::proto::PutRowRequest* InternalRequestUnion::mutable_put_row() {
+  if (!has_put_row()) {
     clear_value();
-    set_has_internal_resolve_intent();
-    value_.internal_resolve_intent_ = new ::cockroach::proto::InternalResolveIntentRequest;
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowRequest;
   }
-  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.internal_resolve_intent)
-  return value_.internal_resolve_intent_;
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.put_row)
+  return value_.put_row_;
 }
- ::cockroach::proto::InternalResolveIntentRequest* InternalRequestUnion::release_internal_resolve_intent() {
-  if (has_internal_resolve_intent()) {
+ ::cockroach::proto::PutRowRequest* InternalRequestUnion::release_put_row() {
+  if (has_put_row()) {
     clear_has_value();
-    ::cockroach::proto::InternalResolveIntentRequest* temp = value_.internal_resolve_intent_;
-    value_.internal_resolve_intent_ = NULL;
+    ::cockroach::proto::PutRowRequest* temp = value_.put_row_;
+    value_.put_row_ = NULL;
     return temp;
   } else {
     return NULL;
   }
 }
- void InternalRequestUnion::set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent) {
+ void InternalRequestUnion::set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row) {
   clear_value();
-  if (internal_resolve_intent) {
-    set_has_internal_resolve_intent();
-    value_.internal_resolve_intent_ = internal_resolve_intent;
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
   }
-  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.internal_resolve_intent)
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.put_row)
 }
 
-// optional .cockroach.proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 32;
+// optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+bool InternalRequestUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+void InternalRequestUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+void InternalRequestUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::DeleteRowRequest& InternalRequestUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowRequest::default_instance();
+}
+ ::cockroach::proto::DeleteRowRequest* InternalRequestUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.delete_row)
+  return value_.delete_row_;
+}
+ ::cockroach::proto::DeleteRowRequest* InternalRequestUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowRequest* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+bool InternalRequestUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+void InternalRequestUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+void InternalRequestUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanRowsRequest& InternalRequestUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsRequest::default_instance();
+}
+ ::cockroach::proto::ScanRowsRequest* InternalRequestUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.scan_rows)
+  return value_.scan_rows_;
+}
+ ::cockroach::proto::ScanRowsRequest* InternalRequestUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsRequest* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowRequest lock_row = 14;
+bool InternalRequestUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+void InternalRequestUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+void InternalRequestUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::LockRowRequest& InternalRequestUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowRequest::default_instance();
+}
+ ::cockroach::proto::LockRowRequest* InternalRequestUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.lock_row)
+  return value_.lock_row_;
+}
+ ::cockroach::proto::LockRowRequest* InternalRequestUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowRequest* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+bool InternalRequestUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+void InternalRequestUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+void InternalRequestUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutUniqueRequest& InternalRequestUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueRequest::default_instance();
+}
+ ::cockroach::proto::PutUniqueRequest* InternalRequestUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.put_unique)
+  return value_.put_unique_;
+}
+ ::cockroach::proto::PutUniqueRequest* InternalRequestUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueRequest* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+bool InternalRequestUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+void InternalRequestUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+void InternalRequestUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanChangesRequest& InternalRequestUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesRequest::default_instance();
+}
+ ::cockroach::proto::ScanChangesRequest* InternalRequestUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.scan_changes)
+  return value_.scan_changes_;
+}
+ ::cockroach::proto::ScanChangesRequest* InternalRequestUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesRequest* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.scan_changes)
+}
+
+// optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
+bool InternalRequestUnion::has_internal_push_txn() const {
+  return value_case() == kInternalPushTxn;
+}
+void InternalRequestUnion::set_has_internal_push_txn() {
+  _oneof_case_[0] = kInternalPushTxn;
+}
+void InternalRequestUnion::clear_internal_push_txn() {
+  if (has_internal_push_txn()) {
+    delete value_.internal_push_txn_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::InternalPushTxnRequest& InternalRequestUnion::internal_push_txn() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.internal_push_txn)
+  return has_internal_push_txn() ? *value_.internal_push_txn_
+                      : ::cockroach::proto::InternalPushTxnRequest::default_instance();
+}
+ ::cockroach::proto::InternalPushTxnRequest* InternalRequestUnion::mutable_internal_push_txn() {
+  if (!has_internal_push_txn()) {
+    clear_value();
+    set_has_internal_push_txn();
+    value_.internal_push_txn_ = new ::cockroach::proto::InternalPushTxnRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.internal_push_txn)
+  return value_.internal_push_txn_;
+}
+ ::cockroach::proto::InternalPushTxnRequest* InternalRequestUnion::release_internal_push_txn() {
+  if (has_internal_push_txn()) {
+    clear_has_value();
+    ::cockroach::proto::InternalPushTxnRequest* temp = value_.internal_push_txn_;
+    value_.internal_push_txn_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_internal_push_txn(::cockroach::proto::InternalPushTxnRequest* internal_push_txn) {
+  clear_value();
+  if (internal_push_txn) {
+    set_has_internal_push_txn();
+    value_.internal_push_txn_ = internal_push_txn;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.internal_push_txn)
+}
+
+// optional .cockroach.proto.InternalResolveIntentRequest internal_resolve_intent = 31;
+bool InternalRequestUnion::has_internal_resolve_intent() const {
+  return value_case() == kInternalResolveIntent;
+}
+void InternalRequestUnion::set_has_internal_resolve_intent() {
+  _oneof_case_[0] = kInternalResolveIntent;
+}
+void InternalRequestUnion::clear_internal_resolve_intent() {
+  if (has_internal_resolve_intent()) {
+    delete value_.internal_resolve_intent_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::InternalResolveIntentRequest& InternalRequestUnion::internal_resolve_intent() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.internal_resolve_intent)
+  return has_internal_resolve_intent() ? *value_.internal_resolve_intent_
+                      : ::cockroach::proto::InternalResolveIntentRequest::default_instance();
+}
+ ::cockroach::proto::InternalResolveIntentRequest* InternalRequestUnion::mutable_internal_resolve_intent() {
+  if (!has_internal_resolve_intent()) {
+    clear_value();
+    set_has_internal_resolve_intent();
+    value_.internal_resolve_intent_ = new ::cockroach::proto::InternalResolveIntentRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.internal_resolve_intent)
+  return value_.internal_resolve_intent_;
+}
+ ::cockroach::proto::InternalResolveIntentRequest* InternalRequestUnion::release_internal_resolve_intent() {
+  if (has_internal_resolve_intent()) {
+    clear_has_value();
+    ::cockroach::proto::InternalResolveIntentRequest* temp = value_.internal_resolve_intent_;
+    value_.internal_resolve_intent_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRequestUnion::set_allocated_internal_resolve_intent(::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent) {
+  clear_value();
+  if (internal_resolve_intent) {
+    set_has_internal_resolve_intent();
+    value_.internal_resolve_intent_ = internal_resolve_intent;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.internal_resolve_intent)
+}
+
+// optional .cockroach.proto.InternalResolveIntentRangeRequest internal_resolve_intent_range = 32;
 bool InternalRequestUnion::has_internal_resolve_intent_range() const {
   return value_case() == kInternalResolveIntentRange;
 }
@@ -8987,6 +9756,13 @@ const int InternalResponseUnion::kDeleteFieldNumber;
 const int InternalResponseUnion::kDeleteRangeFieldNumber;
 const int InternalResponseUnion::kScanFieldNumber;
 const int InternalResponseUnion::kEndTransactionFieldNumber;
+const int InternalResponseUnion::kGetRowFieldNumber;
+const int InternalResponseUnion::kPutRowFieldNumber;
+const int InternalResponseUnion::kDeleteRowFieldNumber;
+const int InternalResponseUnion::kScanRowsFieldNumber;
+const int InternalResponseUnion::kLockRowFieldNumber;
+const int InternalResponseUnion::kPutUniqueFieldNumber;
+const int InternalResponseUnion::kScanChangesFieldNumber;
 const int InternalResponseUnion::kInternalPushTxnFieldNumber;
 const int InternalResponseUnion::kInternalResolveIntentFieldNumber;
 const int InternalResponseUnion::kInternalResolveIntentRangeFieldNumber;
@@ -9007,6 +9783,13 @@ void InternalResponseUnion::InitAsDefaultInstance() {
   InternalResponseUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanResponse*>(&::cockroach::proto::ScanResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->get_row_ = const_cast< ::cockroach::proto::GetRowResponse*>(&::cockroach::proto::GetRowResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->put_row_ = const_cast< ::cockroach::proto::PutRowResponse*>(&::cockroach::proto::PutRowResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->delete_row_ = const_cast< ::cockroach::proto::DeleteRowResponse*>(&::cockroach::proto::DeleteRowResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->scan_rows_ = const_cast< ::cockroach::proto::ScanRowsResponse*>(&::cockroach::proto::ScanRowsResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->lock_row_ = const_cast< ::cockroach::proto::LockRowResponse*>(&::cockroach::proto::LockRowResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->put_unique_ = const_cast< ::cockroach::proto::PutUniqueResponse*>(&::cockroach::proto::PutUniqueResponse::default_instance());
+  InternalResponseUnion_default_oneof_instance_->scan_changes_ = const_cast< ::cockroach::proto::ScanChangesResponse*>(&::cockroach::proto::ScanChangesResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnResponse*>(&::cockroach::proto::InternalPushTxnResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentResponse*>(&::cockroach::proto::InternalResolveIntentResponse::default_instance());
   InternalResponseUnion_default_oneof_instance_->internal_resolve_intent_range_ = const_cast< ::cockroach::proto::InternalResolveIntentRangeResponse*>(&::cockroach::proto::InternalResolveIntentRangeResponse::default_instance());
@@ -9098,6 +9881,34 @@ void InternalResponseUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kGetRow: {
+      delete value_.get_row_;
+      break;
+    }
+    case kPutRow: {
+      delete value_.put_row_;
+      break;
+    }
+    case kDeleteRow: {
+      delete value_.delete_row_;
+      break;
+    }
+    case kScanRows: {
+      delete value_.scan_rows_;
+      break;
+    }
+    case kLockRow: {
+      delete value_.lock_row_;
+      break;
+    }
+    case kPutUnique: {
+      delete value_.put_unique_;
+      break;
+    }
+    case kScanChanges: {
+      delete value_.scan_changes_;
+      break;
+    }
     case kInternalPushTxn: {
       delete value_.internal_push_txn_;
       break;
@@ -9235,6 +10046,97 @@ bool InternalResponseUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(82)) goto parse_get_row;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRowResponse get_row = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_get_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_put_row;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRowResponse put_row = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_put_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_delete_row;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_delete_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_scan_rows;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_scan_rows:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan_rows()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(114)) goto parse_lock_row;
+        break;
+      }
+
+      // optional .cockroach.proto.LockRowResponse lock_row = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_lock_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_lock_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(122)) goto parse_put_unique;
+        break;
+      }
+
+      // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_put_unique:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_unique()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(130)) goto parse_scan_changes;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_scan_changes:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan_changes()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectTag(242)) goto parse_internal_push_txn;
         break;
       }
@@ -9351,6 +10253,48 @@ void InternalResponseUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.GetRowResponse get_row = 10;
+  if (has_get_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.get_row_, output);
+  }
+
+  // optional .cockroach.proto.PutRowResponse put_row = 11;
+  if (has_put_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.put_row_, output);
+  }
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+  if (has_delete_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.delete_row_, output);
+  }
+
+  // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+  if (has_scan_rows()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.scan_rows_, output);
+  }
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 14;
+  if (has_lock_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.lock_row_, output);
+  }
+
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+  if (has_put_unique()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, *value_.put_unique_, output);
+  }
+
+  // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+  if (has_scan_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, *value_.scan_changes_, output);
+  }
+
   // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
   if (has_internal_push_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -9435,6 +10379,55 @@ void InternalResponseUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.GetRowResponse get_row = 10;
+  if (has_get_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.get_row_, target);
+  }
+
+  // optional .cockroach.proto.PutRowResponse put_row = 11;
+  if (has_put_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.put_row_, target);
+  }
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+  if (has_delete_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.delete_row_, target);
+  }
+
+  // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+  if (has_scan_rows()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.scan_rows_, target);
+  }
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 14;
+  if (has_lock_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.lock_row_, target);
+  }
+
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+  if (has_put_unique()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, *value_.put_unique_, target);
+  }
+
+  // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+  if (has_scan_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, *value_.scan_changes_, target);
+  }
+
   // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
   if (has_internal_push_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -9524,6 +10517,55 @@ int InternalResponseUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.GetRowResponse get_row = 10;
+    case kGetRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.get_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutRowResponse put_row = 11;
+    case kPutRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_row_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+    case kDeleteRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_row_);
+      break;
+    }
+    // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+    case kScanRows: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_rows_);
+      break;
+    }
+    // optional .cockroach.proto.LockRowResponse lock_row = 14;
+    case kLockRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.lock_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+    case kPutUnique: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_unique_);
+      break;
+    }
+    // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+    case kScanChanges: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_changes_);
+      break;
+    }
     // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
     case kInternalPushTxn: {
       total_size += 2 +
@@ -9607,6 +10649,34 @@ void InternalResponseUnion::MergeFrom(const InternalResponseUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
       break;
     }
+    case kGetRow: {
+      mutable_get_row()->::cockroach::proto::GetRowResponse::MergeFrom(from.get_row());
+      break;
+    }
+    case kPutRow: {
+      mutable_put_row()->::cockroach::proto::PutRowResponse::MergeFrom(from.put_row());
+      break;
+    }
+    case kDeleteRow: {
+      mutable_delete_row()->::cockroach::proto::DeleteRowResponse::MergeFrom(from.delete_row());
+      break;
+    }
+    case kScanRows: {
+      mutable_scan_rows()->::cockroach::proto::ScanRowsResponse::MergeFrom(from.scan_rows());
+      break;
+    }
+    case kLockRow: {
+      mutable_lock_row()->::cockroach::proto::LockRowResponse::MergeFrom(from.lock_row());
+      break;
+    }
+    case kPutUnique: {
+      mutable_put_unique()->::cockroach::proto::PutUniqueResponse::MergeFrom(from.put_unique());
+      break;
+    }
+    case kScanChanges: {
+      mutable_scan_changes()->::cockroach::proto::ScanChangesResponse::MergeFrom(from.scan_changes());
+      break;
+    }
     case kInternalPushTxn: {
       mutable_internal_push_txn()->::cockroach::proto::InternalPushTxnResponse::MergeFrom(from.internal_push_txn());
       break;
@@ -10036,6 +11106,328 @@ void InternalResponseUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowResponse get_row = 10;
+bool InternalResponseUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+void InternalResponseUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+void InternalResponseUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::GetRowResponse& InternalResponseUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowResponse::default_instance();
+}
+ ::cockroach::proto::GetRowResponse* InternalResponseUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.get_row)
+  return value_.get_row_;
+}
+ ::cockroach::proto::GetRowResponse* InternalResponseUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowResponse* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_get_row(::cockroach::proto::GetRowResponse* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowResponse put_row = 11;
+bool InternalResponseUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+void InternalResponseUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+void InternalResponseUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutRowResponse& InternalResponseUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowResponse::default_instance();
+}
+ ::cockroach::proto::PutRowResponse* InternalResponseUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.put_row)
+  return value_.put_row_;
+}
+ ::cockroach::proto::PutRowResponse* InternalResponseUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowResponse* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+bool InternalResponseUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+void InternalResponseUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+void InternalResponseUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::DeleteRowResponse& InternalResponseUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowResponse::default_instance();
+}
+ ::cockroach::proto::DeleteRowResponse* InternalResponseUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.delete_row)
+  return value_.delete_row_;
+}
+ ::cockroach::proto::DeleteRowResponse* InternalResponseUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowResponse* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+bool InternalResponseUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+void InternalResponseUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+void InternalResponseUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanRowsResponse& InternalResponseUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsResponse::default_instance();
+}
+ ::cockroach::proto::ScanRowsResponse* InternalResponseUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan_rows)
+  return value_.scan_rows_;
+}
+ ::cockroach::proto::ScanRowsResponse* InternalResponseUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsResponse* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsResponse* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowResponse lock_row = 14;
+bool InternalResponseUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+void InternalResponseUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+void InternalResponseUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::LockRowResponse& InternalResponseUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowResponse::default_instance();
+}
+ ::cockroach::proto::LockRowResponse* InternalResponseUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.lock_row)
+  return value_.lock_row_;
+}
+ ::cockroach::proto::LockRowResponse* InternalResponseUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowResponse* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+bool InternalResponseUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+void InternalResponseUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+void InternalResponseUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutUniqueResponse& InternalResponseUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueResponse::default_instance();
+}
+ ::cockroach::proto::PutUniqueResponse* InternalResponseUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.put_unique)
+  return value_.put_unique_;
+}
+ ::cockroach::proto::PutUniqueResponse* InternalResponseUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueResponse* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+bool InternalResponseUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+void InternalResponseUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+void InternalResponseUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanChangesResponse& InternalResponseUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesResponse::default_instance();
+}
+ ::cockroach::proto::ScanChangesResponse* InternalResponseUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan_changes)
+  return value_.scan_changes_;
+}
+ ::cockroach::proto::ScanChangesResponse* InternalResponseUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesResponse* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalResponseUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesResponse* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan_changes)
+}
+
 // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
 bool InternalResponseUnion::has_internal_push_txn() const {
   return value_case() == kInternalPushTxn;
@@ -10904,6 +12296,9 @@ const int ReadWriteCmdResponse::kIncrementFieldNumber;
 const int ReadWriteCmdResponse::kDeleteFieldNumber;
 const int ReadWriteCmdResponse::kDeleteRangeFieldNumber;
 const int ReadWriteCmdResponse::kEndTransactionFieldNumber;
+const int ReadWriteCmdResponse::kPutRowFieldNumber;
+const int ReadWriteCmdResponse::kDeleteRowFieldNumber;
+const int ReadWriteCmdResponse::kLockRowFieldNumber;
 const int ReadWriteCmdResponse::kInternalHeartbeatTxnFieldNumber;
 const int ReadWriteCmdResponse::kInternalPushTxnFieldNumber;
 const int ReadWriteCmdResponse::kInternalResolveIntentFieldNumber;
@@ -10912,6 +12307,7 @@ const int ReadWriteCmdResponse::kInternalMergeFieldNumber;
 const int ReadWriteCmdResponse::kInternalTruncateLogFieldNumber;
 const int ReadWriteCmdResponse::kInternalGcFieldNumber;
 const int ReadWriteCmdResponse::kInternalLeaderLeaseFieldNumber;
+const int ReadWriteCmdResponse::kPutUniqueFieldNumber;
 #endif  // !_MSC_VER
 
 ReadWriteCmdResponse::ReadWriteCmdResponse()
@@ -10927,6 +12323,9 @@ void ReadWriteCmdResponse::InitAsDefaultInstance() {
   ReadWriteCmdResponse_default_oneof_instance_->delete__ = const_cast< ::cockroach::proto::DeleteResponse*>(&::cockroach::proto::DeleteResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeResponse*>(&::cockroach::proto::DeleteRangeResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionResponse*>(&::cockroach::proto::EndTransactionResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->put_row_ = const_cast< ::cockroach::proto::PutRowResponse*>(&::cockroach::proto::PutRowResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->delete_row_ = const_cast< ::cockroach::proto::DeleteRowResponse*>(&::cockroach::proto::DeleteRowResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->lock_row_ = const_cast< ::cockroach::proto::LockRowResponse*>(&::cockroach::proto::LockRowResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnResponse*>(&::cockroach::proto::InternalHeartbeatTxnResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_push_txn_ = const_cast< ::cockroach::proto::InternalPushTxnResponse*>(&::cockroach::proto::InternalPushTxnResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_resolve_intent_ = const_cast< ::cockroach::proto::InternalResolveIntentResponse*>(&::cockroach::proto::InternalResolveIntentResponse::default_instance());
@@ -10935,6 +12334,7 @@ void ReadWriteCmdResponse::InitAsDefaultInstance() {
   ReadWriteCmdResponse_default_oneof_instance_->internal_truncate_log_ = const_cast< ::cockroach::proto::InternalTruncateLogResponse*>(&::cockroach::proto::InternalTruncateLogResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_gc_ = const_cast< ::cockroach::proto::InternalGCResponse*>(&::cockroach::proto::InternalGCResponse::default_instance());
   ReadWriteCmdResponse_default_oneof_instance_->internal_leader_lease_ = const_cast< ::cockroach::proto::InternalLeaderLeaseResponse*>(&::cockroach::proto::InternalLeaderLeaseResponse::default_instance());
+  ReadWriteCmdResponse_default_oneof_instance_->put_unique_ = const_cast< ::cockroach::proto::PutUniqueResponse*>(&::cockroach::proto::PutUniqueResponse::default_instance());
 }
 
 ReadWriteCmdResponse::ReadWriteCmdResponse(const ReadWriteCmdResponse& from)
@@ -11015,6 +12415,18 @@ void ReadWriteCmdResponse::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kPutRow: {
+      delete value_.put_row_;
+      break;
+    }
+    case kDeleteRow: {
+      delete value_.delete_row_;
+      break;
+    }
+    case kLockRow: {
+      delete value_.lock_row_;
+      break;
+    }
     case kInternalHeartbeatTxn: {
       delete value_.internal_heartbeat_txn_;
       break;
@@ -11047,6 +12459,10 @@ void ReadWriteCmdResponse::clear_value() {
       delete value_.internal_leader_lease_;
       break;
     }
+    case kPutUnique: {
+      delete value_.put_unique_;
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -11146,6 +12562,45 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(58)) goto parse_put_row;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRowResponse put_row = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_put_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_delete_row;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_delete_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_lock_row;
+        break;
+      }
+
+      // optional .cockroach.proto.LockRowResponse lock_row = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_lock_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_lock_row()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectTag(82)) goto parse_internal_heartbeat_txn;
         break;
       }
@@ -11250,6 +12705,19 @@ bool ReadWriteCmdResponse::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
+        if (input->ExpectTag(146)) goto parse_put_unique;
+        break;
+      }
+
+      // optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+      case 18: {
+        if (tag == 146) {
+         parse_put_unique:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_unique()));
+        } else {
+          goto handle_unusual;
+        }
         if (input->ExpectAtEnd()) goto success;
         break;
       }
@@ -11315,6 +12783,24 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
       6, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.PutRowResponse put_row = 7;
+  if (has_put_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      7, *value_.put_row_, output);
+  }
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+  if (has_delete_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      8, *value_.delete_row_, output);
+  }
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 9;
+  if (has_lock_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      9, *value_.lock_row_, output);
+  }
+
   // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
   if (has_internal_heartbeat_txn()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -11363,6 +12849,12 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
       17, *value_.internal_leader_lease_, output);
   }
 
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+  if (has_put_unique()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      18, *value_.put_unique_, output);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     ::google::protobuf::internal::WireFormat::SerializeUnknownFields(
         unknown_fields(), output);
@@ -11415,6 +12907,27 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
         6, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.PutRowResponse put_row = 7;
+  if (has_put_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        7, *value_.put_row_, target);
+  }
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+  if (has_delete_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        8, *value_.delete_row_, target);
+  }
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 9;
+  if (has_lock_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        9, *value_.lock_row_, target);
+  }
+
   // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
   if (has_internal_heartbeat_txn()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -11471,6 +12984,13 @@ void ReadWriteCmdResponse::SerializeWithCachedSizes(
         17, *value_.internal_leader_lease_, target);
   }
 
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+  if (has_put_unique()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        18, *value_.put_unique_, target);
+  }
+
   if (_internal_metadata_.have_unknown_fields()) {
     target = ::google::protobuf::internal::WireFormat::SerializeUnknownFieldsToArray(
         unknown_fields(), target);
@@ -11525,6 +13045,27 @@ int ReadWriteCmdResponse::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.PutRowResponse put_row = 7;
+    case kPutRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_row_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+    case kDeleteRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_row_);
+      break;
+    }
+    // optional .cockroach.proto.LockRowResponse lock_row = 9;
+    case kLockRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.lock_row_);
+      break;
+    }
     // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
     case kInternalHeartbeatTxn: {
       total_size += 1 +
@@ -11581,6 +13122,13 @@ int ReadWriteCmdResponse::ByteSize() const {
           *value_.internal_leader_lease_);
       break;
     }
+    // optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+    case kPutUnique: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_unique_);
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -11635,6 +13183,18 @@ void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionResponse::MergeFrom(from.end_transaction());
       break;
     }
+    case kPutRow: {
+      mutable_put_row()->::cockroach::proto::PutRowResponse::MergeFrom(from.put_row());
+      break;
+    }
+    case kDeleteRow: {
+      mutable_delete_row()->::cockroach::proto::DeleteRowResponse::MergeFrom(from.delete_row());
+      break;
+    }
+    case kLockRow: {
+      mutable_lock_row()->::cockroach::proto::LockRowResponse::MergeFrom(from.lock_row());
+      break;
+    }
     case kInternalHeartbeatTxn: {
       mutable_internal_heartbeat_txn()->::cockroach::proto::InternalHeartbeatTxnResponse::MergeFrom(from.internal_heartbeat_txn());
       break;
@@ -11667,6 +13227,10 @@ void ReadWriteCmdResponse::MergeFrom(const ReadWriteCmdResponse& from) {
       mutable_internal_leader_lease()->::cockroach::proto::InternalLeaderLeaseResponse::MergeFrom(from.internal_leader_lease());
       break;
     }
+    case kPutUnique: {
+      mutable_put_unique()->::cockroach::proto::PutUniqueResponse::MergeFrom(from.put_unique());
+      break;
+    }
     case VALUE_NOT_SET: {
       break;
     }
@@ -11992,6 +13556,144 @@ void ReadWriteCmdResponse::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.end_transaction)
 }
 
+// optional .cockroach.proto.PutRowResponse put_row = 7;
+bool ReadWriteCmdResponse::has_put_row() const {
+  return value_case() == kPutRow;
+}
+void ReadWriteCmdResponse::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+void ReadWriteCmdResponse::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutRowResponse& ReadWriteCmdResponse::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowResponse::default_instance();
+}
+ ::cockroach::proto::PutRowResponse* ReadWriteCmdResponse::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.put_row)
+  return value_.put_row_;
+}
+ ::cockroach::proto::PutRowResponse* ReadWriteCmdResponse::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowResponse* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+bool ReadWriteCmdResponse::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+void ReadWriteCmdResponse::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+void ReadWriteCmdResponse::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::DeleteRowResponse& ReadWriteCmdResponse::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowResponse::default_instance();
+}
+ ::cockroach::proto::DeleteRowResponse* ReadWriteCmdResponse::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.delete_row)
+  return value_.delete_row_;
+}
+ ::cockroach::proto::DeleteRowResponse* ReadWriteCmdResponse::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowResponse* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.delete_row)
+}
+
+// optional .cockroach.proto.LockRowResponse lock_row = 9;
+bool ReadWriteCmdResponse::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+void ReadWriteCmdResponse::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+void ReadWriteCmdResponse::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::LockRowResponse& ReadWriteCmdResponse::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowResponse::default_instance();
+}
+ ::cockroach::proto::LockRowResponse* ReadWriteCmdResponse::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.lock_row)
+  return value_.lock_row_;
+}
+ ::cockroach::proto::LockRowResponse* ReadWriteCmdResponse::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowResponse* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.lock_row)
+}
+
 // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
 bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
   return value_case() == kInternalHeartbeatTxn;
@@ -12360,6 +14062,52 @@ void ReadWriteCmdResponse::clear_internal_leader_lease() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_leader_lease)
 }
 
+// optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+bool ReadWriteCmdResponse::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+void ReadWriteCmdResponse::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+void ReadWriteCmdResponse::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutUniqueResponse& ReadWriteCmdResponse::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueResponse::default_instance();
+}
+ ::cockroach::proto::PutUniqueResponse* ReadWriteCmdResponse::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.put_unique)
+  return value_.put_unique_;
+}
+ ::cockroach::proto::PutUniqueResponse* ReadWriteCmdResponse::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueResponse* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void ReadWriteCmdResponse::set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.put_unique)
+}
+
 bool ReadWriteCmdResponse::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -12382,6 +14130,13 @@ const int InternalRaftCommandUnion::kDeleteFieldNumber;
 const int InternalRaftCommandUnion::kDeleteRangeFieldNumber;
 const int InternalRaftCommandUnion::kScanFieldNumber;
 const int InternalRaftCommandUnion::kEndTransactionFieldNumber;
+const int InternalRaftCommandUnion::kGetRowFieldNumber;
+const int InternalRaftCommandUnion::kPutRowFieldNumber;
+const int InternalRaftCommandUnion::kDeleteRowFieldNumber;
+const int InternalRaftCommandUnion::kScanRowsFieldNumber;
+const int InternalRaftCommandUnion::kLockRowFieldNumber;
+const int InternalRaftCommandUnion::kPutUniqueFieldNumber;
+const int InternalRaftCommandUnion::kScanChangesFieldNumber;
 const int InternalRaftCommandUnion::kBatchFieldNumber;
 const int InternalRaftCommandUnion::kInternalRangeLookupFieldNumber;
 const int InternalRaftCommandUnion::kInternalHeartbeatTxnFieldNumber;
@@ -12410,6 +14165,13 @@ void InternalRaftCommandUnion::InitAsDefaultInstance() {
   InternalRaftCommandUnion_default_oneof_instance_->delete_range_ = const_cast< ::cockroach::proto::DeleteRangeRequest*>(&::cockroach::proto::DeleteRangeRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->scan_ = const_cast< ::cockroach::proto::ScanRequest*>(&::cockroach::proto::ScanRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->end_transaction_ = const_cast< ::cockroach::proto::EndTransactionRequest*>(&::cockroach::proto::EndTransactionRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->get_row_ = const_cast< ::cockroach::proto::GetRowRequest*>(&::cockroach::proto::GetRowRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->put_row_ = const_cast< ::cockroach::proto::PutRowRequest*>(&::cockroach::proto::PutRowRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->delete_row_ = const_cast< ::cockroach::proto::DeleteRowRequest*>(&::cockroach::proto::DeleteRowRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->scan_rows_ = const_cast< ::cockroach::proto::ScanRowsRequest*>(&::cockroach::proto::ScanRowsRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->lock_row_ = const_cast< ::cockroach::proto::LockRowRequest*>(&::cockroach::proto::LockRowRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->put_unique_ = const_cast< ::cockroach::proto::PutUniqueRequest*>(&::cockroach::proto::PutUniqueRequest::default_instance());
+  InternalRaftCommandUnion_default_oneof_instance_->scan_changes_ = const_cast< ::cockroach::proto::ScanChangesRequest*>(&::cockroach::proto::ScanChangesRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->batch_ = const_cast< ::cockroach::proto::BatchRequest*>(&::cockroach::proto::BatchRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->internal_range_lookup_ = const_cast< ::cockroach::proto::InternalRangeLookupRequest*>(&::cockroach::proto::InternalRangeLookupRequest::default_instance());
   InternalRaftCommandUnion_default_oneof_instance_->internal_heartbeat_txn_ = const_cast< ::cockroach::proto::InternalHeartbeatTxnRequest*>(&::cockroach::proto::InternalHeartbeatTxnRequest::default_instance());
@@ -12509,6 +14271,34 @@ void InternalRaftCommandUnion::clear_value() {
       delete value_.end_transaction_;
       break;
     }
+    case kGetRow: {
+      delete value_.get_row_;
+      break;
+    }
+    case kPutRow: {
+      delete value_.put_row_;
+      break;
+    }
+    case kDeleteRow: {
+      delete value_.delete_row_;
+      break;
+    }
+    case kScanRows: {
+      delete value_.scan_rows_;
+      break;
+    }
+    case kLockRow: {
+      delete value_.lock_row_;
+      break;
+    }
+    case kPutUnique: {
+      delete value_.put_unique_;
+      break;
+    }
+    case kScanChanges: {
+      delete value_.scan_changes_;
+      break;
+    }
     case kBatch: {
       delete value_.batch_;
       break;
@@ -12626,55 +14416,146 @@ bool InternalRaftCommandUnion::MergePartialFromCodedStream(
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(50)) goto parse_delete;
+        if (input->ExpectTag(50)) goto parse_delete;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRequest delete = 6;
+      case 6: {
+        if (tag == 50) {
+         parse_delete:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(58)) goto parse_delete_range;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
+      case 7: {
+        if (tag == 58) {
+         parse_delete_range:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_range()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(66)) goto parse_scan;
+        break;
+      }
+
+      // optional .cockroach.proto.ScanRequest scan = 8;
+      case 8: {
+        if (tag == 66) {
+         parse_scan:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_scan()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(74)) goto parse_end_transaction;
+        break;
+      }
+
+      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
+      case 9: {
+        if (tag == 74) {
+         parse_end_transaction:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_end_transaction()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(82)) goto parse_get_row;
+        break;
+      }
+
+      // optional .cockroach.proto.GetRowRequest get_row = 10;
+      case 10: {
+        if (tag == 82) {
+         parse_get_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_get_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(90)) goto parse_put_row;
+        break;
+      }
+
+      // optional .cockroach.proto.PutRowRequest put_row = 11;
+      case 11: {
+        if (tag == 90) {
+         parse_put_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_put_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(98)) goto parse_delete_row;
+        break;
+      }
+
+      // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+      case 12: {
+        if (tag == 98) {
+         parse_delete_row:
+          DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
+               input, mutable_delete_row()));
+        } else {
+          goto handle_unusual;
+        }
+        if (input->ExpectTag(106)) goto parse_scan_rows;
         break;
       }
 
-      // optional .cockroach.proto.DeleteRequest delete = 6;
-      case 6: {
-        if (tag == 50) {
-         parse_delete:
+      // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+      case 13: {
+        if (tag == 106) {
+         parse_scan_rows:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_()));
+               input, mutable_scan_rows()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(58)) goto parse_delete_range;
+        if (input->ExpectTag(114)) goto parse_lock_row;
         break;
       }
 
-      // optional .cockroach.proto.DeleteRangeRequest delete_range = 7;
-      case 7: {
-        if (tag == 58) {
-         parse_delete_range:
+      // optional .cockroach.proto.LockRowRequest lock_row = 14;
+      case 14: {
+        if (tag == 114) {
+         parse_lock_row:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_delete_range()));
+               input, mutable_lock_row()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(66)) goto parse_scan;
+        if (input->ExpectTag(122)) goto parse_put_unique;
         break;
       }
 
-      // optional .cockroach.proto.ScanRequest scan = 8;
-      case 8: {
-        if (tag == 66) {
-         parse_scan:
+      // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+      case 15: {
+        if (tag == 122) {
+         parse_put_unique:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_scan()));
+               input, mutable_put_unique()));
         } else {
           goto handle_unusual;
         }
-        if (input->ExpectTag(74)) goto parse_end_transaction;
+        if (input->ExpectTag(130)) goto parse_scan_changes;
         break;
       }
 
-      // optional .cockroach.proto.EndTransactionRequest end_transaction = 9;
-      case 9: {
-        if (tag == 74) {
-         parse_end_transaction:
+      // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+      case 16: {
+        if (tag == 130) {
+         parse_scan_changes:
           DO_(::google::protobuf::internal::WireFormatLite::ReadMessageNoVirtual(
-               input, mutable_end_transaction()));
+               input, mutable_scan_changes()));
         } else {
           goto handle_unusual;
         }
@@ -12898,6 +14779,48 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
       9, *value_.end_transaction_, output);
   }
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  if (has_get_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      10, *value_.get_row_, output);
+  }
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  if (has_put_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      11, *value_.put_row_, output);
+  }
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  if (has_delete_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      12, *value_.delete_row_, output);
+  }
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  if (has_scan_rows()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      13, *value_.scan_rows_, output);
+  }
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  if (has_lock_row()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      14, *value_.lock_row_, output);
+  }
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  if (has_put_unique()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      15, *value_.put_unique_, output);
+  }
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  if (has_scan_changes()) {
+    ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
+      16, *value_.scan_changes_, output);
+  }
+
   // optional .cockroach.proto.BatchRequest batch = 30;
   if (has_batch()) {
     ::google::protobuf::internal::WireFormatLite::WriteMessageMaybeToArray(
@@ -13030,6 +14953,55 @@ void InternalRaftCommandUnion::SerializeWithCachedSizes(
         9, *value_.end_transaction_, target);
   }
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  if (has_get_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        10, *value_.get_row_, target);
+  }
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  if (has_put_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        11, *value_.put_row_, target);
+  }
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  if (has_delete_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        12, *value_.delete_row_, target);
+  }
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  if (has_scan_rows()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        13, *value_.scan_rows_, target);
+  }
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  if (has_lock_row()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        14, *value_.lock_row_, target);
+  }
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  if (has_put_unique()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        15, *value_.put_unique_, target);
+  }
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  if (has_scan_changes()) {
+    target = ::google::protobuf::internal::WireFormatLite::
+      WriteMessageNoVirtualToArray(
+        16, *value_.scan_changes_, target);
+  }
+
   // optional .cockroach.proto.BatchRequest batch = 30;
   if (has_batch()) {
     target = ::google::protobuf::internal::WireFormatLite::
@@ -13175,6 +15147,55 @@ int InternalRaftCommandUnion::ByteSize() const {
           *value_.end_transaction_);
       break;
     }
+    // optional .cockroach.proto.GetRowRequest get_row = 10;
+    case kGetRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.get_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutRowRequest put_row = 11;
+    case kPutRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_row_);
+      break;
+    }
+    // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+    case kDeleteRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.delete_row_);
+      break;
+    }
+    // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+    case kScanRows: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_rows_);
+      break;
+    }
+    // optional .cockroach.proto.LockRowRequest lock_row = 14;
+    case kLockRow: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.lock_row_);
+      break;
+    }
+    // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+    case kPutUnique: {
+      total_size += 1 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.put_unique_);
+      break;
+    }
+    // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+    case kScanChanges: {
+      total_size += 2 +
+        ::google::protobuf::internal::WireFormatLite::MessageSizeNoVirtual(
+          *value_.scan_changes_);
+      break;
+    }
     // optional .cockroach.proto.BatchRequest batch = 30;
     case kBatch: {
       total_size += 2 +
@@ -13314,6 +15335,34 @@ void InternalRaftCommandUnion::MergeFrom(const InternalRaftCommandUnion& from) {
       mutable_end_transaction()->::cockroach::proto::EndTransactionRequest::MergeFrom(from.end_transaction());
       break;
     }
+    case kGetRow: {
+      mutable_get_row()->::cockroach::proto::GetRowRequest::MergeFrom(from.get_row());
+      break;
+    }
+    case kPutRow: {
+      mutable_put_row()->::cockroach::proto::PutRowRequest::MergeFrom(from.put_row());
+      break;
+    }
+    case kDeleteRow: {
+      mutable_delete_row()->::cockroach::proto::DeleteRowRequest::MergeFrom(from.delete_row());
+      break;
+    }
+    case kScanRows: {
+      mutable_scan_rows()->::cockroach::proto::ScanRowsRequest::MergeFrom(from.scan_rows());
+      break;
+    }
+    case kLockRow: {
+      mutable_lock_row()->::cockroach::proto::LockRowRequest::MergeFrom(from.lock_row());
+      break;
+    }
+    case kPutUnique: {
+      mutable_put_unique()->::cockroach::proto::PutUniqueRequest::MergeFrom(from.put_unique());
+      break;
+    }
+    case kScanChanges: {
+      mutable_scan_changes()->::cockroach::proto::ScanChangesRequest::MergeFrom(from.scan_changes());
+      break;
+    }
     case kBatch: {
       mutable_batch()->::cockroach::proto::BatchRequest::MergeFrom(from.batch());
       break;
@@ -13775,6 +15824,328 @@ void InternalRaftCommandUnion::clear_end_transaction() {
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowRequest get_row = 10;
+bool InternalRaftCommandUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+void InternalRaftCommandUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+void InternalRaftCommandUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::GetRowRequest& InternalRaftCommandUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowRequest::default_instance();
+}
+ ::cockroach::proto::GetRowRequest* InternalRaftCommandUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.get_row)
+  return value_.get_row_;
+}
+ ::cockroach::proto::GetRowRequest* InternalRaftCommandUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowRequest* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowRequest put_row = 11;
+bool InternalRaftCommandUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+void InternalRaftCommandUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+void InternalRaftCommandUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutRowRequest& InternalRaftCommandUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowRequest::default_instance();
+}
+ ::cockroach::proto::PutRowRequest* InternalRaftCommandUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.put_row)
+  return value_.put_row_;
+}
+ ::cockroach::proto::PutRowRequest* InternalRaftCommandUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowRequest* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+bool InternalRaftCommandUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+void InternalRaftCommandUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+void InternalRaftCommandUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::DeleteRowRequest& InternalRaftCommandUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowRequest::default_instance();
+}
+ ::cockroach::proto::DeleteRowRequest* InternalRaftCommandUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.delete_row)
+  return value_.delete_row_;
+}
+ ::cockroach::proto::DeleteRowRequest* InternalRaftCommandUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowRequest* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+bool InternalRaftCommandUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+void InternalRaftCommandUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+void InternalRaftCommandUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanRowsRequest& InternalRaftCommandUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsRequest::default_instance();
+}
+ ::cockroach::proto::ScanRowsRequest* InternalRaftCommandUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.scan_rows)
+  return value_.scan_rows_;
+}
+ ::cockroach::proto::ScanRowsRequest* InternalRaftCommandUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsRequest* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowRequest lock_row = 14;
+bool InternalRaftCommandUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+void InternalRaftCommandUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+void InternalRaftCommandUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::LockRowRequest& InternalRaftCommandUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowRequest::default_instance();
+}
+ ::cockroach::proto::LockRowRequest* InternalRaftCommandUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.lock_row)
+  return value_.lock_row_;
+}
+ ::cockroach::proto::LockRowRequest* InternalRaftCommandUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowRequest* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+bool InternalRaftCommandUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+void InternalRaftCommandUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+void InternalRaftCommandUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::PutUniqueRequest& InternalRaftCommandUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueRequest::default_instance();
+}
+ ::cockroach::proto::PutUniqueRequest* InternalRaftCommandUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.put_unique)
+  return value_.put_unique_;
+}
+ ::cockroach::proto::PutUniqueRequest* InternalRaftCommandUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueRequest* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+bool InternalRaftCommandUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+void InternalRaftCommandUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+void InternalRaftCommandUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+ const ::cockroach::proto::ScanChangesRequest& InternalRaftCommandUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesRequest::default_instance();
+}
+ ::cockroach::proto::ScanChangesRequest* InternalRaftCommandUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.scan_changes)
+  return value_.scan_changes_;
+}
+ ::cockroach::proto::ScanChangesRequest* InternalRaftCommandUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesRequest* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+ void InternalRaftCommandUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.scan_changes)
+}
+
 // optional .cockroach.proto.BatchRequest batch = 30;
 bool InternalRaftCommandUnion::has_batch() const {
   return value_case() == kBatch;
@@ -203,6 +203,13 @@ class InternalRangeLookupRequest : public ::google::protobuf::Message {
   bool ignore_intents() const;
   void set_ignore_intents(bool value);
 
+  // optional bool reverse = 4;
+  bool has_reverse() const;
+  void clear_reverse();
+  static const int kReverseFieldNumber = 4;
+  bool reverse() const;
+  void set_reverse(bool value);
+
   // @@protoc_insertion_point(class_scope:cockroach.proto.InternalRangeLookupRequest)
  private:
   inline void set_has_header();
@@ -211,6 +218,8 @@ class InternalRangeLookupRequest : public ::google::protobuf::Message {
   inline void clear_has_max_ranges();
   inline void set_has_ignore_intents();
   inline void clear_has_ignore_intents();
+  inline void set_has_reverse();
+  inline void clear_has_reverse();
 
   ::google::protobuf::internal::InternalMetadataWithArena _internal_metadata_;
   ::google::protobuf::uint32 _has_bits_[1];
@@ -218,6 +227,7 @@ class InternalRangeLookupRequest : public ::google::protobuf::Message {
   ::cockroach::proto::RequestHeader* header_;
   ::google::protobuf::int32 max_ranges_;
   bool ignore_intents_;
+  bool reverse_;
   friend void  protobuf_AddDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_AssignDesc_cockroach_2fproto_2finternal_2eproto();
   friend void protobuf_ShutdownFile_cockroach_2fproto_2finternal_2eproto();
@@ -2042,6 +2052,13 @@ class InternalRequestUnion : public ::google::protobuf::Message {
     kDeleteRange = 7,
     kScan = 8,
     kEndTransaction = 9,
+    kGetRow = 10,
+    kPutRow = 11,
+    kDeleteRow = 12,
+    kScanRows = 13,
+    kLockRow = 14,
+    kPutUnique = 15,
+    kScanChanges = 16,
     kInternalPushTxn = 30,
     kInternalResolveIntent = 31,
     kInternalResolveIntentRange = 32,
@@ -2161,6 +2178,69 @@ class InternalRequestUnion : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionRequest* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  bool has_get_row() const;
+  void clear_get_row();
+  static const int kGetRowFieldNumber = 10;
+  const ::cockroach::proto::GetRowRequest& get_row() const;
+  ::cockroach::proto::GetRowRequest* mutable_get_row();
+  ::cockroach::proto::GetRowRequest* release_get_row();
+  void set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row);
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  bool has_put_row() const;
+  void clear_put_row();
+  static const int kPutRowFieldNumber = 11;
+  const ::cockroach::proto::PutRowRequest& put_row() const;
+  ::cockroach::proto::PutRowRequest* mutable_put_row();
+  ::cockroach::proto::PutRowRequest* release_put_row();
+  void set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row);
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  bool has_delete_row() const;
+  void clear_delete_row();
+  static const int kDeleteRowFieldNumber = 12;
+  const ::cockroach::proto::DeleteRowRequest& delete_row() const;
+  ::cockroach::proto::DeleteRowRequest* mutable_delete_row();
+  ::cockroach::proto::DeleteRowRequest* release_delete_row();
+  void set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row);
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  bool has_scan_rows() const;
+  void clear_scan_rows();
+  static const int kScanRowsFieldNumber = 13;
+  const ::cockroach::proto::ScanRowsRequest& scan_rows() const;
+  ::cockroach::proto::ScanRowsRequest* mutable_scan_rows();
+  ::cockroach::proto::ScanRowsRequest* release_scan_rows();
+  void set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows);
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  bool has_lock_row() const;
+  void clear_lock_row();
+  static const int kLockRowFieldNumber = 14;
+  const ::cockroach::proto::LockRowRequest& lock_row() const;
+  ::cockroach::proto::LockRowRequest* mutable_lock_row();
+  ::cockroach::proto::LockRowRequest* release_lock_row();
+  void set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row);
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  bool has_put_unique() const;
+  void clear_put_unique();
+  static const int kPutUniqueFieldNumber = 15;
+  const ::cockroach::proto::PutUniqueRequest& put_unique() const;
+  ::cockroach::proto::PutUniqueRequest* mutable_put_unique();
+  ::cockroach::proto::PutUniqueRequest* release_put_unique();
+  void set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique);
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  bool has_scan_changes() const;
+  void clear_scan_changes();
+  static const int kScanChangesFieldNumber = 16;
+  const ::cockroach::proto::ScanChangesRequest& scan_changes() const;
+  ::cockroach::proto::ScanChangesRequest* mutable_scan_changes();
+  ::cockroach::proto::ScanChangesRequest* release_scan_changes();
+  void set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes);
+
   // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
   bool has_internal_push_txn() const;
   void clear_internal_push_txn();
@@ -2199,6 +2279,13 @@ class InternalRequestUnion : public ::google::protobuf::Message {
   inline void set_has_delete_range();
   inline void set_has_scan();
   inline void set_has_end_transaction();
+  inline void set_has_get_row();
+  inline void set_has_put_row();
+  inline void set_has_delete_row();
+  inline void set_has_scan_rows();
+  inline void set_has_lock_row();
+  inline void set_has_put_unique();
+  inline void set_has_scan_changes();
   inline void set_has_internal_push_txn();
   inline void set_has_internal_resolve_intent();
   inline void set_has_internal_resolve_intent_range();
@@ -2220,6 +2307,13 @@ class InternalRequestUnion : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteRangeRequest* delete_range_;
     ::cockroach::proto::ScanRequest* scan_;
     ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::GetRowRequest* get_row_;
+    ::cockroach::proto::PutRowRequest* put_row_;
+    ::cockroach::proto::DeleteRowRequest* delete_row_;
+    ::cockroach::proto::ScanRowsRequest* scan_rows_;
+    ::cockroach::proto::LockRowRequest* lock_row_;
+    ::cockroach::proto::PutUniqueRequest* put_unique_;
+    ::cockroach::proto::ScanChangesRequest* scan_changes_;
     ::cockroach::proto::InternalPushTxnRequest* internal_push_txn_;
     ::cockroach::proto::InternalResolveIntentRequest* internal_resolve_intent_;
     ::cockroach::proto::InternalResolveIntentRangeRequest* internal_resolve_intent_range_;
@@ -2267,6 +2361,13 @@ class InternalResponseUnion : public ::google::protobuf::Message {
     kDeleteRange = 7,
     kScan = 8,
     kEndTransaction = 9,
+    kGetRow = 10,
+    kPutRow = 11,
+    kDeleteRow = 12,
+    kScanRows = 13,
+    kLockRow = 14,
+    kPutUnique = 15,
+    kScanChanges = 16,
     kInternalPushTxn = 30,
     kInternalResolveIntent = 31,
     kInternalResolveIntentRange = 32,
@@ -2386,6 +2487,69 @@ class InternalResponseUnion : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionResponse* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
 
+  // optional .cockroach.proto.GetRowResponse get_row = 10;
+  bool has_get_row() const;
+  void clear_get_row();
+  static const int kGetRowFieldNumber = 10;
+  const ::cockroach::proto::GetRowResponse& get_row() const;
+  ::cockroach::proto::GetRowResponse* mutable_get_row();
+  ::cockroach::proto::GetRowResponse* release_get_row();
+  void set_allocated_get_row(::cockroach::proto::GetRowResponse* get_row);
+
+  // optional .cockroach.proto.PutRowResponse put_row = 11;
+  bool has_put_row() const;
+  void clear_put_row();
+  static const int kPutRowFieldNumber = 11;
+  const ::cockroach::proto::PutRowResponse& put_row() const;
+  ::cockroach::proto::PutRowResponse* mutable_put_row();
+  ::cockroach::proto::PutRowResponse* release_put_row();
+  void set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row);
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+  bool has_delete_row() const;
+  void clear_delete_row();
+  static const int kDeleteRowFieldNumber = 12;
+  const ::cockroach::proto::DeleteRowResponse& delete_row() const;
+  ::cockroach::proto::DeleteRowResponse* mutable_delete_row();
+  ::cockroach::proto::DeleteRowResponse* release_delete_row();
+  void set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row);
+
+  // optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+  bool has_scan_rows() const;
+  void clear_scan_rows();
+  static const int kScanRowsFieldNumber = 13;
+  const ::cockroach::proto::ScanRowsResponse& scan_rows() const;
+  ::cockroach::proto::ScanRowsResponse* mutable_scan_rows();
+  ::cockroach::proto::ScanRowsResponse* release_scan_rows();
+  void set_allocated_scan_rows(::cockroach::proto::ScanRowsResponse* scan_rows);
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 14;
+  bool has_lock_row() const;
+  void clear_lock_row();
+  static const int kLockRowFieldNumber = 14;
+  const ::cockroach::proto::LockRowResponse& lock_row() const;
+  ::cockroach::proto::LockRowResponse* mutable_lock_row();
+  ::cockroach::proto::LockRowResponse* release_lock_row();
+  void set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row);
+
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+  bool has_put_unique() const;
+  void clear_put_unique();
+  static const int kPutUniqueFieldNumber = 15;
+  const ::cockroach::proto::PutUniqueResponse& put_unique() const;
+  ::cockroach::proto::PutUniqueResponse* mutable_put_unique();
+  ::cockroach::proto::PutUniqueResponse* release_put_unique();
+  void set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique);
+
+  // optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+  bool has_scan_changes() const;
+  void clear_scan_changes();
+  static const int kScanChangesFieldNumber = 16;
+  const ::cockroach::proto::ScanChangesResponse& scan_changes() const;
+  ::cockroach::proto::ScanChangesResponse* mutable_scan_changes();
+  ::cockroach::proto::ScanChangesResponse* release_scan_changes();
+  void set_allocated_scan_changes(::cockroach::proto::ScanChangesResponse* scan_changes);
+
   // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
   bool has_internal_push_txn() const;
   void clear_internal_push_txn();
@@ -2424,6 +2588,13 @@ class InternalResponseUnion : public ::google::protobuf::Message {
   inline void set_has_delete_range();
   inline void set_has_scan();
   inline void set_has_end_transaction();
+  inline void set_has_get_row();
+  inline void set_has_put_row();
+  inline void set_has_delete_row();
+  inline void set_has_scan_rows();
+  inline void set_has_lock_row();
+  inline void set_has_put_unique();
+  inline void set_has_scan_changes();
   inline void set_has_internal_push_txn();
   inline void set_has_internal_resolve_intent();
   inline void set_has_internal_resolve_intent_range();
@@ -2445,6 +2616,13 @@ class InternalResponseUnion : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteRangeResponse* delete_range_;
     ::cockroach::proto::ScanResponse* scan_;
     ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::GetRowResponse* get_row_;
+    ::cockroach::proto::PutRowResponse* put_row_;
+    ::cockroach::proto::DeleteRowResponse* delete_row_;
+    ::cockroach::proto::ScanRowsResponse* scan_rows_;
+    ::cockroach::proto::LockRowResponse* lock_row_;
+    ::cockroach::proto::PutUniqueResponse* put_unique_;
+    ::cockroach::proto::ScanChangesResponse* scan_changes_;
     ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
     ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
     ::cockroach::proto::InternalResolveIntentRangeResponse* internal_resolve_intent_range_;
@@ -2698,6 +2876,9 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     kDelete = 4,
     kDeleteRange = 5,
     kEndTransaction = 6,
+    kPutRow = 7,
+    kDeleteRow = 8,
+    kLockRow = 9,
     kInternalHeartbeatTxn = 10,
     kInternalPushTxn = 11,
     kInternalResolveIntent = 12,
@@ -2706,6 +2887,7 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     kInternalTruncateLog = 15,
     kInternalGc = 16,
     kInternalLeaderLease = 17,
+    kPutUnique = 18,
     VALUE_NOT_SET = 0,
   };
 
@@ -2804,6 +2986,33 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionResponse* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionResponse* end_transaction);
 
+  // optional .cockroach.proto.PutRowResponse put_row = 7;
+  bool has_put_row() const;
+  void clear_put_row();
+  static const int kPutRowFieldNumber = 7;
+  const ::cockroach::proto::PutRowResponse& put_row() const;
+  ::cockroach::proto::PutRowResponse* mutable_put_row();
+  ::cockroach::proto::PutRowResponse* release_put_row();
+  void set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row);
+
+  // optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+  bool has_delete_row() const;
+  void clear_delete_row();
+  static const int kDeleteRowFieldNumber = 8;
+  const ::cockroach::proto::DeleteRowResponse& delete_row() const;
+  ::cockroach::proto::DeleteRowResponse* mutable_delete_row();
+  ::cockroach::proto::DeleteRowResponse* release_delete_row();
+  void set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row);
+
+  // optional .cockroach.proto.LockRowResponse lock_row = 9;
+  bool has_lock_row() const;
+  void clear_lock_row();
+  static const int kLockRowFieldNumber = 9;
+  const ::cockroach::proto::LockRowResponse& lock_row() const;
+  ::cockroach::proto::LockRowResponse* mutable_lock_row();
+  ::cockroach::proto::LockRowResponse* release_lock_row();
+  void set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row);
+
   // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
   bool has_internal_heartbeat_txn() const;
   void clear_internal_heartbeat_txn();
@@ -2876,6 +3085,15 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   ::cockroach::proto::InternalLeaderLeaseResponse* release_internal_leader_lease();
   void set_allocated_internal_leader_lease(::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease);
 
+  // optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+  bool has_put_unique() const;
+  void clear_put_unique();
+  static const int kPutUniqueFieldNumber = 18;
+  const ::cockroach::proto::PutUniqueResponse& put_unique() const;
+  ::cockroach::proto::PutUniqueResponse* mutable_put_unique();
+  ::cockroach::proto::PutUniqueResponse* release_put_unique();
+  void set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique);
+
   ValueCase value_case() const;
   // @@protoc_insertion_point(class_scope:cockroach.proto.ReadWriteCmdResponse)
  private:
@@ -2885,6 +3103,9 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   inline void set_has_delete_();
   inline void set_has_delete_range();
   inline void set_has_end_transaction();
+  inline void set_has_put_row();
+  inline void set_has_delete_row();
+  inline void set_has_lock_row();
   inline void set_has_internal_heartbeat_txn();
   inline void set_has_internal_push_txn();
   inline void set_has_internal_resolve_intent();
@@ -2893,6 +3114,7 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
   inline void set_has_internal_truncate_log();
   inline void set_has_internal_gc();
   inline void set_has_internal_leader_lease();
+  inline void set_has_put_unique();
 
   inline bool has_value() const;
   void clear_value();
@@ -2909,6 +3131,9 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteResponse* delete__;
     ::cockroach::proto::DeleteRangeResponse* delete_range_;
     ::cockroach::proto::EndTransactionResponse* end_transaction_;
+    ::cockroach::proto::PutRowResponse* put_row_;
+    ::cockroach::proto::DeleteRowResponse* delete_row_;
+    ::cockroach::proto::LockRowResponse* lock_row_;
     ::cockroach::proto::InternalHeartbeatTxnResponse* internal_heartbeat_txn_;
     ::cockroach::proto::InternalPushTxnResponse* internal_push_txn_;
     ::cockroach::proto::InternalResolveIntentResponse* internal_resolve_intent_;
@@ -2917,6 +3142,7 @@ class ReadWriteCmdResponse : public ::google::protobuf::Message {
     ::cockroach::proto::InternalTruncateLogResponse* internal_truncate_log_;
     ::cockroach::proto::InternalGCResponse* internal_gc_;
     ::cockroach::proto::InternalLeaderLeaseResponse* internal_leader_lease_;
+    ::cockroach::proto::PutUniqueResponse* put_unique_;
   } value_;
   ::google::protobuf::uint32 _oneof_case_[1];
 
@@ -2961,6 +3187,13 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
     kDeleteRange = 7,
     kScan = 8,
     kEndTransaction = 9,
+    kGetRow = 10,
+    kPutRow = 11,
+    kDeleteRow = 12,
+    kScanRows = 13,
+    kLockRow = 14,
+    kPutUnique = 15,
+    kScanChanges = 16,
     kBatch = 30,
     kInternalRangeLookup = 31,
     kInternalHeartbeatTxn = 32,
@@ -3088,6 +3321,69 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   ::cockroach::proto::EndTransactionRequest* release_end_transaction();
   void set_allocated_end_transaction(::cockroach::proto::EndTransactionRequest* end_transaction);
 
+  // optional .cockroach.proto.GetRowRequest get_row = 10;
+  bool has_get_row() const;
+  void clear_get_row();
+  static const int kGetRowFieldNumber = 10;
+  const ::cockroach::proto::GetRowRequest& get_row() const;
+  ::cockroach::proto::GetRowRequest* mutable_get_row();
+  ::cockroach::proto::GetRowRequest* release_get_row();
+  void set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row);
+
+  // optional .cockroach.proto.PutRowRequest put_row = 11;
+  bool has_put_row() const;
+  void clear_put_row();
+  static const int kPutRowFieldNumber = 11;
+  const ::cockroach::proto::PutRowRequest& put_row() const;
+  ::cockroach::proto::PutRowRequest* mutable_put_row();
+  ::cockroach::proto::PutRowRequest* release_put_row();
+  void set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row);
+
+  // optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+  bool has_delete_row() const;
+  void clear_delete_row();
+  static const int kDeleteRowFieldNumber = 12;
+  const ::cockroach::proto::DeleteRowRequest& delete_row() const;
+  ::cockroach::proto::DeleteRowRequest* mutable_delete_row();
+  ::cockroach::proto::DeleteRowRequest* release_delete_row();
+  void set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row);
+
+  // optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+  bool has_scan_rows() const;
+  void clear_scan_rows();
+  static const int kScanRowsFieldNumber = 13;
+  const ::cockroach::proto::ScanRowsRequest& scan_rows() const;
+  ::cockroach::proto::ScanRowsRequest* mutable_scan_rows();
+  ::cockroach::proto::ScanRowsRequest* release_scan_rows();
+  void set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows);
+
+  // optional .cockroach.proto.LockRowRequest lock_row = 14;
+  bool has_lock_row() const;
+  void clear_lock_row();
+  static const int kLockRowFieldNumber = 14;
+  const ::cockroach::proto::LockRowRequest& lock_row() const;
+  ::cockroach::proto::LockRowRequest* mutable_lock_row();
+  ::cockroach::proto::LockRowRequest* release_lock_row();
+  void set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row);
+
+  // optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+  bool has_put_unique() const;
+  void clear_put_unique();
+  static const int kPutUniqueFieldNumber = 15;
+  const ::cockroach::proto::PutUniqueRequest& put_unique() const;
+  ::cockroach::proto::PutUniqueRequest* mutable_put_unique();
+  ::cockroach::proto::PutUniqueRequest* release_put_unique();
+  void set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique);
+
+  // optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+  bool has_scan_changes() const;
+  void clear_scan_changes();
+  static const int kScanChangesFieldNumber = 16;
+  const ::cockroach::proto::ScanChangesRequest& scan_changes() const;
+  ::cockroach::proto::ScanChangesRequest* mutable_scan_changes();
+  ::cockroach::proto::ScanChangesRequest* release_scan_changes();
+  void set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes);
+
   // optional .cockroach.proto.BatchRequest batch = 30;
   bool has_batch() const;
   void clear_batch();
@@ -3198,6 +3494,13 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
   inline void set_has_delete_range();
   inline void set_has_scan();
   inline void set_has_end_transaction();
+  inline void set_has_get_row();
+  inline void set_has_put_row();
+  inline void set_has_delete_row();
+  inline void set_has_scan_rows();
+  inline void set_has_lock_row();
+  inline void set_has_put_unique();
+  inline void set_has_scan_changes();
   inline void set_has_batch();
   inline void set_has_internal_range_lookup();
   inline void set_has_internal_heartbeat_txn();
@@ -3227,6 +3530,13 @@ class InternalRaftCommandUnion : public ::google::protobuf::Message {
     ::cockroach::proto::DeleteRangeRequest* delete_range_;
     ::cockroach::proto::ScanRequest* scan_;
     ::cockroach::proto::EndTransactionRequest* end_transaction_;
+    ::cockroach::proto::GetRowRequest* get_row_;
+    ::cockroach::proto::PutRowRequest* put_row_;
+    ::cockroach::proto::DeleteRowRequest* delete_row_;
+    ::cockroach::proto::ScanRowsRequest* scan_rows_;
+    ::cockroach::proto::LockRowRequest* lock_row_;
+    ::cockroach::proto::PutUniqueRequest* put_unique_;
+    ::cockroach::proto::ScanChangesRequest* scan_changes_;
     ::cockroach::proto::BatchRequest* batch_;
     ::cockroach::proto::InternalRangeLookupRequest* internal_range_lookup_;
     ::cockroach::proto::InternalHeartbeatTxnRequest* internal_heartbeat_txn_;
@@ -4196,6 +4506,30 @@ inline void InternalRangeLookupRequest::set_ignore_intents(bool value) {
   // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.ignore_intents)
 }
 
+// optional bool reverse = 4;
+inline bool InternalRangeLookupRequest::has_reverse() const {
+  return (_has_bits_[0] & 0x00000008u) != 0;
+}
+inline void InternalRangeLookupRequest::set_has_reverse() {
+  _has_bits_[0] |= 0x00000008u;
+}
+inline void InternalRangeLookupRequest::clear_has_reverse() {
+  _has_bits_[0] &= ~0x00000008u;
+}
+inline void InternalRangeLookupRequest::clear_reverse() {
+  reverse_ = false;
+  clear_has_reverse();
+}
+inline bool InternalRangeLookupRequest::reverse() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRangeLookupRequest.reverse)
+  return reverse_;
+}
+inline void InternalRangeLookupRequest::set_reverse(bool value) {
+  set_has_reverse();
+  reverse_ = value;
+  // @@protoc_insertion_point(field_set:cockroach.proto.InternalRangeLookupRequest.reverse)
+}
+
 // -------------------------------------------------------------------
 
 // InternalRangeLookupResponse
@@ -5858,6 +6192,328 @@ inline void InternalRequestUnion::set_allocated_end_transaction(::cockroach::pro
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowRequest get_row = 10;
+inline bool InternalRequestUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+inline void InternalRequestUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+inline void InternalRequestUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::GetRowRequest& InternalRequestUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowRequest::default_instance();
+}
+inline ::cockroach::proto::GetRowRequest* InternalRequestUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.get_row)
+  return value_.get_row_;
+}
+inline ::cockroach::proto::GetRowRequest* InternalRequestUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowRequest* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowRequest put_row = 11;
+inline bool InternalRequestUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+inline void InternalRequestUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+inline void InternalRequestUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRowRequest& InternalRequestUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowRequest::default_instance();
+}
+inline ::cockroach::proto::PutRowRequest* InternalRequestUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.put_row)
+  return value_.put_row_;
+}
+inline ::cockroach::proto::PutRowRequest* InternalRequestUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowRequest* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+inline bool InternalRequestUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+inline void InternalRequestUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+inline void InternalRequestUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRowRequest& InternalRequestUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowRequest::default_instance();
+}
+inline ::cockroach::proto::DeleteRowRequest* InternalRequestUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.delete_row)
+  return value_.delete_row_;
+}
+inline ::cockroach::proto::DeleteRowRequest* InternalRequestUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowRequest* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+inline bool InternalRequestUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+inline void InternalRequestUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+inline void InternalRequestUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanRowsRequest& InternalRequestUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsRequest::default_instance();
+}
+inline ::cockroach::proto::ScanRowsRequest* InternalRequestUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.scan_rows)
+  return value_.scan_rows_;
+}
+inline ::cockroach::proto::ScanRowsRequest* InternalRequestUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsRequest* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowRequest lock_row = 14;
+inline bool InternalRequestUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+inline void InternalRequestUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+inline void InternalRequestUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::LockRowRequest& InternalRequestUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowRequest::default_instance();
+}
+inline ::cockroach::proto::LockRowRequest* InternalRequestUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.lock_row)
+  return value_.lock_row_;
+}
+inline ::cockroach::proto::LockRowRequest* InternalRequestUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowRequest* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+inline bool InternalRequestUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+inline void InternalRequestUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+inline void InternalRequestUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutUniqueRequest& InternalRequestUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueRequest::default_instance();
+}
+inline ::cockroach::proto::PutUniqueRequest* InternalRequestUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.put_unique)
+  return value_.put_unique_;
+}
+inline ::cockroach::proto::PutUniqueRequest* InternalRequestUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueRequest* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+inline bool InternalRequestUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+inline void InternalRequestUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+inline void InternalRequestUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanChangesRequest& InternalRequestUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRequestUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesRequest::default_instance();
+}
+inline ::cockroach::proto::ScanChangesRequest* InternalRequestUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRequestUnion.scan_changes)
+  return value_.scan_changes_;
+}
+inline ::cockroach::proto::ScanChangesRequest* InternalRequestUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesRequest* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRequestUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRequestUnion.scan_changes)
+}
+
 // optional .cockroach.proto.InternalPushTxnRequest internal_push_txn = 30;
 inline bool InternalRequestUnion::has_internal_push_txn() const {
   return value_case() == kInternalPushTxn;
@@ -6377,6 +7033,328 @@ inline void InternalResponseUnion::set_allocated_end_transaction(::cockroach::pr
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowResponse get_row = 10;
+inline bool InternalResponseUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+inline void InternalResponseUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+inline void InternalResponseUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::GetRowResponse& InternalResponseUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowResponse::default_instance();
+}
+inline ::cockroach::proto::GetRowResponse* InternalResponseUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.get_row)
+  return value_.get_row_;
+}
+inline ::cockroach::proto::GetRowResponse* InternalResponseUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowResponse* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_get_row(::cockroach::proto::GetRowResponse* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowResponse put_row = 11;
+inline bool InternalResponseUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+inline void InternalResponseUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+inline void InternalResponseUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRowResponse& InternalResponseUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowResponse::default_instance();
+}
+inline ::cockroach::proto::PutRowResponse* InternalResponseUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.put_row)
+  return value_.put_row_;
+}
+inline ::cockroach::proto::PutRowResponse* InternalResponseUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowResponse* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowResponse delete_row = 12;
+inline bool InternalResponseUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+inline void InternalResponseUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+inline void InternalResponseUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRowResponse& InternalResponseUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowResponse::default_instance();
+}
+inline ::cockroach::proto::DeleteRowResponse* InternalResponseUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.delete_row)
+  return value_.delete_row_;
+}
+inline ::cockroach::proto::DeleteRowResponse* InternalResponseUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowResponse* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsResponse scan_rows = 13;
+inline bool InternalResponseUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+inline void InternalResponseUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+inline void InternalResponseUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanRowsResponse& InternalResponseUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsResponse::default_instance();
+}
+inline ::cockroach::proto::ScanRowsResponse* InternalResponseUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan_rows)
+  return value_.scan_rows_;
+}
+inline ::cockroach::proto::ScanRowsResponse* InternalResponseUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsResponse* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsResponse* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowResponse lock_row = 14;
+inline bool InternalResponseUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+inline void InternalResponseUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+inline void InternalResponseUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::LockRowResponse& InternalResponseUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowResponse::default_instance();
+}
+inline ::cockroach::proto::LockRowResponse* InternalResponseUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.lock_row)
+  return value_.lock_row_;
+}
+inline ::cockroach::proto::LockRowResponse* InternalResponseUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowResponse* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueResponse put_unique = 15;
+inline bool InternalResponseUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+inline void InternalResponseUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+inline void InternalResponseUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutUniqueResponse& InternalResponseUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueResponse::default_instance();
+}
+inline ::cockroach::proto::PutUniqueResponse* InternalResponseUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.put_unique)
+  return value_.put_unique_;
+}
+inline ::cockroach::proto::PutUniqueResponse* InternalResponseUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueResponse* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesResponse scan_changes = 16;
+inline bool InternalResponseUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+inline void InternalResponseUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+inline void InternalResponseUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanChangesResponse& InternalResponseUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalResponseUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesResponse::default_instance();
+}
+inline ::cockroach::proto::ScanChangesResponse* InternalResponseUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalResponseUnion.scan_changes)
+  return value_.scan_changes_;
+}
+inline ::cockroach::proto::ScanChangesResponse* InternalResponseUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesResponse* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalResponseUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesResponse* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalResponseUnion.scan_changes)
+}
+
 // optional .cockroach.proto.InternalPushTxnResponse internal_push_txn = 30;
 inline bool InternalResponseUnion::has_internal_push_txn() const {
   return value_case() == kInternalPushTxn;
@@ -6958,6 +7936,144 @@ inline void ReadWriteCmdResponse::set_allocated_end_transaction(::cockroach::pro
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.end_transaction)
 }
 
+// optional .cockroach.proto.PutRowResponse put_row = 7;
+inline bool ReadWriteCmdResponse::has_put_row() const {
+  return value_case() == kPutRow;
+}
+inline void ReadWriteCmdResponse::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+inline void ReadWriteCmdResponse::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRowResponse& ReadWriteCmdResponse::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowResponse::default_instance();
+}
+inline ::cockroach::proto::PutRowResponse* ReadWriteCmdResponse::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.put_row)
+  return value_.put_row_;
+}
+inline ::cockroach::proto::PutRowResponse* ReadWriteCmdResponse::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowResponse* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_put_row(::cockroach::proto::PutRowResponse* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowResponse delete_row = 8;
+inline bool ReadWriteCmdResponse::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+inline void ReadWriteCmdResponse::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+inline void ReadWriteCmdResponse::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRowResponse& ReadWriteCmdResponse::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowResponse::default_instance();
+}
+inline ::cockroach::proto::DeleteRowResponse* ReadWriteCmdResponse::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.delete_row)
+  return value_.delete_row_;
+}
+inline ::cockroach::proto::DeleteRowResponse* ReadWriteCmdResponse::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowResponse* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_delete_row(::cockroach::proto::DeleteRowResponse* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.delete_row)
+}
+
+// optional .cockroach.proto.LockRowResponse lock_row = 9;
+inline bool ReadWriteCmdResponse::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+inline void ReadWriteCmdResponse::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+inline void ReadWriteCmdResponse::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::LockRowResponse& ReadWriteCmdResponse::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowResponse::default_instance();
+}
+inline ::cockroach::proto::LockRowResponse* ReadWriteCmdResponse::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.lock_row)
+  return value_.lock_row_;
+}
+inline ::cockroach::proto::LockRowResponse* ReadWriteCmdResponse::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowResponse* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_lock_row(::cockroach::proto::LockRowResponse* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.lock_row)
+}
+
 // optional .cockroach.proto.InternalHeartbeatTxnResponse internal_heartbeat_txn = 10;
 inline bool ReadWriteCmdResponse::has_internal_heartbeat_txn() const {
   return value_case() == kInternalHeartbeatTxn;
@@ -7326,6 +8442,52 @@ inline void ReadWriteCmdResponse::set_allocated_internal_leader_lease(::cockroac
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.internal_leader_lease)
 }
 
+// optional .cockroach.proto.PutUniqueResponse put_unique = 18;
+inline bool ReadWriteCmdResponse::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+inline void ReadWriteCmdResponse::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+inline void ReadWriteCmdResponse::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutUniqueResponse& ReadWriteCmdResponse::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.ReadWriteCmdResponse.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueResponse::default_instance();
+}
+inline ::cockroach::proto::PutUniqueResponse* ReadWriteCmdResponse::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueResponse;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.ReadWriteCmdResponse.put_unique)
+  return value_.put_unique_;
+}
+inline ::cockroach::proto::PutUniqueResponse* ReadWriteCmdResponse::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueResponse* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void ReadWriteCmdResponse::set_allocated_put_unique(::cockroach::proto::PutUniqueResponse* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.ReadWriteCmdResponse.put_unique)
+}
+
 inline bool ReadWriteCmdResponse::has_value() const {
   return value_case() != VALUE_NOT_SET;
 }
@@ -7707,6 +8869,328 @@ inline void InternalRaftCommandUnion::set_allocated_end_transaction(::cockroach:
   // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.end_transaction)
 }
 
+// optional .cockroach.proto.GetRowRequest get_row = 10;
+inline bool InternalRaftCommandUnion::has_get_row() const {
+  return value_case() == kGetRow;
+}
+inline void InternalRaftCommandUnion::set_has_get_row() {
+  _oneof_case_[0] = kGetRow;
+}
+inline void InternalRaftCommandUnion::clear_get_row() {
+  if (has_get_row()) {
+    delete value_.get_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::GetRowRequest& InternalRaftCommandUnion::get_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.get_row)
+  return has_get_row() ? *value_.get_row_
+                      : ::cockroach::proto::GetRowRequest::default_instance();
+}
+inline ::cockroach::proto::GetRowRequest* InternalRaftCommandUnion::mutable_get_row() {
+  if (!has_get_row()) {
+    clear_value();
+    set_has_get_row();
+    value_.get_row_ = new ::cockroach::proto::GetRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.get_row)
+  return value_.get_row_;
+}
+inline ::cockroach::proto::GetRowRequest* InternalRaftCommandUnion::release_get_row() {
+  if (has_get_row()) {
+    clear_has_value();
+    ::cockroach::proto::GetRowRequest* temp = value_.get_row_;
+    value_.get_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_get_row(::cockroach::proto::GetRowRequest* get_row) {
+  clear_value();
+  if (get_row) {
+    set_has_get_row();
+    value_.get_row_ = get_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.get_row)
+}
+
+// optional .cockroach.proto.PutRowRequest put_row = 11;
+inline bool InternalRaftCommandUnion::has_put_row() const {
+  return value_case() == kPutRow;
+}
+inline void InternalRaftCommandUnion::set_has_put_row() {
+  _oneof_case_[0] = kPutRow;
+}
+inline void InternalRaftCommandUnion::clear_put_row() {
+  if (has_put_row()) {
+    delete value_.put_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutRowRequest& InternalRaftCommandUnion::put_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.put_row)
+  return has_put_row() ? *value_.put_row_
+                      : ::cockroach::proto::PutRowRequest::default_instance();
+}
+inline ::cockroach::proto::PutRowRequest* InternalRaftCommandUnion::mutable_put_row() {
+  if (!has_put_row()) {
+    clear_value();
+    set_has_put_row();
+    value_.put_row_ = new ::cockroach::proto::PutRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.put_row)
+  return value_.put_row_;
+}
+inline ::cockroach::proto::PutRowRequest* InternalRaftCommandUnion::release_put_row() {
+  if (has_put_row()) {
+    clear_has_value();
+    ::cockroach::proto::PutRowRequest* temp = value_.put_row_;
+    value_.put_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_put_row(::cockroach::proto::PutRowRequest* put_row) {
+  clear_value();
+  if (put_row) {
+    set_has_put_row();
+    value_.put_row_ = put_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.put_row)
+}
+
+// optional .cockroach.proto.DeleteRowRequest delete_row = 12;
+inline bool InternalRaftCommandUnion::has_delete_row() const {
+  return value_case() == kDeleteRow;
+}
+inline void InternalRaftCommandUnion::set_has_delete_row() {
+  _oneof_case_[0] = kDeleteRow;
+}
+inline void InternalRaftCommandUnion::clear_delete_row() {
+  if (has_delete_row()) {
+    delete value_.delete_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::DeleteRowRequest& InternalRaftCommandUnion::delete_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.delete_row)
+  return has_delete_row() ? *value_.delete_row_
+                      : ::cockroach::proto::DeleteRowRequest::default_instance();
+}
+inline ::cockroach::proto::DeleteRowRequest* InternalRaftCommandUnion::mutable_delete_row() {
+  if (!has_delete_row()) {
+    clear_value();
+    set_has_delete_row();
+    value_.delete_row_ = new ::cockroach::proto::DeleteRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.delete_row)
+  return value_.delete_row_;
+}
+inline ::cockroach::proto::DeleteRowRequest* InternalRaftCommandUnion::release_delete_row() {
+  if (has_delete_row()) {
+    clear_has_value();
+    ::cockroach::proto::DeleteRowRequest* temp = value_.delete_row_;
+    value_.delete_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_delete_row(::cockroach::proto::DeleteRowRequest* delete_row) {
+  clear_value();
+  if (delete_row) {
+    set_has_delete_row();
+    value_.delete_row_ = delete_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.delete_row)
+}
+
+// optional .cockroach.proto.ScanRowsRequest scan_rows = 13;
+inline bool InternalRaftCommandUnion::has_scan_rows() const {
+  return value_case() == kScanRows;
+}
+inline void InternalRaftCommandUnion::set_has_scan_rows() {
+  _oneof_case_[0] = kScanRows;
+}
+inline void InternalRaftCommandUnion::clear_scan_rows() {
+  if (has_scan_rows()) {
+    delete value_.scan_rows_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanRowsRequest& InternalRaftCommandUnion::scan_rows() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.scan_rows)
+  return has_scan_rows() ? *value_.scan_rows_
+                      : ::cockroach::proto::ScanRowsRequest::default_instance();
+}
+inline ::cockroach::proto::ScanRowsRequest* InternalRaftCommandUnion::mutable_scan_rows() {
+  if (!has_scan_rows()) {
+    clear_value();
+    set_has_scan_rows();
+    value_.scan_rows_ = new ::cockroach::proto::ScanRowsRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.scan_rows)
+  return value_.scan_rows_;
+}
+inline ::cockroach::proto::ScanRowsRequest* InternalRaftCommandUnion::release_scan_rows() {
+  if (has_scan_rows()) {
+    clear_has_value();
+    ::cockroach::proto::ScanRowsRequest* temp = value_.scan_rows_;
+    value_.scan_rows_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_scan_rows(::cockroach::proto::ScanRowsRequest* scan_rows) {
+  clear_value();
+  if (scan_rows) {
+    set_has_scan_rows();
+    value_.scan_rows_ = scan_rows;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.scan_rows)
+}
+
+// optional .cockroach.proto.LockRowRequest lock_row = 14;
+inline bool InternalRaftCommandUnion::has_lock_row() const {
+  return value_case() == kLockRow;
+}
+inline void InternalRaftCommandUnion::set_has_lock_row() {
+  _oneof_case_[0] = kLockRow;
+}
+inline void InternalRaftCommandUnion::clear_lock_row() {
+  if (has_lock_row()) {
+    delete value_.lock_row_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::LockRowRequest& InternalRaftCommandUnion::lock_row() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.lock_row)
+  return has_lock_row() ? *value_.lock_row_
+                      : ::cockroach::proto::LockRowRequest::default_instance();
+}
+inline ::cockroach::proto::LockRowRequest* InternalRaftCommandUnion::mutable_lock_row() {
+  if (!has_lock_row()) {
+    clear_value();
+    set_has_lock_row();
+    value_.lock_row_ = new ::cockroach::proto::LockRowRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.lock_row)
+  return value_.lock_row_;
+}
+inline ::cockroach::proto::LockRowRequest* InternalRaftCommandUnion::release_lock_row() {
+  if (has_lock_row()) {
+    clear_has_value();
+    ::cockroach::proto::LockRowRequest* temp = value_.lock_row_;
+    value_.lock_row_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_lock_row(::cockroach::proto::LockRowRequest* lock_row) {
+  clear_value();
+  if (lock_row) {
+    set_has_lock_row();
+    value_.lock_row_ = lock_row;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.lock_row)
+}
+
+// optional .cockroach.proto.PutUniqueRequest put_unique = 15;
+inline bool InternalRaftCommandUnion::has_put_unique() const {
+  return value_case() == kPutUnique;
+}
+inline void InternalRaftCommandUnion::set_has_put_unique() {
+  _oneof_case_[0] = kPutUnique;
+}
+inline void InternalRaftCommandUnion::clear_put_unique() {
+  if (has_put_unique()) {
+    delete value_.put_unique_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::PutUniqueRequest& InternalRaftCommandUnion::put_unique() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.put_unique)
+  return has_put_unique() ? *value_.put_unique_
+                      : ::cockroach::proto::PutUniqueRequest::default_instance();
+}
+inline ::cockroach::proto::PutUniqueRequest* InternalRaftCommandUnion::mutable_put_unique() {
+  if (!has_put_unique()) {
+    clear_value();
+    set_has_put_unique();
+    value_.put_unique_ = new ::cockroach::proto::PutUniqueRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.put_unique)
+  return value_.put_unique_;
+}
+inline ::cockroach::proto::PutUniqueRequest* InternalRaftCommandUnion::release_put_unique() {
+  if (has_put_unique()) {
+    clear_has_value();
+    ::cockroach::proto::PutUniqueRequest* temp = value_.put_unique_;
+    value_.put_unique_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_put_unique(::cockroach::proto::PutUniqueRequest* put_unique) {
+  clear_value();
+  if (put_unique) {
+    set_has_put_unique();
+    value_.put_unique_ = put_unique;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.put_unique)
+}
+
+// optional .cockroach.proto.ScanChangesRequest scan_changes = 16;
+inline bool InternalRaftCommandUnion::has_scan_changes() const {
+  return value_case() == kScanChanges;
+}
+inline void InternalRaftCommandUnion::set_has_scan_changes() {
+  _oneof_case_[0] = kScanChanges;
+}
+inline void InternalRaftCommandUnion::clear_scan_changes() {
+  if (has_scan_changes()) {
+    delete value_.scan_changes_;
+    clear_has_value();
+  }
+}
+inline const ::cockroach::proto::ScanChangesRequest& InternalRaftCommandUnion::scan_changes() const {
+  // @@protoc_insertion_point(field_get:cockroach.proto.InternalRaftCommandUnion.scan_changes)
+  return has_scan_changes() ? *value_.scan_changes_
+                      : ::cockroach::proto::ScanChangesRequest::default_instance();
+}
+inline ::cockroach::proto::ScanChangesRequest* InternalRaftCommandUnion::mutable_scan_changes() {
+  if (!has_scan_changes()) {
+    clear_value();
+    set_has_scan_changes();
+    value_.scan_changes_ = new ::cockroach::proto::ScanChangesRequest;
+  }
+  // @@protoc_insertion_point(field_mutable:cockroach.proto.InternalRaftCommandUnion.scan_changes)
+  return value_.scan_changes_;
+}
+inline ::cockroach::proto::ScanChangesRequest* InternalRaftCommandUnion::release_scan_changes() {
+  if (has_scan_changes()) {
+    clear_has_value();
+    ::cockroach::proto::ScanChangesRequest* temp = value_.scan_changes_;
+    value_.scan_changes_ = NULL;
+    return temp;
+  } else {
+    return NULL;
+  }
+}
+inline void InternalRaftCommandUnion::set_allocated_scan_changes(::cockroach::proto::ScanChangesRequest* scan_changes) {
+  clear_value();
+  if (scan_changes) {
+    set_has_scan_changes();
+    value_.scan_changes_ = scan_changes;
+  }
+  // @@protoc_insertion_point(field_set_allocated:cockroach.proto.InternalRaftCommandUnion.scan_changes)
+}
+
 // optional .cockroach.proto.BatchRequest batch = 30;
 inline bool InternalRaftCommandUnion::has_batch() const {
   return value_case() == kBatch;
@@ -141,6 +141,14 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.delete_range().header();
   } else if (rwResp.has_end_transaction()) {
     return &rwResp.end_transaction().header();
+  } else if (rwResp.has_put_row()) {
+    return &rwResp.put_row().header();
+  } else if (rwResp.has_delete_row()) {
+    return &rwResp.delete_row().header();
+  } else if (rwResp.has_lock_row()) {
+    return &rwResp.lock_row().header();
+  } else if (rwResp.has_put_unique()) {
+    return &rwResp.put_unique().header();
   } else if (rwResp.has_internal_heartbeat_txn()) {
     return &rwResp.internal_heartbeat_txn().header();
   } else if (rwResp.has_internal_gc()) {
@@ -149,10 +157,14 @@ const cockroach::proto::ResponseHeader* GetResponseHeader(const cockroach::proto
     return &rwResp.internal_push_txn().header();
   } else if (rwResp.has_internal_resolve_intent()) {
     return &rwResp.internal_resolve_intent().header();
+  } else if (rwResp.has_internal_resolve_intent_range()) {
+    return &rwResp.internal_resolve_intent_range().header();
   } else if (rwResp.has_internal_merge()) {
     return &rwResp.internal_merge().header();
   } else if (rwResp.has_internal_truncate_log()) {
     return &rwResp.internal_truncate_log().header();
+  } else if (rwResp.has_internal_leader_lease()) {
+    return &rwResp.internal_leader_lease().header();
   }
   return NULL;
 }
@@ -883,9 +895,7 @@ class BaseDeltaIterator : public rocksdb::Iterator {
   }
 
   void SeekToLast() override {
-    base_iterator_->SeekToLast();
-    delta_iterator_->SeekToLast();
-    UpdateCurrent();
+    SeekBefore(NULL);
   }
 
   void Seek(const rocksdb::Slice& k) override {
@@ -902,7 +912,13 @@ class BaseDeltaIterator : public rocksdb::Iterator {
   }
 
   void Prev() override {
-    status_ = rocksdb::Status::NotSupported("Prev() not supported");
+    if (!Valid()) {
+      status_ = rocksdb::Status::NotSupported("Prev() on invalid iterator");
+      return;
+    }
+    const std::string k = key().ToString();
+    const rocksdb::Slice target(k);
+    SeekBefore(&target);
   }
 
   rocksdb::Slice key() const override {
@@ -983,6 +999,55 @@ class BaseDeltaIterator : public rocksdb::Iterator {
     delta_iterator_->Next();
     ClearMerged();
   }
+  // SeekBefore positions the iterator at the last key which is less
+  // than target, or at the last key if target is NULL. The base and
+  // delta iterators are stepped back to find the candidate key, and the
+  // iterator is then positioned at it with a forward seek so that the
+  // invariants relied upon by Next() hold. Keys deleted by the batch
+  // are skipped.
+  void SeekBefore(const rocksdb::Slice* target) {
+    bool bounded = target != NULL;
+    std::string bound;
+    if (bounded) {
+      bound = target->ToString();
+    }
+    for (;;) {
+      if (!bounded) {
+        base_iterator_->SeekToLast();
+        delta_iterator_->SeekToLast();
+      } else {
+        base_iterator_->Seek(bound);
+        if (BaseValid()) {
+          base_iterator_->Prev();
+        } else {
+          base_iterator_->SeekToLast();
+        }
+        delta_iterator_->Seek(bound);
+        if (DeltaValid()) {
+          delta_iterator_->Prev();
+        } else {
+          delta_iterator_->SeekToLast();
+        }
+      }
+      ClearMerged();
+      if (!BaseValid() && !DeltaValid()) {
+        // There is no key before the target.
+        current_at_base_ = true;
+        equal_keys_ = false;
+        return;
+      }
+      const bool in_delta = DeltaValid() && (!BaseValid() || Compare() >= 0);
+      const std::string k = in_delta ? delta_iterator_->Entry().key.ToString()
+                                     : base_iterator_->key().ToString();
+      Seek(k);
+      if (!in_delta || (Valid() && comparator_->Compare(key(), k) == 0)) {
+        return;
+      }
+      // The key was deleted by the batch, so look before it.
+      bound = k;
+      bounded = true;
+    }
+  }
   bool ProcessDelta() {
     IteratorGetter base(equal_keys_ ? base_iterator_.get() : NULL);
     DBStatus status = ProcessDeltaKey(&base, delta_iterator_.get(),
@@ -1232,6 +1297,15 @@ void DBIterSeekToLast(DBIterator* iter) {
   iter->rep->SeekToLast();
 }
 
+void DBIterSeekReverse(DBIterator* iter, DBSlice key) {
+  iter->rep->Seek(ToSlice(key));
+  if (iter->rep->Valid()) {
+    iter->rep->Prev();
+  } else {
+    iter->rep->SeekToLast();
+  }
+}
+
 int DBIterValid(DBIterator* iter) {
   return iter->rep->Valid();
 }
@@ -1240,6 +1314,10 @@ void DBIterNext(DBIterator* iter) {
   iter->rep->Next();
 }
 
+void DBIterPrev(DBIterator* iter) {
+  iter->rep->Prev();
+}
+
 DBSlice DBIterKey(DBIterator* iter) {
   return ToDBSlice(iter->rep->key());
 }
@@ -128,6 +128,9 @@ void DBIterSeekToFirst(DBIterator* iter);
 // Positions the iterator at the last key in the database.
 void DBIterSeekToLast(DBIterator* iter);
 
+// Positions the iterator at the last key that is < "key".
+void DBIterSeekReverse(DBIterator* iter, DBSlice key);
+
 // Returns 1 if the iterator is positioned at a valid key/value pair
 // and 0 otherwise.
 int  DBIterValid(DBIterator* iter);
@@ -137,6 +140,11 @@ int  DBIterValid(DBIterator* iter);
 // last key.
 void DBIterNext(DBIterator* iter);
 
+// Moves the iterator back to the previous key. After this call,
+// DBIterValid() returns 1 iff the iterator was not positioned at the
+// first key.
+void DBIterPrev(DBIterator* iter);
+
 // Returns the key at the current iterator position. Note that a slice
 // is returned and the memory does not have to be freed.
 DBSlice DBIterKey(DBIterator* iter);
@@ -595,7 +595,7 @@ func MVCCPut(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.
 	buf.value.Reset()
 	buf.value.Value = &buf.pvalue
 
-	err := mvccPutInternal(engine, ms, key, timestamp, buf.value, txn, buf)
+	err := mvccPutInternal(engine, ms, key, timestamp, buf.value, txn, false, buf)
 
 	// Using defer would be more convenient, but it is measurably
 	// slower.
@@ -611,7 +611,28 @@ func MVCCDelete(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp pro
 	buf.value.Reset()
 	buf.value.Deleted = true
 
-	err := mvccPutInternal(engine, ms, key, timestamp, buf.value, txn, buf)
+	err := mvccPutInternal(engine, ms, key, timestamp, buf.value, txn, false, buf)
+
+	// Using defer would be more convenient, but it is measurably
+	// slower.
+	putBufferPool.Put(buf)
+	return err
+}
+
+// MVCCDeleteIntent is like MVCCDelete, but writes the deletion tombstone
+// even if the key has no value, so that the transaction holds a write
+// intent on the key: writes of other transactions conflict with it until
+// the intent is resolved, while reads see no value.
+func MVCCDeleteIntent(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp,
+	txn *proto.Transaction) error {
+	if txn == nil {
+		return util.Errorf("no transaction specified to write an intent on %q", key)
+	}
+	buf := putBufferPool.Get().(*putBuffer)
+	buf.value.Reset()
+	buf.value.Deleted = true
+
+	err := mvccPutInternal(engine, ms, key, timestamp, buf.value, txn, true, buf)
 
 	// Using defer would be more convenient, but it is measurably
 	// slower.
@@ -620,9 +641,10 @@ func MVCCDelete(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp pro
 }
 
 // mvccPutInternal adds a new timestamped value to the specified key.
-// If value is nil, creates a deletion tombstone value.
+// If value is nil, creates a deletion tombstone value. The deletion of a
+// key which has no value is a no-op unless alwaysWrite is true.
 func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestamp proto.Timestamp,
-	value proto.MVCCValue, txn *proto.Transaction, buf *putBuffer) error {
+	value proto.MVCCValue, txn *proto.Transaction, alwaysWrite bool, buf *putBuffer) error {
 	if len(key) == 0 {
 		return emptyKeyError()
 	}
@@ -691,9 +713,9 @@ func mvccPutInternal(engine Engine, ms *proto.MVCCStats, key proto.Key, timestam
 			}
 		}
 	} else {
-		// No existing metadata record. If this is a delete, do nothing;
-		// otherwise we can perform the write.
-		if value.Deleted {
+		// No existing metadata record. If this is a delete, do nothing
+		// unless asked to write it; otherwise we can perform the write.
+		if value.Deleted && !alwaysWrite {
 			return nil
 		}
 	}
@@ -853,8 +875,21 @@ func MVCCDeleteRange(engine Engine, ms *proto.MVCCStats, key, endKey proto.Key,
 // scans.
 func MVCCScan(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
 	consistent bool, txn *proto.Transaction) ([]proto.KeyValue, error) {
+	return mvccScanInternal(engine, key, endKey, max, timestamp, consistent, txn, false)
+}
+
+// MVCCReverseScan is like MVCCScan, but returns the key/value pairs in
+// descending key order: the first pair returned is the last one before
+// endKey and at most max pairs are returned.
+func MVCCReverseScan(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	consistent bool, txn *proto.Transaction) ([]proto.KeyValue, error) {
+	return mvccScanInternal(engine, key, endKey, max, timestamp, consistent, txn, true)
+}
+
+func mvccScanInternal(engine Engine, key, endKey proto.Key, max int64, timestamp proto.Timestamp,
+	consistent bool, txn *proto.Transaction, reverse bool) ([]proto.KeyValue, error) {
 	res := []proto.KeyValue{}
-	if err := MVCCIterate(engine, key, endKey, timestamp, consistent, txn, func(kv proto.KeyValue) (bool, error) {
+	if err := mvccIterateInternal(engine, key, endKey, timestamp, consistent, txn, reverse, func(kv proto.KeyValue) (bool, error) {
 		res = append(res, kv)
 		if max != 0 && max == int64(len(res)) {
 			return true, nil
@@ -877,6 +912,13 @@ func MVCCScan(engine Engine, key, endKey proto.Key, max int64, timestamp proto.T
 // iteration stops and the error is propagated.
 func MVCCIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Timestamp,
 	consistent bool, txn *proto.Transaction, f func(proto.KeyValue) (bool, error)) error {
+	return mvccIterateInternal(engine, startKey, endKey, timestamp, consistent, txn, false, f)
+}
+
+// mvccIterateInternal is MVCCIterate, visiting the keys in descending
+// order if reverse is true.
+func mvccIterateInternal(engine Engine, startKey, endKey proto.Key, timestamp proto.Timestamp,
+	consistent bool, txn *proto.Transaction, reverse bool, f func(proto.KeyValue) (bool, error)) error {
 	if !consistent && txn != nil {
 		return util.Errorf("cannot allow inconsistent reads within a transaction")
 	}
@@ -892,6 +934,13 @@ func MVCCIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Time
 	encEndKey := mvccEncodeKey(buf.key[0:0], endKey)
 	keyBuf := encEndKey[len(encEndKey):]
 	encKey := mvccEncodeKey(keyBuf, startKey)
+	// A reverse iteration starts before the end key and stops before the
+	// start key, so the start key needs its own buffer.
+	var encStartKey proto.EncodedKey
+	if reverse {
+		encStartKey = mvccEncodeKey(nil, startKey)
+		encKey = encEndKey
+	}
 
 	// Get a new iterator and define our getEarlierFunc using iter.Seek.
 	iter := engine.NewIterator()
@@ -913,7 +962,21 @@ func MVCCIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Time
 	var wiErr error
 
 	for {
-		iter.Seek(encKey)
+		if reverse {
+			// Find the last key before encKey, which may be one of its
+			// versions, and seek to its metadata key.
+			iter.SeekReverse(encKey)
+			if iter.Valid() && bytes.Compare(iter.Key(), encStartKey) >= 0 {
+				key, _, _ := MVCCDecodeKey(iter.Key())
+				iter.Seek(mvccEncodeKey(keyBuf, key))
+			} else if err := iter.Error(); err != nil {
+				return err
+			} else {
+				return wiErr
+			}
+		} else {
+			iter.Seek(encKey)
+		}
 		if !iter.Valid() {
 			if err := iter.Error(); err != nil {
 				return err
@@ -921,7 +984,7 @@ func MVCCIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Time
 			return wiErr
 		}
 		metaKey := iter.Key()
-		if bytes.Compare(metaKey, encEndKey) >= 0 {
+		if !reverse && bytes.Compare(metaKey, encEndKey) >= 0 {
 			if err := iter.Error(); err != nil {
 				return err
 			}
@@ -957,8 +1020,77 @@ func MVCCIterate(engine Engine, startKey, endKey proto.Key, timestamp proto.Time
 				return wiErr
 			}
 		}
-		encKey = mvccEncodeKey(keyBuf, key.Next())
+		if reverse {
+			encKey = mvccEncodeKey(keyBuf, key)
+		} else {
+			encKey = mvccEncodeKey(keyBuf, key.Next())
+		}
+	}
+}
+
+// MVCCScanChanges scans the key range specified by start key through
+// end key for the versions written after startTimestamp and at or
+// before endTimestamp, including deletion tombstones, returning them in
+// key order and, for each key, in descending timestamp order. Scanning
+// stops after the key at which max versions have been returned,
+// including all of its versions; specify max=0 for unbounded scans.
+// Write intents within the interval are not returned, but are
+// accumulated in a WriteIntentError so that they can be resolved.
+// Inline values have no versions and are skipped.
+func MVCCScanChanges(engine Engine, key, endKey proto.Key, max int64, startTimestamp,
+	endTimestamp proto.Timestamp) ([]proto.KeyValueChange, error) {
+	if len(endKey) == 0 {
+		return nil, emptyKeyError()
+	}
+	encEndKey := MVCCEncodeKey(endKey)
+	iter := engine.NewIterator()
+	defer iter.Close()
+
+	var res []proto.KeyValueChange
+	var wiErr *proto.WriteIntentError
+	var meta proto.MVCCMetadata
+	for iter.Seek(MVCCEncodeKey(key)); iter.Valid(); iter.Next() {
+		if bytes.Compare(iter.Key(), encEndKey) >= 0 {
+			break
+		}
+		key, ts, isValue := MVCCDecodeKey(iter.Key())
+		if !isValue {
+			if max != 0 && int64(len(res)) >= max {
+				break
+			}
+			if err := iter.ValueProto(&meta); err != nil {
+				return nil, err
+			}
+			if meta.Txn != nil && !endTimestamp.Less(meta.Timestamp) {
+				if wiErr == nil {
+					wiErr = &proto.WriteIntentError{}
+				}
+				wiErr.Intents = append(wiErr.Intents, proto.WriteIntentError_Intent{Key: key, Txn: *meta.Txn})
+			}
+			continue
+		}
+		if !startTimestamp.Less(ts) || endTimestamp.Less(ts) ||
+			(meta.Txn != nil && ts.Equal(meta.Timestamp)) {
+			continue
+		}
+		var value proto.MVCCValue
+		if err := iter.ValueProto(&value); err != nil {
+			return nil, err
+		}
+		change := proto.KeyValueChange{Key: key, Deleted: value.Deleted}
+		if value.Value != nil {
+			change.Value = *value.Value
+		}
+		change.Value.Timestamp = &ts
+		res = append(res, change)
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
 	}
+	if wiErr != nil {
+		return nil, wiErr
+	}
+	return res, nil
 }
 
 // MVCCResolveWriteIntent either commits or aborts (rolls back) an
@@ -486,6 +486,42 @@ func TestMVCCDeleteMissingKey(t *testing.T) {
 	}
 }
 
+func TestMVCCDeleteIntentMissingKey(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCDeleteIntent(engine, nil, testKey1, makeTS(1, 0), nil); err == nil {
+		t.Error("expected an error writing an intent outside of a transaction")
+	}
+	if err := MVCCDeleteIntent(engine, nil, testKey1, makeTS(1, 0), txn1); err != nil {
+		t.Fatal(err)
+	}
+	// The intent is invisible to its own transaction, but conflicts with
+	// the reads and writes of others.
+	if value, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, txn1); err != nil || value != nil {
+		t.Fatalf("expected no value; got %+v: %v", value, err)
+	}
+	if _, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, nil); err == nil {
+		t.Error("expected a read to encounter the intent")
+	}
+	err := MVCCPut(engine, nil, testKey1, makeTS(2, 0), value1, txn2)
+	if _, ok := err.(*proto.WriteIntentError); !ok {
+		t.Fatalf("expected a write intent error, but found %v", err)
+	}
+
+	// Once resolved, the tombstone reads as no value.
+	txn := *txn1
+	txn.Status = proto.COMMITTED
+	txn.Timestamp = makeTS(1, 0)
+	if err := MVCCResolveWriteIntent(engine, nil, testKey1, makeTS(1, 0), &txn); err != nil {
+		t.Fatal(err)
+	}
+	if value, err := MVCCGet(engine, testKey1, makeTS(2, 0), true, nil); err != nil || value != nil {
+		t.Fatalf("expected no value; got %+v: %v", value, err)
+	}
+}
+
 func TestMVCCGetAndDeleteInTxn(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := createTestEngine()
@@ -856,6 +892,145 @@ func TestMVCCScanMaxNum(t *testing.T) {
 	}
 }
 
+func TestMVCCReverseScan(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil)
+	err = MVCCPut(engine, nil, testKey2, makeTS(1, 0), value2, nil)
+	err = MVCCPut(engine, nil, testKey3, makeTS(1, 0), value3, nil)
+	err = MVCCPut(engine, nil, testKey4, makeTS(1, 0), value4, nil)
+
+	kvs, err := MVCCReverseScan(engine, testKey1, testKey4, 0, makeTS(1, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 3 ||
+		!bytes.Equal(kvs[0].Key, testKey3) ||
+		!bytes.Equal(kvs[1].Key, testKey2) ||
+		!bytes.Equal(kvs[2].Key, testKey1) ||
+		!bytes.Equal(kvs[0].Value.Bytes, value3.Bytes) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+
+	kvs, err = MVCCReverseScan(engine, testKey1, proto.KeyMax, 2, makeTS(1, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 ||
+		!bytes.Equal(kvs[0].Key, testKey4) ||
+		!bytes.Equal(kvs[1].Key, testKey3) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+
+	// Later versions and deletions are honored at the scan timestamp,
+	// including those written to a batch.
+	batch := engine.NewBatch()
+	defer batch.Close()
+	if err := MVCCPut(batch, nil, testKey2, makeTS(2, 0), value4, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(batch, nil, testKey3, makeTS(2, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	kvs, err = MVCCReverseScan(batch, testKey1, proto.KeyMax, 0, makeTS(2, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 3 ||
+		!bytes.Equal(kvs[0].Key, testKey4) ||
+		!bytes.Equal(kvs[1].Key, testKey2) ||
+		!bytes.Equal(kvs[1].Value.Bytes, value4.Bytes) ||
+		!bytes.Equal(kvs[2].Key, testKey1) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+	kvs, err = MVCCReverseScan(batch, testKey2, testKey4, 0, makeTS(1, 0), true, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(kvs) != 2 ||
+		!bytes.Equal(kvs[0].Key, testKey3) ||
+		!bytes.Equal(kvs[1].Key, testKey2) ||
+		!bytes.Equal(kvs[1].Value.Bytes, value2.Bytes) {
+		t.Fatalf("unexpected reverse scan results: %v", kvs)
+	}
+}
+
+// TestMVCCScanChanges verifies that the versions written within a time
+// interval are returned, including deletions, and that intents within
+// the interval are returned as a WriteIntentError.
+func TestMVCCScanChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	engine := createTestEngine()
+	defer engine.Close()
+
+	if err := MVCCPut(engine, nil, testKey1, makeTS(1, 0), value1, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey1, makeTS(3, 0), value2, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey2, makeTS(2, 0), value3, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCDelete(engine, nil, testKey2, makeTS(4, 0), nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := MVCCPut(engine, nil, testKey3, makeTS(6, 0), value4, makeTxn(txn1, makeTS(6, 0))); err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		start, end int64
+		max        int64
+		expKeys    []proto.Key
+		expTS      []int64
+		expDeleted []bool
+	}{
+		{0, 5, 0, []proto.Key{testKey1, testKey1, testKey2, testKey2}, []int64{3, 1, 4, 2}, []bool{false, false, true, false}},
+		{1, 3, 0, []proto.Key{testKey1, testKey2}, []int64{3, 2}, []bool{false, false}},
+		{3, 5, 0, []proto.Key{testKey2}, []int64{4}, []bool{true}},
+		{4, 5, 0, nil, nil, nil},
+		// All of the versions of the last key are returned.
+		{0, 5, 1, []proto.Key{testKey1, testKey1}, []int64{3, 1}, []bool{false, false}},
+	}
+	for i, test := range testCases {
+		changes, err := MVCCScanChanges(engine, testKey1, testKey4, test.max, makeTS(test.start, 0), makeTS(test.end, 0))
+		if err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if len(changes) != len(test.expKeys) {
+			t.Errorf("%d: expected %d changes, but found %d", i, len(test.expKeys), len(changes))
+			continue
+		}
+		for j, c := range changes {
+			if !c.Key.Equal(test.expKeys[j]) || c.Value.Timestamp.WallTime != test.expTS[j] || c.Deleted != test.expDeleted[j] {
+				t.Errorf("%d: expected %q@%d (deleted %t), but found %q@%d (deleted %t)", i, test.expKeys[j],
+					test.expTS[j], test.expDeleted[j], c.Key, c.Value.Timestamp.WallTime, c.Deleted)
+			}
+		}
+	}
+
+	// The intent at timestamp 6 is only returned by scans of intervals
+	// containing it.
+	if _, err := MVCCScanChanges(engine, testKey1, testKey4, 0, makeTS(0, 0), makeTS(6, 0)); err == nil {
+		t.Errorf("expected a WriteIntentError")
+	} else if wiErr, ok := err.(*proto.WriteIntentError); !ok || len(wiErr.Intents) != 1 || !wiErr.Intents[0].Key.Equal(testKey3) {
+		t.Errorf("expected a WriteIntentError for %q, but found %v", testKey3, err)
+	}
+	if err := MVCCResolveWriteIntent(engine, nil, testKey3, makeTS(6, 0), makeTxn(txn1Commit, makeTS(6, 0))); err != nil {
+		t.Fatal(err)
+	}
+	changes, err := MVCCScanChanges(engine, testKey1, testKey4, 0, makeTS(5, 0), makeTS(6, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || !changes[0].Key.Equal(testKey3) || !bytes.Equal(changes[0].Value.Bytes, value4.Bytes) {
+		t.Errorf("expected the committed version of %q, but found %+v", testKey3, changes)
+	}
+}
+
 func TestMVCCScanWithKeyPrefix(t *testing.T) {
 	defer leaktest.AfterTest(t)
 	engine := createTestEngine()
@@ -699,6 +699,19 @@ func (r *rocksDBIterator) Seek(key []byte) {
 	}
 }
 
+func (r *rocksDBIterator) SeekReverse(key []byte) {
+	if len(key) == 0 {
+		// No key is less than Key(""), so leave the iterator positioned
+		// before the first key.
+		C.DBIterSeekToFirst(r.iter)
+		if r.Valid() {
+			C.DBIterPrev(r.iter)
+		}
+	} else {
+		C.DBIterSeekReverse(r.iter, goToCSlice(key))
+	}
+}
+
 func (r *rocksDBIterator) Valid() bool {
 	return C.DBIterValid(r.iter) == 1
 }
@@ -707,6 +720,10 @@ func (r *rocksDBIterator) Next() {
 	C.DBIterNext(r.iter)
 }
 
+func (r *rocksDBIterator) Prev() {
+	C.DBIterPrev(r.iter)
+}
+
 func (r *rocksDBIterator) Key() proto.EncodedKey {
 	// The data returned by rocksdb_iter_{key,value} is not meant to be
 	// freed by the client. It is a direct reference to the data managed
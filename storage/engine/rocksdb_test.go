@@ -54,6 +54,23 @@ func encodePutResponse(timestamp proto.Timestamp, t *testing.T) []byte {
 	return data
 }
 
+// encodePutRowResponse creates a put row response using the specified
+// timestamp and encodes it using gogoprotobuf.
+func encodePutRowResponse(timestamp proto.Timestamp, t *testing.T) []byte {
+	rwCmd := &proto.ReadWriteCmdResponse{
+		PutRow: &proto.PutRowResponse{
+			ResponseHeader: proto.ResponseHeader{
+				Timestamp: timestamp,
+			},
+		},
+	}
+	data, err := gogoproto.Marshal(rwCmd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
 // encodeTransaction creates a transaction using the specified
 // timestamp and encodes it using gogoprotobuf.
 func encodeTransaction(timestamp proto.Timestamp, t *testing.T) []byte {
@@ -83,8 +100,9 @@ func TestRocksDBCompaction(t *testing.T) {
 
 	cmdID := &proto.ClientCmdID{WallTime: 1, Random: 1}
 
-	// Write two transaction values and two response cache values such
-	// that exactly one of each should be GC'd based on our GC timeouts.
+	// Write two transaction values and two response cache values of each
+	// of two types such that exactly one of each should be GC'd based on
+	// our GC timeouts.
 	kvs := []proto.KeyValue{
 		{
 			Key:   keys.ResponseCacheKey(1, cmdID),
@@ -94,6 +112,14 @@ func TestRocksDBCompaction(t *testing.T) {
 			Key:   keys.ResponseCacheKey(2, cmdID),
 			Value: proto.Value{Bytes: encodePutResponse(makeTS(3, 0), t)},
 		},
+		{
+			Key:   keys.ResponseCacheKey(3, cmdID),
+			Value: proto.Value{Bytes: encodePutRowResponse(makeTS(2, 0), t)},
+		},
+		{
+			Key:   keys.ResponseCacheKey(4, cmdID),
+			Value: proto.Value{Bytes: encodePutRowResponse(makeTS(3, 0), t)},
+		},
 		{
 			Key:   keys.TransactionKey(proto.Key("a"), proto.Key(util.NewUUID4())),
 			Value: proto.Value{Bytes: encodeTransaction(makeTS(1, 0), t)},
@@ -123,6 +149,7 @@ func TestRocksDBCompaction(t *testing.T) {
 	expKeys := []proto.Key{
 		kvs[1].Key,
 		kvs[3].Key,
+		kvs[5].Key,
 	}
 	if !reflect.DeepEqual(expKeys, keys) {
 		t.Errorf("expected keys %+v, got keys %+v", expKeys, keys)
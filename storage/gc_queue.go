@@ -24,9 +24,11 @@ import (
 
 	"github.com/cockroachdb/cockroach/client"
 	"github.com/cockroachdb/cockroach/gossip"
+	"github.com/cockroachdb/cockroach/keys"
 	"github.com/cockroachdb/cockroach/proto"
 	"github.com/cockroachdb/cockroach/storage/engine"
 	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
 	"github.com/cockroachdb/cockroach/util/log"
 	gogoproto "github.com/gogo/protobuf/proto"
 )
@@ -55,20 +57,37 @@ const (
 //    as implemented going forward).
 //  - Resolve extant write intents and determine oldest non-resolvable
 //    intent.
+//  - Deletion of the expired rows of tables whose rows have a time to
+//    live (see client.DB.SetTableTTL).
 //
-// The shouldQueue function combines the need for both tasks into a
+// The shouldQueue function combines the need for these tasks into a
 // single priority. If any task is overdue, shouldQueue returns true.
 type gcQueue struct {
 	*baseQueue
+	db     *client.DB
+	tables tableDescCache // Tables whose rows have a time to live
 }
 
-// newGCQueue returns a new instance of gcQueue.
-func newGCQueue() *gcQueue {
-	gcq := &gcQueue{}
+// newGCQueue returns a new instance of gcQueue. The expired rows of
+// tables are only deleted if db is non-nil.
+func newGCQueue(db *client.DB) *gcQueue {
+	gcq := &gcQueue{
+		db:     db,
+		tables: tableDescCache{name: "tables with a TTL", list: db.ListExpiringTables},
+	}
 	gcq.baseQueue = newBaseQueue("gc", gcq, gcQueueMaxSize)
 	return gcq
 }
 
+// Start launches the processing of the queue and the worker which
+// rereads the list of tables whose rows have a time to live.
+func (gcq *gcQueue) Start(clock *hlc.Clock, stopper *util.Stopper) {
+	gcq.baseQueue.Start(clock, stopper)
+	if gcq.db != nil {
+		gcq.tables.start(stopper)
+	}
+}
+
 func (gcq *gcQueue) needsLeaderLease() bool {
 	return true
 }
@@ -76,7 +95,8 @@ func (gcq *gcQueue) needsLeaderLease() bool {
 // shouldQueue determines whether a range should be queued for garbage
 // collection, and if so, at what priority. Returns true for shouldQ
 // in the event that the cumulative ages of GC'able bytes or extant
-// intents exceed thresholds.
+// intents exceed thresholds, or if the range holds the rows of a table
+// whose rows have a time to live.
 func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool, priority float64) {
 	// Lookup GC policy for this range.
 	policy, err := gcq.lookupGCPolicy(rng)
@@ -99,6 +119,12 @@ func (gcq *gcQueue) shouldQueue(now proto.Timestamp, rng *Range) (shouldQ bool,
 	if intentScore > 1 {
 		priority += intentScore
 	}
+	// The expired rows of tables are not accounted for in the range's
+	// stats, so ranges holding rows with a time to live are queued on
+	// every scan.
+	if len(gcq.expiringTables(rng)) > 0 {
+		priority++
+	}
 	shouldQ = priority > 0
 	return
 }
@@ -209,6 +235,10 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	// Handle last collected set of keys/vals.
 	processKeysAndValues()
 
+	if err := gcq.expireRows(now, rng, snap); err != nil {
+		return err
+	}
+
 	// Set start and end keys.
 	switch len(gcArgs.Keys) {
 	case 0:
@@ -225,6 +255,21 @@ func (gcq *gcQueue) process(now proto.Timestamp, rng *Range) error {
 	wg.Wait()
 	gcMeta.OldestIntentNanos = gogoproto.Int64(oldestIntentNanos)
 
+	// Record the timestamp before which superseded versions are collected,
+	// so that ScanChanges can refuse to read changes which may be missing.
+	// It never moves backwards, e.g. if the TTL of the zone is raised.
+	prevMeta, err := rng.GetGCMetadata()
+	if err != nil {
+		return err
+	}
+	gcMeta.ThresholdNanos = prevMeta.ThresholdNanos
+	if policy.TTLSeconds > 0 {
+		threshold := now.WallTime - int64(policy.TTLSeconds)*1E9
+		if threshold > prevMeta.GetThresholdNanos() {
+			gcMeta.ThresholdNanos = gogoproto.Int64(threshold)
+		}
+	}
+
 	// Send GC request through range.
 	gcArgs.GCMeta = *gcMeta
 	if err := rng.AddCmd(rng.context(), client.Call{Args: gcArgs, Reply: &proto.InternalGCResponse{}}, true); err != nil {
@@ -295,6 +340,55 @@ func (gcq *gcQueue) resolveIntent(rng *Range, key proto.Key, meta *proto.MVCCMet
 	}
 }
 
+// expiringTables returns the tables whose rows have a time to live and
+// whose primary indexes intersect the range.
+func (gcq *gcQueue) expiringTables(rng *Range) []proto.TableDescriptor {
+	return gcq.tables.get(func(desc *proto.TableDescriptor) bool {
+		prefix := keys.MakeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+		_, _, ok := intersectSpan(rng.Desc(), prefix, prefix.PrefixEnd())
+		return ok
+	})
+}
+
+// expireRows deletes the rows within the range which have expired at now,
+// along with their secondary index entries. The rows are found in the
+// snapshot and each is deleted by DeleteExpiredRow, which verifies in its
+// transaction that the row is still expired, so that rows written since
+// the snapshot was taken are kept. Rows which cannot be deleted are logged
+// and left to the next GC of the range.
+func (gcq *gcQueue) expireRows(now proto.Timestamp, rng *Range, snap engine.Engine) error {
+	for _, desc := range gcq.expiringTables(rng) {
+		prefix := keys.MakeIndexPrefix(desc.Id, desc.PrimaryIndex.Id)
+		start, end, _ := intersectSpan(rng.Desc(), prefix, prefix.PrefixEnd())
+		var rowKey proto.Key
+		var expired []proto.Key
+		if err := engine.MVCCIterate(snap, start, end, now, false, nil, func(kv proto.KeyValue) (bool, error) {
+			if _, ok := keys.DecodeCellKey(rowKey, kv.Key); rowKey != nil && ok {
+				return false, nil
+			}
+			rowKey = kv.Key
+			if proto.RowExpired(&kv.Value, desc.TTLSeconds, now) {
+				expired = append(expired, rowKey)
+			}
+			return false, nil
+		}); err != nil {
+			return err
+		}
+		var deleted int
+		for _, key := range expired {
+			if ok, err := rng.rm.DB().DeleteExpiredRow(&desc, key); err != nil {
+				log.Warningf("unable to delete expired row %q: %s", key, err)
+			} else if ok {
+				deleted++
+			}
+		}
+		if log.V(1) {
+			log.Infof("deleted %d expired rows of table %q from range %s", deleted, desc.Name, rng)
+		}
+	}
+	return nil
+}
+
 // lookupGCPolicy queries the gossip prefix config map based on the
 // supplied range's start key. It queries all matching config prefixes
 // and then iterates from most specific to least, returning the first
@@ -91,7 +91,7 @@ func TestGCQueueShouldQueue(t *testing.T) {
 		{bc, bc * ttl, 1, 0, makeTS(iaN*2, 0), true, 5},
 	}
 
-	gcQ := newGCQueue()
+	gcQ := newGCQueue(nil)
 
 	for i, test := range testCases {
 		// Write gc'able bytes as key bytes; since "live" bytes will be
@@ -205,7 +205,7 @@ func TestGCQueueProcess(t *testing.T) {
 	}
 
 	// Process through a scan queue.
-	gcQ := newGCQueue()
+	gcQ := newGCQueue(nil)
 	if err := gcQ.process(tc.clock.Now(), tc.rng); err != nil {
 		t.Error(err)
 	}
@@ -279,6 +279,9 @@ func TestGCQueueProcess(t *testing.T) {
 	if *gcMeta.OldestIntentNanos != ts4.WallTime {
 		t.Errorf("expected oldest intent nanos=%d; got %d", ts4.WallTime, gcMeta.OldestIntentNanos)
 	}
+	if threshold := now - 24*60*60*1E9; gcMeta.GetThresholdNanos() != threshold {
+		t.Errorf("expected threshold nanos=%d; got %d", threshold, gcMeta.GetThresholdNanos())
+	}
 
 	// Verify that the last verification timestamp was updated as whole range was scanned.
 	ts, err := tc.rng.GetLastVerificationTimestamp()
@@ -336,7 +339,7 @@ func TestGCQueueLookupGCPolicy(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	gcQ := newGCQueue()
+	gcQ := newGCQueue(nil)
 	gcPolicy, err := gcQ.lookupGCPolicy(rng2)
 	if err != nil {
 		t.Fatal(err)
@@ -49,6 +49,7 @@ import (
 func init() {
 	gob.Register(proto.StoreDescriptor{})
 	gob.Register(PrefixConfigMap{})
+	gob.Register(TablePrivilegeMap{})
 	gob.Register(&proto.AcctConfig{})
 	gob.Register(&proto.PermConfig{})
 	gob.Register(&proto.ZoneConfig{})
@@ -134,6 +135,13 @@ var tsCacheMethods = [...]bool{
 	proto.DeleteRange:                true,
 	proto.InternalResolveIntent:      true,
 	proto.InternalResolveIntentRange: true,
+	proto.GetRow:                     true,
+	proto.PutRow:                     true,
+	proto.DeleteRow:                  true,
+	proto.ScanRows:                   true,
+	proto.LockRow:                    true,
+	proto.PutUnique:                  true,
+	proto.ScanChanges:                true,
 }
 
 // usesTimestampCache returns true if the request affects or is
@@ -202,6 +210,7 @@ type Range struct {
 	// Last index applied to the state machine. Updated atomically.
 	appliedIndex uint64
 	configHashes map[int][]byte // Config map sha256 hashes @ last gossip
+	privHash     []byte         // Table privilege map sha256 hash @ last gossip
 	lease        unsafe.Pointer // Information for leader lease, updated atomically
 	llMu         sync.Mutex     // Synchronizes readers' requests for leader lease
 
@@ -529,11 +538,13 @@ func (r *Range) AddCmd(ctx context.Context, call client.Call, wait bool) error {
 // there are any overlapping commands already in the queue. Returns
 // the command queue insertion key, to be supplied to subsequent
 // invocation of endCmd().
-func (r *Range) beginCmd(header *proto.RequestHeader, readOnly bool) interface{} {
+func (r *Range) beginCmd(args proto.Request, readOnly bool) interface{} {
+	header := args.Header()
+	start, end := proto.KeySpan(args)
 	r.Lock()
 	var wg sync.WaitGroup
-	r.cmdQ.GetWait(header.Key, header.EndKey, readOnly, &wg)
-	cmdKey := r.cmdQ.Add(header.Key, header.EndKey, readOnly)
+	r.cmdQ.GetWait(start, end, readOnly, &wg)
+	cmdKey := r.cmdQ.Add(start, end, readOnly)
 	r.Unlock()
 	wg.Wait()
 	// Update the incoming timestamp if unset. Wait until after any
@@ -551,7 +562,8 @@ func (r *Range) endCmd(cmdKey interface{}, args proto.Request, err error, readOn
 	r.Lock()
 	if err == nil && usesTimestampCache(args) {
 		header := args.Header()
-		r.tsCache.Add(header.Key, header.EndKey, header.Timestamp, header.Txn.GetID(), readOnly)
+		start, end := proto.KeySpan(args)
+		r.tsCache.Add(start, end, header.Timestamp, header.Txn.GetID(), readOnly)
 	}
 	r.cmdQ.Remove(cmdKey)
 	r.Unlock()
@@ -603,7 +615,7 @@ func (r *Range) addReadOnlyCmd(ctx context.Context, args proto.Request, reply pr
 
 	// Add the read to the command queue to gate subsequent
 	// overlapping commands until this command completes.
-	cmdKey := r.beginCmd(header, true)
+	cmdKey := r.beginCmd(args, true)
 
 	// This replica must have leader lease to process a consistent read.
 	if err := r.redirectOnOrAcquireLeaderLease(args.Header().Timestamp); err != nil {
@@ -641,7 +653,7 @@ func (r *Range) addWriteCmd(ctx context.Context, args proto.Request, reply proto
 	// done before getting the max timestamp for the key(s), as
 	// timestamp cache is only updated after preceding commands have
 	// been run to successful completion.
-	cmdKey := r.beginCmd(header, false)
+	cmdKey := r.beginCmd(args, false)
 
 	// This replica must have leader lease to process a write.
 	if err := r.redirectOnOrAcquireLeaderLease(header.Timestamp); err != nil {
@@ -660,7 +672,8 @@ func (r *Range) addWriteCmd(ctx context.Context, args proto.Request, reply proto
 	// inform the final commit timestamp.
 	if usesTimestampCache(args) {
 		r.Lock()
-		rTS, wTS := r.tsCache.GetMax(header.Key, header.EndKey, header.Txn.GetID())
+		start, end := proto.KeySpan(args)
+		rTS, wTS := r.tsCache.GetMax(start, end, header.Txn.GetID())
 		r.Unlock()
 
 		// Always push the timestamp forward if there's been a read which
@@ -876,6 +889,16 @@ func (r *Range) applyRaftCommand(ctx context.Context, index uint64, originNodeID
 				})
 			}
 		}
+		// Table descriptors are written transactionally, so their
+		// privileges are regossiped as intents are resolved as well.
+		switch args.(type) {
+		case *proto.PutRequest, *proto.ConditionalPutRequest, *proto.DeleteRequest, *proto.DeleteRangeRequest,
+			*proto.InternalResolveIntentRequest, *proto.InternalResolveIntentRangeRequest:
+			if header.Key.Less(keys.DescMetadataPrefix.PrefixEnd()) &&
+				(bytes.HasPrefix(header.Key, keys.DescMetadataPrefix) || keys.DescMetadataPrefix.Less(header.EndKey)) {
+				r.maybeGossipTablePrivilegesLocked()
+			}
+		}
 	}
 
 	// Add this command's result to the response cache if this is a
@@ -1000,6 +1023,38 @@ func (r *Range) maybeGossipConfigsLocked(match func(configPrefix proto.Key) bool
 	}
 }
 
+// maybeGossipTablePrivileges gossips the privileges of the tables whose
+// descriptors the range holds if they changed since they were last
+// gossiped. Like the configuration maps, they are gossiped by the store
+// initially and then following writes to the descriptors.
+func (r *Range) maybeGossipTablePrivileges() {
+	r.Lock()
+	defer r.Unlock()
+	r.maybeGossipTablePrivilegesLocked()
+}
+
+func (r *Range) maybeGossipTablePrivilegesLocked() {
+	if r.rm.Gossip() == nil || !r.isInitialized() {
+		return
+	}
+	ctx := r.context()
+	if !r.ContainsKey(keys.DescMetadataPrefix.PrefixEnd()) {
+		log.Fatalc(ctx, "range splits table descriptors")
+	}
+	privMap, hash, err := loadTablePrivilegeMap(r.rm.Engine())
+	if err != nil {
+		log.Errorc(ctx, "failed loading table privileges: %s", err)
+		return
+	}
+	if !bytes.Equal(r.privHash, hash) {
+		r.privHash = hash
+		log.Infoc(ctx, "gossiping table privileges from store %d, range %d", r.rm.StoreID(), r.Desc().RaftID)
+		if err := r.rm.Gossip().AddInfo(gossip.KeyTablePrivileges, privMap, 0*time.Second); err != nil {
+			log.Errorc(ctx, "failed to gossip table privileges: %s", err)
+		}
+	}
+}
+
 // loadConfigMap scans the config entries under keyPrefix and
 // instantiates/returns a config map and its sha256 hash. Prefix
 // configuration maps include accounting, permissions, and zones.
@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"fmt"
 	"math/rand"
+	"sort"
 	"sync/atomic"
 	"unsafe"
 
@@ -68,6 +69,20 @@ func (r *Range) executeCmd(batch engine.Engine, ms *proto.MVCCStats, args proto.
 		r.DeleteRange(batch, ms, tArgs, reply.(*proto.DeleteRangeResponse))
 	case *proto.ScanRequest:
 		r.Scan(batch, tArgs, reply.(*proto.ScanResponse))
+	case *proto.GetRowRequest:
+		r.GetRow(batch, tArgs, reply.(*proto.GetRowResponse))
+	case *proto.PutRowRequest:
+		r.PutRow(batch, ms, tArgs, reply.(*proto.PutRowResponse))
+	case *proto.DeleteRowRequest:
+		r.DeleteRow(batch, ms, tArgs, reply.(*proto.DeleteRowResponse))
+	case *proto.ScanRowsRequest:
+		r.ScanRows(batch, tArgs, reply.(*proto.ScanRowsResponse))
+	case *proto.ScanChangesRequest:
+		r.ScanChanges(batch, tArgs, reply.(*proto.ScanChangesResponse))
+	case *proto.LockRowRequest:
+		r.LockRow(batch, ms, tArgs, reply.(*proto.LockRowResponse))
+	case *proto.PutUniqueRequest:
+		r.PutUnique(batch, ms, tArgs, reply.(*proto.PutUniqueResponse))
 	case *proto.EndTransactionRequest:
 		r.EndTransaction(batch, ms, tArgs, reply.(*proto.EndTransactionResponse))
 	case *proto.InternalRangeLookupRequest:
@@ -144,6 +159,22 @@ func (r *Range) ConditionalPut(batch engine.Engine, ms *proto.MVCCStats, args *p
 	reply.SetGoError(err)
 }
 
+// PutUnique sets the value for a specified key unless the key holds a
+// different value, in which case a ConditionFailedError holding the
+// existing value is returned. Rewriting the existing value succeeds.
+func (r *Range) PutUnique(batch engine.Engine, ms *proto.MVCCStats, args *proto.PutUniqueRequest, reply *proto.PutUniqueResponse) {
+	existing, err := engine.MVCCGet(batch, args.Key, args.Timestamp, true, args.Txn)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if existing != nil && !bytes.Equal(existing.Bytes, args.Value.Bytes) {
+		reply.SetGoError(&proto.ConditionFailedError{ActualValue: existing})
+		return
+	}
+	reply.SetGoError(engine.MVCCPut(batch, ms, args.Key, args.Timestamp, args.Value, args.Txn))
+}
+
 // Increment increments the value (interpreted as varint64 encoded) and
 // returns the newly incremented value (encoded as varint64). If no value
 // exists for the key, zero is incremented.
@@ -168,13 +199,417 @@ func (r *Range) DeleteRange(batch engine.Engine, ms *proto.MVCCStats, args *prot
 
 // Scan scans the key range specified by start key through end key up
 // to some maximum number of results. The last key of the iteration is
-// returned with the reply.
+// returned with the reply. If args.KeysOnly is set, the values of the
+// rows are omitted from the reply. If args.Reverse is set, the rows are
+// returned in descending key order.
 func (r *Range) Scan(batch engine.Engine, args *proto.ScanRequest, reply *proto.ScanResponse) {
-	kvs, err := engine.MVCCScan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
+	scan := engine.MVCCScan
+	if args.Reverse {
+		scan = engine.MVCCReverseScan
+	}
+	kvs, err := scan(batch, args.Key, args.EndKey, args.MaxResults, args.Timestamp, args.ReadConsistency == proto.CONSISTENT, args.Txn)
+	if args.KeysOnly {
+		for i := range kvs {
+			kvs[i].Value = proto.Value{Timestamp: kvs[i].Value.Timestamp}
+		}
+	}
 	reply.Rows = kvs
 	reply.SetGoError(err)
 }
 
+// ScanChanges scans the committed versions of the keys in the range
+// which were written after args.StartTimestamp and at or before the
+// timestamp of the request. Reading at the timestamp of the request
+// updates the timestamp cache, so that later writes to the range are
+// committed after it and the interval cannot gain versions once read.
+func (r *Range) ScanChanges(batch engine.Engine, args *proto.ScanChangesRequest, reply *proto.ScanChangesResponse) {
+	if args.Txn != nil {
+		reply.SetGoError(util.Errorf("cannot scan changes within a transaction"))
+		return
+	}
+	if args.ReadConsistency != proto.CONSISTENT {
+		reply.SetGoError(util.Errorf("cannot scan changes with %s read consistency", args.ReadConsistency))
+		return
+	}
+	// The versions superseded before the GC threshold of the range may
+	// have been garbage collected, so changes following a start timestamp
+	// before the threshold cannot be read reliably. A zero start timestamp
+	// asks for the changes which remain.
+	gcMeta := &proto.GCMetadata{}
+	if _, err := engine.MVCCGetProto(batch, keys.RangeGCMetadataKey(r.Desc().RaftID), proto.ZeroTimestamp, true, nil, gcMeta); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	threshold := proto.Timestamp{WallTime: gcMeta.GetThresholdNanos()}
+	if !args.StartTimestamp.Equal(proto.ZeroTimestamp) && args.StartTimestamp.Less(threshold) {
+		reply.SetGoError(util.Errorf("cannot scan changes since %s: versions before %s have been garbage collected",
+			args.StartTimestamp, threshold))
+		return
+	}
+	changes, err := engine.MVCCScanChanges(batch, args.Key, args.EndKey, args.MaxResults, args.StartTimestamp, args.Timestamp)
+	reply.Changes = changes
+	reply.SetGoError(err)
+}
+
+// rowKey returns the sentinel key of the row addressed by a row request,
+// verifying that it is the key of the request and that every key of the
+// row is contained in this range.
+func (r *Range) rowKey(header *proto.RequestHeader, tableID, indexID uint32, primaryKey []byte) (proto.Key, error) {
+	rowKey := keys.MakeRowKey(tableID, indexID, primaryKey)
+	if !rowKey.Equal(header.Key) {
+		return nil, util.Errorf("key %q is not the key of row %q", header.Key, rowKey)
+	}
+	// Ranges are split at row boundaries (see rowSplitKey), so a row
+	// straddles two ranges only if they were split before splits were
+	// made row-aware.
+	if !r.ContainsKeyRange(rowKey, rowKey.PrefixEnd()) {
+		return nil, util.Errorf("row %q spans a range boundary", rowKey)
+	}
+	return rowKey, nil
+}
+
+// rowSplitKey returns the key at which to split a range in place of key
+// so that the cells of a row are not separated from its sentinel: the
+// sentinel key of the row if key is the key of one of its cells, and key
+// otherwise. A cell key is the sentinel key of its row followed by the
+// uvarint encoding of the column ID, and the row is recognized by the
+// presence of its sentinel.
+func rowSplitKey(eng engine.Engine, key proto.Key) (proto.Key, error) {
+	if _, ok := decodeTableID(key); !ok {
+		return key, nil
+	}
+	// The encoding of a column ID is between 2 and 9 bytes long.
+	for n := 2; n <= 9 && n < len(key); n++ {
+		rowKey := key[:len(key)-n]
+		if _, ok := keys.DecodeCellKey(rowKey, key); !ok {
+			continue
+		}
+		meta, err := eng.Get(engine.MVCCEncodeKey(rowKey))
+		if err != nil {
+			return nil, err
+		}
+		if meta != nil {
+			return rowKey, nil
+		}
+	}
+	return key, nil
+}
+
+// scanRows iterates over the keys of an index between start and end,
+// grouping the cells of each row by its sentinel key, and invokes f with
+// each row which satisfies the filters until f returns true. Only the
+// cells of the specified columns are passed to f, or all cells if
+// columnIDs is empty. The primary keys of the rows are the suffixes of
+// their sentinel keys following the index prefix. Rows which have expired
+// at the timestamp of the header given ttlSeconds are skipped.
+//
+// If columns are specified, only the cells of the columns which are
+// returned or filtered on are read: the scan seeks from the sentinel of
+// each row to each of these cells and then past the end of the row, so
+// the cells of the other columns are never visited.
+func scanRows(batch engine.Engine, header *proto.RequestHeader, prefix, start, end proto.Key, ttlSeconds int32,
+	columnIDs []uint32, filters []proto.RowFilter, f func(row *proto.Row) (bool, error)) error {
+	// The cells of the filtered columns are read even if they are not
+	// returned.
+	var columns, read map[uint32]bool
+	if len(columnIDs) > 0 {
+		columns = make(map[uint32]bool, len(columnIDs))
+		read = make(map[uint32]bool, len(columnIDs)+len(filters))
+		for _, id := range columnIDs {
+			columns[id], read[id] = true, true
+		}
+		for _, f := range filters {
+			read[f.ColumnId] = true
+		}
+	}
+	var row *proto.Row
+	var rowKey proto.Key
+	// finishRow passes the current row to f if it satisfies the filters,
+	// dropping the cells of the columns which are not returned.
+	finishRow := func() (bool, error) {
+		if row == nil {
+			return false, nil
+		}
+		r := row
+		row = nil
+		if ok, err := proto.MatchRow(r, filters); !ok || err != nil {
+			return false, err
+		}
+		if columns != nil && len(read) > len(columns) {
+			cells := r.Cells[:0]
+			for _, cell := range r.Cells {
+				if columns[cell.ColumnId] {
+					cells = append(cells, cell)
+				}
+			}
+			r.Cells = cells
+		}
+		return f(r)
+	}
+	if read != nil {
+		ids := make([]uint32, 0, len(read))
+		for id := range read {
+			ids = append(ids, id)
+		}
+		// The cells of a row are ordered by column ID.
+		sort.Sort(columnIDsByValue(ids))
+		consistent := header.ReadConsistency == proto.CONSISTENT
+		for key := start; ; {
+			kvs, err := engine.MVCCScan(batch, key, end, 1, header.Timestamp, consistent, header.Txn)
+			if err != nil || len(kvs) == 0 {
+				return err
+			}
+			rowKey = kvs[0].Key
+			if !bytes.HasPrefix(rowKey, prefix) {
+				return util.Errorf("key %q is not in index %q", rowKey, prefix)
+			}
+			key = rowKey.PrefixEnd()
+			if proto.RowExpired(&kvs[0].Value, ttlSeconds, header.Timestamp) {
+				continue
+			}
+			row = &proto.Row{PrimaryKey: []byte(rowKey[len(prefix):])}
+			for _, id := range ids {
+				v, err := engine.MVCCGet(batch, keys.MakeCellKey(rowKey, id), header.Timestamp, consistent, header.Txn)
+				if err != nil {
+					return err
+				}
+				if v != nil {
+					row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: v.Bytes})
+				}
+			}
+			if done, err := finishRow(); done || err != nil {
+				return err
+			}
+		}
+	}
+	err := engine.MVCCIterate(batch, start, end, header.Timestamp,
+		header.ReadConsistency == proto.CONSISTENT, header.Txn, func(kv proto.KeyValue) (bool, error) {
+			if id, ok := keys.DecodeCellKey(rowKey, kv.Key); rowKey != nil && ok {
+				// The row is nil if it has expired.
+				if row != nil && (read == nil || read[id]) {
+					row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: kv.Value.Bytes})
+				}
+				return false, nil
+			}
+			// The key is the sentinel of the next row.
+			if done, err := finishRow(); done || err != nil {
+				return done, err
+			}
+			if !bytes.HasPrefix(kv.Key, prefix) {
+				return false, util.Errorf("key %q is not in index %q", kv.Key, prefix)
+			}
+			rowKey = kv.Key
+			if !proto.RowExpired(&kv.Value, ttlSeconds, header.Timestamp) {
+				row = &proto.Row{PrimaryKey: []byte(rowKey[len(prefix):])}
+			}
+			return false, nil
+		})
+	if err == nil {
+		_, err = finishRow()
+	}
+	return err
+}
+
+// columnIDsByValue implements sort.Interface for a slice of column IDs.
+type columnIDsByValue []uint32
+
+func (c columnIDsByValue) Len() int           { return len(c) }
+func (c columnIDsByValue) Swap(i, j int)      { c[i], c[j] = c[j], c[i] }
+func (c columnIDsByValue) Less(i, j int) bool { return c[i] < c[j] }
+
+// GetRow returns the cells of the row addressed by the table, index and
+// primary key of the request. The row is nil if it does not exist or has
+// expired. If the request specifies columns, only the sentinel of the row
+// and the cells of those columns are read, in the order of the request.
+func (r *Range) GetRow(batch engine.Engine, args *proto.GetRowRequest, reply *proto.GetRowResponse) {
+	rowKey, err := r.rowKey(&args.RequestHeader, args.TableId, args.IndexId, args.PrimaryKey)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	prefix := keys.MakeIndexPrefix(args.TableId, args.IndexId)
+	if len(args.ColumnIds) > 0 {
+		consistent := args.ReadConsistency == proto.CONSISTENT
+		sentinel, err := engine.MVCCGet(batch, rowKey, args.Timestamp, consistent, args.Txn)
+		if err != nil || sentinel == nil || proto.RowExpired(sentinel, args.TTLSeconds, args.Timestamp) {
+			reply.SetGoError(err)
+			return
+		}
+		row := &proto.Row{PrimaryKey: []byte(rowKey[len(prefix):])}
+		for _, id := range args.ColumnIds {
+			v, err := engine.MVCCGet(batch, keys.MakeCellKey(rowKey, id), args.Timestamp, consistent, args.Txn)
+			if err != nil {
+				reply.SetGoError(err)
+				return
+			}
+			if v != nil {
+				row.Cells = append(row.Cells, proto.RowCell{ColumnId: id, Value: v.Bytes})
+			}
+		}
+		reply.Row = row
+		return
+	}
+	reply.SetGoError(scanRows(batch, &args.RequestHeader, prefix, rowKey, rowKey.PrefixEnd(), args.TTLSeconds, args.ColumnIds, nil,
+		func(row *proto.Row) (bool, error) {
+			reply.Row = row
+			return true, nil
+		}))
+}
+
+// PutRow writes the sentinel of the row addressed by the request along
+// with its cells. Cells with a nil value are deleted, as are all of the
+// cells of the row if it has expired.
+func (r *Range) PutRow(batch engine.Engine, ms *proto.MVCCStats, args *proto.PutRowRequest, reply *proto.PutRowResponse) {
+	rowKey, err := r.rowKey(&args.RequestHeader, args.TableId, args.IndexId, args.PrimaryKey)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if args.TTLSeconds > 0 {
+		sentinel, err := engine.MVCCGet(batch, rowKey, args.Timestamp, true, args.Txn)
+		if err == nil && proto.RowExpired(sentinel, args.TTLSeconds, args.Timestamp) {
+			_, err = engine.MVCCDeleteRange(batch, ms, rowKey.Next(), rowKey.PrefixEnd(), 0, args.Timestamp, args.Txn)
+		}
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	}
+	sentinel := proto.Value{Bytes: []byte{}}
+	sentinel.InitChecksum(rowKey)
+	if err := engine.MVCCPut(batch, ms, rowKey, args.Timestamp, sentinel, args.Txn); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	for _, cell := range args.Cells {
+		if cell.ColumnId == 0 {
+			reply.SetGoError(util.Errorf("row %q: invalid column ID 0", rowKey))
+			return
+		}
+		key := keys.MakeCellKey(rowKey, cell.ColumnId)
+		if cell.Value == nil {
+			err = engine.MVCCDelete(batch, ms, key, args.Timestamp, args.Txn)
+		} else {
+			value := proto.Value{Bytes: cell.Value}
+			value.InitChecksum(key)
+			err = engine.MVCCPut(batch, ms, key, args.Timestamp, value, args.Txn)
+		}
+		if err != nil {
+			reply.SetGoError(err)
+			return
+		}
+	}
+}
+
+// DeleteRow deletes the sentinel and the cells of the row addressed by
+// the request. The reply records whether the row existed. If the request
+// specifies a time to live, the row is only deleted if it has expired.
+func (r *Range) DeleteRow(batch engine.Engine, ms *proto.MVCCStats, args *proto.DeleteRowRequest, reply *proto.DeleteRowResponse) {
+	rowKey, err := r.rowKey(&args.RequestHeader, args.TableId, args.IndexId, args.PrimaryKey)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	if args.TTLSeconds > 0 {
+		sentinel, err := engine.MVCCGet(batch, rowKey, args.Timestamp, true, args.Txn)
+		if err != nil || !proto.RowExpired(sentinel, args.TTLSeconds, args.Timestamp) {
+			reply.SetGoError(err)
+			return
+		}
+	}
+	num, err := engine.MVCCDeleteRange(batch, ms, rowKey, rowKey.PrefixEnd(), 0, args.Timestamp, args.Txn)
+	reply.Deleted = num > 0
+	reply.SetGoError(err)
+}
+
+// ScanRows returns the rows of the index whose sentinel keys fall between
+// the start and end keys of the request and which satisfy its filters, up
+// to a maximum number of rows. If the request specifies aggregates, the
+// rows are folded into their results instead of being returned.
+// Rows do not straddle range boundaries, as ranges are split at row
+// boundaries (see rowSplitKey); the cells at the start of a range would
+// otherwise be mistaken for the sentinel of a row.
+func (r *Range) ScanRows(batch engine.Engine, args *proto.ScanRowsRequest, reply *proto.ScanRowsResponse) {
+	prefix := keys.MakeIndexPrefix(args.TableId, args.IndexId)
+	if len(args.Aggregates) == 0 {
+		reply.SetGoError(scanRows(batch, &args.RequestHeader, prefix, args.Key, args.EndKey, args.TTLSeconds, args.ColumnIds, args.Filters,
+			func(row *proto.Row) (bool, error) {
+				reply.Rows = append(reply.Rows, *row)
+				return args.MaxRows > 0 && int64(len(reply.Rows)) == args.MaxRows, nil
+			}))
+		return
+	}
+	if args.MaxRows != 0 {
+		reply.SetGoError(util.Errorf("cannot limit the rows of an aggregating scan"))
+		return
+	}
+	// Only the aggregated columns are read; counting rows reads column 0,
+	// which has no cells, leaving just the sentinels.
+	columnIDs := make([]uint32, len(args.Aggregates))
+	reply.Aggregates = make([]proto.RowAggregateResult, len(args.Aggregates))
+	for i, a := range args.Aggregates {
+		columnIDs[i] = a.ColumnId
+		reply.Aggregates[i].Aggregate = a
+	}
+	reply.SetGoError(scanRows(batch, &args.RequestHeader, prefix, args.Key, args.EndKey, args.TTLSeconds, columnIDs, args.Filters,
+		func(row *proto.Row) (bool, error) {
+			for i := range reply.Aggregates {
+				if err := reply.Aggregates[i].Add(row); err != nil {
+					return false, err
+				}
+			}
+			return false, nil
+		}))
+}
+
+// LockRow reads the row addressed by the request like GetRow and lays a
+// write intent on its sentinel without modifying the row: the sentinel is
+// rewritten if the row exists and deleted otherwise. Writers of the row
+// write its sentinel as well, and so conflict with the intent until the
+// transaction ends.
+func (r *Range) LockRow(batch engine.Engine, ms *proto.MVCCStats, args *proto.LockRowRequest, reply *proto.LockRowResponse) {
+	if args.Txn == nil {
+		reply.SetGoError(util.Errorf("no transaction specified to LockRow"))
+		return
+	}
+	rowKey, err := r.rowKey(&args.RequestHeader, args.TableId, args.IndexId, args.PrimaryKey)
+	if err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	getReply := &proto.GetRowResponse{}
+	r.GetRow(batch, &proto.GetRowRequest{
+		RequestHeader: args.RequestHeader,
+		TableId:       args.TableId,
+		IndexId:       args.IndexId,
+		PrimaryKey:    args.PrimaryKey,
+		ColumnIds:     args.ColumnIds,
+		TTLSeconds:    args.TTLSeconds,
+	}, getReply)
+	if err := getReply.GoError(); err != nil {
+		reply.SetGoError(err)
+		return
+	}
+	reply.Row = getReply.Row
+	if reply.Row == nil {
+		// The cells of an expired row are deleted along with its sentinel.
+		if args.TTLSeconds > 0 {
+			if _, err := engine.MVCCDeleteRange(batch, ms, rowKey.Next(), rowKey.PrefixEnd(), 0, args.Timestamp, args.Txn); err != nil {
+				reply.SetGoError(err)
+				return
+			}
+		}
+		// The deletion of the sentinel is written even though the row does
+		// not exist, so that inserts of the row by other transactions
+		// conflict with its intent.
+		reply.SetGoError(engine.MVCCDeleteIntent(batch, ms, rowKey, args.Timestamp, args.Txn))
+		return
+	}
+	sentinel := proto.Value{Bytes: []byte{}}
+	sentinel.InitChecksum(rowKey)
+	reply.SetGoError(engine.MVCCPut(batch, ms, rowKey, args.Timestamp, sentinel, args.Txn))
+}
+
 // EndTransaction either commits or aborts (rolls back) an extant
 // transaction according to the args.Commit parameter.
 func (r *Range) EndTransaction(batch engine.Engine, ms *proto.MVCCStats, args *proto.EndTransactionRequest, reply *proto.EndTransactionResponse) {
@@ -333,11 +768,17 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args *proto.InternalRan
 		rangeCount = 1 // simplify lookup because we may have to retry to read new
 	}
 
-	// We want to search for the metadata key just greater than args.Key. Scan
-	// for both the requested key and the keys immediately afterwards, up to
-	// MaxRanges.
-	startKey, endKey := keys.MetaScanBounds(args.Key)
-	kvs, err := engine.MVCCScan(batch, startKey, endKey, rangeCount, args.Timestamp, false, args.Txn)
+	var kvs []proto.KeyValue
+	var err error
+	if args.Reverse {
+		kvs, err = reverseMetaScan(batch, args.Key, rangeCount, args.Timestamp, args.Txn)
+	} else {
+		// We want to search for the metadata key just greater than args.Key. Scan
+		// for both the requested key and the keys immediately afterwards, up to
+		// MaxRanges.
+		startKey, endKey := keys.MetaScanBounds(args.Key)
+		kvs, err = engine.MVCCScan(batch, startKey, endKey, rangeCount, args.Timestamp, false, args.Txn)
+	}
 	if err != nil {
 		if wiErr, ok := err.(*proto.WriteIntentError); ok && args.IgnoreIntents {
 			// NOTE (subtle): in general, we want to try to clean up dangling
@@ -393,6 +834,25 @@ func (r *Range) InternalRangeLookup(batch engine.Engine, args *proto.InternalRan
 	return
 }
 
+// reverseMetaScan returns the first meta record at or after the given
+// meta key, which is that of the range holding the keys immediately
+// before the key addressed by it, followed by the records preceding it
+// in the same meta prefix, in descending order, up to max records in
+// total.
+func reverseMetaScan(batch engine.Engine, key proto.Key, max int64, timestamp proto.Timestamp,
+	txn *proto.Transaction) ([]proto.KeyValue, error) {
+	if key.Equal(proto.KeyMin) {
+		return nil, util.Errorf("no range precedes %s", key)
+	}
+	prefix := proto.Key(key[:len(keys.Meta1Prefix)])
+	kvs, err := engine.MVCCScan(batch, key, prefix.PrefixEnd(), 1, timestamp, false, txn)
+	if err != nil || len(kvs) == 0 || max == 1 {
+		return kvs, err
+	}
+	prev, err := engine.MVCCReverseScan(batch, prefix, kvs[0].Key, max-1, timestamp, false, txn)
+	return append(kvs, prev...), err
+}
+
 // InternalHeartbeatTxn updates the transaction status and heartbeat
 // timestamp after receiving transaction heartbeat messages from
 // coordinator. Returns the updated transaction.
@@ -758,6 +1218,9 @@ func (r *Range) InternalLeaderLease(batch engine.Engine, ms *proto.MVCCStats, ar
 	r.maybeGossipConfigsLocked(func(configPrefix proto.Key) bool {
 		return r.ContainsKey(configPrefix)
 	})
+	if r.ContainsKey(keys.DescMetadataPrefix) {
+		r.maybeGossipTablePrivilegesLocked()
+	}
 }
 
 // AdminSplit divides the range into into two ranges, using either
@@ -776,16 +1239,31 @@ func (r *Range) AdminSplit(args *proto.AdminSplitRequest, reply *proto.AdminSpli
 	// allowed to be relatively slow because admin commands don't block
 	// other commands.
 	desc := r.Desc()
+	snap := r.rm.NewSnapshot()
+	defer snap.Close()
 	splitKey := proto.Key(args.SplitKey)
 	if len(splitKey) == 0 {
-		snap := r.rm.NewSnapshot()
-		defer snap.Close()
 		var err error
 		if splitKey, err = engine.MVCCFindSplitKey(snap, desc.RaftID, desc.StartKey, desc.EndKey); err != nil {
 			reply.SetGoError(util.Errorf("unable to determine split key: %s", err))
 			return
 		}
 	}
+	// Splits within a row are moved to the start of the row, or to its end
+	// if the row starts the range, so that the cells of a row stay with
+	// its sentinel.
+	rowKey, err := rowSplitKey(snap, splitKey)
+	if err != nil {
+		reply.SetGoError(util.Errorf("unable to determine split key: %s", err))
+		return
+	}
+	if rowKey.Equal(desc.StartKey) && !rowKey.Equal(splitKey) {
+		if rowKey = rowKey.PrefixEnd(); !rowKey.Less(desc.EndKey) {
+			reply.SetGoError(util.Errorf("range %s holds a single row and cannot be split", r))
+			return
+		}
+	}
+	splitKey = rowKey
 	// First verify this condition so that it will not return
 	// proto.NewRangeKeyMismatchError if splitKey equals to desc.EndKey,
 	// otherwise it will cause infinite retry loop.
@@ -1975,6 +1975,456 @@ func verifyRangeStats(eng engine.Engine, raftID proto.RaftID, expMS proto.MVCCSt
 	}
 }
 
+// TestRangeRowCommands verifies that rows written with PutRow are read by
+// GetRow and ScanRows, filtered by ScanRows and deleted by DeleteRow.
+func TestRangeRowCommands(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const tableID, indexID = 100, 1
+	header := func(primaryKey string) proto.RequestHeader {
+		return proto.RequestHeader{
+			Key:       keys.MakeRowKey(tableID, indexID, []byte(primaryKey)),
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		}
+	}
+	send := func(args proto.Request, reply proto.Response) {
+		if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: reply}, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	putRow := func(primaryKey string, cells ...proto.RowCell) {
+		send(&proto.PutRowRequest{
+			RequestHeader: header(primaryKey),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte(primaryKey),
+			Cells:         cells,
+		}, &proto.PutRowResponse{})
+	}
+	scanRows := func(filters ...proto.RowFilter) []proto.Row {
+		prefix := keys.MakeIndexPrefix(tableID, indexID)
+		h := header("")
+		h.Key, h.EndKey = prefix, prefix.PrefixEnd()
+		reply := &proto.ScanRowsResponse{}
+		send(&proto.ScanRowsRequest{RequestHeader: h, TableId: tableID, IndexId: indexID, Filters: filters}, reply)
+		return reply.Rows
+	}
+
+	putRow("a", proto.RowCell{ColumnId: 2, Value: []byte("x")}, proto.RowCell{ColumnId: 3, Value: []byte("y")})
+	putRow("b", proto.RowCell{ColumnId: 2, Value: []byte("z")})
+
+	gReply := &proto.GetRowResponse{}
+	send(&proto.GetRowRequest{
+		RequestHeader: header("a"),
+		TableId:       tableID,
+		IndexId:       indexID,
+		PrimaryKey:    []byte("a"),
+		ColumnIds:     []uint32{3},
+	}, gReply)
+	expRow := &proto.Row{PrimaryKey: []byte("a"), Cells: []proto.RowCell{{ColumnId: 3, Value: []byte("y")}}}
+	if !reflect.DeepEqual(gReply.Row, expRow) {
+		t.Errorf("expected %+v, but found %+v", expRow, gReply.Row)
+	}
+	// Only the sentinel of a row marks its existence.
+	gReply = &proto.GetRowResponse{}
+	send(&proto.GetRowRequest{
+		RequestHeader: header("c"),
+		TableId:       tableID,
+		IndexId:       indexID,
+		PrimaryKey:    []byte("c"),
+		ColumnIds:     []uint32{2, 3},
+	}, gReply)
+	if gReply.Row != nil {
+		t.Errorf("expected no row, but found %+v", gReply.Row)
+	}
+
+	// Deleting a cell leaves the other cells of the row untouched.
+	putRow("a", proto.RowCell{ColumnId: 3})
+	expRows := []proto.Row{
+		{PrimaryKey: []byte("a"), Cells: []proto.RowCell{{ColumnId: 2, Value: []byte("x")}}},
+		{PrimaryKey: []byte("b"), Cells: []proto.RowCell{{ColumnId: 2, Value: []byte("z")}}},
+	}
+	if rows := scanRows(); !reflect.DeepEqual(rows, expRows) {
+		t.Errorf("expected %+v, but found %+v", expRows, rows)
+	}
+	// Filters are evaluated by the replica.
+	filter := proto.RowFilter{ColumnId: 2, Op: proto.RowFilter_GT, Value: []byte("y")}
+	if rows := scanRows(filter); !reflect.DeepEqual(rows, expRows[1:]) {
+		t.Errorf("expected %+v, but found %+v", expRows[1:], rows)
+	}
+	// Aggregates are computed by the replica.
+	prefix := keys.MakeIndexPrefix(tableID, indexID)
+	h := header("")
+	h.Key, h.EndKey = prefix, prefix.PrefixEnd()
+	aReply := &proto.ScanRowsResponse{}
+	send(&proto.ScanRowsRequest{RequestHeader: h, TableId: tableID, IndexId: indexID, Aggregates: []proto.RowAggregate{
+		{Func: proto.RowAggregate_COUNT},
+		{Func: proto.RowAggregate_MAX, ColumnId: 2},
+	}}, aReply)
+	if len(aReply.Rows) != 0 || len(aReply.Aggregates) != 2 ||
+		aReply.Aggregates[0].Count != 2 || !bytes.Equal(aReply.Aggregates[1].Value, []byte("z")) {
+		t.Errorf("expected a count of 2 and a max of \"z\", but found %+v", aReply)
+	}
+
+	for i, expDeleted := range []bool{true, false} {
+		dReply := &proto.DeleteRowResponse{}
+		send(&proto.DeleteRowRequest{
+			RequestHeader: header("b"),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte("b"),
+		}, dReply)
+		if dReply.Deleted != expDeleted {
+			t.Errorf("%d: expected deleted %t, but found %t", i, expDeleted, dReply.Deleted)
+		}
+	}
+	if rows := scanRows(); !reflect.DeepEqual(rows, expRows[:1]) {
+		t.Errorf("expected %+v, but found %+v", expRows[:1], rows)
+	}
+
+	// The key of a row request must be the key of the row.
+	args := &proto.GetRowRequest{RequestHeader: header("a"), TableId: tableID, IndexId: indexID, PrimaryKey: []byte("b")}
+	if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: &proto.GetRowResponse{}}, true); err == nil {
+		t.Error("expected an error for a mismatched row key")
+	}
+}
+
+// TestScanRowsSeeksToColumns verifies that a scan of rows which
+// specifies columns reads only the cells of the returned and filtered
+// columns: an intent on the cell of another column does not block it.
+func TestScanRowsSeeksToColumns(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	prefix := keys.MakeIndexPrefix(100, 1)
+	ts := proto.Timestamp{WallTime: 1}
+	put := func(key proto.Key, value string, txn *proto.Transaction) {
+		putTS := ts
+		if txn != nil {
+			putTS = txn.Timestamp
+		}
+		if err := engine.MVCCPut(tc.engine, nil, key, putTS, proto.Value{Bytes: []byte(value)}, txn); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, primaryKey := range []string{"a", "b"} {
+		rowKey := keys.MakeRowKey(100, 1, []byte(primaryKey))
+		put(rowKey, "", nil)
+		for id := uint32(1); id <= 4; id++ {
+			put(keys.MakeCellKey(rowKey, id), fmt.Sprintf("%s%d", primaryKey, id), nil)
+		}
+	}
+	// A pending transaction has written to a cell of column 3.
+	txn := newTransaction("test", prefix, 1, proto.SERIALIZABLE, tc.clock)
+	txn.Timestamp = ts.Next()
+	put(keys.MakeCellKey(keys.MakeRowKey(100, 1, []byte("a")), 3), "intent", txn)
+
+	header := &proto.RequestHeader{Timestamp: proto.Timestamp{WallTime: 3}}
+	scan := func(columnIDs ...uint32) ([]proto.Row, error) {
+		var rows []proto.Row
+		filters := []proto.RowFilter{{ColumnId: 1, Op: proto.RowFilter_GT, Value: []byte("a1")}}
+		err := scanRows(tc.engine, header, prefix, prefix, prefix.PrefixEnd(), 0, columnIDs, filters,
+			func(row *proto.Row) (bool, error) {
+				rows = append(rows, *row)
+				return false, nil
+			})
+		return rows, err
+	}
+	rows, err := scan(4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expRows := []proto.Row{{PrimaryKey: []byte("b"), Cells: []proto.RowCell{
+		{ColumnId: 2, Value: []byte("b2")}, {ColumnId: 4, Value: []byte("b4")}}}}
+	if !reflect.DeepEqual(rows, expRows) {
+		t.Errorf("expected %+v, but found %+v", expRows, rows)
+	}
+	if _, err := scan(); err == nil {
+		t.Errorf("expected a scan of all columns to encounter the intent")
+	}
+}
+
+// TestRangeLockRow verifies that LockRow reads a row and lays an intent on
+// it which conflicts with writers of the row, whether or not it exists.
+func TestRangeLockRow(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const tableID, indexID = 100, 1
+	header := func(primaryKey string) proto.RequestHeader {
+		return proto.RequestHeader{
+			Key:       keys.MakeRowKey(tableID, indexID, []byte(primaryKey)),
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		}
+	}
+	putRow := func(primaryKey string) error {
+		args := &proto.PutRowRequest{
+			RequestHeader: header(primaryKey),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte(primaryKey),
+			Cells:         []proto.RowCell{{ColumnId: 2, Value: []byte("x")}},
+		}
+		return tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: &proto.PutRowResponse{}}, true)
+	}
+	lockRow := func(primaryKey string, txn *proto.Transaction) (*proto.Row, error) {
+		args := &proto.LockRowRequest{
+			RequestHeader: header(primaryKey),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte(primaryKey),
+		}
+		if txn != nil {
+			args.Txn, args.Timestamp = txn, txn.Timestamp
+		}
+		reply := &proto.LockRowResponse{}
+		err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: reply}, true)
+		return reply.Row, err
+	}
+
+	if err := putRow("a"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := lockRow("a", nil); err == nil {
+		t.Error("expected an error locking a row outside of a transaction")
+	}
+	for _, primaryKey := range []string{"a", "b"} {
+		txn := newTransaction("test", keys.MakeRowKey(tableID, indexID, []byte(primaryKey)), 1, proto.SERIALIZABLE, tc.clock)
+		row, err := lockRow(primaryKey, txn)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists := row != nil; exists != (primaryKey == "a") {
+			t.Errorf("%s: expected the row to exist: %t, but found %+v", primaryKey, primaryKey == "a", row)
+		}
+		if err := putRow(primaryKey); err == nil {
+			t.Errorf("%s: expected the write of a locked row to fail", primaryKey)
+		} else if _, ok := err.(*proto.WriteIntentError); !ok {
+			t.Errorf("%s: expected a write intent error, but found %s", primaryKey, err)
+		}
+	}
+}
+
+// TestRangePutUnique verifies that PutUnique writes a key unless it holds
+// a different value, in which case the existing value is returned.
+func TestRangePutUnique(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	key := proto.Key("a")
+	putUnique := func(value string) error {
+		args := &proto.PutUniqueRequest{
+			RequestHeader: proto.RequestHeader{
+				Key:       key,
+				Timestamp: tc.clock.Now(),
+				RaftID:    1,
+				Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+			},
+			Value: proto.Value{Bytes: []byte(value)},
+		}
+		return tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: &proto.PutUniqueResponse{}}, true)
+	}
+
+	if err := putUnique("1"); err != nil {
+		t.Fatal(err)
+	}
+	// Rewriting the existing value succeeds.
+	if err := putUnique("1"); err != nil {
+		t.Fatal(err)
+	}
+	err := putUnique("2")
+	if cErr, ok := err.(*proto.ConditionFailedError); !ok || cErr.ActualValue == nil ||
+		!bytes.Equal(cErr.ActualValue.Bytes, []byte("1")) {
+		t.Errorf("expected ConditionFailedError with the existing value, but found %v", err)
+	}
+}
+
+// TestRangeScanChanges verifies that ScanChanges returns the versions
+// written within its interval and records its reads in the timestamp
+// cache, so that the interval cannot gain versions once read.
+func TestRangeScanChanges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	pArgs, pReply := putArgs(proto.Key("a"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = tc.clock.Now()
+	if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: pArgs, Reply: pReply}, true); err != nil {
+		t.Fatal(err)
+	}
+
+	args := &proto.ScanChangesRequest{
+		RequestHeader: proto.RequestHeader{
+			Key:       proto.Key("a"),
+			EndKey:    proto.Key("z"),
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		},
+	}
+	reply := &proto.ScanChangesResponse{}
+	if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: reply}, true); err != nil {
+		t.Fatal(err)
+	}
+	if len(reply.Changes) != 1 || !reply.Changes[0].Key.Equal(proto.Key("a")) ||
+		!reply.Changes[0].Value.Timestamp.Equal(pReply.Timestamp) {
+		t.Errorf("expected the version of \"a\" at %s, but found %+v", pReply.Timestamp, reply.Changes)
+	}
+
+	// A write within the interval is pushed past its end.
+	pArgs, pReply = putArgs(proto.Key("b"), []byte("value"), 1, tc.store.StoreID())
+	pArgs.Timestamp = args.Timestamp.Prev()
+	if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: pArgs, Reply: pReply}, true); err != nil {
+		t.Fatal(err)
+	}
+	if !args.Timestamp.Less(pReply.Timestamp) {
+		t.Errorf("expected the write to be pushed past %s, but found %s", args.Timestamp, pReply.Timestamp)
+	}
+
+	// The versions superseded before the GC threshold may have been garbage
+	// collected, so the changes since an earlier timestamp cannot be read.
+	tc.manualClock.Set(10)
+	threshold := makeTS(5, 0)
+	gcMeta := &proto.GCMetadata{ThresholdNanos: gogoproto.Int64(threshold.WallTime)}
+	if err := engine.MVCCPutProto(tc.store.Engine(), nil, keys.RangeGCMetadataKey(1), proto.ZeroTimestamp, nil, gcMeta); err != nil {
+		t.Fatal(err)
+	}
+	args.Timestamp = tc.clock.Now()
+	for i, d := range []struct {
+		start proto.Timestamp
+		ok    bool
+	}{
+		{threshold.Prev(), false},
+		{threshold, true},
+		{proto.ZeroTimestamp, true},
+	} {
+		args.StartTimestamp = d.start
+		err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: &proto.ScanChangesResponse{}}, true)
+		if ok := err == nil; ok != d.ok {
+			t.Errorf("%d: expected success %t, but found %v", i, d.ok, err)
+		}
+	}
+
+	// ScanChanges cannot be part of a transaction.
+	args.Txn = newTransaction("test", proto.Key("a"), 1, proto.SERIALIZABLE, tc.clock)
+	if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: reply}, true); err == nil {
+		t.Errorf("expected a transactional ScanChanges to fail")
+	}
+}
+
+// TestRangeRowTTL verifies that row commands honor the time to live of the
+// rows of a table.
+func TestRangeRowTTL(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	const tableID, indexID, ttl = 100, 1, 10
+	header := func(primaryKey string) proto.RequestHeader {
+		return proto.RequestHeader{
+			Key:       keys.MakeRowKey(tableID, indexID, []byte(primaryKey)),
+			Timestamp: tc.clock.Now(),
+			RaftID:    1,
+			Replica:   proto.Replica{StoreID: tc.store.StoreID()},
+		}
+	}
+	send := func(args proto.Request, reply proto.Response) {
+		if err := tc.rng.AddCmd(tc.rng.context(), client.Call{Args: args, Reply: reply}, true); err != nil {
+			t.Fatal(err)
+		}
+	}
+	putRow := func(primaryKey string, cells ...proto.RowCell) {
+		send(&proto.PutRowRequest{
+			RequestHeader: header(primaryKey),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte(primaryKey),
+			Cells:         cells,
+			TTLSeconds:    ttl,
+		}, &proto.PutRowResponse{})
+	}
+	scanRows := func() []proto.Row {
+		prefix := keys.MakeIndexPrefix(tableID, indexID)
+		h := header("")
+		h.Key, h.EndKey = prefix, prefix.PrefixEnd()
+		reply := &proto.ScanRowsResponse{}
+		send(&proto.ScanRowsRequest{RequestHeader: h, TableId: tableID, IndexId: indexID, TTLSeconds: ttl}, reply)
+		return reply.Rows
+	}
+	getRow := func(primaryKey string) *proto.Row {
+		reply := &proto.GetRowResponse{}
+		send(&proto.GetRowRequest{
+			RequestHeader: header(primaryKey),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte(primaryKey),
+			TTLSeconds:    ttl,
+		}, reply)
+		return reply.Row
+	}
+
+	putRow("a", proto.RowCell{ColumnId: 2, Value: []byte("x")}, proto.RowCell{ColumnId: 3, Value: []byte("y")})
+	tc.manualClock.Increment(ttl * 1E9 / 2)
+	putRow("b", proto.RowCell{ColumnId: 2, Value: []byte("z")})
+	if rows := scanRows(); len(rows) != 2 {
+		t.Fatalf("expected 2 rows, but found %+v", rows)
+	}
+
+	// Row "a" expires first, and is skipped by reads.
+	tc.manualClock.Increment(ttl * 1E9 / 2)
+	expRows := []proto.Row{{PrimaryKey: []byte("b"), Cells: []proto.RowCell{{ColumnId: 2, Value: []byte("z")}}}}
+	if rows := scanRows(); !reflect.DeepEqual(rows, expRows) {
+		t.Errorf("expected %+v, but found %+v", expRows, rows)
+	}
+	if row := getRow("a"); row != nil {
+		t.Errorf("expected no row, but found %+v", row)
+	}
+
+	// Deleting rows which have not expired with a time to live is a no-op.
+	for i, test := range []struct {
+		primaryKey string
+		expDeleted bool
+	}{
+		{"b", false},
+		{"a", true},
+	} {
+		dReply := &proto.DeleteRowResponse{}
+		send(&proto.DeleteRowRequest{
+			RequestHeader: header(test.primaryKey),
+			TableId:       tableID,
+			IndexId:       indexID,
+			PrimaryKey:    []byte(test.primaryKey),
+			TTLSeconds:    ttl,
+		}, dReply)
+		if dReply.Deleted != test.expDeleted {
+			t.Errorf("%d: expected deleted %t, but found %t", i, test.expDeleted, dReply.Deleted)
+		}
+	}
+
+	// Rewriting an expired row does not revive its previous cells.
+	tc.manualClock.Increment(ttl * 1E9)
+	putRow("b", proto.RowCell{ColumnId: 3, Value: []byte("w")})
+	expRow := &proto.Row{PrimaryKey: []byte("b"), Cells: []proto.RowCell{{ColumnId: 3, Value: []byte("w")}}}
+	if row := getRow("b"); !reflect.DeepEqual(row, expRow) {
+		t.Errorf("expected %+v, but found %+v", expRow, row)
+	}
+}
+
 // TestRangeStatsComputation verifies that commands executed against a
 // range update the range stat counters. The stat values are
 // empirically derived; we're really just testing that they increment
@@ -2420,6 +2870,59 @@ func TestInternalRangeLookup(t *testing.T) {
 	}
 }
 
+// TestInternalRangeLookupReverse verifies that a reverse range lookup
+// returns the range holding the keys before the requested key, followed
+// by the preceding ranges.
+func TestInternalRangeLookupReverse(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	tc := testContext{}
+	tc.Start(t)
+	defer tc.Stop()
+
+	// Write meta records for two ranges [a, c) and [c, e) preceding the
+	// range's own record at the end of meta2.
+	descs := []proto.RangeDescriptor{
+		{RaftID: 2, StartKey: proto.Key("a"), EndKey: proto.Key("c")},
+		{RaftID: 3, StartKey: proto.Key("c"), EndKey: proto.Key("e")},
+	}
+	for i := range descs {
+		if err := engine.MVCCPutProto(tc.engine, nil, keys.RangeMetaKey(descs[i].EndKey), proto.MinTimestamp, nil, &descs[i]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	testCases := []struct {
+		key       string
+		maxRanges int32
+		expected  []proto.RangeDescriptor
+	}{
+		{"c", 1, descs[:1]},
+		{"c", 3, descs[:1]},
+		{"d", 1, descs[1:]},
+		{"e", 3, []proto.RangeDescriptor{descs[1], descs[0]}},
+		{"f", 2, []proto.RangeDescriptor{*tc.rng.Desc(), descs[1]}},
+	}
+	for i, c := range testCases {
+		reply := proto.InternalRangeLookupResponse{}
+		if err := tc.store.ExecuteCmd(context.Background(), client.Call{
+			Args: &proto.InternalRangeLookupRequest{
+				RequestHeader: proto.RequestHeader{
+					RaftID: 1,
+					Key:    keys.RangeMetaKey(proto.Key(c.key)),
+				},
+				MaxRanges: c.maxRanges,
+				Reverse:   true,
+			},
+			Reply: &reply,
+		}); err != nil {
+			t.Fatalf("%d: %s", i, err)
+		}
+		if !reflect.DeepEqual(reply.Ranges, c.expected) {
+			t.Errorf("%d: expected %+v, got %+v", i, c.expected, reply.Ranges)
+		}
+	}
+}
+
 // benchmarkEvents is designed to determine the impact of sending events on the
 // performance of write commands. This benchmark can be run with or without
 // events, and with or without a consumer reading the events.
@@ -260,6 +260,7 @@ type Store struct {
 	verifyQueue    *verifyQueue    // Checksum verification queue
 	replicateQueue *replicateQueue // Replication queue
 	rangeGCQueue   *rangeGCQueue   // Range GC queue
+	tableGCQueue   *tableGCQueue   // Dropped table GC queue
 	scanner        *rangeScanner   // Range scanner
 	feed           StoreEventFeed  // Event Feed
 	multiraft      *multiraft.MultiRaft
@@ -269,6 +270,10 @@ type Store struct {
 	nodeDesc       *proto.NodeDescriptor
 	initComplete   sync.WaitGroup // Signaled by async init tasks
 
+	// tablePrivileges holds the gossiped privileges of tables, which
+	// are verified on every request (see verifyTablePrivileges).
+	tablePrivileges *tablePrivilegeCache
+
 	mu           sync.RWMutex            // Protects variables below...
 	ranges       map[proto.RaftID]*Range // Map of ranges by Raft ID
 	rangesByKey  *btree.BTree            // btree keyed by ranges end keys.
@@ -314,6 +319,11 @@ type StoreContext struct {
 
 	// EventFeed is a feed to which this store will publish events.
 	EventFeed *util.Feed
+
+	// TableGCGracePeriod is the time after which the leaders of ranges
+	// holding the data of a dropped table delete it. Zero disables the
+	// deletion of dropped tables.
+	TableGCGracePeriod time.Duration
 }
 
 // Valid returns true if the StoreContext is populated correctly.
@@ -362,16 +372,19 @@ func NewStore(ctx StoreContext, eng engine.Engine, nodeDesc *proto.NodeDescripto
 		uninitRanges: map[proto.RaftID]*Range{},
 		nodeDesc:     nodeDesc,
 	}
+	s.tablePrivileges = &tablePrivilegeCache{}
 
 	// Add range scanner and configure with queues.
 	s.scanner = newRangeScanner(ctx.ScanInterval, ctx.ScanMaxIdleTime, newStoreRangeSet(s),
 		s.updateStoreStatus)
-	s.gcQueue = newGCQueue()
+	s.gcQueue = newGCQueue(s.db)
 	s._splitQueue = newSplitQueue(s.db, s.ctx.Gossip)
 	s.verifyQueue = newVerifyQueue(s.scanner.Stats)
 	s.replicateQueue = newReplicateQueue(s.ctx.Gossip, s.allocator(), s.ctx.Clock)
 	s.rangeGCQueue = newRangeGCQueue(s.db)
-	s.scanner.AddQueues(s.gcQueue, s.splitQueue(), s.verifyQueue, s.replicateQueue, s.rangeGCQueue)
+	s.tableGCQueue = newTableGCQueue(s.db, s.ctx.TableGCGracePeriod)
+	s.scanner.AddQueues(s.gcQueue, s.splitQueue(), s.verifyQueue, s.replicateQueue, s.rangeGCQueue,
+		s.tableGCQueue)
 
 	return s
 }
@@ -506,6 +519,16 @@ func (s *Store) Start(stopper *util.Stopper) error {
 
 	s.mu.Unlock()
 
+	// If the store holds the table descriptors, read the privileges of
+	// tables from them rather than waiting for them to be gossiped.
+	if s.LookupRange(keys.DescMetadataPrefix, nil) != nil {
+		privMap, _, err := loadTablePrivilegeMap(s.engine)
+		if err != nil {
+			return err
+		}
+		s.tablePrivileges.update(privMap)
+	}
+
 	// Start Raft processing goroutines.
 	if err = s.multiraft.Start(); err != nil {
 		return err
@@ -522,6 +545,9 @@ func (s *Store) Start(stopper *util.Stopper) error {
 		// permissions don't have such a requirement.)
 		s.ctx.Gossip.RegisterCallback(gossip.KeyConfigAccounting, s.configGossipUpdate)
 		s.ctx.Gossip.RegisterCallback(gossip.KeyConfigZone, s.configGossipUpdate)
+		// Register a callback for the privileges of tables, which are
+		// verified on every request.
+		s.ctx.Gossip.RegisterCallback(gossip.KeyTablePrivileges, s.tablePrivilegeGossipUpdate)
 
 		// Start a single goroutine in charge of periodically gossipping the
 		// sentinel and first range metadata if we have a first range.
@@ -650,6 +676,14 @@ func (s *Store) maybeGossipConfigs() error {
 			return rng.ContainsKey(cd.keyPrefix)
 		})
 	}
+	// The privileges of tables are gossiped alike by the range holding
+	// the table descriptors.
+	if rng := s.LookupRange(keys.DescMetadataPrefix, nil); rng != nil {
+		if _, err := rng.getLeaseForGossip(s.Context(nil)); err != nil {
+			return err
+		}
+		rng.maybeGossipTablePrivileges()
+	}
 	return nil
 }
 
@@ -738,6 +772,35 @@ func (s *Store) ForceRangeGCScan(t util.Tester) {
 	}
 }
 
+// ForceGCScan rereads the list of tables whose rows have a time to live
+// and enqueues any ranges that may need to be GC'd. Exposed only for
+// testing.
+func (s *Store) ForceGCScan(t util.Tester) {
+	if err := s.gcQueue.tables.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.ranges {
+		s.gcQueue.MaybeAdd(r, s.ctx.Clock.Now())
+	}
+}
+
+// ForceTableGCScan rereads the list of dropped tables and enqueues the
+// ranges holding their data. Exposed only for testing.
+func (s *Store) ForceTableGCScan(t util.Tester) {
+	if err := s.tableGCQueue.dropped.refresh(); err != nil {
+		t.Fatal(err)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, r := range s.ranges {
+		s.tableGCQueue.MaybeAdd(r, s.ctx.Clock.Now())
+	}
+}
+
 // setRangesMaxBytes sets the max bytes for every range according
 // to the zone configs.
 //
@@ -1197,6 +1260,10 @@ func (s *Store) ExecuteCmd(ctx context.Context, call client.Call) error {
 		reply.Header().SetGoError(err)
 		return err
 	}
+	if err := s.verifyTablePrivileges(args); err != nil {
+		reply.Header().SetGoError(err)
+		return err
+	}
 	if !header.Timestamp.Equal(proto.ZeroTimestamp) {
 		if s.Clock().MaxOffset() > 0 {
 			// Once a command is submitted to raft, all replicas' logical
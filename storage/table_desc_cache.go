@@ -0,0 +1,104 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+// tableDescCacheRefreshInterval is the interval at which the list of
+// table descriptors held by a tableDescCache is reread.
+const tableDescCacheRefreshInterval = 1 * time.Minute
+
+// A tableDescCache holds a list of table descriptors, such as those of the
+// dropped tables, for the queues which act upon the data of tables. The
+// list is reread periodically by a worker rather than on demand, as the
+// shouldQueue methods of queues are called with the store's lock held and
+// must not read through the store. The list function must return
+// descriptors upgraded by proto.MaybeUpgradeTableDescriptor.
+type tableDescCache struct {
+	name string // Describes the list in log messages
+	list func() ([]proto.TableDescriptor, error)
+
+	mu    sync.Mutex // Protects descs
+	descs []proto.TableDescriptor
+}
+
+// start launches a worker which rereads the list every
+// tableDescCacheRefreshInterval until the stopper is stopped.
+func (c *tableDescCache) start(stopper *util.Stopper) {
+	stopper.RunWorker(func() {
+		ticker := time.NewTicker(tableDescCacheRefreshInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				if !stopper.StartTask() {
+					continue
+				}
+				if err := c.refresh(); err != nil {
+					log.Warningf("unable to list %s: %s", c.name, err)
+				}
+				stopper.FinishTask()
+			case <-stopper.ShouldStop():
+				return
+			}
+		}
+	})
+}
+
+// refresh rereads the list.
+func (c *tableDescCache) refresh() error {
+	descs, err := c.list()
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.descs = descs
+	return nil
+}
+
+// get returns the descriptors of the list which satisfy the predicate.
+func (c *tableDescCache) get(pred func(*proto.TableDescriptor) bool) []proto.TableDescriptor {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var descs []proto.TableDescriptor
+	for i := range c.descs {
+		if pred(&c.descs[i]) {
+			descs = append(descs, c.descs[i])
+		}
+	}
+	return descs
+}
+
+// remove removes the descriptor with the given ID from the list until it
+// is next reread.
+func (c *tableDescCache) remove(tableID uint32) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, desc := range c.descs {
+		if desc.Id == tableID {
+			c.descs = append(c.descs[:i:i], c.descs[i+1:]...)
+			return
+		}
+	}
+}
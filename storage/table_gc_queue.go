@@ -0,0 +1,180 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/hlc"
+	"github.com/cockroachdb/cockroach/util/log"
+)
+
+const (
+	// tableGCQueueMaxSize is the max size of the table GC queue.
+	tableGCQueueMaxSize = 100
+
+	// tableGCQueueTimerDuration is the duration between GCs of queued ranges.
+	tableGCQueueTimerDuration = 1 * time.Second
+
+	// tableGCQueueBatchSize is the maximum number of keys deleted by a
+	// single request.
+	tableGCQueueBatchSize = 1000
+)
+
+// tableGCQueue manages a queue of ranges holding the data of dropped
+// tables. Once the grace period of a dropped table has passed, the leader
+// of each range deletes the portion of the table's data within the range,
+// in batches of at most tableGCQueueBatchSize keys, and the descriptor of
+// the table is removed once all of its data has been deleted. This spares
+// clients from reclaiming the data of large tables themselves (see
+// client.DB.GCDroppedTables).
+type tableGCQueue struct {
+	*baseQueue
+	db          *client.DB
+	gracePeriod time.Duration
+	dropped     tableDescCache
+}
+
+// newTableGCQueue returns a new instance of tableGCQueue. A zero grace
+// period disables the queue.
+func newTableGCQueue(db *client.DB, gracePeriod time.Duration) *tableGCQueue {
+	q := &tableGCQueue{
+		db:          db,
+		gracePeriod: gracePeriod,
+		dropped:     tableDescCache{name: "dropped tables", list: db.ListDroppedTables},
+	}
+	q.baseQueue = newBaseQueue("tableGC", q, tableGCQueueMaxSize)
+	return q
+}
+
+func (q *tableGCQueue) needsLeaderLease() bool {
+	return true
+}
+
+// shouldQueue determines whether a range should be queued for table GC.
+// Ranges are queued if they intersect the data of a dropped table whose
+// grace period has passed, at equal priority.
+func (q *tableGCQueue) shouldQueue(now proto.Timestamp, rng *Range) (bool, float64) {
+	for _, desc := range q.reclaimable() {
+		if _, _, ok := intersectTable(rng.Desc(), desc.Id); ok {
+			return true, 1
+		}
+	}
+	return false, 0
+}
+
+// process deletes the data of dropped tables within the range.
+func (q *tableGCQueue) process(now proto.Timestamp, rng *Range) error {
+	for _, desc := range q.reclaimable() {
+		start, end, ok := intersectTable(rng.Desc(), desc.Id)
+		if !ok {
+			continue
+		}
+		// The table may have been restored since the list was read. Once
+		// marked as being reclaimed, it can no longer be.
+		if ok, err := q.db.BeginTableReclamation(desc.Id, q.gracePeriod); err != nil {
+			return err
+		} else if !ok {
+			continue
+		}
+		for {
+			b := &client.Batch{}
+			b.DelRangeLimit(start, end, tableGCQueueBatchSize)
+			b.SetUserPriority(client.LowUserPriority)
+			if err := q.db.Run(b); err != nil {
+				return err
+			}
+			if b.Results[0].Deleted < tableGCQueueBatchSize {
+				break
+			}
+		}
+		if err := q.maybeRemoveTable(desc.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// timer returns interval between processing successive queued ranges.
+func (q *tableGCQueue) timer() time.Duration {
+	return tableGCQueueTimerDuration
+}
+
+// Start launches the processing of the queue and the worker which
+// rereads the list of dropped tables.
+func (q *tableGCQueue) Start(clock *hlc.Clock, stopper *util.Stopper) {
+	q.baseQueue.Start(clock, stopper)
+	if q.gracePeriod > 0 && q.db != nil {
+		q.dropped.start(stopper)
+	}
+}
+
+// reclaimable returns the dropped tables whose grace period has passed.
+func (q *tableGCQueue) reclaimable() []proto.TableDescriptor {
+	if q.gracePeriod <= 0 {
+		return nil
+	}
+	now := time.Now().UnixNano()
+	return q.dropped.get(func(desc *proto.TableDescriptor) bool {
+		return now-desc.DropTime >= q.gracePeriod.Nanoseconds()
+	})
+}
+
+// maybeRemoveTable removes the descriptor and other metadata of a dropped
+// table once all of its data, in whichever range, has been deleted.
+func (q *tableGCQueue) maybeRemoveTable(tableID uint32) error {
+	prefix := keys.MakeTablePrefix(tableID)
+	kvs, err := q.db.Scan(prefix, prefix.PrefixEnd(), 1)
+	if err != nil || len(kvs) > 0 {
+		return err
+	}
+	if err := q.db.Txn(func(txn *client.Txn) error {
+		b := &client.Batch{}
+		client.DeleteTableMetadata(b, tableID)
+		return txn.Commit(b)
+	}); err != nil {
+		return err
+	}
+	if log.V(1) {
+		log.Infof("reclaimed dropped table %d", tableID)
+	}
+	q.dropped.remove(tableID)
+	return nil
+}
+
+// intersectTable returns the intersection of the range with the data of
+// the table with the given ID, and whether it is non-empty.
+func intersectTable(desc *proto.RangeDescriptor, tableID uint32) (proto.Key, proto.Key, bool) {
+	prefix := keys.MakeTablePrefix(tableID)
+	return intersectSpan(desc, prefix, prefix.PrefixEnd())
+}
+
+// intersectSpan returns the intersection of the range with the span from
+// start to end, and whether it is non-empty.
+func intersectSpan(desc *proto.RangeDescriptor, start, end proto.Key) (proto.Key, proto.Key, bool) {
+	if bytes.Compare(desc.StartKey, start) > 0 {
+		start = desc.StartKey
+	}
+	if bytes.Compare(desc.EndKey, end) < 0 {
+		end = desc.EndKey
+	}
+	return start, end, bytes.Compare(start, end) < 0
+}
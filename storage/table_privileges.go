@@ -0,0 +1,220 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/storage/engine"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/encoding"
+	"github.com/cockroachdb/cockroach/util/log"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+// A TablePrivilegeMap maps the IDs of tables to their privileges. It is
+// gossiped by the range holding the table descriptors.
+type TablePrivilegeMap map[uint32]proto.PrivilegeDescriptor
+
+// loadTablePrivilegeMap reads the privileges of the tables from their
+// descriptors and returns them with their sha256 hash. The descriptors
+// are read inconsistently, so descriptors written by transactions which
+// have not yet been resolved keep their previous privileges.
+func loadTablePrivilegeMap(eng engine.Engine) (TablePrivilegeMap, []byte, error) {
+	kvs, err := engine.MVCCScan(eng, keys.DescMetadataPrefix, keys.DescMetadataPrefix.PrefixEnd(), 0,
+		proto.MaxTimestamp, false /* !consistent */, nil)
+	if _, ok := err.(*proto.WriteIntentError); err != nil && !ok {
+		return nil, nil, err
+	}
+	m := TablePrivilegeMap{}
+	sha := sha256.New()
+	for _, kv := range kvs {
+		// Database descriptors share the ID space of tables; they fail
+		// to parse as table descriptors or have no columns.
+		var desc proto.TableDescriptor
+		if err := gogoproto.Unmarshal(kv.Value.Bytes, &desc); err != nil || len(desc.Columns) == 0 {
+			continue
+		}
+		if _, err := proto.MaybeUpgradeTableDescriptor(&desc); err != nil {
+			// The descriptor is of a format this node cannot read; deny
+			// access to the table to everyone but root.
+			log.Warningf("unable to read the privileges of table %d: %s", desc.Id, err)
+			desc.Privileges = proto.PrivilegeDescriptor{}
+		}
+		m[desc.Id] = desc.Privileges
+		sha.Write(kv.Value.Bytes)
+	}
+	return m, sha.Sum(nil), nil
+}
+
+// A tablePrivileges holds the privileges of a table.
+type tablePrivileges struct {
+	tableID    uint32
+	privileges proto.PrivilegeDescriptor
+}
+
+// A tablePrivilegeCache holds the gossiped privileges of tables for the
+// enforcement of table privileges by a store. Privileges granted or
+// revoked with client.DB.Grant and client.DB.Revoke take effect once
+// they are gossiped to the store.
+type tablePrivilegeCache struct {
+	mu     sync.RWMutex // Protects the fields below
+	loaded bool         // Whether privileges have been gossiped
+	privs  []tablePrivileges
+}
+
+// update replaces the privileges held by the cache.
+func (c *tablePrivilegeCache) update(m TablePrivilegeMap) {
+	privs := make([]tablePrivileges, 0, len(m))
+	for id, p := range m {
+		privs = append(privs, tablePrivileges{tableID: id, privileges: p})
+	}
+	sort.Sort(tablePrivilegesByID(privs))
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.loaded = true
+	c.privs = privs
+}
+
+// lookup returns the privileges of the tables whose data intersects the
+// span from key to endKey, ordered by table ID. An empty endKey
+// specifies the single key. An error is returned if the span intersects
+// the table data keyspace and no privileges have been gossiped yet.
+func (c *tablePrivilegeCache) lookup(key, endKey proto.Key) ([]tablePrivileges, error) {
+	single := len(endKey) == 0
+	if single {
+		endKey = key.Next()
+	}
+	if !key.Less(tableDataMax) || !tableDataMin.Less(endKey) {
+		return nil, nil
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if !c.loaded {
+		return nil, util.Errorf("table privileges have not been gossiped yet")
+	}
+	if single {
+		id, ok := decodeTableID(key)
+		if !ok {
+			return nil, nil
+		}
+		i := sort.Search(len(c.privs), func(i int) bool { return c.privs[i].tableID >= id })
+		if i == len(c.privs) || c.privs[i].tableID != id {
+			return nil, nil
+		}
+		return c.privs[i : i+1], nil
+	}
+	// Table data is ordered by table ID, as uvarints sort numerically.
+	i := sort.Search(len(c.privs), func(i int) bool {
+		return key.Less(keys.MakeTablePrefix(c.privs[i].tableID).PrefixEnd())
+	})
+	j := i
+	for j < len(c.privs) && keys.MakeTablePrefix(c.privs[j].tableID).Less(endKey) {
+		j++
+	}
+	return c.privs[i:j], nil
+}
+
+// tablePrivilegesByID implements sort.Interface for a slice of
+// tablePrivileges ordered by table ID.
+type tablePrivilegesByID []tablePrivileges
+
+func (p tablePrivilegesByID) Len() int           { return len(p) }
+func (p tablePrivilegesByID) Swap(i, j int)      { p[i], p[j] = p[j], p[i] }
+func (p tablePrivilegesByID) Less(i, j int) bool { return p[i].tableID < p[j].tableID }
+
+var (
+	// tableDataMin and tableDataMax bound the keys of table data, which
+	// are prefixed by the uvarint encoding of the table ID (see
+	// keys.MakeTablePrefix).
+	tableDataMin = keys.MakeTablePrefix(0)
+	tableDataMax = keys.MakeTablePrefix(math.MaxUint32).PrefixEnd()
+)
+
+// decodeTableID returns the ID of the table whose data contains the key,
+// and false if the key does not begin with the prefix of a table.
+func decodeTableID(key proto.Key) (id uint32, ok bool) {
+	// DecodeUvarint panics on malformed input.
+	defer func() {
+		if r := recover(); r != nil {
+			id, ok = 0, false
+		}
+	}()
+	_, v := encoding.DecodeUvarint(key)
+	if v > math.MaxUint32 || !bytes.HasPrefix(key, keys.MakeTablePrefix(uint32(v))) {
+		return 0, false
+	}
+	return uint32(v), true
+}
+
+// tablePrivilegeGossipUpdate is a callback for gossip updates to the
+// privileges of tables.
+func (s *Store) tablePrivilegeGossipUpdate(key string, contentsChanged bool) {
+	if !contentsChanged {
+		return
+	}
+	ctx := s.Context(nil)
+	info, err := s.ctx.Gossip.GetInfo(key)
+	if err != nil {
+		log.Errorc(ctx, "unable to fetch table privileges from gossip: %s", err)
+		return
+	}
+	privMap, ok := info.(TablePrivilegeMap)
+	if !ok {
+		log.Errorc(ctx, "gossiped info is not a table privilege map: %+v", info)
+		return
+	}
+	s.tablePrivileges.update(privMap)
+}
+
+// verifyTablePrivileges verifies that the user of a request holds the
+// privileges required on the tables whose data the request accesses:
+// READ for reads and WRITE for writes. Structured row requests and raw
+// key-value requests addressing table data are treated alike. The root
+// user holds every privilege.
+func (s *Store) verifyTablePrivileges(args proto.Request) error {
+	header := args.Header()
+	if header.User == UserRoot {
+		return nil
+	}
+	switch args.(type) {
+	case *proto.EndTransactionRequest, *proto.InternalHeartbeatTxnRequest, *proto.InternalPushTxnRequest:
+		// These address transaction records, which are anchored at the
+		// first key written by a transaction, rather than table data.
+		return nil
+	}
+	privs, err := s.tablePrivileges.lookup(header.Key, header.EndKey)
+	if err != nil {
+		return util.Errorf("unable to verify table privileges: %s", err)
+	}
+	for _, p := range privs {
+		if proto.IsRead(args) && !p.privileges.CheckPrivilege(header.User, proto.PrivilegeDescriptor_READ) {
+			return util.Errorf("user %q does not have %s privilege on table %d", header.User,
+				proto.PrivilegeDescriptor_READ, p.tableID)
+		}
+		if proto.IsWrite(args) && !p.privileges.CheckPrivilege(header.User, proto.PrivilegeDescriptor_WRITE) {
+			return util.Errorf("user %q does not have %s privilege on table %d", header.User,
+				proto.PrivilegeDescriptor_WRITE, p.tableID)
+		}
+	}
+	return nil
+}
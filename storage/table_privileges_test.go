@@ -0,0 +1,182 @@
+// Copyright 2015 The Cockroach Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or
+// implied. See the License for the specific language governing
+// permissions and limitations under the License. See the AUTHORS file
+// for names of contributors.
+
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/cockroach/client"
+	"github.com/cockroachdb/cockroach/keys"
+	"github.com/cockroachdb/cockroach/proto"
+	"github.com/cockroachdb/cockroach/util"
+	"github.com/cockroachdb/cockroach/util/leaktest"
+	gogoproto "github.com/gogo/protobuf/proto"
+)
+
+func TestTablePrivilegeCacheLookup(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	c := &tablePrivilegeCache{}
+	if _, err := c.lookup(keys.MakeTablePrefix(5), nil); err == nil {
+		t.Errorf("expected a lookup of table data before privileges are gossiped to fail")
+	}
+	if _, err := c.lookup(proto.Key("a"), nil); err != nil {
+		t.Errorf("unexpected error looking up non-table data: %s", err)
+	}
+	c.update(TablePrivilegeMap{5: {}, 6: {}, 255: {}, 256: {}, 1000: {}})
+
+	testCases := []struct {
+		key, endKey proto.Key
+		ids         []uint32
+	}{
+		// Single keys.
+		{keys.MakeTablePrefix(5), nil, []uint32{5}},
+		{keys.MakeKey(keys.MakeTablePrefix(1000), proto.Key("row")), nil, []uint32{1000}},
+		{keys.MakeTablePrefix(7), nil, nil},
+		{proto.Key("a"), nil, nil},
+		{keys.MakeDescMetadataKey(5), nil, nil},
+		// Truncated and non-canonical table prefixes.
+		{proto.Key("\x0a\x03"), nil, nil},
+		{proto.Key("\x0a\x00\x05"), nil, nil},
+		// Spans.
+		{keys.MakeTablePrefix(5), keys.MakeTablePrefix(5).PrefixEnd(), []uint32{5}},
+		{keys.MakeTablePrefix(5), keys.MakeTablePrefix(7), []uint32{5, 6}},
+		{keys.MakeTablePrefix(255), keys.MakeTablePrefix(256), []uint32{255}},
+		{keys.MakeKey(keys.MakeTablePrefix(5), proto.Key("a")), keys.MakeKey(keys.MakeTablePrefix(5), proto.Key("b")), []uint32{5}},
+		{keys.MakeKey(keys.MakeTablePrefix(6), proto.Key("a")), keys.MakeKey(keys.MakeTablePrefix(1000), proto.Key("a")), []uint32{6, 255, 256, 1000}},
+		{proto.KeyMin, proto.Key("a"), []uint32{5, 6, 255, 256, 1000}},
+		{proto.KeyMin, keys.MakeTablePrefix(0), nil},
+		{keys.SystemPrefix, keys.SystemPrefix.PrefixEnd(), nil},
+		{proto.Key("a"), proto.Key("b"), nil},
+	}
+	for i, test := range testCases {
+		privs, err := c.lookup(test.key, test.endKey)
+		if err != nil {
+			t.Errorf("%d: unexpected error: %s", i, err)
+			continue
+		}
+		var ids []uint32
+		for _, p := range privs {
+			ids = append(ids, p.tableID)
+		}
+		if len(ids) != len(test.ids) {
+			t.Errorf("%d: expected tables %v, but found %v", i, test.ids, ids)
+			continue
+		}
+		for j := range ids {
+			if ids[j] != test.ids[j] {
+				t.Errorf("%d: expected tables %v, but found %v", i, test.ids, ids)
+				break
+			}
+		}
+	}
+}
+
+// TestStoreVerifyTablePrivileges verifies that the store requires the
+// READ and WRITE privileges on a table for reads and writes of its data
+// by users other than root, as gossiped from the table's descriptor.
+func TestStoreVerifyTablePrivileges(t *testing.T) {
+	defer leaktest.AfterTest(t)
+	store, _, stopper := createTestStore(t)
+	defer stopper.Stop()
+
+	desc := proto.TableDescriptor{
+		Name:       "users",
+		Id:         1000,
+		Columns:    []proto.ColumnDescriptor{{Id: 1, Column: proto.Column{Name: "id", Type: proto.Column_INT}}},
+		Privileges: proto.NewDefaultPrivilegeDescriptor(),
+	}
+	key := keys.MakeKey(keys.MakeTablePrefix(desc.Id), proto.Key("a"))
+
+	execute := func(user string, args proto.Request, reply proto.Response) error {
+		args.Header().User = user
+		args.Header().RaftID = 1
+		args.Header().Replica = proto.Replica{StoreID: store.StoreID()}
+		return store.ExecuteCmd(nil, client.Call{Args: args, Reply: reply})
+	}
+	get := func(user string) error {
+		return execute(user, &proto.GetRequest{RequestHeader: proto.RequestHeader{Key: key}}, &proto.GetResponse{})
+	}
+	put := func(user string) error {
+		return execute(user, &proto.PutRequest{
+			RequestHeader: proto.RequestHeader{Key: key},
+			Value:         proto.Value{Bytes: []byte("value")},
+		}, &proto.PutResponse{})
+	}
+	scan := func(user string) error {
+		return execute(user, &proto.ScanRequest{
+			RequestHeader: proto.RequestHeader{Key: proto.KeyMin, EndKey: proto.Key("a")},
+		}, &proto.ScanResponse{})
+	}
+	// writeDesc writes the descriptor and waits for its privileges to be
+	// gossiped to the store.
+	writeDesc := func() {
+		b, err := gogoproto.Marshal(&desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := execute(UserRoot, &proto.PutRequest{
+			RequestHeader: proto.RequestHeader{Key: keys.MakeDescMetadataKey(desc.Id)},
+			Value:         proto.Value{Bytes: b},
+		}, &proto.PutResponse{}); err != nil {
+			t.Fatal(err)
+		}
+		util.SucceedsWithin(t, time.Second, func() error {
+			privs, err := store.tablePrivileges.lookup(key, nil)
+			if err != nil {
+				return err
+			}
+			if len(privs) != 1 || !gogoproto.Equal(&privs[0].privileges, &desc.Privileges) {
+				return util.Errorf("expected privileges %s, but found %+v", desc.Privileges, privs)
+			}
+			return nil
+		})
+	}
+
+	writeDesc()
+	if err := put(UserRoot); err != nil {
+		t.Fatal(err)
+	}
+	for _, fn := range []func(string) error{get, put, scan} {
+		if err := fn("alice"); err == nil {
+			t.Errorf("expected the request of a user without privileges to fail")
+		}
+	}
+
+	desc.Privileges.Grant("alice", []proto.PrivilegeDescriptor_Kind{proto.PrivilegeDescriptor_READ})
+	writeDesc()
+	if err := get("alice"); err != nil {
+		t.Error(err)
+	}
+	if err := scan("alice"); err != nil {
+		t.Error(err)
+	}
+	if err := put("alice"); err == nil {
+		t.Errorf("expected a write without the WRITE privilege to fail")
+	}
+
+	desc.Privileges.Grant("alice", []proto.PrivilegeDescriptor_Kind{proto.PrivilegeDescriptor_WRITE})
+	writeDesc()
+	if err := put("alice"); err != nil {
+		t.Error(err)
+	}
+
+	desc.Privileges.Revoke("alice", []proto.PrivilegeDescriptor_Kind{proto.PrivilegeDescriptor_READ})
+	writeDesc()
+	if err := get("alice"); err == nil {
+		t.Errorf("expected a read after the READ privilege was revoked to fail")
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"path/filepath"
 	"runtime"
+	"strings"
 )
 
 const defaultSkip = 2
@@ -65,3 +66,17 @@ func ErrorSkipFrames(skip int, a ...interface{}) error {
 	}
 	return fmt.Errorf("%s", fmt.Sprint(a...))
 }
+
+// Errors is a list of errors which are reported together, such as all the
+// problems found while validating a descriptor.
+type Errors []error
+
+// Error implements the error interface, joining the messages of all the
+// contained errors.
+func (e Errors) Error() string {
+	msgs := make([]string, len(e))
+	for i, err := range e {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}